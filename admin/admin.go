@@ -3,6 +3,8 @@ package admin
 import (
 	"context"
 	"fmt"
+	"sync"
+	"time"
 
 	"github.com/rilldata/rill/admin/ai"
 	"github.com/rilldata/rill/admin/database"
@@ -15,6 +17,7 @@ import (
 type Options struct {
 	DatabaseDriver     string
 	DatabaseDSN        string
+	EncryptionKeys     database.KeyProvider
 	ProvisionerSetJSON string
 	DefaultProvisioner string
 	ExternalURL        string
@@ -39,11 +42,14 @@ type Service struct {
 	VersionCommit    string
 	metricsProjectID string
 	AutoscalerCron   string
+	bgCtx            context.Context
+	bgCancel         context.CancelFunc
+	bgWg             sync.WaitGroup
 }
 
 func New(ctx context.Context, opts *Options, logger *zap.Logger, issuer *auth.Issuer, emailClient *email.Client, github Github, aiClient ai.Client) (*Service, error) {
 	// Init db
-	db, err := database.Open(opts.DatabaseDriver, opts.DatabaseDSN)
+	db, err := database.Open(opts.DatabaseDriver, opts.DatabaseDSN, opts.EncryptionKeys)
 	if err != nil {
 		logger.Fatal("error connecting to database", zap.Error(err))
 	}
@@ -89,6 +95,8 @@ func New(ctx context.Context, opts *Options, logger *zap.Logger, issuer *auth.Is
 		metricsProjectID = proj.ID
 	}
 
+	bgCtx, bgCancel := context.WithCancel(context.Background())
+
 	return &Service{
 		DB:               db,
 		ProvisionerSet:   provSet,
@@ -103,10 +111,33 @@ func New(ctx context.Context, opts *Options, logger *zap.Logger, issuer *auth.Is
 		VersionCommit:    opts.VersionCommit,
 		metricsProjectID: metricsProjectID,
 		AutoscalerCron:   opts.AutoscalerCron,
+		bgCtx:            bgCtx,
+		bgCancel:         bgCancel,
 	}, nil
 }
 
 func (s *Service) Close() error {
+	// Cancel and wait for background jobs before closing the resources they use
+	s.bgCancel()
+	s.bgWg.Wait()
+
 	s.Used.Close()
 	return s.DB.Close()
 }
+
+// runInBackground runs a job without blocking the caller, e.g. for work triggered by a webhook that can take a while.
+// Unlike a plain goroutine, the job is canceled when the service is closed and Close waits for it to return.
+func (s *Service) runInBackground(name string, timeout time.Duration, fn func(ctx context.Context) error) {
+	s.bgWg.Add(1)
+	go func() {
+		defer s.bgWg.Done()
+
+		ctx, cancel := context.WithTimeout(s.bgCtx, timeout)
+		defer cancel()
+
+		err := fn(ctx)
+		if err != nil {
+			s.Logger.Error("background job failed", zap.String("name", name), zap.Error(err))
+		}
+	}()
+}
@@ -19,13 +19,14 @@ func Register(name string, driver Driver) {
 }
 
 // Open opens a new database connection.
-func Open(driver, dsn string) (DB, error) {
+// If keys is nil, sensitive columns are stored unencrypted and previously encrypted data can't be read.
+func Open(driver, dsn string, keys KeyProvider) (DB, error) {
 	d, ok := Drivers[driver]
 	if !ok {
 		return nil, fmt.Errorf("unknown database driver: %s", driver)
 	}
 
-	db, err := d.Open(dsn)
+	db, err := d.Open(dsn, keys)
 	if err != nil {
 		return nil, err
 	}
@@ -35,7 +36,7 @@ func Open(driver, dsn string) (DB, error) {
 
 // Driver is the interface for DB drivers.
 type Driver interface {
-	Open(dsn string) (DB, error)
+	Open(dsn string, keys KeyProvider) (DB, error)
 }
 
 // DB is the interface for a database connection.
@@ -46,6 +47,10 @@ type DB interface {
 	Migrate(ctx context.Context) error
 	FindMigrationVersion(ctx context.Context) (int, error)
 
+	// RotateEncryptionKeys re-wraps the data keys of encrypted rows with the key provider's current key and encrypts rows that are not yet encrypted.
+	// It returns the number of rows that were updated.
+	RotateEncryptionKeys(ctx context.Context) (int, error)
+
 	FindOrganizations(ctx context.Context, afterName string, limit int) ([]*Organization, error)
 	FindOrganizationsForUser(ctx context.Context, userID string, afterName string, limit int) ([]*Organization, error)
 	FindOrganization(ctx context.Context, id string) (*Organization, error)
@@ -96,6 +101,10 @@ type DB interface {
 	UpdateDeploymentRuntimeVersion(ctx context.Context, id, version string) (*Deployment, error)
 	UpdateDeploymentBranch(ctx context.Context, id, branch string) (*Deployment, error)
 	UpdateDeploymentUsedOn(ctx context.Context, ids []string) error
+	FindPreviewDeploymentsForProject(ctx context.Context, projectID string) ([]*Deployment, error)
+	FindPreviewDeploymentForBranch(ctx context.Context, projectID, branch string) (*Deployment, error)
+	FindExpiredPreviewDeployments(ctx context.Context, unusedFor time.Duration) ([]*Deployment, error)
+	UpdatePreviewDeployment(ctx context.Context, id string, opts *UpdatePreviewDeploymentOptions) (*Deployment, error)
 	CountDeploymentsForOrganization(ctx context.Context, orgID string) (*DeploymentsCount, error)
 
 	ResolveRuntimeSlotsUsed(ctx context.Context) ([]*RuntimeSlotsUsed, error)
@@ -113,11 +122,20 @@ type DB interface {
 	UpdateSuperuser(ctx context.Context, userID string, superuser bool) error
 	CheckUserIsAnOrganizationMember(ctx context.Context, userID, orgID string) (bool, error)
 	CheckUserIsAProjectMember(ctx context.Context, userID, projectID string) (bool, error)
+	CheckUserIsSCIMProvisioned(ctx context.Context, userID, orgID string) (bool, error)
+	InsertSCIMProvisionedUser(ctx context.Context, orgID, userID string) error
 
+	FindUsergroupsForOrganization(ctx context.Context, orgID, afterName string, limit int) ([]*Usergroup, error)
+	FindUsergroup(ctx context.Context, id string) (*Usergroup, error)
+	FindUsergroupByName(ctx context.Context, orgName, name string) (*Usergroup, error)
 	InsertUsergroup(ctx context.Context, opts *InsertUsergroupOptions) (*Usergroup, error)
+	UpdateUsergroupName(ctx context.Context, id string, opts *UpdateUsergroupOptions) (*Usergroup, error)
+	DeleteUsergroup(ctx context.Context, id string) error
 	FindUsergroupsForUser(ctx context.Context, userID, orgID string) ([]*Usergroup, error)
+	FindUsergroupMemberUsers(ctx context.Context, groupID, afterEmail string, limit int) ([]*User, error)
 	InsertUsergroupMember(ctx context.Context, groupID, userID string) error
 	DeleteUsergroupMember(ctx context.Context, groupID, userID string) error
+	DeleteUsergroupsMemberUser(ctx context.Context, orgID, userID string) error
 
 	FindUserAuthTokens(ctx context.Context, userID string) ([]*UserAuthToken, error)
 	FindUserAuthToken(ctx context.Context, id string) (*UserAuthToken, error)
@@ -172,11 +190,15 @@ type DB interface {
 
 	FindProjectMemberUsers(ctx context.Context, projectID, afterEmail string, limit int) ([]*Member, error)
 	InsertProjectMemberUser(ctx context.Context, projectID, userID, roleID string) error
-	InsertProjectMemberUsergroup(ctx context.Context, groupID, projectID, roleID string) error
 	DeleteProjectMemberUser(ctx context.Context, projectID, userID string) error
 	DeleteAllProjectMemberUserForOrganization(ctx context.Context, orgID, userID string) error
 	UpdateProjectMemberUserRole(ctx context.Context, projectID, userID, roleID string) error
 
+	FindProjectMemberUsergroups(ctx context.Context, projectID, afterName string, limit int) ([]*MemberUsergroup, error)
+	InsertProjectMemberUsergroup(ctx context.Context, groupID, projectID, roleID string) error
+	DeleteProjectMemberUsergroup(ctx context.Context, groupID, projectID string) error
+	UpdateProjectMemberUsergroupRole(ctx context.Context, groupID, projectID, roleID string) error
+
 	FindOrganizationInvites(ctx context.Context, orgID, afterEmail string, limit int) ([]*Invite, error)
 	FindOrganizationInvitesByEmail(ctx context.Context, userEmail string) ([]*OrganizationInvite, error)
 	FindOrganizationInvite(ctx context.Context, orgID, userEmail string) (*OrganizationInvite, error)
@@ -201,6 +223,15 @@ type DB interface {
 
 	SearchProjectUsers(ctx context.Context, projectID, emailQuery string, afterEmail string, limit int) ([]*User, error)
 
+	FindAuditEvents(ctx context.Context, opts *FindAuditEventsOptions) ([]*AuditEvent, error)
+	InsertAuditEvent(ctx context.Context, opts *InsertAuditEventOptions) (*AuditEvent, error)
+
+	FindSSOProvider(ctx context.Context, id string) (*SSOProvider, error)
+	FindSSOProviderForOrganization(ctx context.Context, orgID string) (*SSOProvider, error)
+	FindSSOProviderForDomain(ctx context.Context, domain string) (*SSOProvider, error)
+	UpsertSSOProvider(ctx context.Context, opts *UpsertSSOProviderOptions) (*SSOProvider, error)
+	DeleteSSOProvider(ctx context.Context, id string) error
+
 	FindVirtualFiles(ctx context.Context, projectID, branch string, afterUpdatedOn time.Time, afterPath string, limit int) ([]*VirtualFile, error)
 	FindVirtualFile(ctx context.Context, projectID, branch, path string) (*VirtualFile, error)
 	UpsertVirtualFile(ctx context.Context, opts *InsertVirtualFileOptions) error
@@ -272,20 +303,26 @@ type Project struct {
 	Public               bool
 	CreatedByUserID      *string `db:"created_by_user_id"`
 	Provisioner          string
-	GithubURL            *string           `db:"github_url"`
-	GithubInstallationID *int64            `db:"github_installation_id"`
-	Subpath              string            `db:"subpath"`
-	ProdVersion          string            `db:"prod_version"`
-	ProdBranch           string            `db:"prod_branch"`
-	ProdVariables        map[string]string `db:"prod_variables"`
-	ProdOLAPDriver       string            `db:"prod_olap_driver"`
-	ProdOLAPDSN          string            `db:"prod_olap_dsn"`
-	ProdSlots            int               `db:"prod_slots"`
-	ProdTTLSeconds       *int64            `db:"prod_ttl_seconds"`
-	ProdDeploymentID     *string           `db:"prod_deployment_id"`
-	Annotations          map[string]string `db:"annotations"`
-	CreatedOn            time.Time         `db:"created_on"`
-	UpdatedOn            time.Time         `db:"updated_on"`
+	GithubURL            *string `db:"github_url"`
+	GithubInstallationID *int64  `db:"github_installation_id"`
+	Subpath              string  `db:"subpath"`
+	ProdVersion          string  `db:"prod_version"`
+	ProdBranch           string  `db:"prod_branch"`
+	// ProdVariables contains the variables that are explicitly shared by all environments.
+	// The name is historical: variables for prod deployments are in EnvironmentVariables["prod"].
+	ProdVariables      map[string]string `db:"prod_variables"`
+	ProdOLAPDriver     string            `db:"prod_olap_driver"`
+	ProdOLAPDSN        string            `db:"prod_olap_dsn"`
+	ProdSlots          int               `db:"prod_slots"`
+	ProdTTLSeconds     *int64            `db:"prod_ttl_seconds"`
+	ProdDeploymentID   *string           `db:"prod_deployment_id"`
+	PreviewDeployments bool              `db:"preview_deployments"`
+	// EnvironmentVariables contains variables for specific environments, keyed by environment name.
+	// They take precedence over ProdVariables, which are shared by all environments.
+	EnvironmentVariables map[string]map[string]string `db:"environment_variables"`
+	Annotations          map[string]string            `db:"annotations"`
+	CreatedOn            time.Time                    `db:"created_on"`
+	UpdatedOn            time.Time                    `db:"updated_on"`
 }
 
 // InsertProjectOptions defines options for inserting a new Project.
@@ -302,6 +339,7 @@ type InsertProjectOptions struct {
 	ProdVersion          string
 	ProdBranch           string
 	ProdVariables        map[string]string
+	EnvironmentVariables map[string]map[string]string
 	ProdOLAPDriver       string
 	ProdOLAPDSN          string
 	ProdSlots            int
@@ -322,6 +360,8 @@ type UpdateProjectOptions struct {
 	ProdDeploymentID     *string
 	ProdSlots            int
 	ProdTTLSeconds       *int64
+	PreviewDeployments   bool
+	EnvironmentVariables map[string]map[string]string
 	Annotations          map[string]string
 }
 
@@ -337,6 +377,7 @@ const (
 
 // Deployment is a single deployment of a git branch.
 // Deployments belong to a project.
+// A project has at most one prod deployment (referenced by Project.ProdDeploymentID) and any number of preview deployments of other branches.
 type Deployment struct {
 	ID                string           `db:"id"`
 	ProjectID         string           `db:"project_id"`
@@ -350,6 +391,11 @@ type Deployment struct {
 	RuntimeAudience   string           `db:"runtime_audience"`
 	Status            DeploymentStatus `db:"status"`
 	StatusMessage     string           `db:"status_message"`
+	Environment       string           `db:"environment"`
+	Preview           bool             `db:"preview"`
+	PullRequestNumber *int             `db:"pull_request_number"`
+	PullRequestURL    string           `db:"pull_request_url"`
+	ClosedOn          *time.Time       `db:"closed_on"`
 	CreatedOn         time.Time        `db:"created_on"`
 	UpdatedOn         time.Time        `db:"updated_on"`
 	UsedOn            time.Time        `db:"used_on"`
@@ -368,6 +414,17 @@ type InsertDeploymentOptions struct {
 	RuntimeAudience   string
 	Status            DeploymentStatus
 	StatusMessage     string
+	Environment       string `validate:"required"`
+	Preview           bool
+	PullRequestNumber *int
+	PullRequestURL    string `validate:"omitempty,http_url"`
+}
+
+// UpdatePreviewDeploymentOptions defines options for updating the pull request of a preview deployment.
+type UpdatePreviewDeploymentOptions struct {
+	PullRequestNumber *int
+	PullRequestURL    string `validate:"omitempty,http_url"`
+	ClosedOn          *time.Time
 }
 
 // RuntimeSlotsUsed is the result of a ResolveRuntimeSlotsUsed query.
@@ -436,9 +493,11 @@ type UpdateServiceOptions struct {
 
 // Usergroup represents a group of org members
 type Usergroup struct {
-	ID    string `db:"id"`
-	OrgID string `db:"org_id"`
-	Name  string `db:"name" validate:"slug"`
+	ID        string    `db:"id"`
+	OrgID     string    `db:"org_id"`
+	Name      string    `db:"name" validate:"slug"`
+	CreatedOn time.Time `db:"created_on"`
+	UpdatedOn time.Time `db:"updated_on"`
 }
 
 // InsertUsergroupOptions defines options for inserting a new usergroup
@@ -447,6 +506,11 @@ type InsertUsergroupOptions struct {
 	Name  string `validate:"slug"`
 }
 
+// UpdateUsergroupOptions defines options for updating an existing usergroup
+type UpdateUsergroupOptions struct {
+	Name string `validate:"slug"`
+}
+
 // UserAuthToken is a persistent API token for a user.
 type UserAuthToken struct {
 	ID                 string
@@ -613,6 +677,15 @@ type Member struct {
 	UpdatedOn   time.Time `db:"updated_on"`
 }
 
+// MemberUsergroup is a convenience type used for display-friendly representation of a usergroup's role on a project.
+type MemberUsergroup struct {
+	ID        string
+	Name      string
+	RoleName  string    `db:"role_name"`
+	CreatedOn time.Time `db:"created_on"`
+	UpdatedOn time.Time `db:"updated_on"`
+}
+
 // OrganizationInvite represents an outstanding invitation to join an org.
 type OrganizationInvite struct {
 	ID              string
@@ -761,3 +834,103 @@ type InsertVirtualFileOptions struct {
 	Path      string `validate:"required"`
 	Data      []byte `validate:"max=8192"` // 8kb
 }
+
+// AuditEvent is an entry in the append-only log of changes to control-plane state.
+// Before and After are only set for actions that record the state of the changed resource.
+type AuditEvent struct {
+	ID          string
+	OrgID       *string        `db:"org_id"`
+	ProjectID   *string        `db:"project_id"`
+	ProjectName string         `db:"project_name"`
+	ActorType   string         `db:"actor_type"`
+	ActorID     string         `db:"actor_id"`
+	ActorName   string         `db:"actor_name"`
+	Action      string         `db:"action"`
+	Payload     map[string]any `db:"payload"`
+	Before      map[string]any `db:"before"`
+	After       map[string]any `db:"after"`
+	CreatedOn   time.Time      `db:"created_on"`
+}
+
+// InsertAuditEventOptions defines options for inserting an AuditEvent
+type InsertAuditEventOptions struct {
+	OrgID       string
+	ProjectID   string
+	ProjectName string
+	ActorType   string `validate:"required"`
+	ActorID     string
+	ActorName   string
+	Action      string `validate:"required"`
+	Payload     map[string]any
+	Before      map[string]any
+	After       map[string]any
+}
+
+// FindAuditEventsOptions defines filters and pagination for finding an org's audit events.
+// Events are returned newest first. Empty fields are not used for filtering.
+type FindAuditEventsOptions struct {
+	OrgID     string `validate:"required"`
+	ProjectID string
+	ActorID   string
+	Action    string
+	// StartTime is inclusive and EndTime is exclusive
+	StartTime time.Time
+	EndTime   time.Time
+	// BeforeCreatedOn and BeforeID is the position of the last event of the previous page
+	BeforeCreatedOn time.Time
+	BeforeID        string
+	Limit           int
+}
+
+// Protocols supported by SSO providers.
+const (
+	SSOProviderTypeOIDC = "oidc"
+	SSOProviderTypeSAML = "saml"
+)
+
+// SSOProvider is an identity provider configured by an org for single sign-on.
+// Users with an email address in one of the provider's verified domains log in through the provider.
+type SSOProvider struct {
+	ID      string
+	OrgID   string   `db:"org_id"`
+	Type    string   `db:"type"`
+	Domains []string `db:"domains"`
+	// VerifiedDomains is the subset of Domains that the org has proven it controls (or that a superuser has approved).
+	VerifiedDomains []string `db:"verified_domains"`
+	// DomainVerificationToken is the value the org must publish in a DNS TXT record to verify its domains.
+	DomainVerificationToken string            `db:"domain_verification_token"`
+	Config                  SSOProviderConfig `db:"config"`
+	// JITOrgRoleID is the role new users are given in the org when they first log in. If nil, users must be invited to the org.
+	JITOrgRoleID   *string   `db:"jit_org_role_id"`
+	JITOrgRoleName string    `db:"jit_org_role_name"`
+	CreatedOn      time.Time `db:"created_on"`
+	UpdatedOn      time.Time `db:"updated_on"`
+}
+
+// SSOProviderConfig contains the protocol-specific configuration of an SSOProvider.
+type SSOProviderConfig struct {
+	// OIDC
+	IssuerURL    string   `json:"issuer_url,omitempty"`
+	ClientID     string   `json:"client_id,omitempty"`
+	ClientSecret string   `json:"client_secret,omitempty"`
+	Scopes       []string `json:"scopes,omitempty"`
+	// SAML
+	IDPMetadataURL string `json:"idp_metadata_url,omitempty"`
+	IDPMetadataXML string `json:"idp_metadata_xml,omitempty"`
+	// Names of the OIDC claims or SAML attributes that contain the user's details.
+	// If empty, the standard claims or attributes are used.
+	EmailClaim  string `json:"email_claim,omitempty"`
+	NameClaim   string `json:"name_claim,omitempty"`
+	GroupsClaim string `json:"groups_claim,omitempty"`
+}
+
+// UpsertSSOProviderOptions defines options for creating or replacing an org's SSOProvider
+type UpsertSSOProviderOptions struct {
+	OrgID                   string   `validate:"required"`
+	Type                    string   `validate:"required,oneof=oidc saml"`
+	Domains                 []string `validate:"required,min=1,dive,required"`
+	VerifiedDomains         []string
+	DomainVerificationToken string `validate:"required"`
+	Config                  SSOProviderConfig
+	JITOrgRoleID            *string
+}
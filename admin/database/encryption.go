@@ -0,0 +1,138 @@
+package database
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+// ErrEncryptionNotConfigured is returned when encrypted data is accessed without a key provider.
+var ErrEncryptionNotConfigured = errors.New("database: encryption is not configured")
+
+// KeyProvider manages the key encryption keys used for envelope encryption of sensitive columns.
+// Sensitive data is encrypted with a data key, which is stored alongside the data after being wrapped (encrypted) with a key encryption key.
+// The interface is compatible with key management services (KMS), which never expose the key encryption keys themselves.
+type KeyProvider interface {
+	// CurrentKeyID returns the ID of the key encryption key that new data keys should be wrapped with.
+	CurrentKeyID() string
+	// WrapKey encrypts a data key with the key encryption key identified by keyID.
+	WrapKey(ctx context.Context, keyID string, dataKey []byte) ([]byte, error)
+	// UnwrapKey decrypts a data key that was wrapped with the key encryption key identified by keyID.
+	UnwrapKey(ctx context.Context, keyID string, wrappedKey []byte) ([]byte, error)
+}
+
+// keyfile is the format of a file used with NewKeyfileProvider.
+type keyfile struct {
+	Keys []struct {
+		ID     string `json:"id"`
+		Secret string `json:"secret"`
+	} `json:"keys"`
+}
+
+// keyfileProvider is a KeyProvider that uses AES-256 key encryption keys loaded from a local file.
+type keyfileProvider struct {
+	currentKeyID string
+	keys         map[string]cipher.AEAD
+}
+
+// NewKeyfileProvider creates a KeyProvider from a JSON file in the format {"keys": [{"id": "...", "secret": "..."}]},
+// where each secret is a base64 encoded 32 byte key (e.g. generated with `openssl rand -base64 32`).
+// The last key in the file is used to wrap new data keys. Previous keys are only used for unwrapping,
+// so they can be removed after all data keys have been re-wrapped with the new key.
+func NewKeyfileProvider(path string) (KeyProvider, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read keyfile: %w", err)
+	}
+
+	var kf keyfile
+	err = json.Unmarshal(data, &kf)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse keyfile: %w", err)
+	}
+	if len(kf.Keys) == 0 {
+		return nil, fmt.Errorf("keyfile %q does not contain any keys", path)
+	}
+
+	p := &keyfileProvider{keys: make(map[string]cipher.AEAD, len(kf.Keys))}
+	for _, k := range kf.Keys {
+		if k.ID == "" {
+			return nil, fmt.Errorf("keyfile %q contains a key without an ID", path)
+		}
+		if _, ok := p.keys[k.ID]; ok {
+			return nil, fmt.Errorf("keyfile %q contains duplicate key ID %q", path, k.ID)
+		}
+
+		secret, err := base64.StdEncoding.DecodeString(k.Secret)
+		if err != nil {
+			return nil, fmt.Errorf("key %q is not valid base64: %w", k.ID, err)
+		}
+		if len(secret) != 32 {
+			return nil, fmt.Errorf("key %q must be 32 bytes, got %d", k.ID, len(secret))
+		}
+
+		aead, err := NewAEAD(secret)
+		if err != nil {
+			return nil, err
+		}
+
+		p.keys[k.ID] = aead
+		p.currentKeyID = k.ID
+	}
+
+	return p, nil
+}
+
+func (p *keyfileProvider) CurrentKeyID() string {
+	return p.currentKeyID
+}
+
+func (p *keyfileProvider) WrapKey(ctx context.Context, keyID string, dataKey []byte) ([]byte, error) {
+	aead, ok := p.keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("key %q not found in keyfile", keyID)
+	}
+	return Encrypt(aead, dataKey)
+}
+
+func (p *keyfileProvider) UnwrapKey(ctx context.Context, keyID string, wrappedKey []byte) ([]byte, error) {
+	aead, ok := p.keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("key %q not found in keyfile", keyID)
+	}
+	return Decrypt(aead, wrappedKey)
+}
+
+// NewAEAD returns an AES-GCM cipher for a 32 byte key.
+func NewAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// Encrypt encrypts plaintext with a random nonce, which is prepended to the result.
+func Encrypt(aead cipher.AEAD, plaintext []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+// Decrypt decrypts a ciphertext created with Encrypt.
+func Decrypt(aead cipher.AEAD, ciphertext []byte) ([]byte, error) {
+	if len(ciphertext) < aead.NonceSize() {
+		return nil, errors.New("ciphertext too short")
+	}
+	nonce, ciphertext := ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():]
+	return aead.Open(nil, nonce, ciphertext, nil)
+}
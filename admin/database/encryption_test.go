@@ -0,0 +1,55 @@
+package database
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyfileProvider(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := NewKeyfileProvider(writeKeyfile(t, `{"keys": []}`))
+	require.ErrorContains(t, err, "does not contain any keys")
+
+	_, err = NewKeyfileProvider(writeKeyfile(t, `{"keys": [{"id": "a", "secret": "c2hvcnQ="}]}`))
+	require.ErrorContains(t, err, "must be 32 bytes")
+
+	_, err = NewKeyfileProvider(writeKeyfile(t, `{"keys": [{"id": "a", "secret": "MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY="}, {"id": "a", "secret": "MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY="}]}`))
+	require.ErrorContains(t, err, "duplicate key ID")
+
+	p, err := NewKeyfileProvider(writeKeyfile(t, `{"keys": [
+		{"id": "old", "secret": "MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY="},
+		{"id": "new", "secret": "ZmVkY2JhOTg3NjU0MzIxMGZlZGNiYTk4NzY1NDMyMTA="}
+	]}`))
+	require.NoError(t, err)
+	require.Equal(t, "new", p.CurrentKeyID())
+
+	dataKey := []byte("0123456789abcdef0123456789abcdef")
+	for _, keyID := range []string{"old", "new"} {
+		wrapped, err := p.WrapKey(ctx, keyID, dataKey)
+		require.NoError(t, err)
+		require.NotContains(t, string(wrapped), string(dataKey))
+
+		unwrapped, err := p.UnwrapKey(ctx, keyID, wrapped)
+		require.NoError(t, err)
+		require.Equal(t, dataKey, unwrapped)
+	}
+
+	// Data keys can only be unwrapped with the key they were wrapped with
+	wrapped, err := p.WrapKey(ctx, "old", dataKey)
+	require.NoError(t, err)
+	_, err = p.UnwrapKey(ctx, "new", wrapped)
+	require.Error(t, err)
+	_, err = p.UnwrapKey(ctx, "missing", wrapped)
+	require.ErrorContains(t, err, "not found")
+}
+
+func writeKeyfile(t *testing.T, contents string) string {
+	path := filepath.Join(t.TempDir(), "keyfile.json")
+	require.NoError(t, os.WriteFile(path, []byte(contents), 0o600))
+	return path
+}
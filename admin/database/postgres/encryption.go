@@ -0,0 +1,323 @@
+package postgres
+
+import (
+	"context"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"io"
+
+	"github.com/rilldata/rill/admin/database"
+)
+
+// dataKeyCacheSize is the number of unwrapped data keys to keep in memory.
+// Unwrapping a data key may call an external key management service, so we avoid doing it on every read.
+const dataKeyCacheSize = 1000
+
+// rotateEncryptionKeysBatchSize is the number of rows to look up at a time in RotateEncryptionKeys.
+const rotateEncryptionKeysBatchSize = 100
+
+// dataKey is a per-row key used to encrypt sensitive columns (envelope encryption).
+// The row stores the data key wrapped with a key encryption key from the database.KeyProvider.
+type dataKey struct {
+	keyID   string
+	wrapped []byte
+	raw     []byte
+	aead    cipher.AEAD
+}
+
+// newDataKey generates a new data key and wraps it with the key provider's current key.
+// It returns nil if encryption is not configured, in which case data should be stored unencrypted.
+func (c *connection) newDataKey(ctx context.Context) (*dataKey, error) {
+	if c.keys == nil {
+		return nil, nil
+	}
+
+	raw := make([]byte, 32)
+	if _, err := io.ReadFull(rand.Reader, raw); err != nil {
+		return nil, err
+	}
+
+	keyID := c.keys.CurrentKeyID()
+	wrapped, err := c.keys.WrapKey(ctx, keyID, raw)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.cacheDataKey(keyID, wrapped, raw)
+}
+
+// unwrapDataKey returns the data key of a row. It returns nil for rows that are not encrypted.
+func (c *connection) unwrapDataKey(ctx context.Context, keyID string, wrapped []byte) (*dataKey, error) {
+	if keyID == "" {
+		return nil, nil
+	}
+	if c.keys == nil {
+		return nil, database.ErrEncryptionNotConfigured
+	}
+
+	c.dataKeysMu.Lock()
+	val, ok := c.dataKeys.Get(keyID + ":" + string(wrapped))
+	c.dataKeysMu.Unlock()
+	if ok {
+		return val.(*dataKey), nil
+	}
+
+	raw, err := c.keys.UnwrapKey(ctx, keyID, wrapped)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.cacheDataKey(keyID, wrapped, raw)
+}
+
+func (c *connection) cacheDataKey(keyID string, wrapped, raw []byte) (*dataKey, error) {
+	aead, err := database.NewAEAD(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	k := &dataKey{keyID: keyID, wrapped: wrapped, raw: raw, aead: aead}
+	c.dataKeysMu.Lock()
+	c.dataKeys.Add(keyID+":"+string(wrapped), k)
+	c.dataKeysMu.Unlock()
+	return k, nil
+}
+
+// id returns the ID of the key encryption key that the data key is wrapped with, or an empty string if k is nil.
+func (k *dataKey) id() string {
+	if k == nil {
+		return ""
+	}
+	return k.keyID
+}
+
+// wrappedKey returns the wrapped data key to store alongside the encrypted data, or nil if k is nil.
+func (k *dataKey) wrappedKey() []byte {
+	if k == nil {
+		return nil
+	}
+	return k.wrapped
+}
+
+// encrypt encrypts a value. If k is nil, the value is returned as is.
+func (k *dataKey) encrypt(val string) (string, error) {
+	if k == nil {
+		return val, nil
+	}
+	res, err := database.Encrypt(k.aead, []byte(val))
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(res), nil
+}
+
+// decrypt decrypts a value encrypted with encrypt. If k is nil, the value is returned as is.
+func (k *dataKey) decrypt(val string) (string, error) {
+	if k == nil {
+		return val, nil
+	}
+	data, err := base64.StdEncoding.DecodeString(val)
+	if err != nil {
+		return "", err
+	}
+	res, err := database.Decrypt(k.aead, data)
+	if err != nil {
+		return "", err
+	}
+	return string(res), nil
+}
+
+// encryptMap encrypts the values of a map. The keys are left unencrypted.
+func (k *dataKey) encryptMap(m map[string]string) (map[string]string, error) {
+	if k == nil || m == nil {
+		return m, nil
+	}
+	res := make(map[string]string, len(m))
+	for key, val := range m {
+		enc, err := k.encrypt(val)
+		if err != nil {
+			return nil, err
+		}
+		res[key] = enc
+	}
+	return res, nil
+}
+
+// decryptMap decrypts the values of a map encrypted with encryptMap.
+func (k *dataKey) decryptMap(m map[string]string) (map[string]string, error) {
+	if k == nil || m == nil {
+		return m, nil
+	}
+	res := make(map[string]string, len(m))
+	for key, val := range m {
+		dec, err := k.decrypt(val)
+		if err != nil {
+			return nil, err
+		}
+		res[key] = dec
+	}
+	return res, nil
+}
+
+// encryptProject encrypts the sensitive fields of a project.
+func (k *dataKey) encryptProject(prodVariables map[string]string, envVariables map[string]map[string]string, prodOLAPDSN string) (map[string]string, map[string]map[string]string, string, error) {
+	prodVariables, err := k.encryptMap(prodVariables)
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	var encEnvVariables map[string]map[string]string
+	if envVariables != nil {
+		encEnvVariables = make(map[string]map[string]string, len(envVariables))
+		for env, vars := range envVariables {
+			encEnvVariables[env], err = k.encryptMap(vars)
+			if err != nil {
+				return nil, nil, "", err
+			}
+		}
+	}
+
+	prodOLAPDSN, err = k.encrypt(prodOLAPDSN)
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	return prodVariables, encEnvVariables, prodOLAPDSN, nil
+}
+
+// decryptProject decrypts the sensitive fields of a project in place.
+func (k *dataKey) decryptProject(p *database.Project) error {
+	var err error
+	p.ProdVariables, err = k.decryptMap(p.ProdVariables)
+	if err != nil {
+		return err
+	}
+
+	for env, vars := range p.EnvironmentVariables {
+		p.EnvironmentVariables[env], err = k.decryptMap(vars)
+		if err != nil {
+			return err
+		}
+	}
+
+	p.ProdOLAPDSN, err = k.decrypt(p.ProdOLAPDSN)
+	return err
+}
+
+// findProjectDataKey returns the data key of a project, or nil if the project is not encrypted.
+func (c *connection) findProjectDataKey(ctx context.Context, id string) (*dataKey, string, error) {
+	var res struct {
+		KeyID string `db:"encryption_key_id"`
+		Key   []byte `db:"encryption_key"`
+	}
+	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT encryption_key_id, encryption_key FROM projects WHERE id=$1", id).StructScan(&res)
+	if err != nil {
+		return nil, "", parseErr("project", err)
+	}
+
+	k, err := c.unwrapDataKey(ctx, res.KeyID, res.Key)
+	if err != nil {
+		return nil, "", err
+	}
+	return k, res.KeyID, nil
+}
+
+func (c *connection) RotateEncryptionKeys(ctx context.Context) (int, error) {
+	if c.keys == nil {
+		return 0, database.ErrEncryptionNotConfigured
+	}
+	currentKeyID := c.keys.CurrentKeyID()
+
+	n := 0
+	afterID := ""
+	for {
+		var ids []string
+		err := c.getDB(ctx).SelectContext(ctx, &ids, "SELECT id FROM projects WHERE encryption_key_id <> $1 AND id::TEXT > $2 ORDER BY id::TEXT LIMIT $3", currentKeyID, afterID, rotateEncryptionKeysBatchSize)
+		if err != nil {
+			return n, parseErr("projects", err)
+		}
+
+		for _, id := range ids {
+			updated, err := c.rotateProjectEncryptionKey(ctx, id)
+			if err != nil {
+				return n, err
+			}
+			if updated {
+				n++
+			}
+		}
+
+		if len(ids) < rotateEncryptionKeysBatchSize {
+			return n, nil
+		}
+		afterID = ids[len(ids)-1]
+	}
+}
+
+// rotateProjectEncryptionKey re-wraps a project's data key with the current key, or encrypts the project if it's not encrypted yet.
+func (c *connection) rotateProjectEncryptionKey(ctx context.Context, id string) (bool, error) {
+	txCtx, tx, err := c.NewTx(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	dto := &projectDTO{}
+	err = c.getDB(txCtx).QueryRowxContext(txCtx, "SELECT * FROM projects WHERE id=$1 FOR UPDATE", id).StructScan(dto)
+	if err != nil {
+		return false, parseErr("project", err)
+	}
+
+	currentKeyID := c.keys.CurrentKeyID()
+	if dto.EncryptionKeyID == currentKeyID {
+		return false, nil
+	}
+
+	if dto.EncryptionKeyID != "" {
+		// The data is already encrypted, so we only need to re-wrap the data key
+		k, err := c.unwrapDataKey(txCtx, dto.EncryptionKeyID, dto.EncryptionKey)
+		if err != nil {
+			return false, err
+		}
+		wrapped, err := c.keys.WrapKey(txCtx, currentKeyID, k.raw)
+		if err != nil {
+			return false, err
+		}
+
+		_, err = c.getDB(txCtx).ExecContext(txCtx, "UPDATE projects SET encryption_key_id=$1, encryption_key=$2 WHERE id=$3", currentKeyID, wrapped, id)
+		if err != nil {
+			return false, parseErr("project", err)
+		}
+	} else {
+		// Encrypt a project that was stored before encryption was configured
+		proj, err := c.projectFromDTO(txCtx, dto)
+		if err != nil {
+			return false, err
+		}
+
+		k, err := c.newDataKey(txCtx)
+		if err != nil {
+			return false, err
+		}
+		prodVariables, envVariables, prodOLAPDSN, err := k.encryptProject(proj.ProdVariables, proj.EnvironmentVariables, proj.ProdOLAPDSN)
+		if err != nil {
+			return false, err
+		}
+
+		_, err = c.getDB(txCtx).ExecContext(txCtx, `
+			UPDATE projects SET prod_variables=$1, environment_variables=$2, prod_olap_dsn=$3, encryption_key_id=$4, encryption_key=$5
+			WHERE id=$6`,
+			prodVariables, envVariables, prodOLAPDSN, k.id(), k.wrappedKey(), id,
+		)
+		if err != nil {
+			return false, parseErr("project", err)
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
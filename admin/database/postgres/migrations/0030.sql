@@ -0,0 +1,27 @@
+-- Append-only log of changes to control-plane state.
+-- Org and project IDs intentionally have no foreign keys so events outlive the resources they refer to.
+CREATE TABLE audit_events (
+    id UUID DEFAULT uuid_generate_v4() PRIMARY KEY,
+    org_id UUID,
+    project_id UUID,
+    project_name TEXT NOT NULL DEFAULT '',
+    actor_type TEXT NOT NULL,
+    actor_id TEXT NOT NULL DEFAULT '',
+    actor_name TEXT NOT NULL DEFAULT '',
+    action TEXT NOT NULL,
+    payload JSONB DEFAULT '{}'::JSONB NOT NULL,
+    before JSONB,
+    after JSONB,
+    created_on TIMESTAMPTZ DEFAULT now() NOT NULL
+);
+
+CREATE INDEX audit_events_org_id_created_on_idx ON audit_events (org_id, created_on DESC);
+
+CREATE FUNCTION audit_events_append_only() RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_events is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER audit_events_append_only BEFORE UPDATE OR DELETE ON audit_events
+    FOR EACH ROW EXECUTE FUNCTION audit_events_append_only();
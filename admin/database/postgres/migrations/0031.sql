@@ -0,0 +1,16 @@
+-- SSO identity providers configured by orgs.
+-- Users with an email in one of the domains are routed to the provider when logging in.
+CREATE TABLE sso_providers (
+    id UUID DEFAULT uuid_generate_v4() PRIMARY KEY,
+    org_id UUID NOT NULL REFERENCES orgs (id) ON DELETE CASCADE,
+    type TEXT NOT NULL,
+    domains TEXT[] NOT NULL,
+    config JSONB DEFAULT '{}'::JSONB NOT NULL,
+    jit_org_role_id UUID REFERENCES org_roles (id) ON DELETE SET NULL,
+    created_on TIMESTAMPTZ DEFAULT now() NOT NULL,
+    updated_on TIMESTAMPTZ DEFAULT now() NOT NULL
+);
+
+CREATE UNIQUE INDEX sso_providers_org_id_idx ON sso_providers (org_id);
+
+CREATE INDEX sso_providers_domains_idx ON sso_providers USING GIN (domains);
@@ -0,0 +1,10 @@
+-- Preview deployments of non-prod branches, e.g. for pull requests.
+ALTER TABLE projects ADD COLUMN preview_deployments BOOLEAN DEFAULT false NOT NULL;
+ALTER TABLE projects ADD COLUMN preview_variables JSONB DEFAULT '{}'::JSONB NOT NULL;
+
+ALTER TABLE deployments ADD COLUMN preview BOOLEAN DEFAULT false NOT NULL;
+ALTER TABLE deployments ADD COLUMN pull_request_number INTEGER;
+ALTER TABLE deployments ADD COLUMN pull_request_url TEXT DEFAULT '' NOT NULL;
+ALTER TABLE deployments ADD COLUMN closed_on TIMESTAMPTZ;
+
+CREATE UNIQUE INDEX deployments_project_id_preview_branch_idx ON deployments (project_id, branch) WHERE preview;
@@ -0,0 +1,12 @@
+-- Variables scoped to a named environment, e.g. "dev", "prod" or "staging".
+-- They take precedence over prod_variables, which now only contains variables that are explicitly shared by all environments.
+ALTER TABLE projects ADD COLUMN environment_variables JSONB DEFAULT '{}'::JSONB NOT NULL;
+UPDATE projects SET environment_variables = jsonb_build_object('preview', preview_variables) WHERE preview_variables <> '{}'::JSONB;
+ALTER TABLE projects DROP COLUMN preview_variables;
+
+-- Existing prod variables were only meant for prod deployments, so they're moved to the prod environment (instead of being shared with all environments).
+UPDATE projects SET environment_variables = environment_variables || jsonb_build_object('prod', prod_variables), prod_variables = '{}'::JSONB WHERE prod_variables <> '{}'::JSONB;
+
+-- The environment that a deployment's runtime instance is configured for.
+ALTER TABLE deployments ADD COLUMN environment TEXT DEFAULT 'prod' NOT NULL;
+UPDATE deployments SET environment = 'preview' WHERE preview;
@@ -0,0 +1,5 @@
+-- Envelope encryption of sensitive project columns (prod_variables, environment_variables and prod_olap_dsn).
+-- The data key is stored wrapped with the key encryption key identified by encryption_key_id.
+-- An empty encryption_key_id means the project's sensitive columns are not encrypted.
+ALTER TABLE projects ADD COLUMN encryption_key_id TEXT DEFAULT '' NOT NULL;
+ALTER TABLE projects ADD COLUMN encryption_key BYTEA;
@@ -0,0 +1,8 @@
+-- Users provisioned by an org's SCIM client.
+-- Used to scope SCIM updates to users that the org manages, including users it has deactivated (removed from the org).
+CREATE TABLE scim_users (
+    org_id UUID NOT NULL REFERENCES orgs (id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    created_on TIMESTAMPTZ DEFAULT now() NOT NULL,
+    PRIMARY KEY (org_id, user_id)
+);
@@ -0,0 +1,9 @@
+-- Domains are only routed to an org's SSO provider once the org has proven that it controls them.
+-- A domain is verified through a DNS TXT record containing the provider's domain_verification_token or by a superuser.
+-- Existing providers must re-verify their domains.
+ALTER TABLE sso_providers ADD COLUMN verified_domains TEXT[] DEFAULT '{}' NOT NULL;
+ALTER TABLE sso_providers ADD COLUMN domain_verification_token TEXT DEFAULT '' NOT NULL;
+UPDATE sso_providers SET domain_verification_token = md5(random()::TEXT || id::TEXT);
+
+DROP INDEX sso_providers_domains_idx;
+CREATE INDEX sso_providers_verified_domains_idx ON sso_providers USING GIN (verified_domains);
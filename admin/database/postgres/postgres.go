@@ -3,11 +3,15 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/XSAM/otelsql"
+	"github.com/hashicorp/golang-lru/simplelru"
 	"github.com/jackc/pgconn"
 	"github.com/jackc/pgtype"
 	"github.com/jmoiron/sqlx"
@@ -24,7 +28,7 @@ func init() {
 
 type driver struct{}
 
-func (d driver) Open(dsn string) (database.DB, error) {
+func (d driver) Open(dsn string, keys database.KeyProvider) (database.DB, error) {
 	db, err := otelsql.Open("pgx", dsn)
 	if err != nil {
 		return nil, err
@@ -35,12 +39,20 @@ func (d driver) Open(dsn string) (database.DB, error) {
 		return nil, err
 	}
 
+	dataKeys, err := simplelru.NewLRU(dataKeyCacheSize, nil)
+	if err != nil {
+		return nil, err
+	}
+
 	dbx := sqlx.NewDb(db, "pgx")
-	return &connection{db: dbx}, nil
+	return &connection{db: dbx, keys: keys, dataKeys: dataKeys}, nil
 }
 
 type connection struct {
-	db *sqlx.DB
+	db         *sqlx.DB
+	keys       database.KeyProvider
+	dataKeys   *simplelru.LRU
+	dataKeysMu sync.Mutex
 }
 
 func (c *connection) Close() error {
@@ -208,7 +220,7 @@ func (c *connection) FindProjects(ctx context.Context, afterName string, limit i
 	if err != nil {
 		return nil, parseErr("projects", err)
 	}
-	return projectsFromDTOs(res)
+	return c.projectsFromDTOs(ctx, res)
 }
 
 func (c *connection) FindProjectsByVersion(ctx context.Context, version, afterName string, limit int) ([]*database.Project, error) {
@@ -217,7 +229,7 @@ func (c *connection) FindProjectsByVersion(ctx context.Context, version, afterNa
 	if err != nil {
 		return nil, parseErr("projects", err)
 	}
-	return projectsFromDTOs(res)
+	return c.projectsFromDTOs(ctx, res)
 }
 
 func (c *connection) FindProjectPathsByPattern(ctx context.Context, namePattern, afterName string, limit int) ([]string, error) {
@@ -264,7 +276,7 @@ func (c *connection) FindProjectsForUser(ctx context.Context, userID string) ([]
 	if err != nil {
 		return nil, parseErr("projects", err)
 	}
-	return projectsFromDTOs(res)
+	return c.projectsFromDTOs(ctx, res)
 }
 
 func (c *connection) FindProjectsForOrganization(ctx context.Context, orgID, afterProjectName string, limit int) ([]*database.Project, error) {
@@ -277,7 +289,7 @@ func (c *connection) FindProjectsForOrganization(ctx context.Context, orgID, aft
 	if err != nil {
 		return nil, parseErr("projects", err)
 	}
-	return projectsFromDTOs(res)
+	return c.projectsFromDTOs(ctx, res)
 }
 
 func (c *connection) FindProjectsForOrgAndUser(ctx context.Context, orgID, userID, afterProjectName string, limit int) ([]*database.Project, error) {
@@ -293,7 +305,7 @@ func (c *connection) FindProjectsForOrgAndUser(ctx context.Context, orgID, userI
 	if err != nil {
 		return nil, parseErr("projects", err)
 	}
-	return projectsFromDTOs(res)
+	return c.projectsFromDTOs(ctx, res)
 }
 
 func (c *connection) FindPublicProjectsInOrganization(ctx context.Context, orgID, afterProjectName string, limit int) ([]*database.Project, error) {
@@ -306,7 +318,7 @@ func (c *connection) FindPublicProjectsInOrganization(ctx context.Context, orgID
 	if err != nil {
 		return nil, parseErr("projects", err)
 	}
-	return projectsFromDTOs(res)
+	return c.projectsFromDTOs(ctx, res)
 }
 
 func (c *connection) FindProjectsByGithubURL(ctx context.Context, githubURL string) ([]*database.Project, error) {
@@ -315,7 +327,7 @@ func (c *connection) FindProjectsByGithubURL(ctx context.Context, githubURL stri
 	if err != nil {
 		return nil, parseErr("projects", err)
 	}
-	return projectsFromDTOs(res)
+	return c.projectsFromDTOs(ctx, res)
 }
 
 func (c *connection) FindProjectsByGithubInstallationID(ctx context.Context, id int64) ([]*database.Project, error) {
@@ -324,7 +336,7 @@ func (c *connection) FindProjectsByGithubInstallationID(ctx context.Context, id
 	if err != nil {
 		return nil, parseErr("projects", err)
 	}
-	return projectsFromDTOs(res)
+	return c.projectsFromDTOs(ctx, res)
 }
 
 func (c *connection) FindProject(ctx context.Context, id string) (*database.Project, error) {
@@ -333,7 +345,7 @@ func (c *connection) FindProject(ctx context.Context, id string) (*database.Proj
 	if err != nil {
 		return nil, parseErr("project", err)
 	}
-	return res.AsProject()
+	return c.projectFromDTO(ctx, res)
 }
 
 func (c *connection) FindProjectByName(ctx context.Context, orgName, name string) (*database.Project, error) {
@@ -342,7 +354,7 @@ func (c *connection) FindProjectByName(ctx context.Context, orgName, name string
 	if err != nil {
 		return nil, parseErr("project", err)
 	}
-	return res.AsProject()
+	return c.projectFromDTO(ctx, res)
 }
 
 func (c *connection) InsertProject(ctx context.Context, opts *database.InsertProjectOptions) (*database.Project, error) {
@@ -350,16 +362,29 @@ func (c *connection) InsertProject(ctx context.Context, opts *database.InsertPro
 		return nil, err
 	}
 
+	k, err := c.newDataKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+	envVariables := opts.EnvironmentVariables
+	if envVariables == nil {
+		envVariables = make(map[string]map[string]string, 0)
+	}
+	prodVariables, envVariables, prodOLAPDSN, err := k.encryptProject(opts.ProdVariables, envVariables, opts.ProdOLAPDSN)
+	if err != nil {
+		return nil, err
+	}
+
 	res := &projectDTO{}
-	err := c.getDB(ctx).QueryRowxContext(ctx, `
-		INSERT INTO projects (org_id, name, description, public, created_by_user_id, provisioner, prod_olap_driver, prod_olap_dsn, prod_slots, subpath, prod_branch, prod_variables, github_url, github_installation_id, prod_ttl_seconds, prod_version)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16) RETURNING *`,
-		opts.OrganizationID, opts.Name, opts.Description, opts.Public, opts.CreatedByUserID, opts.Provisioner, opts.ProdOLAPDriver, opts.ProdOLAPDSN, opts.ProdSlots, opts.Subpath, opts.ProdBranch, opts.ProdVariables, opts.GithubURL, opts.GithubInstallationID, opts.ProdTTLSeconds, opts.ProdVersion,
+	err = c.getDB(ctx).QueryRowxContext(ctx, `
+		INSERT INTO projects (org_id, name, description, public, created_by_user_id, provisioner, prod_olap_driver, prod_olap_dsn, prod_slots, subpath, prod_branch, prod_variables, environment_variables, github_url, github_installation_id, prod_ttl_seconds, prod_version, encryption_key_id, encryption_key)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19) RETURNING *`,
+		opts.OrganizationID, opts.Name, opts.Description, opts.Public, opts.CreatedByUserID, opts.Provisioner, opts.ProdOLAPDriver, prodOLAPDSN, opts.ProdSlots, opts.Subpath, opts.ProdBranch, prodVariables, envVariables, opts.GithubURL, opts.GithubInstallationID, opts.ProdTTLSeconds, opts.ProdVersion, k.id(), k.wrappedKey(),
 	).StructScan(res)
 	if err != nil {
 		return nil, parseErr("project", err)
 	}
-	return res.AsProject()
+	return c.projectFromDTO(ctx, res)
 }
 
 func (c *connection) DeleteProject(ctx context.Context, id string) error {
@@ -374,17 +399,36 @@ func (c *connection) UpdateProject(ctx context.Context, id string, opts *databas
 	if opts.Annotations == nil {
 		opts.Annotations = make(map[string]string, 0)
 	}
+	if opts.EnvironmentVariables == nil {
+		opts.EnvironmentVariables = make(map[string]map[string]string, 0)
+	}
 
-	res := &projectDTO{}
-	err := c.getDB(ctx).QueryRowxContext(ctx, `
-		UPDATE projects SET name=$1, description=$2, public=$3, prod_branch=$4, prod_variables=$5, github_url=$6, github_installation_id=$7, prod_deployment_id=$8, provisioner=$9, prod_slots=$10, prod_ttl_seconds=$11, annotations=$12, prod_version=$13, updated_on=now()
-		WHERE id=$14 RETURNING *`,
-		opts.Name, opts.Description, opts.Public, opts.ProdBranch, opts.ProdVariables, opts.GithubURL, opts.GithubInstallationID, opts.ProdDeploymentID, opts.Provisioner, opts.ProdSlots, opts.ProdTTLSeconds, opts.Annotations, opts.ProdVersion, id,
-	).StructScan(res)
-	if err != nil {
-		return nil, parseErr("project", err)
+	// The variables must be encrypted with the project's existing data key since it's also used for columns not updated here.
+	// If the data key is concurrently changed by RotateEncryptionKeys, the update doesn't match any rows and we retry once.
+	for attempt := 0; ; attempt++ {
+		k, keyID, err := c.findProjectDataKey(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		prodVariables, envVariables, _, err := k.encryptProject(opts.ProdVariables, opts.EnvironmentVariables, "")
+		if err != nil {
+			return nil, err
+		}
+
+		res := &projectDTO{}
+		err = c.getDB(ctx).QueryRowxContext(ctx, `
+			UPDATE projects SET name=$1, description=$2, public=$3, prod_branch=$4, prod_variables=$5, github_url=$6, github_installation_id=$7, prod_deployment_id=$8, provisioner=$9, prod_slots=$10, prod_ttl_seconds=$11, annotations=$12, prod_version=$13, preview_deployments=$14, environment_variables=$15, updated_on=now()
+			WHERE id=$16 AND encryption_key_id=$17 RETURNING *`,
+			opts.Name, opts.Description, opts.Public, opts.ProdBranch, prodVariables, opts.GithubURL, opts.GithubInstallationID, opts.ProdDeploymentID, opts.Provisioner, opts.ProdSlots, opts.ProdTTLSeconds, opts.Annotations, opts.ProdVersion, opts.PreviewDeployments, envVariables, id, keyID,
+		).StructScan(res)
+		if errors.Is(err, sql.ErrNoRows) && attempt == 0 {
+			continue
+		}
+		if err != nil {
+			return nil, parseErr("project", err)
+		}
+		return c.projectFromDTO(ctx, res)
 	}
-	return res.AsProject()
 }
 
 func (c *connection) CountProjectsForOrganization(ctx context.Context, orgID string) (int, error) {
@@ -441,13 +485,13 @@ func (c *connection) DeleteProjectWhitelistedDomain(ctx context.Context, id stri
 	return checkDeleteRow("project whitelist domain", res, err)
 }
 
-// FindExpiredDeployments returns all the deployments which are expired as per prod ttl
+// FindExpiredDeployments returns all the prod deployments which are expired as per prod ttl
 func (c *connection) FindExpiredDeployments(ctx context.Context) ([]*database.Deployment, error) {
 	var res []*database.Deployment
 	err := c.getDB(ctx).SelectContext(ctx, &res, `
 		SELECT d.* FROM deployments d
 		JOIN projects p ON d.project_id = p.id
-		WHERE p.prod_ttl_seconds IS NOT NULL AND d.used_on + p.prod_ttl_seconds * interval '1 second' < now()
+		WHERE NOT d.preview AND p.prod_ttl_seconds IS NOT NULL AND d.used_on + p.prod_ttl_seconds * interval '1 second' < now()
 	`)
 	if err != nil {
 		return nil, parseErr("deployments", err)
@@ -489,9 +533,9 @@ func (c *connection) InsertDeployment(ctx context.Context, opts *database.Insert
 
 	res := &database.Deployment{}
 	err := c.getDB(ctx).QueryRowxContext(ctx, `
-		INSERT INTO deployments (project_id, provisioner, provision_id, slots, branch, runtime_host, runtime_instance_id, runtime_audience, runtime_version, status, status_message)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11) RETURNING *`,
-		opts.ProjectID, opts.Provisioner, opts.ProvisionID, opts.Slots, opts.Branch, opts.RuntimeHost, opts.RuntimeInstanceID, opts.RuntimeAudience, opts.RuntimeVersion, opts.Status, opts.StatusMessage,
+		INSERT INTO deployments (project_id, provisioner, provision_id, slots, branch, runtime_host, runtime_instance_id, runtime_audience, runtime_version, status, status_message, environment, preview, pull_request_number, pull_request_url)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15) RETURNING *`,
+		opts.ProjectID, opts.Provisioner, opts.ProvisionID, opts.Slots, opts.Branch, opts.RuntimeHost, opts.RuntimeInstanceID, opts.RuntimeAudience, opts.RuntimeVersion, opts.Status, opts.StatusMessage, opts.Environment, opts.Preview, opts.PullRequestNumber, opts.PullRequestURL,
 	).StructScan(res)
 	if err != nil {
 		return nil, parseErr("deployment", err)
@@ -539,6 +583,54 @@ func (c *connection) UpdateDeploymentBranch(ctx context.Context, id, branch stri
 	return res, nil
 }
 
+func (c *connection) FindPreviewDeploymentsForProject(ctx context.Context, projectID string) ([]*database.Deployment, error) {
+	var res []*database.Deployment
+	err := c.getDB(ctx).SelectContext(ctx, &res, "SELECT * FROM deployments d WHERE d.project_id=$1 AND d.preview ORDER BY d.branch", projectID)
+	if err != nil {
+		return nil, parseErr("deployments", err)
+	}
+	return res, nil
+}
+
+func (c *connection) FindPreviewDeploymentForBranch(ctx context.Context, projectID, branch string) (*database.Deployment, error) {
+	res := &database.Deployment{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT * FROM deployments d WHERE d.project_id=$1 AND d.preview AND d.branch=$2", projectID, branch).StructScan(res)
+	if err != nil {
+		return nil, parseErr("deployment", err)
+	}
+	return res, nil
+}
+
+// FindExpiredPreviewDeployments returns the preview deployments which have been closed or have not been used for the given duration.
+func (c *connection) FindExpiredPreviewDeployments(ctx context.Context, unusedFor time.Duration) ([]*database.Deployment, error) {
+	var res []*database.Deployment
+	err := c.getDB(ctx).SelectContext(ctx, &res, `
+		SELECT d.* FROM deployments d
+		WHERE d.preview AND (d.closed_on IS NOT NULL OR d.used_on < now() - $1 * interval '1 second')
+	`, unusedFor.Seconds())
+	if err != nil {
+		return nil, parseErr("deployments", err)
+	}
+	return res, nil
+}
+
+func (c *connection) UpdatePreviewDeployment(ctx context.Context, id string, opts *database.UpdatePreviewDeploymentOptions) (*database.Deployment, error) {
+	if err := database.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	res := &database.Deployment{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, `
+		UPDATE deployments SET pull_request_number=$1, pull_request_url=$2, closed_on=$3, updated_on=now()
+		WHERE id=$4 AND preview RETURNING *`,
+		opts.PullRequestNumber, opts.PullRequestURL, opts.ClosedOn, id,
+	).StructScan(res)
+	if err != nil {
+		return nil, parseErr("deployment", err)
+	}
+	return res, nil
+}
+
 func (c *connection) CountDeploymentsForOrganization(ctx context.Context, orgID string) (*database.DeploymentsCount, error) {
 	res := &database.DeploymentsCount{}
 	err := c.getDB(ctx).QueryRowxContext(ctx, `
@@ -688,6 +780,57 @@ func (c *connection) CheckUserIsAProjectMember(ctx context.Context, userID, proj
 	return res, nil
 }
 
+func (c *connection) CheckUserIsSCIMProvisioned(ctx context.Context, userID, orgID string) (bool, error) {
+	var res bool
+	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT EXISTS (SELECT 1 FROM scim_users WHERE user_id=$1 AND org_id=$2)", userID, orgID).Scan(&res)
+	if err != nil {
+		return false, parseErr("check", err)
+	}
+	return res, nil
+}
+
+func (c *connection) InsertSCIMProvisionedUser(ctx context.Context, orgID, userID string) error {
+	_, err := c.getDB(ctx).ExecContext(ctx, "INSERT INTO scim_users (org_id, user_id) VALUES ($1, $2) ON CONFLICT DO NOTHING", orgID, userID)
+	if err != nil {
+		return parseErr("scim user", err)
+	}
+	return nil
+}
+
+func (c *connection) FindUsergroupsForOrganization(ctx context.Context, orgID, afterName string, limit int) ([]*database.Usergroup, error) {
+	var res []*database.Usergroup
+	err := c.getDB(ctx).SelectContext(ctx, &res, `
+		SELECT * FROM usergroups
+		WHERE org_id = $1 AND lower(name) > lower($2)
+		ORDER BY lower(name) LIMIT $3
+	`, orgID, afterName, limit)
+	if err != nil {
+		return nil, parseErr("usergroups", err)
+	}
+	return res, nil
+}
+
+func (c *connection) FindUsergroup(ctx context.Context, id string) (*database.Usergroup, error) {
+	res := &database.Usergroup{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT * FROM usergroups WHERE id=$1", id).StructScan(res)
+	if err != nil {
+		return nil, parseErr("usergroup", err)
+	}
+	return res, nil
+}
+
+func (c *connection) FindUsergroupByName(ctx context.Context, orgName, name string) (*database.Usergroup, error) {
+	res := &database.Usergroup{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, `
+		SELECT ug.* FROM usergroups ug JOIN orgs o ON ug.org_id = o.id
+		WHERE lower(ug.name)=lower($1) AND lower(o.name)=lower($2)
+	`, name, orgName).StructScan(res)
+	if err != nil {
+		return nil, parseErr("usergroup", err)
+	}
+	return res, nil
+}
+
 func (c *connection) InsertUsergroup(ctx context.Context, opts *database.InsertUsergroupOptions) (*database.Usergroup, error) {
 	if err := database.Validate(opts); err != nil {
 		return nil, err
@@ -703,6 +846,26 @@ func (c *connection) InsertUsergroup(ctx context.Context, opts *database.InsertU
 	return res, nil
 }
 
+func (c *connection) UpdateUsergroupName(ctx context.Context, id string, opts *database.UpdateUsergroupOptions) (*database.Usergroup, error) {
+	if err := database.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	res := &database.Usergroup{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, `
+		UPDATE usergroups SET name=$1, updated_on=now() WHERE id=$2 RETURNING *
+	`, opts.Name, id).StructScan(res)
+	if err != nil {
+		return nil, parseErr("usergroup", err)
+	}
+	return res, nil
+}
+
+func (c *connection) DeleteUsergroup(ctx context.Context, id string) error {
+	res, err := c.getDB(ctx).ExecContext(ctx, "DELETE FROM usergroups WHERE id=$1", id)
+	return checkDeleteRow("usergroup", res, err)
+}
+
 func (c *connection) FindUsergroupsForUser(ctx context.Context, userID, orgID string) ([]*database.Usergroup, error) {
 	var res []*database.Usergroup
 	err := c.getDB(ctx).SelectContext(ctx, &res, `
@@ -715,6 +878,19 @@ func (c *connection) FindUsergroupsForUser(ctx context.Context, userID, orgID st
 	return res, nil
 }
 
+func (c *connection) FindUsergroupMemberUsers(ctx context.Context, groupID, afterEmail string, limit int) ([]*database.User, error) {
+	var res []*database.User
+	err := c.getDB(ctx).SelectContext(ctx, &res, `
+		SELECT u.* FROM users u JOIN usergroups_users uug ON u.id = uug.user_id
+		WHERE uug.usergroup_id = $1 AND lower(u.email) > lower($2)
+		ORDER BY lower(u.email) LIMIT $3
+	`, groupID, afterEmail, limit)
+	if err != nil {
+		return nil, parseErr("usergroup members", err)
+	}
+	return res, nil
+}
+
 func (c *connection) InsertUsergroupMember(ctx context.Context, groupID, userID string) error {
 	_, err := c.getDB(ctx).ExecContext(ctx, "INSERT INTO usergroups_users (user_id, usergroup_id) VALUES ($1, $2)", userID, groupID)
 	if err != nil {
@@ -728,6 +904,15 @@ func (c *connection) DeleteUsergroupMember(ctx context.Context, groupID, userID
 	return checkDeleteRow("usergroup member", res, err)
 }
 
+// DeleteUsergroupsMemberUser removes a user from all usergroups in an org.
+func (c *connection) DeleteUsergroupsMemberUser(ctx context.Context, orgID, userID string) error {
+	_, err := c.getDB(ctx).ExecContext(ctx, "DELETE FROM usergroups_users uug WHERE uug.user_id = $1 AND uug.usergroup_id IN (SELECT ug.id FROM usergroups ug WHERE ug.org_id = $2)", userID, orgID)
+	if err != nil {
+		return parseErr("usergroup member", err)
+	}
+	return nil
+}
+
 func (c *connection) FindUserAuthTokens(ctx context.Context, userID string) ([]*database.UserAuthToken, error) {
 	var res []*database.UserAuthToken
 	err := c.getDB(ctx).SelectContext(ctx, &res, "SELECT t.* FROM user_auth_tokens t WHERE t.user_id=$1", userID)
@@ -1197,6 +1382,21 @@ func (c *connection) InsertProjectMemberUser(ctx context.Context, projectID, use
 	return nil
 }
 
+func (c *connection) FindProjectMemberUsergroups(ctx context.Context, projectID, afterName string, limit int) ([]*database.MemberUsergroup, error) {
+	var res []*database.MemberUsergroup
+	err := c.getDB(ctx).SelectContext(ctx, &res, `
+		SELECT ug.id, ug.name, ug.created_on, ug.updated_on, r.name as role_name FROM usergroups ug
+		JOIN usergroups_projects_roles upr ON ug.id = upr.usergroup_id
+		JOIN project_roles r ON r.id = upr.project_role_id
+		WHERE upr.project_id=$1 AND lower(ug.name) > lower($2)
+		ORDER BY lower(ug.name) LIMIT $3
+	`, projectID, afterName, limit)
+	if err != nil {
+		return nil, parseErr("project group members", err)
+	}
+	return res, nil
+}
+
 func (c *connection) InsertProjectMemberUsergroup(ctx context.Context, groupID, projectID, roleID string) error {
 	_, err := c.getDB(ctx).ExecContext(ctx, "INSERT INTO usergroups_projects_roles (usergroup_id, project_id, project_role_id) VALUES ($1, $2, $3)", groupID, projectID, roleID)
 	if err != nil {
@@ -1205,6 +1405,16 @@ func (c *connection) InsertProjectMemberUsergroup(ctx context.Context, groupID,
 	return nil
 }
 
+func (c *connection) DeleteProjectMemberUsergroup(ctx context.Context, groupID, projectID string) error {
+	res, err := c.getDB(ctx).ExecContext(ctx, "DELETE FROM usergroups_projects_roles WHERE usergroup_id = $1 AND project_id = $2", groupID, projectID)
+	return checkDeleteRow("project group member", res, err)
+}
+
+func (c *connection) UpdateProjectMemberUsergroupRole(ctx context.Context, groupID, projectID, roleID string) error {
+	res, err := c.getDB(ctx).ExecContext(ctx, `UPDATE usergroups_projects_roles SET project_role_id = $1 WHERE usergroup_id = $2 AND project_id = $3`, roleID, groupID, projectID)
+	return checkUpdateRow("project group member", res, err)
+}
+
 func (c *connection) DeleteProjectMemberUser(ctx context.Context, projectID, userID string) error {
 	res, err := c.getDB(ctx).ExecContext(ctx, "DELETE FROM users_projects_roles WHERE user_id = $1 AND project_id = $2", userID, projectID)
 	return checkDeleteRow("project member", res, err)
@@ -1409,6 +1619,144 @@ func (c *connection) DeleteBookmark(ctx context.Context, bookmarkID string) erro
 	return checkDeleteRow("bookmarks", res, err)
 }
 
+func (c *connection) FindAuditEvents(ctx context.Context, opts *database.FindAuditEventsOptions) ([]*database.AuditEvent, error) {
+	if err := database.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	var res []*auditEventDTO
+	err := c.getDB(ctx).SelectContext(ctx, &res, `
+		SELECT * FROM audit_events
+		WHERE org_id=$1
+			AND ($2='' OR project_id::TEXT=$2)
+			AND ($3='' OR actor_id=$3)
+			AND ($4='' OR lower(action)=lower($4))
+			AND ($5::TIMESTAMPTZ IS NULL OR created_on>=$5)
+			AND ($6::TIMESTAMPTZ IS NULL OR created_on<$6)
+			AND ($7::TIMESTAMPTZ IS NULL OR created_on<$7 OR created_on=$7 AND id::TEXT<$8)
+		ORDER BY created_on DESC, id::TEXT DESC LIMIT $9
+	`, opts.OrgID, opts.ProjectID, opts.ActorID, opts.Action, nullTime(opts.StartTime), nullTime(opts.EndTime), nullTime(opts.BeforeCreatedOn), opts.BeforeID, opts.Limit)
+	if err != nil {
+		return nil, parseErr("audit events", err)
+	}
+
+	events := make([]*database.AuditEvent, len(res))
+	for i, dto := range res {
+		events[i], err = dto.AsAuditEvent()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return events, nil
+}
+
+func (c *connection) InsertAuditEvent(ctx context.Context, opts *database.InsertAuditEventOptions) (*database.AuditEvent, error) {
+	if err := database.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	if opts.Payload == nil {
+		opts.Payload = make(map[string]any)
+	}
+	payload, err := json.Marshal(opts.Payload)
+	if err != nil {
+		return nil, err
+	}
+	before, err := marshalNullableJSON(opts.Before)
+	if err != nil {
+		return nil, err
+	}
+	after, err := marshalNullableJSON(opts.After)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &auditEventDTO{AuditEvent: &database.AuditEvent{}}
+	err = c.getDB(ctx).QueryRowxContext(ctx, `
+		INSERT INTO audit_events (org_id, project_id, project_name, actor_type, actor_id, actor_name, action, payload, before, after)
+		VALUES (NULLIF($1, '')::UUID, NULLIF($2, '')::UUID, $3, $4, $5, $6, $7, $8::JSONB, NULLIF($9, '')::JSONB, NULLIF($10, '')::JSONB)
+		RETURNING *
+	`, opts.OrgID, opts.ProjectID, opts.ProjectName, opts.ActorType, opts.ActorID, opts.ActorName, opts.Action, string(payload), before, after).StructScan(res)
+	if err != nil {
+		return nil, parseErr("audit event", err)
+	}
+	return res.AsAuditEvent()
+}
+
+func (c *connection) FindSSOProvider(ctx context.Context, id string) (*database.SSOProvider, error) {
+	res := &ssoProviderDTO{SSOProvider: &database.SSOProvider{}}
+	err := c.getDB(ctx).QueryRowxContext(ctx, `SELECT p.*, COALESCE(r.name, '') AS jit_org_role_name FROM sso_providers p LEFT JOIN org_roles r ON r.id = p.jit_org_role_id WHERE p.id=$1`, id).StructScan(res)
+	if err != nil {
+		return nil, parseErr("sso provider", err)
+	}
+	return res.AsSSOProvider()
+}
+
+func (c *connection) FindSSOProviderForOrganization(ctx context.Context, orgID string) (*database.SSOProvider, error) {
+	res := &ssoProviderDTO{SSOProvider: &database.SSOProvider{}}
+	err := c.getDB(ctx).QueryRowxContext(ctx, `SELECT p.*, COALESCE(r.name, '') AS jit_org_role_name FROM sso_providers p LEFT JOIN org_roles r ON r.id = p.jit_org_role_id WHERE p.org_id=$1`, orgID).StructScan(res)
+	if err != nil {
+		return nil, parseErr("sso provider", err)
+	}
+	return res.AsSSOProvider()
+}
+
+func (c *connection) FindSSOProviderForDomain(ctx context.Context, domain string) (*database.SSOProvider, error) {
+	res := &ssoProviderDTO{SSOProvider: &database.SSOProvider{}}
+	err := c.getDB(ctx).QueryRowxContext(ctx, `SELECT p.*, COALESCE(r.name, '') AS jit_org_role_name FROM sso_providers p LEFT JOIN org_roles r ON r.id = p.jit_org_role_id WHERE p.verified_domains @> ARRAY[lower($1)]`, domain).StructScan(res)
+	if err != nil {
+		return nil, parseErr("sso provider", err)
+	}
+	return res.AsSSOProvider()
+}
+
+func (c *connection) UpsertSSOProvider(ctx context.Context, opts *database.UpsertSSOProviderOptions) (*database.SSOProvider, error) {
+	if err := database.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	domains := make([]string, len(opts.Domains))
+	for i, d := range opts.Domains {
+		domains[i] = strings.ToLower(d)
+	}
+	verifiedDomains := make([]string, len(opts.VerifiedDomains))
+	for i, d := range opts.VerifiedDomains {
+		verifiedDomains[i] = strings.ToLower(d)
+	}
+
+	config, err := json.Marshal(opts.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &ssoProviderDTO{SSOProvider: &database.SSOProvider{}}
+	err = c.getDB(ctx).QueryRowxContext(ctx, `
+		WITH p AS (
+			INSERT INTO sso_providers (org_id, type, domains, verified_domains, domain_verification_token, config, jit_org_role_id)
+			VALUES ($1, $2, $3, $4, $5, $6::JSONB, $7)
+			ON CONFLICT (org_id) DO UPDATE SET
+				type = EXCLUDED.type,
+				domains = EXCLUDED.domains,
+				verified_domains = EXCLUDED.verified_domains,
+				domain_verification_token = EXCLUDED.domain_verification_token,
+				config = EXCLUDED.config,
+				jit_org_role_id = EXCLUDED.jit_org_role_id,
+				updated_on = now()
+			RETURNING *
+		)
+		SELECT p.*, COALESCE(r.name, '') AS jit_org_role_name FROM p LEFT JOIN org_roles r ON r.id = p.jit_org_role_id
+	`, opts.OrgID, opts.Type, domains, verifiedDomains, opts.DomainVerificationToken, string(config), opts.JITOrgRoleID).StructScan(res)
+	if err != nil {
+		return nil, parseErr("sso provider", err)
+	}
+	return res.AsSSOProvider()
+}
+
+func (c *connection) DeleteSSOProvider(ctx context.Context, id string) error {
+	res, err := c.getDB(ctx).ExecContext(ctx, "DELETE FROM sso_providers WHERE id=$1", id)
+	return checkDeleteRow("sso provider", res, err)
+}
+
 func (c *connection) FindVirtualFiles(ctx context.Context, projectID, branch string, afterUpdatedOn time.Time, afterPath string, limit int) ([]*database.VirtualFile, error) {
 	var res []*database.VirtualFile
 	err := c.getDB(ctx).SelectContext(ctx, &res, `
@@ -1473,8 +1821,11 @@ func (c *connection) DeleteExpiredVirtualFiles(ctx context.Context, retention ti
 // projectDTO wraps database.Project, using the pgtype package to handle types that pgx can't read directly into their native Go types.
 type projectDTO struct {
 	*database.Project
-	ProdVariables pgtype.JSON `db:"prod_variables"`
-	Annotations   pgtype.JSON `db:"annotations"`
+	ProdVariables        pgtype.JSON `db:"prod_variables"`
+	EnvironmentVariables pgtype.JSON `db:"environment_variables"`
+	Annotations          pgtype.JSON `db:"annotations"`
+	EncryptionKeyID      string      `db:"encryption_key_id"`
+	EncryptionKey        []byte      `db:"encryption_key"`
 }
 
 func (p *projectDTO) AsProject() (*database.Project, error) {
@@ -1483,6 +1834,11 @@ func (p *projectDTO) AsProject() (*database.Project, error) {
 		return nil, err
 	}
 
+	err = p.EnvironmentVariables.AssignTo(&p.Project.EnvironmentVariables)
+	if err != nil {
+		return nil, err
+	}
+
 	err = p.Annotations.AssignTo(&p.Project.Annotations)
 	if err != nil {
 		return nil, err
@@ -1491,11 +1847,31 @@ func (p *projectDTO) AsProject() (*database.Project, error) {
 	return p.Project, nil
 }
 
-func projectsFromDTOs(dtos []*projectDTO) ([]*database.Project, error) {
+// projectFromDTO converts a projectDTO to a database.Project and decrypts its sensitive fields.
+func (c *connection) projectFromDTO(ctx context.Context, dto *projectDTO) (*database.Project, error) {
+	p, err := dto.AsProject()
+	if err != nil {
+		return nil, err
+	}
+
+	k, err := c.unwrapDataKey(ctx, dto.EncryptionKeyID, dto.EncryptionKey)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.decryptProject(p)
+	if err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+func (c *connection) projectsFromDTOs(ctx context.Context, dtos []*projectDTO) ([]*database.Project, error) {
 	res := make([]*database.Project, len(dtos))
 	for i, dto := range dtos {
 		var err error
-		res[i], err = dto.AsProject()
+		res[i], err = c.projectFromDTO(ctx, dto)
 		if err != nil {
 			return nil, err
 		}
@@ -1503,6 +1879,80 @@ func projectsFromDTOs(dtos []*projectDTO) ([]*database.Project, error) {
 	return res, nil
 }
 
+// auditEventDTO wraps database.AuditEvent, using the pgtype package to read its JSON columns.
+type auditEventDTO struct {
+	*database.AuditEvent
+	Payload pgtype.JSON `db:"payload"`
+	Before  pgtype.JSON `db:"before"`
+	After   pgtype.JSON `db:"after"`
+}
+
+func (e *auditEventDTO) AsAuditEvent() (*database.AuditEvent, error) {
+	err := e.Payload.AssignTo(&e.AuditEvent.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	err = e.Before.AssignTo(&e.AuditEvent.Before)
+	if err != nil {
+		return nil, err
+	}
+
+	err = e.After.AssignTo(&e.AuditEvent.After)
+	if err != nil {
+		return nil, err
+	}
+
+	return e.AuditEvent, nil
+}
+
+// ssoProviderDTO wraps database.SSOProvider, using the pgtype package to read its array and JSON columns.
+type ssoProviderDTO struct {
+	*database.SSOProvider
+	Domains         pgtype.TextArray `db:"domains"`
+	VerifiedDomains pgtype.TextArray `db:"verified_domains"`
+	Config          pgtype.JSON      `db:"config"`
+}
+
+func (p *ssoProviderDTO) AsSSOProvider() (*database.SSOProvider, error) {
+	err := p.Domains.AssignTo(&p.SSOProvider.Domains)
+	if err != nil {
+		return nil, err
+	}
+
+	err = p.VerifiedDomains.AssignTo(&p.SSOProvider.VerifiedDomains)
+	if err != nil {
+		return nil, err
+	}
+
+	err = p.Config.AssignTo(&p.SSOProvider.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.SSOProvider, nil
+}
+
+// marshalNullableJSON serializes a map to JSON. It returns an empty string for a nil map.
+func marshalNullableJSON(m map[string]any) (string, error) {
+	if m == nil {
+		return "", nil
+	}
+	data, err := json.Marshal(m)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// nullTime returns nil for a zero time, so it can be passed as a NULL parameter.
+func nullTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
 func checkUpdateRow(target string, res sql.Result, err error) error {
 	if err != nil {
 		return parseErr(target, err)
@@ -2,6 +2,11 @@ package postgres
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -18,7 +23,7 @@ func TestPostgres(t *testing.T) {
 	pg := pgtestcontainer.New(t)
 	defer pg.Terminate(t)
 
-	db, err := database.Open("postgres", pg.DatabaseURL)
+	db, err := database.Open("postgres", pg.DatabaseURL, nil)
 	require.NoError(t, err)
 	require.NotNil(t, db)
 
@@ -32,6 +37,11 @@ func TestPostgres(t *testing.T) {
 	t.Run("TestProjectsWithPagination", func(t *testing.T) { testProjectsWithPagination(t, db) })
 	t.Run("TestProjectsForUsersWithPagination", func(t *testing.T) { testProjectsForUserWithPagination(t, db) })
 	t.Run("TestMembersWithPagination", func(t *testing.T) { testOrgsMembersPagination(t, db) })
+	t.Run("TestUsergroups", func(t *testing.T) { testUsergroups(t, db) })
+	t.Run("TestAuditEvents", func(t *testing.T) { testAuditEvents(t, db) })
+	t.Run("TestSSOProviders", func(t *testing.T) { testSSOProviders(t, db) })
+	t.Run("TestPreviewDeployments", func(t *testing.T) { testPreviewDeployments(t, db) })
+	t.Run("TestEncryption", func(t *testing.T) { testEncryption(t, db, pg.DatabaseURL) })
 	// Add new tests here
 
 	require.NoError(t, db.Close())
@@ -197,18 +207,35 @@ func testProjectsWithVariables(t *testing.T, db database.DB) {
 	require.Equal(t, "foo", org.Name)
 
 	opts := &database.InsertProjectOptions{
-		OrganizationID: org.ID,
-		Name:           "bar",
-		Description:    "hello world",
-		ProdVariables:  map[string]string{"hello": "world"},
+		OrganizationID:       org.ID,
+		Name:                 "bar",
+		Description:          "hello world",
+		ProdVariables:        map[string]string{"hello": "world"},
+		EnvironmentVariables: map[string]map[string]string{"prod": {"hello": "prod"}},
 	}
 	proj, err := db.InsertProject(ctx, opts)
 	require.NoError(t, err)
 	require.Equal(t, opts.ProdVariables, proj.ProdVariables)
+	require.Equal(t, opts.EnvironmentVariables, proj.EnvironmentVariables)
+
+	proj, err = db.FindProjectByName(ctx, org.Name, proj.Name)
+	require.NoError(t, err)
+	require.Equal(t, opts.ProdVariables, proj.ProdVariables)
+	require.Equal(t, opts.EnvironmentVariables, proj.EnvironmentVariables)
+
+	envVars := map[string]map[string]string{"staging": {"hello": "staging"}}
+	proj, err = db.UpdateProject(ctx, proj.ID, &database.UpdateProjectOptions{
+		Name:                 proj.Name,
+		ProdVariables:        proj.ProdVariables,
+		EnvironmentVariables: envVars,
+	})
+	require.NoError(t, err)
+	require.Equal(t, envVars, proj.EnvironmentVariables)
 
 	proj, err = db.FindProjectByName(ctx, org.Name, proj.Name)
 	require.NoError(t, err)
 	require.Equal(t, opts.ProdVariables, proj.ProdVariables)
+	require.Equal(t, envVars, proj.EnvironmentVariables)
 
 	err = db.DeleteProject(ctx, proj.ID)
 	require.NoError(t, err)
@@ -419,3 +446,440 @@ func testOrgsMembersPagination(t *testing.T, db database.DB) {
 	//cleanup
 	require.NoError(t, db.DeleteOrganization(ctx, "alpha"))
 }
+
+func testUsergroups(t *testing.T, db database.DB) {
+	ctx := context.Background()
+
+	user, err := db.InsertUser(ctx, &database.InsertUserOptions{Email: "test@rilldata.com"})
+	require.NoError(t, err)
+
+	viewer, err := db.FindProjectRole(ctx, database.ProjectRoleNameViewer)
+	require.NoError(t, err)
+	admin, err := db.FindProjectRole(ctx, database.ProjectRoleNameAdmin)
+	require.NoError(t, err)
+
+	org, err := db.InsertOrganization(ctx, &database.InsertOrganizationOptions{Name: "groups"})
+	require.NoError(t, err)
+	proj, err := db.InsertProject(ctx, &database.InsertProjectOptions{OrganizationID: org.ID, Name: "alpha"})
+	require.NoError(t, err)
+
+	// add groups
+	eng, err := db.InsertUsergroup(ctx, &database.InsertUsergroupOptions{OrgID: org.ID, Name: "eng"})
+	require.NoError(t, err)
+	require.Equal(t, "eng", eng.Name)
+	sales, err := db.InsertUsergroup(ctx, &database.InsertUsergroupOptions{OrgID: org.ID, Name: "sales"})
+	require.NoError(t, err)
+
+	// fetch groups with pagination
+	groups, err := db.FindUsergroupsForOrganization(ctx, org.ID, "", 1)
+	require.NoError(t, err)
+	require.Len(t, groups, 1)
+	require.Equal(t, "eng", groups[0].Name)
+	groups, err = db.FindUsergroupsForOrganization(ctx, org.ID, groups[0].Name, 1)
+	require.NoError(t, err)
+	require.Len(t, groups, 1)
+	require.Equal(t, "sales", groups[0].Name)
+
+	// rename group
+	sales, err = db.UpdateUsergroupName(ctx, sales.ID, &database.UpdateUsergroupOptions{Name: "marketing"})
+	require.NoError(t, err)
+	require.Equal(t, "marketing", sales.Name)
+	g, err := db.FindUsergroupByName(ctx, "groups", "marketing")
+	require.NoError(t, err)
+	require.Equal(t, sales.ID, g.ID)
+
+	// group members
+	require.NoError(t, db.InsertUsergroupMember(ctx, eng.ID, user.ID))
+	users, err := db.FindUsergroupMemberUsers(ctx, eng.ID, "", 10)
+	require.NoError(t, err)
+	require.Len(t, users, 1)
+	require.Equal(t, user.ID, users[0].ID)
+
+	// group roles on projects
+	require.NoError(t, db.InsertProjectMemberUsergroup(ctx, eng.ID, proj.ID, viewer.ID))
+	require.NoError(t, db.UpdateProjectMemberUsergroupRole(ctx, eng.ID, proj.ID, admin.ID))
+	members, err := db.FindProjectMemberUsergroups(ctx, proj.ID, "", 10)
+	require.NoError(t, err)
+	require.Len(t, members, 1)
+	require.Equal(t, "eng", members[0].Name)
+	require.Equal(t, database.ProjectRoleNameAdmin, members[0].RoleName)
+
+	// the user inherits the group's role
+	roles, err := db.ResolveProjectRolesForUser(ctx, user.ID, proj.ID)
+	require.NoError(t, err)
+	require.Len(t, roles, 1)
+	require.True(t, roles[0].ManageProject)
+
+	require.NoError(t, db.DeleteProjectMemberUsergroup(ctx, eng.ID, proj.ID))
+	members, err = db.FindProjectMemberUsergroups(ctx, proj.ID, "", 10)
+	require.NoError(t, err)
+	require.Len(t, members, 0)
+
+	// removing the user from the org's groups
+	require.NoError(t, db.DeleteUsergroupsMemberUser(ctx, org.ID, user.ID))
+	users, err = db.FindUsergroupMemberUsers(ctx, eng.ID, "", 10)
+	require.NoError(t, err)
+	require.Len(t, users, 0)
+
+	// delete group
+	require.NoError(t, db.DeleteUsergroup(ctx, sales.ID))
+	_, err = db.FindUsergroupByName(ctx, "groups", "marketing")
+	require.ErrorIs(t, err, database.ErrNotFound)
+
+	//cleanup
+	require.NoError(t, db.DeleteProject(ctx, proj.ID))
+	require.NoError(t, db.DeleteOrganization(ctx, "groups"))
+	require.NoError(t, db.DeleteUser(ctx, user.ID))
+}
+
+func testAuditEvents(t *testing.T, db database.DB) {
+	ctx := context.Background()
+
+	org, err := db.InsertOrganization(ctx, &database.InsertOrganizationOptions{Name: "audited"})
+	require.NoError(t, err)
+	proj, err := db.InsertProject(ctx, &database.InsertProjectOptions{OrganizationID: org.ID, Name: "alpha"})
+	require.NoError(t, err)
+
+	// insert events
+	for i := 0; i < 3; i++ {
+		_, err := db.InsertAuditEvent(ctx, &database.InsertAuditEventOptions{
+			OrgID:     org.ID,
+			ActorType: "user",
+			ActorID:   "u1",
+			Action:    "UpdateOrganization",
+			Payload:   map[string]any{"name": org.Name},
+		})
+		require.NoError(t, err)
+	}
+	evt, err := db.InsertAuditEvent(ctx, &database.InsertAuditEventOptions{
+		OrgID:       org.ID,
+		ProjectID:   proj.ID,
+		ProjectName: proj.Name,
+		ActorType:   "service",
+		ActorID:     "s1",
+		Action:      "UpdateProjectVariables",
+		Before:      map[string]any{"variables": map[string]any{"foo": "[redacted]"}},
+		After:       map[string]any{"variables": map[string]any{}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, proj.ID, *evt.ProjectID)
+	require.Equal(t, map[string]any{}, evt.Payload)
+	require.Equal(t, map[string]any{"variables": map[string]any{"foo": "[redacted]"}}, evt.Before)
+
+	// filters
+	events, err := db.FindAuditEvents(ctx, &database.FindAuditEventsOptions{OrgID: org.ID, ProjectID: proj.ID, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, evt.ID, events[0].ID)
+
+	events, err = db.FindAuditEvents(ctx, &database.FindAuditEventsOptions{OrgID: org.ID, ActorID: "u1", Action: "updateorganization", Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, events, 3)
+	require.Nil(t, events[0].Before)
+
+	events, err = db.FindAuditEvents(ctx, &database.FindAuditEventsOptions{OrgID: org.ID, StartTime: evt.CreatedOn.Add(time.Second), Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, events, 0)
+
+	// pagination
+	events, err = db.FindAuditEvents(ctx, &database.FindAuditEventsOptions{OrgID: org.ID, Limit: 3})
+	require.NoError(t, err)
+	require.Len(t, events, 3)
+	require.Equal(t, evt.ID, events[0].ID)
+	last := events[2]
+	events, err = db.FindAuditEvents(ctx, &database.FindAuditEventsOptions{OrgID: org.ID, BeforeCreatedOn: last.CreatedOn, BeforeID: last.ID, Limit: 3})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+
+	// org is required
+	_, err = db.FindAuditEvents(ctx, &database.FindAuditEventsOptions{Limit: 3})
+	require.Error(t, err)
+
+	// events outlive the org
+	require.NoError(t, db.DeleteOrganization(ctx, org.Name))
+	events, err = db.FindAuditEvents(ctx, &database.FindAuditEventsOptions{OrgID: org.ID, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, events, 4)
+}
+
+func testSSOProviders(t *testing.T, db database.DB) {
+	ctx := context.Background()
+
+	org, err := db.InsertOrganization(ctx, &database.InsertOrganizationOptions{Name: "sso"})
+	require.NoError(t, err)
+	role, err := db.FindOrganizationRole(ctx, database.OrganizationRoleNameViewer)
+	require.NoError(t, err)
+
+	_, err = db.FindSSOProviderForOrganization(ctx, org.ID)
+	require.ErrorIs(t, err, database.ErrNotFound)
+
+	p, err := db.UpsertSSOProvider(ctx, &database.UpsertSSOProviderOptions{
+		OrgID:                   org.ID,
+		Type:                    database.SSOProviderTypeOIDC,
+		Domains:                 []string{"Example.com", "example.org"},
+		VerifiedDomains:         []string{"example.org"},
+		DomainVerificationToken: "token",
+		Config:                  database.SSOProviderConfig{IssuerURL: "https://idp.example.com", ClientID: "rill", ClientSecret: "secret"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"example.com", "example.org"}, p.Domains)
+	require.Equal(t, []string{"example.org"}, p.VerifiedDomains)
+	require.Equal(t, "token", p.DomainVerificationToken)
+	require.Equal(t, "secret", p.Config.ClientSecret)
+	require.Nil(t, p.JITOrgRoleID)
+	require.Equal(t, "", p.JITOrgRoleName)
+
+	// find by domain is case insensitive
+	p2, err := db.FindSSOProviderForDomain(ctx, "EXAMPLE.org")
+	require.NoError(t, err)
+	require.Equal(t, p.ID, p2.ID)
+	_, err = db.FindSSOProviderForDomain(ctx, "sub.example.org")
+	require.ErrorIs(t, err, database.ErrNotFound)
+
+	// unverified domains are not routed to the provider
+	_, err = db.FindSSOProviderForDomain(ctx, "example.com")
+	require.ErrorIs(t, err, database.ErrNotFound)
+
+	// upsert replaces the org's provider
+	p2, err = db.UpsertSSOProvider(ctx, &database.UpsertSSOProviderOptions{
+		OrgID:                   org.ID,
+		Type:                    database.SSOProviderTypeSAML,
+		Domains:                 []string{"example.com"},
+		VerifiedDomains:         []string{"example.com"},
+		DomainVerificationToken: "token",
+		Config:                  database.SSOProviderConfig{IDPMetadataURL: "https://idp.example.com/metadata"},
+		JITOrgRoleID:            &role.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, p.ID, p2.ID)
+	require.Equal(t, database.SSOProviderTypeSAML, p2.Type)
+	require.Equal(t, role.ID, *p2.JITOrgRoleID)
+	require.Equal(t, role.Name, p2.JITOrgRoleName)
+
+	p2, err = db.FindSSOProvider(ctx, p.ID)
+	require.NoError(t, err)
+	require.Equal(t, "https://idp.example.com/metadata", p2.Config.IDPMetadataURL)
+	require.Equal(t, role.Name, p2.JITOrgRoleName)
+	_, err = db.FindSSOProviderForDomain(ctx, "example.org")
+	require.ErrorIs(t, err, database.ErrNotFound)
+
+	// validation
+	_, err = db.UpsertSSOProvider(ctx, &database.UpsertSSOProviderOptions{OrgID: org.ID, Type: "ldap", Domains: []string{"example.com"}, DomainVerificationToken: "token"})
+	require.Error(t, err)
+	_, err = db.UpsertSSOProvider(ctx, &database.UpsertSSOProviderOptions{OrgID: org.ID, Type: database.SSOProviderTypeOIDC, DomainVerificationToken: "token"})
+	require.Error(t, err)
+	_, err = db.UpsertSSOProvider(ctx, &database.UpsertSSOProviderOptions{OrgID: org.ID, Type: database.SSOProviderTypeOIDC, Domains: []string{"example.com"}})
+	require.Error(t, err)
+
+	require.NoError(t, db.DeleteSSOProvider(ctx, p.ID))
+	_, err = db.FindSSOProvider(ctx, p.ID)
+	require.ErrorIs(t, err, database.ErrNotFound)
+}
+
+func testPreviewDeployments(t *testing.T, db database.DB) {
+	ctx := context.Background()
+
+	org, err := db.InsertOrganization(ctx, &database.InsertOrganizationOptions{Name: "previews"})
+	require.NoError(t, err)
+	proj, err := db.InsertProject(ctx, &database.InsertProjectOptions{OrganizationID: org.ID, Name: "bar", ProdBranch: "main"})
+	require.NoError(t, err)
+
+	prod, err := db.InsertDeployment(ctx, &database.InsertDeploymentOptions{
+		ProjectID:         proj.ID,
+		Provisioner:       "static",
+		Branch:            "main",
+		RuntimeHost:       "http://localhost:9091",
+		RuntimeInstanceID: "prod",
+		Environment:       "prod",
+	})
+	require.NoError(t, err)
+	require.False(t, prod.Preview)
+
+	prNumber := 1
+	depl, err := db.InsertDeployment(ctx, &database.InsertDeploymentOptions{
+		ProjectID:         proj.ID,
+		Provisioner:       "static",
+		Branch:            "feature",
+		RuntimeHost:       "http://localhost:9091",
+		RuntimeInstanceID: "preview",
+		Environment:       "preview",
+		Preview:           true,
+		PullRequestNumber: &prNumber,
+		PullRequestURL:    "https://github.com/foo/bar/pull/1",
+	})
+	require.NoError(t, err)
+	require.True(t, depl.Preview)
+	require.Equal(t, 1, *depl.PullRequestNumber)
+	require.Nil(t, depl.ClosedOn)
+
+	// a branch can only have one preview deployment
+	_, err = db.InsertDeployment(ctx, &database.InsertDeploymentOptions{
+		ProjectID:         proj.ID,
+		Provisioner:       "static",
+		Branch:            "feature",
+		RuntimeHost:       "http://localhost:9091",
+		RuntimeInstanceID: "preview2",
+		Environment:       "preview",
+		Preview:           true,
+	})
+	require.ErrorIs(t, err, database.ErrNotUnique)
+
+	depls, err := db.FindPreviewDeploymentsForProject(ctx, proj.ID)
+	require.NoError(t, err)
+	require.Len(t, depls, 1)
+	require.Equal(t, depl.ID, depls[0].ID)
+
+	_, err = db.FindPreviewDeploymentForBranch(ctx, proj.ID, "main")
+	require.ErrorIs(t, err, database.ErrNotFound)
+
+	// open and recently used previews are not expired
+	expired, err := db.FindExpiredPreviewDeployments(ctx, time.Hour)
+	require.NoError(t, err)
+	require.Len(t, expired, 0)
+
+	now := time.Now()
+	depl, err = db.UpdatePreviewDeployment(ctx, depl.ID, &database.UpdatePreviewDeploymentOptions{
+		PullRequestNumber: depl.PullRequestNumber,
+		PullRequestURL:    depl.PullRequestURL,
+		ClosedOn:          &now,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, depl.ClosedOn)
+
+	expired, err = db.FindExpiredPreviewDeployments(ctx, time.Hour)
+	require.NoError(t, err)
+	require.Len(t, expired, 1)
+	require.Equal(t, depl.ID, expired[0].ID)
+
+	// prod deployments are not updated as previews
+	_, err = db.UpdatePreviewDeployment(ctx, prod.ID, &database.UpdatePreviewDeploymentOptions{})
+	require.ErrorIs(t, err, database.ErrNotFound)
+
+	require.NoError(t, db.DeleteDeployment(ctx, depl.ID))
+	require.NoError(t, db.DeleteDeployment(ctx, prod.ID))
+	require.NoError(t, db.DeleteProject(ctx, proj.ID))
+	require.NoError(t, db.DeleteOrganization(ctx, org.Name))
+}
+
+func testEncryption(t *testing.T, db database.DB, dsn string) {
+	ctx := context.Background()
+
+	_, err := db.RotateEncryptionKeys(ctx)
+	require.ErrorIs(t, err, database.ErrEncryptionNotConfigured)
+
+	// Insert a project before encryption is configured
+	org, err := db.InsertOrganization(ctx, &database.InsertOrganizationOptions{Name: "foo"})
+	require.NoError(t, err)
+	prodVars := map[string]string{"password": "hunter2"}
+	envVars := map[string]map[string]string{"dev": {"password": "dev"}}
+	proj1, err := db.InsertProject(ctx, &database.InsertProjectOptions{
+		OrganizationID: org.ID,
+		Name:           "plain",
+		ProdVariables:  prodVars,
+		ProdOLAPDSN:    "secret-dsn",
+	})
+	require.NoError(t, err)
+	proj1, err = db.UpdateProject(ctx, proj1.ID, &database.UpdateProjectOptions{
+		Name:                 proj1.Name,
+		ProdVariables:        proj1.ProdVariables,
+		EnvironmentVariables: envVars,
+	})
+	require.NoError(t, err)
+
+	// Configure a key and encrypt the existing project
+	key1 := newTestKey(t)
+	db1 := openWithKeys(t, dsn, key1)
+	n, err := db1.RotateEncryptionKeys(ctx)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, n, 1)
+	n, err = db1.RotateEncryptionKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 0, n)
+
+	proj1, err = db1.FindProject(ctx, proj1.ID)
+	require.NoError(t, err)
+	require.Equal(t, prodVars, proj1.ProdVariables)
+	require.Equal(t, envVars, proj1.EnvironmentVariables)
+	require.Equal(t, "secret-dsn", proj1.ProdOLAPDSN)
+
+	// The values are not stored in plain text
+	var raw string
+	err = db1.(*connection).db.QueryRowxContext(ctx, "SELECT prod_variables::TEXT FROM projects WHERE id=$1", proj1.ID).Scan(&raw)
+	require.NoError(t, err)
+	require.NotContains(t, raw, "hunter2")
+
+	// Encrypted projects can't be read without the key
+	_, err = db.FindProject(ctx, proj1.ID)
+	require.ErrorIs(t, err, database.ErrEncryptionNotConfigured)
+
+	// New projects are encrypted on insert and update
+	proj2, err := db1.InsertProject(ctx, &database.InsertProjectOptions{
+		OrganizationID: org.ID,
+		Name:           "encrypted",
+		ProdVariables:  prodVars,
+	})
+	require.NoError(t, err)
+	require.Equal(t, prodVars, proj2.ProdVariables)
+	proj2, err = db1.UpdateProject(ctx, proj2.ID, &database.UpdateProjectOptions{
+		Name:                 proj2.Name,
+		ProdVariables:        map[string]string{"password": "updated"},
+		EnvironmentVariables: envVars,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "updated", proj2.ProdVariables["password"])
+	require.Equal(t, envVars, proj2.EnvironmentVariables)
+
+	// Rotate to a new key, after which the old key is no longer needed
+	key2 := newTestKey(t)
+	db2 := openWithKeys(t, dsn, key1, key2)
+	n, err = db2.RotateEncryptionKeys(ctx)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, n, 2)
+
+	db3 := openWithKeys(t, dsn, key2)
+	proj1, err = db3.FindProject(ctx, proj1.ID)
+	require.NoError(t, err)
+	require.Equal(t, prodVars, proj1.ProdVariables)
+	proj2, err = db3.FindProject(ctx, proj2.ID)
+	require.NoError(t, err)
+	require.Equal(t, "updated", proj2.ProdVariables["password"])
+
+	_, err = db1.FindProject(ctx, proj2.ID)
+	require.Error(t, err)
+
+	require.NoError(t, db3.DeleteProject(ctx, proj1.ID))
+	require.NoError(t, db3.DeleteProject(ctx, proj2.ID))
+	require.NoError(t, db3.DeleteOrganization(ctx, org.Name))
+}
+
+func newTestKey(t *testing.T) map[string]string {
+	secret := make([]byte, 32)
+	_, err := rand.Read(secret)
+	require.NoError(t, err)
+
+	id := make([]byte, 8)
+	_, err = rand.Read(id)
+	require.NoError(t, err)
+
+	return map[string]string{
+		"id":     base64.RawURLEncoding.EncodeToString(id),
+		"secret": base64.StdEncoding.EncodeToString(secret),
+	}
+}
+
+func openWithKeys(t *testing.T, dsn string, keys ...map[string]string) database.DB {
+	data, err := json.Marshal(map[string]any{"keys": keys})
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "keyfile.json")
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+
+	provider, err := database.NewKeyfileProvider(path)
+	require.NoError(t, err)
+
+	db, err := database.Open("postgres", dsn, provider)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, db.Close()) })
+
+	return db
+}
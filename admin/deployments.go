@@ -21,15 +21,19 @@ import (
 )
 
 type createDeploymentOptions struct {
-	ProjectID      string
-	Provisioner    string
-	Annotations    DeploymentAnnotations
-	ProdBranch     string
-	ProdVariables  map[string]string
-	ProdOLAPDriver string
-	ProdOLAPDSN    string
-	ProdSlots      int
-	ProdVersion    string
+	ProjectID         string
+	Provisioner       string
+	Annotations       DeploymentAnnotations
+	ProdBranch        string
+	ProdVariables     map[string]string
+	ProdOLAPDriver    string
+	ProdOLAPDSN       string
+	ProdSlots         int
+	ProdVersion       string
+	Environment       string
+	Preview           bool
+	PullRequestNumber *int
+	PullRequestURL    string
 }
 
 func (s *Service) createDeployment(ctx context.Context, opts *createDeploymentOptions) (*database.Deployment, error) {
@@ -126,9 +130,14 @@ func (s *Service) createDeployment(ctx context.Context, opts *createDeploymentOp
 		RuntimeAudience:   alloc.Audience,
 		RuntimeVersion:    runtimeVersion,
 		Status:            database.DeploymentStatusPending,
+		Environment:       opts.Environment,
+		Preview:           opts.Preview,
+		PullRequestNumber: opts.PullRequestNumber,
+		PullRequestURL:    opts.PullRequestURL,
 	})
 	if err != nil {
-		return nil, err
+		err2 := p.Deprovision(ctx, provisionID)
+		return nil, multierr.Combine(err, err2)
 	}
 
 	// Wait for the runtime to be ready
@@ -175,7 +184,7 @@ func (s *Service) createDeployment(ctx context.Context, opts *createDeploymentOp
 	// Create the instance
 	_, err = rt.CreateInstance(ctx, &runtimev1.CreateInstanceRequest{
 		InstanceId:     instanceID,
-		Environment:    "prod",
+		Environment:    opts.Environment,
 		OlapConnector:  olapConnector,
 		RepoConnector:  "admin",
 		AdminConnector: "admin",
@@ -330,6 +339,10 @@ func (s *Service) HibernateDeployments(ctx context.Context) error {
 			continue
 		}
 
+		if proj.ProdDeploymentID == nil || *proj.ProdDeploymentID != depl.ID {
+			continue
+		}
+
 		// Update prod deployment on project
 		_, err = s.DB.UpdateProject(ctx, proj.ID, &database.UpdateProjectOptions{
 			Name:                 proj.Name,
@@ -344,6 +357,8 @@ func (s *Service) HibernateDeployments(ctx context.Context) error {
 			ProdSlots:            proj.ProdSlots,
 			ProdTTLSeconds:       proj.ProdTTLSeconds,
 			ProdDeploymentID:     nil,
+			PreviewDeployments:   proj.PreviewDeployments,
+			EnvironmentVariables: proj.EnvironmentVariables,
 			Annotations:          proj.Annotations,
 		})
 		if err != nil {
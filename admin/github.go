@@ -176,6 +176,9 @@ func (s *Service) ProcessGithubEvent(ctx context.Context, rawEvent any) error {
 	// Triggered on push to repository
 	case *github.PushEvent:
 		return s.processGithubPush(ctx, event)
+	// Triggered when a pull request is opened, updated or closed
+	case *github.PullRequestEvent:
+		return s.processGithubPullRequest(ctx, event)
 	// Triggered during first installation of app to an account (org or user) or one or more repos
 	case *github.InstallationEvent:
 		return s.processGithubInstallationEvent(ctx, event)
@@ -212,7 +215,13 @@ func (s *Service) processGithubPush(ctx context.Context, event *github.PushEvent
 	// Iterate over all projects and trigger reconcile
 	for _, project := range projects {
 		if branch != project.ProdBranch {
-			// Ignore if push was not to production branch
+			// Pushes to other branches are deployed as previews
+			if project.PreviewDeployments {
+				err = s.processGithubPushForPreview(ctx, project, branch, event.GetDeleted())
+				if err != nil {
+					return err
+				}
+			}
 			continue
 		}
 
@@ -234,6 +243,81 @@ func (s *Service) processGithubPush(ctx context.Context, event *github.PushEvent
 	return nil
 }
 
+func (s *Service) processGithubPushForPreview(ctx context.Context, project *database.Project, branch string, deleted bool) error {
+	if !deleted {
+		s.createPreviewDeploymentInBackground(project, branch, nil)
+		return nil
+	}
+
+	// The branch was deleted, so we close its preview deployment (if any)
+	depl, err := s.DB.FindPreviewDeploymentForBranch(ctx, project.ID, branch)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+	return s.ClosePreviewDeployment(ctx, depl)
+}
+
+func (s *Service) processGithubPullRequest(ctx context.Context, event *github.PullRequestEvent) error {
+	pr := event.GetPullRequest()
+	if pr == nil {
+		return fmt.Errorf("nil pull request")
+	}
+
+	// Find Rill projects matching the repo of the pull request
+	githubURL := event.GetRepo().GetHTMLURL()
+	projects, err := s.DB.FindProjectsByGithubURL(ctx, githubURL)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	// We only deploy pull requests from branches in the same repository.
+	// Branches of forks can't be cloned with the installation's credentials, and shouldn't have access to the project's variables.
+	if pr.GetHead().GetRepo().GetHTMLURL() != githubURL {
+		return nil
+	}
+	branch := pr.GetHead().GetRef()
+
+	for _, project := range projects {
+		if !project.PreviewDeployments || branch == project.ProdBranch {
+			continue
+		}
+
+		switch event.GetAction() {
+		case "opened", "reopened":
+			s.createPreviewDeploymentInBackground(project, branch, &PreviewPullRequest{
+				Number: pr.GetNumber(),
+				URL:    pr.GetHTMLURL(),
+			})
+		case "closed":
+			depl, err := s.DB.FindPreviewDeploymentForBranch(ctx, project.ID, branch)
+			if err != nil {
+				if errors.Is(err, database.ErrNotFound) {
+					continue
+				}
+				return err
+			}
+
+			// Another pull request may have been opened for the branch since
+			if depl.PullRequestNumber != nil && *depl.PullRequestNumber != pr.GetNumber() {
+				continue
+			}
+
+			err = s.ClosePreviewDeployment(ctx, depl)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 func (s *Service) processGithubInstallationEvent(ctx context.Context, event *github.InstallationEvent) error {
 	switch event.GetAction() {
 	case "created", "unsuspend", "new_permissions_accepted":
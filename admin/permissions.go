@@ -8,6 +8,7 @@ import (
 )
 
 // OrganizationPermissionsForUser resolves organization permissions for a user.
+// The permissions are the union of the user's own role on the org and the roles of all the usergroups the user belongs to.
 func (s *Service) OrganizationPermissionsForUser(ctx context.Context, orgID, userID string) (*adminv1.OrganizationPermissions, error) {
 	roles, err := s.DB.ResolveOrganizationRolesForUser(ctx, userID, orgID)
 	if err != nil {
@@ -53,6 +54,7 @@ func (s *Service) OrganizationPermissionsForDeployment(ctx context.Context, orgI
 }
 
 // ProjectPermissionsForUser resolves project permissions for a user.
+// The permissions are the union of the user's own role on the project and the roles of all the usergroups the user belongs to.
 func (s *Service) ProjectPermissionsForUser(ctx context.Context, projectID, userID string, orgPerms *adminv1.OrganizationPermissions) (*adminv1.ProjectPermissions, error) {
 	// ManageProjects permission on the org gives full access to all projects in the org (only org admins have this)
 	if orgPerms.ManageProjects {
@@ -0,0 +1,200 @@
+package sso
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/crewjam/saml"
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/runtime/pkg/httputil"
+)
+
+// maxMetadataSize is the max size of IdP metadata fetched from a URL.
+const maxMetadataSize = 1 << 20 // 1 MB
+
+// SAML logs in users through a SAML 2.0 identity provider using SP-initiated login.
+// Requests use the HTTP-Redirect binding and responses are posted to the ACS URL.
+type SAML struct {
+	sp     *saml.ServiceProvider
+	config database.SSOProviderConfig
+}
+
+// NewSAML creates a SAML service provider for the identity provider described by cfg.
+// The IdP metadata is read from the config's IDPMetadataXML. Use FetchIDPMetadata to populate it from a URL.
+// The metadataURL is used as the service provider's entity ID and acsURL is the endpoint that receives responses.
+func NewSAML(cfg database.SSOProviderConfig, metadataURL, acsURL string) (*SAML, error) {
+	if cfg.IDPMetadataXML == "" {
+		return nil, errors.New("sso: an IdP metadata URL or XML document is required for SAML")
+	}
+	idp, err := parseIDPMetadata([]byte(cfg.IDPMetadataXML))
+	if err != nil {
+		return nil, err
+	}
+
+	mu, err := url.Parse(metadataURL)
+	if err != nil {
+		return nil, err
+	}
+	au, err := url.Parse(acsURL)
+	if err != nil {
+		return nil, err
+	}
+
+	sp := &saml.ServiceProvider{
+		EntityID:          metadataURL,
+		MetadataURL:       *mu,
+		AcsURL:            *au,
+		IDPMetadata:       idp,
+		AuthnNameIDFormat: saml.EmailAddressNameIDFormat,
+	}
+	if sp.GetSSOBindingLocation(saml.HTTPRedirectBinding) == "" {
+		return nil, errors.New("sso: the identity provider does not support the HTTP-Redirect binding")
+	}
+
+	return &SAML{sp: sp, config: cfg}, nil
+}
+
+// Metadata returns the service provider's metadata, which can be uploaded to the identity provider.
+func (s *SAML) Metadata() ([]byte, error) {
+	data, err := xml.MarshalIndent(s.sp.Metadata(), "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), data...), nil
+}
+
+// AuthnRequestURL returns the identity provider URL that starts a login.
+// It also returns the ID of the request, which must be stored by the caller and passed to ParseResponse.
+func (s *SAML) AuthnRequestURL(relayState string) (string, string, error) {
+	req, err := s.sp.MakeAuthenticationRequest(s.sp.GetSSOBindingLocation(saml.HTTPRedirectBinding), saml.HTTPRedirectBinding, saml.HTTPPostBinding)
+	if err != nil {
+		return "", "", err
+	}
+
+	u, err := req.Redirect(url.QueryEscape(relayState), s.sp)
+	if err != nil {
+		return "", "", err
+	}
+
+	return u.String(), req.ID, nil
+}
+
+// ParseResponse validates the response that the identity provider posted to the ACS URL and returns the identity it asserts.
+func (s *SAML) ParseResponse(r *http.Request, requestID string) (*Identity, error) {
+	if err := r.ParseForm(); err != nil {
+		return nil, err
+	}
+
+	assertion, err := s.sp.ParseResponse(r, []string{requestID})
+	if err != nil {
+		// The error of an invalid response is intentionally vague, so we unwrap the cause
+		var invalidErr *saml.InvalidResponseError
+		if errors.As(err, &invalidErr) && invalidErr.PrivateErr != nil {
+			return nil, fmt.Errorf("sso: invalid SAML response: %w", invalidErr.PrivateErr)
+		}
+		return nil, fmt.Errorf("sso: invalid SAML response: %w", err)
+	}
+
+	claims := map[string]any{}
+	for _, stmt := range assertion.AttributeStatements {
+		for _, attr := range stmt.Attributes {
+			vals := make([]string, 0, len(attr.Values))
+			for _, v := range attr.Values {
+				vals = append(vals, v.Value)
+			}
+			claims[attr.Name] = vals
+			if attr.FriendlyName != "" {
+				claims[attr.FriendlyName] = vals
+			}
+		}
+	}
+
+	emailClaim := valOrDefault(s.config.EmailClaim, defaultSAMLEmailClaim)
+	nameClaim := valOrDefault(s.config.NameClaim, defaultSAMLNameClaim)
+	groupsClaim := valOrDefault(s.config.GroupsClaim, defaultSAMLGroupsClaim)
+
+	// Fall back to the subject's name ID, which is usually the email address
+	if _, ok := claims[emailClaim]; !ok && assertion.Subject != nil && assertion.Subject.NameID != nil && strings.Contains(assertion.Subject.NameID.Value, "@") {
+		claims[emailClaim] = assertion.Subject.NameID.Value
+	}
+
+	ident, err := identityFromClaims(claims, emailClaim, nameClaim, groupsClaim)
+	if err != nil {
+		return nil, err
+	}
+	ident.EmailVerified = true
+
+	return ident, nil
+}
+
+// metadataClient fetches IdP metadata. The URL is provided by the org, so it only connects to public addresses.
+// It can be overridden in tests.
+var metadataClient = httputil.NewPublicClient(30 * time.Second)
+
+// FetchIDPMetadata fetches and validates the identity provider's metadata from a URL.
+// It should be called when the provider is configured and the result stored in the config's IDPMetadataXML,
+// so that the URL isn't requested on every login.
+func FetchIDPMetadata(ctx context.Context, metadataURL string) (string, error) {
+	u, err := url.Parse(metadataURL)
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") {
+		return "", fmt.Errorf("sso: invalid IdP metadata URL %q", metadataURL)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, metadataURL, http.NoBody)
+	if err != nil {
+		return "", err
+	}
+	res, err := metadataClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("sso: failed to fetch IdP metadata: %w", err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("sso: failed to fetch IdP metadata: unexpected status %d", res.StatusCode)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(res.Body, maxMetadataSize))
+	if err != nil {
+		return "", fmt.Errorf("sso: failed to fetch IdP metadata: %w", err)
+	}
+
+	_, err = parseIDPMetadata(data)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// parseIDPMetadata parses an EntityDescriptor, or the first identity provider in an EntitiesDescriptor.
+func parseIDPMetadata(data []byte) (*saml.EntityDescriptor, error) {
+	ed := &saml.EntityDescriptor{}
+	err := xml.Unmarshal(data, ed)
+	if err != nil {
+		eds := &saml.EntitiesDescriptor{}
+		if err2 := xml.Unmarshal(data, eds); err2 != nil {
+			return nil, fmt.Errorf("sso: invalid IdP metadata: %w", err)
+		}
+		ed = nil
+		for i := range eds.EntityDescriptors {
+			if len(eds.EntityDescriptors[i].IDPSSODescriptors) > 0 {
+				ed = &eds.EntityDescriptors[i]
+				break
+			}
+		}
+		if ed == nil {
+			return nil, errors.New("sso: invalid IdP metadata: no identity provider found")
+		}
+	}
+
+	if len(ed.IDPSSODescriptors) == 0 {
+		return nil, errors.New("sso: invalid IdP metadata: no IDPSSODescriptor found")
+	}
+	return ed, nil
+}
@@ -0,0 +1,140 @@
+// Package sso implements login through organization-managed identity providers using OIDC or SAML 2.0.
+package sso
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"slices"
+	"strings"
+
+	"github.com/rilldata/rill/admin/database"
+)
+
+// Identity is the user identity asserted by an identity provider after a successful login.
+type Identity struct {
+	Email         string
+	EmailVerified bool
+	Name          string
+	PhotoURL      string
+	Groups        []string
+}
+
+// Default names of claims or attributes used when the provider's config doesn't override them.
+const (
+	defaultOIDCEmailClaim  = "email"
+	defaultOIDCNameClaim   = "name"
+	defaultOIDCGroupsClaim = "groups"
+	defaultSAMLEmailClaim  = "http://schemas.xmlsoap.org/ws/2005/05/identity/claims/emailaddress"
+	defaultSAMLNameClaim   = "http://schemas.xmlsoap.org/ws/2005/05/identity/claims/name"
+	defaultSAMLGroupsClaim = "http://schemas.microsoft.com/ws/2008/06/identity/claims/groups"
+)
+
+// ErrDomainNotAllowed is returned when a provider asserts an email address outside of its configured domains.
+var ErrDomainNotAllowed = errors.New("sso: email domain is not managed by the identity provider")
+
+// EmailDomain returns the lowercase domain of an email address.
+func EmailDomain(email string) (string, error) {
+	addr, err := mail.ParseAddress(email)
+	if err != nil {
+		return "", fmt.Errorf("invalid email address %q", email)
+	}
+	_, domain, ok := strings.Cut(addr.Address, "@")
+	if !ok || domain == "" {
+		return "", fmt.Errorf("invalid email address %q", email)
+	}
+	return strings.ToLower(domain), nil
+}
+
+// CheckDomain returns ErrDomainNotAllowed if the identity's email is not in one of the provider's verified domains.
+// It must be checked before trusting an identity since a provider can assert any email address.
+func CheckDomain(p *database.SSOProvider, ident *Identity) error {
+	domain, err := EmailDomain(ident.Email)
+	if err != nil {
+		return err
+	}
+	for _, d := range p.VerifiedDomains {
+		if strings.EqualFold(d, domain) {
+			return nil
+		}
+	}
+	return ErrDomainNotAllowed
+}
+
+// lookupTXT resolves DNS TXT records. It can be overridden in tests.
+var lookupTXT = net.DefaultResolver.LookupTXT
+
+// DomainVerificationRecordName returns the name of the DNS TXT record that proves control of a domain.
+func DomainVerificationRecordName(domain string) string {
+	return "_rill-challenge." + domain
+}
+
+// DomainVerificationRecordValue returns the value of the DNS TXT record that proves control of a domain for a provider with the given verification token.
+func DomainVerificationRecordValue(token string) string {
+	return "rill-domain-verification=" + token
+}
+
+// VerifyDomain returns true if the domain has a DNS TXT record containing the verification token.
+// A missing record is not an error.
+func VerifyDomain(ctx context.Context, domain, token string) (bool, error) {
+	if token == "" {
+		return false, nil
+	}
+	records, err := lookupTXT(ctx, DomainVerificationRecordName(domain))
+	if err != nil {
+		var dnsErr *net.DNSError
+		if errors.As(err, &dnsErr) && dnsErr.IsNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+	return slices.Contains(records, DomainVerificationRecordValue(token)), nil
+}
+
+// identityFromClaims maps claims to an Identity. Values can be strings or lists of strings.
+func identityFromClaims(claims map[string]any, emailClaim, nameClaim, groupsClaim string) (*Identity, error) {
+	ident := &Identity{
+		Email:  firstString(claims[emailClaim]),
+		Name:   firstString(claims[nameClaim]),
+		Groups: stringValues(claims[groupsClaim]),
+	}
+	if ident.Email == "" {
+		return nil, fmt.Errorf("sso: claim %q not found", emailClaim)
+	}
+	if ident.Name == "" {
+		ident.Name, _, _ = strings.Cut(ident.Email, "@")
+	}
+	return ident, nil
+}
+
+func firstString(v any) string {
+	vals := stringValues(v)
+	if len(vals) == 0 {
+		return ""
+	}
+	return vals[0]
+}
+
+func stringValues(v any) []string {
+	switch v := v.(type) {
+	case string:
+		if v == "" {
+			return nil
+		}
+		return []string{v}
+	case []string:
+		return v
+	case []any:
+		res := make([]string, 0, len(v))
+		for _, e := range v {
+			if s, ok := e.(string); ok && s != "" {
+				res = append(res, s)
+			}
+		}
+		return res
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,342 @@
+package sso
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/xml"
+	"html"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/crewjam/saml"
+	"github.com/go-jose/go-jose/v3"
+	"github.com/go-jose/go-jose/v3/jwt"
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/runtime/pkg/httputil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckDomain(t *testing.T) {
+	p := &database.SSOProvider{Domains: []string{"example.com", "example.org"}, VerifiedDomains: []string{"example.com"}}
+	require.NoError(t, CheckDomain(p, &Identity{Email: "jane@Example.com"}))
+	require.ErrorIs(t, CheckDomain(p, &Identity{Email: "jane@example.org"}), ErrDomainNotAllowed)
+	require.ErrorIs(t, CheckDomain(p, &Identity{Email: "jane@evil.com"}), ErrDomainNotAllowed)
+	require.ErrorIs(t, CheckDomain(p, &Identity{Email: "jane@sub.example.com"}), ErrDomainNotAllowed)
+	require.Error(t, CheckDomain(p, &Identity{Email: "not-an-email"}))
+}
+
+func TestVerifyDomain(t *testing.T) {
+	defer func(fn func(context.Context, string) ([]string, error)) { lookupTXT = fn }(lookupTXT)
+	lookupTXT = func(ctx context.Context, name string) ([]string, error) {
+		switch name {
+		case "_rill-challenge.example.com":
+			return []string{"v=spf1 -all", "rill-domain-verification=abc"}, nil
+		case "_rill-challenge.example.org":
+			return nil, &net.DNSError{Err: "no such host", Name: name, IsNotFound: true}
+		default:
+			return nil, &net.DNSError{Err: "server misbehaving", Name: name, IsTemporary: true}
+		}
+	}
+
+	ok, err := VerifyDomain(context.Background(), "example.com", "abc")
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	ok, err = VerifyDomain(context.Background(), "example.com", "xyz")
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	ok, err = VerifyDomain(context.Background(), "example.org", "abc")
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	_, err = VerifyDomain(context.Background(), "example.net", "abc")
+	require.Error(t, err)
+}
+
+func TestIdentityFromClaims(t *testing.T) {
+	ident, err := identityFromClaims(map[string]any{
+		"mail":   []string{"jane@example.com"},
+		"groups": []any{"eng", "", "sales"},
+	}, "mail", "name", "groups")
+	require.NoError(t, err)
+	require.Equal(t, &Identity{Email: "jane@example.com", Name: "jane", Groups: []string{"eng", "sales"}}, ident)
+
+	_, err = identityFromClaims(map[string]any{"name": "Jane"}, "email", "name", "groups")
+	require.Error(t, err)
+
+	require.True(t, emailVerified(nil))
+	require.True(t, emailVerified("true"))
+	require.False(t, emailVerified(false))
+	require.False(t, emailVerified("nope"))
+}
+
+func TestOIDC(t *testing.T) {
+	idp := newMockOIDC(t, map[string]any{
+		"email":          "jane@example.com",
+		"email_verified": true,
+		"name":           "Jane Doe",
+		"roles":          []string{"eng"},
+	})
+
+	ctx := context.Background()
+	o, err := NewOIDC(ctx, database.SSOProviderConfig{
+		IssuerURL:    idp.URL,
+		ClientID:     "rill",
+		ClientSecret: "secret",
+		GroupsClaim:  "roles",
+	}, "https://admin.example.com/auth/sso/callback")
+	require.NoError(t, err)
+
+	// Log in at the IdP and extract the authorization code from the redirect to the callback
+	verifier := "verifier-0123456789-0123456789-0123456789"
+	code := idp.login(t, o.AuthCodeURL("state", "nonce", verifier, "jane@example.com"))
+
+	ident, err := o.Exchange(ctx, code, "nonce", verifier)
+	require.NoError(t, err)
+	require.Equal(t, &Identity{Email: "jane@example.com", EmailVerified: true, Name: "Jane Doe", Groups: []string{"eng"}}, ident)
+
+	// PKCE verifier must match
+	code = idp.login(t, o.AuthCodeURL("state", "nonce", verifier, ""))
+	_, err = o.Exchange(ctx, code, "nonce", "wrong-verifier-0123456789-0123456789")
+	require.Error(t, err)
+
+	// Nonce must match
+	code = idp.login(t, o.AuthCodeURL("state", "nonce", verifier, ""))
+	_, err = o.Exchange(ctx, code, "other", verifier)
+	require.Error(t, err)
+}
+
+func TestSAML(t *testing.T) {
+	idp := newMockSAML(t, &saml.Session{
+		ID:       "session",
+		NameID:   "jane@example.com",
+		UserName: "jane",
+		CustomAttributes: []saml.Attribute{
+			{Name: defaultSAMLNameClaim, Values: []saml.AttributeValue{{Type: "xs:string", Value: "Jane Doe"}}},
+			{Name: defaultSAMLGroupsClaim, Values: []saml.AttributeValue{{Type: "xs:string", Value: "eng"}, {Type: "xs:string", Value: "sales"}}},
+		},
+	})
+
+	// The default client doesn't connect to the mock IdP on a loopback address
+	ctx := context.Background()
+	_, err := FetchIDPMetadata(ctx, idp.metadataURL)
+	require.ErrorIs(t, err, httputil.ErrNonPublicAddress)
+	_, err = FetchIDPMetadata(ctx, "file:///etc/passwd")
+	require.Error(t, err)
+
+	defer func(c *http.Client) { metadataClient = c }(metadataClient)
+	metadataClient = http.DefaultClient
+	metadataXML, err := FetchIDPMetadata(ctx, idp.metadataURL)
+	require.NoError(t, err)
+
+	s, err := NewSAML(database.SSOProviderConfig{IDPMetadataURL: idp.metadataURL, IDPMetadataXML: metadataXML}, "https://admin.example.com/auth/saml/p1/metadata", "https://admin.example.com/auth/saml/p1/acs")
+	require.NoError(t, err)
+	idp.sp = s
+
+	md, err := s.Metadata()
+	require.NoError(t, err)
+	require.Contains(t, string(md), "https://admin.example.com/auth/saml/p1/acs")
+
+	authURL, requestID, err := s.AuthnRequestURL("")
+	require.NoError(t, err)
+
+	// The email falls back to the name ID since the default email attribute is not set
+	ident, err := s.ParseResponse(idp.login(t, authURL), requestID)
+	require.NoError(t, err)
+	require.Equal(t, &Identity{Email: "jane@example.com", EmailVerified: true, Name: "Jane Doe", Groups: []string{"eng", "sales"}}, ident)
+
+	// The response must be for the request we made
+	authURL, _, err = s.AuthnRequestURL("")
+	require.NoError(t, err)
+	_, err = s.ParseResponse(idp.login(t, authURL), "id-other")
+	require.Error(t, err)
+
+	// Metadata can also be passed inline, but the URL is never fetched when creating the provider
+	_, err = NewSAML(database.SSOProviderConfig{IDPMetadataXML: idp.metadataXML}, "https://admin.example.com/auth/saml/p1/metadata", "https://admin.example.com/auth/saml/p1/acs")
+	require.NoError(t, err)
+	_, err = NewSAML(database.SSOProviderConfig{IDPMetadataXML: "<foo/>"}, "https://admin.example.com/auth/saml/p1/metadata", "https://admin.example.com/auth/saml/p1/acs")
+	require.Error(t, err)
+	_, err = NewSAML(database.SSOProviderConfig{IDPMetadataURL: idp.metadataURL}, "https://admin.example.com/auth/saml/p1/metadata", "https://admin.example.com/auth/saml/p1/acs")
+	require.Error(t, err)
+}
+
+// mockOIDC is a minimal OIDC identity provider that logs in a fixed user.
+type mockOIDC struct {
+	*httptest.Server
+	key    *rsa.PrivateKey
+	claims map[string]any
+	mu     sync.Mutex
+	codes  map[string]url.Values
+}
+
+func newMockOIDC(t *testing.T, claims map[string]any) *mockOIDC {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	m := &mockOIDC{key: key, claims: claims, codes: map[string]url.Values{}}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, map[string]any{
+			"issuer":                                m.URL,
+			"authorization_endpoint":                m.URL + "/authorize",
+			"token_endpoint":                        m.URL + "/token",
+			"jwks_uri":                              m.URL + "/jwks",
+			"id_token_signing_alg_values_supported": []string{"RS256"},
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, jose.JSONWebKeySet{Keys: []jose.JSONWebKey{{Key: &m.key.PublicKey, KeyID: "k1", Algorithm: "RS256", Use: "sig"}}})
+	})
+	mux.HandleFunc("/authorize", func(w http.ResponseWriter, r *http.Request) {
+		code := base64.RawURLEncoding.EncodeToString([]byte(time.Now().String()))
+		m.mu.Lock()
+		m.codes[code] = r.URL.Query()
+		m.mu.Unlock()
+		http.Redirect(w, r, r.URL.Query().Get("redirect_uri")+"?code="+code+"&state="+r.URL.Query().Get("state"), http.StatusFound)
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		m.mu.Lock()
+		authReq, ok := m.codes[r.Form.Get("code")]
+		delete(m.codes, r.Form.Get("code"))
+		m.mu.Unlock()
+
+		challenge := sha256.Sum256([]byte(r.Form.Get("code_verifier")))
+		if !ok || authReq.Get("code_challenge") != base64.RawURLEncoding.EncodeToString(challenge[:]) {
+			w.WriteHeader(http.StatusBadRequest)
+			writeJSON(w, map[string]any{"error": "invalid_grant"})
+			return
+		}
+
+		claims := map[string]any{
+			"iss":   m.URL,
+			"sub":   "user-1",
+			"aud":   authReq.Get("client_id"),
+			"exp":   time.Now().Add(time.Hour).Unix(),
+			"iat":   time.Now().Unix(),
+			"nonce": authReq.Get("nonce"),
+		}
+		for k, v := range m.claims {
+			claims[k] = v
+		}
+
+		signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.RS256, Key: m.key}, (&jose.SignerOptions{}).WithType("JWT").WithHeader("kid", "k1"))
+		require.NoError(t, err)
+		idToken, err := jwt.Signed(signer).Claims(claims).CompactSerialize()
+		require.NoError(t, err)
+
+		writeJSON(w, map[string]any{"access_token": "access", "token_type": "Bearer", "expires_in": 3600, "id_token": idToken})
+	})
+	m.Server = httptest.NewServer(mux)
+	t.Cleanup(m.Close)
+
+	return m
+}
+
+// login follows the authorization URL and returns the code passed to the redirect URL.
+func (m *mockOIDC) login(t *testing.T, authURL string) string {
+	client := &http.Client{CheckRedirect: func(req *http.Request, via []*http.Request) error { return http.ErrUseLastResponse }}
+	res, err := client.Get(authURL)
+	require.NoError(t, err)
+	res.Body.Close()
+	require.Equal(t, http.StatusFound, res.StatusCode)
+
+	loc, err := url.Parse(res.Header.Get("Location"))
+	require.NoError(t, err)
+	require.Equal(t, "state", loc.Query().Get("state"))
+	return loc.Query().Get("code")
+}
+
+// mockSAML is a SAML identity provider that logs in a fixed user.
+type mockSAML struct {
+	idp         *saml.IdentityProvider
+	sp          *SAML
+	metadataURL string
+	metadataXML string
+}
+
+func newMockSAML(t *testing.T, session *saml.Session) *mockSAML {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "mock-idp"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	m := &mockSAML{}
+	mux := http.NewServeMux()
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	metadataURL, _ := url.Parse(srv.URL + "/metadata")
+	ssoURL, _ := url.Parse(srv.URL + "/sso")
+	m.idp = &saml.IdentityProvider{
+		Key:                     key,
+		Certificate:             cert,
+		MetadataURL:             *metadataURL,
+		SSOURL:                  *ssoURL,
+		SessionProvider:         sessionProviderFunc(func(w http.ResponseWriter, r *http.Request, req *saml.IdpAuthnRequest) *saml.Session { return session }),
+		ServiceProviderProvider: serviceProviderFunc(func(r *http.Request, id string) (*saml.EntityDescriptor, error) { return m.sp.sp.Metadata(), nil }),
+	}
+	mux.HandleFunc("/metadata", m.idp.ServeMetadata)
+
+	md, err := xml.Marshal(m.idp.Metadata())
+	require.NoError(t, err)
+	m.metadataURL = metadataURL.String()
+	m.metadataXML = string(md)
+
+	return m
+}
+
+// login follows the authentication request URL and returns the request the user's browser would post to the ACS URL.
+func (m *mockSAML) login(t *testing.T, authURL string) *http.Request {
+	w := httptest.NewRecorder()
+	m.idp.ServeSSO(w, httptest.NewRequest(http.MethodGet, authURL, http.NoBody))
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+	match := regexp.MustCompile(`name="SAMLResponse" value="([^"]+)"`).FindStringSubmatch(w.Body.String())
+	require.Len(t, match, 2)
+
+	form := url.Values{"SAMLResponse": {html.UnescapeString(match[1])}}
+	r := httptest.NewRequest(http.MethodPost, "https://admin.example.com/auth/saml/p1/acs", strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	return r
+}
+
+type sessionProviderFunc func(w http.ResponseWriter, r *http.Request, req *saml.IdpAuthnRequest) *saml.Session
+
+func (f sessionProviderFunc) GetSession(w http.ResponseWriter, r *http.Request, req *saml.IdpAuthnRequest) *saml.Session {
+	return f(w, r, req)
+}
+
+type serviceProviderFunc func(r *http.Request, id string) (*saml.EntityDescriptor, error)
+
+func (f serviceProviderFunc) GetServiceProvider(r *http.Request, id string) (*saml.EntityDescriptor, error) {
+	return f(r, id)
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
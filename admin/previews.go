@@ -0,0 +1,196 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"go.uber.org/zap"
+)
+
+const (
+	// previewDeploymentTimeout is the maximum time for provisioning a preview deployment in the background.
+	previewDeploymentTimeout = 15 * time.Minute
+	// previewDeploymentUnusedTTL is the time after which an unused preview deployment is torn down, even if its pull request is still open.
+	previewDeploymentUnusedTTL = 7 * 24 * time.Hour
+	// previewDeploymentDefaultSlots is the number of slots for preview deployments of projects that don't use DuckDB in prod.
+	previewDeploymentDefaultSlots = 2
+)
+
+// PreviewPullRequest identifies the pull request that a preview deployment was created for.
+type PreviewPullRequest struct {
+	Number int
+	URL    string
+}
+
+// CreateOrUpdatePreviewDeployment ensures the project has a preview deployment of the given branch.
+// If the branch already has a preview deployment, it is re-opened if it was closed and a reconcile is triggered.
+// The pull request is optional and is nil for pushes to branches without a pull request.
+//
+// Preview deployments run unreviewed code, so they are isolated from prod: they always use their own DuckDB OLAP (never the prod OLAP DSN),
+// and they only get the variables of the preview environment (not the prod variables).
+func (s *Service) CreateOrUpdatePreviewDeployment(ctx context.Context, proj *database.Project, branch string, pr *PreviewPullRequest) (*database.Deployment, error) {
+	if branch == proj.ProdBranch {
+		return nil, fmt.Errorf("cannot create a preview deployment of the prod branch %q", branch)
+	}
+
+	depl, err := s.DB.FindPreviewDeploymentForBranch(ctx, proj.ID, branch)
+	if err == nil {
+		return s.updatePreviewDeployment(ctx, depl, pr)
+	}
+	if !errors.Is(err, database.ErrNotFound) {
+		return nil, err
+	}
+
+	org, err := s.DB.FindOrganization(ctx, proj.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	slots := proj.ProdSlots
+	if slots == 0 {
+		slots = previewDeploymentDefaultSlots
+	}
+
+	// Preview deployments count towards the org's deployment quotas
+	stats, err := s.DB.CountDeploymentsForOrganization(ctx, org.ID)
+	if err != nil {
+		return nil, err
+	}
+	if org.QuotaDeployments >= 0 && stats.Deployments >= org.QuotaDeployments {
+		return nil, fmt.Errorf("quota exceeded: org %q is limited to %d deployments", org.Name, org.QuotaDeployments)
+	}
+	if org.QuotaSlotsTotal >= 0 && stats.Slots+slots > org.QuotaSlotsTotal {
+		return nil, fmt.Errorf("quota exceeded: org %q is limited to %d total slots", org.Name, org.QuotaSlotsTotal)
+	}
+
+	opts := &createDeploymentOptions{
+		ProjectID:      proj.ID,
+		Provisioner:    proj.Provisioner,
+		Annotations:    s.NewDeploymentAnnotations(org, proj),
+		ProdVersion:    proj.ProdVersion,
+		ProdBranch:     branch,
+		ProdVariables:  previewVariables(proj),
+		ProdOLAPDriver: "duckdb",
+		ProdSlots:      slots,
+		Environment:    previewEnvironment,
+		Preview:        true,
+	}
+	if pr != nil {
+		opts.PullRequestNumber = &pr.Number
+		opts.PullRequestURL = pr.URL
+	}
+
+	depl, err = s.createDeployment(ctx, opts)
+	if err != nil {
+		// The branch's push and pull request events usually arrive together, so another call may have created the preview deployment concurrently
+		if errors.Is(err, database.ErrNotUnique) {
+			depl, err2 := s.DB.FindPreviewDeploymentForBranch(ctx, proj.ID, branch)
+			if err2 == nil {
+				return s.updatePreviewDeployment(ctx, depl, pr)
+			}
+		}
+		return nil, err
+	}
+
+	s.Logger.Info("created preview deployment", zap.String("project_id", proj.ID), zap.String("deployment_id", depl.ID), zap.String("branch", branch), observability.ZapCtx(ctx))
+
+	return depl, nil
+}
+
+// updatePreviewDeployment re-opens an existing preview deployment if it was closed, tracks the latest pull request for its branch and triggers a reconcile.
+func (s *Service) updatePreviewDeployment(ctx context.Context, depl *database.Deployment, pr *PreviewPullRequest) (*database.Deployment, error) {
+	opts := &database.UpdatePreviewDeploymentOptions{
+		PullRequestNumber: depl.PullRequestNumber,
+		PullRequestURL:    depl.PullRequestURL,
+		ClosedOn:          nil,
+	}
+	if pr != nil {
+		opts.PullRequestNumber = &pr.Number
+		opts.PullRequestURL = pr.URL
+	}
+
+	var err error
+	if depl.ClosedOn != nil || !equalPtr(depl.PullRequestNumber, opts.PullRequestNumber) {
+		depl, err = s.DB.UpdatePreviewDeployment(ctx, depl.ID, opts)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = s.TriggerReconcile(ctx, depl)
+	if err != nil {
+		return nil, err
+	}
+	return depl, nil
+}
+
+// ClosePreviewDeployment marks a preview deployment as closed, e.g. when its pull request is closed or its branch is deleted.
+// Closed preview deployments are torn down by DeleteExpiredPreviewDeployments.
+func (s *Service) ClosePreviewDeployment(ctx context.Context, depl *database.Deployment) error {
+	if depl.ClosedOn != nil {
+		return nil
+	}
+
+	now := time.Now()
+	_, err := s.DB.UpdatePreviewDeployment(ctx, depl.ID, &database.UpdatePreviewDeploymentOptions{
+		PullRequestNumber: depl.PullRequestNumber,
+		PullRequestURL:    depl.PullRequestURL,
+		ClosedOn:          &now,
+	})
+	if err != nil {
+		return err
+	}
+
+	s.Logger.Info("closed preview deployment", zap.String("project_id", depl.ProjectID), zap.String("deployment_id", depl.ID), zap.String("branch", depl.Branch), observability.ZapCtx(ctx))
+	return nil
+}
+
+// DeleteExpiredPreviewDeployments tears down preview deployments that have been closed or have not been used for a while.
+func (s *Service) DeleteExpiredPreviewDeployments(ctx context.Context) error {
+	depls, err := s.DB.FindExpiredPreviewDeployments(ctx, previewDeploymentUnusedTTL)
+	if err != nil {
+		return err
+	}
+
+	if len(depls) == 0 {
+		return nil
+	}
+
+	s.Logger.Info("delete expired preview deployments: starting", zap.Int("deployments", len(depls)))
+
+	for _, depl := range depls {
+		s.Logger.Info("delete expired preview deployments: deleting deployment", zap.String("project_id", depl.ProjectID), zap.String("deployment_id", depl.ID), zap.String("branch", depl.Branch), zap.Bool("closed", depl.ClosedOn != nil))
+
+		err = s.teardownDeployment(ctx, depl)
+		if err != nil {
+			s.Logger.Error("delete expired preview deployments: teardown deployment error", zap.String("project_id", depl.ProjectID), zap.String("deployment_id", depl.ID), zap.Error(err), observability.ZapCtx(ctx))
+			continue
+		}
+	}
+
+	s.Logger.Info("delete expired preview deployments: completed", zap.Int("deployments", len(depls)))
+
+	return nil
+}
+
+// createPreviewDeploymentInBackground creates or updates a preview deployment without blocking the caller, since provisioning a deployment can take a while.
+func (s *Service) createPreviewDeploymentInBackground(proj *database.Project, branch string, pr *PreviewPullRequest) {
+	s.runInBackground("create_preview_deployment", previewDeploymentTimeout, func(ctx context.Context) error {
+		_, err := s.CreateOrUpdatePreviewDeployment(ctx, proj, branch, pr)
+		if err != nil {
+			return fmt.Errorf("failed to create or update preview deployment of branch %q of project %q: %w", branch, proj.ID, err)
+		}
+		return nil
+	})
+}
+
+func equalPtr[T comparable](a, b *T) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
@@ -8,9 +8,11 @@ import (
 
 	"github.com/rilldata/rill/admin/database"
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
 	"github.com/rilldata/rill/runtime/pkg/observability"
 	"go.uber.org/multierr"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // TODO: The functions in this file are not truly fault tolerant. They should be refactored to run as idempotent, retryable background tasks.
@@ -71,10 +73,11 @@ func (s *Service) CreateProject(ctx context.Context, org *database.Organization,
 		Provisioner:    proj.Provisioner,
 		Annotations:    s.NewDeploymentAnnotations(org, proj),
 		ProdBranch:     proj.ProdBranch,
-		ProdVariables:  proj.ProdVariables,
+		ProdVariables:  ResolveVariables(proj, ProdEnvironment),
 		ProdOLAPDriver: proj.ProdOLAPDriver,
 		ProdOLAPDSN:    proj.ProdOLAPDSN,
 		ProdSlots:      proj.ProdSlots,
+		Environment:    ProdEnvironment,
 		ProdVersion:    proj.ProdVersion,
 	})
 	if err != nil {
@@ -96,6 +99,8 @@ func (s *Service) CreateProject(ctx context.Context, org *database.Organization,
 		ProdSlots:            proj.ProdSlots,
 		ProdTTLSeconds:       proj.ProdTTLSeconds,
 		ProdDeploymentID:     &depl.ID,
+		PreviewDeployments:   proj.PreviewDeployments,
+		EnvironmentVariables: proj.EnvironmentVariables,
 		Annotations:          proj.Annotations,
 	})
 	if err != nil {
@@ -142,6 +147,7 @@ func (s *Service) UpdateProject(ctx context.Context, proj *database.Project, opt
 		(proj.ProdBranch != opts.ProdBranch) ||
 		!reflect.DeepEqual(proj.Annotations, opts.Annotations) ||
 		!reflect.DeepEqual(proj.ProdVariables, opts.ProdVariables) ||
+		!reflect.DeepEqual(proj.EnvironmentVariables, opts.EnvironmentVariables) ||
 		!reflect.DeepEqual(proj.GithubURL, opts.GithubURL) ||
 		!reflect.DeepEqual(proj.GithubInstallationID, opts.GithubInstallationID))
 
@@ -165,7 +171,11 @@ func (s *Service) UpdateProject(ctx context.Context, proj *database.Project, opt
 			}
 		}
 
-		return s.TriggerRedeploy(ctx, proj, oldDepl)
+		// Preview deployments are short-lived, so we don't redeploy them. They're updated with the new settings that don't require a reset.
+		proj, err = s.TriggerRedeploy(ctx, proj, oldDepl)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	s.Logger.Info("update project: updating deployments", observability.ZapCtx(ctx))
@@ -176,18 +186,27 @@ func (s *Service) UpdateProject(ctx context.Context, proj *database.Project, opt
 	}
 	annotations := s.NewDeploymentAnnotations(org, proj)
 
-	ds, err := s.DB.FindDeploymentsForProject(ctx, proj.ID)
+	var ds []*database.Deployment
+	if requiresReset {
+		ds, err = s.DB.FindPreviewDeploymentsForProject(ctx, proj.ID)
+	} else {
+		ds, err = s.DB.FindDeploymentsForProject(ctx, proj.ID)
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	// NOTE: This assumes every deployment (almost always, there's just one) deploys the prod branch.
-	// It needs to be refactored when implementing preview deploys.
+	// The prod deployment deploys the prod branch, while preview deployments keep deploying their own branch.
 	for _, d := range ds {
+		branch := opts.ProdBranch
+		if d.Preview {
+			branch = d.Branch
+		}
+
 		err := s.UpdateDeployment(ctx, d, &UpdateDeploymentOptions{
 			Version:         opts.ProdVersion,
-			Branch:          opts.ProdBranch,
-			Variables:       opts.ProdVariables,
+			Branch:          branch,
+			Variables:       s.DeploymentVariables(proj, d),
 			Annotations:     annotations,
 			EvictCachedRepo: true,
 		})
@@ -219,9 +238,14 @@ func (s *Service) UpdateOrgDeploymentAnnotations(ctx context.Context, org *datab
 			}
 
 			for _, d := range ds {
+				branch := proj.ProdBranch
+				if d.Preview {
+					branch = d.Branch
+				}
+
 				err := s.UpdateDeployment(ctx, d, &UpdateDeploymentOptions{
-					Branch:          proj.ProdBranch,
-					Variables:       proj.ProdVariables,
+					Branch:          branch,
+					Variables:       s.DeploymentVariables(proj, d),
 					Annotations:     s.NewDeploymentAnnotations(org, proj),
 					EvictCachedRepo: false,
 				})
@@ -255,10 +279,11 @@ func (s *Service) TriggerRedeploy(ctx context.Context, proj *database.Project, p
 		Annotations:    s.NewDeploymentAnnotations(org, proj),
 		ProdVersion:    proj.ProdVersion,
 		ProdBranch:     proj.ProdBranch,
-		ProdVariables:  proj.ProdVariables,
+		ProdVariables:  ResolveVariables(proj, ProdEnvironment),
 		ProdOLAPDriver: proj.ProdOLAPDriver,
 		ProdOLAPDSN:    proj.ProdOLAPDSN,
 		ProdSlots:      proj.ProdSlots,
+		Environment:    ProdEnvironment,
 	})
 	if err != nil {
 		return nil, err
@@ -278,6 +303,8 @@ func (s *Service) TriggerRedeploy(ctx context.Context, proj *database.Project, p
 		ProdDeploymentID:     &newDepl.ID,
 		ProdSlots:            proj.ProdSlots,
 		ProdTTLSeconds:       proj.ProdTTLSeconds,
+		PreviewDeployments:   proj.PreviewDeployments,
+		EnvironmentVariables: proj.EnvironmentVariables,
 		Annotations:          proj.Annotations,
 	})
 	if err != nil {
@@ -353,3 +380,54 @@ func (s *Service) TriggerRefreshSources(ctx context.Context, depl *database.Depl
 	})
 	return err
 }
+
+// TriggerRefreshReportsAndAlerts triggers execution of a deployment's reports and alerts.
+// If from and to are set, it backfills them over the time range (from, to] instead of running them for the current time.
+func (s *Service) TriggerRefreshReportsAndAlerts(ctx context.Context, depl *database.Deployment, reports, alerts []string, from, to *timestamppb.Timestamp) (err error) {
+	s.Logger.Info("reconcile: triggering refresh of reports and alerts", zap.String("deployment_id", depl.ID), zap.Bool("backfill", from != nil), observability.ZapCtx(ctx))
+	defer func() {
+		if err != nil {
+			s.Logger.Error("reconcile: trigger refresh of reports and alerts failed", zap.String("deployment_id", depl.ID), zap.Error(err), observability.ZapCtx(ctx))
+		} else {
+			s.Logger.Info("reconcile: trigger refresh of reports and alerts completed", zap.String("deployment_id", depl.ID), observability.ZapCtx(ctx))
+		}
+	}()
+
+	if (from == nil) != (to == nil) {
+		return fmt.Errorf("both from and to must be set for a backfill")
+	}
+
+	names := make([]*runtimev1.ResourceName, 0, len(reports)+len(alerts))
+	for _, report := range reports {
+		names = append(names, &runtimev1.ResourceName{Kind: runtime.ResourceKindReport, Name: report})
+	}
+	for _, alert := range alerts {
+		names = append(names, &runtimev1.ResourceName{Kind: runtime.ResourceKindAlert, Name: alert})
+	}
+	if len(names) == 0 {
+		return fmt.Errorf("no reports or alerts to refresh")
+	}
+
+	spec := &runtimev1.RefreshTriggerSpec{}
+	if from == nil {
+		spec.OnlyNames = names
+	} else {
+		for _, n := range names {
+			spec.Backfills = append(spec.Backfills, &runtimev1.RefreshBackfillTrigger{Name: n, From: from, To: to})
+		}
+	}
+
+	rt, err := s.openRuntimeClientForDeployment(depl)
+	if err != nil {
+		return err
+	}
+	defer rt.Close()
+
+	_, err = rt.CreateTrigger(ctx, &runtimev1.CreateTriggerRequest{
+		InstanceId: depl.RuntimeInstanceID,
+		Trigger: &runtimev1.CreateTriggerRequest_RefreshTriggerSpec{
+			RefreshTriggerSpec: spec,
+		},
+	})
+	return err
+}
@@ -0,0 +1,441 @@
+package provisioner
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/c2h5oh/datasize"
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/api/types/mount"
+	"github.com/docker/docker/api/types/network"
+	"github.com/docker/docker/api/types/volume"
+	"github.com/docker/docker/client"
+	retryablehttp "github.com/hashicorp/go-retryablehttp"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+const (
+	dockerLabelInstance  = "app.rilldata.com/instance"
+	dockerLabelManagedBy = "app.rilldata.com/managed-by"
+	dockerLabelSlots     = "app.rilldata.com/slots"
+	dockerManagedBy      = "rill-cloud-admin"
+)
+
+// dockerReservedEnv are env vars that the provisioner sets for each runtime container.
+var dockerReservedEnv = map[string]bool{
+	"RILL_RUNTIME_HTTP_PORT":         true,
+	"RILL_RUNTIME_GRPC_PORT":         true,
+	"RILL_RUNTIME_DATA_DIR":          true,
+	"RILL_RUNTIME_AUTH_AUDIENCE_URL": true,
+}
+
+type DockerSpec struct {
+	DockerHost     string            `json:"docker_host"`
+	Host           string            `json:"host"`
+	Image          string            `json:"image"`
+	Network        string            `json:"network"`
+	DataDir        string            `json:"data_dir"`
+	User           string            `json:"user"`
+	Env            map[string]string `json:"env"`
+	Labels         map[string]string `json:"labels"`
+	MaxSlots       int               `json:"max_slots"`
+	TimeoutSeconds int               `json:"timeout_seconds"`
+}
+
+// DockerProvisioner provisions a dedicated runtime container for each deployment using the Docker Engine API.
+// It is intended for self-hosted setups on a single machine, where a reverse proxy (configured through container labels) routes requests for each runtime's host to its container.
+type DockerProvisioner struct {
+	Spec   *DockerSpec
+	client *client.Client
+	logger *zap.Logger
+}
+
+func NewDocker(spec json.RawMessage, logger *zap.Logger) (*DockerProvisioner, error) {
+	// Parse the Docker provisioner spec
+	dsp := &DockerSpec{}
+	err := json.Unmarshal(spec, dsp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse docker provisioner spec: %w", err)
+	}
+
+	if dsp.Image == "" {
+		return nil, fmt.Errorf("docker provisioner spec: image is required")
+	}
+	if !strings.Contains(dsp.Host, "*") {
+		return nil, fmt.Errorf("docker provisioner spec: host must contain a wildcard '*' to give each runtime a unique host")
+	}
+	if dsp.DockerHost == "" {
+		dsp.DockerHost = "unix:///var/run/docker.sock"
+	}
+	if dsp.DataDir == "" {
+		dsp.DataDir = "/data"
+	}
+	if dsp.TimeoutSeconds == 0 {
+		dsp.TimeoutSeconds = 60
+	}
+
+	// The provisioner sets the reserved env vars for each runtime, so they can't be configured
+	for k := range dsp.Env {
+		if dockerReservedEnv[k] {
+			logger.Warn("docker provisioner: ignoring reserved env var in spec", zap.String("key", k))
+			delete(dsp.Env, k)
+		}
+	}
+
+	// Create the client for the Docker Engine API. It doesn't connect until the first request.
+	c, err := client.NewClientWithOpts(client.WithHost(dsp.DockerHost), client.WithAPIVersionNegotiation())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create docker client: %w", err)
+	}
+
+	return &DockerProvisioner{
+		Spec:   dsp,
+		client: c,
+		logger: logger,
+	}, nil
+}
+
+func (p *DockerProvisioner) Provision(ctx context.Context, opts *ProvisionOptions) (*Allocation, error) {
+	// We start by deprovisioning any previous attempt, we do this as a simple way to achieve idempotency
+	err := p.Deprovision(ctx, opts.ProvisionID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check there's capacity left on the machine
+	if p.Spec.MaxSlots > 0 {
+		slotsUsed, _, err := p.slotsUsed(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if slotsUsed+opts.Slots > p.Spec.MaxSlots {
+			return nil, fmt.Errorf("docker provisioner has insufficient available slots (used %d of %d, requested %d)", slotsUsed, p.Spec.MaxSlots, opts.Slots)
+		}
+	}
+
+	name := p.getResourceName(opts.ProvisionID)
+	host := p.getHost(opts.ProvisionID)
+	image := fmt.Sprintf("%s:%s", p.Spec.Image, opts.RuntimeVersion)
+	cpu := 1 * opts.Slots
+	memoryGB := 2 * opts.Slots
+	storageBytes := 40 * int64(opts.Slots) * int64(datasize.GB)
+
+	err = p.pullImage(ctx, image)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create the volume for data storage. Local volumes can't be size limited, so the storage allocation is not enforced.
+	_, err = p.client.VolumeCreate(ctx, volume.CreateOptions{
+		Name:   name,
+		Labels: p.getLabels(opts.ProvisionID, opts.Slots),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("docker provisioner create volume error: %w", err)
+	}
+
+	// Create and start the runtime container
+	cfg := &container.Config{
+		Image:  image,
+		Cmd:    []string{"runtime", "start"},
+		Env:    p.getEnv(host),
+		User:   p.Spec.User,
+		Labels: p.getLabels(opts.ProvisionID, opts.Slots),
+	}
+	hostCfg := &container.HostConfig{
+		Mounts: []mount.Mount{{
+			Type:   mount.TypeVolume,
+			Source: name,
+			Target: p.Spec.DataDir,
+		}},
+		Resources: container.Resources{
+			NanoCPUs: int64(cpu) * 1e9,
+			Memory:   int64(memoryGB) * int64(datasize.GB),
+		},
+		RestartPolicy: container.RestartPolicy{Name: container.RestartPolicyUnlessStopped},
+	}
+	var netCfg *network.NetworkingConfig
+	if p.Spec.Network != "" {
+		netCfg = &network.NetworkingConfig{
+			EndpointsConfig: map[string]*network.EndpointSettings{p.Spec.Network: {}},
+		}
+	}
+
+	err = p.createAndStart(ctx, name, cfg, hostCfg, netCfg)
+	if err != nil {
+		err2 := p.Deprovision(ctx, opts.ProvisionID)
+		return nil, multierr.Combine(err, err2)
+	}
+
+	return &Allocation{
+		Host:         host,
+		Audience:     host,
+		CPU:          cpu,
+		MemoryGB:     memoryGB,
+		StorageBytes: storageBytes,
+	}, nil
+}
+
+func (p *DockerProvisioner) Deprovision(ctx context.Context, provisionID string) error {
+	name := p.getResourceName(provisionID)
+
+	// Remove the containers (including a new container left over by a failed update) before the volume, since a volume can't be removed while it's in use
+	err1 := p.client.ContainerRemove(ctx, name, container.RemoveOptions{Force: true})
+	err2 := p.client.ContainerRemove(ctx, p.getNextResourceName(provisionID), container.RemoveOptions{Force: true})
+	err3 := p.client.VolumeRemove(ctx, name, true)
+
+	// We ignore not found errors for idempotency
+	errs := []error{err1, err2, err3}
+	for i := 0; i < len(errs); i++ {
+		if client.IsErrNotFound(errs[i]) {
+			errs[i] = nil
+		}
+	}
+
+	// This returns 'nil' if all errors are 'nil'
+	return multierr.Combine(errs...)
+}
+
+func (p *DockerProvisioner) AwaitReady(ctx context.Context, provisionID string) error {
+	name := p.getResourceName(provisionID)
+
+	// Wait for the container to be running (with timeout)
+	err := wait.PollUntilContextTimeout(ctx, time.Second, time.Duration(p.Spec.TimeoutSeconds)*time.Second, true, func(ctx context.Context) (done bool, err error) {
+		c, err := p.client.ContainerInspect(ctx, name)
+		if err != nil {
+			return false, nil
+		}
+		if c.State == nil {
+			return false, nil
+		}
+		if c.State.Status == "exited" || c.State.Status == "dead" {
+			return false, fmt.Errorf("runtime container %q stopped with exit code %d: %s", name, c.State.ExitCode, c.State.Error)
+		}
+		if c.State.Health != nil && c.State.Health.Status != types.Healthy {
+			return false, nil
+		}
+		return c.State.Running, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// As a final step we make sure the runtime can be reached through the host, we retry on failure, to account for the reverse proxy picking up the new container
+	retryClient := retryablehttp.NewClient()
+	retryClient.RetryMax = 5
+	retryClient.RetryWaitMin = 2 * time.Second
+	retryClient.RetryWaitMax = 10 * time.Second
+	retryClient.Logger = nil // Disable inbuilt logger
+	pingURL, err := url.JoinPath(p.getHost(provisionID), "/v1/ping")
+	if err != nil {
+		return err
+	}
+	req, err := retryablehttp.NewRequestWithContext(ctx, http.MethodGet, pingURL, http.NoBody)
+	if err != nil {
+		return err
+	}
+	resp, err := retryClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+// Update recreates the runtime container with a new image version.
+// The new container is created under a temporary name and only swapped in once it has started, so a failed update leaves the current container running.
+// The containers share the data volume, so the current container is stopped before the new container is started.
+func (p *DockerProvisioner) Update(ctx context.Context, provisionID, newVersion string) error {
+	name := p.getResourceName(provisionID)
+	nextName := p.getNextResourceName(provisionID)
+
+	// Retrieve the current container, which we recreate with the same configuration and volume
+	c, err := p.client.ContainerInspect(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	image := fmt.Sprintf("%s:%s", p.Spec.Image, newVersion)
+	err = p.pullImage(ctx, image)
+	if err != nil {
+		return err
+	}
+
+	var netCfg *network.NetworkingConfig
+	if c.NetworkSettings != nil && p.Spec.Network != "" {
+		if _, ok := c.NetworkSettings.Networks[p.Spec.Network]; ok {
+			netCfg = &network.NetworkingConfig{
+				EndpointsConfig: map[string]*network.EndpointSettings{p.Spec.Network: {}},
+			}
+		}
+	}
+
+	// Remove the leftover of a previous failed update
+	err = p.client.ContainerRemove(ctx, nextName, container.RemoveOptions{Force: true})
+	if err != nil && !client.IsErrNotFound(err) {
+		return err
+	}
+
+	cfg := c.Config
+	cfg.Image = image
+	res, err := p.client.ContainerCreate(ctx, cfg, c.HostConfig, netCfg, nil, nextName)
+	if err != nil {
+		return fmt.Errorf("docker provisioner create container error: %w", err)
+	}
+
+	err = p.client.ContainerStop(ctx, c.ID, container.StopOptions{})
+	if err != nil {
+		err2 := p.client.ContainerRemove(ctx, res.ID, container.RemoveOptions{Force: true})
+		return multierr.Combine(fmt.Errorf("docker provisioner stop container error: %w", err), err2)
+	}
+
+	err = p.client.ContainerStart(ctx, res.ID, container.StartOptions{})
+	if err != nil {
+		// Restore the current container
+		err2 := p.client.ContainerRemove(ctx, res.ID, container.RemoveOptions{Force: true})
+		err3 := p.client.ContainerStart(ctx, c.ID, container.StartOptions{})
+		return multierr.Combine(fmt.Errorf("docker provisioner start container error: %w", err), err2, err3)
+	}
+
+	// Swap in the new container
+	err = p.client.ContainerRemove(ctx, c.ID, container.RemoveOptions{Force: true})
+	if err != nil && !client.IsErrNotFound(err) {
+		return err
+	}
+	return p.client.ContainerRename(ctx, res.ID, name)
+}
+
+func (p *DockerProvisioner) CheckCapacity(ctx context.Context) error {
+	slotsUsed, runtimes, err := p.slotsUsed(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Log info status
+	p.logger.Info(`slots check: status`, zap.Int("runtimes", runtimes), zap.Int("slots_total", p.Spec.MaxSlots), zap.Int("slots_used", slotsUsed), observability.ZapCtx(ctx))
+
+	// Check there's at least 20% free slots
+	if p.Spec.MaxSlots > 0 && float64(slotsUsed)/float64(p.Spec.MaxSlots) >= 0.8 {
+		p.logger.Warn(`slots check: +80% of all slots used`, zap.Int("slots_total", p.Spec.MaxSlots), zap.Int("slots_used", slotsUsed), observability.ZapCtx(ctx))
+	}
+
+	return nil
+}
+
+// slotsUsed returns the total slots and number of runtime containers managed by the provisioner.
+func (p *DockerProvisioner) slotsUsed(ctx context.Context) (int, int, error) {
+	cs, err := p.client.ContainerList(ctx, container.ListOptions{
+		All:     true,
+		Filters: filters.NewArgs(filters.Arg("label", fmt.Sprintf("%s=%s", dockerLabelManagedBy, dockerManagedBy))),
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var slots int
+	for _, c := range cs {
+		n, err := strconv.Atoi(c.Labels[dockerLabelSlots])
+		if err != nil {
+			p.logger.Warn("docker provisioner: container has invalid slots label", zap.String("container_id", c.ID), zap.Error(err))
+			continue
+		}
+		slots += n
+	}
+
+	return slots, len(cs), nil
+}
+
+func (p *DockerProvisioner) createAndStart(ctx context.Context, name string, cfg *container.Config, hostCfg *container.HostConfig, netCfg *network.NetworkingConfig) error {
+	res, err := p.client.ContainerCreate(ctx, cfg, hostCfg, netCfg, nil, name)
+	if err != nil {
+		return fmt.Errorf("docker provisioner create container error: %w", err)
+	}
+
+	err = p.client.ContainerStart(ctx, res.ID, container.StartOptions{})
+	if err != nil {
+		return fmt.Errorf("docker provisioner start container error: %w", err)
+	}
+
+	return nil
+}
+
+// pullImage pulls the image from its registry. If the pull fails, it falls back to an image already present on the machine, which supports images that are built locally.
+func (p *DockerProvisioner) pullImage(ctx context.Context, image string) error {
+	rc, err := p.client.ImagePull(ctx, image, types.ImagePullOptions{})
+	if err == nil {
+		// The pull completes when the progress stream has been consumed
+		_, err = io.Copy(io.Discard, rc)
+		err = errors.Join(err, rc.Close())
+	}
+	if err == nil {
+		return nil
+	}
+
+	_, _, err2 := p.client.ImageInspectWithRaw(ctx, image)
+	if err2 != nil {
+		return fmt.Errorf("docker provisioner pull image error: %w", err)
+	}
+
+	p.logger.Warn("docker provisioner: failed to pull image, using local image", zap.String("image", image), zap.Error(err))
+	return nil
+}
+
+func (p *DockerProvisioner) getResourceName(provisionID string) string {
+	return fmt.Sprintf("runtime-%s", provisionID)
+}
+
+// getNextResourceName returns the temporary name of the container that replaces the runtime's container during an update.
+func (p *DockerProvisioner) getNextResourceName(provisionID string) string {
+	return fmt.Sprintf("runtime-%s-next", provisionID)
+}
+
+func (p *DockerProvisioner) getHost(provisionID string) string {
+	return strings.ReplaceAll(p.Spec.Host, "*", provisionID)
+}
+
+// getLabels returns the labels for the runtime's container and volume.
+// The wildcard '*' in the keys and values of the configured labels is replaced with the provision ID, which enables configuring a reverse proxy like Traefik per runtime.
+func (p *DockerProvisioner) getLabels(provisionID string, slots int) map[string]string {
+	labels := make(map[string]string, len(p.Spec.Labels)+3)
+	for k, v := range p.Spec.Labels {
+		labels[strings.ReplaceAll(k, "*", provisionID)] = strings.ReplaceAll(v, "*", provisionID)
+	}
+	labels[dockerLabelInstance] = provisionID
+	labels[dockerLabelManagedBy] = dockerManagedBy
+	labels[dockerLabelSlots] = strconv.Itoa(slots)
+	return labels
+}
+
+// getEnv returns the runtime's environment variables in Docker's KEY=VALUE format.
+func (p *DockerProvisioner) getEnv(host string) []string {
+	env := make(map[string]string, len(p.Spec.Env)+len(dockerReservedEnv))
+	for k, v := range p.Spec.Env {
+		env[k] = v
+	}
+	env["RILL_RUNTIME_HTTP_PORT"] = "8080"
+	env["RILL_RUNTIME_GRPC_PORT"] = "9090"
+	env["RILL_RUNTIME_DATA_DIR"] = p.Spec.DataDir
+	env["RILL_RUNTIME_AUTH_AUDIENCE_URL"] = host
+
+	res := make([]string, 0, len(env))
+	for k, v := range env {
+		res = append(res, fmt.Sprintf("%s=%s", k, v))
+	}
+	sort.Strings(res)
+	return res
+}
@@ -0,0 +1,50 @@
+package provisioner
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestDockerSpec(t *testing.T) {
+	// Host must give each runtime a unique host
+	_, err := NewDocker(json.RawMessage(`{"image":"rilldata/rill","host":"http://localhost:9091"}`), zap.NewNop())
+	require.ErrorContains(t, err, "wildcard")
+
+	// Image is required
+	_, err = NewDocker(json.RawMessage(`{"host":"http://*.localhost"}`), zap.NewNop())
+	require.ErrorContains(t, err, "image")
+
+	p, err := NewDocker(json.RawMessage(`{
+		"image": "rilldata/rill",
+		"host": "https://*.runtime.example.com",
+		"env": {"RILL_RUNTIME_AUTH_ENABLE": "true", "RILL_RUNTIME_AUTH_AUDIENCE_URL": "https://runtime.example.com"},
+		"labels": {"traefik.http.routers.*.rule": "Host(`+"`*.runtime.example.com`"+`)"}
+	}`), zap.NewNop())
+	require.NoError(t, err)
+	require.Equal(t, "unix:///var/run/docker.sock", p.Spec.DockerHost)
+	require.Equal(t, "/data", p.Spec.DataDir)
+
+	require.Equal(t, "runtime-abc", p.getResourceName("abc"))
+	require.Equal(t, "runtime-abc-next", p.getNextResourceName("abc"))
+	require.Equal(t, "https://abc.runtime.example.com", p.getHost("abc"))
+
+	require.Equal(t, map[string]string{
+		"traefik.http.routers.abc.rule": "Host(`abc.runtime.example.com`)",
+		dockerLabelInstance:             "abc",
+		dockerLabelManagedBy:            dockerManagedBy,
+		dockerLabelSlots:                "4",
+	}, p.getLabels("abc", 4))
+
+	// Reserved env vars can't be overridden
+	require.Equal(t, map[string]string{"RILL_RUNTIME_AUTH_ENABLE": "true"}, p.Spec.Env)
+	require.Equal(t, []string{
+		"RILL_RUNTIME_AUTH_AUDIENCE_URL=https://abc.runtime.example.com",
+		"RILL_RUNTIME_AUTH_ENABLE=true",
+		"RILL_RUNTIME_DATA_DIR=/data",
+		"RILL_RUNTIME_GRPC_PORT=9090",
+		"RILL_RUNTIME_HTTP_PORT=8080",
+	}, p.getEnv("https://abc.runtime.example.com"))
+}
@@ -63,6 +63,13 @@ func NewSet(set string, db database.DB, logger *zap.Logger) (map[string]Provisio
 			}
 			ps[k] = p
 			continue
+		case "docker":
+			p, err := NewDocker(v.Spec, logger)
+			if err != nil {
+				return nil, err
+			}
+			ps[k] = p
+			continue
 		default:
 			return nil, fmt.Errorf("invalid provisioner type %q", v.Type)
 		}
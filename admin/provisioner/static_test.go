@@ -17,7 +17,7 @@ func Test_staticProvisioner_Provision(t *testing.T) {
 	pg := pgtestcontainer.New(t)
 	defer pg.Terminate(t)
 
-	db, err := database.Open("postgres", pg.DatabaseURL)
+	db, err := database.Open("postgres", pg.DatabaseURL, nil)
 	require.NoError(t, err)
 	require.NotNil(t, db)
 	defer db.Close()
@@ -45,6 +45,7 @@ func Test_staticProvisioner_Provision(t *testing.T) {
 		ProvisionID:       uuid.NewString(),
 		Slots:             2,
 		Branch:            "main",
+		Environment:       "prod",
 		RuntimeHost:       "host_1",
 		RuntimeInstanceID: uuid.NewString(),
 	})
@@ -56,6 +57,7 @@ func Test_staticProvisioner_Provision(t *testing.T) {
 		ProvisionID:       uuid.NewString(),
 		Slots:             5,
 		Branch:            "main",
+		Environment:       "prod",
 		RuntimeHost:       "host_2",
 		RuntimeInstanceID: uuid.NewString(),
 	})
@@ -67,6 +69,7 @@ func Test_staticProvisioner_Provision(t *testing.T) {
 		ProvisionID:       uuid.NewString(),
 		Slots:             4,
 		Branch:            "main",
+		Environment:       "prod",
 		RuntimeHost:       "host_3",
 		RuntimeInstanceID: uuid.NewString(),
 	})
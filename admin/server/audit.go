@@ -0,0 +1,536 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/rilldata/rill/admin"
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/admin/server/auth"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/rilldata/rill/runtime/pkg/activity"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	auditMethodPrefix = "/rill.admin.v1.AdminService/"
+	auditRedacted     = "[redacted]"
+	// auditMaxStringLength is the max length of string values stored in audit payloads.
+	auditMaxStringLength = 1024
+)
+
+// auditReadOnlyPrefixes are method name prefixes of AdminService handlers that do not mutate state.
+var auditReadOnlyPrefixes = []string{"Ping", "Get", "List", "Search", "Pull", "Generate", "SudoGet"}
+
+// auditRedactKeys matches keys in audit payloads whose values must never be persisted.
+var auditRedactKeys = regexp.MustCompile(`(?i)(secret|token|password|dsn|credential|variables|private_key|api_key)`)
+
+type auditContextKey struct{}
+
+// auditRecord holds the state changes a handler attaches to the audit event for the current request.
+type auditRecord struct {
+	before map[string]any
+	after  map[string]any
+}
+
+// auditChange attaches the state of a resource before and after a mutation to the audit event for the current request.
+// The values can be proto messages, maps or JSON-serializable structs. Only the fields that differ are recorded.
+// It is a no-op when the request is not audited.
+func auditChange(ctx context.Context, before, after any) {
+	rec, ok := ctx.Value(auditContextKey{}).(*auditRecord)
+	if !ok {
+		return
+	}
+
+	b, a := diffAuditMaps(toAuditMap(before), toAuditMap(after))
+	rec.before = redactAuditMap(b)
+	rec.after = redactAuditMap(a)
+}
+
+// auditUnaryServerInterceptor records an audit event for every successful call to a mutating AdminService handler.
+func (s *Server) auditUnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		action, ok := auditAction(info.FullMethod)
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		msg, ok := req.(proto.Message)
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		// Resolve the org and project before calling the handler since deletes and renames change them.
+		target := s.resolveAuditTarget(ctx, action, msg.ProtoReflect())
+
+		rec := &auditRecord{}
+		resp, err := handler(context.WithValue(ctx, auditContextKey{}, rec), req)
+		if err != nil {
+			return resp, err
+		}
+
+		// Creates can only be resolved after the handler has run.
+		if target.orgID == "" {
+			target = s.resolveAuditTarget(ctx, action, msg.ProtoReflect())
+		}
+
+		payload, err := auditPayload(msg)
+		if err != nil {
+			s.logger.Error("audit: failed to serialize request", zap.String("action", action), zap.Error(err), observability.ZapCtx(ctx))
+		}
+
+		s.recordAuditEvent(ctx, s.newAuditEvent(ctx, action, payload, target, rec))
+		return resp, nil
+	}
+}
+
+// newAuditEvent prepares an audit event for a request made by the caller identified by the claims in ctx.
+func (s *Server) newAuditEvent(ctx context.Context, action string, payload map[string]any, target auditTarget, rec *auditRecord) *database.InsertAuditEventOptions {
+	claims := auth.GetClaims(ctx)
+	return &database.InsertAuditEventOptions{
+		OrgID:       target.orgID,
+		ProjectID:   target.projectID,
+		ProjectName: target.projectName,
+		ActorType:   string(claims.OwnerType()),
+		ActorID:     claims.OwnerID(),
+		ActorName:   s.auditActorName(ctx, claims),
+		Action:      action,
+		Payload:     payload,
+		Before:      rec.before,
+		After:       rec.after,
+	}
+}
+
+// recordAuditEvent persists an audit event and forwards it to the activity client if audit log forwarding is enabled.
+// Failing to record the audit event should not fail a mutation that has already been applied, so errors are only logged.
+func (s *Server) recordAuditEvent(ctx context.Context, opts *database.InsertAuditEventOptions) {
+	action := opts.Action
+	evt, err := s.admin.DB.InsertAuditEvent(ctx, opts)
+	if err != nil {
+		s.logger.Error("audit: failed to insert event", zap.String("action", action), zap.Error(err), observability.ZapCtx(ctx))
+		return
+	}
+
+	if s.opts.AuditLogForwarding && s.activity != nil {
+		attrs := []attribute.KeyValue{
+			attribute.String("audit_event_id", evt.ID),
+			attribute.String("org_id", opts.OrgID),
+			attribute.String("project_id", opts.ProjectID),
+			attribute.String("project_name", opts.ProjectName),
+			attribute.String("actor_type", opts.ActorType),
+			attribute.String("actor_id", opts.ActorID),
+			attribute.String("actor_name", opts.ActorName),
+		}
+		for k, v := range map[string]map[string]any{"payload": opts.Payload, "before": opts.Before, "after": opts.After} {
+			if v == nil {
+				continue
+			}
+			data, err := json.Marshal(v)
+			if err != nil {
+				continue
+			}
+			attrs = append(attrs, attribute.String(k, string(data)))
+		}
+		s.activity.Record(ctx, activity.EventTypeAudit, action, attrs...)
+	}
+}
+
+// auditSSOProvisioning records an audit event for a user who was added to an org or its usergroups when logging in through the org's SSO provider.
+// The user isn't authenticated yet when it's provisioned, so the actor is set explicitly.
+func (s *Server) auditSSOProvisioning(ctx context.Context, p *database.SSOProvider, user *database.User, res *admin.SSOProvisioning) {
+	payload := map[string]any{
+		"sso_provider_id": p.ID,
+		"email":           user.Email,
+		"added_to_org":    res.AddedToOrg,
+	}
+	if len(res.AddedToUsergroups) > 0 {
+		payload["added_to_usergroups"] = res.AddedToUsergroups
+	}
+
+	s.recordAuditEvent(ctx, &database.InsertAuditEventOptions{
+		OrgID:     p.OrgID,
+		ActorType: string(auth.OwnerTypeUser),
+		ActorID:   user.ID,
+		ActorName: user.Email,
+		Action:    "SSOProvisionUser",
+		Payload:   payload,
+	})
+}
+
+func (s *Server) auditActorName(ctx context.Context, claims auth.Claims) string {
+	switch claims.OwnerType() {
+	case auth.OwnerTypeUser:
+		user, err := s.admin.DB.FindUser(ctx, claims.OwnerID())
+		if err == nil {
+			return user.Email
+		}
+	case auth.OwnerTypeService:
+		service, err := s.admin.DB.FindService(ctx, claims.OwnerID())
+		if err == nil {
+			return service.Name
+		}
+	}
+	return ""
+}
+
+// auditTarget is the org and project an audited request applies to.
+type auditTarget struct {
+	orgID       string
+	projectID   string
+	projectName string
+}
+
+// resolveAuditTarget resolves the org and project of a request from its conventionally named fields.
+// Errors are ignored since the handler is responsible for validating the request.
+func (s *Server) resolveAuditTarget(ctx context.Context, action string, msg protoreflect.Message) auditTarget {
+	var t auditTarget
+
+	if id := auditStringField(msg, "project_id"); id != "" {
+		proj, err := s.admin.DB.FindProject(ctx, id)
+		if err == nil {
+			t.orgID = proj.OrganizationID
+			t.projectID = proj.ID
+			t.projectName = proj.Name
+			return t
+		}
+	}
+
+	orgName := auditStringField(msg, "organization", "organization_name", "org", "org_name")
+	projName := auditStringField(msg, "project", "project_name")
+	if orgName == "" && strings.Contains(action, "Organization") {
+		orgName = auditStringField(msg, "name")
+	} else if orgName != "" && projName == "" && strings.Contains(action, "Project") {
+		projName = auditStringField(msg, "name")
+	}
+
+	if orgName != "" {
+		org, err := s.admin.DB.FindOrganizationByName(ctx, orgName)
+		if err == nil {
+			t.orgID = org.ID
+		}
+	} else if id := auditStringField(msg, "org_id"); id != "" {
+		org, err := s.admin.DB.FindOrganization(ctx, id)
+		if err == nil {
+			t.orgID = org.ID
+			orgName = org.Name
+		}
+	}
+
+	if t.orgID != "" && projName != "" {
+		t.projectName = projName
+		proj, err := s.admin.DB.FindProjectByName(ctx, orgName, projName)
+		if err == nil {
+			t.projectID = proj.ID
+		}
+	}
+
+	return t
+}
+
+// ListAuditEvents lists the audit events of an organization, newest first.
+func (s *Server) ListAuditEvents(ctx context.Context, req *adminv1.ListAuditEventsRequest) (*adminv1.ListAuditEventsResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.org", req.Organization),
+		attribute.String("args.project", req.Project),
+		attribute.String("args.actor_email", req.ActorEmail),
+		attribute.String("args.action", req.Action),
+	)
+
+	org, err := s.admin.DB.FindOrganizationByName(ctx, req.Organization)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	claims := auth.GetClaims(ctx)
+	if !claims.Superuser(ctx) && !claims.OrganizationPermissions(ctx, org.ID).ManageOrg {
+		return nil, status.Error(codes.PermissionDenied, "not allowed to read audit events")
+	}
+
+	opts := &database.FindAuditEventsOptions{
+		OrgID:  org.ID,
+		Action: req.Action,
+		Limit:  validPageSize(req.PageSize),
+	}
+
+	if req.Project != "" {
+		proj, err := s.admin.DB.FindProjectByName(ctx, org.Name, req.Project)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		opts.ProjectID = proj.ID
+	}
+
+	if req.ActorEmail != "" {
+		user, err := s.admin.DB.FindUserByEmail(ctx, req.ActorEmail)
+		if err != nil {
+			if errors.Is(err, database.ErrNotFound) {
+				return &adminv1.ListAuditEventsResponse{}, nil
+			}
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		opts.ActorID = user.ID
+	}
+
+	if req.StartTime != nil {
+		opts.StartTime = req.StartTime.AsTime()
+	}
+	if req.EndTime != nil {
+		opts.EndTime = req.EndTime.AsTime()
+	}
+
+	if req.PageToken != "" {
+		token, err := unmarshalStringTimestampPageToken(req.PageToken)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		opts.BeforeID = token.Str
+		opts.BeforeCreatedOn = token.Ts.AsTime()
+	}
+
+	events, err := s.admin.DB.FindAuditEvents(ctx, opts)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	nextToken := ""
+	if len(events) >= opts.Limit {
+		last := events[len(events)-1]
+		nextToken = marshalStringTimestampPageToken(last.ID, last.CreatedOn)
+	}
+
+	dtos := make([]*adminv1.AuditEvent, len(events))
+	for i, evt := range events {
+		dtos[i], err = auditEventToPB(evt)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return &adminv1.ListAuditEventsResponse{
+		Events:        dtos,
+		NextPageToken: nextToken,
+	}, nil
+}
+
+func auditEventToPB(e *database.AuditEvent) (*adminv1.AuditEvent, error) {
+	pb := &adminv1.AuditEvent{
+		Id:          e.ID,
+		ProjectName: e.ProjectName,
+		ActorType:   e.ActorType,
+		ActorId:     e.ActorID,
+		ActorName:   e.ActorName,
+		Action:      e.Action,
+		CreatedOn:   timestamppb.New(e.CreatedOn),
+	}
+	if e.OrgID != nil {
+		pb.OrgId = *e.OrgID
+	}
+	if e.ProjectID != nil {
+		pb.ProjectId = *e.ProjectID
+	}
+
+	var err error
+	pb.Payload, err = structpb.NewStruct(e.Payload)
+	if err != nil {
+		return nil, err
+	}
+	if e.Before != nil {
+		pb.Before, err = structpb.NewStruct(e.Before)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if e.After != nil {
+		pb.After, err = structpb.NewStruct(e.After)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return pb, nil
+}
+
+// auditUserEmails returns the emails of users for inclusion in an audit record.
+func auditUserEmails(users []*database.User) []string {
+	emails := make([]string, len(users))
+	for i, u := range users {
+		emails[i] = u.Email
+	}
+	return emails
+}
+
+// auditAction returns the action name for a gRPC method if calls to it should be audited.
+func auditAction(fullMethod string) (string, bool) {
+	action, ok := strings.CutPrefix(fullMethod, auditMethodPrefix)
+	if !ok {
+		return "", false
+	}
+	for _, prefix := range auditReadOnlyPrefixes {
+		if strings.HasPrefix(action, prefix) {
+			return "", false
+		}
+	}
+	return action, true
+}
+
+// auditStringField returns the value of the first non-empty string field with one of the given names.
+func auditStringField(msg protoreflect.Message, names ...string) string {
+	fields := msg.Descriptor().Fields()
+	for _, name := range names {
+		fd := fields.ByName(protoreflect.Name(name))
+		if fd == nil || fd.Kind() != protoreflect.StringKind || fd.IsList() || fd.IsMap() {
+			continue
+		}
+		if v := msg.Get(fd).String(); v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// auditPayload serializes a request to a redacted map.
+func auditPayload(msg proto.Message) (map[string]any, error) {
+	data, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(msg)
+	if err != nil {
+		return map[string]any{}, err
+	}
+
+	res := map[string]any{}
+	if err := json.Unmarshal(data, &res); err != nil {
+		return map[string]any{}, err
+	}
+
+	return redactAuditMap(res), nil
+}
+
+// toAuditMap converts a value to a map of JSON values.
+func toAuditMap(v any) map[string]any {
+	if v == nil || (reflect.ValueOf(v).Kind() == reflect.Pointer && reflect.ValueOf(v).IsNil()) {
+		return nil
+	}
+
+	var data []byte
+	var err error
+	if msg, ok := v.(proto.Message); ok {
+		data, err = protojson.MarshalOptions{UseProtoNames: true}.Marshal(msg)
+	} else {
+		data, err = json.Marshal(v)
+	}
+	if err != nil {
+		return nil
+	}
+
+	res := map[string]any{}
+	if err := json.Unmarshal(data, &res); err != nil {
+		return nil
+	}
+	return res
+}
+
+// diffAuditMaps returns the subsets of before and after that differ, comparing nested maps key by key.
+// If one of the maps is nil, the other is returned unchanged.
+func diffAuditMaps(before, after map[string]any) (map[string]any, map[string]any) {
+	if before == nil || after == nil {
+		return before, after
+	}
+
+	b := map[string]any{}
+	a := map[string]any{}
+	for k, bv := range before {
+		av, ok := after[k]
+		if !ok {
+			b[k] = bv
+			continue
+		}
+
+		bm, bok := bv.(map[string]any)
+		am, aok := av.(map[string]any)
+		if bok && aok {
+			bd, ad := diffAuditMaps(bm, am)
+			if len(bd) > 0 || len(ad) > 0 {
+				b[k] = bd
+				a[k] = ad
+			}
+			continue
+		}
+
+		if !reflect.DeepEqual(bv, av) {
+			b[k] = bv
+			a[k] = av
+		}
+	}
+	for k, av := range after {
+		if _, ok := before[k]; !ok {
+			a[k] = av
+		}
+	}
+
+	return b, a
+}
+
+// redactAuditMap replaces sensitive values in m and truncates long strings.
+// For sensitive maps, such as variables, the keys are kept so it is possible to tell which entries changed.
+func redactAuditMap(m map[string]any) map[string]any {
+	if m == nil {
+		return nil
+	}
+
+	res := make(map[string]any, len(m))
+	for k, v := range m {
+		if auditRedactKeys.MatchString(k) {
+			res[k] = redactAuditValue(v)
+			continue
+		}
+		res[k] = sanitizeAuditValue(v)
+	}
+	return res
+}
+
+func redactAuditValue(v any) any {
+	switch v := v.(type) {
+	case nil:
+		return nil
+	case map[string]any:
+		res := make(map[string]any, len(v))
+		for k := range v {
+			res[k] = auditRedacted
+		}
+		return res
+	default:
+		return auditRedacted
+	}
+}
+
+func sanitizeAuditValue(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		return redactAuditMap(v)
+	case []any:
+		res := make([]any, len(v))
+		for i, e := range v {
+			res[i] = sanitizeAuditValue(e)
+		}
+		return res
+	case string:
+		if len(v) > auditMaxStringLength {
+			return v[:auditMaxStringLength] + "..."
+		}
+		return v
+	default:
+		return v
+	}
+}
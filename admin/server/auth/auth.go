@@ -6,6 +6,7 @@ import (
 
 	"github.com/coreos/go-oidc/v3/oidc"
 	"github.com/rilldata/rill/admin"
+	"github.com/rilldata/rill/admin/database"
 	"github.com/rilldata/rill/admin/server/cookies"
 	"go.uber.org/zap"
 	"golang.org/x/oauth2"
@@ -18,6 +19,8 @@ type AuthenticatorOptions struct {
 	AuthClientSecret string
 	ExternalURL      string
 	FrontendURL      string
+	// AuditSSOProvisioning is called after a login through an organization's SSO provider added the user to the organization or its usergroups.
+	AuditSSOProvisioning func(ctx context.Context, p *database.SSOProvider, user *database.User, res *admin.SSOProvisioning)
 }
 
 // Authenticator wraps functionality for admin server auth.
@@ -11,6 +11,7 @@ import (
 	"strconv"
 
 	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/gorilla/sessions"
 	"github.com/rilldata/rill/admin/database"
 	"github.com/rilldata/rill/runtime/pkg/httputil"
 	"github.com/rilldata/rill/runtime/pkg/middleware"
@@ -53,6 +54,9 @@ func (a *Authenticator) RegisterEndpoints(mux *http.ServeMux, limiter ratelimit.
 	observability.MuxHandle(inner, "/auth/signup", middleware.Check(checkLimit("/auth/signup"), http.HandlerFunc(a.authSignup)))
 	observability.MuxHandle(inner, "/auth/login", middleware.Check(checkLimit("/auth/login"), http.HandlerFunc(a.authLogin)))
 	observability.MuxHandle(inner, "/auth/callback", middleware.Check(checkLimit("/auth/callback"), http.HandlerFunc(a.authLoginCallback)))
+	observability.MuxHandle(inner, "/auth/sso/callback", middleware.Check(checkLimit("/auth/sso/callback"), http.HandlerFunc(a.ssoCallback)))
+	observability.MuxHandle(inner, "GET /auth/saml/{id}/metadata", middleware.Check(checkLimit("/auth/saml/metadata"), http.HandlerFunc(a.samlMetadata)))
+	observability.MuxHandle(inner, "POST /auth/saml/{id}/acs", middleware.Check(checkLimit("/auth/saml/acs"), http.HandlerFunc(a.samlACS)))
 	observability.MuxHandle(inner, "/auth/with-token", middleware.Check(checkLimit("/auth/with-token"), http.HandlerFunc(a.authWithToken)))
 	observability.MuxHandle(inner, "/auth/logout", middleware.Check(checkLimit("/auth/logout"), http.HandlerFunc(a.authLogout)))
 	observability.MuxHandle(inner, "/auth/logout/callback", middleware.Check(checkLimit("/auth/logout/callback"), http.HandlerFunc(a.authLogoutCallback)))
@@ -76,10 +80,22 @@ func (a *Authenticator) authLogin(w http.ResponseWriter, r *http.Request) {
 // authStart starts an OAuth and OIDC flow that redirects the user for authentication with the auth provider.
 // After auth, the user is redirected back to authLoginCallback, which in turn will redirect the user to "/".
 // You can override the redirect destination by passing a `?redirect=URI` query to this endpoint.
+// If an `?org=NAME` or `?email=ADDRESS` query matches an organization's SSO provider, the user is instead redirected to that identity provider (see ssoStart).
 func (a *Authenticator) authStart(w http.ResponseWriter, r *http.Request, signup bool) {
+	// Route to an organization's identity provider if configured
+	provider, err := a.findSSOProvider(r)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to find SSO provider: %s", err), http.StatusInternalServerError)
+		return
+	}
+	if provider != nil {
+		a.ssoStart(w, r, provider)
+		return
+	}
+
 	// Generate random state for CSRF
 	b := make([]byte, 32)
-	_, err := rand.Read(b)
+	_, err = rand.Read(b)
 	if err != nil {
 		http.Error(w, fmt.Sprintf("failed to generate state: %s", err), http.StatusInternalServerError)
 		return
@@ -105,12 +121,15 @@ func (a *Authenticator) authStart(w http.ResponseWriter, r *http.Request, signup
 	}
 
 	// Redirect to auth provider
-	redirectURL := a.oauth2.AuthCodeURL(state)
+	var opts []oauth2.AuthCodeOption
 	if signup {
 		// Set custom parameters for signup using AuthCodeOption
-		customOption := oauth2.SetAuthURLParam("screen_hint", "signup")
-		redirectURL = a.oauth2.AuthCodeURL(state, customOption)
+		opts = append(opts, oauth2.SetAuthURLParam("screen_hint", "signup"))
 	}
+	if email := r.URL.Query().Get("email"); email != "" {
+		opts = append(opts, oauth2.SetAuthURLParam("login_hint", email))
+	}
+	redirectURL := a.oauth2.AuthCodeURL(state, opts...)
 
 	http.Redirect(w, r, redirectURL, http.StatusTemporaryRedirect)
 }
@@ -215,6 +234,12 @@ func (a *Authenticator) authLoginCallback(w http.ResponseWriter, r *http.Request
 		return
 	}
 
+	a.completeLogin(w, r, sess, user)
+}
+
+// completeLogin issues a new user auth token for the user and saves it in the auth cookie.
+// It then redirects the user to the location specified in the initial call to authLogin.
+func (a *Authenticator) completeLogin(w http.ResponseWriter, r *http.Request, sess *sessions.Session, user *database.User) {
 	// If there's already a token in the cookie, revoke it (since we're now issuing a new one)
 	oldAuthToken, ok := sess.Values[cookieFieldAccessToken].(string)
 	if ok && oldAuthToken != "" {
@@ -248,8 +273,13 @@ func (a *Authenticator) authLoginCallback(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	// Redirect to UI (usually)
-	http.Redirect(w, r, redirect, http.StatusTemporaryRedirect)
+	// Redirect to UI (usually).
+	// When completing a SAML login, the request is a POST, so we use a 303 to make the browser switch to a GET.
+	code := http.StatusTemporaryRedirect
+	if r.Method == http.MethodPost {
+		code = http.StatusSeeOther
+	}
+	http.Redirect(w, r, redirect, code)
 }
 
 func (a *Authenticator) authWithToken(w http.ResponseWriter, r *http.Request) {
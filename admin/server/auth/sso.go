@@ -0,0 +1,353 @@
+package auth
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/gorilla/sessions"
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/admin/pkg/sso"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"go.uber.org/zap"
+	"golang.org/x/oauth2"
+)
+
+const (
+	cookieFieldSSOProvider = "sso_provider"
+	cookieFieldSSONonce    = "sso_nonce"
+	cookieFieldSSOVerifier = "sso_verifier"
+
+	// samlCookieName is the cookie used during SAML logins.
+	// The identity provider posts the SAML response cross-site, so browsers don't send the SameSite=Lax auth cookie with it.
+	samlCookieName         = "auth_saml"
+	samlCookieFieldRequest = "request_id"
+	samlCookieMaxAge       = 10 * 60 // 10 minutes
+)
+
+// findSSOProvider returns the SSO provider to use for a login request, or nil if the default login flow should be used.
+// It uses the provider of the organization in the `?org=` query if set, and otherwise the provider for the domain of the `?email=` query.
+func (a *Authenticator) findSSOProvider(r *http.Request) (*database.SSOProvider, error) {
+	ctx := r.Context()
+
+	if orgName := r.URL.Query().Get("org"); orgName != "" {
+		org, err := a.admin.DB.FindOrganizationByName(ctx, orgName)
+		if err != nil {
+			if errors.Is(err, database.ErrNotFound) {
+				return nil, nil
+			}
+			return nil, err
+		}
+		p, err := a.admin.DB.FindSSOProviderForOrganization(ctx, org.ID)
+		if err != nil {
+			if errors.Is(err, database.ErrNotFound) {
+				return nil, nil
+			}
+			return nil, err
+		}
+		// The provider can't log in anyone until at least one of its domains is verified
+		if len(p.VerifiedDomains) == 0 {
+			return nil, nil
+		}
+		return p, nil
+	}
+
+	if email := r.URL.Query().Get("email"); email != "" {
+		domain, err := sso.EmailDomain(email)
+		if err != nil {
+			return nil, nil
+		}
+		p, err := a.admin.DB.FindSSOProviderForDomain(ctx, domain)
+		if err != nil {
+			if errors.Is(err, database.ErrNotFound) {
+				return nil, nil
+			}
+			return nil, err
+		}
+		return p, nil
+	}
+
+	return nil, nil
+}
+
+// ssoStart starts a login with an organization's identity provider.
+// For OIDC, the user is redirected back to ssoCallback. For SAML, the identity provider posts the response to samlACS.
+// Both complete the login the same way as authLoginCallback.
+func (a *Authenticator) ssoStart(w http.ResponseWriter, r *http.Request, p *database.SSOProvider) {
+	redirect := r.URL.Query().Get("redirect")
+	loginHint := r.URL.Query().Get("email")
+
+	switch p.Type {
+	case database.SSOProviderTypeOIDC:
+		provider, err := sso.NewOIDC(r.Context(), p.Config, a.ssoCallbackURL())
+		if err != nil {
+			http.Error(w, fmt.Sprintf("failed to initialize SSO provider: %s", err), http.StatusInternalServerError)
+			return
+		}
+
+		state, err := randomString()
+		if err != nil {
+			internalServerError(w, err)
+			return
+		}
+		nonce, err := randomString()
+		if err != nil {
+			internalServerError(w, err)
+			return
+		}
+		verifier := oauth2.GenerateVerifier()
+
+		// Save the flow's state in the auth cookie
+		sess := a.cookies.Get(r, cookieName)
+		sess.Values[cookieFieldState] = state
+		sess.Values[cookieFieldSSOProvider] = p.ID
+		sess.Values[cookieFieldSSONonce] = nonce
+		sess.Values[cookieFieldSSOVerifier] = verifier
+		if redirect != "" {
+			sess.Values[cookieFieldRedirect] = redirect
+		}
+		if err := sess.Save(r, w); err != nil {
+			http.Error(w, fmt.Sprintf("failed to save session: %s", err), http.StatusInternalServerError)
+			return
+		}
+
+		http.Redirect(w, r, provider.AuthCodeURL(state, nonce, verifier, loginHint), http.StatusTemporaryRedirect)
+	case database.SSOProviderTypeSAML:
+		provider, err := sso.NewSAML(p.Config, a.samlMetadataURL(p.ID), a.samlACSURL(p.ID))
+		if err != nil {
+			http.Error(w, fmt.Sprintf("failed to initialize SSO provider: %s", err), http.StatusInternalServerError)
+			return
+		}
+
+		authURL, requestID, err := provider.AuthnRequestURL("")
+		if err != nil {
+			http.Error(w, fmt.Sprintf("failed to create SAML request: %s", err), http.StatusInternalServerError)
+			return
+		}
+
+		// Save the flow's state in the SAML cookie
+		sess := a.cookies.Get(r, samlCookieName)
+		opts := *a.cookies.Options
+		opts.MaxAge = samlCookieMaxAge
+		if opts.Secure {
+			// Browsers only send SameSite=None cookies over HTTPS. In local development over HTTP, Lax works when the IdP is also on localhost.
+			opts.SameSite = http.SameSiteNoneMode
+		}
+		sess.Options = &opts
+		sess.Values[samlCookieFieldRequest] = requestID
+		sess.Values[cookieFieldSSOProvider] = p.ID
+		sess.Values[cookieFieldRedirect] = redirect
+		if err := sess.Save(r, w); err != nil {
+			http.Error(w, fmt.Sprintf("failed to save session: %s", err), http.StatusInternalServerError)
+			return
+		}
+
+		http.Redirect(w, r, authURL, http.StatusTemporaryRedirect)
+	default:
+		http.Error(w, fmt.Sprintf("unsupported SSO provider type %q", p.Type), http.StatusInternalServerError)
+	}
+}
+
+// ssoCallback is called after the user has authenticated with an organization's OIDC identity provider.
+func (a *Authenticator) ssoCallback(w http.ResponseWriter, r *http.Request) {
+	// Get auth cookie
+	sess := a.cookies.Get(r, cookieName)
+	// Check that random state matches (for CSRF protection)
+	state, ok := sess.Values[cookieFieldState].(string)
+	if !ok || state == "" || r.URL.Query().Get("state") != state {
+		http.Error(w, "invalid state parameter", http.StatusBadRequest)
+		return
+	}
+	providerID, _ := sess.Values[cookieFieldSSOProvider].(string)
+	nonce, _ := sess.Values[cookieFieldSSONonce].(string)
+	verifier, _ := sess.Values[cookieFieldSSOVerifier].(string)
+	delete(sess.Values, cookieFieldState)
+	delete(sess.Values, cookieFieldSSOProvider)
+	delete(sess.Values, cookieFieldSSONonce)
+	delete(sess.Values, cookieFieldSSOVerifier)
+
+	// Check for errors in the auth flow
+	if errStr := r.URL.Query().Get("error"); errStr != "" {
+		description := r.URL.Query().Get("error_description")
+		http.Error(w, fmt.Sprintf("auth error of type %q: %s", errStr, description), http.StatusUnauthorized)
+		return
+	}
+
+	p, err := a.admin.DB.FindSSOProvider(r.Context(), providerID)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			http.Error(w, "SSO provider not found", http.StatusBadRequest)
+			return
+		}
+		internalServerError(w, err)
+		return
+	}
+	if p.Type != database.SSOProviderTypeOIDC {
+		http.Error(w, "SSO provider is not an OIDC provider", http.StatusBadRequest)
+		return
+	}
+
+	provider, err := sso.NewOIDC(r.Context(), p.Config, a.ssoCallbackURL())
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to initialize SSO provider: %s", err), http.StatusInternalServerError)
+		return
+	}
+
+	ident, err := provider.Exchange(r.Context(), r.URL.Query().Get("code"), nonce, verifier)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	a.ssoCompleteLogin(w, r, sess, p, ident)
+}
+
+// samlMetadata serves the SAML service provider metadata for an organization's identity provider.
+func (a *Authenticator) samlMetadata(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	p, err := a.admin.DB.FindSSOProvider(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			http.Error(w, "SSO provider not found", http.StatusNotFound)
+			return
+		}
+		internalServerError(w, err)
+		return
+	}
+	if p.Type != database.SSOProviderTypeSAML {
+		http.Error(w, "SSO provider not found", http.StatusNotFound)
+		return
+	}
+
+	provider, err := sso.NewSAML(p.Config, a.samlMetadataURL(p.ID), a.samlACSURL(p.ID))
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to initialize SSO provider: %s", err), http.StatusInternalServerError)
+		return
+	}
+
+	data, err := provider.Metadata()
+	if err != nil {
+		internalServerError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/samlmetadata+xml")
+	_, err = w.Write(data)
+	if err != nil {
+		a.logger.Info("failed to write SAML metadata", zap.Error(err), observability.ZapCtx(r.Context()))
+	}
+}
+
+// samlACS is the assertion consumer service that SAML identity providers post responses to after the user has authenticated.
+func (a *Authenticator) samlACS(w http.ResponseWriter, r *http.Request) {
+	// Get and clear the SAML cookie
+	samlSess := a.cookies.Get(r, samlCookieName)
+	requestID, _ := samlSess.Values[samlCookieFieldRequest].(string)
+	providerID, _ := samlSess.Values[cookieFieldSSOProvider].(string)
+	redirect, _ := samlSess.Values[cookieFieldRedirect].(string)
+	samlSess.Options.MaxAge = -1
+	if err := samlSess.Save(r, w); err != nil {
+		http.Error(w, fmt.Sprintf("failed to save session: %s", err), http.StatusInternalServerError)
+		return
+	}
+
+	// Only SP-initiated logins are supported, so the response must be for the provider that the login was started with
+	if requestID == "" || providerID != r.PathValue("id") {
+		http.Error(w, "no SAML login in progress", http.StatusBadRequest)
+		return
+	}
+
+	p, err := a.admin.DB.FindSSOProvider(r.Context(), providerID)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			http.Error(w, "SSO provider not found", http.StatusBadRequest)
+			return
+		}
+		internalServerError(w, err)
+		return
+	}
+	if p.Type != database.SSOProviderTypeSAML {
+		http.Error(w, "SSO provider is not a SAML provider", http.StatusBadRequest)
+		return
+	}
+
+	provider, err := sso.NewSAML(p.Config, a.samlMetadataURL(p.ID), a.samlACSURL(p.ID))
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to initialize SSO provider: %s", err), http.StatusInternalServerError)
+		return
+	}
+
+	ident, err := provider.ParseResponse(r, requestID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	// The auth cookie is not sent with the cross-site POST, so this creates a new one.
+	// This means we can't revoke a previous auth token from the same browser.
+	sess := a.cookies.Get(r, cookieName)
+	if redirect != "" {
+		sess.Values[cookieFieldRedirect] = redirect
+	}
+
+	a.ssoCompleteLogin(w, r, sess, p, ident)
+}
+
+// ssoCompleteLogin checks the identity asserted by an SSO provider, provisions the user and completes the login.
+func (a *Authenticator) ssoCompleteLogin(w http.ResponseWriter, r *http.Request, sess *sessions.Session, p *database.SSOProvider, ident *sso.Identity) {
+	// The identity provider is managed by the organization, so it's only trusted for the organization's own domains
+	if err := sso.CheckDomain(p, ident); err != nil {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	if !ident.EmailVerified {
+		errorRedirect, err := url.JoinPath(a.opts.FrontendURL, "/-/auth/verify-email")
+		if err != nil {
+			internalServerError(w, fmt.Errorf("failed to email verify uri: %w", err))
+			return
+		}
+
+		http.Redirect(w, r, errorRedirect, http.StatusSeeOther)
+		return
+	}
+
+	user, res, err := a.admin.ProvisionSSOUser(r.Context(), p, ident)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to update user: %s", err), http.StatusInternalServerError)
+		return
+	}
+
+	if res.Changed() && a.opts.AuditSSOProvisioning != nil {
+		a.opts.AuditSSOProvisioning(r.Context(), p, user, res)
+	}
+
+	a.completeLogin(w, r, sess, user)
+}
+
+func (a *Authenticator) ssoCallbackURL() string {
+	u, _ := url.JoinPath(a.opts.ExternalURL, "/auth/sso/callback")
+	return u
+}
+
+func (a *Authenticator) samlMetadataURL(providerID string) string {
+	u, _ := url.JoinPath(a.opts.ExternalURL, "/auth/saml", providerID, "metadata")
+	return u
+}
+
+func (a *Authenticator) samlACSURL(providerID string) string {
+	u, _ := url.JoinPath(a.opts.ExternalURL, "/auth/saml", providerID, "acs")
+	return u
+}
+
+func randomString() (string, error) {
+	b := make([]byte, 32)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate random string: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
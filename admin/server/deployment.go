@@ -51,7 +51,31 @@ func (s *Server) TriggerRefreshSources(ctx context.Context, req *adminv1.Trigger
 	observability.AddRequestAttributes(ctx,
 		attribute.String("args.deployment_id", req.DeploymentId),
 		attribute.StringSlice("args.sources", req.Sources),
+		attribute.StringSlice("args.reports", req.Reports),
+		attribute.StringSlice("args.alerts", req.Alerts),
 	)
+	if req.BackfillFrom != nil {
+		observability.AddRequestAttributes(ctx, attribute.String("args.backfill_from", req.BackfillFrom.AsTime().String()))
+	}
+	if req.BackfillTo != nil {
+		observability.AddRequestAttributes(ctx, attribute.String("args.backfill_to", req.BackfillTo.AsTime().String()))
+	}
+
+	backfill := req.BackfillFrom != nil || req.BackfillTo != nil
+	if backfill {
+		if req.BackfillFrom == nil || req.BackfillTo == nil {
+			return nil, status.Error(codes.InvalidArgument, "both backfill_from and backfill_to must be set")
+		}
+		if !req.BackfillFrom.AsTime().Before(req.BackfillTo.AsTime()) {
+			return nil, status.Error(codes.InvalidArgument, "backfill_from must be before backfill_to")
+		}
+		if len(req.Reports) == 0 && len(req.Alerts) == 0 {
+			return nil, status.Error(codes.InvalidArgument, "a backfill requires at least one report or alert")
+		}
+		if len(req.Sources) > 0 {
+			return nil, status.Error(codes.InvalidArgument, "sources cannot be backfilled")
+		}
+	}
 
 	depl, err := s.admin.DB.FindDeployment(ctx, req.DeploymentId)
 	if err != nil {
@@ -68,9 +92,19 @@ func (s *Server) TriggerRefreshSources(ctx context.Context, req *adminv1.Trigger
 		return nil, status.Error(codes.PermissionDenied, "does not have permission to manage deployment")
 	}
 
-	err = s.admin.TriggerRefreshSources(ctx, depl, req.Sources)
-	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+	// Refresh sources unless only reports or alerts were requested (an empty list of sources refreshes all sources)
+	if len(req.Sources) > 0 || (len(req.Reports) == 0 && len(req.Alerts) == 0) {
+		err = s.admin.TriggerRefreshSources(ctx, depl, req.Sources)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	if len(req.Reports) > 0 || len(req.Alerts) > 0 {
+		err = s.admin.TriggerRefreshReportsAndAlerts(ctx, depl, req.Reports, req.Alerts, req.BackfillFrom, req.BackfillTo)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 	}
 
 	return &adminv1.TriggerRefreshSourcesResponse{}, nil
@@ -139,24 +173,35 @@ func (s *Server) GetDeploymentCredentials(ctx context.Context, req *adminv1.GetD
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	if proj.ProdDeploymentID == nil {
-		return nil, status.Error(codes.InvalidArgument, "project does not have a deployment")
-	}
-
-	prodDepl, err := s.admin.DB.FindDeployment(ctx, *proj.ProdDeploymentID)
-	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
-	}
+	var depl *database.Deployment
+	if req.Branch == "" || req.Branch == proj.ProdBranch {
+		if proj.ProdDeploymentID == nil {
+			return nil, status.Error(codes.InvalidArgument, "project does not have a deployment")
+		}
 
-	if req.Branch != "" && req.Branch != prodDepl.Branch {
-		return nil, status.Error(codes.InvalidArgument, "project does not have a deployment for given branch")
+		depl, err = s.admin.DB.FindDeployment(ctx, *proj.ProdDeploymentID)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	} else {
+		depl, err = s.admin.DB.FindPreviewDeploymentForBranch(ctx, proj.ID, req.Branch)
+		if err != nil {
+			if errors.Is(err, database.ErrNotFound) {
+				return nil, status.Error(codes.InvalidArgument, "project does not have a deployment for given branch")
+			}
+			return nil, status.Error(codes.Internal, err.Error())
+		}
 	}
 
 	claims := auth.GetClaims(ctx)
 	permissions := claims.ProjectPermissions(ctx, proj.OrganizationID, proj.ID)
 
-	// If the user is not a superuser, they must have ManageProd permissions
-	if !permissions.ManageProd && !claims.Superuser(ctx) {
+	// If the user is not a superuser, they must have ManageProd permissions (or ManageDev permissions for preview deployments)
+	canManage := permissions.ManageProd
+	if depl.Preview {
+		canManage = permissions.ManageDev
+	}
+	if !canManage && !claims.Superuser(ctx) {
 		return nil, status.Error(codes.PermissionDenied, "does not have permission to manage deployment")
 	}
 
@@ -195,11 +240,11 @@ func (s *Server) GetDeploymentCredentials(ctx context.Context, req *adminv1.GetD
 
 	// Generate JWT
 	jwt, err := s.issuer.NewToken(runtimeauth.TokenOptions{
-		AudienceURL: prodDepl.RuntimeAudience,
+		AudienceURL: depl.RuntimeAudience,
 		Subject:     claims.OwnerID(),
 		TTL:         ttlDuration,
 		InstancePermissions: map[string][]runtimeauth.Permission{
-			prodDepl.RuntimeInstanceID: {
+			depl.RuntimeInstanceID: {
 				// TODO: Remove ReadProfiling and ReadRepo (may require frontend changes)
 				runtimeauth.ReadObjects,
 				runtimeauth.ReadMetrics,
@@ -214,11 +259,11 @@ func (s *Server) GetDeploymentCredentials(ctx context.Context, req *adminv1.GetD
 		return nil, status.Errorf(codes.Internal, "could not issue jwt: %s", err.Error())
 	}
 
-	s.admin.Used.Deployment(prodDepl.ID)
+	s.admin.Used.Deployment(depl.ID)
 
 	return &adminv1.GetDeploymentCredentialsResponse{
-		RuntimeHost: prodDepl.RuntimeHost,
-		InstanceId:  prodDepl.RuntimeInstanceID,
+		RuntimeHost: depl.RuntimeHost,
+		InstanceId:  depl.RuntimeInstanceID,
 		AccessToken: jwt,
 		TtlSeconds:  uint32(ttlDuration.Seconds()),
 	}, nil
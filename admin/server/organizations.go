@@ -175,6 +175,7 @@ func (s *Server) UpdateOrganization(ctx context.Context, req *adminv1.UpdateOrga
 
 	nameChanged := req.NewName != nil && *req.NewName != org.Name
 
+	prevOrg := org
 	org, err = s.admin.DB.UpdateOrganization(ctx, org.ID, &database.UpdateOrganizationOptions{
 		Name:                    valOrDefault(req.NewName, org.Name),
 		Description:             valOrDefault(req.Description, org.Description),
@@ -188,6 +189,8 @@ func (s *Server) UpdateOrganization(ctx context.Context, req *adminv1.UpdateOrga
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	auditChange(ctx, organizationToDTO(prevOrg), organizationToDTO(org))
+
 	if nameChanged {
 		err := s.admin.UpdateOrgDeploymentAnnotations(ctx, org)
 		if err != nil {
@@ -477,8 +480,8 @@ func (s *Server) RemoveOrganizationMember(ctx context.Context, req *adminv1.Remo
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	// delete from all user group
-	err = s.admin.DB.DeleteUsergroupMember(ctx, *org.AllUsergroupID, user.ID)
+	// delete from the org's usergroups (including the all user group)
+	err = s.admin.DB.DeleteUsergroupsMemberUser(ctx, org.ID, user.ID)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -557,11 +560,22 @@ func (s *Server) SetOrganizationMemberRole(ctx context.Context, req *adminv1.Set
 		}
 	}
 
+	prevRoles, err := s.admin.DB.ResolveOrganizationRolesForUser(ctx, user.ID, org.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	err = s.admin.DB.UpdateOrganizationMemberUserRole(ctx, org.ID, user.ID, role.ID)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	prevRoleNames := make([]string, len(prevRoles))
+	for i, r := range prevRoles {
+		prevRoleNames[i] = r.Name
+	}
+	auditChange(ctx, map[string]any{"roles": prevRoleNames}, map[string]any{"roles": []string{role.Name}})
+
 	return &adminv1.SetOrganizationMemberRoleResponse{}, nil
 }
 
@@ -612,8 +626,8 @@ func (s *Server) LeaveOrganization(ctx context.Context, req *adminv1.LeaveOrgani
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	// delete from all user group
-	err = s.admin.DB.DeleteUsergroupMember(ctx, *org.AllUsergroupID, claims.OwnerID())
+	// delete from the org's usergroups (including the all user group)
+	err = s.admin.DB.DeleteUsergroupsMemberUser(ctx, org.ID, claims.OwnerID())
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -723,6 +737,8 @@ func (s *Server) CreateWhitelistedDomain(ctx context.Context, req *adminv1.Creat
 		return nil, err
 	}
 
+	auditChange(ctx, nil, map[string]any{"domain": req.Domain, "role": role.Name, "added_members": auditUserEmails(newUsers)})
+
 	return &adminv1.CreateWhitelistedDomainResponse{}, nil
 }
 
@@ -759,6 +775,8 @@ func (s *Server) RemoveWhitelistedDomain(ctx context.Context, req *adminv1.Remov
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	auditChange(ctx, map[string]any{"domain": invite.Domain}, nil)
+
 	return &adminv1.RemoveWhitelistedDomainResponse{}, nil
 }
 
@@ -839,6 +857,8 @@ func (s *Server) SudoUpdateOrganizationQuotas(ctx context.Context, req *adminv1.
 		return nil, err
 	}
 
+	auditChange(ctx, organizationToDTO(org), organizationToDTO(updatedOrg))
+
 	return &adminv1.SudoUpdateOrganizationQuotasResponse{
 		Organization: organizationToDTO(updatedOrg),
 	}, nil
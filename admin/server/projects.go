@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"math"
 	"net/url"
+	"slices"
 	"strings"
 	"time"
 
@@ -34,6 +35,9 @@ const runtimeAccessTokenDefaultTTL = 30 * time.Minute
 // It can be overridden to a lower value when issued for high-risk embed users.
 const runtimeAccessTokenEmbedTTL = 24 * time.Hour
 
+// maskedVariableValue replaces the values of project variables returned to callers that are not allowed to read them.
+const maskedVariableValue = "********"
+
 func (s *Server) ListProjectsForOrganization(ctx context.Context, req *adminv1.ListProjectsForOrganizationRequest) (*adminv1.ListProjectsForOrganizationResponse, error) {
 	observability.AddRequestAttributes(ctx,
 		attribute.String("args.org", req.OrganizationName),
@@ -126,10 +130,26 @@ func (s *Server) GetProject(ctx context.Context, req *adminv1.GetProjectRequest)
 		return nil, status.Error(codes.PermissionDenied, "does not have permission to read project")
 	}
 
+	var previewDepls []*adminv1.Deployment
+	if permissions.ReadDev {
+		ds, err := s.admin.DB.FindPreviewDeploymentsForProject(ctx, proj.ID)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		previewDepls = make([]*adminv1.Deployment, len(ds))
+		for i, d := range ds {
+			if !permissions.ReadDevStatus {
+				d.StatusMessage = ""
+			}
+			previewDepls[i] = deploymentToDTO(d)
+		}
+	}
+
 	if proj.ProdDeploymentID == nil || !permissions.ReadProd {
 		return &adminv1.GetProjectResponse{
 			Project:            s.projToDTO(proj, org.Name),
 			ProjectPermissions: permissions,
+			PreviewDeployments: previewDepls,
 		}, nil
 	}
 
@@ -182,6 +202,7 @@ func (s *Server) GetProject(ctx context.Context, req *adminv1.GetProjectRequest)
 		ProdDeployment:     deploymentToDTO(depl),
 		Jwt:                jwt,
 		ProjectPermissions: permissions,
+		PreviewDeployments: previewDepls,
 	}, nil
 }
 
@@ -325,7 +346,7 @@ func (s *Server) CreateProject(ctx context.Context, req *adminv1.CreateProjectRe
 		ProdBranch:           req.ProdBranch,
 		GithubURL:            &req.GithubUrl,
 		GithubInstallationID: &installationID,
-		ProdVariables:        req.Variables,
+		EnvironmentVariables: map[string]map[string]string{admin.ProdEnvironment: req.Variables},
 		ProdTTLSeconds:       prodTTL,
 	})
 	if err != nil {
@@ -393,6 +414,9 @@ func (s *Server) UpdateProject(ctx context.Context, req *adminv1.UpdateProjectRe
 	if req.NewName != nil {
 		observability.AddRequestAttributes(ctx, attribute.String("args.new_name", *req.NewName))
 	}
+	if req.PreviewDeployments != nil {
+		observability.AddRequestAttributes(ctx, attribute.Bool("args.preview_deployments", *req.PreviewDeployments))
+	}
 
 	// Check the request is made by a user
 	claims := auth.GetClaims(ctx)
@@ -444,13 +468,18 @@ func (s *Server) UpdateProject(ctx context.Context, req *adminv1.UpdateProjectRe
 		ProdSlots:            int(valOrDefault(req.ProdSlots, int64(proj.ProdSlots))),
 		ProdTTLSeconds:       prodTTLSeconds,
 		Provisioner:          valOrDefault(req.Provisioner, proj.Provisioner),
+		PreviewDeployments:   valOrDefault(req.PreviewDeployments, proj.PreviewDeployments),
+		EnvironmentVariables: proj.EnvironmentVariables,
 		Annotations:          proj.Annotations,
 	}
+	prevProj := proj
 	proj, err = s.admin.UpdateProject(ctx, proj, opts)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	auditChange(ctx, s.projToDTO(prevProj, req.OrganizationName), s.projToDTO(proj, req.OrganizationName))
+
 	return &adminv1.UpdateProjectResponse{
 		Project: s.projToDTO(proj, req.OrganizationName),
 	}, nil
@@ -460,6 +489,7 @@ func (s *Server) GetProjectVariables(ctx context.Context, req *adminv1.GetProjec
 	observability.AddRequestAttributes(ctx,
 		attribute.String("args.org", req.OrganizationName),
 		attribute.String("args.project", req.Name),
+		attribute.String("args.environment", req.Environment),
 	)
 
 	proj, err := s.admin.DB.FindProjectByName(ctx, req.OrganizationName, req.Name)
@@ -468,14 +498,46 @@ func (s *Server) GetProjectVariables(ctx context.Context, req *adminv1.GetProjec
 	}
 
 	claims := auth.GetClaims(ctx)
-	if !claims.ProjectPermissions(ctx, proj.OrganizationID, proj.ID).ManageProject {
+	permissions := claims.ProjectPermissions(ctx, proj.OrganizationID, proj.ID)
+	if !permissions.ManageProject && !permissions.ManageDev && !claims.Superuser(ctx) {
 		return nil, status.Error(codes.PermissionDenied, "does not have permission to read project variables")
 	}
 
-	return &adminv1.GetProjectVariablesResponse{Variables: proj.ProdVariables}, nil
+	vars := proj.ProdVariables
+	if req.Environment != "" {
+		vars = proj.EnvironmentVariables[req.Environment]
+	}
+
+	// Variables usually contain credentials, so only project admins can read their values
+	if permissions.ManageProject || claims.Superuser(ctx) {
+		return &adminv1.GetProjectVariablesResponse{Variables: vars}, nil
+	}
+
+	masked := make(map[string]string, len(vars))
+	names := make([]string, 0, len(vars))
+	for k := range vars {
+		masked[k] = maskedVariableValue
+		names = append(names, k)
+	}
+	slices.Sort(names)
+
+	return &adminv1.GetProjectVariablesResponse{
+		Variables:       masked,
+		MaskedVariables: names,
+	}, nil
 }
 
 func (s *Server) UpdateProjectVariables(ctx context.Context, req *adminv1.UpdateProjectVariablesRequest) (*adminv1.UpdateProjectVariablesResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.org", req.OrganizationName),
+		attribute.String("args.project", req.Name),
+		attribute.String("args.environment", req.Environment),
+	)
+
+	if err := admin.ValidateEnvironmentName(req.Environment); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	proj, err := s.admin.DB.FindProjectByName(ctx, req.OrganizationName, req.Name)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
@@ -491,7 +553,7 @@ func (s *Server) UpdateProjectVariables(ctx context.Context, req *adminv1.Update
 		return nil, status.Error(codes.PermissionDenied, "does not have permission to update project variables")
 	}
 
-	proj, err = s.admin.UpdateProject(ctx, proj, &database.UpdateProjectOptions{
+	opts := &database.UpdateProjectOptions{
 		Name:                 proj.Name,
 		Description:          proj.Description,
 		Public:               proj.Public,
@@ -499,18 +561,46 @@ func (s *Server) UpdateProjectVariables(ctx context.Context, req *adminv1.Update
 		GithubInstallationID: proj.GithubInstallationID,
 		ProdVersion:          proj.ProdVersion,
 		ProdBranch:           proj.ProdBranch,
-		ProdVariables:        req.Variables,
+		ProdVariables:        proj.ProdVariables,
 		ProdDeploymentID:     proj.ProdDeploymentID,
 		ProdSlots:            proj.ProdSlots,
 		ProdTTLSeconds:       proj.ProdTTLSeconds,
 		Provisioner:          proj.Provisioner,
+		PreviewDeployments:   proj.PreviewDeployments,
+		EnvironmentVariables: proj.EnvironmentVariables,
 		Annotations:          proj.Annotations,
-	})
+	}
+	prevVariables := proj.ProdVariables
+	if req.Environment == "" {
+		opts.ProdVariables = req.Variables
+	} else {
+		prevVariables = proj.EnvironmentVariables[req.Environment]
+
+		// Copy the map to avoid mutating the project's variables before they're compared in UpdateProject
+		opts.EnvironmentVariables = make(map[string]map[string]string, len(proj.EnvironmentVariables)+1)
+		for env, vars := range proj.EnvironmentVariables {
+			opts.EnvironmentVariables[env] = vars
+		}
+		if len(req.Variables) == 0 {
+			delete(opts.EnvironmentVariables, req.Environment)
+		} else {
+			opts.EnvironmentVariables[req.Environment] = req.Variables
+		}
+	}
+
+	proj, err = s.admin.UpdateProject(ctx, proj, opts)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "variables updated failed with error %s", err.Error())
 	}
 
-	return &adminv1.UpdateProjectVariablesResponse{Variables: proj.ProdVariables}, nil
+	newVariables := proj.ProdVariables
+	if req.Environment != "" {
+		newVariables = proj.EnvironmentVariables[req.Environment]
+	}
+
+	auditChange(ctx, map[string]any{"variables": prevVariables, "environment": req.Environment}, map[string]any{"variables": newVariables, "environment": req.Environment})
+
+	return &adminv1.UpdateProjectVariablesResponse{Variables: newVariables}, nil
 }
 
 func (s *Server) ListProjectMembers(ctx context.Context, req *adminv1.ListProjectMembersRequest) (*adminv1.ListProjectMembersResponse, error) {
@@ -803,11 +893,22 @@ func (s *Server) SetProjectMemberRole(ctx context.Context, req *adminv1.SetProje
 		return &adminv1.SetProjectMemberRoleResponse{}, nil
 	}
 
+	prevRoles, err := s.admin.DB.ResolveProjectRolesForUser(ctx, user.ID, proj.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	err = s.admin.DB.UpdateProjectMemberUserRole(ctx, proj.ID, user.ID, role.ID)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	// Project roles are also granted through usergroups, so we record the user's effective roles.
+	newRoles, err := s.admin.DB.ResolveProjectRolesForUser(ctx, user.ID, proj.ID)
+	if err == nil {
+		auditChange(ctx, map[string]any{"roles": projectRoleNames(prevRoles)}, map[string]any{"roles": projectRoleNames(newRoles)})
+	}
+
 	return &adminv1.SetProjectMemberRoleResponse{}, nil
 }
 
@@ -880,6 +981,8 @@ func (s *Server) SudoUpdateAnnotations(ctx context.Context, req *adminv1.SudoUpd
 		ProdSlots:            proj.ProdSlots,
 		ProdTTLSeconds:       proj.ProdTTLSeconds,
 		Provisioner:          proj.Provisioner,
+		PreviewDeployments:   proj.PreviewDeployments,
+		EnvironmentVariables: proj.EnvironmentVariables,
 		Annotations:          req.Annotations,
 	})
 	if err != nil {
@@ -891,6 +994,26 @@ func (s *Server) SudoUpdateAnnotations(ctx context.Context, req *adminv1.SudoUpd
 	}, nil
 }
 
+func (s *Server) SudoRotateEncryptionKeys(ctx context.Context, req *adminv1.SudoRotateEncryptionKeysRequest) (*adminv1.SudoRotateEncryptionKeysResponse, error) {
+	// Check the request is made by a superuser
+	claims := auth.GetClaims(ctx)
+	if !claims.Superuser(ctx) {
+		return nil, status.Error(codes.PermissionDenied, "not authorized to rotate encryption keys")
+	}
+
+	n, err := s.admin.DB.RotateEncryptionKeys(ctx)
+	if err != nil {
+		if errors.Is(err, database.ErrEncryptionNotConfigured) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "rotated %d projects before failing: %s", n, err.Error())
+	}
+
+	return &adminv1.SudoRotateEncryptionKeysResponse{
+		RotatedProjects: int64(n),
+	}, nil
+}
+
 func (s *Server) CreateProjectWhitelistedDomain(ctx context.Context, req *adminv1.CreateProjectWhitelistedDomainRequest) (*adminv1.CreateProjectWhitelistedDomainResponse, error) {
 	observability.AddRequestAttributes(ctx,
 		attribute.String("args.organization", req.Organization),
@@ -984,6 +1107,8 @@ func (s *Server) CreateProjectWhitelistedDomain(ctx context.Context, req *adminv
 		return nil, err
 	}
 
+	auditChange(ctx, nil, map[string]any{"domain": req.Domain, "role": role.Name, "added_members": auditUserEmails(newUsers)})
+
 	return &adminv1.CreateProjectWhitelistedDomainResponse{}, nil
 }
 
@@ -1021,6 +1146,8 @@ func (s *Server) RemoveProjectWhitelistedDomain(ctx context.Context, req *adminv
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	auditChange(ctx, map[string]any{"domain": invite.Domain}, nil)
+
 	return &adminv1.RemoveProjectWhitelistedDomainResponse{}, nil
 }
 
@@ -1059,31 +1186,40 @@ func (s *Server) ListProjectWhitelistedDomains(ctx context.Context, req *adminv1
 	return &adminv1.ListProjectWhitelistedDomainsResponse{Domains: dtos}, nil
 }
 
+func projectRoleNames(roles []*database.ProjectRole) []string {
+	names := make([]string, len(roles))
+	for i, r := range roles {
+		names[i] = r.Name
+	}
+	return names
+}
+
 func (s *Server) projToDTO(p *database.Project, orgName string) *adminv1.Project {
 	frontendURL, _ := url.JoinPath(s.opts.FrontendURL, orgName, p.Name)
 
 	return &adminv1.Project{
-		Id:               p.ID,
-		Name:             p.Name,
-		OrgId:            p.OrganizationID,
-		OrgName:          orgName,
-		Description:      p.Description,
-		Public:           p.Public,
-		CreatedByUserId:  safeStr(p.CreatedByUserID),
-		Provisioner:      p.Provisioner,
-		ProdVersion:      p.ProdVersion,
-		ProdOlapDriver:   p.ProdOLAPDriver,
-		ProdOlapDsn:      p.ProdOLAPDSN,
-		ProdSlots:        int64(p.ProdSlots),
-		ProdBranch:       p.ProdBranch,
-		Subpath:          p.Subpath,
-		GithubUrl:        safeStr(p.GithubURL),
-		ProdDeploymentId: safeStr(p.ProdDeploymentID),
-		ProdTtlSeconds:   safeInt64(p.ProdTTLSeconds),
-		FrontendUrl:      frontendURL,
-		Annotations:      p.Annotations,
-		CreatedOn:        timestamppb.New(p.CreatedOn),
-		UpdatedOn:        timestamppb.New(p.UpdatedOn),
+		Id:                 p.ID,
+		Name:               p.Name,
+		OrgId:              p.OrganizationID,
+		OrgName:            orgName,
+		Description:        p.Description,
+		Public:             p.Public,
+		CreatedByUserId:    safeStr(p.CreatedByUserID),
+		Provisioner:        p.Provisioner,
+		ProdVersion:        p.ProdVersion,
+		ProdOlapDriver:     p.ProdOLAPDriver,
+		ProdOlapDsn:        p.ProdOLAPDSN,
+		ProdSlots:          int64(p.ProdSlots),
+		ProdBranch:         p.ProdBranch,
+		Subpath:            p.Subpath,
+		GithubUrl:          safeStr(p.GithubURL),
+		ProdDeploymentId:   safeStr(p.ProdDeploymentID),
+		ProdTtlSeconds:     safeInt64(p.ProdTTLSeconds),
+		PreviewDeployments: p.PreviewDeployments,
+		FrontendUrl:        frontendURL,
+		Annotations:        p.Annotations,
+		CreatedOn:          timestamppb.New(p.CreatedOn),
+		UpdatedOn:          timestamppb.New(p.UpdatedOn),
 	}
 }
 
@@ -1102,6 +1238,11 @@ func deploymentToDTO(d *database.Deployment) *adminv1.Deployment {
 		panic(fmt.Errorf("unhandled deployment status %d", d.Status))
 	}
 
+	var closedOn *timestamppb.Timestamp
+	if d.ClosedOn != nil {
+		closedOn = timestamppb.New(*d.ClosedOn)
+	}
+
 	return &adminv1.Deployment{
 		Id:                d.ID,
 		ProjectId:         d.ProjectID,
@@ -1111,6 +1252,11 @@ func deploymentToDTO(d *database.Deployment) *adminv1.Deployment {
 		RuntimeInstanceId: d.RuntimeInstanceID,
 		Status:            s,
 		StatusMessage:     d.StatusMessage,
+		Environment:       d.Environment,
+		Preview:           d.Preview,
+		PullRequestNumber: int64(valOrDefault(d.PullRequestNumber, 0)),
+		PullRequestUrl:    d.PullRequestURL,
+		ClosedOn:          closedOn,
 		CreatedOn:         timestamppb.New(d.CreatedOn),
 		UpdatedOn:         timestamppb.New(d.UpdatedOn),
 	}
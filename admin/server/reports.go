@@ -474,6 +474,10 @@ func (s *Server) yamlForCommittedReport(opts *adminv1.ReportOptions) ([]byte, er
 		exportFormat = "parquet"
 	case runtimev1.ExportFormat_EXPORT_FORMAT_XLSX:
 		exportFormat = "xlsx"
+	case runtimev1.ExportFormat_EXPORT_FORMAT_JSONL:
+		exportFormat = "jsonl"
+	case runtimev1.ExportFormat_EXPORT_FORMAT_ARROW:
+		exportFormat = "arrow"
 	default:
 		exportFormat = opts.ExportFormat.String()
 	}
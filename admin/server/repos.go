@@ -37,7 +37,11 @@ func (s *Server) GetRepoMeta(ctx context.Context, req *adminv1.GetRepoMetaReques
 		return nil, status.Error(codes.PermissionDenied, "does not have permission to read project repo")
 	}
 
-	if proj.ProdBranch != req.Branch {
+	ok, err := s.isDeployedBranch(ctx, proj, req.Branch)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !ok {
 		return nil, status.Error(codes.InvalidArgument, "branch not found")
 	}
 
@@ -81,7 +85,11 @@ func (s *Server) PullVirtualRepo(ctx context.Context, req *adminv1.PullVirtualRe
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	if proj.ProdBranch != req.Branch {
+	ok, err := s.isDeployedBranch(ctx, proj, req.Branch)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !ok {
 		return nil, status.Error(codes.InvalidArgument, "branch not found")
 	}
 
@@ -120,6 +128,21 @@ func (s *Server) PullVirtualRepo(ctx context.Context, req *adminv1.PullVirtualRe
 	}, nil
 }
 
+// isDeployedBranch returns true if the branch is the project's prod branch or has a preview deployment.
+func (s *Server) isDeployedBranch(ctx context.Context, proj *database.Project, branch string) (bool, error) {
+	if branch == proj.ProdBranch {
+		return true, nil
+	}
+	_, err := s.admin.DB.FindPreviewDeploymentForBranch(ctx, proj.ID, branch)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
 func virtualFileToDTO(vf *database.VirtualFile) *adminv1.VirtualFile {
 	return &adminv1.VirtualFile{
 		Path:      vf.Path,
@@ -0,0 +1,1368 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/mail"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/admin/pkg/sso"
+	"github.com/rilldata/rill/admin/pkg/urlutil"
+	"github.com/rilldata/rill/admin/server/auth"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"go.uber.org/zap"
+)
+
+// SCIM 2.0 provisioning endpoints (RFC 7643 and RFC 7644).
+//
+// The endpoints are authenticated with a service token and operate on the organization that the service belongs to.
+// Users are global in Rill, so a SCIM user is "active" if it's a member of the organization.
+// Deactivating or deleting a SCIM user removes it from the organization, its usergroups and its projects, but doesn't delete the Rill user.
+// Existing users can only be provisioned if their email is in one of the organization's verified SSO domains.
+// Users can only be updated if they are members of the organization or were provisioned by it (so deactivated users can be re-activated).
+// Since profile fields are shared across orgs, a user's name is only updated if it doesn't belong to any other organization.
+// SCIM groups map to the organization's usergroups (except the built-in all-users group).
+// Since usergroup names must be slugs, group display names are converted to slugs (e.g. "Data Team" becomes "Data-Team").
+
+const (
+	scimSchemaUser                  = "urn:ietf:params:scim:schemas:core:2.0:User"
+	scimSchemaGroup                 = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	scimSchemaServiceProviderConfig = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+	scimSchemaListResponse          = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	scimSchemaError                 = "urn:ietf:params:scim:api:messages:2.0:Error"
+	scimContentType                 = "application/scim+json"
+	scimDefaultPageSize             = 100
+	scimMaxPageSize                 = 1000
+	scimMaxBodyBytes                = 1 << 20
+)
+
+// registerSCIMEndpoints registers the non-gRPC endpoints for SCIM provisioning on /scim/v2/*.
+func (s *Server) registerSCIMEndpoints(mux *http.ServeMux) {
+	inner := http.NewServeMux()
+	// Mutating endpoints have an action name for audit events
+	handle := func(pattern, action string, fn scimHandlerFunc) {
+		observability.MuxHandle(inner, pattern, s.authenticator.HTTPMiddleware(s.scimHandler(action, fn)))
+	}
+	handle("GET /scim/v2/ServiceProviderConfig", "", s.scimServiceProviderConfig)
+	handle("GET /scim/v2/Users", "", s.scimListUsers)
+	handle("POST /scim/v2/Users", "SCIMCreateUser", s.scimCreateUser)
+	handle("GET /scim/v2/Users/{id}", "", s.scimGetUser)
+	handle("PUT /scim/v2/Users/{id}", "SCIMReplaceUser", s.scimReplaceUser)
+	handle("PATCH /scim/v2/Users/{id}", "SCIMPatchUser", s.scimPatchUser)
+	handle("DELETE /scim/v2/Users/{id}", "SCIMDeleteUser", s.scimDeleteUser)
+	handle("GET /scim/v2/Groups", "", s.scimListGroups)
+	handle("POST /scim/v2/Groups", "SCIMCreateGroup", s.scimCreateGroup)
+	handle("GET /scim/v2/Groups/{id}", "", s.scimGetGroup)
+	handle("PUT /scim/v2/Groups/{id}", "SCIMReplaceGroup", s.scimReplaceGroup)
+	handle("PATCH /scim/v2/Groups/{id}", "SCIMPatchGroup", s.scimPatchGroup)
+	handle("DELETE /scim/v2/Groups/{id}", "SCIMDeleteGroup", s.scimDeleteGroup)
+	mux.Handle("/scim/", observability.Middleware("admin", s.logger, inner))
+}
+
+// scimHandlerFunc handles a SCIM request for the organization of the calling service.
+type scimHandlerFunc func(w http.ResponseWriter, r *http.Request, org *database.Organization) error
+
+// scimHandler authenticates a SCIM request and writes returned errors as SCIM error responses.
+// If action is not empty, successful requests are recorded as audit events with that action.
+func (s *Server) scimHandler(action string, fn scimHandlerFunc) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		err := s.scimServe(w, r, action, fn)
+		if err == nil {
+			return
+		}
+
+		var serr *scimError
+		if !errors.As(err, &serr) {
+			switch {
+			case errors.Is(err, database.ErrNotFound):
+				serr = &scimError{status: http.StatusNotFound, detail: err.Error()}
+			case errors.Is(err, database.ErrNotUnique):
+				serr = &scimError{status: http.StatusConflict, scimType: "uniqueness", detail: err.Error()}
+			default:
+				s.logger.Error("scim: request failed", zap.String("path", r.URL.Path), zap.Error(err), observability.ZapCtx(r.Context()))
+				serr = &scimError{status: http.StatusInternalServerError, detail: "internal error"}
+			}
+		}
+
+		writeSCIM(w, serr.status, map[string]any{
+			"schemas":  []string{scimSchemaError},
+			"status":   strconv.Itoa(serr.status),
+			"scimType": serr.scimType,
+			"detail":   serr.detail,
+		})
+	})
+}
+
+func (s *Server) scimServe(w http.ResponseWriter, r *http.Request, action string, fn scimHandlerFunc) error {
+	// SCIM clients must authenticate with a service token
+	claims := auth.GetClaims(r.Context())
+	if claims.OwnerType() != auth.OwnerTypeService {
+		return &scimError{status: http.StatusUnauthorized, detail: "a service token is required"}
+	}
+
+	svc, err := s.admin.DB.FindService(r.Context(), claims.OwnerID())
+	if err != nil {
+		return err
+	}
+
+	if !claims.OrganizationPermissions(r.Context(), svc.OrgID).ManageOrgMembers {
+		return &scimError{status: http.StatusForbidden, detail: "not allowed to manage org members"}
+	}
+
+	org, err := s.admin.DB.FindOrganization(r.Context(), svc.OrgID)
+	if err != nil {
+		return err
+	}
+
+	if action == "" {
+		return fn(w, r, org)
+	}
+	return s.scimServeAudited(w, r, org, action, fn)
+}
+
+// scimServeAudited calls a mutating SCIM handler and records an audit event if it succeeds.
+// The audit payload is the request body and the ID of the user or group in the path.
+func (s *Server) scimServeAudited(w http.ResponseWriter, r *http.Request, org *database.Organization, action string, fn scimHandlerFunc) error {
+	body, err := io.ReadAll(io.LimitReader(r.Body, scimMaxBodyBytes))
+	if err != nil {
+		return &scimError{status: http.StatusBadRequest, scimType: "invalidSyntax", detail: err.Error()}
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	rec := &auditRecord{}
+	err = fn(w, r.WithContext(context.WithValue(r.Context(), auditContextKey{}, rec)), org)
+	if err != nil {
+		return err
+	}
+
+	payload := map[string]any{}
+	if len(body) > 0 {
+		_ = json.Unmarshal(body, &payload)
+	}
+	if id := r.PathValue("id"); id != "" {
+		payload["id"] = id
+	}
+
+	s.recordAuditEvent(r.Context(), s.newAuditEvent(r.Context(), action, redactAuditMap(payload), auditTarget{orgID: org.ID}, rec))
+	return nil
+}
+
+func (s *Server) scimServiceProviderConfig(w http.ResponseWriter, r *http.Request, org *database.Organization) error {
+	writeSCIM(w, http.StatusOK, map[string]any{
+		"schemas":        []string{scimSchemaServiceProviderConfig},
+		"patch":          map[string]any{"supported": true},
+		"bulk":           map[string]any{"supported": false, "maxOperations": 0, "maxPayloadSize": 0},
+		"filter":         map[string]any{"supported": true, "maxResults": scimMaxPageSize},
+		"changePassword": map[string]any{"supported": false},
+		"sort":           map[string]any{"supported": false},
+		"etag":           map[string]any{"supported": false},
+		"authenticationSchemes": []map[string]any{{
+			"type":        "oauthbearertoken",
+			"name":        "OAuth Bearer Token",
+			"description": "Authentication with a Rill service token",
+			"primary":     true,
+		}},
+	})
+	return nil
+}
+
+func (s *Server) scimListUsers(w http.ResponseWriter, r *http.Request, org *database.Organization) error {
+	ctx := r.Context()
+
+	filter, err := parseSCIMFilter(r.URL.Query().Get("filter"))
+	if err != nil {
+		return err
+	}
+
+	var users []*scimUser
+	switch {
+	case filter == nil:
+		members, err := s.findAllOrganizationMembers(ctx, org.ID)
+		if err != nil {
+			return err
+		}
+		for _, m := range members {
+			users = append(users, s.scimUserFromMember(m))
+		}
+	case filter.attr == "username" || filter.attr == "emails.value" || filter.attr == "emails" || filter.attr == "id":
+		var user *database.User
+		if filter.attr == "id" {
+			user, err = s.findSCIMUser(ctx, filter.value)
+		} else {
+			user, err = s.admin.DB.FindUserByEmail(ctx, filter.value)
+		}
+		if err != nil && !isSCIMNotFound(err) {
+			return err
+		}
+		if err == nil {
+			ok, err := s.admin.DB.CheckUserIsAnOrganizationMember(ctx, user.ID, org.ID)
+			if err != nil {
+				return err
+			}
+			if ok {
+				users = append(users, s.scimUserFromUser(user, true))
+			}
+		}
+	default:
+		return &scimError{status: http.StatusBadRequest, scimType: "invalidFilter", detail: fmt.Sprintf("filtering on %q is not supported", filter.attr)}
+	}
+
+	return writeSCIMList(w, r, users)
+}
+
+func (s *Server) scimCreateUser(w http.ResponseWriter, r *http.Request, org *database.Organization) error {
+	ctx := r.Context()
+
+	req := &scimUser{}
+	if err := readSCIM(r, req); err != nil {
+		return err
+	}
+
+	email := req.email()
+	if email == "" {
+		return &scimError{status: http.StatusBadRequest, scimType: "invalidValue", detail: "userName or a primary email must be a valid email address"}
+	}
+
+	user, err := s.admin.DB.FindUserByEmail(ctx, email)
+	if err != nil {
+		if !errors.Is(err, database.ErrNotFound) {
+			return err
+		}
+		// This also adds the user to orgs and projects it has been invited to
+		user, err = s.admin.CreateOrUpdateUser(ctx, email, req.displayName(), "")
+		if err != nil {
+			return err
+		}
+	} else {
+		ok, err := s.admin.DB.CheckUserIsAnOrganizationMember(ctx, user.ID, org.ID)
+		if err != nil {
+			return err
+		}
+		if ok {
+			return &scimError{status: http.StatusConflict, scimType: "uniqueness", detail: "user is already a member of the organization"}
+		}
+
+		// Users are global, so an org can only provision existing users whose email is in one of its verified SSO domains.
+		// Other existing users must be added to the org through Rill.
+		managed, err := s.scimManagesEmailDomain(ctx, org, user.Email)
+		if err != nil {
+			return err
+		}
+		if !managed {
+			return &scimError{status: http.StatusConflict, scimType: "uniqueness", detail: "an existing user's email must be in one of the organization's verified SSO domains to provision it"}
+		}
+	}
+
+	err = s.admin.DB.InsertSCIMProvisionedUser(ctx, org.ID, user.ID)
+	if err != nil {
+		return err
+	}
+
+	active := req.Active == nil || *req.Active
+	active, err = s.setSCIMUserActive(ctx, org, user, active)
+	if err != nil {
+		return err
+	}
+
+	writeSCIM(w, http.StatusCreated, s.scimUserFromUser(user, active))
+	return nil
+}
+
+func (s *Server) scimGetUser(w http.ResponseWriter, r *http.Request, org *database.Organization) error {
+	user, err := s.findSCIMMemberUser(r.Context(), org, r.PathValue("id"))
+	if err != nil {
+		return err
+	}
+
+	writeSCIM(w, http.StatusOK, s.scimUserFromUser(user, true))
+	return nil
+}
+
+func (s *Server) scimReplaceUser(w http.ResponseWriter, r *http.Request, org *database.Organization) error {
+	ctx := r.Context()
+
+	// Deactivated users are not members of the org, so we also accept users provisioned by the org (to allow re-activation).
+	user, err := s.findSCIMManagedUser(ctx, org, r.PathValue("id"))
+	if err != nil {
+		return err
+	}
+
+	req := &scimUser{}
+	if err := readSCIM(r, req); err != nil {
+		return err
+	}
+
+	if email := req.email(); email != "" && !strings.EqualFold(email, user.Email) {
+		return &scimError{status: http.StatusBadRequest, scimType: "mutability", detail: "changing a user's email is not supported"}
+	}
+
+	user, err = s.updateSCIMUserName(ctx, org, user, req.displayName())
+	if err != nil {
+		return err
+	}
+
+	active, err := s.setSCIMUserActive(ctx, org, user, req.Active == nil || *req.Active)
+	if err != nil {
+		return err
+	}
+
+	writeSCIM(w, http.StatusOK, s.scimUserFromUser(user, active))
+	return nil
+}
+
+func (s *Server) scimPatchUser(w http.ResponseWriter, r *http.Request, org *database.Organization) error {
+	ctx := r.Context()
+
+	// See note in scimReplaceUser about membership.
+	user, err := s.findSCIMManagedUser(ctx, org, r.PathValue("id"))
+	if err != nil {
+		return err
+	}
+
+	req := &scimPatchRequest{}
+	if err := readSCIM(r, req); err != nil {
+		return err
+	}
+
+	// Collect the changes. Attributes that Rill doesn't store (e.g. title or phone numbers) are ignored.
+	var active *bool
+	patch := &scimUser{Name: &scimName{}}
+	for _, op := range req.Operations {
+		if op.op() == "remove" {
+			continue
+		}
+
+		path := strings.ToLower(op.Path)
+		if path == "" {
+			vals := &scimUser{}
+			if err := json.Unmarshal(op.Value, vals); err != nil {
+				return &scimError{status: http.StatusBadRequest, scimType: "invalidValue", detail: err.Error()}
+			}
+			if vals.Active != nil {
+				active = vals.Active
+			}
+			patch.merge(vals)
+			continue
+		}
+
+		switch path {
+		case "active":
+			v, err := parseSCIMBool(op.Value)
+			if err != nil {
+				return err
+			}
+			active = &v
+		case "displayname", "name.formatted", "name.givenname", "name.familyname":
+			var v string
+			if err := json.Unmarshal(op.Value, &v); err != nil {
+				return &scimError{status: http.StatusBadRequest, scimType: "invalidValue", detail: err.Error()}
+			}
+			switch path {
+			case "displayname":
+				patch.DisplayName = v
+			case "name.formatted":
+				patch.Name.Formatted = v
+			case "name.givenname":
+				patch.Name.GivenName = v
+			case "name.familyname":
+				patch.Name.FamilyName = v
+			}
+		}
+	}
+
+	if name := patch.displayName(); name != "" {
+		user, err = s.updateSCIMUserName(ctx, org, user, name)
+		if err != nil {
+			return err
+		}
+	}
+
+	isMember, err := s.admin.DB.CheckUserIsAnOrganizationMember(ctx, user.ID, org.ID)
+	if err != nil {
+		return err
+	}
+	if active != nil {
+		isMember, err = s.setSCIMUserActive(ctx, org, user, *active)
+		if err != nil {
+			return err
+		}
+	}
+
+	writeSCIM(w, http.StatusOK, s.scimUserFromUser(user, isMember))
+	return nil
+}
+
+func (s *Server) scimDeleteUser(w http.ResponseWriter, r *http.Request, org *database.Organization) error {
+	user, err := s.findSCIMMemberUser(r.Context(), org, r.PathValue("id"))
+	if err != nil {
+		return err
+	}
+
+	_, err = s.setSCIMUserActive(r.Context(), org, user, false)
+	if err != nil {
+		return err
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+func (s *Server) scimListGroups(w http.ResponseWriter, r *http.Request, org *database.Organization) error {
+	ctx := r.Context()
+
+	filter, err := parseSCIMFilter(r.URL.Query().Get("filter"))
+	if err != nil {
+		return err
+	}
+
+	var groups []*database.Usergroup
+	switch {
+	case filter == nil:
+		groups, err = s.findAllSCIMGroups(ctx, org)
+		if err != nil {
+			return err
+		}
+	case filter.attr == "displayname" || filter.attr == "id":
+		var group *database.Usergroup
+		if filter.attr == "id" {
+			group, err = s.findSCIMGroup(ctx, org, filter.value)
+		} else {
+			group, err = s.admin.DB.FindUsergroupByName(ctx, org.Name, scimGroupName(filter.value))
+			if err == nil && !isSCIMGroup(org, group) {
+				group = nil
+			}
+		}
+		if err != nil && !isSCIMNotFound(err) {
+			return err
+		}
+		if err == nil && group != nil {
+			groups = append(groups, group)
+		}
+	default:
+		return &scimError{status: http.StatusBadRequest, scimType: "invalidFilter", detail: fmt.Sprintf("filtering on %q is not supported", filter.attr)}
+	}
+
+	withMembers := !scimAttributeExcluded(r, "members")
+	res := make([]*scimGroup, 0, len(groups))
+	for _, g := range groups {
+		sg, err := s.scimGroupFromUsergroup(ctx, g, withMembers)
+		if err != nil {
+			return err
+		}
+		res = append(res, sg)
+	}
+
+	return writeSCIMList(w, r, res)
+}
+
+func (s *Server) scimCreateGroup(w http.ResponseWriter, r *http.Request, org *database.Organization) error {
+	ctx := r.Context()
+
+	req := &scimGroup{}
+	if err := readSCIM(r, req); err != nil {
+		return err
+	}
+
+	name, err := validSCIMGroupName(req.DisplayName)
+	if err != nil {
+		return err
+	}
+
+	ctx, tx, err := s.admin.DB.NewTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	group, err := s.admin.DB.InsertUsergroup(ctx, &database.InsertUsergroupOptions{OrgID: org.ID, Name: name})
+	if err != nil {
+		return err
+	}
+
+	for _, m := range req.Members {
+		err = s.addSCIMGroupMember(ctx, org, group, m.Value)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	res, err := s.scimGroupFromUsergroup(r.Context(), group, true)
+	if err != nil {
+		return err
+	}
+
+	writeSCIM(w, http.StatusCreated, res)
+	return nil
+}
+
+func (s *Server) scimGetGroup(w http.ResponseWriter, r *http.Request, org *database.Organization) error {
+	group, err := s.findSCIMGroup(r.Context(), org, r.PathValue("id"))
+	if err != nil {
+		return err
+	}
+
+	res, err := s.scimGroupFromUsergroup(r.Context(), group, !scimAttributeExcluded(r, "members"))
+	if err != nil {
+		return err
+	}
+
+	writeSCIM(w, http.StatusOK, res)
+	return nil
+}
+
+func (s *Server) scimReplaceGroup(w http.ResponseWriter, r *http.Request, org *database.Organization) error {
+	ctx := r.Context()
+
+	group, err := s.findSCIMGroup(ctx, org, r.PathValue("id"))
+	if err != nil {
+		return err
+	}
+
+	req := &scimGroup{}
+	if err := readSCIM(r, req); err != nil {
+		return err
+	}
+
+	ctx, tx, err := s.admin.DB.NewTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	group, err = s.renameSCIMGroup(ctx, group, req.DisplayName)
+	if err != nil {
+		return err
+	}
+
+	err = s.replaceSCIMGroupMembers(ctx, org, group, req.Members)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	res, err := s.scimGroupFromUsergroup(r.Context(), group, true)
+	if err != nil {
+		return err
+	}
+
+	writeSCIM(w, http.StatusOK, res)
+	return nil
+}
+
+func (s *Server) scimPatchGroup(w http.ResponseWriter, r *http.Request, org *database.Organization) error {
+	ctx := r.Context()
+
+	group, err := s.findSCIMGroup(ctx, org, r.PathValue("id"))
+	if err != nil {
+		return err
+	}
+
+	req := &scimPatchRequest{}
+	if err := readSCIM(r, req); err != nil {
+		return err
+	}
+
+	ctx, tx, err := s.admin.DB.NewTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	for _, op := range req.Operations {
+		group, err = s.applySCIMGroupPatch(ctx, org, group, op)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	res, err := s.scimGroupFromUsergroup(r.Context(), group, !scimAttributeExcluded(r, "members"))
+	if err != nil {
+		return err
+	}
+
+	writeSCIM(w, http.StatusOK, res)
+	return nil
+}
+
+func (s *Server) scimDeleteGroup(w http.ResponseWriter, r *http.Request, org *database.Organization) error {
+	group, err := s.findSCIMGroup(r.Context(), org, r.PathValue("id"))
+	if err != nil {
+		return err
+	}
+
+	err = s.admin.DB.DeleteUsergroup(r.Context(), group.ID)
+	if err != nil {
+		return err
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+// applySCIMGroupPatch applies a single PATCH operation to a group.
+func (s *Server) applySCIMGroupPatch(ctx context.Context, org *database.Organization, group *database.Usergroup, op *scimPatchOp) (*database.Usergroup, error) {
+	path := strings.TrimSpace(op.Path)
+
+	// Without a path, the value is an object of attributes to add or replace.
+	if path == "" {
+		if op.op() == "remove" {
+			return nil, &scimError{status: http.StatusBadRequest, scimType: "noTarget", detail: "remove operations require a path"}
+		}
+		vals := &scimGroup{}
+		if err := json.Unmarshal(op.Value, vals); err != nil {
+			return nil, &scimError{status: http.StatusBadRequest, scimType: "invalidValue", detail: err.Error()}
+		}
+		group, err := s.renameSCIMGroup(ctx, group, vals.DisplayName)
+		if err != nil {
+			return nil, err
+		}
+		if vals.Members != nil {
+			if op.op() == "replace" {
+				return group, s.replaceSCIMGroupMembers(ctx, org, group, vals.Members)
+			}
+			for _, m := range vals.Members {
+				if err := s.addSCIMGroupMember(ctx, org, group, m.Value); err != nil {
+					return nil, err
+				}
+			}
+		}
+		return group, nil
+	}
+
+	if strings.EqualFold(path, "displayName") {
+		if op.op() == "remove" {
+			return nil, &scimError{status: http.StatusBadRequest, scimType: "mutability", detail: "displayName is required"}
+		}
+		var name string
+		if err := json.Unmarshal(op.Value, &name); err != nil {
+			return nil, &scimError{status: http.StatusBadRequest, scimType: "invalidValue", detail: err.Error()}
+		}
+		return s.renameSCIMGroup(ctx, group, name)
+	}
+
+	// Handle a path that selects a single member, e.g. `members[value eq "<id>"]`
+	if len(path) > len("members[") && strings.EqualFold(path[:len("members[")], "members[") && strings.HasSuffix(path, "]") {
+		filter, err := parseSCIMFilter(path[len("members[") : len(path)-1])
+		if err != nil {
+			return nil, err
+		}
+		if filter == nil || filter.attr != "value" {
+			return nil, &scimError{status: http.StatusBadRequest, scimType: "invalidPath", detail: fmt.Sprintf("unsupported path %q", path)}
+		}
+		if op.op() != "remove" {
+			return nil, &scimError{status: http.StatusBadRequest, scimType: "invalidPath", detail: fmt.Sprintf("unsupported %s operation on path %q", op.Op, path)}
+		}
+		return group, s.removeSCIMGroupMember(ctx, group, filter.value)
+	}
+
+	if !strings.EqualFold(path, "members") {
+		return nil, &scimError{status: http.StatusBadRequest, scimType: "invalidPath", detail: fmt.Sprintf("unsupported path %q", path)}
+	}
+
+	var members []*scimMember
+	if len(op.Value) > 0 {
+		if err := json.Unmarshal(op.Value, &members); err != nil {
+			return nil, &scimError{status: http.StatusBadRequest, scimType: "invalidValue", detail: err.Error()}
+		}
+	}
+
+	switch op.op() {
+	case "add":
+		for _, m := range members {
+			if err := s.addSCIMGroupMember(ctx, org, group, m.Value); err != nil {
+				return nil, err
+			}
+		}
+	case "remove":
+		// Some clients send the members to remove as the value instead of in the path. No value removes all members.
+		if members == nil {
+			return group, s.replaceSCIMGroupMembers(ctx, org, group, nil)
+		}
+		for _, m := range members {
+			if err := s.removeSCIMGroupMember(ctx, group, m.Value); err != nil {
+				return nil, err
+			}
+		}
+	case "replace":
+		return group, s.replaceSCIMGroupMembers(ctx, org, group, members)
+	}
+	return group, nil
+}
+
+// setSCIMUserActive adds or removes a user from the org. It returns the resulting active state.
+// Removing a user from the org also removes it from the org's usergroups and projects.
+func (s *Server) setSCIMUserActive(ctx context.Context, org *database.Organization, user *database.User, active bool) (bool, error) {
+	isMember, err := s.admin.DB.CheckUserIsAnOrganizationMember(ctx, user.ID, org.ID)
+	if err != nil {
+		return false, err
+	}
+	if isMember == active {
+		return active, nil
+	}
+
+	if active {
+		role, err := s.admin.DB.FindOrganizationRole(ctx, database.OrganizationRoleNameViewer)
+		if err != nil {
+			return false, err
+		}
+
+		ctx, tx, err := s.admin.DB.NewTx(ctx)
+		if err != nil {
+			return false, err
+		}
+		defer func() { _ = tx.Rollback() }()
+
+		err = s.admin.DB.InsertOrganizationMemberUser(ctx, org.ID, user.ID, role.ID)
+		if err != nil {
+			return false, err
+		}
+
+		err = s.admin.DB.InsertUsergroupMember(ctx, *org.AllUsergroupID, user.ID)
+		if err != nil && !errors.Is(err, database.ErrNotUnique) {
+			return false, err
+		}
+
+		return true, tx.Commit()
+	}
+
+	// Check that the user is not the last admin
+	role, err := s.admin.DB.FindOrganizationRole(ctx, database.OrganizationRoleNameAdmin)
+	if err != nil {
+		return false, err
+	}
+	admins, err := s.admin.DB.FindOrganizationMemberUsersByRole(ctx, org.ID, role.ID)
+	if err != nil {
+		return false, err
+	}
+	if len(admins) == 1 && admins[0].ID == user.ID {
+		return false, &scimError{status: http.StatusBadRequest, scimType: "mutability", detail: "cannot deactivate the last admin member"}
+	}
+
+	ctx, tx, err := s.admin.DB.NewTx(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	err = s.admin.DB.DeleteOrganizationMemberUser(ctx, org.ID, user.ID)
+	if err != nil {
+		return false, err
+	}
+
+	err = s.admin.DB.DeleteUsergroupsMemberUser(ctx, org.ID, user.ID)
+	if err != nil {
+		return false, err
+	}
+
+	err = s.admin.DB.DeleteAllProjectMemberUserForOrganization(ctx, org.ID, user.ID)
+	if err != nil {
+		return false, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return false, err
+	}
+
+	s.logger.Info("scim: deactivated user", zap.String("org", org.Name), zap.String("user_id", user.ID), observability.ZapCtx(ctx))
+	return false, nil
+}
+
+// scimManagesEmailDomain returns true if the email's domain is one of the org's verified SSO domains.
+func (s *Server) scimManagesEmailDomain(ctx context.Context, org *database.Organization, email string) (bool, error) {
+	p, err := s.admin.DB.FindSSOProviderForOrganization(ctx, org.ID)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	domain, err := sso.EmailDomain(email)
+	if err != nil {
+		return false, &scimError{status: http.StatusBadRequest, scimType: "invalidValue", detail: err.Error()}
+	}
+
+	for _, d := range p.VerifiedDomains {
+		if strings.EqualFold(d, domain) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// updateSCIMUserName updates the user's display name.
+// Users are global, so it's skipped for users that belong to other orgs (to prevent one org from changing another org's users).
+func (s *Server) updateSCIMUserName(ctx context.Context, org *database.Organization, user *database.User, name string) (*database.User, error) {
+	if name == "" || name == user.DisplayName {
+		return user, nil
+	}
+
+	orgs, err := s.admin.DB.FindOrganizationsForUser(ctx, user.ID, "", 2)
+	if err != nil {
+		return nil, err
+	}
+	for _, o := range orgs {
+		if o.ID != org.ID {
+			s.logger.Info("scim: skipped renaming user that belongs to other orgs", zap.String("org", org.Name), zap.String("user_id", user.ID), observability.ZapCtx(ctx))
+			return user, nil
+		}
+	}
+
+	return s.admin.DB.UpdateUser(ctx, user.ID, &database.UpdateUserOptions{
+		DisplayName:         name,
+		PhotoURL:            user.PhotoURL,
+		GithubUsername:      user.GithubUsername,
+		GithubRefreshToken:  user.GithubRefreshToken,
+		QuotaSingleuserOrgs: user.QuotaSingleuserOrgs,
+		PreferenceTimeZone:  user.PreferenceTimeZone,
+	})
+}
+
+func (s *Server) renameSCIMGroup(ctx context.Context, group *database.Usergroup, displayName string) (*database.Usergroup, error) {
+	if displayName == "" {
+		return group, nil
+	}
+	name, err := validSCIMGroupName(displayName)
+	if err != nil {
+		return nil, err
+	}
+	if name == group.Name {
+		return group, nil
+	}
+	return s.admin.DB.UpdateUsergroupName(ctx, group.ID, &database.UpdateUsergroupOptions{Name: name})
+}
+
+// addSCIMGroupMember adds a user to a group. Only members of the org can be added to its groups.
+func (s *Server) addSCIMGroupMember(ctx context.Context, org *database.Organization, group *database.Usergroup, userID string) error {
+	if _, err := uuid.Parse(userID); err != nil {
+		return &scimError{status: http.StatusBadRequest, scimType: "invalidValue", detail: fmt.Sprintf("invalid member %q", userID)}
+	}
+
+	ok, err := s.admin.DB.CheckUserIsAnOrganizationMember(ctx, userID, org.ID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return &scimError{status: http.StatusBadRequest, scimType: "invalidValue", detail: fmt.Sprintf("member %q is not an active user of the organization", userID)}
+	}
+
+	// Check for existing membership instead of ignoring the unique violation, since a failed statement aborts the caller's transaction
+	groups, err := s.admin.DB.FindUsergroupsForUser(ctx, userID, org.ID)
+	if err != nil {
+		return err
+	}
+	for _, g := range groups {
+		if g.ID == group.ID {
+			return nil
+		}
+	}
+
+	return s.admin.DB.InsertUsergroupMember(ctx, group.ID, userID)
+}
+
+// removeSCIMGroupMember removes a user from a group. Unknown users are ignored.
+func (s *Server) removeSCIMGroupMember(ctx context.Context, group *database.Usergroup, userID string) error {
+	if _, err := uuid.Parse(userID); err != nil {
+		return nil
+	}
+	return s.admin.DB.DeleteUsergroupMember(ctx, group.ID, userID)
+}
+
+// replaceSCIMGroupMembers sets the group's members to exactly the given users.
+// It must be called with a ctx from DB.NewTx so that the group's members are replaced atomically.
+func (s *Server) replaceSCIMGroupMembers(ctx context.Context, org *database.Organization, group *database.Usergroup, members []*scimMember) error {
+	current, err := s.findAllUsergroupMembers(ctx, group.ID)
+	if err != nil {
+		return err
+	}
+
+	keep := make(map[string]bool, len(members))
+	for _, m := range members {
+		keep[m.Value] = true
+	}
+
+	for _, u := range current {
+		if keep[u.ID] {
+			delete(keep, u.ID)
+			continue
+		}
+		err = s.admin.DB.DeleteUsergroupMember(ctx, group.ID, u.ID)
+		if err != nil {
+			return err
+		}
+	}
+
+	for id := range keep {
+		err = s.addSCIMGroupMember(ctx, org, group, id)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// findSCIMUser finds a user by ID. Malformed IDs are treated as not found.
+func (s *Server) findSCIMUser(ctx context.Context, id string) (*database.User, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, &scimError{status: http.StatusNotFound, detail: "user not found"}
+	}
+	return s.admin.DB.FindUser(ctx, id)
+}
+
+// findSCIMMemberUser finds a user by ID and returns not found if it's not a member of the org.
+func (s *Server) findSCIMMemberUser(ctx context.Context, org *database.Organization, id string) (*database.User, error) {
+	user, err := s.findSCIMUser(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	ok, err := s.admin.DB.CheckUserIsAnOrganizationMember(ctx, user.ID, org.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, &scimError{status: http.StatusNotFound, detail: "user not found"}
+	}
+	return user, nil
+}
+
+// findSCIMManagedUser finds a user by ID and returns not found if it's neither a member of the org nor provisioned by it.
+func (s *Server) findSCIMManagedUser(ctx context.Context, org *database.Organization, id string) (*database.User, error) {
+	user, err := s.findSCIMUser(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	ok, err := s.admin.DB.CheckUserIsAnOrganizationMember(ctx, user.ID, org.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		ok, err = s.admin.DB.CheckUserIsSCIMProvisioned(ctx, user.ID, org.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if !ok {
+		return nil, &scimError{status: http.StatusNotFound, detail: "user not found"}
+	}
+	return user, nil
+}
+
+// findSCIMGroup finds a usergroup of the org by ID.
+func (s *Server) findSCIMGroup(ctx context.Context, org *database.Organization, id string) (*database.Usergroup, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, &scimError{status: http.StatusNotFound, detail: "group not found"}
+	}
+	group, err := s.admin.DB.FindUsergroup(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !isSCIMGroup(org, group) {
+		return nil, &scimError{status: http.StatusNotFound, detail: "group not found"}
+	}
+	return group, nil
+}
+
+func (s *Server) findAllSCIMGroups(ctx context.Context, org *database.Organization) ([]*database.Usergroup, error) {
+	var res []*database.Usergroup
+	afterName := ""
+	for {
+		groups, err := s.admin.DB.FindUsergroupsForOrganization(ctx, org.ID, afterName, scimMaxPageSize)
+		if err != nil {
+			return nil, err
+		}
+		for _, g := range groups {
+			if isSCIMGroup(org, g) {
+				res = append(res, g)
+			}
+		}
+		if len(groups) < scimMaxPageSize {
+			return res, nil
+		}
+		afterName = groups[len(groups)-1].Name
+	}
+}
+
+func (s *Server) findAllOrganizationMembers(ctx context.Context, orgID string) ([]*database.Member, error) {
+	var res []*database.Member
+	afterEmail := ""
+	for {
+		members, err := s.admin.DB.FindOrganizationMemberUsers(ctx, orgID, afterEmail, scimMaxPageSize)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, members...)
+		if len(members) < scimMaxPageSize {
+			return res, nil
+		}
+		afterEmail = members[len(members)-1].Email
+	}
+}
+
+func (s *Server) findAllUsergroupMembers(ctx context.Context, groupID string) ([]*database.User, error) {
+	var res []*database.User
+	afterEmail := ""
+	for {
+		users, err := s.admin.DB.FindUsergroupMemberUsers(ctx, groupID, afterEmail, scimMaxPageSize)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, users...)
+		if len(users) < scimMaxPageSize {
+			return res, nil
+		}
+		afterEmail = users[len(users)-1].Email
+	}
+}
+
+func (s *Server) scimUserFromUser(user *database.User, active bool) *scimUser {
+	return &scimUser{
+		Schemas:     []string{scimSchemaUser},
+		ID:          user.ID,
+		UserName:    user.Email,
+		DisplayName: user.DisplayName,
+		Name:        &scimName{Formatted: user.DisplayName},
+		Emails:      []*scimEmail{{Value: user.Email, Primary: true}},
+		Active:      &active,
+		Meta:        s.scimMeta("User", user.ID, user.CreatedOn, user.UpdatedOn),
+	}
+}
+
+func (s *Server) scimUserFromMember(m *database.Member) *scimUser {
+	active := true
+	return &scimUser{
+		Schemas:     []string{scimSchemaUser},
+		ID:          m.ID,
+		UserName:    m.Email,
+		DisplayName: m.DisplayName,
+		Name:        &scimName{Formatted: m.DisplayName},
+		Emails:      []*scimEmail{{Value: m.Email, Primary: true}},
+		Active:      &active,
+		Meta:        s.scimMeta("User", m.ID, m.CreatedOn, m.UpdatedOn),
+	}
+}
+
+func (s *Server) scimGroupFromUsergroup(ctx context.Context, group *database.Usergroup, withMembers bool) (*scimGroup, error) {
+	res := &scimGroup{
+		Schemas:     []string{scimSchemaGroup},
+		ID:          group.ID,
+		DisplayName: group.Name,
+		Meta:        s.scimMeta("Group", group.ID, group.CreatedOn, group.UpdatedOn),
+	}
+
+	if withMembers {
+		users, err := s.findAllUsergroupMembers(ctx, group.ID)
+		if err != nil {
+			return nil, err
+		}
+		res.Members = make([]*scimMember, len(users))
+		for i, u := range users {
+			res.Members[i] = &scimMember{
+				Value:   u.ID,
+				Display: u.Email,
+				Ref:     urlutil.MustJoinURL(s.opts.ExternalURL, "scim/v2/Users", u.ID),
+			}
+		}
+	}
+
+	return res, nil
+}
+
+func (s *Server) scimMeta(resourceType, id string, created, updated time.Time) *scimMeta {
+	return &scimMeta{
+		ResourceType: resourceType,
+		Created:      created.UTC().Format(time.RFC3339),
+		LastModified: updated.UTC().Format(time.RFC3339),
+		Location:     urlutil.MustJoinURL(s.opts.ExternalURL, "scim/v2", resourceType+"s", id),
+	}
+}
+
+// isSCIMGroup returns true if the usergroup can be managed with SCIM.
+func isSCIMGroup(org *database.Organization, group *database.Usergroup) bool {
+	return group.OrgID == org.ID && (org.AllUsergroupID == nil || *org.AllUsergroupID != group.ID)
+}
+
+var scimGroupNameInvalidChars = regexp.MustCompile(`[^-_a-zA-Z0-9]+`)
+
+// scimGroupName converts a SCIM group display name to a usergroup name.
+func scimGroupName(displayName string) string {
+	name := scimGroupNameInvalidChars.ReplaceAllString(strings.TrimSpace(displayName), "-")
+	name = strings.Trim(name, "-")
+	if len(name) > 40 {
+		name = strings.TrimRight(name[:40], "-")
+	}
+	return name
+}
+
+func validSCIMGroupName(displayName string) (string, error) {
+	name := scimGroupName(displayName)
+	if len(name) < 3 {
+		return "", &scimError{status: http.StatusBadRequest, scimType: "invalidValue", detail: fmt.Sprintf("invalid group name %q: must contain at least 3 letters, numbers, dashes or underscores", displayName)}
+	}
+	return name, nil
+}
+
+// isSCIMNotFound returns true if err is a not found error from the database or a SCIM helper.
+func isSCIMNotFound(err error) bool {
+	var serr *scimError
+	if errors.As(err, &serr) {
+		return serr.status == http.StatusNotFound
+	}
+	return errors.Is(err, database.ErrNotFound)
+}
+
+type scimError struct {
+	status   int
+	scimType string
+	detail   string
+}
+
+func (e *scimError) Error() string {
+	return e.detail
+}
+
+type scimMeta struct {
+	ResourceType string `json:"resourceType"`
+	Created      string `json:"created,omitempty"`
+	LastModified string `json:"lastModified,omitempty"`
+	Location     string `json:"location,omitempty"`
+}
+
+type scimName struct {
+	Formatted  string `json:"formatted,omitempty"`
+	GivenName  string `json:"givenName,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+}
+
+type scimEmail struct {
+	Value   string `json:"value"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+type scimUser struct {
+	Schemas     []string     `json:"schemas"`
+	ID          string       `json:"id,omitempty"`
+	ExternalID  string       `json:"externalId,omitempty"`
+	UserName    string       `json:"userName"`
+	Name        *scimName    `json:"name,omitempty"`
+	DisplayName string       `json:"displayName,omitempty"`
+	Emails      []*scimEmail `json:"emails,omitempty"`
+	Active      *bool        `json:"active,omitempty"`
+	Meta        *scimMeta    `json:"meta,omitempty"`
+}
+
+// email returns the user's email. It uses the userName if it's an email address and otherwise the primary email.
+func (u *scimUser) email() string {
+	if _, err := mail.ParseAddress(u.UserName); err == nil {
+		return u.UserName
+	}
+	for _, e := range u.Emails {
+		if e.Primary || len(u.Emails) == 1 {
+			if _, err := mail.ParseAddress(e.Value); err == nil {
+				return e.Value
+			}
+		}
+	}
+	return ""
+}
+
+// displayName returns the user's display name, falling back to the name components.
+func (u *scimUser) displayName() string {
+	if u.DisplayName != "" {
+		return u.DisplayName
+	}
+	if u.Name == nil {
+		return ""
+	}
+	if u.Name.Formatted != "" {
+		return u.Name.Formatted
+	}
+	return strings.TrimSpace(u.Name.GivenName + " " + u.Name.FamilyName)
+}
+
+// merge copies the name attributes that are set in other.
+func (u *scimUser) merge(other *scimUser) {
+	if other.DisplayName != "" {
+		u.DisplayName = other.DisplayName
+	}
+	if other.Name == nil {
+		return
+	}
+	if other.Name.Formatted != "" {
+		u.Name.Formatted = other.Name.Formatted
+	}
+	if other.Name.GivenName != "" {
+		u.Name.GivenName = other.Name.GivenName
+	}
+	if other.Name.FamilyName != "" {
+		u.Name.FamilyName = other.Name.FamilyName
+	}
+}
+
+type scimMember struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+	Ref     string `json:"$ref,omitempty"`
+}
+
+type scimGroup struct {
+	Schemas     []string      `json:"schemas"`
+	ID          string        `json:"id,omitempty"`
+	ExternalID  string        `json:"externalId,omitempty"`
+	DisplayName string        `json:"displayName"`
+	Members     []*scimMember `json:"members,omitempty"`
+	Meta        *scimMeta     `json:"meta,omitempty"`
+}
+
+type scimPatchRequest struct {
+	Schemas    []string       `json:"schemas"`
+	Operations []*scimPatchOp `json:"Operations"`
+}
+
+type scimPatchOp struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path,omitempty"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// op returns the normalized operation name. Some clients capitalize it (e.g. "Replace").
+func (o *scimPatchOp) op() string {
+	return strings.ToLower(o.Op)
+}
+
+// scimFilter is a parsed SCIM filter.
+// Only equality filters on a single attribute are supported, which is what identity providers use to look up existing resources.
+type scimFilter struct {
+	attr  string // lowercase, since SCIM attribute names are case insensitive
+	value string
+}
+
+var scimFilterRegexp = regexp.MustCompile(`(?i)^\s*([a-z][a-z0-9_.$:-]*)\s+eq\s+("(?:[^"\\]|\\.)*")\s*$`)
+
+// parseSCIMFilter parses a filter such as `userName eq "jane@example.com"`. It returns nil for an empty filter.
+func parseSCIMFilter(filter string) (*scimFilter, error) {
+	if strings.TrimSpace(filter) == "" {
+		return nil, nil
+	}
+
+	m := scimFilterRegexp.FindStringSubmatch(filter)
+	if m == nil {
+		return nil, &scimError{status: http.StatusBadRequest, scimType: "invalidFilter", detail: fmt.Sprintf("unsupported filter %q: only 'eq' filters on a single attribute are supported", filter)}
+	}
+
+	val, err := strconv.Unquote(m[2])
+	if err != nil {
+		return nil, &scimError{status: http.StatusBadRequest, scimType: "invalidFilter", detail: fmt.Sprintf("invalid filter value %s", m[2])}
+	}
+
+	return &scimFilter{attr: strings.ToLower(m[1]), value: val}, nil
+}
+
+// parseSCIMBool parses a boolean PATCH value. Some clients send booleans as strings (e.g. "False").
+func parseSCIMBool(raw json.RawMessage) (bool, error) {
+	var v any
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return false, &scimError{status: http.StatusBadRequest, scimType: "invalidValue", detail: err.Error()}
+	}
+	switch v := v.(type) {
+	case bool:
+		return v, nil
+	case string:
+		b, err := strconv.ParseBool(strings.ToLower(v))
+		if err == nil {
+			return b, nil
+		}
+	}
+	return false, &scimError{status: http.StatusBadRequest, scimType: "invalidValue", detail: fmt.Sprintf("invalid boolean %s", string(raw))}
+}
+
+// scimAttributeExcluded returns true if the attribute was excluded with the "excludedAttributes" query parameter.
+func scimAttributeExcluded(r *http.Request, attr string) bool {
+	for _, a := range strings.Split(r.URL.Query().Get("excludedAttributes"), ",") {
+		if strings.EqualFold(strings.TrimSpace(a), attr) {
+			return true
+		}
+	}
+	return false
+}
+
+func readSCIM(r *http.Request, v any) error {
+	err := json.NewDecoder(io.LimitReader(r.Body, scimMaxBodyBytes)).Decode(v)
+	if err != nil {
+		return &scimError{status: http.StatusBadRequest, scimType: "invalidSyntax", detail: err.Error()}
+	}
+	return nil
+}
+
+// writeSCIMList writes a page of resources as a SCIM list response.
+// Pagination uses the 1-based "startIndex" and "count" query parameters.
+func writeSCIMList[T any](w http.ResponseWriter, r *http.Request, resources []T) error {
+	startIndex := 1
+	count := scimDefaultPageSize
+	if v := r.URL.Query().Get("startIndex"); v != "" {
+		i, err := strconv.Atoi(v)
+		if err != nil {
+			return &scimError{status: http.StatusBadRequest, detail: fmt.Sprintf("invalid startIndex %q", v)}
+		}
+		startIndex = max(i, 1)
+	}
+	if v := r.URL.Query().Get("count"); v != "" {
+		i, err := strconv.Atoi(v)
+		if err != nil {
+			return &scimError{status: http.StatusBadRequest, detail: fmt.Sprintf("invalid count %q", v)}
+		}
+		count = min(max(i, 0), scimMaxPageSize)
+	}
+
+	total := len(resources)
+	start := min(startIndex-1, total)
+	end := min(start+count, total)
+	page := resources[start:end]
+	if page == nil {
+		page = []T{}
+	}
+
+	writeSCIM(w, http.StatusOK, map[string]any{
+		"schemas":      []string{scimSchemaListResponse},
+		"totalResults": total,
+		"startIndex":   startIndex,
+		"itemsPerPage": len(page),
+		"Resources":    page,
+	})
+	return nil
+}
+
+func writeSCIM(w http.ResponseWriter, statusCode int, v any) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	w.Header().Set("Content-Type", scimContentType)
+	w.WriteHeader(statusCode)
+	_, _ = w.Write(data)
+}
@@ -0,0 +1,275 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rilldata/rill/admin"
+	"github.com/rilldata/rill/admin/ai"
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/admin/pkg/pgtestcontainer"
+	"github.com/rilldata/rill/admin/server/auth"
+	"github.com/rilldata/rill/admin/server/cookies"
+	"github.com/rilldata/rill/runtime/pkg/email"
+	runtimeauth "github.com/rilldata/rill/runtime/server/auth"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	_ "github.com/rilldata/rill/admin/database/postgres"
+)
+
+func TestParseSCIMFilter(t *testing.T) {
+	f, err := parseSCIMFilter("")
+	require.NoError(t, err)
+	require.Nil(t, f)
+
+	f, err = parseSCIMFilter(`userName eq "jane@example.com"`)
+	require.NoError(t, err)
+	require.Equal(t, &scimFilter{attr: "username", value: "jane@example.com"}, f)
+
+	f, err = parseSCIMFilter(` displayName EQ "Data \"Team\"" `)
+	require.NoError(t, err)
+	require.Equal(t, &scimFilter{attr: "displayname", value: `Data "Team"`}, f)
+
+	_, err = parseSCIMFilter(`userName sw "jane"`)
+	require.Error(t, err)
+	_, err = parseSCIMFilter(`userName eq "a" and active eq true`)
+	require.Error(t, err)
+}
+
+func TestSCIMGroupName(t *testing.T) {
+	require.Equal(t, "Data-Team", scimGroupName("Data Team"))
+	require.Equal(t, "eng_ops", scimGroupName("  eng_ops "))
+	require.Equal(t, "Sales-EMEA", scimGroupName("Sales / EMEA!"))
+	require.Equal(t, "a-very-long-group-name-that-exceeds-the", scimGroupName("a very long group name that exceeds the limit for usergroups"))
+
+	_, err := validSCIMGroupName("!!")
+	require.Error(t, err)
+}
+
+func TestParseSCIMBool(t *testing.T) {
+	for raw, expected := range map[string]bool{`true`: true, `false`: false, `"False"`: false, `"True"`: true} {
+		v, err := parseSCIMBool(json.RawMessage(raw))
+		require.NoError(t, err)
+		require.Equal(t, expected, v, raw)
+	}
+
+	_, err := parseSCIMBool(json.RawMessage(`"nope"`))
+	require.Error(t, err)
+}
+
+func TestWriteSCIMList(t *testing.T) {
+	list := func(query string) map[string]any {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "/scim/v2/Users?"+query, http.NoBody)
+		require.NoError(t, writeSCIMList(w, r, []int{1, 2, 3, 4, 5}))
+		require.Equal(t, scimContentType, w.Header().Get("Content-Type"))
+
+		res := map[string]any{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+		return res
+	}
+
+	res := list("")
+	require.Equal(t, float64(5), res["totalResults"])
+	require.Equal(t, []any{float64(1), float64(2), float64(3), float64(4), float64(5)}, res["Resources"])
+
+	res = list("startIndex=2&count=2")
+	require.Equal(t, float64(5), res["totalResults"])
+	require.Equal(t, float64(2), res["itemsPerPage"])
+	require.Equal(t, []any{float64(2), float64(3)}, res["Resources"])
+
+	res = list("startIndex=10")
+	require.Equal(t, []any{}, res["Resources"])
+}
+
+func TestSCIMHandlers(t *testing.T) {
+	pg := pgtestcontainer.New(t)
+	defer pg.Terminate(t)
+
+	ctx := context.Background()
+	logger := zap.NewNop()
+
+	sender, err := email.NewConsoleSender(logger, "rill-test@rilldata.io", "")
+	require.NoError(t, err)
+	issuer, err := runtimeauth.NewEphemeralIssuer("")
+	require.NoError(t, err)
+
+	service, err := admin.New(ctx,
+		&admin.Options{
+			DatabaseDriver:     "postgres",
+			DatabaseDSN:        pg.DatabaseURL,
+			ProvisionerSetJSON: "{\"static\":{\"type\":\"static\",\"spec\":{\"runtimes\":[]}}}",
+			DefaultProvisioner: "static",
+			ExternalURL:        "http://localhost:9090",
+		},
+		logger,
+		issuer,
+		email.New(sender),
+		&mockGithub{},
+		ai.NewNoop(),
+	)
+	require.NoError(t, err)
+	db := service.DB
+
+	authenticator, err := auth.NewAuthenticator(logger, service, cookies.New(logger, nil), &auth.AuthenticatorOptions{AuthDomain: "rill-test.auth0.com"})
+	require.NoError(t, err)
+	server := &Server{
+		admin:         service,
+		opts:          &Options{ExternalURL: "http://localhost:9090"},
+		authenticator: authenticator,
+		logger:        logger,
+	}
+	mux := http.NewServeMux()
+	server.registerSCIMEndpoints(mux)
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	// Create an org with a SCIM service and an SSO provider that has verified acme.com
+	owner, err := service.CreateOrUpdateUser(ctx, "owner@acme.com", "Owner", "")
+	require.NoError(t, err)
+	org, err := service.CreateOrganizationForUser(ctx, owner.ID, "acme", "")
+	require.NoError(t, err)
+	svc, err := db.InsertService(ctx, &database.InsertServiceOptions{OrgID: org.ID, Name: "scim"})
+	require.NoError(t, err)
+	svcToken, err := service.IssueServiceAuthToken(ctx, svc.ID, nil)
+	require.NoError(t, err)
+	_, err = db.UpsertSSOProvider(ctx, &database.UpsertSSOProviderOptions{
+		OrgID:                   org.ID,
+		Type:                    "oidc",
+		Domains:                 []string{"acme.com"},
+		VerifiedDomains:         []string{"acme.com"},
+		DomainVerificationToken: "token",
+	})
+	require.NoError(t, err)
+	ownerToken, err := service.IssueUserAuthToken(ctx, owner.ID, database.AuthClientIDRillWeb, "test", nil, nil)
+	require.NoError(t, err)
+
+	do := func(token, method, path string, body any) (int, map[string]any) {
+		var buf bytes.Buffer
+		if body != nil {
+			require.NoError(t, json.NewEncoder(&buf).Encode(body))
+		}
+		req, err := http.NewRequest(method, srv.URL+path, &buf)
+		require.NoError(t, err)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		res := map[string]any{}
+		_ = json.NewDecoder(resp.Body).Decode(&res)
+		return resp.StatusCode, res
+	}
+	scim := func(method, path string, body any) (int, map[string]any) {
+		return do(svcToken.Token().String(), method, path, body)
+	}
+	createUser := func(email string) string {
+		status, res := scim(http.MethodPost, "/scim/v2/Users", map[string]any{"schemas": []string{scimSchemaUser}, "userName": email, "displayName": email})
+		require.Equal(t, http.StatusCreated, status, res)
+		require.Equal(t, true, res["active"])
+		return res["id"].(string)
+	}
+	isMember := func(userID string) bool {
+		ok, err := db.CheckUserIsAnOrganizationMember(ctx, userID, org.ID)
+		require.NoError(t, err)
+		return ok
+	}
+	groupMembers := func(groupID string) []string {
+		users, err := db.FindUsergroupMemberUsers(ctx, groupID, "", 100)
+		require.NoError(t, err)
+		var res []string
+		for _, u := range users {
+			res = append(res, u.ID)
+		}
+		return res
+	}
+
+	// Auth: requests need a service token
+	status, _ := do("", http.MethodGet, "/scim/v2/Users", nil)
+	require.Equal(t, http.StatusUnauthorized, status)
+	status, _ = do(ownerToken.Token().String(), http.MethodGet, "/scim/v2/Users", nil)
+	require.Equal(t, http.StatusUnauthorized, status)
+
+	// Create a new user
+	jane := createUser("jane@acme.com")
+	require.True(t, isMember(jane))
+	status, _ = scim(http.MethodPost, "/scim/v2/Users", map[string]any{"userName": "jane@acme.com"})
+	require.Equal(t, http.StatusConflict, status)
+
+	// Existing users can only be provisioned in the org's verified domains
+	_, err = service.CreateOrUpdateUser(ctx, "bob@acme.com", "Bob", "")
+	require.NoError(t, err)
+	bob := createUser("bob@acme.com")
+	require.True(t, isMember(bob))
+	outsider, err := service.CreateOrUpdateUser(ctx, "eve@other.com", "Eve", "")
+	require.NoError(t, err)
+	status, _ = scim(http.MethodPost, "/scim/v2/Users", map[string]any{"userName": "eve@other.com"})
+	require.Equal(t, http.StatusConflict, status)
+	require.False(t, isMember(outsider.ID))
+
+	// Users that the org doesn't manage can't be changed
+	status, _ = scim(http.MethodPatch, "/scim/v2/Users/"+outsider.ID, map[string]any{"Operations": []map[string]any{{"op": "replace", "path": "active", "value": true}}})
+	require.Equal(t, http.StatusNotFound, status)
+	require.False(t, isMember(outsider.ID))
+
+	// Create a group
+	status, res := scim(http.MethodPost, "/scim/v2/Groups", map[string]any{"displayName": "Data Team", "members": []map[string]any{{"value": jane}}})
+	require.Equal(t, http.StatusCreated, status, res)
+	group := res["id"].(string)
+	require.ElementsMatch(t, []string{jane}, groupMembers(group))
+
+	// PUT replaces the group's members
+	status, res = scim(http.MethodPut, "/scim/v2/Groups/"+group, map[string]any{"displayName": "Data Team", "members": []map[string]any{{"value": bob}}})
+	require.Equal(t, http.StatusOK, status, res)
+	require.ElementsMatch(t, []string{bob}, groupMembers(group))
+
+	// PATCH adding an existing member is a no-op
+	status, res = scim(http.MethodPatch, "/scim/v2/Groups/"+group, map[string]any{"Operations": []map[string]any{{"op": "add", "path": "members", "value": []map[string]any{{"value": bob}, {"value": jane}}}}})
+	require.Equal(t, http.StatusOK, status, res)
+	require.ElementsMatch(t, []string{bob, jane}, groupMembers(group))
+
+	// PATCH replaces the group's members
+	status, res = scim(http.MethodPatch, "/scim/v2/Groups/"+group, map[string]any{"Operations": []map[string]any{{"op": "replace", "path": "members", "value": []map[string]any{{"value": jane}}}}})
+	require.Equal(t, http.StatusOK, status, res)
+	require.ElementsMatch(t, []string{jane}, groupMembers(group))
+
+	// A failed replace doesn't change the members
+	status, _ = scim(http.MethodPut, "/scim/v2/Groups/"+group, map[string]any{"displayName": "Data Team", "members": []map[string]any{{"value": bob}, {"value": outsider.ID}}})
+	require.Equal(t, http.StatusBadRequest, status)
+	require.ElementsMatch(t, []string{jane}, groupMembers(group))
+
+	// The built-in all-users group can't be changed
+	status, _ = scim(http.MethodPatch, "/scim/v2/Groups/"+*org.AllUsergroupID, map[string]any{"Operations": []map[string]any{{"op": "replace", "path": "members", "value": []map[string]any{}}}})
+	require.Equal(t, http.StatusNotFound, status)
+
+	// PATCH active=false removes the user from the org and its groups
+	status, res = scim(http.MethodPatch, "/scim/v2/Users/"+jane, map[string]any{"Operations": []map[string]any{{"op": "replace", "path": "active", "value": false}}})
+	require.Equal(t, http.StatusOK, status, res)
+	require.Equal(t, false, res["active"])
+	require.False(t, isMember(jane))
+	require.Empty(t, groupMembers(group))
+
+	// Deactivated users can be re-activated
+	status, res = scim(http.MethodPatch, "/scim/v2/Users/"+jane, map[string]any{"Operations": []map[string]any{{"op": "replace", "value": map[string]any{"active": true}}}})
+	require.Equal(t, http.StatusOK, status, res)
+	require.True(t, isMember(jane))
+
+	// The last admin can't be deactivated
+	status, _ = scim(http.MethodPatch, "/scim/v2/Users/"+owner.ID, map[string]any{"Operations": []map[string]any{{"op": "replace", "path": "active", "value": false}}})
+	require.Equal(t, http.StatusBadRequest, status)
+	require.True(t, isMember(owner.ID))
+
+	// Successful mutations are audited
+	events, err := db.FindAuditEvents(ctx, &database.FindAuditEventsOptions{OrgID: org.ID, Action: "SCIMPatchUser", Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	require.Equal(t, "service", events[0].ActorType)
+	require.Equal(t, "scim", events[0].ActorName)
+	require.Equal(t, jane, events[0].Payload["id"])
+}
@@ -60,6 +60,8 @@ type Options struct {
 	GithubAppWebhookSecret string
 	GithubClientID         string
 	GithubClientSecret     string
+	// AuditLogForwarding enables forwarding of audit events to the activity client's sinks.
+	AuditLogForwarding bool
 }
 
 type Server struct {
@@ -119,28 +121,30 @@ func New(logger *zap.Logger, adm *admin.Service, issuer *runtimeauth.Issuer, lim
 	// In the future, if iframes need to communicate with the admin service, we should introduce a scheme involving ephemeral tokens and not rely on cookies.
 	cookieStore.Options.SameSite = http.SameSiteLaxMode
 
-	authenticator, err := auth.NewAuthenticator(logger, adm, cookieStore, &auth.AuthenticatorOptions{
-		AuthDomain:       opts.AuthDomain,
-		AuthClientID:     opts.AuthClientID,
-		AuthClientSecret: opts.AuthClientSecret,
-		ExternalURL:      opts.ExternalURL,
-		FrontendURL:      opts.FrontendURL,
+	s := &Server{
+		logger:   logger,
+		admin:    adm,
+		opts:     opts,
+		cookies:  cookieStore,
+		issuer:   issuer,
+		urls:     newURLRegistry(opts),
+		limiter:  limiter,
+		activity: activityClient,
+	}
+
+	s.authenticator, err = auth.NewAuthenticator(logger, adm, cookieStore, &auth.AuthenticatorOptions{
+		AuthDomain:           opts.AuthDomain,
+		AuthClientID:         opts.AuthClientID,
+		AuthClientSecret:     opts.AuthClientSecret,
+		ExternalURL:          opts.ExternalURL,
+		FrontendURL:          opts.FrontendURL,
+		AuditSSOProvisioning: s.auditSSOProvisioning,
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	return &Server{
-		logger:        logger,
-		admin:         adm,
-		opts:          opts,
-		cookies:       cookieStore,
-		authenticator: authenticator,
-		issuer:        issuer,
-		urls:          newURLRegistry(opts),
-		limiter:       limiter,
-		activity:      activityClient,
-	}, nil
+	return s, nil
 }
 
 // ServeGRPC Starts the gRPC server.
@@ -163,6 +167,7 @@ func (s *Server) ServeGRPC(ctx context.Context) error {
 			grpc_validator.UnaryServerInterceptor(),
 			s.authenticator.UnaryServerInterceptor(),
 			grpc_auth.UnaryServerInterceptor(s.checkRateLimit),
+			s.auditUnaryServerInterceptor(),
 		),
 		grpc.StatsHandler(otelgrpc.NewServerHandler()),
 	)
@@ -246,6 +251,9 @@ func (s *Server) HTTPHandler(ctx context.Context) (http.Handler, error) {
 	// Add Github-related endpoints (not gRPC handlers, just regular endpoints on /github/*)
 	s.registerGithubEndpoints(mux)
 
+	// Add SCIM provisioning endpoints (not gRPC handlers, just regular endpoints on /scim/*)
+	s.registerSCIMEndpoints(mux)
+
 	// Build CORS options for admin server
 
 	// If the AllowedOrigins contains a "*" we want to return the requester's origin instead of "*" in the "Access-Control-Allow-Origin" header.
@@ -443,6 +451,7 @@ type externalURLs struct {
 	githubAuthCallback    string
 	githubAuthRetry       string
 	authLogin             string
+	ssoCallback           string
 }
 
 func newURLRegistry(opts *Options) *externalURLs {
@@ -459,9 +468,18 @@ func newURLRegistry(opts *Options) *externalURLs {
 		githubAuthCallback:    urlutil.MustJoinURL(opts.ExternalURL, "/github/auth/callback"),
 		githubAuthRetry:       urlutil.MustJoinURL(opts.FrontendURL, "/-/github/connect/retry-auth"),
 		authLogin:             urlutil.MustJoinURL(opts.ExternalURL, "/auth/login"),
+		ssoCallback:           urlutil.MustJoinURL(opts.ExternalURL, "/auth/sso/callback"),
 	}
 }
 
+func (u *externalURLs) samlMetadata(providerID string) string {
+	return urlutil.MustJoinURL(u.external, "/auth/saml", providerID, "metadata")
+}
+
+func (u *externalURLs) samlACS(providerID string) string {
+	return urlutil.MustJoinURL(u.external, "/auth/saml", providerID, "acs")
+}
+
 func (u *externalURLs) reportOpen(org, project, report string, executionTime time.Time) string {
 	reportURL := urlutil.MustJoinURL(u.frontend, org, project, "-", "reports", report, "open")
 	reportURL += fmt.Sprintf("?execution_time=%s", executionTime.UTC().Format(time.RFC3339))
@@ -39,6 +39,8 @@ func (s *Server) CreateService(ctx context.Context, req *adminv1.CreateServiceRe
 		return nil, err
 	}
 
+	auditChange(ctx, nil, serviceToPB(service, req.OrganizationName))
+
 	return &adminv1.CreateServiceResponse{
 		Service: serviceToPB(service, req.OrganizationName),
 	}, nil
@@ -105,6 +107,8 @@ func (s *Server) UpdateService(ctx context.Context, req *adminv1.UpdateServiceRe
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	auditChange(ctx, serviceToPB(service, req.OrganizationName), serviceToPB(updatedService, req.OrganizationName))
+
 	return &adminv1.UpdateServiceResponse{
 		Service: serviceToPB(updatedService, req.OrganizationName),
 	}, nil
@@ -137,6 +141,8 @@ func (s *Server) DeleteService(ctx context.Context, req *adminv1.DeleteServiceRe
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	auditChange(ctx, serviceToPB(service, req.OrganizationName), nil)
+
 	return &adminv1.DeleteServiceResponse{}, nil
 }
 
@@ -0,0 +1,315 @@
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/admin/pkg/publicemail"
+	"github.com/rilldata/rill/admin/pkg/sso"
+	"github.com/rilldata/rill/admin/server/auth"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Server) GetSSOProvider(ctx context.Context, req *adminv1.GetSSOProviderRequest) (*adminv1.GetSSOProviderResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.org", req.Organization),
+	)
+
+	claims := auth.GetClaims(ctx)
+
+	org, err := s.admin.DB.FindOrganizationByName(ctx, req.Organization)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "org not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if !(claims.OrganizationPermissions(ctx, org.ID).ManageOrg || claims.Superuser(ctx)) {
+		return nil, status.Error(codes.PermissionDenied, "only org admins can view the SSO provider")
+	}
+
+	p, err := s.admin.DB.FindSSOProviderForOrganization(ctx, org.ID)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "SSO provider not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &adminv1.GetSSOProviderResponse{Provider: s.ssoProviderToPB(p)}, nil
+}
+
+func (s *Server) SetSSOProvider(ctx context.Context, req *adminv1.SetSSOProviderRequest) (*adminv1.SetSSOProviderResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.org", req.Organization),
+		attribute.String("args.type", req.Type),
+		attribute.StringSlice("args.domains", req.Domains),
+		attribute.String("args.jit_role", req.JitRole),
+	)
+
+	claims := auth.GetClaims(ctx)
+	if claims.OwnerType() != auth.OwnerTypeUser {
+		return nil, status.Error(codes.Unauthenticated, "not authenticated as a user")
+	}
+
+	org, err := s.admin.DB.FindOrganizationByName(ctx, req.Organization)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "org not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	domains := make([]string, 0, len(req.Domains))
+	for _, d := range req.Domains {
+		d = strings.ToLower(strings.TrimSpace(d))
+		if d == "" || strings.Contains(d, "@") {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid domain %q", d)
+		}
+		if !slices.Contains(domains, d) {
+			domains = append(domains, d)
+		}
+	}
+
+	if !claims.Superuser(ctx) {
+		if !claims.OrganizationPermissions(ctx, org.ID).ManageOrg {
+			return nil, status.Error(codes.PermissionDenied, "only org admins can configure the SSO provider")
+		}
+		// Only allow domains that match the user's own verified email domain, similar to whitelisted domains
+		user, err := s.admin.DB.FindUser(ctx, claims.OwnerID())
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		userDomain, err := sso.EmailDomain(user.Email)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		for _, d := range domains {
+			if d != userDomain {
+				return nil, status.Errorf(codes.PermissionDenied, "Domain %s doesn’t match verified email domain. Please contact Rill support.", d)
+			}
+			if publicemail.IsPublic(d) {
+				return nil, status.Errorf(codes.InvalidArgument, "Public Domain %s cannot be used for SSO", d)
+			}
+		}
+	}
+
+	prev, err := s.admin.DB.FindSSOProviderForOrganization(ctx, org.ID)
+	if err != nil && !errors.Is(err, database.ErrNotFound) {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	token := ""
+	if prev != nil {
+		token = prev.DomainVerificationToken
+	}
+	if token == "" {
+		token, err = newDomainVerificationToken()
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	// Domains are only routed to the provider once verified. Domains set by a superuser count as approved.
+	// Otherwise, a domain must already be verified or have a DNS TXT record with the provider's verification token.
+	var verifiedDomains []string
+	for _, d := range domains {
+		verified := claims.Superuser(ctx) || (prev != nil && slices.Contains(prev.VerifiedDomains, d))
+		if !verified {
+			verified, err = sso.VerifyDomain(ctx, d, token)
+			if err != nil {
+				return nil, status.Errorf(codes.Unavailable, "failed to look up the verification record of domain %s: %s", d, err.Error())
+			}
+		}
+		if verified {
+			verifiedDomains = append(verifiedDomains, d)
+		}
+	}
+
+	// A domain can only be routed to one provider
+	for _, d := range verifiedDomains {
+		other, err := s.admin.DB.FindSSOProviderForDomain(ctx, d)
+		if err != nil {
+			if errors.Is(err, database.ErrNotFound) {
+				continue
+			}
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if other.OrgID != org.ID {
+			return nil, status.Errorf(codes.AlreadyExists, "domain %s is already used by the SSO provider of another org", d)
+		}
+	}
+
+	var jitRoleID *string
+	if req.JitRole != "" {
+		role, err := s.admin.DB.FindOrganizationRole(ctx, req.JitRole)
+		if err != nil {
+			if errors.Is(err, database.ErrNotFound) {
+				return nil, status.Error(codes.NotFound, "role not found")
+			}
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		jitRoleID = &role.ID
+	}
+
+	cfg := database.SSOProviderConfig{
+		EmailClaim:  req.EmailClaim,
+		NameClaim:   req.NameClaim,
+		GroupsClaim: req.GroupsClaim,
+	}
+	switch req.Type {
+	case database.SSOProviderTypeOIDC:
+		cfg.IssuerURL = req.OidcIssuerUrl
+		cfg.ClientID = req.OidcClientId
+		cfg.ClientSecret = req.OidcClientSecret
+		cfg.Scopes = req.OidcScopes
+		// Keep the existing client secret, which is never returned to clients
+		if cfg.ClientSecret == "" && prev != nil && prev.Type == database.SSOProviderTypeOIDC {
+			cfg.ClientSecret = prev.Config.ClientSecret
+		}
+	case database.SSOProviderTypeSAML:
+		cfg.IDPMetadataURL = req.SamlIdpMetadataUrl
+		cfg.IDPMetadataXML = req.SamlIdpMetadataXml
+		// Fetch the metadata once and store it, so the URL isn't requested on logins
+		if cfg.IDPMetadataXML == "" && cfg.IDPMetadataURL != "" {
+			cfg.IDPMetadataXML, err = sso.FetchIDPMetadata(ctx, cfg.IDPMetadataURL)
+			if err != nil {
+				return nil, status.Error(codes.InvalidArgument, err.Error())
+			}
+		}
+	}
+
+	ctx, tx, err := s.admin.DB.NewTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	p, err := s.admin.DB.UpsertSSOProvider(ctx, &database.UpsertSSOProviderOptions{
+		OrgID:                   org.ID,
+		Type:                    req.Type,
+		Domains:                 domains,
+		VerifiedDomains:         verifiedDomains,
+		DomainVerificationToken: token,
+		Config:                  cfg,
+		JITOrgRoleID:            jitRoleID,
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// Check that the identity provider can be reached and is configured correctly
+	switch p.Type {
+	case database.SSOProviderTypeOIDC:
+		_, err = sso.NewOIDC(ctx, p.Config, s.urls.ssoCallback)
+	case database.SSOProviderTypeSAML:
+		_, err = sso.NewSAML(p.Config, s.urls.samlMetadata(p.ID), s.urls.samlACS(p.ID))
+	}
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	var prevPB *adminv1.SSOProvider
+	if prev != nil {
+		prevPB = s.ssoProviderToPB(prev)
+	}
+	pb := s.ssoProviderToPB(p)
+	auditChange(ctx, prevPB, pb)
+
+	return &adminv1.SetSSOProviderResponse{Provider: pb}, nil
+}
+
+func (s *Server) DeleteSSOProvider(ctx context.Context, req *adminv1.DeleteSSOProviderRequest) (*adminv1.DeleteSSOProviderResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.org", req.Organization),
+	)
+
+	claims := auth.GetClaims(ctx)
+
+	org, err := s.admin.DB.FindOrganizationByName(ctx, req.Organization)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "org not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if !(claims.OrganizationPermissions(ctx, org.ID).ManageOrg || claims.Superuser(ctx)) {
+		return nil, status.Error(codes.PermissionDenied, "only org admins can remove the SSO provider")
+	}
+
+	p, err := s.admin.DB.FindSSOProviderForOrganization(ctx, org.ID)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "SSO provider not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	err = s.admin.DB.DeleteSSOProvider(ctx, p.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	auditChange(ctx, s.ssoProviderToPB(p), nil)
+
+	return &adminv1.DeleteSSOProviderResponse{}, nil
+}
+
+// ssoProviderToPB converts an SSO provider to its API representation. It never includes the OIDC client secret.
+func (s *Server) ssoProviderToPB(p *database.SSOProvider) *adminv1.SSOProvider {
+	pb := &adminv1.SSOProvider{
+		Id:                         p.ID,
+		OrgId:                      p.OrgID,
+		Type:                       p.Type,
+		Domains:                    p.Domains,
+		VerifiedDomains:            p.VerifiedDomains,
+		DomainVerificationTxtValue: sso.DomainVerificationRecordValue(p.DomainVerificationToken),
+		JitRole:                    p.JITOrgRoleName,
+		EmailClaim:                 p.Config.EmailClaim,
+		NameClaim:                  p.Config.NameClaim,
+		GroupsClaim:                p.Config.GroupsClaim,
+		CreatedOn:                  timestamppb.New(p.CreatedOn),
+		UpdatedOn:                  timestamppb.New(p.UpdatedOn),
+	}
+
+	switch p.Type {
+	case database.SSOProviderTypeOIDC:
+		pb.OidcIssuerUrl = p.Config.IssuerURL
+		pb.OidcClientId = p.Config.ClientID
+		pb.OidcScopes = p.Config.Scopes
+		pb.OidcRedirectUrl = s.urls.ssoCallback
+	case database.SSOProviderTypeSAML:
+		pb.SamlIdpMetadataUrl = p.Config.IDPMetadataURL
+		pb.SamlEntityId = s.urls.samlMetadata(p.ID)
+		pb.SamlAcsUrl = s.urls.samlACS(p.ID)
+	}
+
+	return pb
+}
+
+// newDomainVerificationToken returns a random token for verifying an SSO provider's domains.
+func newDomainVerificationToken() (string, error) {
+	b := make([]byte, 16)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
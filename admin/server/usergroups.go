@@ -0,0 +1,425 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/admin/server/auth"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Server) ListUsergroups(ctx context.Context, req *adminv1.ListUsergroupsRequest) (*adminv1.ListUsergroupsResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.org", req.Organization),
+	)
+
+	org, err := s.admin.DB.FindOrganizationByName(ctx, req.Organization)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	claims := auth.GetClaims(ctx)
+	if !claims.Superuser(ctx) && !claims.OrganizationPermissions(ctx, org.ID).ReadOrgMembers {
+		return nil, status.Error(codes.PermissionDenied, "not authorized to read org members")
+	}
+
+	token, err := unmarshalPageToken(req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	pageSize := validPageSize(req.PageSize)
+
+	groups, err := s.admin.DB.FindUsergroupsForOrganization(ctx, org.ID, token.Val, pageSize)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	nextToken := ""
+	if len(groups) >= pageSize {
+		nextToken = marshalPageToken(groups[len(groups)-1].Name)
+	}
+
+	dtos := make([]*adminv1.Usergroup, len(groups))
+	for i, group := range groups {
+		dtos[i] = usergroupToPB(group)
+	}
+
+	return &adminv1.ListUsergroupsResponse{
+		Usergroups:    dtos,
+		NextPageToken: nextToken,
+	}, nil
+}
+
+func (s *Server) CreateUsergroup(ctx context.Context, req *adminv1.CreateUsergroupRequest) (*adminv1.CreateUsergroupResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.org", req.Organization),
+		attribute.String("args.name", req.Name),
+	)
+
+	org, err := s.admin.DB.FindOrganizationByName(ctx, req.Organization)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	claims := auth.GetClaims(ctx)
+	if !claims.OrganizationPermissions(ctx, org.ID).ManageOrgMembers {
+		return nil, status.Error(codes.PermissionDenied, "not allowed to manage usergroups")
+	}
+
+	group, err := s.admin.DB.InsertUsergroup(ctx, &database.InsertUsergroupOptions{
+		OrgID: org.ID,
+		Name:  req.Name,
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &adminv1.CreateUsergroupResponse{Usergroup: usergroupToPB(group)}, nil
+}
+
+func (s *Server) RenameUsergroup(ctx context.Context, req *adminv1.RenameUsergroupRequest) (*adminv1.RenameUsergroupResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.org", req.Organization),
+		attribute.String("args.usergroup", req.Usergroup),
+		attribute.String("args.name", req.Name),
+	)
+
+	_, group, err := s.getManagedUsergroup(ctx, req.Organization, req.Usergroup)
+	if err != nil {
+		return nil, err
+	}
+
+	group, err = s.admin.DB.UpdateUsergroupName(ctx, group.ID, &database.UpdateUsergroupOptions{Name: req.Name})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &adminv1.RenameUsergroupResponse{Usergroup: usergroupToPB(group)}, nil
+}
+
+func (s *Server) DeleteUsergroup(ctx context.Context, req *adminv1.DeleteUsergroupRequest) (*adminv1.DeleteUsergroupResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.org", req.Organization),
+		attribute.String("args.usergroup", req.Usergroup),
+	)
+
+	_, group, err := s.getManagedUsergroup(ctx, req.Organization, req.Usergroup)
+	if err != nil {
+		return nil, err
+	}
+
+	// Members and project roles of the group are deleted by cascade
+	err = s.admin.DB.DeleteUsergroup(ctx, group.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &adminv1.DeleteUsergroupResponse{}, nil
+}
+
+func (s *Server) ListUsergroupMembers(ctx context.Context, req *adminv1.ListUsergroupMembersRequest) (*adminv1.ListUsergroupMembersResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.org", req.Organization),
+		attribute.String("args.usergroup", req.Usergroup),
+	)
+
+	org, err := s.admin.DB.FindOrganizationByName(ctx, req.Organization)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	claims := auth.GetClaims(ctx)
+	if !claims.Superuser(ctx) && !claims.OrganizationPermissions(ctx, org.ID).ReadOrgMembers {
+		return nil, status.Error(codes.PermissionDenied, "not authorized to read org members")
+	}
+
+	group, err := s.admin.DB.FindUsergroupByName(ctx, org.Name, req.Usergroup)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	token, err := unmarshalPageToken(req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	pageSize := validPageSize(req.PageSize)
+
+	users, err := s.admin.DB.FindUsergroupMemberUsers(ctx, group.ID, token.Val, pageSize)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	nextToken := ""
+	if len(users) >= pageSize {
+		nextToken = marshalPageToken(users[len(users)-1].Email)
+	}
+
+	dtos := make([]*adminv1.User, len(users))
+	for i, user := range users {
+		dtos[i] = userToPB(user)
+	}
+
+	return &adminv1.ListUsergroupMembersResponse{
+		Members:       dtos,
+		NextPageToken: nextToken,
+	}, nil
+}
+
+func (s *Server) AddUsergroupMember(ctx context.Context, req *adminv1.AddUsergroupMemberRequest) (*adminv1.AddUsergroupMemberResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.org", req.Organization),
+		attribute.String("args.usergroup", req.Usergroup),
+	)
+
+	org, group, err := s.getManagedUsergroup(ctx, req.Organization, req.Usergroup)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.admin.DB.FindUserByEmail(ctx, req.Email)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, status.Error(codes.InvalidArgument, "user not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// Only org members can be added to a usergroup
+	isMember, err := s.admin.DB.CheckUserIsAnOrganizationMember(ctx, user.ID, org.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !isMember {
+		return nil, status.Error(codes.InvalidArgument, "user is not a member of the org")
+	}
+
+	err = s.admin.DB.InsertUsergroupMember(ctx, group.ID, user.ID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &adminv1.AddUsergroupMemberResponse{}, nil
+}
+
+func (s *Server) RemoveUsergroupMember(ctx context.Context, req *adminv1.RemoveUsergroupMemberRequest) (*adminv1.RemoveUsergroupMemberResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.org", req.Organization),
+		attribute.String("args.usergroup", req.Usergroup),
+	)
+
+	_, group, err := s.getManagedUsergroup(ctx, req.Organization, req.Usergroup)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.admin.DB.FindUserByEmail(ctx, req.Email)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, status.Error(codes.InvalidArgument, "user not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	err = s.admin.DB.DeleteUsergroupMember(ctx, group.ID, user.ID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &adminv1.RemoveUsergroupMemberResponse{}, nil
+}
+
+func (s *Server) ListProjectMemberUsergroups(ctx context.Context, req *adminv1.ListProjectMemberUsergroupsRequest) (*adminv1.ListProjectMemberUsergroupsResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.org", req.Organization),
+		attribute.String("args.project", req.Project),
+	)
+
+	proj, err := s.admin.DB.FindProjectByName(ctx, req.Organization, req.Project)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	claims := auth.GetClaims(ctx)
+	if !claims.Superuser(ctx) && !claims.ProjectPermissions(ctx, proj.OrganizationID, proj.ID).ReadProjectMembers {
+		return nil, status.Error(codes.PermissionDenied, "not authorized to read project members")
+	}
+
+	token, err := unmarshalPageToken(req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	pageSize := validPageSize(req.PageSize)
+
+	groups, err := s.admin.DB.FindProjectMemberUsergroups(ctx, proj.ID, token.Val, pageSize)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	nextToken := ""
+	if len(groups) >= pageSize {
+		nextToken = marshalPageToken(groups[len(groups)-1].Name)
+	}
+
+	dtos := make([]*adminv1.MemberUsergroup, len(groups))
+	for i, group := range groups {
+		dtos[i] = memberUsergroupToPB(group)
+	}
+
+	return &adminv1.ListProjectMemberUsergroupsResponse{
+		Usergroups:    dtos,
+		NextPageToken: nextToken,
+	}, nil
+}
+
+func (s *Server) AddProjectMemberUsergroup(ctx context.Context, req *adminv1.AddProjectMemberUsergroupRequest) (*adminv1.AddProjectMemberUsergroupResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.org", req.Organization),
+		attribute.String("args.project", req.Project),
+		attribute.String("args.usergroup", req.Usergroup),
+		attribute.String("args.role", req.Role),
+	)
+
+	proj, err := s.admin.DB.FindProjectByName(ctx, req.Organization, req.Project)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	claims := auth.GetClaims(ctx)
+	if !claims.ProjectPermissions(ctx, proj.OrganizationID, proj.ID).ManageProjectMembers {
+		return nil, status.Error(codes.PermissionDenied, "not allowed to add project members")
+	}
+
+	group, err := s.admin.DB.FindUsergroupByName(ctx, req.Organization, req.Usergroup)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	role, err := s.admin.DB.FindProjectRole(ctx, req.Role)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = s.admin.DB.InsertProjectMemberUsergroup(ctx, group.ID, proj.ID, role.ID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &adminv1.AddProjectMemberUsergroupResponse{}, nil
+}
+
+func (s *Server) RemoveProjectMemberUsergroup(ctx context.Context, req *adminv1.RemoveProjectMemberUsergroupRequest) (*adminv1.RemoveProjectMemberUsergroupResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.org", req.Organization),
+		attribute.String("args.project", req.Project),
+		attribute.String("args.usergroup", req.Usergroup),
+	)
+
+	proj, err := s.admin.DB.FindProjectByName(ctx, req.Organization, req.Project)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	claims := auth.GetClaims(ctx)
+	if !claims.ProjectPermissions(ctx, proj.OrganizationID, proj.ID).ManageProjectMembers {
+		return nil, status.Error(codes.PermissionDenied, "not allowed to remove project members")
+	}
+
+	group, err := s.admin.DB.FindUsergroupByName(ctx, req.Organization, req.Usergroup)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = s.admin.DB.DeleteProjectMemberUsergroup(ctx, group.ID, proj.ID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &adminv1.RemoveProjectMemberUsergroupResponse{}, nil
+}
+
+func (s *Server) SetProjectMemberUsergroupRole(ctx context.Context, req *adminv1.SetProjectMemberUsergroupRoleRequest) (*adminv1.SetProjectMemberUsergroupRoleResponse, error) {
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.org", req.Organization),
+		attribute.String("args.project", req.Project),
+		attribute.String("args.usergroup", req.Usergroup),
+		attribute.String("args.role", req.Role),
+	)
+
+	proj, err := s.admin.DB.FindProjectByName(ctx, req.Organization, req.Project)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	claims := auth.GetClaims(ctx)
+	if !claims.ProjectPermissions(ctx, proj.OrganizationID, proj.ID).ManageProjectMembers {
+		return nil, status.Error(codes.PermissionDenied, "not allowed to set project member roles")
+	}
+
+	group, err := s.admin.DB.FindUsergroupByName(ctx, req.Organization, req.Usergroup)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	role, err := s.admin.DB.FindProjectRole(ctx, req.Role)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = s.admin.DB.UpdateProjectMemberUsergroupRole(ctx, group.ID, proj.ID, role.ID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &adminv1.SetProjectMemberUsergroupRoleResponse{}, nil
+}
+
+// getManagedUsergroup looks up a usergroup that can be renamed, deleted or have its members edited.
+// It checks that the caller can manage the org's members before looking up the usergroup, so callers without access can't learn about the org's usergroups.
+// The org's "all users" group is maintained automatically, so it can't be changed through the API.
+func (s *Server) getManagedUsergroup(ctx context.Context, orgName, groupName string) (*database.Organization, *database.Usergroup, error) {
+	org, err := s.admin.DB.FindOrganizationByName(ctx, orgName)
+	if err != nil {
+		return nil, nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	claims := auth.GetClaims(ctx)
+	if !claims.OrganizationPermissions(ctx, org.ID).ManageOrgMembers {
+		return nil, nil, status.Error(codes.PermissionDenied, "not allowed to manage usergroups")
+	}
+
+	group, err := s.admin.DB.FindUsergroupByName(ctx, org.Name, groupName)
+	if err != nil {
+		return nil, nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if org.AllUsergroupID != nil && *org.AllUsergroupID == group.ID {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "the usergroup %q is managed by Rill and can't be changed", group.Name)
+	}
+
+	return org, group, nil
+}
+
+func usergroupToPB(g *database.Usergroup) *adminv1.Usergroup {
+	return &adminv1.Usergroup{
+		GroupId:   g.ID,
+		GroupName: g.Name,
+		CreatedOn: timestamppb.New(g.CreatedOn),
+		UpdatedOn: timestamppb.New(g.UpdatedOn),
+	}
+}
+
+func memberUsergroupToPB(g *database.MemberUsergroup) *adminv1.MemberUsergroup {
+	return &adminv1.MemberUsergroup{
+		GroupId:   g.ID,
+		GroupName: g.Name,
+		RoleName:  g.RoleName,
+		CreatedOn: timestamppb.New(g.CreatedOn),
+		UpdatedOn: timestamppb.New(g.UpdatedOn),
+	}
+}
@@ -316,6 +316,8 @@ func (s *Server) SudoUpdateUserQuotas(ctx context.Context, req *adminv1.SudoUpda
 		return nil, err
 	}
 
+	auditChange(ctx, userToPB(user), userToPB(updatedUser))
+
 	return &adminv1.SudoUpdateUserQuotasResponse{User: userToPB(updatedUser)}, nil
 }
 
@@ -0,0 +1,134 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/mail"
+	"strings"
+
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/admin/pkg/sso"
+	"go.uber.org/zap"
+)
+
+// SSOProvisioning describes the changes ProvisionSSOUser made to an organization's members.
+type SSOProvisioning struct {
+	AddedToOrg bool
+	// AddedToUsergroups are the names of the usergroups the user was added to.
+	AddedToUsergroups []string
+}
+
+// Changed returns true if the user was added to the organization or any of its usergroups.
+func (p *SSOProvisioning) Changed() bool {
+	return p.AddedToOrg || len(p.AddedToUsergroups) > 0
+}
+
+// ProvisionSSOUser creates or updates a user who logged in through an organization's SSO provider.
+// If the user is not a member of the organization and the provider has a just-in-time role, the user is added to the organization with that role.
+// Members of the organization are also added to the organization's usergroups with names matching the groups asserted by the identity provider.
+// Callers must check that the identity's email is in one of the provider's domains before calling it.
+func (s *Service) ProvisionSSOUser(ctx context.Context, p *database.SSOProvider, ident *sso.Identity) (*database.User, *SSOProvisioning, error) {
+	// Identity providers may assert an address with a display name (e.g. "Jane <jane@example.com>"), so only use the parsed address
+	addr, err := mail.ParseAddress(ident.Email)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid email address %q", ident.Email)
+	}
+	email := addr.Address
+
+	// Most SAML identity providers don't send a photo, so don't clear an existing one
+	photoURL := ident.PhotoURL
+	if photoURL == "" {
+		existing, err := s.DB.FindUserByEmail(ctx, email)
+		if err == nil {
+			photoURL = existing.PhotoURL
+		} else if !errors.Is(err, database.ErrNotFound) {
+			return nil, nil, err
+		}
+	}
+
+	user, err := s.CreateOrUpdateUser(ctx, email, ident.Name, photoURL)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	org, err := s.DB.FindOrganization(ctx, p.OrgID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	isMember, err := s.DB.CheckUserIsAnOrganizationMember(ctx, user.ID, org.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !isMember && p.JITOrgRoleID == nil {
+		// The user must be invited to the organization
+		return user, &SSOProvisioning{}, nil
+	}
+
+	ctx, tx, err := s.DB.NewTx(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if !isMember {
+		err = s.DB.InsertOrganizationMemberUser(ctx, org.ID, user.ID, *p.JITOrgRoleID)
+		if err != nil {
+			return nil, nil, err
+		}
+		err = s.DB.InsertUsergroupMember(ctx, *org.AllUsergroupID, user.ID)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	// Add the user to usergroups matching the asserted groups. Membership of other usergroups is managed in Rill, so we don't remove the user from them.
+	var addedToGroups []string
+	if len(ident.Groups) > 0 {
+		current, err := s.DB.FindUsergroupsForUser(ctx, user.ID, org.ID)
+		if err != nil {
+			return nil, nil, err
+		}
+		isGroupMember := make(map[string]bool, len(current))
+		for _, g := range current {
+			isGroupMember[g.ID] = true
+		}
+
+		for _, name := range ident.Groups {
+			group, err := s.DB.FindUsergroupByName(ctx, org.Name, name)
+			if err != nil {
+				if errors.Is(err, database.ErrNotFound) {
+					continue
+				}
+				return nil, nil, err
+			}
+			if isGroupMember[group.ID] || (org.AllUsergroupID != nil && group.ID == *org.AllUsergroupID) {
+				continue
+			}
+			err = s.DB.InsertUsergroupMember(ctx, group.ID, user.ID)
+			if err != nil {
+				return nil, nil, err
+			}
+			isGroupMember[group.ID] = true
+			addedToGroups = append(addedToGroups, group.Name)
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	res := &SSOProvisioning{AddedToOrg: !isMember, AddedToUsergroups: addedToGroups}
+	if res.Changed() {
+		s.Logger.Info("provisioned sso user",
+			zap.String("user_id", user.ID),
+			zap.String("org", org.Name),
+			zap.Bool("added_to_org", !isMember),
+			zap.String("groups", strings.Join(addedToGroups, ",")),
+		)
+	}
+
+	return user, res, nil
+}
@@ -0,0 +1,57 @@
+package admin
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/rilldata/rill/admin/database"
+)
+
+const (
+	// ProdEnvironment is the environment of a project's prod deployment.
+	ProdEnvironment = "prod"
+	// previewEnvironment is the environment of a project's preview deployments.
+	previewEnvironment = "preview"
+)
+
+var environmentNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
+// ValidateEnvironmentName returns an error if the name can't be used for an environment.
+// An empty name is valid and refers to the variables that are shared by all environments.
+func ValidateEnvironmentName(name string) error {
+	if name == "" {
+		return nil
+	}
+	if len(name) > 64 || !environmentNameRegexp.MatchString(name) {
+		return fmt.Errorf("invalid environment name %q: must be at most 64 characters and only contain letters, digits, dashes and underscores", name)
+	}
+	return nil
+}
+
+// ResolveVariables returns the project's variables for the given environment.
+// It only includes the variables set for the environment and the variables explicitly shared by all environments (the former take precedence).
+func ResolveVariables(proj *database.Project, environment string) map[string]string {
+	envVars := proj.EnvironmentVariables[environment]
+	res := make(map[string]string, len(proj.ProdVariables)+len(envVars))
+	for k, v := range proj.ProdVariables {
+		res[k] = v
+	}
+	for k, v := range envVars {
+		res[k] = v
+	}
+	return res
+}
+
+// DeploymentVariables returns the variables to configure for a deployment of the project.
+func (s *Service) DeploymentVariables(proj *database.Project, depl *database.Deployment) map[string]string {
+	if depl.Preview {
+		return previewVariables(proj)
+	}
+	return ResolveVariables(proj, depl.Environment)
+}
+
+// previewVariables returns the variables for the project's preview deployments.
+// Preview deployments run unreviewed code, so they only get the variables set for the preview environment (and never the prod variables).
+func previewVariables(proj *database.Project) map[string]string {
+	return proj.EnvironmentVariables[previewEnvironment]
+}
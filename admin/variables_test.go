@@ -0,0 +1,38 @@
+package admin
+
+import (
+	"testing"
+
+	"github.com/rilldata/rill/admin/database"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveVariables(t *testing.T) {
+	proj := &database.Project{
+		ProdVariables: map[string]string{"foo": "all", "bar": "all"},
+		EnvironmentVariables: map[string]map[string]string{
+			"prod":    {"foo": "prod"},
+			"staging": {"foo": "staging", "baz": "staging"},
+		},
+	}
+
+	require.Equal(t, map[string]string{"foo": "prod", "bar": "all"}, ResolveVariables(proj, "prod"))
+	require.Equal(t, map[string]string{"foo": "all", "bar": "all"}, ResolveVariables(proj, "dev"))
+	require.Equal(t, map[string]string{"foo": "staging", "bar": "all", "baz": "staging"}, ResolveVariables(proj, "staging"))
+
+	// Resolving must not modify the project's variables
+	require.Equal(t, map[string]string{"foo": "all", "bar": "all"}, proj.ProdVariables)
+
+	// Variables of other environments never leak into an environment
+	proj.ProdVariables = nil
+	require.Equal(t, map[string]string{"foo": "prod"}, ResolveVariables(proj, "prod"))
+	require.Equal(t, map[string]string{}, ResolveVariables(proj, "dev"))
+}
+
+func TestValidateEnvironmentName(t *testing.T) {
+	require.NoError(t, ValidateEnvironmentName(""))
+	require.NoError(t, ValidateEnvironmentName("prod"))
+	require.NoError(t, ValidateEnvironmentName("staging_eu-1"))
+	require.Error(t, ValidateEnvironmentName("staging eu"))
+	require.Error(t, ValidateEnvironmentName("../prod"))
+}
@@ -65,6 +65,8 @@ func (w *Worker) runAutoscaler(ctx context.Context) error {
 			ProdSlots:            rec.RecommendedSlots,
 			ProdTTLSeconds:       targetProject.ProdTTLSeconds,
 			Provisioner:          targetProject.Provisioner,
+			PreviewDeployments:   targetProject.PreviewDeployments,
+			EnvironmentVariables: targetProject.EnvironmentVariables,
 			Annotations:          targetProject.Annotations,
 		})
 		if err != nil {
@@ -70,7 +70,7 @@ func (w *Worker) upgradeAllDeploymentsForProject(ctx context.Context, proj *data
 			err = w.admin.UpdateDeployment(ctx, depl, &admin.UpdateDeploymentOptions{
 				Version:         latestVersion,
 				Branch:          depl.Branch,
-				Variables:       proj.ProdVariables,
+				Variables:       w.admin.DeploymentVariables(proj, depl),
 				Annotations:     w.admin.NewDeploymentAnnotations(org, proj),
 				EvictCachedRepo: false,
 			})
@@ -56,6 +56,9 @@ func (w *Worker) Run(ctx context.Context) error {
 	group.Go(func() error {
 		return w.schedule(ctx, "hibernate_expired_deployments", w.hibernateExpiredDeployments, 15*time.Minute)
 	})
+	group.Go(func() error {
+		return w.schedule(ctx, "delete_expired_preview_deployments", w.deleteExpiredPreviewDeployments, 15*time.Minute)
+	})
 	group.Go(func() error {
 		return w.schedule(ctx, "upgrade_latest_version_projects", w.upgradeLatestVersionProjects, 6*time.Hour)
 	})
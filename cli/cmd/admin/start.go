@@ -14,6 +14,7 @@ import (
 	"github.com/redis/go-redis/v9"
 	"github.com/rilldata/rill/admin"
 	"github.com/rilldata/rill/admin/ai"
+	"github.com/rilldata/rill/admin/database"
 	"github.com/rilldata/rill/admin/server"
 	"github.com/rilldata/rill/admin/worker"
 	"github.com/rilldata/rill/cli/pkg/cmdutil"
@@ -39,6 +40,7 @@ import (
 type Config struct {
 	DatabaseDriver           string                 `default:"postgres" split_words:"true"`
 	DatabaseURL              string                 `split_words:"true"`
+	EncryptionKeyfile        string                 `split_words:"true"`
 	RedisURL                 string                 `default:"" split_words:"true"`
 	ProvisionerSetJSON       string                 `split_words:"true"`
 	DefaultProvisioner       string                 `split_words:"true"`
@@ -76,6 +78,7 @@ type Config struct {
 	ActivitySinkType         string                 `default:"" split_words:"true"`
 	ActivitySinkKafkaBrokers string                 `default:"" split_words:"true"`
 	ActivityUISinkKafkaTopic string                 `default:"" split_words:"true"`
+	AuditLogForwarding       bool                   `default:"false" split_words:"true"`
 	MetricsProject           string                 `default:"" split_words:"true"`
 	AutoscalerCron           string                 `default:"CRON_TZ=America/Los_Angeles 0 0 * * 1" split_words:"true"`
 }
@@ -234,10 +237,22 @@ func StartCmd(ch *cmdutil.Helper) *cobra.Command {
 				metricsProjectName = parts[1]
 			}
 
+			// Init the key provider for encrypting sensitive data in the database
+			var encryptionKeys database.KeyProvider
+			if conf.EncryptionKeyfile != "" {
+				encryptionKeys, err = database.NewKeyfileProvider(conf.EncryptionKeyfile)
+				if err != nil {
+					logger.Fatal("error loading encryption keyfile", zap.Error(err))
+				}
+			} else {
+				logger.Warn("encryption keyfile not configured, sensitive project data will be stored unencrypted")
+			}
+
 			// Init admin service
 			admOpts := &admin.Options{
 				DatabaseDriver:     conf.DatabaseDriver,
 				DatabaseDSN:        conf.DatabaseURL,
+				EncryptionKeys:     encryptionKeys,
 				ProvisionerSetJSON: conf.ProvisionerSetJSON,
 				DefaultProvisioner: conf.DefaultProvisioner,
 				ExternalURL:        conf.ExternalGRPCURL, // NOTE: using gRPC url
@@ -300,6 +315,7 @@ func StartCmd(ch *cmdutil.Helper) *cobra.Command {
 					GithubAppWebhookSecret: conf.GithubAppWebhookSecret,
 					GithubClientID:         conf.GithubClientID,
 					GithubClientSecret:     conf.GithubClientSecret,
+					AuditLogForwarding:     conf.AuditLogForwarding,
 				})
 				if err != nil {
 					logger.Fatal("error creating server", zap.Error(err))
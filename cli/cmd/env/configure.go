@@ -19,13 +19,18 @@ import (
 )
 
 func ConfigureCmd(ch *cmdutil.Helper) *cobra.Command {
-	var projectPath, projectName string
-	var redeploy bool
+	var projectPath, projectName, environment string
+	var redeploy, shared bool
 
 	configureCommand := &cobra.Command{
 		Use:   "configure",
 		Short: "Configures connector variables for all sources",
 		RunE: func(cmd *cobra.Command, args []string) error {
+			environment, err := resolveEnvironment(cmd, environment, shared)
+			if err != nil {
+				return err
+			}
+
 			ctx := cmd.Context()
 			client, err := ch.Client()
 			if err != nil {
@@ -66,6 +71,7 @@ func ConfigureCmd(ch *cmdutil.Helper) *cobra.Command {
 			varResp, err := client.GetProjectVariables(ctx, &adminv1.GetProjectVariablesRequest{
 				OrganizationName: ch.Org,
 				Name:             projectName,
+				Environment:      environment,
 			})
 			if err != nil {
 				return fmt.Errorf("failed to list existing variables %w", err)
@@ -84,6 +90,7 @@ func ConfigureCmd(ch *cmdutil.Helper) *cobra.Command {
 				OrganizationName: ch.Org,
 				Name:             projectName,
 				Variables:        varResp.Variables,
+				Environment:      environment,
 			})
 			if err != nil {
 				return fmt.Errorf("failed to update variables %w", err)
@@ -105,6 +112,7 @@ func ConfigureCmd(ch *cmdutil.Helper) *cobra.Command {
 	configureCommand.Flags().SortFlags = false
 	configureCommand.Flags().StringVar(&projectPath, "path", ".", "Project directory")
 	configureCommand.Flags().StringVar(&projectName, "project", "", "")
+	addEnvironmentFlags(configureCommand, &environment, &shared)
 	configureCommand.Flags().BoolVar(&redeploy, "redeploy", false, "Redeploy project")
 
 	return configureCommand
@@ -1,6 +1,7 @@
 package env
 
 import (
+	"fmt"
 	"path/filepath"
 
 	"github.com/rilldata/rill/cli/pkg/cmdutil"
@@ -23,6 +24,25 @@ func EnvCmd(ch *cmdutil.Helper) *cobra.Command {
 	return envCmd
 }
 
+// addEnvironmentFlags adds the flags for selecting which of a project's variables to operate on.
+// Variables are scoped to the prod environment by default, since sharing them with all environments (including dev and previews) must be explicit.
+func addEnvironmentFlags(cmd *cobra.Command, environment *string, shared *bool) {
+	cmd.Flags().StringVar(environment, "environment", "prod", "Environment name")
+	cmd.Flags().BoolVar(shared, "shared", false, "Use the variables shared by all environments instead of the variables of an environment")
+}
+
+// resolveEnvironment returns the environment to request variables for based on the flags added by addEnvironmentFlags.
+// An empty environment refers to the variables shared by all environments.
+func resolveEnvironment(cmd *cobra.Command, environment string, shared bool) (string, error) {
+	if !shared {
+		return environment, nil
+	}
+	if cmd.Flags().Changed("environment") {
+		return "", fmt.Errorf("the --shared and --environment flags can't be used together")
+	}
+	return "", nil
+}
+
 // normalizeProjectPath normalizes a project path provided by the user
 func normalizeProjectPath(projectPath string) (string, error) {
 	res, err := fileutil.ExpandHome(projectPath)
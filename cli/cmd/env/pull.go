@@ -17,7 +17,7 @@ import (
 )
 
 func PullCmd(ch *cmdutil.Helper) *cobra.Command {
-	var projectPath, projectName string
+	var projectPath, projectName, environment string
 
 	pullCmd := &cobra.Command{
 		Use:   "pull",
@@ -65,10 +65,41 @@ func PullCmd(ch *cmdutil.Helper) *cobra.Command {
 			if err != nil {
 				return err
 			}
+			cloudVars := res.Variables
+			masked := res.MaskedVariables
+
+			// Variables set for the environment take precedence over variables for all environments
+			if environment != "" {
+				envRes, err := client.GetProjectVariables(cmd.Context(), &adminv1.GetProjectVariablesRequest{
+					OrganizationName: ch.Org,
+					Name:             projectName,
+					Environment:      environment,
+				})
+				if err != nil {
+					return err
+				}
+				cloudVars = make(map[string]string, len(res.Variables)+len(envRes.Variables))
+				maps.Copy(cloudVars, res.Variables)
+				maps.Copy(cloudVars, envRes.Variables)
+				masked = append(masked, envRes.MaskedVariables...)
+			}
+
+			// Don't write placeholders for values the user is not allowed to read
+			if len(masked) > 0 {
+				cloudVars = maps.Clone(cloudVars)
+				skipped := 0
+				for _, k := range masked {
+					if _, ok := cloudVars[k]; ok {
+						delete(cloudVars, k)
+						skipped++
+					}
+				}
+				ch.PrintfWarn("Skipped %d variable(s) that require project admin permissions to read.\n", skipped)
+			}
 
 			// If the variables match any existing .env file, do nothing
-			if maps.Equal(res.Variables, parser.DotEnv) {
-				if len(res.Variables) == 0 {
+			if maps.Equal(cloudVars, parser.DotEnv) {
+				if len(cloudVars) == 0 {
 					ch.Printf("No cloud credentials found for project %q.\n", projectName)
 				} else {
 					ch.Printf("Local .env file is already up to date with cloud credentials.\n")
@@ -81,7 +112,7 @@ func PullCmd(ch *cmdutil.Helper) *cobra.Command {
 			for k, v := range parser.DotEnv {
 				vars[k] = v
 			}
-			for k, v := range res.Variables {
+			for k, v := range cloudVars {
 				vars[k] = v
 			}
 			err = godotenv.Write(vars, filepath.Join(projectPath, ".env"))
@@ -105,6 +136,7 @@ func PullCmd(ch *cmdutil.Helper) *cobra.Command {
 
 	pullCmd.Flags().StringVar(&projectPath, "path", ".", "Project directory")
 	pullCmd.Flags().StringVar(&projectName, "project", "", "Cloud project name (will attempt to infer from Git remote if not provided)")
+	pullCmd.Flags().StringVar(&environment, "environment", "dev", "Environment name")
 
 	return pullCmd
 }
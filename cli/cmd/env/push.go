@@ -11,12 +11,18 @@ import (
 )
 
 func PushCmd(ch *cmdutil.Helper) *cobra.Command {
-	var projectPath, projectName string
+	var projectPath, projectName, environment string
+	var shared bool
 
 	pullCmd := &cobra.Command{
 		Use:   "push",
 		Short: "Push local .env contents to cloud",
 		RunE: func(cmd *cobra.Command, args []string) error {
+			environment, err := resolveEnvironment(cmd, environment, shared)
+			if err != nil {
+				return err
+			}
+
 			// If projectPath is provided, normalize it
 			if projectPath != "" {
 				var err error
@@ -55,6 +61,7 @@ func PushCmd(ch *cmdutil.Helper) *cobra.Command {
 			res, err := client.GetProjectVariables(cmd.Context(), &adminv1.GetProjectVariablesRequest{
 				OrganizationName: ch.Org,
 				Name:             projectName,
+				Environment:      environment,
 			})
 			if err != nil {
 				return err
@@ -100,6 +107,7 @@ func PushCmd(ch *cmdutil.Helper) *cobra.Command {
 					OrganizationName: ch.Org,
 					Name:             projectName,
 					Variables:        vars,
+					Environment:      environment,
 				})
 				if err != nil {
 					return err
@@ -113,6 +121,7 @@ func PushCmd(ch *cmdutil.Helper) *cobra.Command {
 
 	pullCmd.Flags().StringVar(&projectPath, "path", ".", "Project directory")
 	pullCmd.Flags().StringVar(&projectName, "project", "", "Cloud project name (will attempt to infer from Git remote if not provided)")
+	addEnvironmentFlags(pullCmd, &environment, &shared)
 
 	return pullCmd
 }
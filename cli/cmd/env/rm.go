@@ -8,13 +8,19 @@ import (
 
 // RmCmd is sub command for env. Removes the variable for a project
 func RmCmd(ch *cmdutil.Helper) *cobra.Command {
-	var projectPath, projectName string
+	var projectPath, projectName, environment string
+	var shared bool
 
 	rmCmd := &cobra.Command{
 		Use:   "rm <key>",
 		Args:  cobra.ExactArgs(1),
 		Short: "Remove variable",
 		RunE: func(cmd *cobra.Command, args []string) error {
+			environment, err := resolveEnvironment(cmd, environment, shared)
+			if err != nil {
+				return err
+			}
+
 			key := args[0]
 			ctx := cmd.Context()
 			client, err := ch.Client()
@@ -33,6 +39,7 @@ func RmCmd(ch *cmdutil.Helper) *cobra.Command {
 			resp, err := client.GetProjectVariables(ctx, &adminv1.GetProjectVariablesRequest{
 				OrganizationName: ch.Org,
 				Name:             projectName,
+				Environment:      environment,
 			})
 			if err != nil {
 				return err
@@ -47,6 +54,7 @@ func RmCmd(ch *cmdutil.Helper) *cobra.Command {
 				OrganizationName: ch.Org,
 				Name:             projectName,
 				Variables:        resp.Variables,
+				Environment:      environment,
 			})
 			if err != nil {
 				return err
@@ -59,6 +67,7 @@ func RmCmd(ch *cmdutil.Helper) *cobra.Command {
 
 	rmCmd.Flags().StringVar(&projectName, "project", "", "Cloud project name (will attempt to infer from Git remote if not provided)")
 	rmCmd.Flags().StringVar(&projectPath, "path", ".", "Project directory")
+	addEnvironmentFlags(rmCmd, &environment, &shared)
 
 	return rmCmd
 }
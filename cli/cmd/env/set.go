@@ -8,13 +8,19 @@ import (
 
 // SetCmd is sub command for env. Sets the variable for a project
 func SetCmd(ch *cmdutil.Helper) *cobra.Command {
-	var projectPath, projectName string
+	var projectPath, projectName, environment string
+	var shared bool
 
 	setCmd := &cobra.Command{
 		Use:   "set <key> <value>",
 		Args:  cobra.ExactArgs(2),
 		Short: "Set variable",
 		RunE: func(cmd *cobra.Command, args []string) error {
+			environment, err := resolveEnvironment(cmd, environment, shared)
+			if err != nil {
+				return err
+			}
+
 			key := args[0]
 			value := args[1]
 			ctx := cmd.Context()
@@ -34,6 +40,7 @@ func SetCmd(ch *cmdutil.Helper) *cobra.Command {
 			resp, err := client.GetProjectVariables(ctx, &adminv1.GetProjectVariablesRequest{
 				OrganizationName: ch.Org,
 				Name:             projectName,
+				Environment:      environment,
 			})
 			if err != nil {
 				return err
@@ -51,6 +58,7 @@ func SetCmd(ch *cmdutil.Helper) *cobra.Command {
 				OrganizationName: ch.Org,
 				Name:             projectName,
 				Variables:        resp.Variables,
+				Environment:      environment,
 			})
 			if err != nil {
 				return err
@@ -63,6 +71,7 @@ func SetCmd(ch *cmdutil.Helper) *cobra.Command {
 
 	setCmd.Flags().StringVar(&projectName, "project", "", "Cloud project name (will attempt to infer from Git remote if not provided)")
 	setCmd.Flags().StringVar(&projectPath, "path", ".", "Project directory")
+	addEnvironmentFlags(setCmd, &environment, &shared)
 
 	return setCmd
 }
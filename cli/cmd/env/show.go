@@ -8,12 +8,18 @@ import (
 )
 
 func ShowCmd(ch *cmdutil.Helper) *cobra.Command {
-	var projectPath, projectName string
+	var projectPath, projectName, environment string
+	var shared bool
 
 	showCmd := &cobra.Command{
 		Use:   "show",
 		Short: "Show credentials and other variables",
 		RunE: func(cmd *cobra.Command, args []string) error {
+			environment, err := resolveEnvironment(cmd, environment, shared)
+			if err != nil {
+				return err
+			}
+
 			client, err := ch.Client()
 			if err != nil {
 				return err
@@ -30,6 +36,7 @@ func ShowCmd(ch *cmdutil.Helper) *cobra.Command {
 			resp, err := client.GetProjectVariables(cmd.Context(), &adminv1.GetProjectVariablesRequest{
 				OrganizationName: ch.Org,
 				Name:             projectName,
+				Environment:      environment,
 			})
 			if err != nil {
 				return err
@@ -48,6 +55,7 @@ func ShowCmd(ch *cmdutil.Helper) *cobra.Command {
 
 	showCmd.Flags().StringVar(&projectName, "project", "", "Cloud project name (will attempt to infer from Git remote if not provided)")
 	showCmd.Flags().StringVar(&projectPath, "path", ".", "Project directory")
+	addEnvironmentFlags(showCmd, &environment, &shared)
 
 	return showCmd
 }
@@ -0,0 +1,95 @@
+package org
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func AuditCmd(ch *cmdutil.Helper) *cobra.Command {
+	var orgName, projectName, actorEmail, action, since, until string
+	var pageSize uint32
+	var pageToken string
+
+	auditCmd := &cobra.Command{
+		Use:   "audit",
+		Args:  cobra.NoArgs,
+		Short: "List audit events for an organization",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := ch.Client()
+			if err != nil {
+				return err
+			}
+
+			now := time.Now()
+			startTime, err := parseAuditTime(since, now)
+			if err != nil {
+				return fmt.Errorf("invalid --since: %w", err)
+			}
+			endTime, err := parseAuditTime(until, now)
+			if err != nil {
+				return fmt.Errorf("invalid --until: %w", err)
+			}
+
+			res, err := client.ListAuditEvents(cmd.Context(), &adminv1.ListAuditEventsRequest{
+				Organization: orgName,
+				Project:      projectName,
+				ActorEmail:   actorEmail,
+				Action:       action,
+				StartTime:    startTime,
+				EndTime:      endTime,
+				PageSize:     pageSize,
+				PageToken:    pageToken,
+			})
+			if err != nil {
+				return err
+			}
+
+			if len(res.Events) == 0 {
+				ch.PrintfWarn("No audit events found\n")
+				return nil
+			}
+
+			ch.PrintAuditEvents(res.Events)
+
+			if res.NextPageToken != "" {
+				cmd.Println()
+				cmd.Printf("Next page token: %s\n", res.NextPageToken)
+			}
+			return nil
+		},
+	}
+
+	auditCmd.Flags().SortFlags = false
+	auditCmd.Flags().StringVar(&orgName, "org", ch.Org, "Organization Name")
+	auditCmd.Flags().StringVar(&projectName, "project", "", "Only show events for this project")
+	auditCmd.Flags().StringVar(&actorEmail, "actor", "", "Only show events by the user with this email")
+	auditCmd.Flags().StringVar(&action, "action", "", "Only show events for this action (e.g. UpdateProjectVariables)")
+	auditCmd.Flags().StringVar(&since, "since", "", "Only show events after this time (RFC3339 timestamp or duration like 24h)")
+	auditCmd.Flags().StringVar(&until, "until", "", "Only show events before this time (RFC3339 timestamp or duration like 1h)")
+	auditCmd.Flags().Uint32Var(&pageSize, "page-size", 50, "Number of events to return per page")
+	auditCmd.Flags().StringVar(&pageToken, "page-token", "", "Pagination token")
+
+	return auditCmd
+}
+
+// parseAuditTime parses an RFC3339 timestamp or a duration relative to now.
+func parseAuditTime(s string, now time.Time) (*timestamppb.Timestamp, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	if d, err := time.ParseDuration(s); err == nil {
+		return timestamppb.New(now.Add(-d)), nil
+	}
+
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return nil, fmt.Errorf("expected an RFC3339 timestamp or a duration, got %q", s)
+	}
+	return timestamppb.New(t), nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/rilldata/rill/cli/cmd/org/sso"
 	"github.com/rilldata/rill/cli/pkg/cmdutil"
 	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
 	"github.com/spf13/cobra"
@@ -22,6 +23,8 @@ func OrgCmd(ch *cmdutil.Helper) *cobra.Command {
 	orgCmd.AddCommand(ListCmd(ch))
 	orgCmd.AddCommand(DeleteCmd(ch))
 	orgCmd.AddCommand(RenameCmd(ch))
+	orgCmd.AddCommand(AuditCmd(ch))
+	orgCmd.AddCommand(sso.SSOCmd(ch))
 
 	return orgCmd
 }
@@ -0,0 +1,47 @@
+package sso
+
+import (
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func DeleteCmd(ch *cmdutil.Helper) *cobra.Command {
+	var force bool
+
+	deleteCmd := &cobra.Command{
+		Use:   "delete",
+		Args:  cobra.NoArgs,
+		Short: "Remove the SSO provider of the organization",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := ch.Client()
+			if err != nil {
+				return err
+			}
+
+			if !force {
+				ch.PrintfWarn("If you confirm, users of organization %q will log in with the default login flow.\n", ch.Org)
+				ok, err := cmdutil.ConfirmPrompt("Do you confirm?", "", false)
+				if err != nil {
+					return err
+				}
+				if !ok {
+					return nil
+				}
+			}
+
+			_, err = client.DeleteSSOProvider(cmd.Context(), &adminv1.DeleteSSOProviderRequest{Organization: ch.Org})
+			if err != nil {
+				return err
+			}
+
+			ch.PrintfSuccess("Removed the SSO provider of organization %q\n", ch.Org)
+			return nil
+		},
+	}
+
+	deleteCmd.Flags().StringVar(&ch.Org, "org", ch.Org, "Organization")
+	deleteCmd.Flags().BoolVar(&force, "force", false, "Delete without confirmation")
+
+	return deleteCmd
+}
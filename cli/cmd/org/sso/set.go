@@ -0,0 +1,70 @@
+package sso
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func SetCmd(ch *cmdutil.Helper) *cobra.Command {
+	req := &adminv1.SetSSOProviderRequest{}
+	var samlMetadataFile string
+
+	setCmd := &cobra.Command{
+		Use:   "set",
+		Args:  cobra.NoArgs,
+		Short: "Configure the SSO provider of the organization",
+		Long: `Configure the SSO provider of the organization.
+
+Users with an email address in one of the domains will log in through the identity provider.
+A domain is only used once it has been verified with a DNS TXT record (printed by this command) or set by a Rill superuser.
+After adding the DNS record, run this command again to verify the domain.
+For OIDC, register the redirect URL printed by this command with the identity provider.
+For SAML, register the entity ID and ACS URL printed by this command with the identity provider.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := ch.Client()
+			if err != nil {
+				return err
+			}
+
+			if samlMetadataFile != "" {
+				data, err := os.ReadFile(samlMetadataFile)
+				if err != nil {
+					return fmt.Errorf("failed to read SAML metadata file: %w", err)
+				}
+				req.SamlIdpMetadataXml = string(data)
+			}
+			req.Organization = ch.Org
+
+			res, err := client.SetSSOProvider(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			printProvider(ch, res.Provider)
+			return nil
+		},
+	}
+
+	setCmd.Flags().SortFlags = false
+	setCmd.Flags().StringVar(&ch.Org, "org", ch.Org, "Organization")
+	setCmd.Flags().StringVar(&req.Type, "type", "", "Type of identity provider (oidc or saml)")
+	setCmd.Flags().StringSliceVar(&req.Domains, "domain", nil, "Email domain of users who log in through the provider (can be repeated)")
+	setCmd.Flags().StringVar(&req.JitRole, "jit-role", "", "Role to add new users to the organization with on their first login (if not set, users must be invited)")
+	setCmd.Flags().StringVar(&req.OidcIssuerUrl, "oidc-issuer-url", "", "OIDC issuer URL")
+	setCmd.Flags().StringVar(&req.OidcClientId, "oidc-client-id", "", "OIDC client ID")
+	setCmd.Flags().StringVar(&req.OidcClientSecret, "oidc-client-secret", "", "OIDC client secret (keeps the current secret if not set)")
+	setCmd.Flags().StringSliceVar(&req.OidcScopes, "oidc-scope", nil, "OIDC scopes to request (default: email, profile)")
+	setCmd.Flags().StringVar(&req.SamlIdpMetadataUrl, "saml-metadata-url", "", "URL of the SAML identity provider's metadata")
+	setCmd.Flags().StringVar(&samlMetadataFile, "saml-metadata-file", "", "Path to the SAML identity provider's metadata XML")
+	setCmd.Flags().StringVar(&req.EmailClaim, "email-claim", "", "Name of the claim or attribute containing the user's email")
+	setCmd.Flags().StringVar(&req.NameClaim, "name-claim", "", "Name of the claim or attribute containing the user's name")
+	setCmd.Flags().StringVar(&req.GroupsClaim, "groups-claim", "", "Name of the claim or attribute containing the user's groups")
+	_ = setCmd.MarkFlagRequired("type")
+	_ = setCmd.MarkFlagRequired("domain")
+
+	return setCmd
+}
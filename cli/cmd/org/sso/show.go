@@ -0,0 +1,81 @@
+package sso
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func ShowCmd(ch *cmdutil.Helper) *cobra.Command {
+	showCmd := &cobra.Command{
+		Use:   "show",
+		Args:  cobra.NoArgs,
+		Short: "Show the SSO provider of the organization",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := ch.Client()
+			if err != nil {
+				return err
+			}
+
+			res, err := client.GetSSOProvider(cmd.Context(), &adminv1.GetSSOProviderRequest{Organization: ch.Org})
+			if err != nil {
+				return err
+			}
+
+			printProvider(ch, res.Provider)
+			return nil
+		},
+	}
+
+	showCmd.Flags().StringVar(&ch.Org, "org", ch.Org, "Organization")
+
+	return showCmd
+}
+
+func printProvider(ch *cmdutil.Helper, p *adminv1.SSOProvider) {
+	ch.PrintfSuccess("SSO provider of organization %q:\n", ch.Org)
+	ch.Printf("  Type: %s\n", p.Type)
+	ch.Printf("  Domains: %s\n", strings.Join(p.Domains, ", "))
+	var unverified []string
+	for _, d := range p.Domains {
+		if !slices.Contains(p.VerifiedDomains, d) {
+			unverified = append(unverified, d)
+		}
+	}
+	if len(unverified) > 0 {
+		ch.PrintfWarn("  Unverified domains: %s\n", strings.Join(unverified, ", "))
+		ch.Printf("  To verify a domain, add a DNS TXT record named \"_rill-challenge.<domain>\" with the value %q and run \"rill org sso set\" again.\n", p.DomainVerificationTxtValue)
+	}
+	if p.JitRole != "" {
+		ch.Printf("  Role for new users: %s\n", p.JitRole)
+	} else {
+		ch.Printf("  Role for new users: none (users must be invited)\n")
+	}
+	switch p.Type {
+	case "oidc":
+		ch.Printf("  Issuer URL: %s\n", p.OidcIssuerUrl)
+		ch.Printf("  Client ID: %s\n", p.OidcClientId)
+		if len(p.OidcScopes) > 0 {
+			ch.Printf("  Scopes: %s\n", strings.Join(p.OidcScopes, ", "))
+		}
+		ch.Printf("  Redirect URL: %s\n", p.OidcRedirectUrl)
+	case "saml":
+		if p.SamlIdpMetadataUrl != "" {
+			ch.Printf("  IdP metadata URL: %s\n", p.SamlIdpMetadataUrl)
+		}
+		ch.Printf("  Entity ID (SP metadata URL): %s\n", p.SamlEntityId)
+		ch.Printf("  ACS URL: %s\n", p.SamlAcsUrl)
+	}
+	if p.EmailClaim != "" {
+		ch.Printf("  Email claim: %s\n", p.EmailClaim)
+	}
+	if p.NameClaim != "" {
+		ch.Printf("  Name claim: %s\n", p.NameClaim)
+	}
+	if p.GroupsClaim != "" {
+		ch.Printf("  Groups claim: %s\n", p.GroupsClaim)
+	}
+}
@@ -0,0 +1,20 @@
+package sso
+
+import (
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func SSOCmd(ch *cmdutil.Helper) *cobra.Command {
+	ssoCmd := &cobra.Command{
+		Use:               "sso",
+		Short:             "Manage single sign-on through an OIDC or SAML identity provider",
+		PersistentPreRunE: cmdutil.CheckChain(cmdutil.CheckAuth(ch), cmdutil.CheckOrganization(ch)),
+	}
+
+	ssoCmd.AddCommand(ShowCmd(ch))
+	ssoCmd.AddCommand(SetCmd(ch))
+	ssoCmd.AddCommand(DeleteCmd(ch))
+
+	return ssoCmd
+}
@@ -10,7 +10,7 @@ import (
 
 func EditCmd(ch *cmdutil.Helper) *cobra.Command {
 	var name, description, prodVersion, prodBranch, path, provisioner string
-	var public bool
+	var public, previewDeployments bool
 	var slots int
 	var prodTTL int64
 
@@ -82,6 +82,11 @@ func EditCmd(ch *cmdutil.Helper) *cobra.Command {
 				req.ProdTtlSeconds = &prodTTL
 			}
 
+			if cmd.Flags().Changed("preview-deployments") {
+				promptFlagValues = false
+				req.PreviewDeployments = &previewDeployments
+			}
+
 			if promptFlagValues {
 				resp, err := client.GetProject(ctx, &adminv1.GetProjectRequest{OrganizationName: ch.Org, Name: name})
 				if err != nil {
@@ -130,6 +135,7 @@ func EditCmd(ch *cmdutil.Helper) *cobra.Command {
 	editCmd.Flags().StringVar(&path, "path", ".", "Project directory")
 	editCmd.Flags().StringVar(&provisioner, "provisioner", "", "Project provisioner (default: current provisioner)")
 	editCmd.Flags().Int64Var(&prodTTL, "prod-ttl-seconds", 0, "Prod deployment TTL in seconds")
+	editCmd.Flags().BoolVar(&previewDeployments, "preview-deployments", false, "Deploy pull requests and pushes to other branches as preview deployments")
 	editCmd.Flags().StringVar(&prodVersion, "prod-version", "", "Rill version (default: current version)")
 	editCmd.Flags().IntVar(&slots, "prod-slots", 0, "Slots to allocate for production deployments (default: current slots)")
 	if !ch.IsDev() {
@@ -2,15 +2,18 @@ package project
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/rilldata/rill/cli/pkg/cmdutil"
 	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
 	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func RefreshCmd(ch *cmdutil.Helper) *cobra.Command {
 	var project, path string
-	var source []string
+	var source, reports, alerts []string
+	var from, to string
 
 	refreshCmd := &cobra.Command{
 		Use:               "refresh [<project-name>]",
@@ -20,6 +23,28 @@ func RefreshCmd(ch *cmdutil.Helper) *cobra.Command {
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
 
+			if (from == "") != (to == "") {
+				return fmt.Errorf("both --from and --to must be set to backfill")
+			}
+			var backfillFrom, backfillTo *timestamppb.Timestamp
+			if from != "" {
+				if len(reports) == 0 && len(alerts) == 0 {
+					return fmt.Errorf("--from and --to can only be used with --report or --alert")
+				}
+				if len(source) > 0 {
+					return fmt.Errorf("--from and --to cannot be used with --source")
+				}
+				var err error
+				backfillFrom, err = parseBackfillTime(from)
+				if err != nil {
+					return fmt.Errorf("invalid --from: %w", err)
+				}
+				backfillTo, err = parseBackfillTime(to)
+				if err != nil {
+					return fmt.Errorf("invalid --to: %w", err)
+				}
+			}
+
 			client, err := ch.Client()
 			if err != nil {
 				return err
@@ -49,11 +74,23 @@ func RefreshCmd(ch *cmdutil.Helper) *cobra.Command {
 				return fmt.Errorf("no production deployment found for project %q", project)
 			}
 
-			_, err = client.TriggerRefreshSources(ctx, &adminv1.TriggerRefreshSourcesRequest{DeploymentId: resp.ProdDeployment.Id, Sources: source})
+			_, err = client.TriggerRefreshSources(ctx, &adminv1.TriggerRefreshSourcesRequest{
+				DeploymentId: resp.ProdDeployment.Id,
+				Sources:      source,
+				Reports:      reports,
+				Alerts:       alerts,
+				BackfillFrom: backfillFrom,
+				BackfillTo:   backfillTo,
+			})
 			if err != nil {
 				return fmt.Errorf("failed to trigger refresh: %w", err)
 			}
 
+			if backfillFrom != nil {
+				fmt.Printf("Triggered backfill. To see status, run `rill project status --project %s`.\n", project)
+				return nil
+			}
+
 			fmt.Printf("Triggered refresh. To see status, run `rill project status --project %s`.\n", project)
 
 			return nil
@@ -64,6 +101,23 @@ func RefreshCmd(ch *cmdutil.Helper) *cobra.Command {
 	refreshCmd.Flags().StringVar(&project, "project", "", "Project name")
 	refreshCmd.Flags().StringVar(&path, "path", ".", "Project directory")
 	refreshCmd.Flags().StringSliceVar(&source, "source", nil, "Refresh specific source(s)")
+	refreshCmd.Flags().StringSliceVar(&reports, "report", nil, "Run specific report(s)")
+	refreshCmd.Flags().StringSliceVar(&alerts, "alert", nil, "Check specific alert(s)")
+	refreshCmd.Flags().StringVar(&from, "from", "", "Backfill reports and alerts from this time (exclusive, RFC3339 timestamp or date)")
+	refreshCmd.Flags().StringVar(&to, "to", "", "Backfill reports and alerts until this time (inclusive, RFC3339 timestamp or date)")
 
 	return refreshCmd
 }
+
+// parseBackfillTime parses an RFC3339 timestamp or a date (interpreted as midnight UTC).
+func parseBackfillTime(s string) (*timestamppb.Timestamp, error) {
+	if t, err := time.Parse(time.DateOnly, s); err == nil {
+		return timestamppb.New(t), nil
+	}
+
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return nil, fmt.Errorf("expected an RFC3339 timestamp or a date, got %q", s)
+	}
+	return timestamppb.New(t), nil
+}
@@ -53,6 +53,20 @@ func StatusCmd(ch *cmdutil.Helper) *cobra.Command {
 			fmt.Printf("  Github: %v\n", proj.Project.GithubUrl)
 			fmt.Printf("  Created: %s\n", proj.Project.CreatedOn.AsTime().Local().Format(time.RFC3339))
 			fmt.Printf("  Updated: %s\n", proj.Project.UpdatedOn.AsTime().Local().Format(time.RFC3339))
+			if proj.Project.PreviewDeployments {
+				fmt.Printf("  Preview deployments: %v\n", proj.Project.PreviewDeployments)
+			}
+
+			// Print preview deployments of other branches
+			if len(proj.PreviewDeployments) > 0 {
+				var table []*previewDeploymentTableRow
+				for _, d := range proj.PreviewDeployments {
+					table = append(table, newPreviewDeploymentTableRow(d))
+				}
+
+				ch.PrintfSuccess("\nPreview deployments\n\n")
+				ch.PrintData(table)
+			}
 
 			depl := proj.ProdDeployment
 			if depl == nil {
@@ -131,6 +145,29 @@ func StatusCmd(ch *cmdutil.Helper) *cobra.Command {
 	return statusCmd
 }
 
+type previewDeploymentTableRow struct {
+	Branch      string `header:"branch"`
+	PullRequest string `header:"pull request"`
+	Status      string `header:"status"`
+	Runtime     string `header:"runtime"`
+	Updated     string `header:"updated"`
+}
+
+func newPreviewDeploymentTableRow(d *adminv1.Deployment) *previewDeploymentTableRow {
+	status := strings.TrimPrefix(d.Status.String(), "DEPLOYMENT_STATUS_")
+	if d.ClosedOn != nil {
+		status = "CLOSED"
+	}
+
+	return &previewDeploymentTableRow{
+		Branch:      d.Branch,
+		PullRequest: d.PullRequestUrl,
+		Status:      status,
+		Runtime:     d.RuntimeHost,
+		Updated:     d.UpdatedOn.AsTime().Local().Format(time.RFC3339),
+	}
+}
+
 type resourceTableRow struct {
 	Type   string `header:"type"`
 	Name   string `header:"name"`
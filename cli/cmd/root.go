@@ -21,6 +21,7 @@ import (
 	"github.com/rilldata/rill/cli/cmd/uninstall"
 	"github.com/rilldata/rill/cli/cmd/upgrade"
 	"github.com/rilldata/rill/cli/cmd/user"
+	"github.com/rilldata/rill/cli/cmd/usergroup"
 	versioncmd "github.com/rilldata/rill/cli/cmd/version"
 	"github.com/rilldata/rill/cli/cmd/whoami"
 	"github.com/rilldata/rill/cli/pkg/cmdutil"
@@ -144,6 +145,7 @@ func runCmd(ctx context.Context, ver cmdutil.Version) error {
 		deploy.DeployCmd(ch),
 		env.EnvCmd(ch),
 		user.UserCmd(ch),
+		usergroup.UsergroupCmd(ch),
 		org.OrgCmd(ch),
 		project.ProjectCmd(ch),
 		service.ServiceCmd(ch),
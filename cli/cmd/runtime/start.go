@@ -18,6 +18,7 @@ import (
 	"github.com/rilldata/rill/runtime/pkg/email"
 	"github.com/rilldata/rill/runtime/pkg/graceful"
 	"github.com/rilldata/rill/runtime/pkg/observability"
+	"github.com/rilldata/rill/runtime/pkg/querycache"
 	"github.com/rilldata/rill/runtime/pkg/ratelimit"
 	"github.com/rilldata/rill/runtime/server"
 	"github.com/spf13/cobra"
@@ -33,10 +34,13 @@ import (
 	_ "github.com/rilldata/rill/runtime/drivers/clickhouse"
 	_ "github.com/rilldata/rill/runtime/drivers/druid"
 	_ "github.com/rilldata/rill/runtime/drivers/duckdb"
+	_ "github.com/rilldata/rill/runtime/drivers/email"
 	_ "github.com/rilldata/rill/runtime/drivers/file"
 	_ "github.com/rilldata/rill/runtime/drivers/gcs"
 	_ "github.com/rilldata/rill/runtime/drivers/https"
+	_ "github.com/rilldata/rill/runtime/drivers/kafka"
 	_ "github.com/rilldata/rill/runtime/drivers/mysql"
+	_ "github.com/rilldata/rill/runtime/drivers/oracle"
 	_ "github.com/rilldata/rill/runtime/drivers/pinot"
 	_ "github.com/rilldata/rill/runtime/drivers/postgres"
 	_ "github.com/rilldata/rill/runtime/drivers/redshift"
@@ -45,6 +49,8 @@ import (
 	_ "github.com/rilldata/rill/runtime/drivers/slack"
 	_ "github.com/rilldata/rill/runtime/drivers/snowflake"
 	_ "github.com/rilldata/rill/runtime/drivers/sqlite"
+	_ "github.com/rilldata/rill/runtime/drivers/sqlserver"
+	_ "github.com/rilldata/rill/runtime/drivers/webhook"
 	_ "github.com/rilldata/rill/runtime/reconcilers"
 	_ "github.com/rilldata/rill/runtime/resolvers"
 )
@@ -76,6 +82,7 @@ type Config struct {
 	EmailBCC                string                 `split_words:"true"`
 	ConnectionCacheSize     int                    `default:"100" split_words:"true"`
 	QueryCacheSizeBytes     int64                  `default:"104857600" split_words:"true"` // 100MB by default
+	QueryCacheTTL           time.Duration          `default:"0" split_words:"true"`
 	SecurityEngineCacheSize int                    `default:"1000" split_words:"true"`
 	LogBufferCapacity       int                    `default:"10000" split_words:"true"`    // 10k log lines
 	LogBufferSizeBytes      int64                  `default:"16777216" split_words:"true"` // 16MB by default
@@ -91,6 +98,13 @@ type Config struct {
 	ActivitySinkKafkaBrokers string `default:"" split_words:"true"`
 	// Kafka topic of an activity client's sink
 	ActivitySinkKafkaTopic string `default:"" split_words:"true"`
+	// Backend for the query cache: memory (default) or redis.
+	// The redis backend shares cached results between replicas that use the same Redis database.
+	QueryCacheBackend string `default:"memory" split_words:"true"`
+	// Redis URL for the redis query cache backend. Defaults to RedisURL.
+	QueryCacheRedisURL string `default:"" split_words:"true"`
+	// Results larger than this are not stored in the redis query cache backend
+	QueryCacheMaxEntrySizeBytes int64 `default:"10485760" split_words:"true"` // 10MB by default
 }
 
 // StartCmd starts a stand-alone runtime server. It only allows configuration using environment variables.
@@ -198,11 +212,39 @@ func StartCmd(ch *cmdutil.Helper) *cobra.Command {
 			// Create ctx that cancels on termination signals
 			ctx := graceful.WithCancelOnTerminate(context.Background())
 
+			// Init query cache
+			var queryCache querycache.Cache
+			switch conf.QueryCacheBackend {
+			case "", "memory":
+				// The runtime creates the in-memory cache from QueryCacheSizeBytes and QueryCacheTTL
+			case "redis":
+				redisURL := conf.QueryCacheRedisURL
+				if redisURL == "" {
+					redisURL = conf.RedisURL
+				}
+				if redisURL == "" {
+					logger.Fatal("the redis query cache backend requires a redis url")
+				}
+				opts, err := redis.ParseURL(redisURL)
+				if err != nil {
+					logger.Fatal("failed to parse query cache redis url", zap.Error(err))
+				}
+				queryCache = querycache.NewRedis(redis.NewClient(opts), runtime.QueryCacheCodec, &querycache.RedisOptions{
+					KeyPrefix:         "rill:query_cache:",
+					TTL:               conf.QueryCacheTTL,
+					MaxEntrySizeBytes: conf.QueryCacheMaxEntrySizeBytes,
+				})
+			default:
+				logger.Fatal("invalid query cache backend", zap.String("backend", conf.QueryCacheBackend))
+			}
+
 			// Init runtime
 			opts := &runtime.Options{
 				ConnectionCacheSize:          conf.ConnectionCacheSize,
 				MetastoreConnector:           "metastore",
 				QueryCacheSizeBytes:          conf.QueryCacheSizeBytes,
+				QueryCacheTTL:                conf.QueryCacheTTL,
+				QueryCache:                   queryCache,
 				SecurityEngineCacheSize:      conf.SecurityEngineCacheSize,
 				ControllerLogBufferCapacity:  conf.LogBufferCapacity,
 				ControllerLogBufferSizeBytes: conf.LogBufferSizeBytes,
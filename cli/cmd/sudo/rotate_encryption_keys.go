@@ -0,0 +1,34 @@
+package sudo
+
+import (
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func rotateEncryptionKeysCmd(ch *cmdutil.Helper) *cobra.Command {
+	rotateCmd := &cobra.Command{
+		Use:   "rotate-encryption-keys",
+		Args:  cobra.NoArgs,
+		Short: "Re-wrap encrypted secrets with the current encryption key",
+		Long:  "Re-wrap the data keys of encrypted project secrets with the admin service's current key encryption key, and encrypt secrets stored before encryption was configured. Run it after adding a new key to the keyfile, before removing the old key.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			client, err := ch.Client()
+			if err != nil {
+				return err
+			}
+
+			res, err := client.SudoRotateEncryptionKeys(ctx, &adminv1.SudoRotateEncryptionKeysRequest{})
+			if err != nil {
+				return err
+			}
+
+			ch.PrintfSuccess("Rotated encryption keys of %d projects\n", res.RotatedProjects)
+			return nil
+		},
+	}
+
+	return rotateCmd
+}
@@ -25,6 +25,7 @@ func SudoCmd(ch *cmdutil.Helper) *cobra.Command {
 	sudoCmd.AddCommand(lookupCmd(ch))
 	sudoCmd.AddCommand(project.ProjectCmd(ch))
 	sudoCmd.AddCommand(annotations.AnnotationsCmd(ch))
+	sudoCmd.AddCommand(rotateEncryptionKeysCmd(ch))
 
 	return sudoCmd
 }
@@ -0,0 +1,124 @@
+package usergroup
+
+import (
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func ListMembersCmd(ch *cmdutil.Helper) *cobra.Command {
+	var pageSize uint32
+	var pageToken string
+
+	listMembersCmd := &cobra.Command{
+		Use:   "list-members <group-name>",
+		Args:  cobra.ExactArgs(1),
+		Short: "List members of a user group",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := ch.Client()
+			if err != nil {
+				return err
+			}
+
+			res, err := client.ListUsergroupMembers(cmd.Context(), &adminv1.ListUsergroupMembersRequest{
+				Organization: ch.Org,
+				Usergroup:    args[0],
+				PageSize:     pageSize,
+				PageToken:    pageToken,
+			})
+			if err != nil {
+				return err
+			}
+
+			ch.PrintUsers(res.Members)
+
+			if res.NextPageToken != "" {
+				cmd.Println()
+				cmd.Printf("Next page token: %s\n", res.NextPageToken)
+			}
+
+			return nil
+		},
+	}
+
+	listMembersCmd.Flags().Uint32Var(&pageSize, "page-size", 50, "Number of users to return per page")
+	listMembersCmd.Flags().StringVar(&pageToken, "page-token", "", "Pagination token")
+
+	return listMembersCmd
+}
+
+func AddMemberCmd(ch *cmdutil.Helper) *cobra.Command {
+	var email string
+
+	addMemberCmd := &cobra.Command{
+		Use:   "add-member <group-name>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Add an org member to a user group",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			err := cmdutil.StringPromptIfEmpty(&email, "Enter email")
+			if err != nil {
+				return err
+			}
+
+			client, err := ch.Client()
+			if err != nil {
+				return err
+			}
+
+			_, err = client.AddUsergroupMember(cmd.Context(), &adminv1.AddUsergroupMemberRequest{
+				Organization: ch.Org,
+				Usergroup:    args[0],
+				Email:        email,
+			})
+			if err != nil {
+				return err
+			}
+
+			ch.PrintfSuccess("User %q added to the user group %q\n", email, args[0])
+
+			return nil
+		},
+	}
+
+	addMemberCmd.Flags().StringVar(&email, "email", "", "Email of the user")
+
+	return addMemberCmd
+}
+
+func RemoveMemberCmd(ch *cmdutil.Helper) *cobra.Command {
+	var email string
+
+	removeMemberCmd := &cobra.Command{
+		Use:   "remove-member <group-name>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Remove a member from a user group",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			err := cmdutil.StringPromptIfEmpty(&email, "Enter email")
+			if err != nil {
+				return err
+			}
+
+			client, err := ch.Client()
+			if err != nil {
+				return err
+			}
+
+			_, err = client.RemoveUsergroupMember(cmd.Context(), &adminv1.RemoveUsergroupMemberRequest{
+				Organization: ch.Org,
+				Usergroup:    args[0],
+				Email:        email,
+			})
+			if err != nil {
+				return err
+			}
+
+			ch.PrintfSuccess("Removed user %q from the user group %q\n", email, args[0])
+
+			return nil
+		},
+	}
+
+	removeMemberCmd.Flags().StringVar(&email, "email", "", "Email of the user")
+
+	return removeMemberCmd
+}
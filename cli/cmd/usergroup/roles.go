@@ -0,0 +1,140 @@
+package usergroup
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rilldata/rill/cli/pkg/cmdutil"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func GrantCmd(ch *cmdutil.Helper) *cobra.Command {
+	var projectName string
+	var role string
+
+	grantCmd := &cobra.Command{
+		Use:   "grant <group-name>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Grant a user group a role on a project",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			err := cmdutil.StringPromptIfEmpty(&projectName, "Enter project")
+			if err != nil {
+				return err
+			}
+
+			err = cmdutil.SelectPromptIfEmpty(&role, "Select role", projectRoles, "")
+			if err != nil {
+				return err
+			}
+
+			client, err := ch.Client()
+			if err != nil {
+				return err
+			}
+
+			_, err = client.AddProjectMemberUsergroup(cmd.Context(), &adminv1.AddProjectMemberUsergroupRequest{
+				Organization: ch.Org,
+				Project:      projectName,
+				Usergroup:    args[0],
+				Role:         role,
+			})
+			if err != nil {
+				return err
+			}
+
+			ch.PrintfSuccess("User group %q added to the project \"%s/%s\" as %q\n", args[0], ch.Org, projectName, role)
+
+			return nil
+		},
+	}
+
+	grantCmd.Flags().StringVar(&projectName, "project", "", "Project")
+	grantCmd.Flags().StringVar(&role, "role", "", fmt.Sprintf("Role of the user group (options: %s)", strings.Join(projectRoles, ", ")))
+
+	return grantCmd
+}
+
+func SetRoleCmd(ch *cmdutil.Helper) *cobra.Command {
+	var projectName string
+	var role string
+
+	setRoleCmd := &cobra.Command{
+		Use:   "set-role <group-name>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Set the role of a user group on a project",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			err := cmdutil.StringPromptIfEmpty(&projectName, "Enter project")
+			if err != nil {
+				return err
+			}
+
+			err = cmdutil.SelectPromptIfEmpty(&role, "Select role", projectRoles, "")
+			if err != nil {
+				return err
+			}
+
+			client, err := ch.Client()
+			if err != nil {
+				return err
+			}
+
+			_, err = client.SetProjectMemberUsergroupRole(cmd.Context(), &adminv1.SetProjectMemberUsergroupRoleRequest{
+				Organization: ch.Org,
+				Project:      projectName,
+				Usergroup:    args[0],
+				Role:         role,
+			})
+			if err != nil {
+				return err
+			}
+
+			ch.PrintfSuccess("Updated role of user group %q to %q in the project \"%s/%s\"\n", args[0], role, ch.Org, projectName)
+
+			return nil
+		},
+	}
+
+	setRoleCmd.Flags().StringVar(&projectName, "project", "", "Project")
+	setRoleCmd.Flags().StringVar(&role, "role", "", fmt.Sprintf("Role of the user group (options: %s)", strings.Join(projectRoles, ", ")))
+
+	return setRoleCmd
+}
+
+func RevokeCmd(ch *cmdutil.Helper) *cobra.Command {
+	var projectName string
+
+	revokeCmd := &cobra.Command{
+		Use:   "revoke <group-name>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Revoke a user group's role on a project",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			err := cmdutil.StringPromptIfEmpty(&projectName, "Enter project")
+			if err != nil {
+				return err
+			}
+
+			client, err := ch.Client()
+			if err != nil {
+				return err
+			}
+
+			_, err = client.RemoveProjectMemberUsergroup(cmd.Context(), &adminv1.RemoveProjectMemberUsergroupRequest{
+				Organization: ch.Org,
+				Project:      projectName,
+				Usergroup:    args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			ch.PrintfSuccess("Removed user group %q from the project \"%s/%s\"\n", args[0], ch.Org, projectName)
+
+			return nil
+		},
+	}
+
+	revokeCmd.Flags().StringVar(&projectName, "project", "", "Project")
+
+	return revokeCmd
+}
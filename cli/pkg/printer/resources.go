@@ -150,6 +150,109 @@ type member struct {
 	UpdatedOn string `header:"updated_on,timestamp(ms|utc|human)" json:"updated_on"`
 }
 
+func (p *Printer) PrintUsergroups(groups []*adminv1.Usergroup) {
+	if len(groups) == 0 {
+		p.PrintfWarn("No user groups found\n")
+		return
+	}
+
+	p.PrintData(toUsergroupsTable(groups))
+}
+
+func toUsergroupsTable(groups []*adminv1.Usergroup) []*usergroup {
+	allGroups := make([]*usergroup, 0, len(groups))
+
+	for _, g := range groups {
+		allGroups = append(allGroups, toUsergroupRow(g))
+	}
+
+	return allGroups
+}
+
+func toUsergroupRow(g *adminv1.Usergroup) *usergroup {
+	return &usergroup{
+		Name:      g.GroupName,
+		CreatedOn: g.CreatedOn.AsTime().Format(time.DateTime),
+		UpdatedOn: g.UpdatedOn.AsTime().Format(time.DateTime),
+	}
+}
+
+type usergroup struct {
+	Name      string `header:"name" json:"name"`
+	CreatedOn string `header:"created_on,timestamp(ms|utc|human)" json:"created_on"`
+	UpdatedOn string `header:"updated_on,timestamp(ms|utc|human)" json:"updated_on"`
+}
+
+func (p *Printer) PrintMemberUsergroups(groups []*adminv1.MemberUsergroup) {
+	if len(groups) == 0 {
+		p.PrintfWarn("No user groups found\n")
+		return
+	}
+
+	p.PrintData(toMemberUsergroupsTable(groups))
+}
+
+func toMemberUsergroupsTable(groups []*adminv1.MemberUsergroup) []*memberUsergroup {
+	allGroups := make([]*memberUsergroup, 0, len(groups))
+
+	for _, g := range groups {
+		allGroups = append(allGroups, toMemberUsergroupRow(g))
+	}
+
+	return allGroups
+}
+
+func toMemberUsergroupRow(g *adminv1.MemberUsergroup) *memberUsergroup {
+	return &memberUsergroup{
+		Name:     g.GroupName,
+		RoleName: g.RoleName,
+	}
+}
+
+type memberUsergroup struct {
+	Name     string `header:"name" json:"name"`
+	RoleName string `header:"role" json:"role_name"`
+}
+
+func (p *Printer) PrintAuditEvents(events []*adminv1.AuditEvent) {
+	if len(events) == 0 {
+		return
+	}
+
+	p.PrintData(toAuditEventsTable(events))
+}
+
+func toAuditEventsTable(events []*adminv1.AuditEvent) []*auditEvent {
+	allEvents := make([]*auditEvent, 0, len(events))
+
+	for _, e := range events {
+		allEvents = append(allEvents, toAuditEventRow(e))
+	}
+
+	return allEvents
+}
+
+func toAuditEventRow(e *adminv1.AuditEvent) *auditEvent {
+	actor := e.ActorName
+	if actor == "" {
+		actor = e.ActorType
+	}
+
+	return &auditEvent{
+		CreatedOn: e.CreatedOn.AsTime().Format(time.DateTime),
+		Actor:     actor,
+		Action:    e.Action,
+		Project:   e.ProjectName,
+	}
+}
+
+type auditEvent struct {
+	CreatedOn string `header:"time,timestamp(ms|utc|human)" json:"created_on"`
+	Actor     string `header:"actor" json:"actor"`
+	Action    string `header:"action" json:"action"`
+	Project   string `header:"project" json:"project"`
+}
+
 func (p *Printer) PrintInvites(invites []*adminv1.UserInvite) {
 	if len(invites) == 0 {
 		return
@@ -1493,6 +1493,338 @@ var _ interface {
 	ErrorName() string
 } = UpdateOrganizationResponseValidationError{}
 
+// Validate checks the field values on ListAuditEventsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAuditEventsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAuditEventsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAuditEventsRequestMultiError, or nil if none found.
+func (m *ListAuditEventsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAuditEventsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := ListAuditEventsRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Project
+
+	// no validation rules for ActorEmail
+
+	// no validation rules for Action
+
+	if all {
+		switch v := interface{}(m.GetStartTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListAuditEventsRequestValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListAuditEventsRequestValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListAuditEventsRequestValidationError{
+				field:  "StartTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListAuditEventsRequestValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListAuditEventsRequestValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListAuditEventsRequestValidationError{
+				field:  "EndTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetPageSize() != 0 {
+
+		if m.GetPageSize() > 1000 {
+			err := ListAuditEventsRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be less than or equal to 1000",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListAuditEventsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAuditEventsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListAuditEventsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListAuditEventsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAuditEventsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAuditEventsRequestMultiError) AllErrors() []error { return m }
+
+// ListAuditEventsRequestValidationError is the validation error returned by
+// ListAuditEventsRequest.Validate if the designated constraints aren't met.
+type ListAuditEventsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAuditEventsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAuditEventsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAuditEventsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAuditEventsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAuditEventsRequestValidationError) ErrorName() string {
+	return "ListAuditEventsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAuditEventsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAuditEventsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAuditEventsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAuditEventsRequestValidationError{}
+
+// Validate checks the field values on ListAuditEventsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAuditEventsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAuditEventsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAuditEventsResponseMultiError, or nil if none found.
+func (m *ListAuditEventsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAuditEventsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetEvents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListAuditEventsResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListAuditEventsResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListAuditEventsResponseValidationError{
+					field:  fmt.Sprintf("Events[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListAuditEventsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAuditEventsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListAuditEventsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListAuditEventsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAuditEventsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAuditEventsResponseMultiError) AllErrors() []error { return m }
+
+// ListAuditEventsResponseValidationError is the validation error returned by
+// ListAuditEventsResponse.Validate if the designated constraints aren't met.
+type ListAuditEventsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAuditEventsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAuditEventsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAuditEventsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAuditEventsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAuditEventsResponseValidationError) ErrorName() string {
+	return "ListAuditEventsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAuditEventsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAuditEventsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAuditEventsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAuditEventsResponseValidationError{}
+
 // Validate checks the field values on ListProjectsForOrganizationRequest with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the first error encountered is returned, or nil if there are
@@ -1986,7 +2318,41 @@ func (m *GetProjectResponse) validate(all bool) error {
 		}
 	}
 
-	if len(errors) > 0 {
+	for idx, item := range m.GetPreviewDeployments() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetProjectResponseValidationError{
+						field:  fmt.Sprintf("PreviewDeployments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetProjectResponseValidationError{
+						field:  fmt.Sprintf("PreviewDeployments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetProjectResponseValidationError{
+					field:  fmt.Sprintf("PreviewDeployments[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
 		return GetProjectResponseMultiError(errors)
 	}
 
@@ -2319,6 +2685,8 @@ func (m *GetProjectVariablesRequest) validate(all bool) error {
 
 	// no validation rules for Name
 
+	// no validation rules for Environment
+
 	if len(errors) > 0 {
 		return GetProjectVariablesRequestMultiError(errors)
 	}
@@ -5070,6 +5438,10 @@ func (m *UpdateProjectRequest) validate(all bool) error {
 		// no validation rules for ProdVersion
 	}
 
+	if m.PreviewDeployments != nil {
+		// no validation rules for PreviewDeployments
+	}
+
 	if len(errors) > 0 {
 		return UpdateProjectRequestMultiError(errors)
 	}
@@ -5327,6 +5699,8 @@ func (m *UpdateProjectVariablesRequest) validate(all bool) error {
 
 	// no validation rules for Variables
 
+	// no validation rules for Environment
+
 	if len(errors) > 0 {
 		return UpdateProjectVariablesRequestMultiError(errors)
 	}
@@ -5743,6 +6117,64 @@ func (m *TriggerRefreshSourcesRequest) validate(all bool) error {
 
 	// no validation rules for DeploymentId
 
+	if all {
+		switch v := interface{}(m.GetBackfillFrom()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TriggerRefreshSourcesRequestValidationError{
+					field:  "BackfillFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TriggerRefreshSourcesRequestValidationError{
+					field:  "BackfillFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBackfillFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TriggerRefreshSourcesRequestValidationError{
+				field:  "BackfillFrom",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetBackfillTo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TriggerRefreshSourcesRequestValidationError{
+					field:  "BackfillTo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TriggerRefreshSourcesRequestValidationError{
+					field:  "BackfillTo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBackfillTo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TriggerRefreshSourcesRequestValidationError{
+				field:  "BackfillTo",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return TriggerRefreshSourcesRequestMultiError(errors)
 	}
@@ -9480,6 +9912,216 @@ var _ interface {
 	ErrorName() string
 } = SudoUpdateAnnotationsResponseValidationError{}
 
+// Validate checks the field values on SudoRotateEncryptionKeysRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SudoRotateEncryptionKeysRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SudoRotateEncryptionKeysRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SudoRotateEncryptionKeysRequestMultiError, or nil if none found.
+func (m *SudoRotateEncryptionKeysRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SudoRotateEncryptionKeysRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return SudoRotateEncryptionKeysRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SudoRotateEncryptionKeysRequestMultiError is an error wrapping multiple
+// validation errors returned by SudoRotateEncryptionKeysRequest.ValidateAll()
+// if the designated constraints aren't met.
+type SudoRotateEncryptionKeysRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SudoRotateEncryptionKeysRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SudoRotateEncryptionKeysRequestMultiError) AllErrors() []error { return m }
+
+// SudoRotateEncryptionKeysRequestValidationError is the validation error
+// returned by SudoRotateEncryptionKeysRequest.Validate if the designated
+// constraints aren't met.
+type SudoRotateEncryptionKeysRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SudoRotateEncryptionKeysRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SudoRotateEncryptionKeysRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SudoRotateEncryptionKeysRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SudoRotateEncryptionKeysRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SudoRotateEncryptionKeysRequestValidationError) ErrorName() string {
+	return "SudoRotateEncryptionKeysRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SudoRotateEncryptionKeysRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSudoRotateEncryptionKeysRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SudoRotateEncryptionKeysRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SudoRotateEncryptionKeysRequestValidationError{}
+
+// Validate checks the field values on SudoRotateEncryptionKeysResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *SudoRotateEncryptionKeysResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SudoRotateEncryptionKeysResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SudoRotateEncryptionKeysResponseMultiError, or nil if none found.
+func (m *SudoRotateEncryptionKeysResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SudoRotateEncryptionKeysResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RotatedProjects
+
+	if len(errors) > 0 {
+		return SudoRotateEncryptionKeysResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SudoRotateEncryptionKeysResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// SudoRotateEncryptionKeysResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SudoRotateEncryptionKeysResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SudoRotateEncryptionKeysResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SudoRotateEncryptionKeysResponseMultiError) AllErrors() []error { return m }
+
+// SudoRotateEncryptionKeysResponseValidationError is the validation error
+// returned by SudoRotateEncryptionKeysResponse.Validate if the designated
+// constraints aren't met.
+type SudoRotateEncryptionKeysResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SudoRotateEncryptionKeysResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SudoRotateEncryptionKeysResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SudoRotateEncryptionKeysResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SudoRotateEncryptionKeysResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SudoRotateEncryptionKeysResponseValidationError) ErrorName() string {
+	return "SudoRotateEncryptionKeysResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SudoRotateEncryptionKeysResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSudoRotateEncryptionKeysResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SudoRotateEncryptionKeysResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SudoRotateEncryptionKeysResponseValidationError{}
+
 // Validate checks the field values on ListProjectMembersRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -10929,42 +11571,70 @@ var _ interface {
 	ErrorName() string
 } = SetProjectMemberRoleResponseValidationError{}
 
-// Validate checks the field values on GetCurrentUserRequest with the rules
+// Validate checks the field values on ListUsergroupsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetCurrentUserRequest) Validate() error {
+func (m *ListUsergroupsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetCurrentUserRequest with the rules
+// ValidateAll checks the field values on ListUsergroupsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetCurrentUserRequestMultiError, or nil if none found.
-func (m *GetCurrentUserRequest) ValidateAll() error {
+// ListUsergroupsRequestMultiError, or nil if none found.
+func (m *ListUsergroupsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetCurrentUserRequest) validate(all bool) error {
+func (m *ListUsergroupsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(errors) > 0 {
-		return GetCurrentUserRequestMultiError(errors)
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := ListUsergroupsRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
-	return nil
-}
+	if m.GetPageSize() != 0 {
 
-// GetCurrentUserRequestMultiError is an error wrapping multiple validation
-// errors returned by GetCurrentUserRequest.ValidateAll() if the designated
-// constraints aren't met.
-type GetCurrentUserRequestMultiError []error
+		if m.GetPageSize() > 1000 {
+			err := ListUsergroupsRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be less than or equal to 1000",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListUsergroupsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListUsergroupsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListUsergroupsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListUsergroupsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetCurrentUserRequestMultiError) Error() string {
+func (m ListUsergroupsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10973,11 +11643,11 @@ func (m GetCurrentUserRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetCurrentUserRequestMultiError) AllErrors() []error { return m }
+func (m ListUsergroupsRequestMultiError) AllErrors() []error { return m }
 
-// GetCurrentUserRequestValidationError is the validation error returned by
-// GetCurrentUserRequest.Validate if the designated constraints aren't met.
-type GetCurrentUserRequestValidationError struct {
+// ListUsergroupsRequestValidationError is the validation error returned by
+// ListUsergroupsRequest.Validate if the designated constraints aren't met.
+type ListUsergroupsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10985,24 +11655,24 @@ type GetCurrentUserRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetCurrentUserRequestValidationError) Field() string { return e.field }
+func (e ListUsergroupsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetCurrentUserRequestValidationError) Reason() string { return e.reason }
+func (e ListUsergroupsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetCurrentUserRequestValidationError) Cause() error { return e.cause }
+func (e ListUsergroupsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetCurrentUserRequestValidationError) Key() bool { return e.key }
+func (e ListUsergroupsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetCurrentUserRequestValidationError) ErrorName() string {
-	return "GetCurrentUserRequestValidationError"
+func (e ListUsergroupsRequestValidationError) ErrorName() string {
+	return "ListUsergroupsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetCurrentUserRequestValidationError) Error() string {
+func (e ListUsergroupsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11014,14 +11684,14 @@ func (e GetCurrentUserRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetCurrentUserRequest.%s: %s%s",
+		"invalid %sListUsergroupsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetCurrentUserRequestValidationError{}
+var _ error = ListUsergroupsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -11029,102 +11699,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetCurrentUserRequestValidationError{}
+} = ListUsergroupsRequestValidationError{}
 
-// Validate checks the field values on GetCurrentUserResponse with the rules
+// Validate checks the field values on ListUsergroupsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetCurrentUserResponse) Validate() error {
+func (m *ListUsergroupsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetCurrentUserResponse with the rules
+// ValidateAll checks the field values on ListUsergroupsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetCurrentUserResponseMultiError, or nil if none found.
-func (m *GetCurrentUserResponse) ValidateAll() error {
+// ListUsergroupsResponseMultiError, or nil if none found.
+func (m *ListUsergroupsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetCurrentUserResponse) validate(all bool) error {
+func (m *ListUsergroupsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetUser()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetCurrentUserResponseValidationError{
-					field:  "User",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GetCurrentUserResponseValidationError{
-					field:  "User",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetCurrentUserResponseValidationError{
-				field:  "User",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	for idx, item := range m.GetUsergroups() {
+		_, _ = idx, item
 
-	if all {
-		switch v := interface{}(m.GetPreferences()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetCurrentUserResponseValidationError{
-					field:  "Preferences",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListUsergroupsResponseValidationError{
+						field:  fmt.Sprintf("Usergroups[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListUsergroupsResponseValidationError{
+						field:  fmt.Sprintf("Usergroups[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetCurrentUserResponseValidationError{
-					field:  "Preferences",
+				return ListUsergroupsResponseValidationError{
+					field:  fmt.Sprintf("Usergroups[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetPreferences()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetCurrentUserResponseValidationError{
-				field:  "Preferences",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
+	// no validation rules for NextPageToken
+
 	if len(errors) > 0 {
-		return GetCurrentUserResponseMultiError(errors)
+		return ListUsergroupsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetCurrentUserResponseMultiError is an error wrapping multiple validation
-// errors returned by GetCurrentUserResponse.ValidateAll() if the designated
+// ListUsergroupsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListUsergroupsResponse.ValidateAll() if the designated
 // constraints aren't met.
-type GetCurrentUserResponseMultiError []error
+type ListUsergroupsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetCurrentUserResponseMultiError) Error() string {
+func (m ListUsergroupsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11133,11 +11781,11 @@ func (m GetCurrentUserResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetCurrentUserResponseMultiError) AllErrors() []error { return m }
+func (m ListUsergroupsResponseMultiError) AllErrors() []error { return m }
 
-// GetCurrentUserResponseValidationError is the validation error returned by
-// GetCurrentUserResponse.Validate if the designated constraints aren't met.
-type GetCurrentUserResponseValidationError struct {
+// ListUsergroupsResponseValidationError is the validation error returned by
+// ListUsergroupsResponse.Validate if the designated constraints aren't met.
+type ListUsergroupsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11145,24 +11793,24 @@ type GetCurrentUserResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetCurrentUserResponseValidationError) Field() string { return e.field }
+func (e ListUsergroupsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetCurrentUserResponseValidationError) Reason() string { return e.reason }
+func (e ListUsergroupsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetCurrentUserResponseValidationError) Cause() error { return e.cause }
+func (e ListUsergroupsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetCurrentUserResponseValidationError) Key() bool { return e.key }
+func (e ListUsergroupsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetCurrentUserResponseValidationError) ErrorName() string {
-	return "GetCurrentUserResponseValidationError"
+func (e ListUsergroupsResponseValidationError) ErrorName() string {
+	return "ListUsergroupsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetCurrentUserResponseValidationError) Error() string {
+func (e ListUsergroupsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11174,14 +11822,14 @@ func (e GetCurrentUserResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetCurrentUserResponse.%s: %s%s",
+		"invalid %sListUsergroupsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetCurrentUserResponseValidationError{}
+var _ error = ListUsergroupsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -11189,35 +11837,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetCurrentUserResponseValidationError{}
+} = ListUsergroupsResponseValidationError{}
 
-// Validate checks the field values on GetUserRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *GetUserRequest) Validate() error {
+// Validate checks the field values on CreateUsergroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateUsergroupRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetUserRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in GetUserRequestMultiError,
-// or nil if none found.
-func (m *GetUserRequest) ValidateAll() error {
+// ValidateAll checks the field values on CreateUsergroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateUsergroupRequestMultiError, or nil if none found.
+func (m *CreateUsergroupRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetUserRequest) validate(all bool) error {
+func (m *CreateUsergroupRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if err := m._validateEmail(m.GetEmail()); err != nil {
-		err = GetUserRequestValidationError{
-			field:  "Email",
-			reason: "value must be a valid email address",
-			cause:  err,
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := CreateUsergroupRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
 		}
 		if !all {
 			return err
@@ -11225,70 +11872,31 @@ func (m *GetUserRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if len(errors) > 0 {
-		return GetUserRequestMultiError(errors)
-	}
-
-	return nil
-}
-
-func (m *GetUserRequest) _validateHostname(host string) error {
-	s := strings.ToLower(strings.TrimSuffix(host, "."))
-
-	if len(host) > 253 {
-		return errors.New("hostname cannot exceed 253 characters")
-	}
-
-	for _, part := range strings.Split(s, ".") {
-		if l := len(part); l == 0 || l > 63 {
-			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
-		}
-
-		if part[0] == '-' {
-			return errors.New("hostname parts cannot begin with hyphens")
-		}
-
-		if part[len(part)-1] == '-' {
-			return errors.New("hostname parts cannot end with hyphens")
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := CreateUsergroupRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
 		}
-
-		for _, r := range part {
-			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
-				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
-			}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
-	return nil
-}
-
-func (m *GetUserRequest) _validateEmail(addr string) error {
-	a, err := mail.ParseAddress(addr)
-	if err != nil {
-		return err
-	}
-	addr = a.Address
-
-	if len(addr) > 254 {
-		return errors.New("email addresses cannot exceed 254 characters")
-	}
-
-	parts := strings.SplitN(addr, "@", 2)
-
-	if len(parts[0]) > 64 {
-		return errors.New("email address local phrase cannot exceed 64 characters")
+	if len(errors) > 0 {
+		return CreateUsergroupRequestMultiError(errors)
 	}
 
-	return m._validateHostname(parts[1])
+	return nil
 }
 
-// GetUserRequestMultiError is an error wrapping multiple validation errors
-// returned by GetUserRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetUserRequestMultiError []error
+// CreateUsergroupRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateUsergroupRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateUsergroupRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetUserRequestMultiError) Error() string {
+func (m CreateUsergroupRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11297,11 +11905,11 @@ func (m GetUserRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetUserRequestMultiError) AllErrors() []error { return m }
+func (m CreateUsergroupRequestMultiError) AllErrors() []error { return m }
 
-// GetUserRequestValidationError is the validation error returned by
-// GetUserRequest.Validate if the designated constraints aren't met.
-type GetUserRequestValidationError struct {
+// CreateUsergroupRequestValidationError is the validation error returned by
+// CreateUsergroupRequest.Validate if the designated constraints aren't met.
+type CreateUsergroupRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11309,22 +11917,24 @@ type GetUserRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetUserRequestValidationError) Field() string { return e.field }
+func (e CreateUsergroupRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetUserRequestValidationError) Reason() string { return e.reason }
+func (e CreateUsergroupRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetUserRequestValidationError) Cause() error { return e.cause }
+func (e CreateUsergroupRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetUserRequestValidationError) Key() bool { return e.key }
+func (e CreateUsergroupRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetUserRequestValidationError) ErrorName() string { return "GetUserRequestValidationError" }
+func (e CreateUsergroupRequestValidationError) ErrorName() string {
+	return "CreateUsergroupRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetUserRequestValidationError) Error() string {
+func (e CreateUsergroupRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11336,14 +11946,14 @@ func (e GetUserRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetUserRequest.%s: %s%s",
+		"invalid %sCreateUsergroupRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetUserRequestValidationError{}
+var _ error = CreateUsergroupRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -11351,24 +11961,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetUserRequestValidationError{}
+} = CreateUsergroupRequestValidationError{}
 
-// Validate checks the field values on GetUserResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetUserResponse) Validate() error {
+// Validate checks the field values on CreateUsergroupResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateUsergroupResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetUserResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on CreateUsergroupResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetUserResponseMultiError, or nil if none found.
-func (m *GetUserResponse) ValidateAll() error {
+// CreateUsergroupResponseMultiError, or nil if none found.
+func (m *CreateUsergroupResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetUserResponse) validate(all bool) error {
+func (m *CreateUsergroupResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -11376,28 +11986,28 @@ func (m *GetUserResponse) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetUser()).(type) {
+		switch v := interface{}(m.GetUsergroup()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetUserResponseValidationError{
-					field:  "User",
+				errors = append(errors, CreateUsergroupResponseValidationError{
+					field:  "Usergroup",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetUserResponseValidationError{
-					field:  "User",
+				errors = append(errors, CreateUsergroupResponseValidationError{
+					field:  "Usergroup",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetUsergroup()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return GetUserResponseValidationError{
-				field:  "User",
+			return CreateUsergroupResponseValidationError{
+				field:  "Usergroup",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -11405,19 +12015,19 @@ func (m *GetUserResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetUserResponseMultiError(errors)
+		return CreateUsergroupResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetUserResponseMultiError is an error wrapping multiple validation errors
-// returned by GetUserResponse.ValidateAll() if the designated constraints
-// aren't met.
-type GetUserResponseMultiError []error
+// CreateUsergroupResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateUsergroupResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateUsergroupResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetUserResponseMultiError) Error() string {
+func (m CreateUsergroupResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11426,11 +12036,11 @@ func (m GetUserResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetUserResponseMultiError) AllErrors() []error { return m }
+func (m CreateUsergroupResponseMultiError) AllErrors() []error { return m }
 
-// GetUserResponseValidationError is the validation error returned by
-// GetUserResponse.Validate if the designated constraints aren't met.
-type GetUserResponseValidationError struct {
+// CreateUsergroupResponseValidationError is the validation error returned by
+// CreateUsergroupResponse.Validate if the designated constraints aren't met.
+type CreateUsergroupResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11438,22 +12048,24 @@ type GetUserResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetUserResponseValidationError) Field() string { return e.field }
+func (e CreateUsergroupResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetUserResponseValidationError) Reason() string { return e.reason }
+func (e CreateUsergroupResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetUserResponseValidationError) Cause() error { return e.cause }
+func (e CreateUsergroupResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetUserResponseValidationError) Key() bool { return e.key }
+func (e CreateUsergroupResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetUserResponseValidationError) ErrorName() string { return "GetUserResponseValidationError" }
+func (e CreateUsergroupResponseValidationError) ErrorName() string {
+	return "CreateUsergroupResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetUserResponseValidationError) Error() string {
+func (e CreateUsergroupResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11465,14 +12077,14 @@ func (e GetUserResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetUserResponse.%s: %s%s",
+		"invalid %sCreateUsergroupResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetUserResponseValidationError{}
+var _ error = CreateUsergroupResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -11480,48 +12092,77 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetUserResponseValidationError{}
+} = CreateUsergroupResponseValidationError{}
 
-// Validate checks the field values on UserPreferences with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *UserPreferences) Validate() error {
+// Validate checks the field values on RenameUsergroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RenameUsergroupRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UserPreferences with the rules
+// ValidateAll checks the field values on RenameUsergroupRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UserPreferencesMultiError, or nil if none found.
-func (m *UserPreferences) ValidateAll() error {
+// RenameUsergroupRequestMultiError, or nil if none found.
+func (m *RenameUsergroupRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UserPreferences) validate(all bool) error {
+func (m *RenameUsergroupRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.TimeZone != nil {
-		// no validation rules for TimeZone
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := RenameUsergroupRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetUsergroup()) < 1 {
+		err := RenameUsergroupRequestValidationError{
+			field:  "Usergroup",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := RenameUsergroupRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return UserPreferencesMultiError(errors)
+		return RenameUsergroupRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UserPreferencesMultiError is an error wrapping multiple validation errors
-// returned by UserPreferences.ValidateAll() if the designated constraints
-// aren't met.
-type UserPreferencesMultiError []error
+// RenameUsergroupRequestMultiError is an error wrapping multiple validation
+// errors returned by RenameUsergroupRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RenameUsergroupRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UserPreferencesMultiError) Error() string {
+func (m RenameUsergroupRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11530,11 +12171,11 @@ func (m UserPreferencesMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UserPreferencesMultiError) AllErrors() []error { return m }
+func (m RenameUsergroupRequestMultiError) AllErrors() []error { return m }
 
-// UserPreferencesValidationError is the validation error returned by
-// UserPreferences.Validate if the designated constraints aren't met.
-type UserPreferencesValidationError struct {
+// RenameUsergroupRequestValidationError is the validation error returned by
+// RenameUsergroupRequest.Validate if the designated constraints aren't met.
+type RenameUsergroupRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11542,22 +12183,24 @@ type UserPreferencesValidationError struct {
 }
 
 // Field function returns field value.
-func (e UserPreferencesValidationError) Field() string { return e.field }
+func (e RenameUsergroupRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UserPreferencesValidationError) Reason() string { return e.reason }
+func (e RenameUsergroupRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UserPreferencesValidationError) Cause() error { return e.cause }
+func (e RenameUsergroupRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UserPreferencesValidationError) Key() bool { return e.key }
+func (e RenameUsergroupRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UserPreferencesValidationError) ErrorName() string { return "UserPreferencesValidationError" }
+func (e RenameUsergroupRequestValidationError) ErrorName() string {
+	return "RenameUsergroupRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e UserPreferencesValidationError) Error() string {
+func (e RenameUsergroupRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11569,14 +12212,14 @@ func (e UserPreferencesValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUserPreferences.%s: %s%s",
+		"invalid %sRenameUsergroupRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UserPreferencesValidationError{}
+var _ error = RenameUsergroupRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -11584,24 +12227,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UserPreferencesValidationError{}
+} = RenameUsergroupRequestValidationError{}
 
-// Validate checks the field values on UpdateUserPreferencesRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on RenameUsergroupResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateUserPreferencesRequest) Validate() error {
+func (m *RenameUsergroupResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateUserPreferencesRequest with the
+// ValidateAll checks the field values on RenameUsergroupResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateUserPreferencesRequestMultiError, or nil if none found.
-func (m *UpdateUserPreferencesRequest) ValidateAll() error {
+// RenameUsergroupResponseMultiError, or nil if none found.
+func (m *RenameUsergroupResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateUserPreferencesRequest) validate(all bool) error {
+func (m *RenameUsergroupResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -11609,28 +12252,28 @@ func (m *UpdateUserPreferencesRequest) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetPreferences()).(type) {
+		switch v := interface{}(m.GetUsergroup()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateUserPreferencesRequestValidationError{
-					field:  "Preferences",
+				errors = append(errors, RenameUsergroupResponseValidationError{
+					field:  "Usergroup",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateUserPreferencesRequestValidationError{
-					field:  "Preferences",
+				errors = append(errors, RenameUsergroupResponseValidationError{
+					field:  "Usergroup",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetPreferences()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetUsergroup()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return UpdateUserPreferencesRequestValidationError{
-				field:  "Preferences",
+			return RenameUsergroupResponseValidationError{
+				field:  "Usergroup",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -11638,19 +12281,19 @@ func (m *UpdateUserPreferencesRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return UpdateUserPreferencesRequestMultiError(errors)
+		return RenameUsergroupResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateUserPreferencesRequestMultiError is an error wrapping multiple
-// validation errors returned by UpdateUserPreferencesRequest.ValidateAll() if
-// the designated constraints aren't met.
-type UpdateUserPreferencesRequestMultiError []error
+// RenameUsergroupResponseMultiError is an error wrapping multiple validation
+// errors returned by RenameUsergroupResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RenameUsergroupResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateUserPreferencesRequestMultiError) Error() string {
+func (m RenameUsergroupResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11659,12 +12302,11 @@ func (m UpdateUserPreferencesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateUserPreferencesRequestMultiError) AllErrors() []error { return m }
+func (m RenameUsergroupResponseMultiError) AllErrors() []error { return m }
 
-// UpdateUserPreferencesRequestValidationError is the validation error returned
-// by UpdateUserPreferencesRequest.Validate if the designated constraints
-// aren't met.
-type UpdateUserPreferencesRequestValidationError struct {
+// RenameUsergroupResponseValidationError is the validation error returned by
+// RenameUsergroupResponse.Validate if the designated constraints aren't met.
+type RenameUsergroupResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11672,24 +12314,24 @@ type UpdateUserPreferencesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateUserPreferencesRequestValidationError) Field() string { return e.field }
+func (e RenameUsergroupResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateUserPreferencesRequestValidationError) Reason() string { return e.reason }
+func (e RenameUsergroupResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateUserPreferencesRequestValidationError) Cause() error { return e.cause }
+func (e RenameUsergroupResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateUserPreferencesRequestValidationError) Key() bool { return e.key }
+func (e RenameUsergroupResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateUserPreferencesRequestValidationError) ErrorName() string {
-	return "UpdateUserPreferencesRequestValidationError"
+func (e RenameUsergroupResponseValidationError) ErrorName() string {
+	return "RenameUsergroupResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateUserPreferencesRequestValidationError) Error() string {
+func (e RenameUsergroupResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11701,14 +12343,14 @@ func (e UpdateUserPreferencesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateUserPreferencesRequest.%s: %s%s",
+		"invalid %sRenameUsergroupResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateUserPreferencesRequestValidationError{}
+var _ error = RenameUsergroupResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -11716,73 +12358,66 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateUserPreferencesRequestValidationError{}
+} = RenameUsergroupResponseValidationError{}
 
-// Validate checks the field values on UpdateUserPreferencesResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on DeleteUsergroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateUserPreferencesResponse) Validate() error {
+func (m *DeleteUsergroupRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateUserPreferencesResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// UpdateUserPreferencesResponseMultiError, or nil if none found.
-func (m *UpdateUserPreferencesResponse) ValidateAll() error {
+// ValidateAll checks the field values on DeleteUsergroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteUsergroupRequestMultiError, or nil if none found.
+func (m *DeleteUsergroupRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateUserPreferencesResponse) validate(all bool) error {
+func (m *DeleteUsergroupRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetPreferences()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateUserPreferencesResponseValidationError{
-					field:  "Preferences",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateUserPreferencesResponseValidationError{
-					field:  "Preferences",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := DeleteUsergroupRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
 		}
-	} else if v, ok := interface{}(m.GetPreferences()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpdateUserPreferencesResponseValidationError{
-				field:  "Preferences",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetUsergroup()) < 1 {
+		err := DeleteUsergroupRequestValidationError{
+			field:  "Usergroup",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return UpdateUserPreferencesResponseMultiError(errors)
+		return DeleteUsergroupRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateUserPreferencesResponseMultiError is an error wrapping multiple
-// validation errors returned by UpdateUserPreferencesResponse.ValidateAll()
-// if the designated constraints aren't met.
-type UpdateUserPreferencesResponseMultiError []error
+// DeleteUsergroupRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteUsergroupRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteUsergroupRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateUserPreferencesResponseMultiError) Error() string {
+func (m DeleteUsergroupRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11791,12 +12426,11 @@ func (m UpdateUserPreferencesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateUserPreferencesResponseMultiError) AllErrors() []error { return m }
+func (m DeleteUsergroupRequestMultiError) AllErrors() []error { return m }
 
-// UpdateUserPreferencesResponseValidationError is the validation error
-// returned by UpdateUserPreferencesResponse.Validate if the designated
-// constraints aren't met.
-type UpdateUserPreferencesResponseValidationError struct {
+// DeleteUsergroupRequestValidationError is the validation error returned by
+// DeleteUsergroupRequest.Validate if the designated constraints aren't met.
+type DeleteUsergroupRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11804,24 +12438,24 @@ type UpdateUserPreferencesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateUserPreferencesResponseValidationError) Field() string { return e.field }
+func (e DeleteUsergroupRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateUserPreferencesResponseValidationError) Reason() string { return e.reason }
+func (e DeleteUsergroupRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateUserPreferencesResponseValidationError) Cause() error { return e.cause }
+func (e DeleteUsergroupRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateUserPreferencesResponseValidationError) Key() bool { return e.key }
+func (e DeleteUsergroupRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateUserPreferencesResponseValidationError) ErrorName() string {
-	return "UpdateUserPreferencesResponseValidationError"
+func (e DeleteUsergroupRequestValidationError) ErrorName() string {
+	return "DeleteUsergroupRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateUserPreferencesResponseValidationError) Error() string {
+func (e DeleteUsergroupRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11833,14 +12467,14 @@ func (e UpdateUserPreferencesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateUserPreferencesResponse.%s: %s%s",
+		"invalid %sDeleteUsergroupRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateUserPreferencesResponseValidationError{}
+var _ error = DeleteUsergroupRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -11848,50 +12482,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateUserPreferencesResponseValidationError{}
+} = DeleteUsergroupRequestValidationError{}
 
-// Validate checks the field values on ListBookmarksRequest with the rules
+// Validate checks the field values on DeleteUsergroupResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListBookmarksRequest) Validate() error {
+func (m *DeleteUsergroupResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListBookmarksRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on DeleteUsergroupResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListBookmarksRequestMultiError, or nil if none found.
-func (m *ListBookmarksRequest) ValidateAll() error {
+// DeleteUsergroupResponseMultiError, or nil if none found.
+func (m *DeleteUsergroupResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListBookmarksRequest) validate(all bool) error {
+func (m *DeleteUsergroupResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ProjectId
-
-	// no validation rules for ResourceKind
-
-	// no validation rules for ResourceName
-
 	if len(errors) > 0 {
-		return ListBookmarksRequestMultiError(errors)
+		return DeleteUsergroupResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListBookmarksRequestMultiError is an error wrapping multiple validation
-// errors returned by ListBookmarksRequest.ValidateAll() if the designated
+// DeleteUsergroupResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteUsergroupResponse.ValidateAll() if the designated
 // constraints aren't met.
-type ListBookmarksRequestMultiError []error
+type DeleteUsergroupResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListBookmarksRequestMultiError) Error() string {
+func (m DeleteUsergroupResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11900,11 +12528,11 @@ func (m ListBookmarksRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListBookmarksRequestMultiError) AllErrors() []error { return m }
+func (m DeleteUsergroupResponseMultiError) AllErrors() []error { return m }
 
-// ListBookmarksRequestValidationError is the validation error returned by
-// ListBookmarksRequest.Validate if the designated constraints aren't met.
-type ListBookmarksRequestValidationError struct {
+// DeleteUsergroupResponseValidationError is the validation error returned by
+// DeleteUsergroupResponse.Validate if the designated constraints aren't met.
+type DeleteUsergroupResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11912,24 +12540,24 @@ type ListBookmarksRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListBookmarksRequestValidationError) Field() string { return e.field }
+func (e DeleteUsergroupResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListBookmarksRequestValidationError) Reason() string { return e.reason }
+func (e DeleteUsergroupResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListBookmarksRequestValidationError) Cause() error { return e.cause }
+func (e DeleteUsergroupResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListBookmarksRequestValidationError) Key() bool { return e.key }
+func (e DeleteUsergroupResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListBookmarksRequestValidationError) ErrorName() string {
-	return "ListBookmarksRequestValidationError"
+func (e DeleteUsergroupResponseValidationError) ErrorName() string {
+	return "DeleteUsergroupResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListBookmarksRequestValidationError) Error() string {
+func (e DeleteUsergroupResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11941,14 +12569,14 @@ func (e ListBookmarksRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListBookmarksRequest.%s: %s%s",
+		"invalid %sDeleteUsergroupResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListBookmarksRequestValidationError{}
+var _ error = DeleteUsergroupResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -11956,78 +12584,83 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListBookmarksRequestValidationError{}
+} = DeleteUsergroupResponseValidationError{}
 
-// Validate checks the field values on ListBookmarksResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListUsergroupMembersRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListBookmarksResponse) Validate() error {
+func (m *ListUsergroupMembersRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListBookmarksResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListUsergroupMembersRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListBookmarksResponseMultiError, or nil if none found.
-func (m *ListBookmarksResponse) ValidateAll() error {
+// ListUsergroupMembersRequestMultiError, or nil if none found.
+func (m *ListUsergroupMembersRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListBookmarksResponse) validate(all bool) error {
+func (m *ListUsergroupMembersRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetBookmarks() {
-		_, _ = idx, item
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := ListUsergroupMembersRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListBookmarksResponseValidationError{
-						field:  fmt.Sprintf("Bookmarks[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListBookmarksResponseValidationError{
-						field:  fmt.Sprintf("Bookmarks[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if utf8.RuneCountInString(m.GetUsergroup()) < 1 {
+		err := ListUsergroupMembersRequestValidationError{
+			field:  "Usergroup",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPageSize() != 0 {
+
+		if m.GetPageSize() > 1000 {
+			err := ListUsergroupMembersRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be less than or equal to 1000",
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListBookmarksResponseValidationError{
-					field:  fmt.Sprintf("Bookmarks[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
+			if !all {
+				return err
 			}
+			errors = append(errors, err)
 		}
 
 	}
 
+	// no validation rules for PageToken
+
 	if len(errors) > 0 {
-		return ListBookmarksResponseMultiError(errors)
+		return ListUsergroupMembersRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListBookmarksResponseMultiError is an error wrapping multiple validation
-// errors returned by ListBookmarksResponse.ValidateAll() if the designated
-// constraints aren't met.
-type ListBookmarksResponseMultiError []error
+// ListUsergroupMembersRequestMultiError is an error wrapping multiple
+// validation errors returned by ListUsergroupMembersRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListUsergroupMembersRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListBookmarksResponseMultiError) Error() string {
+func (m ListUsergroupMembersRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12036,11 +12669,12 @@ func (m ListBookmarksResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListBookmarksResponseMultiError) AllErrors() []error { return m }
+func (m ListUsergroupMembersRequestMultiError) AllErrors() []error { return m }
 
-// ListBookmarksResponseValidationError is the validation error returned by
-// ListBookmarksResponse.Validate if the designated constraints aren't met.
-type ListBookmarksResponseValidationError struct {
+// ListUsergroupMembersRequestValidationError is the validation error returned
+// by ListUsergroupMembersRequest.Validate if the designated constraints
+// aren't met.
+type ListUsergroupMembersRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12048,24 +12682,24 @@ type ListBookmarksResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListBookmarksResponseValidationError) Field() string { return e.field }
+func (e ListUsergroupMembersRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListBookmarksResponseValidationError) Reason() string { return e.reason }
+func (e ListUsergroupMembersRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListBookmarksResponseValidationError) Cause() error { return e.cause }
+func (e ListUsergroupMembersRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListBookmarksResponseValidationError) Key() bool { return e.key }
+func (e ListUsergroupMembersRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListBookmarksResponseValidationError) ErrorName() string {
-	return "ListBookmarksResponseValidationError"
+func (e ListUsergroupMembersRequestValidationError) ErrorName() string {
+	return "ListUsergroupMembersRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListBookmarksResponseValidationError) Error() string {
+func (e ListUsergroupMembersRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12077,14 +12711,14 @@ func (e ListBookmarksResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListBookmarksResponse.%s: %s%s",
+		"invalid %sListUsergroupMembersRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListBookmarksResponseValidationError{}
+var _ error = ListUsergroupMembersRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -12092,46 +12726,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListBookmarksResponseValidationError{}
+} = ListUsergroupMembersRequestValidationError{}
 
-// Validate checks the field values on GetBookmarkRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListUsergroupMembersResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetBookmarkRequest) Validate() error {
+func (m *ListUsergroupMembersResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetBookmarkRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListUsergroupMembersResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetBookmarkRequestMultiError, or nil if none found.
-func (m *GetBookmarkRequest) ValidateAll() error {
+// ListUsergroupMembersResponseMultiError, or nil if none found.
+func (m *ListUsergroupMembersResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetBookmarkRequest) validate(all bool) error {
+func (m *ListUsergroupMembersResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for BookmarkId
+	for idx, item := range m.GetMembers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListUsergroupMembersResponseValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListUsergroupMembersResponseValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListUsergroupMembersResponseValidationError{
+					field:  fmt.Sprintf("Members[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
 
 	if len(errors) > 0 {
-		return GetBookmarkRequestMultiError(errors)
+		return ListUsergroupMembersResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetBookmarkRequestMultiError is an error wrapping multiple validation errors
-// returned by GetBookmarkRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetBookmarkRequestMultiError []error
+// ListUsergroupMembersResponseMultiError is an error wrapping multiple
+// validation errors returned by ListUsergroupMembersResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListUsergroupMembersResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetBookmarkRequestMultiError) Error() string {
+func (m ListUsergroupMembersResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12140,11 +12808,12 @@ func (m GetBookmarkRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetBookmarkRequestMultiError) AllErrors() []error { return m }
+func (m ListUsergroupMembersResponseMultiError) AllErrors() []error { return m }
 
-// GetBookmarkRequestValidationError is the validation error returned by
-// GetBookmarkRequest.Validate if the designated constraints aren't met.
-type GetBookmarkRequestValidationError struct {
+// ListUsergroupMembersResponseValidationError is the validation error returned
+// by ListUsergroupMembersResponse.Validate if the designated constraints
+// aren't met.
+type ListUsergroupMembersResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12152,24 +12821,24 @@ type GetBookmarkRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetBookmarkRequestValidationError) Field() string { return e.field }
+func (e ListUsergroupMembersResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetBookmarkRequestValidationError) Reason() string { return e.reason }
+func (e ListUsergroupMembersResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetBookmarkRequestValidationError) Cause() error { return e.cause }
+func (e ListUsergroupMembersResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetBookmarkRequestValidationError) Key() bool { return e.key }
+func (e ListUsergroupMembersResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetBookmarkRequestValidationError) ErrorName() string {
-	return "GetBookmarkRequestValidationError"
+func (e ListUsergroupMembersResponseValidationError) ErrorName() string {
+	return "ListUsergroupMembersResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetBookmarkRequestValidationError) Error() string {
+func (e ListUsergroupMembersResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12181,14 +12850,14 @@ func (e GetBookmarkRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetBookmarkRequest.%s: %s%s",
+		"invalid %sListUsergroupMembersResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetBookmarkRequestValidationError{}
+var _ error = ListUsergroupMembersResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -12196,73 +12865,128 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetBookmarkRequestValidationError{}
+} = ListUsergroupMembersResponseValidationError{}
 
-// Validate checks the field values on GetBookmarkResponse with the rules
+// Validate checks the field values on AddUsergroupMemberRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetBookmarkResponse) Validate() error {
+func (m *AddUsergroupMemberRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetBookmarkResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AddUsergroupMemberRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetBookmarkResponseMultiError, or nil if none found.
-func (m *GetBookmarkResponse) ValidateAll() error {
+// AddUsergroupMemberRequestMultiError, or nil if none found.
+func (m *AddUsergroupMemberRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetBookmarkResponse) validate(all bool) error {
+func (m *AddUsergroupMemberRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetBookmark()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetBookmarkResponseValidationError{
-					field:  "Bookmark",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GetBookmarkResponseValidationError{
-					field:  "Bookmark",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := AddUsergroupMemberRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
 		}
-	} else if v, ok := interface{}(m.GetBookmark()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetBookmarkResponseValidationError{
-				field:  "Bookmark",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetUsergroup()) < 1 {
+		err := AddUsergroupMemberRequestValidationError{
+			field:  "Usergroup",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateEmail(m.GetEmail()); err != nil {
+		err = AddUsergroupMemberRequestValidationError{
+			field:  "Email",
+			reason: "value must be a valid email address",
+			cause:  err,
+		}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return GetBookmarkResponseMultiError(errors)
+		return AddUsergroupMemberRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetBookmarkResponseMultiError is an error wrapping multiple validation
-// errors returned by GetBookmarkResponse.ValidateAll() if the designated
-// constraints aren't met.
-type GetBookmarkResponseMultiError []error
+func (m *AddUsergroupMemberRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *AddUsergroupMemberRequest) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+// AddUsergroupMemberRequestMultiError is an error wrapping multiple validation
+// errors returned by AddUsergroupMemberRequest.ValidateAll() if the
+// designated constraints aren't met.
+type AddUsergroupMemberRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetBookmarkResponseMultiError) Error() string {
+func (m AddUsergroupMemberRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12271,11 +12995,11 @@ func (m GetBookmarkResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetBookmarkResponseMultiError) AllErrors() []error { return m }
+func (m AddUsergroupMemberRequestMultiError) AllErrors() []error { return m }
 
-// GetBookmarkResponseValidationError is the validation error returned by
-// GetBookmarkResponse.Validate if the designated constraints aren't met.
-type GetBookmarkResponseValidationError struct {
+// AddUsergroupMemberRequestValidationError is the validation error returned by
+// AddUsergroupMemberRequest.Validate if the designated constraints aren't met.
+type AddUsergroupMemberRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12283,24 +13007,24 @@ type GetBookmarkResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetBookmarkResponseValidationError) Field() string { return e.field }
+func (e AddUsergroupMemberRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetBookmarkResponseValidationError) Reason() string { return e.reason }
+func (e AddUsergroupMemberRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetBookmarkResponseValidationError) Cause() error { return e.cause }
+func (e AddUsergroupMemberRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetBookmarkResponseValidationError) Key() bool { return e.key }
+func (e AddUsergroupMemberRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetBookmarkResponseValidationError) ErrorName() string {
-	return "GetBookmarkResponseValidationError"
+func (e AddUsergroupMemberRequestValidationError) ErrorName() string {
+	return "AddUsergroupMemberRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetBookmarkResponseValidationError) Error() string {
+func (e AddUsergroupMemberRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12312,14 +13036,14 @@ func (e GetBookmarkResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetBookmarkResponse.%s: %s%s",
+		"invalid %sAddUsergroupMemberRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetBookmarkResponseValidationError{}
+var _ error = AddUsergroupMemberRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -12327,60 +13051,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetBookmarkResponseValidationError{}
+} = AddUsergroupMemberRequestValidationError{}
 
-// Validate checks the field values on CreateBookmarkRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AddUsergroupMemberResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateBookmarkRequest) Validate() error {
+func (m *AddUsergroupMemberResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateBookmarkRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AddUsergroupMemberResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateBookmarkRequestMultiError, or nil if none found.
-func (m *CreateBookmarkRequest) ValidateAll() error {
+// AddUsergroupMemberResponseMultiError, or nil if none found.
+func (m *AddUsergroupMemberResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateBookmarkRequest) validate(all bool) error {
+func (m *AddUsergroupMemberResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for DisplayName
+	if len(errors) > 0 {
+		return AddUsergroupMemberResponseMultiError(errors)
+	}
 
-	// no validation rules for Description
+	return nil
+}
 
-	// no validation rules for Data
-
-	// no validation rules for ResourceKind
-
-	// no validation rules for ResourceName
-
-	// no validation rules for ProjectId
-
-	// no validation rules for Default
-
-	// no validation rules for Shared
-
-	if len(errors) > 0 {
-		return CreateBookmarkRequestMultiError(errors)
-	}
-
-	return nil
-}
-
-// CreateBookmarkRequestMultiError is an error wrapping multiple validation
-// errors returned by CreateBookmarkRequest.ValidateAll() if the designated
-// constraints aren't met.
-type CreateBookmarkRequestMultiError []error
+// AddUsergroupMemberResponseMultiError is an error wrapping multiple
+// validation errors returned by AddUsergroupMemberResponse.ValidateAll() if
+// the designated constraints aren't met.
+type AddUsergroupMemberResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateBookmarkRequestMultiError) Error() string {
+func (m AddUsergroupMemberResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12389,11 +13097,11 @@ func (m CreateBookmarkRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateBookmarkRequestMultiError) AllErrors() []error { return m }
+func (m AddUsergroupMemberResponseMultiError) AllErrors() []error { return m }
 
-// CreateBookmarkRequestValidationError is the validation error returned by
-// CreateBookmarkRequest.Validate if the designated constraints aren't met.
-type CreateBookmarkRequestValidationError struct {
+// AddUsergroupMemberResponseValidationError is the validation error returned
+// by AddUsergroupMemberResponse.Validate if the designated constraints aren't met.
+type AddUsergroupMemberResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12401,24 +13109,24 @@ type CreateBookmarkRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateBookmarkRequestValidationError) Field() string { return e.field }
+func (e AddUsergroupMemberResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateBookmarkRequestValidationError) Reason() string { return e.reason }
+func (e AddUsergroupMemberResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateBookmarkRequestValidationError) Cause() error { return e.cause }
+func (e AddUsergroupMemberResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateBookmarkRequestValidationError) Key() bool { return e.key }
+func (e AddUsergroupMemberResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateBookmarkRequestValidationError) ErrorName() string {
-	return "CreateBookmarkRequestValidationError"
+func (e AddUsergroupMemberResponseValidationError) ErrorName() string {
+	return "AddUsergroupMemberResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateBookmarkRequestValidationError) Error() string {
+func (e AddUsergroupMemberResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12430,14 +13138,14 @@ func (e CreateBookmarkRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateBookmarkRequest.%s: %s%s",
+		"invalid %sAddUsergroupMemberResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateBookmarkRequestValidationError{}
+var _ error = AddUsergroupMemberResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -12445,73 +13153,128 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateBookmarkRequestValidationError{}
+} = AddUsergroupMemberResponseValidationError{}
 
-// Validate checks the field values on CreateBookmarkResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on RemoveUsergroupMemberRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateBookmarkResponse) Validate() error {
+func (m *RemoveUsergroupMemberRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateBookmarkResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on RemoveUsergroupMemberRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateBookmarkResponseMultiError, or nil if none found.
-func (m *CreateBookmarkResponse) ValidateAll() error {
+// RemoveUsergroupMemberRequestMultiError, or nil if none found.
+func (m *RemoveUsergroupMemberRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateBookmarkResponse) validate(all bool) error {
+func (m *RemoveUsergroupMemberRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetBookmark()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateBookmarkResponseValidationError{
-					field:  "Bookmark",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateBookmarkResponseValidationError{
-					field:  "Bookmark",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := RemoveUsergroupMemberRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
 		}
-	} else if v, ok := interface{}(m.GetBookmark()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateBookmarkResponseValidationError{
-				field:  "Bookmark",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetUsergroup()) < 1 {
+		err := RemoveUsergroupMemberRequestValidationError{
+			field:  "Usergroup",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateEmail(m.GetEmail()); err != nil {
+		err = RemoveUsergroupMemberRequestValidationError{
+			field:  "Email",
+			reason: "value must be a valid email address",
+			cause:  err,
+		}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return CreateBookmarkResponseMultiError(errors)
+		return RemoveUsergroupMemberRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateBookmarkResponseMultiError is an error wrapping multiple validation
-// errors returned by CreateBookmarkResponse.ValidateAll() if the designated
-// constraints aren't met.
-type CreateBookmarkResponseMultiError []error
+func (m *RemoveUsergroupMemberRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *RemoveUsergroupMemberRequest) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+// RemoveUsergroupMemberRequestMultiError is an error wrapping multiple
+// validation errors returned by RemoveUsergroupMemberRequest.ValidateAll() if
+// the designated constraints aren't met.
+type RemoveUsergroupMemberRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateBookmarkResponseMultiError) Error() string {
+func (m RemoveUsergroupMemberRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12520,11 +13283,12 @@ func (m CreateBookmarkResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateBookmarkResponseMultiError) AllErrors() []error { return m }
+func (m RemoveUsergroupMemberRequestMultiError) AllErrors() []error { return m }
 
-// CreateBookmarkResponseValidationError is the validation error returned by
-// CreateBookmarkResponse.Validate if the designated constraints aren't met.
-type CreateBookmarkResponseValidationError struct {
+// RemoveUsergroupMemberRequestValidationError is the validation error returned
+// by RemoveUsergroupMemberRequest.Validate if the designated constraints
+// aren't met.
+type RemoveUsergroupMemberRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12532,24 +13296,24 @@ type CreateBookmarkResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateBookmarkResponseValidationError) Field() string { return e.field }
+func (e RemoveUsergroupMemberRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateBookmarkResponseValidationError) Reason() string { return e.reason }
+func (e RemoveUsergroupMemberRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateBookmarkResponseValidationError) Cause() error { return e.cause }
+func (e RemoveUsergroupMemberRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateBookmarkResponseValidationError) Key() bool { return e.key }
+func (e RemoveUsergroupMemberRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateBookmarkResponseValidationError) ErrorName() string {
-	return "CreateBookmarkResponseValidationError"
+func (e RemoveUsergroupMemberRequestValidationError) ErrorName() string {
+	return "RemoveUsergroupMemberRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateBookmarkResponseValidationError) Error() string {
+func (e RemoveUsergroupMemberRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12561,14 +13325,14 @@ func (e CreateBookmarkResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateBookmarkResponse.%s: %s%s",
+		"invalid %sRemoveUsergroupMemberRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateBookmarkResponseValidationError{}
+var _ error = RemoveUsergroupMemberRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -12576,56 +13340,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateBookmarkResponseValidationError{}
+} = RemoveUsergroupMemberRequestValidationError{}
 
-// Validate checks the field values on UpdateBookmarkRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on RemoveUsergroupMemberResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateBookmarkRequest) Validate() error {
+func (m *RemoveUsergroupMemberResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateBookmarkRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UpdateBookmarkRequestMultiError, or nil if none found.
-func (m *UpdateBookmarkRequest) ValidateAll() error {
+// ValidateAll checks the field values on RemoveUsergroupMemberResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RemoveUsergroupMemberResponseMultiError, or nil if none found.
+func (m *RemoveUsergroupMemberResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateBookmarkRequest) validate(all bool) error {
+func (m *RemoveUsergroupMemberResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for BookmarkId
-
-	// no validation rules for DisplayName
-
-	// no validation rules for Description
-
-	// no validation rules for Data
-
-	// no validation rules for Default
-
-	// no validation rules for Shared
-
 	if len(errors) > 0 {
-		return UpdateBookmarkRequestMultiError(errors)
+		return RemoveUsergroupMemberResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateBookmarkRequestMultiError is an error wrapping multiple validation
-// errors returned by UpdateBookmarkRequest.ValidateAll() if the designated
-// constraints aren't met.
-type UpdateBookmarkRequestMultiError []error
+// RemoveUsergroupMemberResponseMultiError is an error wrapping multiple
+// validation errors returned by RemoveUsergroupMemberResponse.ValidateAll()
+// if the designated constraints aren't met.
+type RemoveUsergroupMemberResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateBookmarkRequestMultiError) Error() string {
+func (m RemoveUsergroupMemberResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12634,11 +13386,12 @@ func (m UpdateBookmarkRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateBookmarkRequestMultiError) AllErrors() []error { return m }
+func (m RemoveUsergroupMemberResponseMultiError) AllErrors() []error { return m }
 
-// UpdateBookmarkRequestValidationError is the validation error returned by
-// UpdateBookmarkRequest.Validate if the designated constraints aren't met.
-type UpdateBookmarkRequestValidationError struct {
+// RemoveUsergroupMemberResponseValidationError is the validation error
+// returned by RemoveUsergroupMemberResponse.Validate if the designated
+// constraints aren't met.
+type RemoveUsergroupMemberResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12646,24 +13399,24 @@ type UpdateBookmarkRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateBookmarkRequestValidationError) Field() string { return e.field }
+func (e RemoveUsergroupMemberResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateBookmarkRequestValidationError) Reason() string { return e.reason }
+func (e RemoveUsergroupMemberResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateBookmarkRequestValidationError) Cause() error { return e.cause }
+func (e RemoveUsergroupMemberResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateBookmarkRequestValidationError) Key() bool { return e.key }
+func (e RemoveUsergroupMemberResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateBookmarkRequestValidationError) ErrorName() string {
-	return "UpdateBookmarkRequestValidationError"
+func (e RemoveUsergroupMemberResponseValidationError) ErrorName() string {
+	return "RemoveUsergroupMemberResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateBookmarkRequestValidationError) Error() string {
+func (e RemoveUsergroupMemberResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12675,14 +13428,14 @@ func (e UpdateBookmarkRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateBookmarkRequest.%s: %s%s",
+		"invalid %sRemoveUsergroupMemberResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateBookmarkRequestValidationError{}
+var _ error = RemoveUsergroupMemberResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -12690,44 +13443,85 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateBookmarkRequestValidationError{}
+} = RemoveUsergroupMemberResponseValidationError{}
 
-// Validate checks the field values on UpdateBookmarkResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateBookmarkResponse) Validate() error {
+// Validate checks the field values on ListProjectMemberUsergroupsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ListProjectMemberUsergroupsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateBookmarkResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UpdateBookmarkResponseMultiError, or nil if none found.
-func (m *UpdateBookmarkResponse) ValidateAll() error {
+// ValidateAll checks the field values on ListProjectMemberUsergroupsRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ListProjectMemberUsergroupsRequestMultiError, or nil if none found.
+func (m *ListProjectMemberUsergroupsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateBookmarkResponse) validate(all bool) error {
+func (m *ListProjectMemberUsergroupsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(errors) > 0 {
-		return UpdateBookmarkResponseMultiError(errors)
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := ListProjectMemberUsergroupsRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
-	return nil
-}
+	if utf8.RuneCountInString(m.GetProject()) < 1 {
+		err := ListProjectMemberUsergroupsRequestValidationError{
+			field:  "Project",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-// UpdateBookmarkResponseMultiError is an error wrapping multiple validation
-// errors returned by UpdateBookmarkResponse.ValidateAll() if the designated
-// constraints aren't met.
-type UpdateBookmarkResponseMultiError []error
+	if m.GetPageSize() != 0 {
 
-// Error returns a concatenation of all the error messages it wraps.
-func (m UpdateBookmarkResponseMultiError) Error() string {
+		if m.GetPageSize() > 1000 {
+			err := ListProjectMemberUsergroupsRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be less than or equal to 1000",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListProjectMemberUsergroupsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListProjectMemberUsergroupsRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// ListProjectMemberUsergroupsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListProjectMemberUsergroupsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListProjectMemberUsergroupsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12736,11 +13530,12 @@ func (m UpdateBookmarkResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateBookmarkResponseMultiError) AllErrors() []error { return m }
+func (m ListProjectMemberUsergroupsRequestMultiError) AllErrors() []error { return m }
 
-// UpdateBookmarkResponseValidationError is the validation error returned by
-// UpdateBookmarkResponse.Validate if the designated constraints aren't met.
-type UpdateBookmarkResponseValidationError struct {
+// ListProjectMemberUsergroupsRequestValidationError is the validation error
+// returned by ListProjectMemberUsergroupsRequest.Validate if the designated
+// constraints aren't met.
+type ListProjectMemberUsergroupsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12748,24 +13543,24 @@ type UpdateBookmarkResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateBookmarkResponseValidationError) Field() string { return e.field }
+func (e ListProjectMemberUsergroupsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateBookmarkResponseValidationError) Reason() string { return e.reason }
+func (e ListProjectMemberUsergroupsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateBookmarkResponseValidationError) Cause() error { return e.cause }
+func (e ListProjectMemberUsergroupsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateBookmarkResponseValidationError) Key() bool { return e.key }
+func (e ListProjectMemberUsergroupsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateBookmarkResponseValidationError) ErrorName() string {
-	return "UpdateBookmarkResponseValidationError"
+func (e ListProjectMemberUsergroupsRequestValidationError) ErrorName() string {
+	return "ListProjectMemberUsergroupsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateBookmarkResponseValidationError) Error() string {
+func (e ListProjectMemberUsergroupsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12777,14 +13572,14 @@ func (e UpdateBookmarkResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateBookmarkResponse.%s: %s%s",
+		"invalid %sListProjectMemberUsergroupsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateBookmarkResponseValidationError{}
+var _ error = ListProjectMemberUsergroupsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -12792,46 +13587,82 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateBookmarkResponseValidationError{}
+} = ListProjectMemberUsergroupsRequestValidationError{}
 
-// Validate checks the field values on RemoveBookmarkRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RemoveBookmarkRequest) Validate() error {
+// Validate checks the field values on ListProjectMemberUsergroupsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ListProjectMemberUsergroupsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RemoveBookmarkRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// RemoveBookmarkRequestMultiError, or nil if none found.
-func (m *RemoveBookmarkRequest) ValidateAll() error {
+// ValidateAll checks the field values on ListProjectMemberUsergroupsResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ListProjectMemberUsergroupsResponseMultiError, or nil if none found.
+func (m *ListProjectMemberUsergroupsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RemoveBookmarkRequest) validate(all bool) error {
+func (m *ListProjectMemberUsergroupsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for BookmarkId
+	for idx, item := range m.GetUsergroups() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListProjectMemberUsergroupsResponseValidationError{
+						field:  fmt.Sprintf("Usergroups[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListProjectMemberUsergroupsResponseValidationError{
+						field:  fmt.Sprintf("Usergroups[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListProjectMemberUsergroupsResponseValidationError{
+					field:  fmt.Sprintf("Usergroups[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
 
 	if len(errors) > 0 {
-		return RemoveBookmarkRequestMultiError(errors)
+		return ListProjectMemberUsergroupsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// RemoveBookmarkRequestMultiError is an error wrapping multiple validation
-// errors returned by RemoveBookmarkRequest.ValidateAll() if the designated
+// ListProjectMemberUsergroupsResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// ListProjectMemberUsergroupsResponse.ValidateAll() if the designated
 // constraints aren't met.
-type RemoveBookmarkRequestMultiError []error
+type ListProjectMemberUsergroupsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RemoveBookmarkRequestMultiError) Error() string {
+func (m ListProjectMemberUsergroupsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12840,11 +13671,12 @@ func (m RemoveBookmarkRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RemoveBookmarkRequestMultiError) AllErrors() []error { return m }
+func (m ListProjectMemberUsergroupsResponseMultiError) AllErrors() []error { return m }
 
-// RemoveBookmarkRequestValidationError is the validation error returned by
-// RemoveBookmarkRequest.Validate if the designated constraints aren't met.
-type RemoveBookmarkRequestValidationError struct {
+// ListProjectMemberUsergroupsResponseValidationError is the validation error
+// returned by ListProjectMemberUsergroupsResponse.Validate if the designated
+// constraints aren't met.
+type ListProjectMemberUsergroupsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12852,24 +13684,24 @@ type RemoveBookmarkRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RemoveBookmarkRequestValidationError) Field() string { return e.field }
+func (e ListProjectMemberUsergroupsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RemoveBookmarkRequestValidationError) Reason() string { return e.reason }
+func (e ListProjectMemberUsergroupsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RemoveBookmarkRequestValidationError) Cause() error { return e.cause }
+func (e ListProjectMemberUsergroupsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RemoveBookmarkRequestValidationError) Key() bool { return e.key }
+func (e ListProjectMemberUsergroupsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RemoveBookmarkRequestValidationError) ErrorName() string {
-	return "RemoveBookmarkRequestValidationError"
+func (e ListProjectMemberUsergroupsResponseValidationError) ErrorName() string {
+	return "ListProjectMemberUsergroupsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RemoveBookmarkRequestValidationError) Error() string {
+func (e ListProjectMemberUsergroupsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12881,14 +13713,14 @@ func (e RemoveBookmarkRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRemoveBookmarkRequest.%s: %s%s",
+		"invalid %sListProjectMemberUsergroupsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RemoveBookmarkRequestValidationError{}
+var _ error = ListProjectMemberUsergroupsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -12896,44 +13728,90 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RemoveBookmarkRequestValidationError{}
+} = ListProjectMemberUsergroupsResponseValidationError{}
 
-// Validate checks the field values on RemoveBookmarkResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RemoveBookmarkResponse) Validate() error {
+// Validate checks the field values on AddProjectMemberUsergroupRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AddProjectMemberUsergroupRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RemoveBookmarkResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// RemoveBookmarkResponseMultiError, or nil if none found.
-func (m *RemoveBookmarkResponse) ValidateAll() error {
+// ValidateAll checks the field values on AddProjectMemberUsergroupRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AddProjectMemberUsergroupRequestMultiError, or nil if none found.
+func (m *AddProjectMemberUsergroupRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RemoveBookmarkResponse) validate(all bool) error {
+func (m *AddProjectMemberUsergroupRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := AddProjectMemberUsergroupRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetProject()) < 1 {
+		err := AddProjectMemberUsergroupRequestValidationError{
+			field:  "Project",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetUsergroup()) < 1 {
+		err := AddProjectMemberUsergroupRequestValidationError{
+			field:  "Usergroup",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetRole()) < 1 {
+		err := AddProjectMemberUsergroupRequestValidationError{
+			field:  "Role",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
-		return RemoveBookmarkResponseMultiError(errors)
+		return AddProjectMemberUsergroupRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// RemoveBookmarkResponseMultiError is an error wrapping multiple validation
-// errors returned by RemoveBookmarkResponse.ValidateAll() if the designated
+// AddProjectMemberUsergroupRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// AddProjectMemberUsergroupRequest.ValidateAll() if the designated
 // constraints aren't met.
-type RemoveBookmarkResponseMultiError []error
+type AddProjectMemberUsergroupRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RemoveBookmarkResponseMultiError) Error() string {
+func (m AddProjectMemberUsergroupRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12942,11 +13820,12 @@ func (m RemoveBookmarkResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RemoveBookmarkResponseMultiError) AllErrors() []error { return m }
+func (m AddProjectMemberUsergroupRequestMultiError) AllErrors() []error { return m }
 
-// RemoveBookmarkResponseValidationError is the validation error returned by
-// RemoveBookmarkResponse.Validate if the designated constraints aren't met.
-type RemoveBookmarkResponseValidationError struct {
+// AddProjectMemberUsergroupRequestValidationError is the validation error
+// returned by AddProjectMemberUsergroupRequest.Validate if the designated
+// constraints aren't met.
+type AddProjectMemberUsergroupRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12954,24 +13833,24 @@ type RemoveBookmarkResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e RemoveBookmarkResponseValidationError) Field() string { return e.field }
+func (e AddProjectMemberUsergroupRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RemoveBookmarkResponseValidationError) Reason() string { return e.reason }
+func (e AddProjectMemberUsergroupRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RemoveBookmarkResponseValidationError) Cause() error { return e.cause }
+func (e AddProjectMemberUsergroupRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RemoveBookmarkResponseValidationError) Key() bool { return e.key }
+func (e AddProjectMemberUsergroupRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RemoveBookmarkResponseValidationError) ErrorName() string {
-	return "RemoveBookmarkResponseValidationError"
+func (e AddProjectMemberUsergroupRequestValidationError) ErrorName() string {
+	return "AddProjectMemberUsergroupRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RemoveBookmarkResponseValidationError) Error() string {
+func (e AddProjectMemberUsergroupRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12983,14 +13862,14 @@ func (e RemoveBookmarkResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRemoveBookmarkResponse.%s: %s%s",
+		"invalid %sAddProjectMemberUsergroupRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RemoveBookmarkResponseValidationError{}
+var _ error = AddProjectMemberUsergroupRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -12998,72 +13877,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RemoveBookmarkResponseValidationError{}
+} = AddProjectMemberUsergroupRequestValidationError{}
 
-// Validate checks the field values on SearchUsersRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SearchUsersRequest) Validate() error {
+// Validate checks the field values on AddProjectMemberUsergroupResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AddProjectMemberUsergroupResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SearchUsersRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// SearchUsersRequestMultiError, or nil if none found.
-func (m *SearchUsersRequest) ValidateAll() error {
+// ValidateAll checks the field values on AddProjectMemberUsergroupResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// AddProjectMemberUsergroupResponseMultiError, or nil if none found.
+func (m *AddProjectMemberUsergroupResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SearchUsersRequest) validate(all bool) error {
+func (m *AddProjectMemberUsergroupResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetEmailPattern()) < 1 {
-		err := SearchUsersRequestValidationError{
-			field:  "EmailPattern",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if m.GetPageSize() != 0 {
-
-		if m.GetPageSize() > 1000 {
-			err := SearchUsersRequestValidationError{
-				field:  "PageSize",
-				reason: "value must be less than or equal to 1000",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-	}
-
-	// no validation rules for PageToken
-
 	if len(errors) > 0 {
-		return SearchUsersRequestMultiError(errors)
+		return AddProjectMemberUsergroupResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// SearchUsersRequestMultiError is an error wrapping multiple validation errors
-// returned by SearchUsersRequest.ValidateAll() if the designated constraints
-// aren't met.
-type SearchUsersRequestMultiError []error
+// AddProjectMemberUsergroupResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// AddProjectMemberUsergroupResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AddProjectMemberUsergroupResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SearchUsersRequestMultiError) Error() string {
+func (m AddProjectMemberUsergroupResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13072,11 +13925,12 @@ func (m SearchUsersRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SearchUsersRequestMultiError) AllErrors() []error { return m }
+func (m AddProjectMemberUsergroupResponseMultiError) AllErrors() []error { return m }
 
-// SearchUsersRequestValidationError is the validation error returned by
-// SearchUsersRequest.Validate if the designated constraints aren't met.
-type SearchUsersRequestValidationError struct {
+// AddProjectMemberUsergroupResponseValidationError is the validation error
+// returned by AddProjectMemberUsergroupResponse.Validate if the designated
+// constraints aren't met.
+type AddProjectMemberUsergroupResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13084,24 +13938,24 @@ type SearchUsersRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e SearchUsersRequestValidationError) Field() string { return e.field }
+func (e AddProjectMemberUsergroupResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SearchUsersRequestValidationError) Reason() string { return e.reason }
+func (e AddProjectMemberUsergroupResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SearchUsersRequestValidationError) Cause() error { return e.cause }
+func (e AddProjectMemberUsergroupResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SearchUsersRequestValidationError) Key() bool { return e.key }
+func (e AddProjectMemberUsergroupResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SearchUsersRequestValidationError) ErrorName() string {
-	return "SearchUsersRequestValidationError"
+func (e AddProjectMemberUsergroupResponseValidationError) ErrorName() string {
+	return "AddProjectMemberUsergroupResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SearchUsersRequestValidationError) Error() string {
+func (e AddProjectMemberUsergroupResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13113,14 +13967,14 @@ func (e SearchUsersRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSearchUsersRequest.%s: %s%s",
+		"invalid %sAddProjectMemberUsergroupResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SearchUsersRequestValidationError{}
+var _ error = AddProjectMemberUsergroupResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -13128,80 +13982,79 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SearchUsersRequestValidationError{}
+} = AddProjectMemberUsergroupResponseValidationError{}
 
-// Validate checks the field values on SearchUsersResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SearchUsersResponse) Validate() error {
+// Validate checks the field values on RemoveProjectMemberUsergroupRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *RemoveProjectMemberUsergroupRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SearchUsersResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// SearchUsersResponseMultiError, or nil if none found.
-func (m *SearchUsersResponse) ValidateAll() error {
+// ValidateAll checks the field values on RemoveProjectMemberUsergroupRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// RemoveProjectMemberUsergroupRequestMultiError, or nil if none found.
+func (m *RemoveProjectMemberUsergroupRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SearchUsersResponse) validate(all bool) error {
+func (m *RemoveProjectMemberUsergroupRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetUsers() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, SearchUsersResponseValidationError{
-						field:  fmt.Sprintf("Users[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, SearchUsersResponseValidationError{
-						field:  fmt.Sprintf("Users[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return SearchUsersResponseValidationError{
-					field:  fmt.Sprintf("Users[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := RemoveProjectMemberUsergroupRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
+	}
 
+	if utf8.RuneCountInString(m.GetProject()) < 1 {
+		err := RemoveProjectMemberUsergroupRequestValidationError{
+			field:  "Project",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
-	// no validation rules for NextPageToken
+	if utf8.RuneCountInString(m.GetUsergroup()) < 1 {
+		err := RemoveProjectMemberUsergroupRequestValidationError{
+			field:  "Usergroup",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return SearchUsersResponseMultiError(errors)
+		return RemoveProjectMemberUsergroupRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// SearchUsersResponseMultiError is an error wrapping multiple validation
-// errors returned by SearchUsersResponse.ValidateAll() if the designated
+// RemoveProjectMemberUsergroupRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// RemoveProjectMemberUsergroupRequest.ValidateAll() if the designated
 // constraints aren't met.
-type SearchUsersResponseMultiError []error
+type RemoveProjectMemberUsergroupRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SearchUsersResponseMultiError) Error() string {
+func (m RemoveProjectMemberUsergroupRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13210,11 +14063,12 @@ func (m SearchUsersResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SearchUsersResponseMultiError) AllErrors() []error { return m }
+func (m RemoveProjectMemberUsergroupRequestMultiError) AllErrors() []error { return m }
 
-// SearchUsersResponseValidationError is the validation error returned by
-// SearchUsersResponse.Validate if the designated constraints aren't met.
-type SearchUsersResponseValidationError struct {
+// RemoveProjectMemberUsergroupRequestValidationError is the validation error
+// returned by RemoveProjectMemberUsergroupRequest.Validate if the designated
+// constraints aren't met.
+type RemoveProjectMemberUsergroupRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13222,24 +14076,24 @@ type SearchUsersResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e SearchUsersResponseValidationError) Field() string { return e.field }
+func (e RemoveProjectMemberUsergroupRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SearchUsersResponseValidationError) Reason() string { return e.reason }
+func (e RemoveProjectMemberUsergroupRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SearchUsersResponseValidationError) Cause() error { return e.cause }
+func (e RemoveProjectMemberUsergroupRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SearchUsersResponseValidationError) Key() bool { return e.key }
+func (e RemoveProjectMemberUsergroupRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SearchUsersResponseValidationError) ErrorName() string {
-	return "SearchUsersResponseValidationError"
+func (e RemoveProjectMemberUsergroupRequestValidationError) ErrorName() string {
+	return "RemoveProjectMemberUsergroupRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SearchUsersResponseValidationError) Error() string {
+func (e RemoveProjectMemberUsergroupRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13251,14 +14105,14 @@ func (e SearchUsersResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSearchUsersResponse.%s: %s%s",
+		"invalid %sRemoveProjectMemberUsergroupRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SearchUsersResponseValidationError{}
+var _ error = RemoveProjectMemberUsergroupRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -13266,24 +14120,25 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SearchUsersResponseValidationError{}
+} = RemoveProjectMemberUsergroupRequestValidationError{}
 
-// Validate checks the field values on RevokeCurrentAuthTokenRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RevokeCurrentAuthTokenRequest) Validate() error {
+// Validate checks the field values on RemoveProjectMemberUsergroupResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *RemoveProjectMemberUsergroupResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RevokeCurrentAuthTokenRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// RevokeCurrentAuthTokenRequestMultiError, or nil if none found.
-func (m *RevokeCurrentAuthTokenRequest) ValidateAll() error {
+// ValidateAll checks the field values on RemoveProjectMemberUsergroupResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// RemoveProjectMemberUsergroupResponseMultiError, or nil if none found.
+func (m *RemoveProjectMemberUsergroupResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RevokeCurrentAuthTokenRequest) validate(all bool) error {
+func (m *RemoveProjectMemberUsergroupResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -13291,19 +14146,20 @@ func (m *RevokeCurrentAuthTokenRequest) validate(all bool) error {
 	var errors []error
 
 	if len(errors) > 0 {
-		return RevokeCurrentAuthTokenRequestMultiError(errors)
+		return RemoveProjectMemberUsergroupResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// RevokeCurrentAuthTokenRequestMultiError is an error wrapping multiple
-// validation errors returned by RevokeCurrentAuthTokenRequest.ValidateAll()
-// if the designated constraints aren't met.
-type RevokeCurrentAuthTokenRequestMultiError []error
+// RemoveProjectMemberUsergroupResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// RemoveProjectMemberUsergroupResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveProjectMemberUsergroupResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RevokeCurrentAuthTokenRequestMultiError) Error() string {
+func (m RemoveProjectMemberUsergroupResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13312,12 +14168,12 @@ func (m RevokeCurrentAuthTokenRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RevokeCurrentAuthTokenRequestMultiError) AllErrors() []error { return m }
+func (m RemoveProjectMemberUsergroupResponseMultiError) AllErrors() []error { return m }
 
-// RevokeCurrentAuthTokenRequestValidationError is the validation error
-// returned by RevokeCurrentAuthTokenRequest.Validate if the designated
+// RemoveProjectMemberUsergroupResponseValidationError is the validation error
+// returned by RemoveProjectMemberUsergroupResponse.Validate if the designated
 // constraints aren't met.
-type RevokeCurrentAuthTokenRequestValidationError struct {
+type RemoveProjectMemberUsergroupResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13325,24 +14181,24 @@ type RevokeCurrentAuthTokenRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RevokeCurrentAuthTokenRequestValidationError) Field() string { return e.field }
+func (e RemoveProjectMemberUsergroupResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RevokeCurrentAuthTokenRequestValidationError) Reason() string { return e.reason }
+func (e RemoveProjectMemberUsergroupResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RevokeCurrentAuthTokenRequestValidationError) Cause() error { return e.cause }
+func (e RemoveProjectMemberUsergroupResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RevokeCurrentAuthTokenRequestValidationError) Key() bool { return e.key }
+func (e RemoveProjectMemberUsergroupResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RevokeCurrentAuthTokenRequestValidationError) ErrorName() string {
-	return "RevokeCurrentAuthTokenRequestValidationError"
+func (e RemoveProjectMemberUsergroupResponseValidationError) ErrorName() string {
+	return "RemoveProjectMemberUsergroupResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RevokeCurrentAuthTokenRequestValidationError) Error() string {
+func (e RemoveProjectMemberUsergroupResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13354,14 +14210,14 @@ func (e RevokeCurrentAuthTokenRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRevokeCurrentAuthTokenRequest.%s: %s%s",
+		"invalid %sRemoveProjectMemberUsergroupResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RevokeCurrentAuthTokenRequestValidationError{}
+var _ error = RemoveProjectMemberUsergroupResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -13369,46 +14225,90 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RevokeCurrentAuthTokenRequestValidationError{}
+} = RemoveProjectMemberUsergroupResponseValidationError{}
 
-// Validate checks the field values on RevokeCurrentAuthTokenResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RevokeCurrentAuthTokenResponse) Validate() error {
+// Validate checks the field values on SetProjectMemberUsergroupRoleRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *SetProjectMemberUsergroupRoleRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RevokeCurrentAuthTokenResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// RevokeCurrentAuthTokenResponseMultiError, or nil if none found.
-func (m *RevokeCurrentAuthTokenResponse) ValidateAll() error {
+// ValidateAll checks the field values on SetProjectMemberUsergroupRoleRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// SetProjectMemberUsergroupRoleRequestMultiError, or nil if none found.
+func (m *SetProjectMemberUsergroupRoleRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RevokeCurrentAuthTokenResponse) validate(all bool) error {
+func (m *SetProjectMemberUsergroupRoleRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for TokenId
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := SetProjectMemberUsergroupRoleRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetProject()) < 1 {
+		err := SetProjectMemberUsergroupRoleRequestValidationError{
+			field:  "Project",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetUsergroup()) < 1 {
+		err := SetProjectMemberUsergroupRoleRequestValidationError{
+			field:  "Usergroup",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetRole()) < 1 {
+		err := SetProjectMemberUsergroupRoleRequestValidationError{
+			field:  "Role",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return RevokeCurrentAuthTokenResponseMultiError(errors)
+		return SetProjectMemberUsergroupRoleRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// RevokeCurrentAuthTokenResponseMultiError is an error wrapping multiple
-// validation errors returned by RevokeCurrentAuthTokenResponse.ValidateAll()
-// if the designated constraints aren't met.
-type RevokeCurrentAuthTokenResponseMultiError []error
+// SetProjectMemberUsergroupRoleRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// SetProjectMemberUsergroupRoleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetProjectMemberUsergroupRoleRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RevokeCurrentAuthTokenResponseMultiError) Error() string {
+func (m SetProjectMemberUsergroupRoleRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13417,12 +14317,12 @@ func (m RevokeCurrentAuthTokenResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RevokeCurrentAuthTokenResponseMultiError) AllErrors() []error { return m }
+func (m SetProjectMemberUsergroupRoleRequestMultiError) AllErrors() []error { return m }
 
-// RevokeCurrentAuthTokenResponseValidationError is the validation error
-// returned by RevokeCurrentAuthTokenResponse.Validate if the designated
+// SetProjectMemberUsergroupRoleRequestValidationError is the validation error
+// returned by SetProjectMemberUsergroupRoleRequest.Validate if the designated
 // constraints aren't met.
-type RevokeCurrentAuthTokenResponseValidationError struct {
+type SetProjectMemberUsergroupRoleRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13430,24 +14330,24 @@ type RevokeCurrentAuthTokenResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e RevokeCurrentAuthTokenResponseValidationError) Field() string { return e.field }
+func (e SetProjectMemberUsergroupRoleRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RevokeCurrentAuthTokenResponseValidationError) Reason() string { return e.reason }
+func (e SetProjectMemberUsergroupRoleRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RevokeCurrentAuthTokenResponseValidationError) Cause() error { return e.cause }
+func (e SetProjectMemberUsergroupRoleRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RevokeCurrentAuthTokenResponseValidationError) Key() bool { return e.key }
+func (e SetProjectMemberUsergroupRoleRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RevokeCurrentAuthTokenResponseValidationError) ErrorName() string {
-	return "RevokeCurrentAuthTokenResponseValidationError"
+func (e SetProjectMemberUsergroupRoleRequestValidationError) ErrorName() string {
+	return "SetProjectMemberUsergroupRoleRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RevokeCurrentAuthTokenResponseValidationError) Error() string {
+func (e SetProjectMemberUsergroupRoleRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13459,14 +14359,14 @@ func (e RevokeCurrentAuthTokenResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRevokeCurrentAuthTokenResponse.%s: %s%s",
+		"invalid %sSetProjectMemberUsergroupRoleRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RevokeCurrentAuthTokenResponseValidationError{}
+var _ error = SetProjectMemberUsergroupRoleRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -13474,149 +14374,85 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RevokeCurrentAuthTokenResponseValidationError{}
+} = SetProjectMemberUsergroupRoleRequestValidationError{}
 
-// Validate checks the field values on IssueRepresentativeAuthTokenRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *IssueRepresentativeAuthTokenRequest) Validate() error {
+// Validate checks the field values on SetProjectMemberUsergroupRoleResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *SetProjectMemberUsergroupRoleResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on IssueRepresentativeAuthTokenRequest
+// ValidateAll checks the field values on SetProjectMemberUsergroupRoleResponse
 // with the rules defined in the proto definition for this message. If any
 // rules are violated, the result is a list of violation errors wrapped in
-// IssueRepresentativeAuthTokenRequestMultiError, or nil if none found.
-func (m *IssueRepresentativeAuthTokenRequest) ValidateAll() error {
+// SetProjectMemberUsergroupRoleResponseMultiError, or nil if none found.
+func (m *SetProjectMemberUsergroupRoleResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *IssueRepresentativeAuthTokenRequest) validate(all bool) error {
+func (m *SetProjectMemberUsergroupRoleResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if err := m._validateEmail(m.GetEmail()); err != nil {
-		err = IssueRepresentativeAuthTokenRequestValidationError{
-			field:  "Email",
-			reason: "value must be a valid email address",
-			cause:  err,
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	// no validation rules for TtlMinutes
-
 	if len(errors) > 0 {
-		return IssueRepresentativeAuthTokenRequestMultiError(errors)
+		return SetProjectMemberUsergroupRoleResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-func (m *IssueRepresentativeAuthTokenRequest) _validateHostname(host string) error {
-	s := strings.ToLower(strings.TrimSuffix(host, "."))
+// SetProjectMemberUsergroupRoleResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// SetProjectMemberUsergroupRoleResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SetProjectMemberUsergroupRoleResponseMultiError []error
 
-	if len(host) > 253 {
-		return errors.New("hostname cannot exceed 253 characters")
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetProjectMemberUsergroupRoleResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
 	}
+	return strings.Join(msgs, "; ")
+}
 
-	for _, part := range strings.Split(s, ".") {
-		if l := len(part); l == 0 || l > 63 {
-			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
-		}
+// AllErrors returns a list of validation violation errors.
+func (m SetProjectMemberUsergroupRoleResponseMultiError) AllErrors() []error { return m }
 
-		if part[0] == '-' {
-			return errors.New("hostname parts cannot begin with hyphens")
-		}
+// SetProjectMemberUsergroupRoleResponseValidationError is the validation error
+// returned by SetProjectMemberUsergroupRoleResponse.Validate if the
+// designated constraints aren't met.
+type SetProjectMemberUsergroupRoleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
 
-		if part[len(part)-1] == '-' {
-			return errors.New("hostname parts cannot end with hyphens")
-		}
+// Field function returns field value.
+func (e SetProjectMemberUsergroupRoleResponseValidationError) Field() string { return e.field }
 
-		for _, r := range part {
-			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
-				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
-			}
-		}
-	}
-
-	return nil
-}
-
-func (m *IssueRepresentativeAuthTokenRequest) _validateEmail(addr string) error {
-	a, err := mail.ParseAddress(addr)
-	if err != nil {
-		return err
-	}
-	addr = a.Address
-
-	if len(addr) > 254 {
-		return errors.New("email addresses cannot exceed 254 characters")
-	}
-
-	parts := strings.SplitN(addr, "@", 2)
-
-	if len(parts[0]) > 64 {
-		return errors.New("email address local phrase cannot exceed 64 characters")
-	}
-
-	return m._validateHostname(parts[1])
-}
-
-// IssueRepresentativeAuthTokenRequestMultiError is an error wrapping multiple
-// validation errors returned by
-// IssueRepresentativeAuthTokenRequest.ValidateAll() if the designated
-// constraints aren't met.
-type IssueRepresentativeAuthTokenRequestMultiError []error
-
-// Error returns a concatenation of all the error messages it wraps.
-func (m IssueRepresentativeAuthTokenRequestMultiError) Error() string {
-	var msgs []string
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
-	}
-	return strings.Join(msgs, "; ")
-}
-
-// AllErrors returns a list of validation violation errors.
-func (m IssueRepresentativeAuthTokenRequestMultiError) AllErrors() []error { return m }
-
-// IssueRepresentativeAuthTokenRequestValidationError is the validation error
-// returned by IssueRepresentativeAuthTokenRequest.Validate if the designated
-// constraints aren't met.
-type IssueRepresentativeAuthTokenRequestValidationError struct {
-	field  string
-	reason string
-	cause  error
-	key    bool
-}
-
-// Field function returns field value.
-func (e IssueRepresentativeAuthTokenRequestValidationError) Field() string { return e.field }
-
-// Reason function returns reason value.
-func (e IssueRepresentativeAuthTokenRequestValidationError) Reason() string { return e.reason }
+// Reason function returns reason value.
+func (e SetProjectMemberUsergroupRoleResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e IssueRepresentativeAuthTokenRequestValidationError) Cause() error { return e.cause }
+func (e SetProjectMemberUsergroupRoleResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e IssueRepresentativeAuthTokenRequestValidationError) Key() bool { return e.key }
+func (e SetProjectMemberUsergroupRoleResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e IssueRepresentativeAuthTokenRequestValidationError) ErrorName() string {
-	return "IssueRepresentativeAuthTokenRequestValidationError"
+func (e SetProjectMemberUsergroupRoleResponseValidationError) ErrorName() string {
+	return "SetProjectMemberUsergroupRoleResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e IssueRepresentativeAuthTokenRequestValidationError) Error() string {
+func (e SetProjectMemberUsergroupRoleResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13628,14 +14464,14 @@ func (e IssueRepresentativeAuthTokenRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sIssueRepresentativeAuthTokenRequest.%s: %s%s",
+		"invalid %sSetProjectMemberUsergroupRoleResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = IssueRepresentativeAuthTokenRequestValidationError{}
+var _ error = SetProjectMemberUsergroupRoleResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -13643,48 +14479,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = IssueRepresentativeAuthTokenRequestValidationError{}
+} = SetProjectMemberUsergroupRoleResponseValidationError{}
 
-// Validate checks the field values on IssueRepresentativeAuthTokenResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *IssueRepresentativeAuthTokenResponse) Validate() error {
+// Validate checks the field values on GetCurrentUserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCurrentUserRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on IssueRepresentativeAuthTokenResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// IssueRepresentativeAuthTokenResponseMultiError, or nil if none found.
-func (m *IssueRepresentativeAuthTokenResponse) ValidateAll() error {
+// ValidateAll checks the field values on GetCurrentUserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCurrentUserRequestMultiError, or nil if none found.
+func (m *GetCurrentUserRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *IssueRepresentativeAuthTokenResponse) validate(all bool) error {
+func (m *GetCurrentUserRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Token
-
 	if len(errors) > 0 {
-		return IssueRepresentativeAuthTokenResponseMultiError(errors)
+		return GetCurrentUserRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// IssueRepresentativeAuthTokenResponseMultiError is an error wrapping multiple
-// validation errors returned by
-// IssueRepresentativeAuthTokenResponse.ValidateAll() if the designated
+// GetCurrentUserRequestMultiError is an error wrapping multiple validation
+// errors returned by GetCurrentUserRequest.ValidateAll() if the designated
 // constraints aren't met.
-type IssueRepresentativeAuthTokenResponseMultiError []error
+type GetCurrentUserRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m IssueRepresentativeAuthTokenResponseMultiError) Error() string {
+func (m GetCurrentUserRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13693,12 +14525,11 @@ func (m IssueRepresentativeAuthTokenResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m IssueRepresentativeAuthTokenResponseMultiError) AllErrors() []error { return m }
+func (m GetCurrentUserRequestMultiError) AllErrors() []error { return m }
 
-// IssueRepresentativeAuthTokenResponseValidationError is the validation error
-// returned by IssueRepresentativeAuthTokenResponse.Validate if the designated
-// constraints aren't met.
-type IssueRepresentativeAuthTokenResponseValidationError struct {
+// GetCurrentUserRequestValidationError is the validation error returned by
+// GetCurrentUserRequest.Validate if the designated constraints aren't met.
+type GetCurrentUserRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13706,24 +14537,24 @@ type IssueRepresentativeAuthTokenResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e IssueRepresentativeAuthTokenResponseValidationError) Field() string { return e.field }
+func (e GetCurrentUserRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e IssueRepresentativeAuthTokenResponseValidationError) Reason() string { return e.reason }
+func (e GetCurrentUserRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e IssueRepresentativeAuthTokenResponseValidationError) Cause() error { return e.cause }
+func (e GetCurrentUserRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e IssueRepresentativeAuthTokenResponseValidationError) Key() bool { return e.key }
+func (e GetCurrentUserRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e IssueRepresentativeAuthTokenResponseValidationError) ErrorName() string {
-	return "IssueRepresentativeAuthTokenResponseValidationError"
+func (e GetCurrentUserRequestValidationError) ErrorName() string {
+	return "GetCurrentUserRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e IssueRepresentativeAuthTokenResponseValidationError) Error() string {
+func (e GetCurrentUserRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13735,14 +14566,14 @@ func (e IssueRepresentativeAuthTokenResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sIssueRepresentativeAuthTokenResponse.%s: %s%s",
+		"invalid %sGetCurrentUserRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = IssueRepresentativeAuthTokenResponseValidationError{}
+var _ error = GetCurrentUserRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -13750,46 +14581,102 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = IssueRepresentativeAuthTokenResponseValidationError{}
+} = GetCurrentUserRequestValidationError{}
 
-// Validate checks the field values on RevokeServiceAuthTokenRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetCurrentUserResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RevokeServiceAuthTokenRequest) Validate() error {
+func (m *GetCurrentUserResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RevokeServiceAuthTokenRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// RevokeServiceAuthTokenRequestMultiError, or nil if none found.
-func (m *RevokeServiceAuthTokenRequest) ValidateAll() error {
+// ValidateAll checks the field values on GetCurrentUserResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCurrentUserResponseMultiError, or nil if none found.
+func (m *GetCurrentUserResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RevokeServiceAuthTokenRequest) validate(all bool) error {
+func (m *GetCurrentUserResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for TokenId
+	if all {
+		switch v := interface{}(m.GetUser()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetCurrentUserResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetCurrentUserResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetCurrentUserResponseValidationError{
+				field:  "User",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPreferences()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetCurrentUserResponseValidationError{
+					field:  "Preferences",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetCurrentUserResponseValidationError{
+					field:  "Preferences",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPreferences()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetCurrentUserResponseValidationError{
+				field:  "Preferences",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return RevokeServiceAuthTokenRequestMultiError(errors)
+		return GetCurrentUserResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// RevokeServiceAuthTokenRequestMultiError is an error wrapping multiple
-// validation errors returned by RevokeServiceAuthTokenRequest.ValidateAll()
-// if the designated constraints aren't met.
-type RevokeServiceAuthTokenRequestMultiError []error
+// GetCurrentUserResponseMultiError is an error wrapping multiple validation
+// errors returned by GetCurrentUserResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetCurrentUserResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RevokeServiceAuthTokenRequestMultiError) Error() string {
+func (m GetCurrentUserResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13798,12 +14685,11 @@ func (m RevokeServiceAuthTokenRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RevokeServiceAuthTokenRequestMultiError) AllErrors() []error { return m }
+func (m GetCurrentUserResponseMultiError) AllErrors() []error { return m }
 
-// RevokeServiceAuthTokenRequestValidationError is the validation error
-// returned by RevokeServiceAuthTokenRequest.Validate if the designated
-// constraints aren't met.
-type RevokeServiceAuthTokenRequestValidationError struct {
+// GetCurrentUserResponseValidationError is the validation error returned by
+// GetCurrentUserResponse.Validate if the designated constraints aren't met.
+type GetCurrentUserResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13811,24 +14697,24 @@ type RevokeServiceAuthTokenRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RevokeServiceAuthTokenRequestValidationError) Field() string { return e.field }
+func (e GetCurrentUserResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RevokeServiceAuthTokenRequestValidationError) Reason() string { return e.reason }
+func (e GetCurrentUserResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RevokeServiceAuthTokenRequestValidationError) Cause() error { return e.cause }
+func (e GetCurrentUserResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RevokeServiceAuthTokenRequestValidationError) Key() bool { return e.key }
+func (e GetCurrentUserResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RevokeServiceAuthTokenRequestValidationError) ErrorName() string {
-	return "RevokeServiceAuthTokenRequestValidationError"
+func (e GetCurrentUserResponseValidationError) ErrorName() string {
+	return "GetCurrentUserResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RevokeServiceAuthTokenRequestValidationError) Error() string {
+func (e GetCurrentUserResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13840,14 +14726,14 @@ func (e RevokeServiceAuthTokenRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRevokeServiceAuthTokenRequest.%s: %s%s",
+		"invalid %sGetCurrentUserResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RevokeServiceAuthTokenRequestValidationError{}
+var _ error = GetCurrentUserResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -13855,44 +14741,106 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RevokeServiceAuthTokenRequestValidationError{}
+} = GetCurrentUserResponseValidationError{}
 
-// Validate checks the field values on RevokeServiceAuthTokenResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RevokeServiceAuthTokenResponse) Validate() error {
+// Validate checks the field values on GetUserRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetUserRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RevokeServiceAuthTokenResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// RevokeServiceAuthTokenResponseMultiError, or nil if none found.
-func (m *RevokeServiceAuthTokenResponse) ValidateAll() error {
+// ValidateAll checks the field values on GetUserRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetUserRequestMultiError,
+// or nil if none found.
+func (m *GetUserRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RevokeServiceAuthTokenResponse) validate(all bool) error {
+func (m *GetUserRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if err := m._validateEmail(m.GetEmail()); err != nil {
+		err = GetUserRequestValidationError{
+			field:  "Email",
+			reason: "value must be a valid email address",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
-		return RevokeServiceAuthTokenResponseMultiError(errors)
+		return GetUserRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// RevokeServiceAuthTokenResponseMultiError is an error wrapping multiple
-// validation errors returned by RevokeServiceAuthTokenResponse.ValidateAll()
-// if the designated constraints aren't met.
-type RevokeServiceAuthTokenResponseMultiError []error
+func (m *GetUserRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *GetUserRequest) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+// GetUserRequestMultiError is an error wrapping multiple validation errors
+// returned by GetUserRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetUserRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RevokeServiceAuthTokenResponseMultiError) Error() string {
+func (m GetUserRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13901,12 +14849,11 @@ func (m RevokeServiceAuthTokenResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RevokeServiceAuthTokenResponseMultiError) AllErrors() []error { return m }
+func (m GetUserRequestMultiError) AllErrors() []error { return m }
 
-// RevokeServiceAuthTokenResponseValidationError is the validation error
-// returned by RevokeServiceAuthTokenResponse.Validate if the designated
-// constraints aren't met.
-type RevokeServiceAuthTokenResponseValidationError struct {
+// GetUserRequestValidationError is the validation error returned by
+// GetUserRequest.Validate if the designated constraints aren't met.
+type GetUserRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13914,24 +14861,22 @@ type RevokeServiceAuthTokenResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e RevokeServiceAuthTokenResponseValidationError) Field() string { return e.field }
+func (e GetUserRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RevokeServiceAuthTokenResponseValidationError) Reason() string { return e.reason }
+func (e GetUserRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RevokeServiceAuthTokenResponseValidationError) Cause() error { return e.cause }
+func (e GetUserRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RevokeServiceAuthTokenResponseValidationError) Key() bool { return e.key }
+func (e GetUserRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RevokeServiceAuthTokenResponseValidationError) ErrorName() string {
-	return "RevokeServiceAuthTokenResponseValidationError"
-}
+func (e GetUserRequestValidationError) ErrorName() string { return "GetUserRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e RevokeServiceAuthTokenResponseValidationError) Error() string {
+func (e GetUserRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13943,14 +14888,14 @@ func (e RevokeServiceAuthTokenResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRevokeServiceAuthTokenResponse.%s: %s%s",
+		"invalid %sGetUserRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RevokeServiceAuthTokenResponseValidationError{}
+var _ error = GetUserRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -13958,57 +14903,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RevokeServiceAuthTokenResponseValidationError{}
+} = GetUserRequestValidationError{}
 
-// Validate checks the field values on IssueServiceAuthTokenRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *IssueServiceAuthTokenRequest) Validate() error {
+// Validate checks the field values on GetUserResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetUserResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on IssueServiceAuthTokenRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetUserResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// IssueServiceAuthTokenRequestMultiError, or nil if none found.
-func (m *IssueServiceAuthTokenRequest) ValidateAll() error {
+// GetUserResponseMultiError, or nil if none found.
+func (m *GetUserResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *IssueServiceAuthTokenRequest) validate(all bool) error {
+func (m *GetUserResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetOrganizationName()) < 1 {
-		err := IssueServiceAuthTokenRequestValidationError{
-			field:  "OrganizationName",
-			reason: "value length must be at least 1 runes",
+	if all {
+		switch v := interface{}(m.GetUser()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetUserResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetUserResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetUserResponseValidationError{
+				field:  "User",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
-	// no validation rules for ServiceName
-
 	if len(errors) > 0 {
-		return IssueServiceAuthTokenRequestMultiError(errors)
+		return GetUserResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// IssueServiceAuthTokenRequestMultiError is an error wrapping multiple
-// validation errors returned by IssueServiceAuthTokenRequest.ValidateAll() if
-// the designated constraints aren't met.
-type IssueServiceAuthTokenRequestMultiError []error
+// GetUserResponseMultiError is an error wrapping multiple validation errors
+// returned by GetUserResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetUserResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m IssueServiceAuthTokenRequestMultiError) Error() string {
+func (m GetUserResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14017,12 +14978,11 @@ func (m IssueServiceAuthTokenRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m IssueServiceAuthTokenRequestMultiError) AllErrors() []error { return m }
+func (m GetUserResponseMultiError) AllErrors() []error { return m }
 
-// IssueServiceAuthTokenRequestValidationError is the validation error returned
-// by IssueServiceAuthTokenRequest.Validate if the designated constraints
-// aren't met.
-type IssueServiceAuthTokenRequestValidationError struct {
+// GetUserResponseValidationError is the validation error returned by
+// GetUserResponse.Validate if the designated constraints aren't met.
+type GetUserResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14030,24 +14990,22 @@ type IssueServiceAuthTokenRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e IssueServiceAuthTokenRequestValidationError) Field() string { return e.field }
+func (e GetUserResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e IssueServiceAuthTokenRequestValidationError) Reason() string { return e.reason }
+func (e GetUserResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e IssueServiceAuthTokenRequestValidationError) Cause() error { return e.cause }
+func (e GetUserResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e IssueServiceAuthTokenRequestValidationError) Key() bool { return e.key }
+func (e GetUserResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e IssueServiceAuthTokenRequestValidationError) ErrorName() string {
-	return "IssueServiceAuthTokenRequestValidationError"
-}
+func (e GetUserResponseValidationError) ErrorName() string { return "GetUserResponseValidationError" }
 
 // Error satisfies the builtin error interface
-func (e IssueServiceAuthTokenRequestValidationError) Error() string {
+func (e GetUserResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14059,14 +15017,14 @@ func (e IssueServiceAuthTokenRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sIssueServiceAuthTokenRequest.%s: %s%s",
+		"invalid %sGetUserResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = IssueServiceAuthTokenRequestValidationError{}
+var _ error = GetUserResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -14074,46 +15032,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = IssueServiceAuthTokenRequestValidationError{}
+} = GetUserResponseValidationError{}
 
-// Validate checks the field values on IssueServiceAuthTokenResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *IssueServiceAuthTokenResponse) Validate() error {
+// Validate checks the field values on UserPreferences with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UserPreferences) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on IssueServiceAuthTokenResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// IssueServiceAuthTokenResponseMultiError, or nil if none found.
-func (m *IssueServiceAuthTokenResponse) ValidateAll() error {
+// ValidateAll checks the field values on UserPreferences with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserPreferencesMultiError, or nil if none found.
+func (m *UserPreferences) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *IssueServiceAuthTokenResponse) validate(all bool) error {
+func (m *UserPreferences) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Token
+	if m.TimeZone != nil {
+		// no validation rules for TimeZone
+	}
 
 	if len(errors) > 0 {
-		return IssueServiceAuthTokenResponseMultiError(errors)
+		return UserPreferencesMultiError(errors)
 	}
 
 	return nil
 }
 
-// IssueServiceAuthTokenResponseMultiError is an error wrapping multiple
-// validation errors returned by IssueServiceAuthTokenResponse.ValidateAll()
-// if the designated constraints aren't met.
-type IssueServiceAuthTokenResponseMultiError []error
+// UserPreferencesMultiError is an error wrapping multiple validation errors
+// returned by UserPreferences.ValidateAll() if the designated constraints
+// aren't met.
+type UserPreferencesMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m IssueServiceAuthTokenResponseMultiError) Error() string {
+func (m UserPreferencesMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14122,12 +15082,11 @@ func (m IssueServiceAuthTokenResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m IssueServiceAuthTokenResponseMultiError) AllErrors() []error { return m }
+func (m UserPreferencesMultiError) AllErrors() []error { return m }
 
-// IssueServiceAuthTokenResponseValidationError is the validation error
-// returned by IssueServiceAuthTokenResponse.Validate if the designated
-// constraints aren't met.
-type IssueServiceAuthTokenResponseValidationError struct {
+// UserPreferencesValidationError is the validation error returned by
+// UserPreferences.Validate if the designated constraints aren't met.
+type UserPreferencesValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14135,24 +15094,22 @@ type IssueServiceAuthTokenResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e IssueServiceAuthTokenResponseValidationError) Field() string { return e.field }
+func (e UserPreferencesValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e IssueServiceAuthTokenResponseValidationError) Reason() string { return e.reason }
+func (e UserPreferencesValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e IssueServiceAuthTokenResponseValidationError) Cause() error { return e.cause }
+func (e UserPreferencesValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e IssueServiceAuthTokenResponseValidationError) Key() bool { return e.key }
+func (e UserPreferencesValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e IssueServiceAuthTokenResponseValidationError) ErrorName() string {
-	return "IssueServiceAuthTokenResponseValidationError"
-}
+func (e UserPreferencesValidationError) ErrorName() string { return "UserPreferencesValidationError" }
 
 // Error satisfies the builtin error interface
-func (e IssueServiceAuthTokenResponseValidationError) Error() string {
+func (e UserPreferencesValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14164,14 +15121,14 @@ func (e IssueServiceAuthTokenResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sIssueServiceAuthTokenResponse.%s: %s%s",
+		"invalid %sUserPreferences.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = IssueServiceAuthTokenResponseValidationError{}
+var _ error = UserPreferencesValidationError{}
 
 var _ interface {
 	Field() string
@@ -14179,57 +15136,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = IssueServiceAuthTokenResponseValidationError{}
+} = UserPreferencesValidationError{}
 
-// Validate checks the field values on ListServiceAuthTokensRequest with the
+// Validate checks the field values on UpdateUserPreferencesRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListServiceAuthTokensRequest) Validate() error {
+func (m *UpdateUserPreferencesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListServiceAuthTokensRequest with the
+// ValidateAll checks the field values on UpdateUserPreferencesRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListServiceAuthTokensRequestMultiError, or nil if none found.
-func (m *ListServiceAuthTokensRequest) ValidateAll() error {
+// UpdateUserPreferencesRequestMultiError, or nil if none found.
+func (m *UpdateUserPreferencesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListServiceAuthTokensRequest) validate(all bool) error {
+func (m *UpdateUserPreferencesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetOrganizationName()) < 1 {
-		err := ListServiceAuthTokensRequestValidationError{
-			field:  "OrganizationName",
-			reason: "value length must be at least 1 runes",
+	if all {
+		switch v := interface{}(m.GetPreferences()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateUserPreferencesRequestValidationError{
+					field:  "Preferences",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateUserPreferencesRequestValidationError{
+					field:  "Preferences",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetPreferences()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateUserPreferencesRequestValidationError{
+				field:  "Preferences",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
-	// no validation rules for ServiceName
-
 	if len(errors) > 0 {
-		return ListServiceAuthTokensRequestMultiError(errors)
+		return UpdateUserPreferencesRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListServiceAuthTokensRequestMultiError is an error wrapping multiple
-// validation errors returned by ListServiceAuthTokensRequest.ValidateAll() if
+// UpdateUserPreferencesRequestMultiError is an error wrapping multiple
+// validation errors returned by UpdateUserPreferencesRequest.ValidateAll() if
 // the designated constraints aren't met.
-type ListServiceAuthTokensRequestMultiError []error
+type UpdateUserPreferencesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListServiceAuthTokensRequestMultiError) Error() string {
+func (m UpdateUserPreferencesRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14238,12 +15211,12 @@ func (m ListServiceAuthTokensRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListServiceAuthTokensRequestMultiError) AllErrors() []error { return m }
+func (m UpdateUserPreferencesRequestMultiError) AllErrors() []error { return m }
 
-// ListServiceAuthTokensRequestValidationError is the validation error returned
-// by ListServiceAuthTokensRequest.Validate if the designated constraints
+// UpdateUserPreferencesRequestValidationError is the validation error returned
+// by UpdateUserPreferencesRequest.Validate if the designated constraints
 // aren't met.
-type ListServiceAuthTokensRequestValidationError struct {
+type UpdateUserPreferencesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14251,24 +15224,24 @@ type ListServiceAuthTokensRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListServiceAuthTokensRequestValidationError) Field() string { return e.field }
+func (e UpdateUserPreferencesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListServiceAuthTokensRequestValidationError) Reason() string { return e.reason }
+func (e UpdateUserPreferencesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListServiceAuthTokensRequestValidationError) Cause() error { return e.cause }
+func (e UpdateUserPreferencesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListServiceAuthTokensRequestValidationError) Key() bool { return e.key }
+func (e UpdateUserPreferencesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListServiceAuthTokensRequestValidationError) ErrorName() string {
-	return "ListServiceAuthTokensRequestValidationError"
+func (e UpdateUserPreferencesRequestValidationError) ErrorName() string {
+	return "UpdateUserPreferencesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListServiceAuthTokensRequestValidationError) Error() string {
+func (e UpdateUserPreferencesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14280,14 +15253,14 @@ func (e ListServiceAuthTokensRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListServiceAuthTokensRequest.%s: %s%s",
+		"invalid %sUpdateUserPreferencesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListServiceAuthTokensRequestValidationError{}
+var _ error = UpdateUserPreferencesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -14295,78 +15268,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListServiceAuthTokensRequestValidationError{}
+} = UpdateUserPreferencesRequestValidationError{}
 
-// Validate checks the field values on ListServiceAuthTokensResponse with the
+// Validate checks the field values on UpdateUserPreferencesResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListServiceAuthTokensResponse) Validate() error {
+func (m *UpdateUserPreferencesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListServiceAuthTokensResponse with
+// ValidateAll checks the field values on UpdateUserPreferencesResponse with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the result is a list of violation errors wrapped in
-// ListServiceAuthTokensResponseMultiError, or nil if none found.
-func (m *ListServiceAuthTokensResponse) ValidateAll() error {
+// UpdateUserPreferencesResponseMultiError, or nil if none found.
+func (m *UpdateUserPreferencesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListServiceAuthTokensResponse) validate(all bool) error {
+func (m *UpdateUserPreferencesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetTokens() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListServiceAuthTokensResponseValidationError{
-						field:  fmt.Sprintf("Tokens[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListServiceAuthTokensResponseValidationError{
-						field:  fmt.Sprintf("Tokens[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetPreferences()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateUserPreferencesResponseValidationError{
+					field:  "Preferences",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return ListServiceAuthTokensResponseValidationError{
-					field:  fmt.Sprintf("Tokens[%v]", idx),
+				errors = append(errors, UpdateUserPreferencesResponseValidationError{
+					field:  "Preferences",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPreferences()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateUserPreferencesResponseValidationError{
+				field:  "Preferences",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
 	if len(errors) > 0 {
-		return ListServiceAuthTokensResponseMultiError(errors)
+		return UpdateUserPreferencesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListServiceAuthTokensResponseMultiError is an error wrapping multiple
-// validation errors returned by ListServiceAuthTokensResponse.ValidateAll()
+// UpdateUserPreferencesResponseMultiError is an error wrapping multiple
+// validation errors returned by UpdateUserPreferencesResponse.ValidateAll()
 // if the designated constraints aren't met.
-type ListServiceAuthTokensResponseMultiError []error
+type UpdateUserPreferencesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListServiceAuthTokensResponseMultiError) Error() string {
+func (m UpdateUserPreferencesResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14375,12 +15343,12 @@ func (m ListServiceAuthTokensResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListServiceAuthTokensResponseMultiError) AllErrors() []error { return m }
+func (m UpdateUserPreferencesResponseMultiError) AllErrors() []error { return m }
 
-// ListServiceAuthTokensResponseValidationError is the validation error
-// returned by ListServiceAuthTokensResponse.Validate if the designated
+// UpdateUserPreferencesResponseValidationError is the validation error
+// returned by UpdateUserPreferencesResponse.Validate if the designated
 // constraints aren't met.
-type ListServiceAuthTokensResponseValidationError struct {
+type UpdateUserPreferencesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14388,24 +15356,24 @@ type ListServiceAuthTokensResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListServiceAuthTokensResponseValidationError) Field() string { return e.field }
+func (e UpdateUserPreferencesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListServiceAuthTokensResponseValidationError) Reason() string { return e.reason }
+func (e UpdateUserPreferencesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListServiceAuthTokensResponseValidationError) Cause() error { return e.cause }
+func (e UpdateUserPreferencesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListServiceAuthTokensResponseValidationError) Key() bool { return e.key }
+func (e UpdateUserPreferencesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListServiceAuthTokensResponseValidationError) ErrorName() string {
-	return "ListServiceAuthTokensResponseValidationError"
+func (e UpdateUserPreferencesResponseValidationError) ErrorName() string {
+	return "UpdateUserPreferencesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListServiceAuthTokensResponseValidationError) Error() string {
+func (e UpdateUserPreferencesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14417,14 +15385,14 @@ func (e ListServiceAuthTokensResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListServiceAuthTokensResponse.%s: %s%s",
+		"invalid %sUpdateUserPreferencesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListServiceAuthTokensResponseValidationError{}
+var _ error = UpdateUserPreferencesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -14432,46 +15400,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListServiceAuthTokensResponseValidationError{}
+} = UpdateUserPreferencesResponseValidationError{}
 
-// Validate checks the field values on GetGithubRepoStatusRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListBookmarksRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetGithubRepoStatusRequest) Validate() error {
+func (m *ListBookmarksRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetGithubRepoStatusRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListBookmarksRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetGithubRepoStatusRequestMultiError, or nil if none found.
-func (m *GetGithubRepoStatusRequest) ValidateAll() error {
+// ListBookmarksRequestMultiError, or nil if none found.
+func (m *ListBookmarksRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetGithubRepoStatusRequest) validate(all bool) error {
+func (m *ListBookmarksRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for GithubUrl
+	// no validation rules for ProjectId
+
+	// no validation rules for ResourceKind
+
+	// no validation rules for ResourceName
 
 	if len(errors) > 0 {
-		return GetGithubRepoStatusRequestMultiError(errors)
+		return ListBookmarksRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetGithubRepoStatusRequestMultiError is an error wrapping multiple
-// validation errors returned by GetGithubRepoStatusRequest.ValidateAll() if
-// the designated constraints aren't met.
-type GetGithubRepoStatusRequestMultiError []error
+// ListBookmarksRequestMultiError is an error wrapping multiple validation
+// errors returned by ListBookmarksRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListBookmarksRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetGithubRepoStatusRequestMultiError) Error() string {
+func (m ListBookmarksRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14480,11 +15452,11 @@ func (m GetGithubRepoStatusRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetGithubRepoStatusRequestMultiError) AllErrors() []error { return m }
+func (m ListBookmarksRequestMultiError) AllErrors() []error { return m }
 
-// GetGithubRepoStatusRequestValidationError is the validation error returned
-// by GetGithubRepoStatusRequest.Validate if the designated constraints aren't met.
-type GetGithubRepoStatusRequestValidationError struct {
+// ListBookmarksRequestValidationError is the validation error returned by
+// ListBookmarksRequest.Validate if the designated constraints aren't met.
+type ListBookmarksRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14492,24 +15464,24 @@ type GetGithubRepoStatusRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetGithubRepoStatusRequestValidationError) Field() string { return e.field }
+func (e ListBookmarksRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetGithubRepoStatusRequestValidationError) Reason() string { return e.reason }
+func (e ListBookmarksRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetGithubRepoStatusRequestValidationError) Cause() error { return e.cause }
+func (e ListBookmarksRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetGithubRepoStatusRequestValidationError) Key() bool { return e.key }
+func (e ListBookmarksRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetGithubRepoStatusRequestValidationError) ErrorName() string {
-	return "GetGithubRepoStatusRequestValidationError"
+func (e ListBookmarksRequestValidationError) ErrorName() string {
+	return "ListBookmarksRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetGithubRepoStatusRequestValidationError) Error() string {
+func (e ListBookmarksRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14521,14 +15493,14 @@ func (e GetGithubRepoStatusRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetGithubRepoStatusRequest.%s: %s%s",
+		"invalid %sListBookmarksRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetGithubRepoStatusRequestValidationError{}
+var _ error = ListBookmarksRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -14536,50 +15508,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetGithubRepoStatusRequestValidationError{}
+} = ListBookmarksRequestValidationError{}
 
-// Validate checks the field values on GetGithubRepoStatusResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListBookmarksResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetGithubRepoStatusResponse) Validate() error {
+func (m *ListBookmarksResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetGithubRepoStatusResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListBookmarksResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetGithubRepoStatusResponseMultiError, or nil if none found.
-func (m *GetGithubRepoStatusResponse) ValidateAll() error {
+// ListBookmarksResponseMultiError, or nil if none found.
+func (m *ListBookmarksResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetGithubRepoStatusResponse) validate(all bool) error {
+func (m *ListBookmarksResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for HasAccess
+	for idx, item := range m.GetBookmarks() {
+		_, _ = idx, item
 
-	// no validation rules for GrantAccessUrl
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListBookmarksResponseValidationError{
+						field:  fmt.Sprintf("Bookmarks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListBookmarksResponseValidationError{
+						field:  fmt.Sprintf("Bookmarks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListBookmarksResponseValidationError{
+					field:  fmt.Sprintf("Bookmarks[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-	// no validation rules for DefaultBranch
+	}
 
 	if len(errors) > 0 {
-		return GetGithubRepoStatusResponseMultiError(errors)
+		return ListBookmarksResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetGithubRepoStatusResponseMultiError is an error wrapping multiple
-// validation errors returned by GetGithubRepoStatusResponse.ValidateAll() if
-// the designated constraints aren't met.
-type GetGithubRepoStatusResponseMultiError []error
+// ListBookmarksResponseMultiError is an error wrapping multiple validation
+// errors returned by ListBookmarksResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListBookmarksResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetGithubRepoStatusResponseMultiError) Error() string {
+func (m ListBookmarksResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14588,12 +15588,11 @@ func (m GetGithubRepoStatusResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetGithubRepoStatusResponseMultiError) AllErrors() []error { return m }
+func (m ListBookmarksResponseMultiError) AllErrors() []error { return m }
 
-// GetGithubRepoStatusResponseValidationError is the validation error returned
-// by GetGithubRepoStatusResponse.Validate if the designated constraints
-// aren't met.
-type GetGithubRepoStatusResponseValidationError struct {
+// ListBookmarksResponseValidationError is the validation error returned by
+// ListBookmarksResponse.Validate if the designated constraints aren't met.
+type ListBookmarksResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14601,24 +15600,24 @@ type GetGithubRepoStatusResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetGithubRepoStatusResponseValidationError) Field() string { return e.field }
+func (e ListBookmarksResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetGithubRepoStatusResponseValidationError) Reason() string { return e.reason }
+func (e ListBookmarksResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetGithubRepoStatusResponseValidationError) Cause() error { return e.cause }
+func (e ListBookmarksResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetGithubRepoStatusResponseValidationError) Key() bool { return e.key }
+func (e ListBookmarksResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetGithubRepoStatusResponseValidationError) ErrorName() string {
-	return "GetGithubRepoStatusResponseValidationError"
+func (e ListBookmarksResponseValidationError) ErrorName() string {
+	return "ListBookmarksResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetGithubRepoStatusResponseValidationError) Error() string {
+func (e ListBookmarksResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14630,14 +15629,14 @@ func (e GetGithubRepoStatusResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetGithubRepoStatusResponse.%s: %s%s",
+		"invalid %sListBookmarksResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetGithubRepoStatusResponseValidationError{}
+var _ error = ListBookmarksResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -14645,44 +15644,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetGithubRepoStatusResponseValidationError{}
+} = ListBookmarksResponseValidationError{}
 
-// Validate checks the field values on GetGithubUserStatusRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetBookmarkRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetGithubUserStatusRequest) Validate() error {
+func (m *GetBookmarkRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetGithubUserStatusRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetBookmarkRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetGithubUserStatusRequestMultiError, or nil if none found.
-func (m *GetGithubUserStatusRequest) ValidateAll() error {
+// GetBookmarkRequestMultiError, or nil if none found.
+func (m *GetBookmarkRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetGithubUserStatusRequest) validate(all bool) error {
+func (m *GetBookmarkRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for BookmarkId
+
 	if len(errors) > 0 {
-		return GetGithubUserStatusRequestMultiError(errors)
+		return GetBookmarkRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetGithubUserStatusRequestMultiError is an error wrapping multiple
-// validation errors returned by GetGithubUserStatusRequest.ValidateAll() if
-// the designated constraints aren't met.
-type GetGithubUserStatusRequestMultiError []error
+// GetBookmarkRequestMultiError is an error wrapping multiple validation errors
+// returned by GetBookmarkRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetBookmarkRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetGithubUserStatusRequestMultiError) Error() string {
+func (m GetBookmarkRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14691,11 +15692,11 @@ func (m GetGithubUserStatusRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetGithubUserStatusRequestMultiError) AllErrors() []error { return m }
+func (m GetBookmarkRequestMultiError) AllErrors() []error { return m }
 
-// GetGithubUserStatusRequestValidationError is the validation error returned
-// by GetGithubUserStatusRequest.Validate if the designated constraints aren't met.
-type GetGithubUserStatusRequestValidationError struct {
+// GetBookmarkRequestValidationError is the validation error returned by
+// GetBookmarkRequest.Validate if the designated constraints aren't met.
+type GetBookmarkRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14703,24 +15704,24 @@ type GetGithubUserStatusRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetGithubUserStatusRequestValidationError) Field() string { return e.field }
+func (e GetBookmarkRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetGithubUserStatusRequestValidationError) Reason() string { return e.reason }
+func (e GetBookmarkRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetGithubUserStatusRequestValidationError) Cause() error { return e.cause }
+func (e GetBookmarkRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetGithubUserStatusRequestValidationError) Key() bool { return e.key }
+func (e GetBookmarkRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetGithubUserStatusRequestValidationError) ErrorName() string {
-	return "GetGithubUserStatusRequestValidationError"
+func (e GetBookmarkRequestValidationError) ErrorName() string {
+	return "GetBookmarkRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetGithubUserStatusRequestValidationError) Error() string {
+func (e GetBookmarkRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14732,14 +15733,14 @@ func (e GetGithubUserStatusRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetGithubUserStatusRequest.%s: %s%s",
+		"invalid %sGetBookmarkRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetGithubUserStatusRequestValidationError{}
+var _ error = GetBookmarkRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -14747,52 +15748,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetGithubUserStatusRequestValidationError{}
+} = GetBookmarkRequestValidationError{}
 
-// Validate checks the field values on GetGithubUserStatusResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetBookmarkResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetGithubUserStatusResponse) Validate() error {
+func (m *GetBookmarkResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetGithubUserStatusResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetBookmarkResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetGithubUserStatusResponseMultiError, or nil if none found.
-func (m *GetGithubUserStatusResponse) ValidateAll() error {
+// GetBookmarkResponseMultiError, or nil if none found.
+func (m *GetBookmarkResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetGithubUserStatusResponse) validate(all bool) error {
+func (m *GetBookmarkResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for HasAccess
-
-	// no validation rules for GrantAccessUrl
-
-	// no validation rules for AccessToken
-
-	// no validation rules for Account
+	if all {
+		switch v := interface{}(m.GetBookmark()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetBookmarkResponseValidationError{
+					field:  "Bookmark",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetBookmarkResponseValidationError{
+					field:  "Bookmark",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBookmark()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetBookmarkResponseValidationError{
+				field:  "Bookmark",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return GetGithubUserStatusResponseMultiError(errors)
+		return GetBookmarkResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetGithubUserStatusResponseMultiError is an error wrapping multiple
-// validation errors returned by GetGithubUserStatusResponse.ValidateAll() if
-// the designated constraints aren't met.
-type GetGithubUserStatusResponseMultiError []error
+// GetBookmarkResponseMultiError is an error wrapping multiple validation
+// errors returned by GetBookmarkResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetBookmarkResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetGithubUserStatusResponseMultiError) Error() string {
+func (m GetBookmarkResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14801,12 +15823,11 @@ func (m GetGithubUserStatusResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetGithubUserStatusResponseMultiError) AllErrors() []error { return m }
+func (m GetBookmarkResponseMultiError) AllErrors() []error { return m }
 
-// GetGithubUserStatusResponseValidationError is the validation error returned
-// by GetGithubUserStatusResponse.Validate if the designated constraints
-// aren't met.
-type GetGithubUserStatusResponseValidationError struct {
+// GetBookmarkResponseValidationError is the validation error returned by
+// GetBookmarkResponse.Validate if the designated constraints aren't met.
+type GetBookmarkResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14814,24 +15835,24 @@ type GetGithubUserStatusResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetGithubUserStatusResponseValidationError) Field() string { return e.field }
+func (e GetBookmarkResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetGithubUserStatusResponseValidationError) Reason() string { return e.reason }
+func (e GetBookmarkResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetGithubUserStatusResponseValidationError) Cause() error { return e.cause }
+func (e GetBookmarkResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetGithubUserStatusResponseValidationError) Key() bool { return e.key }
+func (e GetBookmarkResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetGithubUserStatusResponseValidationError) ErrorName() string {
-	return "GetGithubUserStatusResponseValidationError"
+func (e GetBookmarkResponseValidationError) ErrorName() string {
+	return "GetBookmarkResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetGithubUserStatusResponseValidationError) Error() string {
+func (e GetBookmarkResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14843,14 +15864,14 @@ func (e GetGithubUserStatusResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetGithubUserStatusResponse.%s: %s%s",
+		"invalid %sGetBookmarkResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetGithubUserStatusResponseValidationError{}
+var _ error = GetBookmarkResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -14858,66 +15879,60 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetGithubUserStatusResponseValidationError{}
+} = GetBookmarkResponseValidationError{}
 
-// Validate checks the field values on GetGitCredentialsRequest with the rules
+// Validate checks the field values on CreateBookmarkRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetGitCredentialsRequest) Validate() error {
+func (m *CreateBookmarkRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetGitCredentialsRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on CreateBookmarkRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetGitCredentialsRequestMultiError, or nil if none found.
-func (m *GetGitCredentialsRequest) ValidateAll() error {
+// CreateBookmarkRequestMultiError, or nil if none found.
+func (m *CreateBookmarkRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetGitCredentialsRequest) validate(all bool) error {
+func (m *CreateBookmarkRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := GetGitCredentialsRequestValidationError{
-			field:  "Organization",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for DisplayName
 
-	if utf8.RuneCountInString(m.GetProject()) < 1 {
-		err := GetGitCredentialsRequestValidationError{
-			field:  "Project",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Description
+
+	// no validation rules for Data
+
+	// no validation rules for ResourceKind
+
+	// no validation rules for ResourceName
+
+	// no validation rules for ProjectId
+
+	// no validation rules for Default
+
+	// no validation rules for Shared
 
 	if len(errors) > 0 {
-		return GetGitCredentialsRequestMultiError(errors)
+		return CreateBookmarkRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetGitCredentialsRequestMultiError is an error wrapping multiple validation
-// errors returned by GetGitCredentialsRequest.ValidateAll() if the designated
+// CreateBookmarkRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateBookmarkRequest.ValidateAll() if the designated
 // constraints aren't met.
-type GetGitCredentialsRequestMultiError []error
+type CreateBookmarkRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetGitCredentialsRequestMultiError) Error() string {
+func (m CreateBookmarkRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14926,11 +15941,11 @@ func (m GetGitCredentialsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetGitCredentialsRequestMultiError) AllErrors() []error { return m }
+func (m CreateBookmarkRequestMultiError) AllErrors() []error { return m }
 
-// GetGitCredentialsRequestValidationError is the validation error returned by
-// GetGitCredentialsRequest.Validate if the designated constraints aren't met.
-type GetGitCredentialsRequestValidationError struct {
+// CreateBookmarkRequestValidationError is the validation error returned by
+// CreateBookmarkRequest.Validate if the designated constraints aren't met.
+type CreateBookmarkRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14938,24 +15953,24 @@ type GetGitCredentialsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetGitCredentialsRequestValidationError) Field() string { return e.field }
+func (e CreateBookmarkRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetGitCredentialsRequestValidationError) Reason() string { return e.reason }
+func (e CreateBookmarkRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetGitCredentialsRequestValidationError) Cause() error { return e.cause }
+func (e CreateBookmarkRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetGitCredentialsRequestValidationError) Key() bool { return e.key }
+func (e CreateBookmarkRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetGitCredentialsRequestValidationError) ErrorName() string {
-	return "GetGitCredentialsRequestValidationError"
+func (e CreateBookmarkRequestValidationError) ErrorName() string {
+	return "CreateBookmarkRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetGitCredentialsRequestValidationError) Error() string {
+func (e CreateBookmarkRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14967,14 +15982,14 @@ func (e GetGitCredentialsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetGitCredentialsRequest.%s: %s%s",
+		"invalid %sCreateBookmarkRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetGitCredentialsRequestValidationError{}
+var _ error = CreateBookmarkRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -14982,54 +15997,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetGitCredentialsRequestValidationError{}
+} = CreateBookmarkRequestValidationError{}
 
-// Validate checks the field values on GetGitCredentialsResponse with the rules
+// Validate checks the field values on CreateBookmarkResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetGitCredentialsResponse) Validate() error {
+func (m *CreateBookmarkResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetGitCredentialsResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on CreateBookmarkResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetGitCredentialsResponseMultiError, or nil if none found.
-func (m *GetGitCredentialsResponse) ValidateAll() error {
+// CreateBookmarkResponseMultiError, or nil if none found.
+func (m *CreateBookmarkResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetGitCredentialsResponse) validate(all bool) error {
+func (m *CreateBookmarkResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for RepoUrl
-
-	// no validation rules for Username
-
-	// no validation rules for Password
-
-	// no validation rules for Subpath
-
-	// no validation rules for ProdBranch
+	if all {
+		switch v := interface{}(m.GetBookmark()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateBookmarkResponseValidationError{
+					field:  "Bookmark",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateBookmarkResponseValidationError{
+					field:  "Bookmark",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBookmark()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateBookmarkResponseValidationError{
+				field:  "Bookmark",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return GetGitCredentialsResponseMultiError(errors)
+		return CreateBookmarkResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetGitCredentialsResponseMultiError is an error wrapping multiple validation
-// errors returned by GetGitCredentialsResponse.ValidateAll() if the
-// designated constraints aren't met.
-type GetGitCredentialsResponseMultiError []error
+// CreateBookmarkResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateBookmarkResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateBookmarkResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetGitCredentialsResponseMultiError) Error() string {
+func (m CreateBookmarkResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -15038,11 +16072,11 @@ func (m GetGitCredentialsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetGitCredentialsResponseMultiError) AllErrors() []error { return m }
+func (m CreateBookmarkResponseMultiError) AllErrors() []error { return m }
 
-// GetGitCredentialsResponseValidationError is the validation error returned by
-// GetGitCredentialsResponse.Validate if the designated constraints aren't met.
-type GetGitCredentialsResponseValidationError struct {
+// CreateBookmarkResponseValidationError is the validation error returned by
+// CreateBookmarkResponse.Validate if the designated constraints aren't met.
+type CreateBookmarkResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -15050,24 +16084,24 @@ type GetGitCredentialsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetGitCredentialsResponseValidationError) Field() string { return e.field }
+func (e CreateBookmarkResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetGitCredentialsResponseValidationError) Reason() string { return e.reason }
+func (e CreateBookmarkResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetGitCredentialsResponseValidationError) Cause() error { return e.cause }
+func (e CreateBookmarkResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetGitCredentialsResponseValidationError) Key() bool { return e.key }
+func (e CreateBookmarkResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetGitCredentialsResponseValidationError) ErrorName() string {
-	return "GetGitCredentialsResponseValidationError"
+func (e CreateBookmarkResponseValidationError) ErrorName() string {
+	return "CreateBookmarkResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetGitCredentialsResponseValidationError) Error() string {
+func (e CreateBookmarkResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -15079,14 +16113,14 @@ func (e GetGitCredentialsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetGitCredentialsResponse.%s: %s%s",
+		"invalid %sCreateBookmarkResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetGitCredentialsResponseValidationError{}
+var _ error = CreateBookmarkResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -15094,77 +16128,56 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetGitCredentialsResponseValidationError{}
+} = CreateBookmarkResponseValidationError{}
 
-// Validate checks the field values on CreateWhitelistedDomainRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on UpdateBookmarkRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateWhitelistedDomainRequest) Validate() error {
+func (m *UpdateBookmarkRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateWhitelistedDomainRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// CreateWhitelistedDomainRequestMultiError, or nil if none found.
-func (m *CreateWhitelistedDomainRequest) ValidateAll() error {
+// ValidateAll checks the field values on UpdateBookmarkRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateBookmarkRequestMultiError, or nil if none found.
+func (m *UpdateBookmarkRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateWhitelistedDomainRequest) validate(all bool) error {
+func (m *UpdateBookmarkRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := CreateWhitelistedDomainRequestValidationError{
-			field:  "Organization",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for BookmarkId
 
-	if utf8.RuneCountInString(m.GetDomain()) < 1 {
-		err := CreateWhitelistedDomainRequestValidationError{
-			field:  "Domain",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for DisplayName
 
-	if utf8.RuneCountInString(m.GetRole()) < 1 {
-		err := CreateWhitelistedDomainRequestValidationError{
-			field:  "Role",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Description
+
+	// no validation rules for Data
+
+	// no validation rules for Default
+
+	// no validation rules for Shared
 
 	if len(errors) > 0 {
-		return CreateWhitelistedDomainRequestMultiError(errors)
+		return UpdateBookmarkRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateWhitelistedDomainRequestMultiError is an error wrapping multiple
-// validation errors returned by CreateWhitelistedDomainRequest.ValidateAll()
-// if the designated constraints aren't met.
-type CreateWhitelistedDomainRequestMultiError []error
+// UpdateBookmarkRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateBookmarkRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateBookmarkRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateWhitelistedDomainRequestMultiError) Error() string {
+func (m UpdateBookmarkRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -15173,12 +16186,11 @@ func (m CreateWhitelistedDomainRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateWhitelistedDomainRequestMultiError) AllErrors() []error { return m }
+func (m UpdateBookmarkRequestMultiError) AllErrors() []error { return m }
 
-// CreateWhitelistedDomainRequestValidationError is the validation error
-// returned by CreateWhitelistedDomainRequest.Validate if the designated
-// constraints aren't met.
-type CreateWhitelistedDomainRequestValidationError struct {
+// UpdateBookmarkRequestValidationError is the validation error returned by
+// UpdateBookmarkRequest.Validate if the designated constraints aren't met.
+type UpdateBookmarkRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -15186,24 +16198,24 @@ type CreateWhitelistedDomainRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateWhitelistedDomainRequestValidationError) Field() string { return e.field }
+func (e UpdateBookmarkRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateWhitelistedDomainRequestValidationError) Reason() string { return e.reason }
+func (e UpdateBookmarkRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateWhitelistedDomainRequestValidationError) Cause() error { return e.cause }
+func (e UpdateBookmarkRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateWhitelistedDomainRequestValidationError) Key() bool { return e.key }
+func (e UpdateBookmarkRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateWhitelistedDomainRequestValidationError) ErrorName() string {
-	return "CreateWhitelistedDomainRequestValidationError"
+func (e UpdateBookmarkRequestValidationError) ErrorName() string {
+	return "UpdateBookmarkRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateWhitelistedDomainRequestValidationError) Error() string {
+func (e UpdateBookmarkRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -15215,14 +16227,14 @@ func (e CreateWhitelistedDomainRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateWhitelistedDomainRequest.%s: %s%s",
+		"invalid %sUpdateBookmarkRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateWhitelistedDomainRequestValidationError{}
+var _ error = UpdateBookmarkRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -15230,24 +16242,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateWhitelistedDomainRequestValidationError{}
+} = UpdateBookmarkRequestValidationError{}
 
-// Validate checks the field values on CreateWhitelistedDomainResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on UpdateBookmarkResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateWhitelistedDomainResponse) Validate() error {
+func (m *UpdateBookmarkResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateWhitelistedDomainResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// CreateWhitelistedDomainResponseMultiError, or nil if none found.
-func (m *CreateWhitelistedDomainResponse) ValidateAll() error {
+// ValidateAll checks the field values on UpdateBookmarkResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateBookmarkResponseMultiError, or nil if none found.
+func (m *UpdateBookmarkResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateWhitelistedDomainResponse) validate(all bool) error {
+func (m *UpdateBookmarkResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -15255,19 +16267,19 @@ func (m *CreateWhitelistedDomainResponse) validate(all bool) error {
 	var errors []error
 
 	if len(errors) > 0 {
-		return CreateWhitelistedDomainResponseMultiError(errors)
+		return UpdateBookmarkResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateWhitelistedDomainResponseMultiError is an error wrapping multiple
-// validation errors returned by CreateWhitelistedDomainResponse.ValidateAll()
-// if the designated constraints aren't met.
-type CreateWhitelistedDomainResponseMultiError []error
+// UpdateBookmarkResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateBookmarkResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateBookmarkResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateWhitelistedDomainResponseMultiError) Error() string {
+func (m UpdateBookmarkResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -15276,12 +16288,11 @@ func (m CreateWhitelistedDomainResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateWhitelistedDomainResponseMultiError) AllErrors() []error { return m }
+func (m UpdateBookmarkResponseMultiError) AllErrors() []error { return m }
 
-// CreateWhitelistedDomainResponseValidationError is the validation error
-// returned by CreateWhitelistedDomainResponse.Validate if the designated
-// constraints aren't met.
-type CreateWhitelistedDomainResponseValidationError struct {
+// UpdateBookmarkResponseValidationError is the validation error returned by
+// UpdateBookmarkResponse.Validate if the designated constraints aren't met.
+type UpdateBookmarkResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -15289,24 +16300,24 @@ type CreateWhitelistedDomainResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateWhitelistedDomainResponseValidationError) Field() string { return e.field }
+func (e UpdateBookmarkResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateWhitelistedDomainResponseValidationError) Reason() string { return e.reason }
+func (e UpdateBookmarkResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateWhitelistedDomainResponseValidationError) Cause() error { return e.cause }
+func (e UpdateBookmarkResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateWhitelistedDomainResponseValidationError) Key() bool { return e.key }
+func (e UpdateBookmarkResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateWhitelistedDomainResponseValidationError) ErrorName() string {
-	return "CreateWhitelistedDomainResponseValidationError"
+func (e UpdateBookmarkResponseValidationError) ErrorName() string {
+	return "UpdateBookmarkResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateWhitelistedDomainResponseValidationError) Error() string {
+func (e UpdateBookmarkResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -15318,14 +16329,14 @@ func (e CreateWhitelistedDomainResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateWhitelistedDomainResponse.%s: %s%s",
+		"invalid %sUpdateBookmarkResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateWhitelistedDomainResponseValidationError{}
+var _ error = UpdateBookmarkResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -15333,66 +16344,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateWhitelistedDomainResponseValidationError{}
+} = UpdateBookmarkResponseValidationError{}
 
-// Validate checks the field values on RemoveWhitelistedDomainRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on RemoveBookmarkRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RemoveWhitelistedDomainRequest) Validate() error {
+func (m *RemoveBookmarkRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RemoveWhitelistedDomainRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// RemoveWhitelistedDomainRequestMultiError, or nil if none found.
-func (m *RemoveWhitelistedDomainRequest) ValidateAll() error {
+// ValidateAll checks the field values on RemoveBookmarkRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveBookmarkRequestMultiError, or nil if none found.
+func (m *RemoveBookmarkRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RemoveWhitelistedDomainRequest) validate(all bool) error {
+func (m *RemoveBookmarkRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := RemoveWhitelistedDomainRequestValidationError{
-			field:  "Organization",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetDomain()) < 1 {
-		err := RemoveWhitelistedDomainRequestValidationError{
-			field:  "Domain",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for BookmarkId
 
 	if len(errors) > 0 {
-		return RemoveWhitelistedDomainRequestMultiError(errors)
+		return RemoveBookmarkRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// RemoveWhitelistedDomainRequestMultiError is an error wrapping multiple
-// validation errors returned by RemoveWhitelistedDomainRequest.ValidateAll()
-// if the designated constraints aren't met.
-type RemoveWhitelistedDomainRequestMultiError []error
+// RemoveBookmarkRequestMultiError is an error wrapping multiple validation
+// errors returned by RemoveBookmarkRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveBookmarkRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RemoveWhitelistedDomainRequestMultiError) Error() string {
+func (m RemoveBookmarkRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -15401,12 +16392,11 @@ func (m RemoveWhitelistedDomainRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RemoveWhitelistedDomainRequestMultiError) AllErrors() []error { return m }
+func (m RemoveBookmarkRequestMultiError) AllErrors() []error { return m }
 
-// RemoveWhitelistedDomainRequestValidationError is the validation error
-// returned by RemoveWhitelistedDomainRequest.Validate if the designated
-// constraints aren't met.
-type RemoveWhitelistedDomainRequestValidationError struct {
+// RemoveBookmarkRequestValidationError is the validation error returned by
+// RemoveBookmarkRequest.Validate if the designated constraints aren't met.
+type RemoveBookmarkRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -15414,24 +16404,24 @@ type RemoveWhitelistedDomainRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RemoveWhitelistedDomainRequestValidationError) Field() string { return e.field }
+func (e RemoveBookmarkRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RemoveWhitelistedDomainRequestValidationError) Reason() string { return e.reason }
+func (e RemoveBookmarkRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RemoveWhitelistedDomainRequestValidationError) Cause() error { return e.cause }
+func (e RemoveBookmarkRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RemoveWhitelistedDomainRequestValidationError) Key() bool { return e.key }
+func (e RemoveBookmarkRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RemoveWhitelistedDomainRequestValidationError) ErrorName() string {
-	return "RemoveWhitelistedDomainRequestValidationError"
+func (e RemoveBookmarkRequestValidationError) ErrorName() string {
+	return "RemoveBookmarkRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RemoveWhitelistedDomainRequestValidationError) Error() string {
+func (e RemoveBookmarkRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -15443,14 +16433,14 @@ func (e RemoveWhitelistedDomainRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRemoveWhitelistedDomainRequest.%s: %s%s",
+		"invalid %sRemoveBookmarkRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RemoveWhitelistedDomainRequestValidationError{}
+var _ error = RemoveBookmarkRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -15458,24 +16448,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RemoveWhitelistedDomainRequestValidationError{}
+} = RemoveBookmarkRequestValidationError{}
 
-// Validate checks the field values on RemoveWhitelistedDomainResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on RemoveBookmarkResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RemoveWhitelistedDomainResponse) Validate() error {
+func (m *RemoveBookmarkResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RemoveWhitelistedDomainResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// RemoveWhitelistedDomainResponseMultiError, or nil if none found.
-func (m *RemoveWhitelistedDomainResponse) ValidateAll() error {
+// ValidateAll checks the field values on RemoveBookmarkResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveBookmarkResponseMultiError, or nil if none found.
+func (m *RemoveBookmarkResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RemoveWhitelistedDomainResponse) validate(all bool) error {
+func (m *RemoveBookmarkResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -15483,19 +16473,19 @@ func (m *RemoveWhitelistedDomainResponse) validate(all bool) error {
 	var errors []error
 
 	if len(errors) > 0 {
-		return RemoveWhitelistedDomainResponseMultiError(errors)
+		return RemoveBookmarkResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// RemoveWhitelistedDomainResponseMultiError is an error wrapping multiple
-// validation errors returned by RemoveWhitelistedDomainResponse.ValidateAll()
-// if the designated constraints aren't met.
-type RemoveWhitelistedDomainResponseMultiError []error
+// RemoveBookmarkResponseMultiError is an error wrapping multiple validation
+// errors returned by RemoveBookmarkResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveBookmarkResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RemoveWhitelistedDomainResponseMultiError) Error() string {
+func (m RemoveBookmarkResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -15504,12 +16494,11 @@ func (m RemoveWhitelistedDomainResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RemoveWhitelistedDomainResponseMultiError) AllErrors() []error { return m }
+func (m RemoveBookmarkResponseMultiError) AllErrors() []error { return m }
 
-// RemoveWhitelistedDomainResponseValidationError is the validation error
-// returned by RemoveWhitelistedDomainResponse.Validate if the designated
-// constraints aren't met.
-type RemoveWhitelistedDomainResponseValidationError struct {
+// RemoveBookmarkResponseValidationError is the validation error returned by
+// RemoveBookmarkResponse.Validate if the designated constraints aren't met.
+type RemoveBookmarkResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -15517,24 +16506,24 @@ type RemoveWhitelistedDomainResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e RemoveWhitelistedDomainResponseValidationError) Field() string { return e.field }
+func (e RemoveBookmarkResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RemoveWhitelistedDomainResponseValidationError) Reason() string { return e.reason }
+func (e RemoveBookmarkResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RemoveWhitelistedDomainResponseValidationError) Cause() error { return e.cause }
+func (e RemoveBookmarkResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RemoveWhitelistedDomainResponseValidationError) Key() bool { return e.key }
+func (e RemoveBookmarkResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RemoveWhitelistedDomainResponseValidationError) ErrorName() string {
-	return "RemoveWhitelistedDomainResponseValidationError"
+func (e RemoveBookmarkResponseValidationError) ErrorName() string {
+	return "RemoveBookmarkResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RemoveWhitelistedDomainResponseValidationError) Error() string {
+func (e RemoveBookmarkResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -15546,14 +16535,14 @@ func (e RemoveWhitelistedDomainResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRemoveWhitelistedDomainResponse.%s: %s%s",
+		"invalid %sRemoveBookmarkResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RemoveWhitelistedDomainResponseValidationError{}
+var _ error = RemoveBookmarkResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -15561,33 +16550,33 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RemoveWhitelistedDomainResponseValidationError{}
+} = RemoveBookmarkResponseValidationError{}
 
-// Validate checks the field values on ListWhitelistedDomainsRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on SearchUsersRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListWhitelistedDomainsRequest) Validate() error {
+func (m *SearchUsersRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListWhitelistedDomainsRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// ListWhitelistedDomainsRequestMultiError, or nil if none found.
-func (m *ListWhitelistedDomainsRequest) ValidateAll() error {
+// ValidateAll checks the field values on SearchUsersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchUsersRequestMultiError, or nil if none found.
+func (m *SearchUsersRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListWhitelistedDomainsRequest) validate(all bool) error {
+func (m *SearchUsersRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := ListWhitelistedDomainsRequestValidationError{
-			field:  "Organization",
+	if utf8.RuneCountInString(m.GetEmailPattern()) < 1 {
+		err := SearchUsersRequestValidationError{
+			field:  "EmailPattern",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -15596,20 +16585,37 @@ func (m *ListWhitelistedDomainsRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if m.GetPageSize() != 0 {
+
+		if m.GetPageSize() > 1000 {
+			err := SearchUsersRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be less than or equal to 1000",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for PageToken
+
 	if len(errors) > 0 {
-		return ListWhitelistedDomainsRequestMultiError(errors)
+		return SearchUsersRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListWhitelistedDomainsRequestMultiError is an error wrapping multiple
-// validation errors returned by ListWhitelistedDomainsRequest.ValidateAll()
-// if the designated constraints aren't met.
-type ListWhitelistedDomainsRequestMultiError []error
+// SearchUsersRequestMultiError is an error wrapping multiple validation errors
+// returned by SearchUsersRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SearchUsersRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListWhitelistedDomainsRequestMultiError) Error() string {
+func (m SearchUsersRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -15618,12 +16624,11 @@ func (m ListWhitelistedDomainsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListWhitelistedDomainsRequestMultiError) AllErrors() []error { return m }
+func (m SearchUsersRequestMultiError) AllErrors() []error { return m }
 
-// ListWhitelistedDomainsRequestValidationError is the validation error
-// returned by ListWhitelistedDomainsRequest.Validate if the designated
-// constraints aren't met.
-type ListWhitelistedDomainsRequestValidationError struct {
+// SearchUsersRequestValidationError is the validation error returned by
+// SearchUsersRequest.Validate if the designated constraints aren't met.
+type SearchUsersRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -15631,24 +16636,24 @@ type ListWhitelistedDomainsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListWhitelistedDomainsRequestValidationError) Field() string { return e.field }
+func (e SearchUsersRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListWhitelistedDomainsRequestValidationError) Reason() string { return e.reason }
+func (e SearchUsersRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListWhitelistedDomainsRequestValidationError) Cause() error { return e.cause }
+func (e SearchUsersRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListWhitelistedDomainsRequestValidationError) Key() bool { return e.key }
+func (e SearchUsersRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListWhitelistedDomainsRequestValidationError) ErrorName() string {
-	return "ListWhitelistedDomainsRequestValidationError"
+func (e SearchUsersRequestValidationError) ErrorName() string {
+	return "SearchUsersRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListWhitelistedDomainsRequestValidationError) Error() string {
+func (e SearchUsersRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -15660,14 +16665,14 @@ func (e ListWhitelistedDomainsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListWhitelistedDomainsRequest.%s: %s%s",
+		"invalid %sSearchUsersRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListWhitelistedDomainsRequestValidationError{}
+var _ error = SearchUsersRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -15675,47 +16680,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListWhitelistedDomainsRequestValidationError{}
+} = SearchUsersRequestValidationError{}
 
-// Validate checks the field values on ListWhitelistedDomainsResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on SearchUsersResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListWhitelistedDomainsResponse) Validate() error {
+func (m *SearchUsersResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListWhitelistedDomainsResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// ListWhitelistedDomainsResponseMultiError, or nil if none found.
-func (m *ListWhitelistedDomainsResponse) ValidateAll() error {
+// ValidateAll checks the field values on SearchUsersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchUsersResponseMultiError, or nil if none found.
+func (m *SearchUsersResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListWhitelistedDomainsResponse) validate(all bool) error {
+func (m *SearchUsersResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetDomains() {
+	for idx, item := range m.GetUsers() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListWhitelistedDomainsResponseValidationError{
-						field:  fmt.Sprintf("Domains[%v]", idx),
+					errors = append(errors, SearchUsersResponseValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ListWhitelistedDomainsResponseValidationError{
-						field:  fmt.Sprintf("Domains[%v]", idx),
+					errors = append(errors, SearchUsersResponseValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -15723,8 +16728,8 @@ func (m *ListWhitelistedDomainsResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ListWhitelistedDomainsResponseValidationError{
-					field:  fmt.Sprintf("Domains[%v]", idx),
+				return SearchUsersResponseValidationError{
+					field:  fmt.Sprintf("Users[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -15733,20 +16738,22 @@ func (m *ListWhitelistedDomainsResponse) validate(all bool) error {
 
 	}
 
+	// no validation rules for NextPageToken
+
 	if len(errors) > 0 {
-		return ListWhitelistedDomainsResponseMultiError(errors)
+		return SearchUsersResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListWhitelistedDomainsResponseMultiError is an error wrapping multiple
-// validation errors returned by ListWhitelistedDomainsResponse.ValidateAll()
-// if the designated constraints aren't met.
-type ListWhitelistedDomainsResponseMultiError []error
+// SearchUsersResponseMultiError is an error wrapping multiple validation
+// errors returned by SearchUsersResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SearchUsersResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListWhitelistedDomainsResponseMultiError) Error() string {
+func (m SearchUsersResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -15755,12 +16762,11 @@ func (m ListWhitelistedDomainsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListWhitelistedDomainsResponseMultiError) AllErrors() []error { return m }
+func (m SearchUsersResponseMultiError) AllErrors() []error { return m }
 
-// ListWhitelistedDomainsResponseValidationError is the validation error
-// returned by ListWhitelistedDomainsResponse.Validate if the designated
-// constraints aren't met.
-type ListWhitelistedDomainsResponseValidationError struct {
+// SearchUsersResponseValidationError is the validation error returned by
+// SearchUsersResponse.Validate if the designated constraints aren't met.
+type SearchUsersResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -15768,24 +16774,24 @@ type ListWhitelistedDomainsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListWhitelistedDomainsResponseValidationError) Field() string { return e.field }
+func (e SearchUsersResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListWhitelistedDomainsResponseValidationError) Reason() string { return e.reason }
+func (e SearchUsersResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListWhitelistedDomainsResponseValidationError) Cause() error { return e.cause }
+func (e SearchUsersResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListWhitelistedDomainsResponseValidationError) Key() bool { return e.key }
+func (e SearchUsersResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListWhitelistedDomainsResponseValidationError) ErrorName() string {
-	return "ListWhitelistedDomainsResponseValidationError"
+func (e SearchUsersResponseValidationError) ErrorName() string {
+	return "SearchUsersResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListWhitelistedDomainsResponseValidationError) Error() string {
+func (e SearchUsersResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -15797,14 +16803,14 @@ func (e ListWhitelistedDomainsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListWhitelistedDomainsResponse.%s: %s%s",
+		"invalid %sSearchUsersResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListWhitelistedDomainsResponseValidationError{}
+var _ error = SearchUsersResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -15812,90 +16818,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListWhitelistedDomainsResponseValidationError{}
+} = SearchUsersResponseValidationError{}
 
-// Validate checks the field values on CreateProjectWhitelistedDomainRequest
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *CreateProjectWhitelistedDomainRequest) Validate() error {
+// Validate checks the field values on RevokeCurrentAuthTokenRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeCurrentAuthTokenRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateProjectWhitelistedDomainRequest
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// CreateProjectWhitelistedDomainRequestMultiError, or nil if none found.
-func (m *CreateProjectWhitelistedDomainRequest) ValidateAll() error {
+// ValidateAll checks the field values on RevokeCurrentAuthTokenRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RevokeCurrentAuthTokenRequestMultiError, or nil if none found.
+func (m *RevokeCurrentAuthTokenRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateProjectWhitelistedDomainRequest) validate(all bool) error {
+func (m *RevokeCurrentAuthTokenRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := CreateProjectWhitelistedDomainRequestValidationError{
-			field:  "Organization",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetProject()) < 1 {
-		err := CreateProjectWhitelistedDomainRequestValidationError{
-			field:  "Project",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetDomain()) < 1 {
-		err := CreateProjectWhitelistedDomainRequestValidationError{
-			field:  "Domain",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetRole()) < 1 {
-		err := CreateProjectWhitelistedDomainRequestValidationError{
-			field:  "Role",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
 	if len(errors) > 0 {
-		return CreateProjectWhitelistedDomainRequestMultiError(errors)
+		return RevokeCurrentAuthTokenRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateProjectWhitelistedDomainRequestMultiError is an error wrapping
-// multiple validation errors returned by
-// CreateProjectWhitelistedDomainRequest.ValidateAll() if the designated
-// constraints aren't met.
-type CreateProjectWhitelistedDomainRequestMultiError []error
+// RevokeCurrentAuthTokenRequestMultiError is an error wrapping multiple
+// validation errors returned by RevokeCurrentAuthTokenRequest.ValidateAll()
+// if the designated constraints aren't met.
+type RevokeCurrentAuthTokenRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateProjectWhitelistedDomainRequestMultiError) Error() string {
+func (m RevokeCurrentAuthTokenRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -15904,12 +16864,12 @@ func (m CreateProjectWhitelistedDomainRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateProjectWhitelistedDomainRequestMultiError) AllErrors() []error { return m }
+func (m RevokeCurrentAuthTokenRequestMultiError) AllErrors() []error { return m }
 
-// CreateProjectWhitelistedDomainRequestValidationError is the validation error
-// returned by CreateProjectWhitelistedDomainRequest.Validate if the
-// designated constraints aren't met.
-type CreateProjectWhitelistedDomainRequestValidationError struct {
+// RevokeCurrentAuthTokenRequestValidationError is the validation error
+// returned by RevokeCurrentAuthTokenRequest.Validate if the designated
+// constraints aren't met.
+type RevokeCurrentAuthTokenRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -15917,24 +16877,24 @@ type CreateProjectWhitelistedDomainRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateProjectWhitelistedDomainRequestValidationError) Field() string { return e.field }
+func (e RevokeCurrentAuthTokenRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateProjectWhitelistedDomainRequestValidationError) Reason() string { return e.reason }
+func (e RevokeCurrentAuthTokenRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateProjectWhitelistedDomainRequestValidationError) Cause() error { return e.cause }
+func (e RevokeCurrentAuthTokenRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateProjectWhitelistedDomainRequestValidationError) Key() bool { return e.key }
+func (e RevokeCurrentAuthTokenRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateProjectWhitelistedDomainRequestValidationError) ErrorName() string {
-	return "CreateProjectWhitelistedDomainRequestValidationError"
+func (e RevokeCurrentAuthTokenRequestValidationError) ErrorName() string {
+	return "RevokeCurrentAuthTokenRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateProjectWhitelistedDomainRequestValidationError) Error() string {
+func (e RevokeCurrentAuthTokenRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -15946,14 +16906,14 @@ func (e CreateProjectWhitelistedDomainRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateProjectWhitelistedDomainRequest.%s: %s%s",
+		"invalid %sRevokeCurrentAuthTokenRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateProjectWhitelistedDomainRequestValidationError{}
+var _ error = RevokeCurrentAuthTokenRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -15961,47 +16921,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateProjectWhitelistedDomainRequestValidationError{}
+} = RevokeCurrentAuthTokenRequestValidationError{}
 
-// Validate checks the field values on CreateProjectWhitelistedDomainResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *CreateProjectWhitelistedDomainResponse) Validate() error {
+// Validate checks the field values on RevokeCurrentAuthTokenResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeCurrentAuthTokenResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// CreateProjectWhitelistedDomainResponse with the rules defined in the proto
-// definition for this message. If any rules are violated, the result is a
-// list of violation errors wrapped in
-// CreateProjectWhitelistedDomainResponseMultiError, or nil if none found.
-func (m *CreateProjectWhitelistedDomainResponse) ValidateAll() error {
+// ValidateAll checks the field values on RevokeCurrentAuthTokenResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RevokeCurrentAuthTokenResponseMultiError, or nil if none found.
+func (m *RevokeCurrentAuthTokenResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateProjectWhitelistedDomainResponse) validate(all bool) error {
+func (m *RevokeCurrentAuthTokenResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for TokenId
+
 	if len(errors) > 0 {
-		return CreateProjectWhitelistedDomainResponseMultiError(errors)
+		return RevokeCurrentAuthTokenResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateProjectWhitelistedDomainResponseMultiError is an error wrapping
-// multiple validation errors returned by
-// CreateProjectWhitelistedDomainResponse.ValidateAll() if the designated
-// constraints aren't met.
-type CreateProjectWhitelistedDomainResponseMultiError []error
+// RevokeCurrentAuthTokenResponseMultiError is an error wrapping multiple
+// validation errors returned by RevokeCurrentAuthTokenResponse.ValidateAll()
+// if the designated constraints aren't met.
+type RevokeCurrentAuthTokenResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateProjectWhitelistedDomainResponseMultiError) Error() string {
+func (m RevokeCurrentAuthTokenResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -16010,37 +16969,37 @@ func (m CreateProjectWhitelistedDomainResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateProjectWhitelistedDomainResponseMultiError) AllErrors() []error { return m }
+func (m RevokeCurrentAuthTokenResponseMultiError) AllErrors() []error { return m }
 
-// CreateProjectWhitelistedDomainResponseValidationError is the validation
-// error returned by CreateProjectWhitelistedDomainResponse.Validate if the
-// designated constraints aren't met.
-type CreateProjectWhitelistedDomainResponseValidationError struct {
-	field  string
+// RevokeCurrentAuthTokenResponseValidationError is the validation error
+// returned by RevokeCurrentAuthTokenResponse.Validate if the designated
+// constraints aren't met.
+type RevokeCurrentAuthTokenResponseValidationError struct {
+	field  string
 	reason string
 	cause  error
 	key    bool
 }
 
 // Field function returns field value.
-func (e CreateProjectWhitelistedDomainResponseValidationError) Field() string { return e.field }
+func (e RevokeCurrentAuthTokenResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateProjectWhitelistedDomainResponseValidationError) Reason() string { return e.reason }
+func (e RevokeCurrentAuthTokenResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateProjectWhitelistedDomainResponseValidationError) Cause() error { return e.cause }
+func (e RevokeCurrentAuthTokenResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateProjectWhitelistedDomainResponseValidationError) Key() bool { return e.key }
+func (e RevokeCurrentAuthTokenResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateProjectWhitelistedDomainResponseValidationError) ErrorName() string {
-	return "CreateProjectWhitelistedDomainResponseValidationError"
+func (e RevokeCurrentAuthTokenResponseValidationError) ErrorName() string {
+	return "RevokeCurrentAuthTokenResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateProjectWhitelistedDomainResponseValidationError) Error() string {
+func (e RevokeCurrentAuthTokenResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -16052,14 +17011,14 @@ func (e CreateProjectWhitelistedDomainResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateProjectWhitelistedDomainResponse.%s: %s%s",
+		"invalid %sRevokeCurrentAuthTokenResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateProjectWhitelistedDomainResponseValidationError{}
+var _ error = RevokeCurrentAuthTokenResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -16067,35 +17026,36 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateProjectWhitelistedDomainResponseValidationError{}
+} = RevokeCurrentAuthTokenResponseValidationError{}
 
-// Validate checks the field values on RemoveProjectWhitelistedDomainRequest
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *RemoveProjectWhitelistedDomainRequest) Validate() error {
+// Validate checks the field values on IssueRepresentativeAuthTokenRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *IssueRepresentativeAuthTokenRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RemoveProjectWhitelistedDomainRequest
+// ValidateAll checks the field values on IssueRepresentativeAuthTokenRequest
 // with the rules defined in the proto definition for this message. If any
 // rules are violated, the result is a list of violation errors wrapped in
-// RemoveProjectWhitelistedDomainRequestMultiError, or nil if none found.
-func (m *RemoveProjectWhitelistedDomainRequest) ValidateAll() error {
+// IssueRepresentativeAuthTokenRequestMultiError, or nil if none found.
+func (m *IssueRepresentativeAuthTokenRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RemoveProjectWhitelistedDomainRequest) validate(all bool) error {
+func (m *IssueRepresentativeAuthTokenRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := RemoveProjectWhitelistedDomainRequestValidationError{
-			field:  "Organization",
-			reason: "value length must be at least 1 runes",
+	if err := m._validateEmail(m.GetEmail()); err != nil {
+		err = IssueRepresentativeAuthTokenRequestValidationError{
+			field:  "Email",
+			reason: "value must be a valid email address",
+			cause:  err,
 		}
 		if !all {
 			return err
@@ -16103,43 +17063,73 @@ func (m *RemoveProjectWhitelistedDomainRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetProject()) < 1 {
-		err := RemoveProjectWhitelistedDomainRequestValidationError{
-			field:  "Project",
-			reason: "value length must be at least 1 runes",
+	// no validation rules for TtlMinutes
+
+	if len(errors) > 0 {
+		return IssueRepresentativeAuthTokenRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *IssueRepresentativeAuthTokenRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
 		}
-		if !all {
-			return err
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
 		}
-		errors = append(errors, err)
-	}
 
-	if utf8.RuneCountInString(m.GetDomain()) < 1 {
-		err := RemoveProjectWhitelistedDomainRequestValidationError{
-			field:  "Domain",
-			reason: "value length must be at least 1 runes",
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
 		}
-		if !all {
-			return err
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
 		}
-		errors = append(errors, err)
 	}
 
-	if len(errors) > 0 {
-		return RemoveProjectWhitelistedDomainRequestMultiError(errors)
+	return nil
+}
+
+func (m *IssueRepresentativeAuthTokenRequest) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
 	}
+	addr = a.Address
 
-	return nil
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
 }
 
-// RemoveProjectWhitelistedDomainRequestMultiError is an error wrapping
-// multiple validation errors returned by
-// RemoveProjectWhitelistedDomainRequest.ValidateAll() if the designated
+// IssueRepresentativeAuthTokenRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// IssueRepresentativeAuthTokenRequest.ValidateAll() if the designated
 // constraints aren't met.
-type RemoveProjectWhitelistedDomainRequestMultiError []error
+type IssueRepresentativeAuthTokenRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RemoveProjectWhitelistedDomainRequestMultiError) Error() string {
+func (m IssueRepresentativeAuthTokenRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -16148,12 +17138,12 @@ func (m RemoveProjectWhitelistedDomainRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RemoveProjectWhitelistedDomainRequestMultiError) AllErrors() []error { return m }
+func (m IssueRepresentativeAuthTokenRequestMultiError) AllErrors() []error { return m }
 
-// RemoveProjectWhitelistedDomainRequestValidationError is the validation error
-// returned by RemoveProjectWhitelistedDomainRequest.Validate if the
-// designated constraints aren't met.
-type RemoveProjectWhitelistedDomainRequestValidationError struct {
+// IssueRepresentativeAuthTokenRequestValidationError is the validation error
+// returned by IssueRepresentativeAuthTokenRequest.Validate if the designated
+// constraints aren't met.
+type IssueRepresentativeAuthTokenRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -16161,24 +17151,24 @@ type RemoveProjectWhitelistedDomainRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RemoveProjectWhitelistedDomainRequestValidationError) Field() string { return e.field }
+func (e IssueRepresentativeAuthTokenRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RemoveProjectWhitelistedDomainRequestValidationError) Reason() string { return e.reason }
+func (e IssueRepresentativeAuthTokenRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RemoveProjectWhitelistedDomainRequestValidationError) Cause() error { return e.cause }
+func (e IssueRepresentativeAuthTokenRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RemoveProjectWhitelistedDomainRequestValidationError) Key() bool { return e.key }
+func (e IssueRepresentativeAuthTokenRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RemoveProjectWhitelistedDomainRequestValidationError) ErrorName() string {
-	return "RemoveProjectWhitelistedDomainRequestValidationError"
+func (e IssueRepresentativeAuthTokenRequestValidationError) ErrorName() string {
+	return "IssueRepresentativeAuthTokenRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RemoveProjectWhitelistedDomainRequestValidationError) Error() string {
+func (e IssueRepresentativeAuthTokenRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -16190,14 +17180,14 @@ func (e RemoveProjectWhitelistedDomainRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRemoveProjectWhitelistedDomainRequest.%s: %s%s",
+		"invalid %sIssueRepresentativeAuthTokenRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RemoveProjectWhitelistedDomainRequestValidationError{}
+var _ error = IssueRepresentativeAuthTokenRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -16205,47 +17195,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RemoveProjectWhitelistedDomainRequestValidationError{}
+} = IssueRepresentativeAuthTokenRequestValidationError{}
 
-// Validate checks the field values on RemoveProjectWhitelistedDomainResponse
+// Validate checks the field values on IssueRepresentativeAuthTokenResponse
 // with the rules defined in the proto definition for this message. If any
 // rules are violated, the first error encountered is returned, or nil if
 // there are no violations.
-func (m *RemoveProjectWhitelistedDomainResponse) Validate() error {
+func (m *IssueRepresentativeAuthTokenResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// RemoveProjectWhitelistedDomainResponse with the rules defined in the proto
-// definition for this message. If any rules are violated, the result is a
-// list of violation errors wrapped in
-// RemoveProjectWhitelistedDomainResponseMultiError, or nil if none found.
-func (m *RemoveProjectWhitelistedDomainResponse) ValidateAll() error {
+// ValidateAll checks the field values on IssueRepresentativeAuthTokenResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// IssueRepresentativeAuthTokenResponseMultiError, or nil if none found.
+func (m *IssueRepresentativeAuthTokenResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RemoveProjectWhitelistedDomainResponse) validate(all bool) error {
+func (m *IssueRepresentativeAuthTokenResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Token
+
 	if len(errors) > 0 {
-		return RemoveProjectWhitelistedDomainResponseMultiError(errors)
+		return IssueRepresentativeAuthTokenResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// RemoveProjectWhitelistedDomainResponseMultiError is an error wrapping
-// multiple validation errors returned by
-// RemoveProjectWhitelistedDomainResponse.ValidateAll() if the designated
+// IssueRepresentativeAuthTokenResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// IssueRepresentativeAuthTokenResponse.ValidateAll() if the designated
 // constraints aren't met.
-type RemoveProjectWhitelistedDomainResponseMultiError []error
+type IssueRepresentativeAuthTokenResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RemoveProjectWhitelistedDomainResponseMultiError) Error() string {
+func (m IssueRepresentativeAuthTokenResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -16254,12 +17245,12 @@ func (m RemoveProjectWhitelistedDomainResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RemoveProjectWhitelistedDomainResponseMultiError) AllErrors() []error { return m }
+func (m IssueRepresentativeAuthTokenResponseMultiError) AllErrors() []error { return m }
 
-// RemoveProjectWhitelistedDomainResponseValidationError is the validation
-// error returned by RemoveProjectWhitelistedDomainResponse.Validate if the
-// designated constraints aren't met.
-type RemoveProjectWhitelistedDomainResponseValidationError struct {
+// IssueRepresentativeAuthTokenResponseValidationError is the validation error
+// returned by IssueRepresentativeAuthTokenResponse.Validate if the designated
+// constraints aren't met.
+type IssueRepresentativeAuthTokenResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -16267,24 +17258,24 @@ type RemoveProjectWhitelistedDomainResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e RemoveProjectWhitelistedDomainResponseValidationError) Field() string { return e.field }
+func (e IssueRepresentativeAuthTokenResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RemoveProjectWhitelistedDomainResponseValidationError) Reason() string { return e.reason }
+func (e IssueRepresentativeAuthTokenResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RemoveProjectWhitelistedDomainResponseValidationError) Cause() error { return e.cause }
+func (e IssueRepresentativeAuthTokenResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RemoveProjectWhitelistedDomainResponseValidationError) Key() bool { return e.key }
+func (e IssueRepresentativeAuthTokenResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RemoveProjectWhitelistedDomainResponseValidationError) ErrorName() string {
-	return "RemoveProjectWhitelistedDomainResponseValidationError"
+func (e IssueRepresentativeAuthTokenResponseValidationError) ErrorName() string {
+	return "IssueRepresentativeAuthTokenResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RemoveProjectWhitelistedDomainResponseValidationError) Error() string {
+func (e IssueRepresentativeAuthTokenResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -16296,14 +17287,14 @@ func (e RemoveProjectWhitelistedDomainResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRemoveProjectWhitelistedDomainResponse.%s: %s%s",
+		"invalid %sIssueRepresentativeAuthTokenResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RemoveProjectWhitelistedDomainResponseValidationError{}
+var _ error = IssueRepresentativeAuthTokenResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -16311,68 +17302,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RemoveProjectWhitelistedDomainResponseValidationError{}
+} = IssueRepresentativeAuthTokenResponseValidationError{}
 
-// Validate checks the field values on ListProjectWhitelistedDomainsRequest
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *ListProjectWhitelistedDomainsRequest) Validate() error {
+// Validate checks the field values on RevokeServiceAuthTokenRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeServiceAuthTokenRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListProjectWhitelistedDomainsRequest
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// ListProjectWhitelistedDomainsRequestMultiError, or nil if none found.
-func (m *ListProjectWhitelistedDomainsRequest) ValidateAll() error {
+// ValidateAll checks the field values on RevokeServiceAuthTokenRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RevokeServiceAuthTokenRequestMultiError, or nil if none found.
+func (m *RevokeServiceAuthTokenRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListProjectWhitelistedDomainsRequest) validate(all bool) error {
+func (m *RevokeServiceAuthTokenRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
-		err := ListProjectWhitelistedDomainsRequestValidationError{
-			field:  "Organization",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetProject()) < 1 {
-		err := ListProjectWhitelistedDomainsRequestValidationError{
-			field:  "Project",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if len(errors) > 0 {
-		return ListProjectWhitelistedDomainsRequestMultiError(errors)
+	// no validation rules for TokenId
+
+	if len(errors) > 0 {
+		return RevokeServiceAuthTokenRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListProjectWhitelistedDomainsRequestMultiError is an error wrapping multiple
-// validation errors returned by
-// ListProjectWhitelistedDomainsRequest.ValidateAll() if the designated
-// constraints aren't met.
-type ListProjectWhitelistedDomainsRequestMultiError []error
+// RevokeServiceAuthTokenRequestMultiError is an error wrapping multiple
+// validation errors returned by RevokeServiceAuthTokenRequest.ValidateAll()
+// if the designated constraints aren't met.
+type RevokeServiceAuthTokenRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListProjectWhitelistedDomainsRequestMultiError) Error() string {
+func (m RevokeServiceAuthTokenRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -16381,12 +17350,12 @@ func (m ListProjectWhitelistedDomainsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListProjectWhitelistedDomainsRequestMultiError) AllErrors() []error { return m }
+func (m RevokeServiceAuthTokenRequestMultiError) AllErrors() []error { return m }
 
-// ListProjectWhitelistedDomainsRequestValidationError is the validation error
-// returned by ListProjectWhitelistedDomainsRequest.Validate if the designated
+// RevokeServiceAuthTokenRequestValidationError is the validation error
+// returned by RevokeServiceAuthTokenRequest.Validate if the designated
 // constraints aren't met.
-type ListProjectWhitelistedDomainsRequestValidationError struct {
+type RevokeServiceAuthTokenRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -16394,24 +17363,24 @@ type ListProjectWhitelistedDomainsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListProjectWhitelistedDomainsRequestValidationError) Field() string { return e.field }
+func (e RevokeServiceAuthTokenRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListProjectWhitelistedDomainsRequestValidationError) Reason() string { return e.reason }
+func (e RevokeServiceAuthTokenRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListProjectWhitelistedDomainsRequestValidationError) Cause() error { return e.cause }
+func (e RevokeServiceAuthTokenRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListProjectWhitelistedDomainsRequestValidationError) Key() bool { return e.key }
+func (e RevokeServiceAuthTokenRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListProjectWhitelistedDomainsRequestValidationError) ErrorName() string {
-	return "ListProjectWhitelistedDomainsRequestValidationError"
+func (e RevokeServiceAuthTokenRequestValidationError) ErrorName() string {
+	return "RevokeServiceAuthTokenRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListProjectWhitelistedDomainsRequestValidationError) Error() string {
+func (e RevokeServiceAuthTokenRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -16423,14 +17392,14 @@ func (e ListProjectWhitelistedDomainsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListProjectWhitelistedDomainsRequest.%s: %s%s",
+		"invalid %sRevokeServiceAuthTokenRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListProjectWhitelistedDomainsRequestValidationError{}
+var _ error = RevokeServiceAuthTokenRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -16438,80 +17407,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListProjectWhitelistedDomainsRequestValidationError{}
+} = RevokeServiceAuthTokenRequestValidationError{}
 
-// Validate checks the field values on ListProjectWhitelistedDomainsResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *ListProjectWhitelistedDomainsResponse) Validate() error {
+// Validate checks the field values on RevokeServiceAuthTokenResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeServiceAuthTokenResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListProjectWhitelistedDomainsResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// ListProjectWhitelistedDomainsResponseMultiError, or nil if none found.
-func (m *ListProjectWhitelistedDomainsResponse) ValidateAll() error {
+// ValidateAll checks the field values on RevokeServiceAuthTokenResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RevokeServiceAuthTokenResponseMultiError, or nil if none found.
+func (m *RevokeServiceAuthTokenResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListProjectWhitelistedDomainsResponse) validate(all bool) error {
+func (m *RevokeServiceAuthTokenResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetDomains() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListProjectWhitelistedDomainsResponseValidationError{
-						field:  fmt.Sprintf("Domains[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListProjectWhitelistedDomainsResponseValidationError{
-						field:  fmt.Sprintf("Domains[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListProjectWhitelistedDomainsResponseValidationError{
-					field:  fmt.Sprintf("Domains[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
 	if len(errors) > 0 {
-		return ListProjectWhitelistedDomainsResponseMultiError(errors)
+		return RevokeServiceAuthTokenResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListProjectWhitelistedDomainsResponseMultiError is an error wrapping
-// multiple validation errors returned by
-// ListProjectWhitelistedDomainsResponse.ValidateAll() if the designated
-// constraints aren't met.
-type ListProjectWhitelistedDomainsResponseMultiError []error
+// RevokeServiceAuthTokenResponseMultiError is an error wrapping multiple
+// validation errors returned by RevokeServiceAuthTokenResponse.ValidateAll()
+// if the designated constraints aren't met.
+type RevokeServiceAuthTokenResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListProjectWhitelistedDomainsResponseMultiError) Error() string {
+func (m RevokeServiceAuthTokenResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -16520,12 +17453,12 @@ func (m ListProjectWhitelistedDomainsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListProjectWhitelistedDomainsResponseMultiError) AllErrors() []error { return m }
+func (m RevokeServiceAuthTokenResponseMultiError) AllErrors() []error { return m }
 
-// ListProjectWhitelistedDomainsResponseValidationError is the validation error
-// returned by ListProjectWhitelistedDomainsResponse.Validate if the
-// designated constraints aren't met.
-type ListProjectWhitelistedDomainsResponseValidationError struct {
+// RevokeServiceAuthTokenResponseValidationError is the validation error
+// returned by RevokeServiceAuthTokenResponse.Validate if the designated
+// constraints aren't met.
+type RevokeServiceAuthTokenResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -16533,24 +17466,24 @@ type ListProjectWhitelistedDomainsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListProjectWhitelistedDomainsResponseValidationError) Field() string { return e.field }
+func (e RevokeServiceAuthTokenResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListProjectWhitelistedDomainsResponseValidationError) Reason() string { return e.reason }
+func (e RevokeServiceAuthTokenResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListProjectWhitelistedDomainsResponseValidationError) Cause() error { return e.cause }
+func (e RevokeServiceAuthTokenResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListProjectWhitelistedDomainsResponseValidationError) Key() bool { return e.key }
+func (e RevokeServiceAuthTokenResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListProjectWhitelistedDomainsResponseValidationError) ErrorName() string {
-	return "ListProjectWhitelistedDomainsResponseValidationError"
+func (e RevokeServiceAuthTokenResponseValidationError) ErrorName() string {
+	return "RevokeServiceAuthTokenResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListProjectWhitelistedDomainsResponseValidationError) Error() string {
+func (e RevokeServiceAuthTokenResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -16562,14 +17495,14 @@ func (e ListProjectWhitelistedDomainsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListProjectWhitelistedDomainsResponse.%s: %s%s",
+		"invalid %sRevokeServiceAuthTokenResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListProjectWhitelistedDomainsResponseValidationError{}
+var _ error = RevokeServiceAuthTokenResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -16577,48 +17510,57 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListProjectWhitelistedDomainsResponseValidationError{}
+} = RevokeServiceAuthTokenResponseValidationError{}
 
-// Validate checks the field values on GetRepoMetaRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on IssueServiceAuthTokenRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetRepoMetaRequest) Validate() error {
+func (m *IssueServiceAuthTokenRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetRepoMetaRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on IssueServiceAuthTokenRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetRepoMetaRequestMultiError, or nil if none found.
-func (m *GetRepoMetaRequest) ValidateAll() error {
+// IssueServiceAuthTokenRequestMultiError, or nil if none found.
+func (m *IssueServiceAuthTokenRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetRepoMetaRequest) validate(all bool) error {
+func (m *IssueServiceAuthTokenRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ProjectId
+	if utf8.RuneCountInString(m.GetOrganizationName()) < 1 {
+		err := IssueServiceAuthTokenRequestValidationError{
+			field:  "OrganizationName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Branch
+	// no validation rules for ServiceName
 
 	if len(errors) > 0 {
-		return GetRepoMetaRequestMultiError(errors)
+		return IssueServiceAuthTokenRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetRepoMetaRequestMultiError is an error wrapping multiple validation errors
-// returned by GetRepoMetaRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetRepoMetaRequestMultiError []error
+// IssueServiceAuthTokenRequestMultiError is an error wrapping multiple
+// validation errors returned by IssueServiceAuthTokenRequest.ValidateAll() if
+// the designated constraints aren't met.
+type IssueServiceAuthTokenRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetRepoMetaRequestMultiError) Error() string {
+func (m IssueServiceAuthTokenRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -16627,11 +17569,12 @@ func (m GetRepoMetaRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetRepoMetaRequestMultiError) AllErrors() []error { return m }
+func (m IssueServiceAuthTokenRequestMultiError) AllErrors() []error { return m }
 
-// GetRepoMetaRequestValidationError is the validation error returned by
-// GetRepoMetaRequest.Validate if the designated constraints aren't met.
-type GetRepoMetaRequestValidationError struct {
+// IssueServiceAuthTokenRequestValidationError is the validation error returned
+// by IssueServiceAuthTokenRequest.Validate if the designated constraints
+// aren't met.
+type IssueServiceAuthTokenRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -16639,24 +17582,24 @@ type GetRepoMetaRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetRepoMetaRequestValidationError) Field() string { return e.field }
+func (e IssueServiceAuthTokenRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetRepoMetaRequestValidationError) Reason() string { return e.reason }
+func (e IssueServiceAuthTokenRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetRepoMetaRequestValidationError) Cause() error { return e.cause }
+func (e IssueServiceAuthTokenRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetRepoMetaRequestValidationError) Key() bool { return e.key }
+func (e IssueServiceAuthTokenRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetRepoMetaRequestValidationError) ErrorName() string {
-	return "GetRepoMetaRequestValidationError"
+func (e IssueServiceAuthTokenRequestValidationError) ErrorName() string {
+	return "IssueServiceAuthTokenRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetRepoMetaRequestValidationError) Error() string {
+func (e IssueServiceAuthTokenRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -16668,14 +17611,14 @@ func (e GetRepoMetaRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetRepoMetaRequest.%s: %s%s",
+		"invalid %sIssueServiceAuthTokenRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetRepoMetaRequestValidationError{}
+var _ error = IssueServiceAuthTokenRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -16683,77 +17626,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetRepoMetaRequestValidationError{}
+} = IssueServiceAuthTokenRequestValidationError{}
 
-// Validate checks the field values on GetRepoMetaResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on IssueServiceAuthTokenResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetRepoMetaResponse) Validate() error {
+func (m *IssueServiceAuthTokenResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetRepoMetaResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetRepoMetaResponseMultiError, or nil if none found.
-func (m *GetRepoMetaResponse) ValidateAll() error {
+// ValidateAll checks the field values on IssueServiceAuthTokenResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// IssueServiceAuthTokenResponseMultiError, or nil if none found.
+func (m *IssueServiceAuthTokenResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetRepoMetaResponse) validate(all bool) error {
+func (m *IssueServiceAuthTokenResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for GitUrl
-
-	if all {
-		switch v := interface{}(m.GetGitUrlExpiresOn()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetRepoMetaResponseValidationError{
-					field:  "GitUrlExpiresOn",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GetRepoMetaResponseValidationError{
-					field:  "GitUrlExpiresOn",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetGitUrlExpiresOn()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetRepoMetaResponseValidationError{
-				field:  "GitUrlExpiresOn",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	// no validation rules for GitSubpath
+	// no validation rules for Token
 
 	if len(errors) > 0 {
-		return GetRepoMetaResponseMultiError(errors)
+		return IssueServiceAuthTokenResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetRepoMetaResponseMultiError is an error wrapping multiple validation
-// errors returned by GetRepoMetaResponse.ValidateAll() if the designated
-// constraints aren't met.
-type GetRepoMetaResponseMultiError []error
+// IssueServiceAuthTokenResponseMultiError is an error wrapping multiple
+// validation errors returned by IssueServiceAuthTokenResponse.ValidateAll()
+// if the designated constraints aren't met.
+type IssueServiceAuthTokenResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetRepoMetaResponseMultiError) Error() string {
+func (m IssueServiceAuthTokenResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -16762,11 +17674,12 @@ func (m GetRepoMetaResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetRepoMetaResponseMultiError) AllErrors() []error { return m }
+func (m IssueServiceAuthTokenResponseMultiError) AllErrors() []error { return m }
 
-// GetRepoMetaResponseValidationError is the validation error returned by
-// GetRepoMetaResponse.Validate if the designated constraints aren't met.
-type GetRepoMetaResponseValidationError struct {
+// IssueServiceAuthTokenResponseValidationError is the validation error
+// returned by IssueServiceAuthTokenResponse.Validate if the designated
+// constraints aren't met.
+type IssueServiceAuthTokenResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -16774,24 +17687,24 @@ type GetRepoMetaResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetRepoMetaResponseValidationError) Field() string { return e.field }
+func (e IssueServiceAuthTokenResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetRepoMetaResponseValidationError) Reason() string { return e.reason }
+func (e IssueServiceAuthTokenResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetRepoMetaResponseValidationError) Cause() error { return e.cause }
+func (e IssueServiceAuthTokenResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetRepoMetaResponseValidationError) Key() bool { return e.key }
+func (e IssueServiceAuthTokenResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetRepoMetaResponseValidationError) ErrorName() string {
-	return "GetRepoMetaResponseValidationError"
+func (e IssueServiceAuthTokenResponseValidationError) ErrorName() string {
+	return "IssueServiceAuthTokenResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetRepoMetaResponseValidationError) Error() string {
+func (e IssueServiceAuthTokenResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -16803,14 +17716,14 @@ func (e GetRepoMetaResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetRepoMetaResponse.%s: %s%s",
+		"invalid %sIssueServiceAuthTokenResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetRepoMetaResponseValidationError{}
+var _ error = IssueServiceAuthTokenResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -16818,65 +17731,57 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetRepoMetaResponseValidationError{}
+} = IssueServiceAuthTokenResponseValidationError{}
 
-// Validate checks the field values on PullVirtualRepoRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListServiceAuthTokensRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *PullVirtualRepoRequest) Validate() error {
+func (m *ListServiceAuthTokensRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PullVirtualRepoRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListServiceAuthTokensRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// PullVirtualRepoRequestMultiError, or nil if none found.
-func (m *PullVirtualRepoRequest) ValidateAll() error {
+// ListServiceAuthTokensRequestMultiError, or nil if none found.
+func (m *ListServiceAuthTokensRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PullVirtualRepoRequest) validate(all bool) error {
+func (m *ListServiceAuthTokensRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ProjectId
-
-	// no validation rules for Branch
-
-	if m.GetPageSize() != 0 {
-
-		if m.GetPageSize() > 100 {
-			err := PullVirtualRepoRequestValidationError{
-				field:  "PageSize",
-				reason: "value must be less than or equal to 100",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
+	if utf8.RuneCountInString(m.GetOrganizationName()) < 1 {
+		err := ListServiceAuthTokensRequestValidationError{
+			field:  "OrganizationName",
+			reason: "value length must be at least 1 runes",
 		}
-
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
-	// no validation rules for PageToken
+	// no validation rules for ServiceName
 
 	if len(errors) > 0 {
-		return PullVirtualRepoRequestMultiError(errors)
+		return ListServiceAuthTokensRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// PullVirtualRepoRequestMultiError is an error wrapping multiple validation
-// errors returned by PullVirtualRepoRequest.ValidateAll() if the designated
-// constraints aren't met.
-type PullVirtualRepoRequestMultiError []error
+// ListServiceAuthTokensRequestMultiError is an error wrapping multiple
+// validation errors returned by ListServiceAuthTokensRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListServiceAuthTokensRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PullVirtualRepoRequestMultiError) Error() string {
+func (m ListServiceAuthTokensRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -16885,11 +17790,12 @@ func (m PullVirtualRepoRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PullVirtualRepoRequestMultiError) AllErrors() []error { return m }
+func (m ListServiceAuthTokensRequestMultiError) AllErrors() []error { return m }
 
-// PullVirtualRepoRequestValidationError is the validation error returned by
-// PullVirtualRepoRequest.Validate if the designated constraints aren't met.
-type PullVirtualRepoRequestValidationError struct {
+// ListServiceAuthTokensRequestValidationError is the validation error returned
+// by ListServiceAuthTokensRequest.Validate if the designated constraints
+// aren't met.
+type ListServiceAuthTokensRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -16897,24 +17803,24 @@ type PullVirtualRepoRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e PullVirtualRepoRequestValidationError) Field() string { return e.field }
+func (e ListServiceAuthTokensRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PullVirtualRepoRequestValidationError) Reason() string { return e.reason }
+func (e ListServiceAuthTokensRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PullVirtualRepoRequestValidationError) Cause() error { return e.cause }
+func (e ListServiceAuthTokensRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PullVirtualRepoRequestValidationError) Key() bool { return e.key }
+func (e ListServiceAuthTokensRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PullVirtualRepoRequestValidationError) ErrorName() string {
-	return "PullVirtualRepoRequestValidationError"
+func (e ListServiceAuthTokensRequestValidationError) ErrorName() string {
+	return "ListServiceAuthTokensRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PullVirtualRepoRequestValidationError) Error() string {
+func (e ListServiceAuthTokensRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -16926,14 +17832,14 @@ func (e PullVirtualRepoRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPullVirtualRepoRequest.%s: %s%s",
+		"invalid %sListServiceAuthTokensRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PullVirtualRepoRequestValidationError{}
+var _ error = ListServiceAuthTokensRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -16941,47 +17847,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PullVirtualRepoRequestValidationError{}
+} = ListServiceAuthTokensRequestValidationError{}
 
-// Validate checks the field values on PullVirtualRepoResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListServiceAuthTokensResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *PullVirtualRepoResponse) Validate() error {
+func (m *ListServiceAuthTokensResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PullVirtualRepoResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// PullVirtualRepoResponseMultiError, or nil if none found.
-func (m *PullVirtualRepoResponse) ValidateAll() error {
+// ValidateAll checks the field values on ListServiceAuthTokensResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListServiceAuthTokensResponseMultiError, or nil if none found.
+func (m *ListServiceAuthTokensResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PullVirtualRepoResponse) validate(all bool) error {
+func (m *ListServiceAuthTokensResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetFiles() {
+	for idx, item := range m.GetTokens() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, PullVirtualRepoResponseValidationError{
-						field:  fmt.Sprintf("Files[%v]", idx),
+					errors = append(errors, ListServiceAuthTokensResponseValidationError{
+						field:  fmt.Sprintf("Tokens[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, PullVirtualRepoResponseValidationError{
-						field:  fmt.Sprintf("Files[%v]", idx),
+					errors = append(errors, ListServiceAuthTokensResponseValidationError{
+						field:  fmt.Sprintf("Tokens[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -16989,8 +17895,8 @@ func (m *PullVirtualRepoResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return PullVirtualRepoResponseValidationError{
-					field:  fmt.Sprintf("Files[%v]", idx),
+				return ListServiceAuthTokensResponseValidationError{
+					field:  fmt.Sprintf("Tokens[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -16999,22 +17905,20 @@ func (m *PullVirtualRepoResponse) validate(all bool) error {
 
 	}
 
-	// no validation rules for NextPageToken
-
 	if len(errors) > 0 {
-		return PullVirtualRepoResponseMultiError(errors)
+		return ListServiceAuthTokensResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// PullVirtualRepoResponseMultiError is an error wrapping multiple validation
-// errors returned by PullVirtualRepoResponse.ValidateAll() if the designated
-// constraints aren't met.
-type PullVirtualRepoResponseMultiError []error
+// ListServiceAuthTokensResponseMultiError is an error wrapping multiple
+// validation errors returned by ListServiceAuthTokensResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ListServiceAuthTokensResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PullVirtualRepoResponseMultiError) Error() string {
+func (m ListServiceAuthTokensResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -17023,11 +17927,12 @@ func (m PullVirtualRepoResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PullVirtualRepoResponseMultiError) AllErrors() []error { return m }
+func (m ListServiceAuthTokensResponseMultiError) AllErrors() []error { return m }
 
-// PullVirtualRepoResponseValidationError is the validation error returned by
-// PullVirtualRepoResponse.Validate if the designated constraints aren't met.
-type PullVirtualRepoResponseValidationError struct {
+// ListServiceAuthTokensResponseValidationError is the validation error
+// returned by ListServiceAuthTokensResponse.Validate if the designated
+// constraints aren't met.
+type ListServiceAuthTokensResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -17035,24 +17940,24 @@ type PullVirtualRepoResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e PullVirtualRepoResponseValidationError) Field() string { return e.field }
+func (e ListServiceAuthTokensResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PullVirtualRepoResponseValidationError) Reason() string { return e.reason }
+func (e ListServiceAuthTokensResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PullVirtualRepoResponseValidationError) Cause() error { return e.cause }
+func (e ListServiceAuthTokensResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PullVirtualRepoResponseValidationError) Key() bool { return e.key }
+func (e ListServiceAuthTokensResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PullVirtualRepoResponseValidationError) ErrorName() string {
-	return "PullVirtualRepoResponseValidationError"
+func (e ListServiceAuthTokensResponseValidationError) ErrorName() string {
+	return "ListServiceAuthTokensResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PullVirtualRepoResponseValidationError) Error() string {
+func (e ListServiceAuthTokensResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -17064,14 +17969,14 @@ func (e PullVirtualRepoResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPullVirtualRepoResponse.%s: %s%s",
+		"invalid %sListServiceAuthTokensResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PullVirtualRepoResponseValidationError{}
+var _ error = ListServiceAuthTokensResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -17079,81 +17984,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PullVirtualRepoResponseValidationError{}
+} = ListServiceAuthTokensResponseValidationError{}
 
-// Validate checks the field values on GetReportMetaRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetGithubRepoStatusRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetReportMetaRequest) Validate() error {
+func (m *GetGithubRepoStatusRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetReportMetaRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetGithubRepoStatusRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetReportMetaRequestMultiError, or nil if none found.
-func (m *GetReportMetaRequest) ValidateAll() error {
+// GetGithubRepoStatusRequestMultiError, or nil if none found.
+func (m *GetGithubRepoStatusRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetReportMetaRequest) validate(all bool) error {
+func (m *GetGithubRepoStatusRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ProjectId
+	// no validation rules for GithubUrl
 
-	// no validation rules for Branch
+	if len(errors) > 0 {
+		return GetGithubRepoStatusRequestMultiError(errors)
+	}
 
-	// no validation rules for Report
+	return nil
+}
 
-	// no validation rules for Annotations
-
-	if all {
-		switch v := interface{}(m.GetExecutionTime()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetReportMetaRequestValidationError{
-					field:  "ExecutionTime",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GetReportMetaRequestValidationError{
-					field:  "ExecutionTime",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetExecutionTime()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetReportMetaRequestValidationError{
-				field:  "ExecutionTime",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if len(errors) > 0 {
-		return GetReportMetaRequestMultiError(errors)
-	}
-
-	return nil
-}
-
-// GetReportMetaRequestMultiError is an error wrapping multiple validation
-// errors returned by GetReportMetaRequest.ValidateAll() if the designated
-// constraints aren't met.
-type GetReportMetaRequestMultiError []error
+// GetGithubRepoStatusRequestMultiError is an error wrapping multiple
+// validation errors returned by GetGithubRepoStatusRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetGithubRepoStatusRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetReportMetaRequestMultiError) Error() string {
+func (m GetGithubRepoStatusRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -17162,11 +18032,11 @@ func (m GetReportMetaRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetReportMetaRequestMultiError) AllErrors() []error { return m }
+func (m GetGithubRepoStatusRequestMultiError) AllErrors() []error { return m }
 
-// GetReportMetaRequestValidationError is the validation error returned by
-// GetReportMetaRequest.Validate if the designated constraints aren't met.
-type GetReportMetaRequestValidationError struct {
+// GetGithubRepoStatusRequestValidationError is the validation error returned
+// by GetGithubRepoStatusRequest.Validate if the designated constraints aren't met.
+type GetGithubRepoStatusRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -17174,24 +18044,24 @@ type GetReportMetaRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetReportMetaRequestValidationError) Field() string { return e.field }
+func (e GetGithubRepoStatusRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetReportMetaRequestValidationError) Reason() string { return e.reason }
+func (e GetGithubRepoStatusRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetReportMetaRequestValidationError) Cause() error { return e.cause }
+func (e GetGithubRepoStatusRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetReportMetaRequestValidationError) Key() bool { return e.key }
+func (e GetGithubRepoStatusRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetReportMetaRequestValidationError) ErrorName() string {
-	return "GetReportMetaRequestValidationError"
+func (e GetGithubRepoStatusRequestValidationError) ErrorName() string {
+	return "GetGithubRepoStatusRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetReportMetaRequestValidationError) Error() string {
+func (e GetGithubRepoStatusRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -17203,14 +18073,14 @@ func (e GetReportMetaRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetReportMetaRequest.%s: %s%s",
+		"invalid %sGetGithubRepoStatusRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetReportMetaRequestValidationError{}
+var _ error = GetGithubRepoStatusRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -17218,50 +18088,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetReportMetaRequestValidationError{}
+} = GetGithubRepoStatusRequestValidationError{}
 
-// Validate checks the field values on GetReportMetaResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetGithubRepoStatusResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetReportMetaResponse) Validate() error {
+func (m *GetGithubRepoStatusResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetReportMetaResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetGithubRepoStatusResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetReportMetaResponseMultiError, or nil if none found.
-func (m *GetReportMetaResponse) ValidateAll() error {
+// GetGithubRepoStatusResponseMultiError, or nil if none found.
+func (m *GetGithubRepoStatusResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetReportMetaResponse) validate(all bool) error {
+func (m *GetGithubRepoStatusResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for OpenUrl
+	// no validation rules for HasAccess
 
-	// no validation rules for ExportUrl
+	// no validation rules for GrantAccessUrl
 
-	// no validation rules for EditUrl
+	// no validation rules for DefaultBranch
 
 	if len(errors) > 0 {
-		return GetReportMetaResponseMultiError(errors)
+		return GetGithubRepoStatusResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetReportMetaResponseMultiError is an error wrapping multiple validation
-// errors returned by GetReportMetaResponse.ValidateAll() if the designated
-// constraints aren't met.
-type GetReportMetaResponseMultiError []error
+// GetGithubRepoStatusResponseMultiError is an error wrapping multiple
+// validation errors returned by GetGithubRepoStatusResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetGithubRepoStatusResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetReportMetaResponseMultiError) Error() string {
+func (m GetGithubRepoStatusResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -17270,11 +18140,12 @@ func (m GetReportMetaResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetReportMetaResponseMultiError) AllErrors() []error { return m }
+func (m GetGithubRepoStatusResponseMultiError) AllErrors() []error { return m }
 
-// GetReportMetaResponseValidationError is the validation error returned by
-// GetReportMetaResponse.Validate if the designated constraints aren't met.
-type GetReportMetaResponseValidationError struct {
+// GetGithubRepoStatusResponseValidationError is the validation error returned
+// by GetGithubRepoStatusResponse.Validate if the designated constraints
+// aren't met.
+type GetGithubRepoStatusResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -17282,24 +18153,24 @@ type GetReportMetaResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetReportMetaResponseValidationError) Field() string { return e.field }
+func (e GetGithubRepoStatusResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetReportMetaResponseValidationError) Reason() string { return e.reason }
+func (e GetGithubRepoStatusResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetReportMetaResponseValidationError) Cause() error { return e.cause }
+func (e GetGithubRepoStatusResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetReportMetaResponseValidationError) Key() bool { return e.key }
+func (e GetGithubRepoStatusResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetReportMetaResponseValidationError) ErrorName() string {
-	return "GetReportMetaResponseValidationError"
+func (e GetGithubRepoStatusResponseValidationError) ErrorName() string {
+	return "GetGithubRepoStatusResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetReportMetaResponseValidationError) Error() string {
+func (e GetGithubRepoStatusResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -17311,14 +18182,14 @@ func (e GetReportMetaResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetReportMetaResponse.%s: %s%s",
+		"invalid %sGetGithubRepoStatusResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetReportMetaResponseValidationError{}
+var _ error = GetGithubRepoStatusResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -17326,81 +18197,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetReportMetaResponseValidationError{}
+} = GetGithubRepoStatusResponseValidationError{}
 
-// Validate checks the field values on GetAlertMetaRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetGithubUserStatusRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetAlertMetaRequest) Validate() error {
+func (m *GetGithubUserStatusRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetAlertMetaRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetGithubUserStatusRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetAlertMetaRequestMultiError, or nil if none found.
-func (m *GetAlertMetaRequest) ValidateAll() error {
+// GetGithubUserStatusRequestMultiError, or nil if none found.
+func (m *GetGithubUserStatusRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetAlertMetaRequest) validate(all bool) error {
+func (m *GetGithubUserStatusRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ProjectId
-
-	// no validation rules for Branch
-
-	// no validation rules for Alert
-
-	// no validation rules for Annotations
-
-	switch v := m.QueryFor.(type) {
-	case *GetAlertMetaRequest_QueryForUserId:
-		if v == nil {
-			err := GetAlertMetaRequestValidationError{
-				field:  "QueryFor",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-		// no validation rules for QueryForUserId
-	case *GetAlertMetaRequest_QueryForUserEmail:
-		if v == nil {
-			err := GetAlertMetaRequestValidationError{
-				field:  "QueryFor",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-		// no validation rules for QueryForUserEmail
-	default:
-		_ = v // ensures v is used
-	}
-
 	if len(errors) > 0 {
-		return GetAlertMetaRequestMultiError(errors)
+		return GetGithubUserStatusRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetAlertMetaRequestMultiError is an error wrapping multiple validation
-// errors returned by GetAlertMetaRequest.ValidateAll() if the designated
-// constraints aren't met.
-type GetAlertMetaRequestMultiError []error
+// GetGithubUserStatusRequestMultiError is an error wrapping multiple
+// validation errors returned by GetGithubUserStatusRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetGithubUserStatusRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetAlertMetaRequestMultiError) Error() string {
+func (m GetGithubUserStatusRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -17409,11 +18243,11 @@ func (m GetAlertMetaRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetAlertMetaRequestMultiError) AllErrors() []error { return m }
+func (m GetGithubUserStatusRequestMultiError) AllErrors() []error { return m }
 
-// GetAlertMetaRequestValidationError is the validation error returned by
-// GetAlertMetaRequest.Validate if the designated constraints aren't met.
-type GetAlertMetaRequestValidationError struct {
+// GetGithubUserStatusRequestValidationError is the validation error returned
+// by GetGithubUserStatusRequest.Validate if the designated constraints aren't met.
+type GetGithubUserStatusRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -17421,24 +18255,24 @@ type GetAlertMetaRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetAlertMetaRequestValidationError) Field() string { return e.field }
+func (e GetGithubUserStatusRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetAlertMetaRequestValidationError) Reason() string { return e.reason }
+func (e GetGithubUserStatusRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetAlertMetaRequestValidationError) Cause() error { return e.cause }
+func (e GetGithubUserStatusRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetAlertMetaRequestValidationError) Key() bool { return e.key }
+func (e GetGithubUserStatusRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetAlertMetaRequestValidationError) ErrorName() string {
-	return "GetAlertMetaRequestValidationError"
+func (e GetGithubUserStatusRequestValidationError) ErrorName() string {
+	return "GetGithubUserStatusRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetAlertMetaRequestValidationError) Error() string {
+func (e GetGithubUserStatusRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -17450,14 +18284,14 @@ func (e GetAlertMetaRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetAlertMetaRequest.%s: %s%s",
+		"invalid %sGetGithubUserStatusRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetAlertMetaRequestValidationError{}
+var _ error = GetGithubUserStatusRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -17465,77 +18299,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetAlertMetaRequestValidationError{}
+} = GetGithubUserStatusRequestValidationError{}
 
-// Validate checks the field values on GetAlertMetaResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetGithubUserStatusResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetAlertMetaResponse) Validate() error {
+func (m *GetGithubUserStatusResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetAlertMetaResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetGithubUserStatusResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetAlertMetaResponseMultiError, or nil if none found.
-func (m *GetAlertMetaResponse) ValidateAll() error {
+// GetGithubUserStatusResponseMultiError, or nil if none found.
+func (m *GetGithubUserStatusResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetAlertMetaResponse) validate(all bool) error {
+func (m *GetGithubUserStatusResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for OpenUrl
+	// no validation rules for HasAccess
 
-	// no validation rules for EditUrl
+	// no validation rules for GrantAccessUrl
 
-	if all {
-		switch v := interface{}(m.GetQueryForAttributes()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetAlertMetaResponseValidationError{
-					field:  "QueryForAttributes",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GetAlertMetaResponseValidationError{
-					field:  "QueryForAttributes",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetQueryForAttributes()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetAlertMetaResponseValidationError{
-				field:  "QueryForAttributes",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for AccessToken
+
+	// no validation rules for Account
 
 	if len(errors) > 0 {
-		return GetAlertMetaResponseMultiError(errors)
+		return GetGithubUserStatusResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetAlertMetaResponseMultiError is an error wrapping multiple validation
-// errors returned by GetAlertMetaResponse.ValidateAll() if the designated
-// constraints aren't met.
-type GetAlertMetaResponseMultiError []error
+// GetGithubUserStatusResponseMultiError is an error wrapping multiple
+// validation errors returned by GetGithubUserStatusResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetGithubUserStatusResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetAlertMetaResponseMultiError) Error() string {
+func (m GetGithubUserStatusResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -17544,11 +18353,12 @@ func (m GetAlertMetaResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetAlertMetaResponseMultiError) AllErrors() []error { return m }
+func (m GetGithubUserStatusResponseMultiError) AllErrors() []error { return m }
 
-// GetAlertMetaResponseValidationError is the validation error returned by
-// GetAlertMetaResponse.Validate if the designated constraints aren't met.
-type GetAlertMetaResponseValidationError struct {
+// GetGithubUserStatusResponseValidationError is the validation error returned
+// by GetGithubUserStatusResponse.Validate if the designated constraints
+// aren't met.
+type GetGithubUserStatusResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -17556,24 +18366,24 @@ type GetAlertMetaResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetAlertMetaResponseValidationError) Field() string { return e.field }
+func (e GetGithubUserStatusResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetAlertMetaResponseValidationError) Reason() string { return e.reason }
+func (e GetGithubUserStatusResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetAlertMetaResponseValidationError) Cause() error { return e.cause }
+func (e GetGithubUserStatusResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetAlertMetaResponseValidationError) Key() bool { return e.key }
+func (e GetGithubUserStatusResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetAlertMetaResponseValidationError) ErrorName() string {
-	return "GetAlertMetaResponseValidationError"
+func (e GetGithubUserStatusResponseValidationError) ErrorName() string {
+	return "GetGithubUserStatusResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetAlertMetaResponseValidationError) Error() string {
+func (e GetGithubUserStatusResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -17585,14 +18395,14 @@ func (e GetAlertMetaResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetAlertMetaResponse.%s: %s%s",
+		"invalid %sGetGithubUserStatusResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetAlertMetaResponseValidationError{}
+var _ error = GetGithubUserStatusResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -17600,38 +18410,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetAlertMetaResponseValidationError{}
+} = GetGithubUserStatusResponseValidationError{}
 
-// Validate checks the field values on CreateReportRequest with the rules
+// Validate checks the field values on GetGitCredentialsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateReportRequest) Validate() error {
+func (m *GetGitCredentialsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateReportRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetGitCredentialsRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateReportRequestMultiError, or nil if none found.
-func (m *CreateReportRequest) ValidateAll() error {
+// GetGitCredentialsRequestMultiError, or nil if none found.
+func (m *GetGitCredentialsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateReportRequest) validate(all bool) error {
+func (m *GetGitCredentialsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Organization
-
-	// no validation rules for Project
-
-	if m.GetOptions() == nil {
-		err := CreateReportRequestValidationError{
-			field:  "Options",
-			reason: "value is required",
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := GetGitCredentialsRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
 		}
 		if !all {
 			return err
@@ -17639,49 +18445,31 @@ func (m *CreateReportRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if all {
-		switch v := interface{}(m.GetOptions()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateReportRequestValidationError{
-					field:  "Options",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateReportRequestValidationError{
-					field:  "Options",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	if utf8.RuneCountInString(m.GetProject()) < 1 {
+		err := GetGitCredentialsRequestValidationError{
+			field:  "Project",
+			reason: "value length must be at least 1 runes",
 		}
-	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateReportRequestValidationError{
-				field:  "Options",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return CreateReportRequestMultiError(errors)
+		return GetGitCredentialsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateReportRequestMultiError is an error wrapping multiple validation
-// errors returned by CreateReportRequest.ValidateAll() if the designated
+// GetGitCredentialsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetGitCredentialsRequest.ValidateAll() if the designated
 // constraints aren't met.
-type CreateReportRequestMultiError []error
+type GetGitCredentialsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateReportRequestMultiError) Error() string {
+func (m GetGitCredentialsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -17690,11 +18478,11 @@ func (m CreateReportRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateReportRequestMultiError) AllErrors() []error { return m }
+func (m GetGitCredentialsRequestMultiError) AllErrors() []error { return m }
 
-// CreateReportRequestValidationError is the validation error returned by
-// CreateReportRequest.Validate if the designated constraints aren't met.
-type CreateReportRequestValidationError struct {
+// GetGitCredentialsRequestValidationError is the validation error returned by
+// GetGitCredentialsRequest.Validate if the designated constraints aren't met.
+type GetGitCredentialsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -17702,24 +18490,24 @@ type CreateReportRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateReportRequestValidationError) Field() string { return e.field }
+func (e GetGitCredentialsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateReportRequestValidationError) Reason() string { return e.reason }
+func (e GetGitCredentialsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateReportRequestValidationError) Cause() error { return e.cause }
+func (e GetGitCredentialsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateReportRequestValidationError) Key() bool { return e.key }
+func (e GetGitCredentialsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateReportRequestValidationError) ErrorName() string {
-	return "CreateReportRequestValidationError"
+func (e GetGitCredentialsRequestValidationError) ErrorName() string {
+	return "GetGitCredentialsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateReportRequestValidationError) Error() string {
+func (e GetGitCredentialsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -17731,14 +18519,14 @@ func (e CreateReportRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateReportRequest.%s: %s%s",
+		"invalid %sGetGitCredentialsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateReportRequestValidationError{}
+var _ error = GetGitCredentialsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -17746,46 +18534,54 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateReportRequestValidationError{}
+} = GetGitCredentialsRequestValidationError{}
 
-// Validate checks the field values on CreateReportResponse with the rules
+// Validate checks the field values on GetGitCredentialsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateReportResponse) Validate() error {
+func (m *GetGitCredentialsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateReportResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetGitCredentialsResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateReportResponseMultiError, or nil if none found.
-func (m *CreateReportResponse) ValidateAll() error {
+// GetGitCredentialsResponseMultiError, or nil if none found.
+func (m *GetGitCredentialsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateReportResponse) validate(all bool) error {
+func (m *GetGitCredentialsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Name
+	// no validation rules for RepoUrl
+
+	// no validation rules for Username
+
+	// no validation rules for Password
+
+	// no validation rules for Subpath
+
+	// no validation rules for ProdBranch
 
 	if len(errors) > 0 {
-		return CreateReportResponseMultiError(errors)
+		return GetGitCredentialsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateReportResponseMultiError is an error wrapping multiple validation
-// errors returned by CreateReportResponse.ValidateAll() if the designated
-// constraints aren't met.
-type CreateReportResponseMultiError []error
+// GetGitCredentialsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetGitCredentialsResponse.ValidateAll() if the
+// designated constraints aren't met.
+type GetGitCredentialsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateReportResponseMultiError) Error() string {
+func (m GetGitCredentialsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -17794,11 +18590,11 @@ func (m CreateReportResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateReportResponseMultiError) AllErrors() []error { return m }
+func (m GetGitCredentialsResponseMultiError) AllErrors() []error { return m }
 
-// CreateReportResponseValidationError is the validation error returned by
-// CreateReportResponse.Validate if the designated constraints aren't met.
-type CreateReportResponseValidationError struct {
+// GetGitCredentialsResponseValidationError is the validation error returned by
+// GetGitCredentialsResponse.Validate if the designated constraints aren't met.
+type GetGitCredentialsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -17806,24 +18602,24 @@ type CreateReportResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateReportResponseValidationError) Field() string { return e.field }
+func (e GetGitCredentialsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateReportResponseValidationError) Reason() string { return e.reason }
+func (e GetGitCredentialsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateReportResponseValidationError) Cause() error { return e.cause }
+func (e GetGitCredentialsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateReportResponseValidationError) Key() bool { return e.key }
+func (e GetGitCredentialsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateReportResponseValidationError) ErrorName() string {
-	return "CreateReportResponseValidationError"
+func (e GetGitCredentialsResponseValidationError) ErrorName() string {
+	return "GetGitCredentialsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateReportResponseValidationError) Error() string {
+func (e GetGitCredentialsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -17835,14 +18631,14 @@ func (e CreateReportResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateReportResponse.%s: %s%s",
+		"invalid %sGetGitCredentialsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateReportResponseValidationError{}
+var _ error = GetGitCredentialsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -17850,40 +18646,45 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateReportResponseValidationError{}
+} = GetGitCredentialsResponseValidationError{}
 
-// Validate checks the field values on EditReportRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *EditReportRequest) Validate() error {
+// Validate checks the field values on CreateWhitelistedDomainRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateWhitelistedDomainRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on EditReportRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// EditReportRequestMultiError, or nil if none found.
-func (m *EditReportRequest) ValidateAll() error {
+// ValidateAll checks the field values on CreateWhitelistedDomainRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CreateWhitelistedDomainRequestMultiError, or nil if none found.
+func (m *CreateWhitelistedDomainRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *EditReportRequest) validate(all bool) error {
+func (m *CreateWhitelistedDomainRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Organization
-
-	// no validation rules for Project
-
-	// no validation rules for Name
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := CreateWhitelistedDomainRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	if m.GetOptions() == nil {
-		err := EditReportRequestValidationError{
-			field:  "Options",
-			reason: "value is required",
+	if utf8.RuneCountInString(m.GetDomain()) < 1 {
+		err := CreateWhitelistedDomainRequestValidationError{
+			field:  "Domain",
+			reason: "value length must be at least 1 runes",
 		}
 		if !all {
 			return err
@@ -17891,49 +18692,31 @@ func (m *EditReportRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if all {
-		switch v := interface{}(m.GetOptions()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, EditReportRequestValidationError{
-					field:  "Options",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, EditReportRequestValidationError{
-					field:  "Options",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	if utf8.RuneCountInString(m.GetRole()) < 1 {
+		err := CreateWhitelistedDomainRequestValidationError{
+			field:  "Role",
+			reason: "value length must be at least 1 runes",
 		}
-	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return EditReportRequestValidationError{
-				field:  "Options",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return EditReportRequestMultiError(errors)
+		return CreateWhitelistedDomainRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// EditReportRequestMultiError is an error wrapping multiple validation errors
-// returned by EditReportRequest.ValidateAll() if the designated constraints
-// aren't met.
-type EditReportRequestMultiError []error
+// CreateWhitelistedDomainRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateWhitelistedDomainRequest.ValidateAll()
+// if the designated constraints aren't met.
+type CreateWhitelistedDomainRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m EditReportRequestMultiError) Error() string {
+func (m CreateWhitelistedDomainRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -17942,11 +18725,12 @@ func (m EditReportRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m EditReportRequestMultiError) AllErrors() []error { return m }
+func (m CreateWhitelistedDomainRequestMultiError) AllErrors() []error { return m }
 
-// EditReportRequestValidationError is the validation error returned by
-// EditReportRequest.Validate if the designated constraints aren't met.
-type EditReportRequestValidationError struct {
+// CreateWhitelistedDomainRequestValidationError is the validation error
+// returned by CreateWhitelistedDomainRequest.Validate if the designated
+// constraints aren't met.
+type CreateWhitelistedDomainRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -17954,24 +18738,24 @@ type EditReportRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e EditReportRequestValidationError) Field() string { return e.field }
+func (e CreateWhitelistedDomainRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e EditReportRequestValidationError) Reason() string { return e.reason }
+func (e CreateWhitelistedDomainRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e EditReportRequestValidationError) Cause() error { return e.cause }
+func (e CreateWhitelistedDomainRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e EditReportRequestValidationError) Key() bool { return e.key }
+func (e CreateWhitelistedDomainRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e EditReportRequestValidationError) ErrorName() string {
-	return "EditReportRequestValidationError"
+func (e CreateWhitelistedDomainRequestValidationError) ErrorName() string {
+	return "CreateWhitelistedDomainRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e EditReportRequestValidationError) Error() string {
+func (e CreateWhitelistedDomainRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -17983,14 +18767,14 @@ func (e EditReportRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sEditReportRequest.%s: %s%s",
+		"invalid %sCreateWhitelistedDomainRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = EditReportRequestValidationError{}
+var _ error = CreateWhitelistedDomainRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -17998,24 +18782,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = EditReportRequestValidationError{}
+} = CreateWhitelistedDomainRequestValidationError{}
 
-// Validate checks the field values on EditReportResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on CreateWhitelistedDomainResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *EditReportResponse) Validate() error {
+func (m *CreateWhitelistedDomainResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on EditReportResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// EditReportResponseMultiError, or nil if none found.
-func (m *EditReportResponse) ValidateAll() error {
+// ValidateAll checks the field values on CreateWhitelistedDomainResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CreateWhitelistedDomainResponseMultiError, or nil if none found.
+func (m *CreateWhitelistedDomainResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *EditReportResponse) validate(all bool) error {
+func (m *CreateWhitelistedDomainResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -18023,19 +18807,19 @@ func (m *EditReportResponse) validate(all bool) error {
 	var errors []error
 
 	if len(errors) > 0 {
-		return EditReportResponseMultiError(errors)
+		return CreateWhitelistedDomainResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// EditReportResponseMultiError is an error wrapping multiple validation errors
-// returned by EditReportResponse.ValidateAll() if the designated constraints
-// aren't met.
-type EditReportResponseMultiError []error
+// CreateWhitelistedDomainResponseMultiError is an error wrapping multiple
+// validation errors returned by CreateWhitelistedDomainResponse.ValidateAll()
+// if the designated constraints aren't met.
+type CreateWhitelistedDomainResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m EditReportResponseMultiError) Error() string {
+func (m CreateWhitelistedDomainResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -18044,11 +18828,12 @@ func (m EditReportResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m EditReportResponseMultiError) AllErrors() []error { return m }
+func (m CreateWhitelistedDomainResponseMultiError) AllErrors() []error { return m }
 
-// EditReportResponseValidationError is the validation error returned by
-// EditReportResponse.Validate if the designated constraints aren't met.
-type EditReportResponseValidationError struct {
+// CreateWhitelistedDomainResponseValidationError is the validation error
+// returned by CreateWhitelistedDomainResponse.Validate if the designated
+// constraints aren't met.
+type CreateWhitelistedDomainResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -18056,24 +18841,24 @@ type EditReportResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e EditReportResponseValidationError) Field() string { return e.field }
+func (e CreateWhitelistedDomainResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e EditReportResponseValidationError) Reason() string { return e.reason }
+func (e CreateWhitelistedDomainResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e EditReportResponseValidationError) Cause() error { return e.cause }
+func (e CreateWhitelistedDomainResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e EditReportResponseValidationError) Key() bool { return e.key }
+func (e CreateWhitelistedDomainResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e EditReportResponseValidationError) ErrorName() string {
-	return "EditReportResponseValidationError"
+func (e CreateWhitelistedDomainResponseValidationError) ErrorName() string {
+	return "CreateWhitelistedDomainResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e EditReportResponseValidationError) Error() string {
+func (e CreateWhitelistedDomainResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -18085,14 +18870,14 @@ func (e EditReportResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sEditReportResponse.%s: %s%s",
+		"invalid %sCreateWhitelistedDomainResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = EditReportResponseValidationError{}
+var _ error = CreateWhitelistedDomainResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -18100,50 +18885,66 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = EditReportResponseValidationError{}
+} = CreateWhitelistedDomainResponseValidationError{}
 
-// Validate checks the field values on UnsubscribeReportRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on RemoveWhitelistedDomainRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UnsubscribeReportRequest) Validate() error {
+func (m *RemoveWhitelistedDomainRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UnsubscribeReportRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UnsubscribeReportRequestMultiError, or nil if none found.
-func (m *UnsubscribeReportRequest) ValidateAll() error {
+// ValidateAll checks the field values on RemoveWhitelistedDomainRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RemoveWhitelistedDomainRequestMultiError, or nil if none found.
+func (m *RemoveWhitelistedDomainRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UnsubscribeReportRequest) validate(all bool) error {
+func (m *RemoveWhitelistedDomainRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Organization
-
-	// no validation rules for Project
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := RemoveWhitelistedDomainRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Name
+	if utf8.RuneCountInString(m.GetDomain()) < 1 {
+		err := RemoveWhitelistedDomainRequestValidationError{
+			field:  "Domain",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return UnsubscribeReportRequestMultiError(errors)
+		return RemoveWhitelistedDomainRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UnsubscribeReportRequestMultiError is an error wrapping multiple validation
-// errors returned by UnsubscribeReportRequest.ValidateAll() if the designated
-// constraints aren't met.
-type UnsubscribeReportRequestMultiError []error
+// RemoveWhitelistedDomainRequestMultiError is an error wrapping multiple
+// validation errors returned by RemoveWhitelistedDomainRequest.ValidateAll()
+// if the designated constraints aren't met.
+type RemoveWhitelistedDomainRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UnsubscribeReportRequestMultiError) Error() string {
+func (m RemoveWhitelistedDomainRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -18152,11 +18953,12 @@ func (m UnsubscribeReportRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UnsubscribeReportRequestMultiError) AllErrors() []error { return m }
+func (m RemoveWhitelistedDomainRequestMultiError) AllErrors() []error { return m }
 
-// UnsubscribeReportRequestValidationError is the validation error returned by
-// UnsubscribeReportRequest.Validate if the designated constraints aren't met.
-type UnsubscribeReportRequestValidationError struct {
+// RemoveWhitelistedDomainRequestValidationError is the validation error
+// returned by RemoveWhitelistedDomainRequest.Validate if the designated
+// constraints aren't met.
+type RemoveWhitelistedDomainRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -18164,24 +18966,24 @@ type UnsubscribeReportRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UnsubscribeReportRequestValidationError) Field() string { return e.field }
+func (e RemoveWhitelistedDomainRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UnsubscribeReportRequestValidationError) Reason() string { return e.reason }
+func (e RemoveWhitelistedDomainRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UnsubscribeReportRequestValidationError) Cause() error { return e.cause }
+func (e RemoveWhitelistedDomainRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UnsubscribeReportRequestValidationError) Key() bool { return e.key }
+func (e RemoveWhitelistedDomainRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UnsubscribeReportRequestValidationError) ErrorName() string {
-	return "UnsubscribeReportRequestValidationError"
+func (e RemoveWhitelistedDomainRequestValidationError) ErrorName() string {
+	return "RemoveWhitelistedDomainRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UnsubscribeReportRequestValidationError) Error() string {
+func (e RemoveWhitelistedDomainRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -18193,14 +18995,14 @@ func (e UnsubscribeReportRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUnsubscribeReportRequest.%s: %s%s",
+		"invalid %sRemoveWhitelistedDomainRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UnsubscribeReportRequestValidationError{}
+var _ error = RemoveWhitelistedDomainRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -18208,24 +19010,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UnsubscribeReportRequestValidationError{}
+} = RemoveWhitelistedDomainRequestValidationError{}
 
-// Validate checks the field values on UnsubscribeReportResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on RemoveWhitelistedDomainResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UnsubscribeReportResponse) Validate() error {
+func (m *RemoveWhitelistedDomainResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UnsubscribeReportResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UnsubscribeReportResponseMultiError, or nil if none found.
-func (m *UnsubscribeReportResponse) ValidateAll() error {
+// ValidateAll checks the field values on RemoveWhitelistedDomainResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RemoveWhitelistedDomainResponseMultiError, or nil if none found.
+func (m *RemoveWhitelistedDomainResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UnsubscribeReportResponse) validate(all bool) error {
+func (m *RemoveWhitelistedDomainResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -18233,19 +19035,19 @@ func (m *UnsubscribeReportResponse) validate(all bool) error {
 	var errors []error
 
 	if len(errors) > 0 {
-		return UnsubscribeReportResponseMultiError(errors)
+		return RemoveWhitelistedDomainResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UnsubscribeReportResponseMultiError is an error wrapping multiple validation
-// errors returned by UnsubscribeReportResponse.ValidateAll() if the
-// designated constraints aren't met.
-type UnsubscribeReportResponseMultiError []error
+// RemoveWhitelistedDomainResponseMultiError is an error wrapping multiple
+// validation errors returned by RemoveWhitelistedDomainResponse.ValidateAll()
+// if the designated constraints aren't met.
+type RemoveWhitelistedDomainResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UnsubscribeReportResponseMultiError) Error() string {
+func (m RemoveWhitelistedDomainResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -18254,11 +19056,12 @@ func (m UnsubscribeReportResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UnsubscribeReportResponseMultiError) AllErrors() []error { return m }
+func (m RemoveWhitelistedDomainResponseMultiError) AllErrors() []error { return m }
 
-// UnsubscribeReportResponseValidationError is the validation error returned by
-// UnsubscribeReportResponse.Validate if the designated constraints aren't met.
-type UnsubscribeReportResponseValidationError struct {
+// RemoveWhitelistedDomainResponseValidationError is the validation error
+// returned by RemoveWhitelistedDomainResponse.Validate if the designated
+// constraints aren't met.
+type RemoveWhitelistedDomainResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -18266,24 +19069,24 @@ type UnsubscribeReportResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UnsubscribeReportResponseValidationError) Field() string { return e.field }
+func (e RemoveWhitelistedDomainResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UnsubscribeReportResponseValidationError) Reason() string { return e.reason }
+func (e RemoveWhitelistedDomainResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UnsubscribeReportResponseValidationError) Cause() error { return e.cause }
+func (e RemoveWhitelistedDomainResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UnsubscribeReportResponseValidationError) Key() bool { return e.key }
+func (e RemoveWhitelistedDomainResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UnsubscribeReportResponseValidationError) ErrorName() string {
-	return "UnsubscribeReportResponseValidationError"
+func (e RemoveWhitelistedDomainResponseValidationError) ErrorName() string {
+	return "RemoveWhitelistedDomainResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UnsubscribeReportResponseValidationError) Error() string {
+func (e RemoveWhitelistedDomainResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -18295,14 +19098,14 @@ func (e UnsubscribeReportResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUnsubscribeReportResponse.%s: %s%s",
+		"invalid %sRemoveWhitelistedDomainResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UnsubscribeReportResponseValidationError{}
+var _ error = RemoveWhitelistedDomainResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -18310,50 +19113,55 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UnsubscribeReportResponseValidationError{}
+} = RemoveWhitelistedDomainResponseValidationError{}
 
-// Validate checks the field values on DeleteReportRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListWhitelistedDomainsRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteReportRequest) Validate() error {
+func (m *ListWhitelistedDomainsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteReportRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// DeleteReportRequestMultiError, or nil if none found.
-func (m *DeleteReportRequest) ValidateAll() error {
+// ValidateAll checks the field values on ListWhitelistedDomainsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListWhitelistedDomainsRequestMultiError, or nil if none found.
+func (m *ListWhitelistedDomainsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteReportRequest) validate(all bool) error {
+func (m *ListWhitelistedDomainsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Organization
-
-	// no validation rules for Project
-
-	// no validation rules for Name
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := ListWhitelistedDomainsRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return DeleteReportRequestMultiError(errors)
+		return ListWhitelistedDomainsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteReportRequestMultiError is an error wrapping multiple validation
-// errors returned by DeleteReportRequest.ValidateAll() if the designated
-// constraints aren't met.
-type DeleteReportRequestMultiError []error
+// ListWhitelistedDomainsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListWhitelistedDomainsRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ListWhitelistedDomainsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteReportRequestMultiError) Error() string {
+func (m ListWhitelistedDomainsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -18362,11 +19170,12 @@ func (m DeleteReportRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteReportRequestMultiError) AllErrors() []error { return m }
+func (m ListWhitelistedDomainsRequestMultiError) AllErrors() []error { return m }
 
-// DeleteReportRequestValidationError is the validation error returned by
-// DeleteReportRequest.Validate if the designated constraints aren't met.
-type DeleteReportRequestValidationError struct {
+// ListWhitelistedDomainsRequestValidationError is the validation error
+// returned by ListWhitelistedDomainsRequest.Validate if the designated
+// constraints aren't met.
+type ListWhitelistedDomainsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -18374,24 +19183,24 @@ type DeleteReportRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteReportRequestValidationError) Field() string { return e.field }
+func (e ListWhitelistedDomainsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteReportRequestValidationError) Reason() string { return e.reason }
+func (e ListWhitelistedDomainsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteReportRequestValidationError) Cause() error { return e.cause }
+func (e ListWhitelistedDomainsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteReportRequestValidationError) Key() bool { return e.key }
+func (e ListWhitelistedDomainsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteReportRequestValidationError) ErrorName() string {
-	return "DeleteReportRequestValidationError"
+func (e ListWhitelistedDomainsRequestValidationError) ErrorName() string {
+	return "ListWhitelistedDomainsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteReportRequestValidationError) Error() string {
+func (e ListWhitelistedDomainsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -18403,14 +19212,14 @@ func (e DeleteReportRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteReportRequest.%s: %s%s",
+		"invalid %sListWhitelistedDomainsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteReportRequestValidationError{}
+var _ error = ListWhitelistedDomainsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -18418,44 +19227,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteReportRequestValidationError{}
+} = ListWhitelistedDomainsRequestValidationError{}
 
-// Validate checks the field values on DeleteReportResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListWhitelistedDomainsResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteReportResponse) Validate() error {
+func (m *ListWhitelistedDomainsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteReportResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// DeleteReportResponseMultiError, or nil if none found.
-func (m *DeleteReportResponse) ValidateAll() error {
+// ValidateAll checks the field values on ListWhitelistedDomainsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListWhitelistedDomainsResponseMultiError, or nil if none found.
+func (m *ListWhitelistedDomainsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteReportResponse) validate(all bool) error {
+func (m *ListWhitelistedDomainsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	for idx, item := range m.GetDomains() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListWhitelistedDomainsResponseValidationError{
+						field:  fmt.Sprintf("Domains[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListWhitelistedDomainsResponseValidationError{
+						field:  fmt.Sprintf("Domains[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListWhitelistedDomainsResponseValidationError{
+					field:  fmt.Sprintf("Domains[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
-		return DeleteReportResponseMultiError(errors)
+		return ListWhitelistedDomainsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteReportResponseMultiError is an error wrapping multiple validation
-// errors returned by DeleteReportResponse.ValidateAll() if the designated
-// constraints aren't met.
-type DeleteReportResponseMultiError []error
+// ListWhitelistedDomainsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListWhitelistedDomainsResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ListWhitelistedDomainsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteReportResponseMultiError) Error() string {
+func (m ListWhitelistedDomainsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -18464,11 +19307,12 @@ func (m DeleteReportResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteReportResponseMultiError) AllErrors() []error { return m }
+func (m ListWhitelistedDomainsResponseMultiError) AllErrors() []error { return m }
 
-// DeleteReportResponseValidationError is the validation error returned by
-// DeleteReportResponse.Validate if the designated constraints aren't met.
-type DeleteReportResponseValidationError struct {
+// ListWhitelistedDomainsResponseValidationError is the validation error
+// returned by ListWhitelistedDomainsResponse.Validate if the designated
+// constraints aren't met.
+type ListWhitelistedDomainsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -18476,24 +19320,24 @@ type DeleteReportResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteReportResponseValidationError) Field() string { return e.field }
+func (e ListWhitelistedDomainsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteReportResponseValidationError) Reason() string { return e.reason }
+func (e ListWhitelistedDomainsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteReportResponseValidationError) Cause() error { return e.cause }
+func (e ListWhitelistedDomainsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteReportResponseValidationError) Key() bool { return e.key }
+func (e ListWhitelistedDomainsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteReportResponseValidationError) ErrorName() string {
-	return "DeleteReportResponseValidationError"
+func (e ListWhitelistedDomainsResponseValidationError) ErrorName() string {
+	return "ListWhitelistedDomainsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteReportResponseValidationError) Error() string {
+func (e ListWhitelistedDomainsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -18505,14 +19349,14 @@ func (e DeleteReportResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteReportResponse.%s: %s%s",
+		"invalid %sListWhitelistedDomainsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteReportResponseValidationError{}
+var _ error = ListWhitelistedDomainsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -18520,50 +19364,55 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteReportResponseValidationError{}
+} = ListWhitelistedDomainsResponseValidationError{}
 
-// Validate checks the field values on TriggerReportRequest with the rules
+// Validate checks the field values on GetSSOProviderRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TriggerReportRequest) Validate() error {
+func (m *GetSSOProviderRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TriggerReportRequest with the rules
+// ValidateAll checks the field values on GetSSOProviderRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TriggerReportRequestMultiError, or nil if none found.
-func (m *TriggerReportRequest) ValidateAll() error {
+// GetSSOProviderRequestMultiError, or nil if none found.
+func (m *GetSSOProviderRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TriggerReportRequest) validate(all bool) error {
+func (m *GetSSOProviderRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Organization
-
-	// no validation rules for Project
-
-	// no validation rules for Name
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := GetSSOProviderRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return TriggerReportRequestMultiError(errors)
+		return GetSSOProviderRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// TriggerReportRequestMultiError is an error wrapping multiple validation
-// errors returned by TriggerReportRequest.ValidateAll() if the designated
+// GetSSOProviderRequestMultiError is an error wrapping multiple validation
+// errors returned by GetSSOProviderRequest.ValidateAll() if the designated
 // constraints aren't met.
-type TriggerReportRequestMultiError []error
+type GetSSOProviderRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TriggerReportRequestMultiError) Error() string {
+func (m GetSSOProviderRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -18572,11 +19421,11 @@ func (m TriggerReportRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TriggerReportRequestMultiError) AllErrors() []error { return m }
+func (m GetSSOProviderRequestMultiError) AllErrors() []error { return m }
 
-// TriggerReportRequestValidationError is the validation error returned by
-// TriggerReportRequest.Validate if the designated constraints aren't met.
-type TriggerReportRequestValidationError struct {
+// GetSSOProviderRequestValidationError is the validation error returned by
+// GetSSOProviderRequest.Validate if the designated constraints aren't met.
+type GetSSOProviderRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -18584,24 +19433,24 @@ type TriggerReportRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e TriggerReportRequestValidationError) Field() string { return e.field }
+func (e GetSSOProviderRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TriggerReportRequestValidationError) Reason() string { return e.reason }
+func (e GetSSOProviderRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TriggerReportRequestValidationError) Cause() error { return e.cause }
+func (e GetSSOProviderRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TriggerReportRequestValidationError) Key() bool { return e.key }
+func (e GetSSOProviderRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TriggerReportRequestValidationError) ErrorName() string {
-	return "TriggerReportRequestValidationError"
+func (e GetSSOProviderRequestValidationError) ErrorName() string {
+	return "GetSSOProviderRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TriggerReportRequestValidationError) Error() string {
+func (e GetSSOProviderRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -18613,14 +19462,14 @@ func (e TriggerReportRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTriggerReportRequest.%s: %s%s",
+		"invalid %sGetSSOProviderRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TriggerReportRequestValidationError{}
+var _ error = GetSSOProviderRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -18628,44 +19477,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TriggerReportRequestValidationError{}
+} = GetSSOProviderRequestValidationError{}
 
-// Validate checks the field values on TriggerReportResponse with the rules
+// Validate checks the field values on GetSSOProviderResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TriggerReportResponse) Validate() error {
+func (m *GetSSOProviderResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TriggerReportResponse with the rules
+// ValidateAll checks the field values on GetSSOProviderResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TriggerReportResponseMultiError, or nil if none found.
-func (m *TriggerReportResponse) ValidateAll() error {
+// GetSSOProviderResponseMultiError, or nil if none found.
+func (m *GetSSOProviderResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TriggerReportResponse) validate(all bool) error {
+func (m *GetSSOProviderResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if all {
+		switch v := interface{}(m.GetProvider()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetSSOProviderResponseValidationError{
+					field:  "Provider",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetSSOProviderResponseValidationError{
+					field:  "Provider",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProvider()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetSSOProviderResponseValidationError{
+				field:  "Provider",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return TriggerReportResponseMultiError(errors)
+		return GetSSOProviderResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// TriggerReportResponseMultiError is an error wrapping multiple validation
-// errors returned by TriggerReportResponse.ValidateAll() if the designated
+// GetSSOProviderResponseMultiError is an error wrapping multiple validation
+// errors returned by GetSSOProviderResponse.ValidateAll() if the designated
 // constraints aren't met.
-type TriggerReportResponseMultiError []error
+type GetSSOProviderResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TriggerReportResponseMultiError) Error() string {
+func (m GetSSOProviderResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -18674,11 +19552,11 @@ func (m TriggerReportResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TriggerReportResponseMultiError) AllErrors() []error { return m }
+func (m GetSSOProviderResponseMultiError) AllErrors() []error { return m }
 
-// TriggerReportResponseValidationError is the validation error returned by
-// TriggerReportResponse.Validate if the designated constraints aren't met.
-type TriggerReportResponseValidationError struct {
+// GetSSOProviderResponseValidationError is the validation error returned by
+// GetSSOProviderResponse.Validate if the designated constraints aren't met.
+type GetSSOProviderResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -18686,24 +19564,24 @@ type TriggerReportResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e TriggerReportResponseValidationError) Field() string { return e.field }
+func (e GetSSOProviderResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TriggerReportResponseValidationError) Reason() string { return e.reason }
+func (e GetSSOProviderResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TriggerReportResponseValidationError) Cause() error { return e.cause }
+func (e GetSSOProviderResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TriggerReportResponseValidationError) Key() bool { return e.key }
+func (e GetSSOProviderResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TriggerReportResponseValidationError) ErrorName() string {
-	return "TriggerReportResponseValidationError"
+func (e GetSSOProviderResponseValidationError) ErrorName() string {
+	return "GetSSOProviderResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TriggerReportResponseValidationError) Error() string {
+func (e GetSSOProviderResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -18715,14 +19593,14 @@ func (e TriggerReportResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTriggerReportResponse.%s: %s%s",
+		"invalid %sGetSSOProviderResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TriggerReportResponseValidationError{}
+var _ error = GetSSOProviderResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -18730,38 +19608,45 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TriggerReportResponseValidationError{}
+} = GetSSOProviderResponseValidationError{}
 
-// Validate checks the field values on GenerateReportYAMLRequest with the rules
+// Validate checks the field values on SetSSOProviderRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GenerateReportYAMLRequest) Validate() error {
+func (m *SetSSOProviderRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateReportYAMLRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on SetSSOProviderRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GenerateReportYAMLRequestMultiError, or nil if none found.
-func (m *GenerateReportYAMLRequest) ValidateAll() error {
+// SetSSOProviderRequestMultiError, or nil if none found.
+func (m *SetSSOProviderRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateReportYAMLRequest) validate(all bool) error {
+func (m *SetSSOProviderRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Organization
-
-	// no validation rules for Project
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := SetSSOProviderRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	if m.GetOptions() == nil {
-		err := GenerateReportYAMLRequestValidationError{
-			field:  "Options",
-			reason: "value is required",
+	if _, ok := _SetSSOProviderRequest_Type_InLookup[m.GetType()]; !ok {
+		err := SetSSOProviderRequestValidationError{
+			field:  "Type",
+			reason: "value must be in list [oidc saml]",
 		}
 		if !all {
 			return err
@@ -18769,49 +19654,49 @@ func (m *GenerateReportYAMLRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if all {
-		switch v := interface{}(m.GetOptions()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GenerateReportYAMLRequestValidationError{
-					field:  "Options",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GenerateReportYAMLRequestValidationError{
-					field:  "Options",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	if len(m.GetDomains()) < 1 {
+		err := SetSSOProviderRequestValidationError{
+			field:  "Domains",
+			reason: "value must contain at least 1 item(s)",
 		}
-	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GenerateReportYAMLRequestValidationError{
-				field:  "Options",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
+	// no validation rules for JitRole
+
+	// no validation rules for OidcIssuerUrl
+
+	// no validation rules for OidcClientId
+
+	// no validation rules for OidcClientSecret
+
+	// no validation rules for SamlIdpMetadataUrl
+
+	// no validation rules for SamlIdpMetadataXml
+
+	// no validation rules for EmailClaim
+
+	// no validation rules for NameClaim
+
+	// no validation rules for GroupsClaim
+
 	if len(errors) > 0 {
-		return GenerateReportYAMLRequestMultiError(errors)
+		return SetSSOProviderRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateReportYAMLRequestMultiError is an error wrapping multiple validation
-// errors returned by GenerateReportYAMLRequest.ValidateAll() if the
-// designated constraints aren't met.
-type GenerateReportYAMLRequestMultiError []error
+// SetSSOProviderRequestMultiError is an error wrapping multiple validation
+// errors returned by SetSSOProviderRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetSSOProviderRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateReportYAMLRequestMultiError) Error() string {
+func (m SetSSOProviderRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -18820,11 +19705,11 @@ func (m GenerateReportYAMLRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateReportYAMLRequestMultiError) AllErrors() []error { return m }
+func (m SetSSOProviderRequestMultiError) AllErrors() []error { return m }
 
-// GenerateReportYAMLRequestValidationError is the validation error returned by
-// GenerateReportYAMLRequest.Validate if the designated constraints aren't met.
-type GenerateReportYAMLRequestValidationError struct {
+// SetSSOProviderRequestValidationError is the validation error returned by
+// SetSSOProviderRequest.Validate if the designated constraints aren't met.
+type SetSSOProviderRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -18832,24 +19717,24 @@ type GenerateReportYAMLRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateReportYAMLRequestValidationError) Field() string { return e.field }
+func (e SetSSOProviderRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateReportYAMLRequestValidationError) Reason() string { return e.reason }
+func (e SetSSOProviderRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateReportYAMLRequestValidationError) Cause() error { return e.cause }
+func (e SetSSOProviderRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateReportYAMLRequestValidationError) Key() bool { return e.key }
+func (e SetSSOProviderRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateReportYAMLRequestValidationError) ErrorName() string {
-	return "GenerateReportYAMLRequestValidationError"
+func (e SetSSOProviderRequestValidationError) ErrorName() string {
+	return "SetSSOProviderRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GenerateReportYAMLRequestValidationError) Error() string {
+func (e SetSSOProviderRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -18861,14 +19746,14 @@ func (e GenerateReportYAMLRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateReportYAMLRequest.%s: %s%s",
+		"invalid %sSetSSOProviderRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateReportYAMLRequestValidationError{}
+var _ error = SetSSOProviderRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -18876,46 +19761,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateReportYAMLRequestValidationError{}
+} = SetSSOProviderRequestValidationError{}
 
-// Validate checks the field values on GenerateReportYAMLResponse with the
-// rules defined in the proto definition for this message. If any rules are
+var _SetSSOProviderRequest_Type_InLookup = map[string]struct{}{
+	"oidc": {},
+	"saml": {},
+}
+
+// Validate checks the field values on SetSSOProviderResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GenerateReportYAMLResponse) Validate() error {
+func (m *SetSSOProviderResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateReportYAMLResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on SetSSOProviderResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GenerateReportYAMLResponseMultiError, or nil if none found.
-func (m *GenerateReportYAMLResponse) ValidateAll() error {
+// SetSSOProviderResponseMultiError, or nil if none found.
+func (m *SetSSOProviderResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateReportYAMLResponse) validate(all bool) error {
+func (m *SetSSOProviderResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Yaml
+	if all {
+		switch v := interface{}(m.GetProvider()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetSSOProviderResponseValidationError{
+					field:  "Provider",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetSSOProviderResponseValidationError{
+					field:  "Provider",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProvider()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetSSOProviderResponseValidationError{
+				field:  "Provider",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return GenerateReportYAMLResponseMultiError(errors)
+		return SetSSOProviderResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateReportYAMLResponseMultiError is an error wrapping multiple
-// validation errors returned by GenerateReportYAMLResponse.ValidateAll() if
-// the designated constraints aren't met.
-type GenerateReportYAMLResponseMultiError []error
+// SetSSOProviderResponseMultiError is an error wrapping multiple validation
+// errors returned by SetSSOProviderResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SetSSOProviderResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateReportYAMLResponseMultiError) Error() string {
+func (m SetSSOProviderResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -18924,11 +19841,11 @@ func (m GenerateReportYAMLResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateReportYAMLResponseMultiError) AllErrors() []error { return m }
+func (m SetSSOProviderResponseMultiError) AllErrors() []error { return m }
 
-// GenerateReportYAMLResponseValidationError is the validation error returned
-// by GenerateReportYAMLResponse.Validate if the designated constraints aren't met.
-type GenerateReportYAMLResponseValidationError struct {
+// SetSSOProviderResponseValidationError is the validation error returned by
+// SetSSOProviderResponse.Validate if the designated constraints aren't met.
+type SetSSOProviderResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -18936,24 +19853,24 @@ type GenerateReportYAMLResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateReportYAMLResponseValidationError) Field() string { return e.field }
+func (e SetSSOProviderResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateReportYAMLResponseValidationError) Reason() string { return e.reason }
+func (e SetSSOProviderResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateReportYAMLResponseValidationError) Cause() error { return e.cause }
+func (e SetSSOProviderResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateReportYAMLResponseValidationError) Key() bool { return e.key }
+func (e SetSSOProviderResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateReportYAMLResponseValidationError) ErrorName() string {
-	return "GenerateReportYAMLResponseValidationError"
+func (e SetSSOProviderResponseValidationError) ErrorName() string {
+	return "SetSSOProviderResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GenerateReportYAMLResponseValidationError) Error() string {
+func (e SetSSOProviderResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -18965,14 +19882,14 @@ func (e GenerateReportYAMLResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateReportYAMLResponse.%s: %s%s",
+		"invalid %sSetSSOProviderResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateReportYAMLResponseValidationError{}
+var _ error = SetSSOProviderResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -18980,38 +19897,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateReportYAMLResponseValidationError{}
+} = SetSSOProviderResponseValidationError{}
 
-// Validate checks the field values on CreateAlertRequest with the rules
+// Validate checks the field values on DeleteSSOProviderRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateAlertRequest) Validate() error {
+func (m *DeleteSSOProviderRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateAlertRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on DeleteSSOProviderRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateAlertRequestMultiError, or nil if none found.
-func (m *CreateAlertRequest) ValidateAll() error {
+// DeleteSSOProviderRequestMultiError, or nil if none found.
+func (m *DeleteSSOProviderRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateAlertRequest) validate(all bool) error {
+func (m *DeleteSSOProviderRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Organization
-
-	// no validation rules for Project
-
-	if m.GetOptions() == nil {
-		err := CreateAlertRequestValidationError{
-			field:  "Options",
-			reason: "value is required",
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := DeleteSSOProviderRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
 		}
 		if !all {
 			return err
@@ -19019,49 +19932,20 @@ func (m *CreateAlertRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if all {
-		switch v := interface{}(m.GetOptions()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateAlertRequestValidationError{
-					field:  "Options",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateAlertRequestValidationError{
-					field:  "Options",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateAlertRequestValidationError{
-				field:  "Options",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	if len(errors) > 0 {
-		return CreateAlertRequestMultiError(errors)
+		return DeleteSSOProviderRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateAlertRequestMultiError is an error wrapping multiple validation errors
-// returned by CreateAlertRequest.ValidateAll() if the designated constraints
-// aren't met.
-type CreateAlertRequestMultiError []error
+// DeleteSSOProviderRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteSSOProviderRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteSSOProviderRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateAlertRequestMultiError) Error() string {
+func (m DeleteSSOProviderRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -19070,11 +19954,11 @@ func (m CreateAlertRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateAlertRequestMultiError) AllErrors() []error { return m }
+func (m DeleteSSOProviderRequestMultiError) AllErrors() []error { return m }
 
-// CreateAlertRequestValidationError is the validation error returned by
-// CreateAlertRequest.Validate if the designated constraints aren't met.
-type CreateAlertRequestValidationError struct {
+// DeleteSSOProviderRequestValidationError is the validation error returned by
+// DeleteSSOProviderRequest.Validate if the designated constraints aren't met.
+type DeleteSSOProviderRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -19082,24 +19966,24 @@ type CreateAlertRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateAlertRequestValidationError) Field() string { return e.field }
+func (e DeleteSSOProviderRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateAlertRequestValidationError) Reason() string { return e.reason }
+func (e DeleteSSOProviderRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateAlertRequestValidationError) Cause() error { return e.cause }
+func (e DeleteSSOProviderRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateAlertRequestValidationError) Key() bool { return e.key }
+func (e DeleteSSOProviderRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateAlertRequestValidationError) ErrorName() string {
-	return "CreateAlertRequestValidationError"
+func (e DeleteSSOProviderRequestValidationError) ErrorName() string {
+	return "DeleteSSOProviderRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateAlertRequestValidationError) Error() string {
+func (e DeleteSSOProviderRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -19111,14 +19995,14 @@ func (e CreateAlertRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateAlertRequest.%s: %s%s",
+		"invalid %sDeleteSSOProviderRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateAlertRequestValidationError{}
+var _ error = DeleteSSOProviderRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -19126,46 +20010,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateAlertRequestValidationError{}
+} = DeleteSSOProviderRequestValidationError{}
 
-// Validate checks the field values on CreateAlertResponse with the rules
+// Validate checks the field values on DeleteSSOProviderResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateAlertResponse) Validate() error {
+func (m *DeleteSSOProviderResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateAlertResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on DeleteSSOProviderResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateAlertResponseMultiError, or nil if none found.
-func (m *CreateAlertResponse) ValidateAll() error {
+// DeleteSSOProviderResponseMultiError, or nil if none found.
+func (m *DeleteSSOProviderResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateAlertResponse) validate(all bool) error {
+func (m *DeleteSSOProviderResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Name
-
 	if len(errors) > 0 {
-		return CreateAlertResponseMultiError(errors)
+		return DeleteSSOProviderResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateAlertResponseMultiError is an error wrapping multiple validation
-// errors returned by CreateAlertResponse.ValidateAll() if the designated
-// constraints aren't met.
-type CreateAlertResponseMultiError []error
+// DeleteSSOProviderResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteSSOProviderResponse.ValidateAll() if the
+// designated constraints aren't met.
+type DeleteSSOProviderResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateAlertResponseMultiError) Error() string {
+func (m DeleteSSOProviderResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -19174,11 +20056,11 @@ func (m CreateAlertResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateAlertResponseMultiError) AllErrors() []error { return m }
+func (m DeleteSSOProviderResponseMultiError) AllErrors() []error { return m }
 
-// CreateAlertResponseValidationError is the validation error returned by
-// CreateAlertResponse.Validate if the designated constraints aren't met.
-type CreateAlertResponseValidationError struct {
+// DeleteSSOProviderResponseValidationError is the validation error returned by
+// DeleteSSOProviderResponse.Validate if the designated constraints aren't met.
+type DeleteSSOProviderResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -19186,24 +20068,24 @@ type CreateAlertResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateAlertResponseValidationError) Field() string { return e.field }
+func (e DeleteSSOProviderResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateAlertResponseValidationError) Reason() string { return e.reason }
+func (e DeleteSSOProviderResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateAlertResponseValidationError) Cause() error { return e.cause }
+func (e DeleteSSOProviderResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateAlertResponseValidationError) Key() bool { return e.key }
+func (e DeleteSSOProviderResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateAlertResponseValidationError) ErrorName() string {
-	return "CreateAlertResponseValidationError"
+func (e DeleteSSOProviderResponseValidationError) ErrorName() string {
+	return "DeleteSSOProviderResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateAlertResponseValidationError) Error() string {
+func (e DeleteSSOProviderResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -19215,14 +20097,14 @@ func (e CreateAlertResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateAlertResponse.%s: %s%s",
+		"invalid %sDeleteSSOProviderResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateAlertResponseValidationError{}
+var _ error = DeleteSSOProviderResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -19230,40 +20112,57 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateAlertResponseValidationError{}
+} = DeleteSSOProviderResponseValidationError{}
 
-// Validate checks the field values on EditAlertRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *EditAlertRequest) Validate() error {
+// Validate checks the field values on CreateProjectWhitelistedDomainRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *CreateProjectWhitelistedDomainRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on EditAlertRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// EditAlertRequestMultiError, or nil if none found.
-func (m *EditAlertRequest) ValidateAll() error {
+// ValidateAll checks the field values on CreateProjectWhitelistedDomainRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// CreateProjectWhitelistedDomainRequestMultiError, or nil if none found.
+func (m *CreateProjectWhitelistedDomainRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *EditAlertRequest) validate(all bool) error {
+func (m *CreateProjectWhitelistedDomainRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Organization
-
-	// no validation rules for Project
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := CreateProjectWhitelistedDomainRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Name
+	if utf8.RuneCountInString(m.GetProject()) < 1 {
+		err := CreateProjectWhitelistedDomainRequestValidationError{
+			field:  "Project",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	if m.GetOptions() == nil {
-		err := EditAlertRequestValidationError{
-			field:  "Options",
-			reason: "value is required",
+	if utf8.RuneCountInString(m.GetDomain()) < 1 {
+		err := CreateProjectWhitelistedDomainRequestValidationError{
+			field:  "Domain",
+			reason: "value length must be at least 1 runes",
 		}
 		if !all {
 			return err
@@ -19271,49 +20170,32 @@ func (m *EditAlertRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if all {
-		switch v := interface{}(m.GetOptions()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, EditAlertRequestValidationError{
-					field:  "Options",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, EditAlertRequestValidationError{
-					field:  "Options",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	if utf8.RuneCountInString(m.GetRole()) < 1 {
+		err := CreateProjectWhitelistedDomainRequestValidationError{
+			field:  "Role",
+			reason: "value length must be at least 1 runes",
 		}
-	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return EditAlertRequestValidationError{
-				field:  "Options",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return EditAlertRequestMultiError(errors)
+		return CreateProjectWhitelistedDomainRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// EditAlertRequestMultiError is an error wrapping multiple validation errors
-// returned by EditAlertRequest.ValidateAll() if the designated constraints
-// aren't met.
-type EditAlertRequestMultiError []error
+// CreateProjectWhitelistedDomainRequestMultiError is an error wrapping
+// multiple validation errors returned by
+// CreateProjectWhitelistedDomainRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateProjectWhitelistedDomainRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m EditAlertRequestMultiError) Error() string {
+func (m CreateProjectWhitelistedDomainRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -19322,11 +20204,12 @@ func (m EditAlertRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m EditAlertRequestMultiError) AllErrors() []error { return m }
+func (m CreateProjectWhitelistedDomainRequestMultiError) AllErrors() []error { return m }
 
-// EditAlertRequestValidationError is the validation error returned by
-// EditAlertRequest.Validate if the designated constraints aren't met.
-type EditAlertRequestValidationError struct {
+// CreateProjectWhitelistedDomainRequestValidationError is the validation error
+// returned by CreateProjectWhitelistedDomainRequest.Validate if the
+// designated constraints aren't met.
+type CreateProjectWhitelistedDomainRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -19334,22 +20217,24 @@ type EditAlertRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e EditAlertRequestValidationError) Field() string { return e.field }
+func (e CreateProjectWhitelistedDomainRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e EditAlertRequestValidationError) Reason() string { return e.reason }
+func (e CreateProjectWhitelistedDomainRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e EditAlertRequestValidationError) Cause() error { return e.cause }
+func (e CreateProjectWhitelistedDomainRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e EditAlertRequestValidationError) Key() bool { return e.key }
+func (e CreateProjectWhitelistedDomainRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e EditAlertRequestValidationError) ErrorName() string { return "EditAlertRequestValidationError" }
+func (e CreateProjectWhitelistedDomainRequestValidationError) ErrorName() string {
+	return "CreateProjectWhitelistedDomainRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e EditAlertRequestValidationError) Error() string {
+func (e CreateProjectWhitelistedDomainRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -19361,14 +20246,14 @@ func (e EditAlertRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sEditAlertRequest.%s: %s%s",
+		"invalid %sCreateProjectWhitelistedDomainRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = EditAlertRequestValidationError{}
+var _ error = CreateProjectWhitelistedDomainRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -19376,24 +20261,26 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = EditAlertRequestValidationError{}
+} = CreateProjectWhitelistedDomainRequestValidationError{}
 
-// Validate checks the field values on EditAlertResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *EditAlertResponse) Validate() error {
+// Validate checks the field values on CreateProjectWhitelistedDomainResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *CreateProjectWhitelistedDomainResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on EditAlertResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// EditAlertResponseMultiError, or nil if none found.
-func (m *EditAlertResponse) ValidateAll() error {
+// ValidateAll checks the field values on
+// CreateProjectWhitelistedDomainResponse with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// CreateProjectWhitelistedDomainResponseMultiError, or nil if none found.
+func (m *CreateProjectWhitelistedDomainResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *EditAlertResponse) validate(all bool) error {
+func (m *CreateProjectWhitelistedDomainResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -19401,19 +20288,20 @@ func (m *EditAlertResponse) validate(all bool) error {
 	var errors []error
 
 	if len(errors) > 0 {
-		return EditAlertResponseMultiError(errors)
+		return CreateProjectWhitelistedDomainResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// EditAlertResponseMultiError is an error wrapping multiple validation errors
-// returned by EditAlertResponse.ValidateAll() if the designated constraints
-// aren't met.
-type EditAlertResponseMultiError []error
+// CreateProjectWhitelistedDomainResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// CreateProjectWhitelistedDomainResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateProjectWhitelistedDomainResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m EditAlertResponseMultiError) Error() string {
+func (m CreateProjectWhitelistedDomainResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -19422,11 +20310,12 @@ func (m EditAlertResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m EditAlertResponseMultiError) AllErrors() []error { return m }
+func (m CreateProjectWhitelistedDomainResponseMultiError) AllErrors() []error { return m }
 
-// EditAlertResponseValidationError is the validation error returned by
-// EditAlertResponse.Validate if the designated constraints aren't met.
-type EditAlertResponseValidationError struct {
+// CreateProjectWhitelistedDomainResponseValidationError is the validation
+// error returned by CreateProjectWhitelistedDomainResponse.Validate if the
+// designated constraints aren't met.
+type CreateProjectWhitelistedDomainResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -19434,24 +20323,24 @@ type EditAlertResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e EditAlertResponseValidationError) Field() string { return e.field }
+func (e CreateProjectWhitelistedDomainResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e EditAlertResponseValidationError) Reason() string { return e.reason }
+func (e CreateProjectWhitelistedDomainResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e EditAlertResponseValidationError) Cause() error { return e.cause }
+func (e CreateProjectWhitelistedDomainResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e EditAlertResponseValidationError) Key() bool { return e.key }
+func (e CreateProjectWhitelistedDomainResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e EditAlertResponseValidationError) ErrorName() string {
-	return "EditAlertResponseValidationError"
+func (e CreateProjectWhitelistedDomainResponseValidationError) ErrorName() string {
+	return "CreateProjectWhitelistedDomainResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e EditAlertResponseValidationError) Error() string {
+func (e CreateProjectWhitelistedDomainResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -19463,14 +20352,14 @@ func (e EditAlertResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sEditAlertResponse.%s: %s%s",
+		"invalid %sCreateProjectWhitelistedDomainResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = EditAlertResponseValidationError{}
+var _ error = CreateProjectWhitelistedDomainResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -19478,50 +20367,79 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = EditAlertResponseValidationError{}
+} = CreateProjectWhitelistedDomainResponseValidationError{}
 
-// Validate checks the field values on UnsubscribeAlertRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UnsubscribeAlertRequest) Validate() error {
+// Validate checks the field values on RemoveProjectWhitelistedDomainRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *RemoveProjectWhitelistedDomainRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UnsubscribeAlertRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UnsubscribeAlertRequestMultiError, or nil if none found.
-func (m *UnsubscribeAlertRequest) ValidateAll() error {
+// ValidateAll checks the field values on RemoveProjectWhitelistedDomainRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// RemoveProjectWhitelistedDomainRequestMultiError, or nil if none found.
+func (m *RemoveProjectWhitelistedDomainRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UnsubscribeAlertRequest) validate(all bool) error {
+func (m *RemoveProjectWhitelistedDomainRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Organization
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := RemoveProjectWhitelistedDomainRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Project
+	if utf8.RuneCountInString(m.GetProject()) < 1 {
+		err := RemoveProjectWhitelistedDomainRequestValidationError{
+			field:  "Project",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Name
+	if utf8.RuneCountInString(m.GetDomain()) < 1 {
+		err := RemoveProjectWhitelistedDomainRequestValidationError{
+			field:  "Domain",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return UnsubscribeAlertRequestMultiError(errors)
+		return RemoveProjectWhitelistedDomainRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UnsubscribeAlertRequestMultiError is an error wrapping multiple validation
-// errors returned by UnsubscribeAlertRequest.ValidateAll() if the designated
+// RemoveProjectWhitelistedDomainRequestMultiError is an error wrapping
+// multiple validation errors returned by
+// RemoveProjectWhitelistedDomainRequest.ValidateAll() if the designated
 // constraints aren't met.
-type UnsubscribeAlertRequestMultiError []error
+type RemoveProjectWhitelistedDomainRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UnsubscribeAlertRequestMultiError) Error() string {
+func (m RemoveProjectWhitelistedDomainRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -19530,11 +20448,12 @@ func (m UnsubscribeAlertRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UnsubscribeAlertRequestMultiError) AllErrors() []error { return m }
+func (m RemoveProjectWhitelistedDomainRequestMultiError) AllErrors() []error { return m }
 
-// UnsubscribeAlertRequestValidationError is the validation error returned by
-// UnsubscribeAlertRequest.Validate if the designated constraints aren't met.
-type UnsubscribeAlertRequestValidationError struct {
+// RemoveProjectWhitelistedDomainRequestValidationError is the validation error
+// returned by RemoveProjectWhitelistedDomainRequest.Validate if the
+// designated constraints aren't met.
+type RemoveProjectWhitelistedDomainRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -19542,24 +20461,24 @@ type UnsubscribeAlertRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UnsubscribeAlertRequestValidationError) Field() string { return e.field }
+func (e RemoveProjectWhitelistedDomainRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UnsubscribeAlertRequestValidationError) Reason() string { return e.reason }
+func (e RemoveProjectWhitelistedDomainRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UnsubscribeAlertRequestValidationError) Cause() error { return e.cause }
+func (e RemoveProjectWhitelistedDomainRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UnsubscribeAlertRequestValidationError) Key() bool { return e.key }
+func (e RemoveProjectWhitelistedDomainRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UnsubscribeAlertRequestValidationError) ErrorName() string {
-	return "UnsubscribeAlertRequestValidationError"
+func (e RemoveProjectWhitelistedDomainRequestValidationError) ErrorName() string {
+	return "RemoveProjectWhitelistedDomainRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UnsubscribeAlertRequestValidationError) Error() string {
+func (e RemoveProjectWhitelistedDomainRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -19571,14 +20490,14 @@ func (e UnsubscribeAlertRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUnsubscribeAlertRequest.%s: %s%s",
+		"invalid %sRemoveProjectWhitelistedDomainRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UnsubscribeAlertRequestValidationError{}
+var _ error = RemoveProjectWhitelistedDomainRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -19586,24 +20505,26 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UnsubscribeAlertRequestValidationError{}
+} = RemoveProjectWhitelistedDomainRequestValidationError{}
 
-// Validate checks the field values on UnsubscribeAlertResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UnsubscribeAlertResponse) Validate() error {
+// Validate checks the field values on RemoveProjectWhitelistedDomainResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *RemoveProjectWhitelistedDomainResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UnsubscribeAlertResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UnsubscribeAlertResponseMultiError, or nil if none found.
-func (m *UnsubscribeAlertResponse) ValidateAll() error {
+// ValidateAll checks the field values on
+// RemoveProjectWhitelistedDomainResponse with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// RemoveProjectWhitelistedDomainResponseMultiError, or nil if none found.
+func (m *RemoveProjectWhitelistedDomainResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UnsubscribeAlertResponse) validate(all bool) error {
+func (m *RemoveProjectWhitelistedDomainResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -19611,19 +20532,20 @@ func (m *UnsubscribeAlertResponse) validate(all bool) error {
 	var errors []error
 
 	if len(errors) > 0 {
-		return UnsubscribeAlertResponseMultiError(errors)
+		return RemoveProjectWhitelistedDomainResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UnsubscribeAlertResponseMultiError is an error wrapping multiple validation
-// errors returned by UnsubscribeAlertResponse.ValidateAll() if the designated
+// RemoveProjectWhitelistedDomainResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// RemoveProjectWhitelistedDomainResponse.ValidateAll() if the designated
 // constraints aren't met.
-type UnsubscribeAlertResponseMultiError []error
+type RemoveProjectWhitelistedDomainResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UnsubscribeAlertResponseMultiError) Error() string {
+func (m RemoveProjectWhitelistedDomainResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -19632,11 +20554,12 @@ func (m UnsubscribeAlertResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UnsubscribeAlertResponseMultiError) AllErrors() []error { return m }
+func (m RemoveProjectWhitelistedDomainResponseMultiError) AllErrors() []error { return m }
 
-// UnsubscribeAlertResponseValidationError is the validation error returned by
-// UnsubscribeAlertResponse.Validate if the designated constraints aren't met.
-type UnsubscribeAlertResponseValidationError struct {
+// RemoveProjectWhitelistedDomainResponseValidationError is the validation
+// error returned by RemoveProjectWhitelistedDomainResponse.Validate if the
+// designated constraints aren't met.
+type RemoveProjectWhitelistedDomainResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -19644,24 +20567,24 @@ type UnsubscribeAlertResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UnsubscribeAlertResponseValidationError) Field() string { return e.field }
+func (e RemoveProjectWhitelistedDomainResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UnsubscribeAlertResponseValidationError) Reason() string { return e.reason }
+func (e RemoveProjectWhitelistedDomainResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UnsubscribeAlertResponseValidationError) Cause() error { return e.cause }
+func (e RemoveProjectWhitelistedDomainResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UnsubscribeAlertResponseValidationError) Key() bool { return e.key }
+func (e RemoveProjectWhitelistedDomainResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UnsubscribeAlertResponseValidationError) ErrorName() string {
-	return "UnsubscribeAlertResponseValidationError"
+func (e RemoveProjectWhitelistedDomainResponseValidationError) ErrorName() string {
+	return "RemoveProjectWhitelistedDomainResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UnsubscribeAlertResponseValidationError) Error() string {
+func (e RemoveProjectWhitelistedDomainResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -19673,14 +20596,14 @@ func (e UnsubscribeAlertResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUnsubscribeAlertResponse.%s: %s%s",
+		"invalid %sRemoveProjectWhitelistedDomainResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UnsubscribeAlertResponseValidationError{}
+var _ error = RemoveProjectWhitelistedDomainResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -19688,50 +20611,68 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UnsubscribeAlertResponseValidationError{}
+} = RemoveProjectWhitelistedDomainResponseValidationError{}
 
-// Validate checks the field values on DeleteAlertRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteAlertRequest) Validate() error {
+// Validate checks the field values on ListProjectWhitelistedDomainsRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *ListProjectWhitelistedDomainsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteAlertRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// DeleteAlertRequestMultiError, or nil if none found.
-func (m *DeleteAlertRequest) ValidateAll() error {
+// ValidateAll checks the field values on ListProjectWhitelistedDomainsRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ListProjectWhitelistedDomainsRequestMultiError, or nil if none found.
+func (m *ListProjectWhitelistedDomainsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteAlertRequest) validate(all bool) error {
+func (m *ListProjectWhitelistedDomainsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Organization
-
-	// no validation rules for Project
+	if utf8.RuneCountInString(m.GetOrganization()) < 1 {
+		err := ListProjectWhitelistedDomainsRequestValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Name
+	if utf8.RuneCountInString(m.GetProject()) < 1 {
+		err := ListProjectWhitelistedDomainsRequestValidationError{
+			field:  "Project",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return DeleteAlertRequestMultiError(errors)
+		return ListProjectWhitelistedDomainsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteAlertRequestMultiError is an error wrapping multiple validation errors
-// returned by DeleteAlertRequest.ValidateAll() if the designated constraints
-// aren't met.
-type DeleteAlertRequestMultiError []error
+// ListProjectWhitelistedDomainsRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// ListProjectWhitelistedDomainsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListProjectWhitelistedDomainsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteAlertRequestMultiError) Error() string {
+func (m ListProjectWhitelistedDomainsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -19740,11 +20681,12 @@ func (m DeleteAlertRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteAlertRequestMultiError) AllErrors() []error { return m }
+func (m ListProjectWhitelistedDomainsRequestMultiError) AllErrors() []error { return m }
 
-// DeleteAlertRequestValidationError is the validation error returned by
-// DeleteAlertRequest.Validate if the designated constraints aren't met.
-type DeleteAlertRequestValidationError struct {
+// ListProjectWhitelistedDomainsRequestValidationError is the validation error
+// returned by ListProjectWhitelistedDomainsRequest.Validate if the designated
+// constraints aren't met.
+type ListProjectWhitelistedDomainsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -19752,24 +20694,24 @@ type DeleteAlertRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteAlertRequestValidationError) Field() string { return e.field }
+func (e ListProjectWhitelistedDomainsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteAlertRequestValidationError) Reason() string { return e.reason }
+func (e ListProjectWhitelistedDomainsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteAlertRequestValidationError) Cause() error { return e.cause }
+func (e ListProjectWhitelistedDomainsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteAlertRequestValidationError) Key() bool { return e.key }
+func (e ListProjectWhitelistedDomainsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteAlertRequestValidationError) ErrorName() string {
-	return "DeleteAlertRequestValidationError"
+func (e ListProjectWhitelistedDomainsRequestValidationError) ErrorName() string {
+	return "ListProjectWhitelistedDomainsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteAlertRequestValidationError) Error() string {
+func (e ListProjectWhitelistedDomainsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -19781,14 +20723,14 @@ func (e DeleteAlertRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteAlertRequest.%s: %s%s",
+		"invalid %sListProjectWhitelistedDomainsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteAlertRequestValidationError{}
+var _ error = ListProjectWhitelistedDomainsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -19796,44 +20738,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteAlertRequestValidationError{}
+} = ListProjectWhitelistedDomainsRequestValidationError{}
 
-// Validate checks the field values on DeleteAlertResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteAlertResponse) Validate() error {
+// Validate checks the field values on ListProjectWhitelistedDomainsResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *ListProjectWhitelistedDomainsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteAlertResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// DeleteAlertResponseMultiError, or nil if none found.
-func (m *DeleteAlertResponse) ValidateAll() error {
+// ValidateAll checks the field values on ListProjectWhitelistedDomainsResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ListProjectWhitelistedDomainsResponseMultiError, or nil if none found.
+func (m *ListProjectWhitelistedDomainsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteAlertResponse) validate(all bool) error {
+func (m *ListProjectWhitelistedDomainsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	for idx, item := range m.GetDomains() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListProjectWhitelistedDomainsResponseValidationError{
+						field:  fmt.Sprintf("Domains[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListProjectWhitelistedDomainsResponseValidationError{
+						field:  fmt.Sprintf("Domains[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListProjectWhitelistedDomainsResponseValidationError{
+					field:  fmt.Sprintf("Domains[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
-		return DeleteAlertResponseMultiError(errors)
+		return ListProjectWhitelistedDomainsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteAlertResponseMultiError is an error wrapping multiple validation
-// errors returned by DeleteAlertResponse.ValidateAll() if the designated
+// ListProjectWhitelistedDomainsResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// ListProjectWhitelistedDomainsResponse.ValidateAll() if the designated
 // constraints aren't met.
-type DeleteAlertResponseMultiError []error
+type ListProjectWhitelistedDomainsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteAlertResponseMultiError) Error() string {
+func (m ListProjectWhitelistedDomainsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -19842,11 +20820,12 @@ func (m DeleteAlertResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteAlertResponseMultiError) AllErrors() []error { return m }
+func (m ListProjectWhitelistedDomainsResponseMultiError) AllErrors() []error { return m }
 
-// DeleteAlertResponseValidationError is the validation error returned by
-// DeleteAlertResponse.Validate if the designated constraints aren't met.
-type DeleteAlertResponseValidationError struct {
+// ListProjectWhitelistedDomainsResponseValidationError is the validation error
+// returned by ListProjectWhitelistedDomainsResponse.Validate if the
+// designated constraints aren't met.
+type ListProjectWhitelistedDomainsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -19854,24 +20833,24 @@ type DeleteAlertResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteAlertResponseValidationError) Field() string { return e.field }
+func (e ListProjectWhitelistedDomainsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteAlertResponseValidationError) Reason() string { return e.reason }
+func (e ListProjectWhitelistedDomainsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteAlertResponseValidationError) Cause() error { return e.cause }
+func (e ListProjectWhitelistedDomainsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteAlertResponseValidationError) Key() bool { return e.key }
+func (e ListProjectWhitelistedDomainsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteAlertResponseValidationError) ErrorName() string {
-	return "DeleteAlertResponseValidationError"
+func (e ListProjectWhitelistedDomainsResponseValidationError) ErrorName() string {
+	return "ListProjectWhitelistedDomainsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteAlertResponseValidationError) Error() string {
+func (e ListProjectWhitelistedDomainsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -19883,14 +20862,14 @@ func (e DeleteAlertResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteAlertResponse.%s: %s%s",
+		"invalid %sListProjectWhitelistedDomainsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteAlertResponseValidationError{}
+var _ error = ListProjectWhitelistedDomainsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -19898,88 +20877,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteAlertResponseValidationError{}
+} = ListProjectWhitelistedDomainsResponseValidationError{}
 
-// Validate checks the field values on GenerateAlertYAMLRequest with the rules
+// Validate checks the field values on GetRepoMetaRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GenerateAlertYAMLRequest) Validate() error {
+func (m *GetRepoMetaRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateAlertYAMLRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetRepoMetaRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GenerateAlertYAMLRequestMultiError, or nil if none found.
-func (m *GenerateAlertYAMLRequest) ValidateAll() error {
+// GetRepoMetaRequestMultiError, or nil if none found.
+func (m *GetRepoMetaRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateAlertYAMLRequest) validate(all bool) error {
+func (m *GetRepoMetaRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Organization
-
-	// no validation rules for Project
-
-	if m.GetOptions() == nil {
-		err := GenerateAlertYAMLRequestValidationError{
-			field:  "Options",
-			reason: "value is required",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for ProjectId
 
-	if all {
-		switch v := interface{}(m.GetOptions()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GenerateAlertYAMLRequestValidationError{
-					field:  "Options",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GenerateAlertYAMLRequestValidationError{
-					field:  "Options",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GenerateAlertYAMLRequestValidationError{
-				field:  "Options",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Branch
 
 	if len(errors) > 0 {
-		return GenerateAlertYAMLRequestMultiError(errors)
+		return GetRepoMetaRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateAlertYAMLRequestMultiError is an error wrapping multiple validation
-// errors returned by GenerateAlertYAMLRequest.ValidateAll() if the designated
-// constraints aren't met.
-type GenerateAlertYAMLRequestMultiError []error
+// GetRepoMetaRequestMultiError is an error wrapping multiple validation errors
+// returned by GetRepoMetaRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetRepoMetaRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateAlertYAMLRequestMultiError) Error() string {
+func (m GetRepoMetaRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -19988,11 +20927,11 @@ func (m GenerateAlertYAMLRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateAlertYAMLRequestMultiError) AllErrors() []error { return m }
+func (m GetRepoMetaRequestMultiError) AllErrors() []error { return m }
 
-// GenerateAlertYAMLRequestValidationError is the validation error returned by
-// GenerateAlertYAMLRequest.Validate if the designated constraints aren't met.
-type GenerateAlertYAMLRequestValidationError struct {
+// GetRepoMetaRequestValidationError is the validation error returned by
+// GetRepoMetaRequest.Validate if the designated constraints aren't met.
+type GetRepoMetaRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -20000,24 +20939,24 @@ type GenerateAlertYAMLRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateAlertYAMLRequestValidationError) Field() string { return e.field }
+func (e GetRepoMetaRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateAlertYAMLRequestValidationError) Reason() string { return e.reason }
+func (e GetRepoMetaRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateAlertYAMLRequestValidationError) Cause() error { return e.cause }
+func (e GetRepoMetaRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateAlertYAMLRequestValidationError) Key() bool { return e.key }
+func (e GetRepoMetaRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateAlertYAMLRequestValidationError) ErrorName() string {
-	return "GenerateAlertYAMLRequestValidationError"
+func (e GetRepoMetaRequestValidationError) ErrorName() string {
+	return "GetRepoMetaRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GenerateAlertYAMLRequestValidationError) Error() string {
+func (e GetRepoMetaRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -20029,14 +20968,14 @@ func (e GenerateAlertYAMLRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateAlertYAMLRequest.%s: %s%s",
+		"invalid %sGetRepoMetaRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateAlertYAMLRequestValidationError{}
+var _ error = GetRepoMetaRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -20044,46 +20983,77 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateAlertYAMLRequestValidationError{}
+} = GetRepoMetaRequestValidationError{}
 
-// Validate checks the field values on GenerateAlertYAMLResponse with the rules
+// Validate checks the field values on GetRepoMetaResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GenerateAlertYAMLResponse) Validate() error {
+func (m *GetRepoMetaResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateAlertYAMLResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetRepoMetaResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GenerateAlertYAMLResponseMultiError, or nil if none found.
-func (m *GenerateAlertYAMLResponse) ValidateAll() error {
+// GetRepoMetaResponseMultiError, or nil if none found.
+func (m *GetRepoMetaResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateAlertYAMLResponse) validate(all bool) error {
+func (m *GetRepoMetaResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Yaml
+	// no validation rules for GitUrl
+
+	if all {
+		switch v := interface{}(m.GetGitUrlExpiresOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetRepoMetaResponseValidationError{
+					field:  "GitUrlExpiresOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetRepoMetaResponseValidationError{
+					field:  "GitUrlExpiresOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGitUrlExpiresOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetRepoMetaResponseValidationError{
+				field:  "GitUrlExpiresOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for GitSubpath
 
 	if len(errors) > 0 {
-		return GenerateAlertYAMLResponseMultiError(errors)
+		return GetRepoMetaResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateAlertYAMLResponseMultiError is an error wrapping multiple validation
-// errors returned by GenerateAlertYAMLResponse.ValidateAll() if the
-// designated constraints aren't met.
-type GenerateAlertYAMLResponseMultiError []error
+// GetRepoMetaResponseMultiError is an error wrapping multiple validation
+// errors returned by GetRepoMetaResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetRepoMetaResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateAlertYAMLResponseMultiError) Error() string {
+func (m GetRepoMetaResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -20092,11 +21062,11 @@ func (m GenerateAlertYAMLResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateAlertYAMLResponseMultiError) AllErrors() []error { return m }
+func (m GetRepoMetaResponseMultiError) AllErrors() []error { return m }
 
-// GenerateAlertYAMLResponseValidationError is the validation error returned by
-// GenerateAlertYAMLResponse.Validate if the designated constraints aren't met.
-type GenerateAlertYAMLResponseValidationError struct {
+// GetRepoMetaResponseValidationError is the validation error returned by
+// GetRepoMetaResponse.Validate if the designated constraints aren't met.
+type GetRepoMetaResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -20104,24 +21074,24 @@ type GenerateAlertYAMLResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateAlertYAMLResponseValidationError) Field() string { return e.field }
+func (e GetRepoMetaResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateAlertYAMLResponseValidationError) Reason() string { return e.reason }
+func (e GetRepoMetaResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateAlertYAMLResponseValidationError) Cause() error { return e.cause }
+func (e GetRepoMetaResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateAlertYAMLResponseValidationError) Key() bool { return e.key }
+func (e GetRepoMetaResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateAlertYAMLResponseValidationError) ErrorName() string {
-	return "GenerateAlertYAMLResponseValidationError"
+func (e GetRepoMetaResponseValidationError) ErrorName() string {
+	return "GetRepoMetaResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GenerateAlertYAMLResponseValidationError) Error() string {
+func (e GetRepoMetaResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -20133,14 +21103,14 @@ func (e GenerateAlertYAMLResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateAlertYAMLResponse.%s: %s%s",
+		"invalid %sGetRepoMetaResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateAlertYAMLResponseValidationError{}
+var _ error = GetRepoMetaResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -20148,50 +21118,65 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateAlertYAMLResponseValidationError{}
+} = GetRepoMetaResponseValidationError{}
 
-// Validate checks the field values on GetAlertYAMLRequest with the rules
+// Validate checks the field values on PullVirtualRepoRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetAlertYAMLRequest) Validate() error {
+func (m *PullVirtualRepoRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetAlertYAMLRequest with the rules
+// ValidateAll checks the field values on PullVirtualRepoRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetAlertYAMLRequestMultiError, or nil if none found.
-func (m *GetAlertYAMLRequest) ValidateAll() error {
+// PullVirtualRepoRequestMultiError, or nil if none found.
+func (m *PullVirtualRepoRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetAlertYAMLRequest) validate(all bool) error {
+func (m *PullVirtualRepoRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Organization
+	// no validation rules for ProjectId
 
-	// no validation rules for Project
+	// no validation rules for Branch
 
-	// no validation rules for Name
+	if m.GetPageSize() != 0 {
+
+		if m.GetPageSize() > 100 {
+			err := PullVirtualRepoRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be less than or equal to 100",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for PageToken
 
 	if len(errors) > 0 {
-		return GetAlertYAMLRequestMultiError(errors)
+		return PullVirtualRepoRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetAlertYAMLRequestMultiError is an error wrapping multiple validation
-// errors returned by GetAlertYAMLRequest.ValidateAll() if the designated
+// PullVirtualRepoRequestMultiError is an error wrapping multiple validation
+// errors returned by PullVirtualRepoRequest.ValidateAll() if the designated
 // constraints aren't met.
-type GetAlertYAMLRequestMultiError []error
+type PullVirtualRepoRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetAlertYAMLRequestMultiError) Error() string {
+func (m PullVirtualRepoRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -20200,11 +21185,11 @@ func (m GetAlertYAMLRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetAlertYAMLRequestMultiError) AllErrors() []error { return m }
+func (m PullVirtualRepoRequestMultiError) AllErrors() []error { return m }
 
-// GetAlertYAMLRequestValidationError is the validation error returned by
-// GetAlertYAMLRequest.Validate if the designated constraints aren't met.
-type GetAlertYAMLRequestValidationError struct {
+// PullVirtualRepoRequestValidationError is the validation error returned by
+// PullVirtualRepoRequest.Validate if the designated constraints aren't met.
+type PullVirtualRepoRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -20212,24 +21197,24 @@ type GetAlertYAMLRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetAlertYAMLRequestValidationError) Field() string { return e.field }
+func (e PullVirtualRepoRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetAlertYAMLRequestValidationError) Reason() string { return e.reason }
+func (e PullVirtualRepoRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetAlertYAMLRequestValidationError) Cause() error { return e.cause }
+func (e PullVirtualRepoRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetAlertYAMLRequestValidationError) Key() bool { return e.key }
+func (e PullVirtualRepoRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetAlertYAMLRequestValidationError) ErrorName() string {
-	return "GetAlertYAMLRequestValidationError"
+func (e PullVirtualRepoRequestValidationError) ErrorName() string {
+	return "PullVirtualRepoRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetAlertYAMLRequestValidationError) Error() string {
+func (e PullVirtualRepoRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -20241,14 +21226,14 @@ func (e GetAlertYAMLRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetAlertYAMLRequest.%s: %s%s",
+		"invalid %sPullVirtualRepoRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetAlertYAMLRequestValidationError{}
+var _ error = PullVirtualRepoRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -20256,46 +21241,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetAlertYAMLRequestValidationError{}
+} = PullVirtualRepoRequestValidationError{}
 
-// Validate checks the field values on GetAlertYAMLResponse with the rules
+// Validate checks the field values on PullVirtualRepoResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetAlertYAMLResponse) Validate() error {
+func (m *PullVirtualRepoResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetAlertYAMLResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on PullVirtualRepoResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetAlertYAMLResponseMultiError, or nil if none found.
-func (m *GetAlertYAMLResponse) ValidateAll() error {
+// PullVirtualRepoResponseMultiError, or nil if none found.
+func (m *PullVirtualRepoResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetAlertYAMLResponse) validate(all bool) error {
+func (m *PullVirtualRepoResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Yaml
+	for idx, item := range m.GetFiles() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PullVirtualRepoResponseValidationError{
+						field:  fmt.Sprintf("Files[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PullVirtualRepoResponseValidationError{
+						field:  fmt.Sprintf("Files[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PullVirtualRepoResponseValidationError{
+					field:  fmt.Sprintf("Files[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
 
 	if len(errors) > 0 {
-		return GetAlertYAMLResponseMultiError(errors)
+		return PullVirtualRepoResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetAlertYAMLResponseMultiError is an error wrapping multiple validation
-// errors returned by GetAlertYAMLResponse.ValidateAll() if the designated
+// PullVirtualRepoResponseMultiError is an error wrapping multiple validation
+// errors returned by PullVirtualRepoResponse.ValidateAll() if the designated
 // constraints aren't met.
-type GetAlertYAMLResponseMultiError []error
+type PullVirtualRepoResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetAlertYAMLResponseMultiError) Error() string {
+func (m PullVirtualRepoResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -20304,11 +21323,11 @@ func (m GetAlertYAMLResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetAlertYAMLResponseMultiError) AllErrors() []error { return m }
+func (m PullVirtualRepoResponseMultiError) AllErrors() []error { return m }
 
-// GetAlertYAMLResponseValidationError is the validation error returned by
-// GetAlertYAMLResponse.Validate if the designated constraints aren't met.
-type GetAlertYAMLResponseValidationError struct {
+// PullVirtualRepoResponseValidationError is the validation error returned by
+// PullVirtualRepoResponse.Validate if the designated constraints aren't met.
+type PullVirtualRepoResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -20316,24 +21335,24 @@ type GetAlertYAMLResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetAlertYAMLResponseValidationError) Field() string { return e.field }
+func (e PullVirtualRepoResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetAlertYAMLResponseValidationError) Reason() string { return e.reason }
+func (e PullVirtualRepoResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetAlertYAMLResponseValidationError) Cause() error { return e.cause }
+func (e PullVirtualRepoResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetAlertYAMLResponseValidationError) Key() bool { return e.key }
+func (e PullVirtualRepoResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetAlertYAMLResponseValidationError) ErrorName() string {
-	return "GetAlertYAMLResponseValidationError"
+func (e PullVirtualRepoResponseValidationError) ErrorName() string {
+	return "PullVirtualRepoResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetAlertYAMLResponseValidationError) Error() string {
+func (e PullVirtualRepoResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -20345,14 +21364,14 @@ func (e GetAlertYAMLResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetAlertYAMLResponse.%s: %s%s",
+		"invalid %sPullVirtualRepoResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetAlertYAMLResponseValidationError{}
+var _ error = PullVirtualRepoResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -20360,57 +21379,61 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetAlertYAMLResponseValidationError{}
+} = PullVirtualRepoResponseValidationError{}
 
-// Validate checks the field values on TelemetryRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *TelemetryRequest) Validate() error {
+// Validate checks the field values on GetReportMetaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetReportMetaRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TelemetryRequest with the rules
+// ValidateAll checks the field values on GetReportMetaRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TelemetryRequestMultiError, or nil if none found.
-func (m *TelemetryRequest) ValidateAll() error {
+// GetReportMetaRequestMultiError, or nil if none found.
+func (m *GetReportMetaRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TelemetryRequest) validate(all bool) error {
+func (m *GetReportMetaRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Name
+	// no validation rules for ProjectId
 
-	// no validation rules for Value
+	// no validation rules for Branch
+
+	// no validation rules for Report
+
+	// no validation rules for Annotations
 
 	if all {
-		switch v := interface{}(m.GetEvent()).(type) {
+		switch v := interface{}(m.GetExecutionTime()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, TelemetryRequestValidationError{
-					field:  "Event",
+				errors = append(errors, GetReportMetaRequestValidationError{
+					field:  "ExecutionTime",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, TelemetryRequestValidationError{
-					field:  "Event",
+				errors = append(errors, GetReportMetaRequestValidationError{
+					field:  "ExecutionTime",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetEvent()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetExecutionTime()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return TelemetryRequestValidationError{
-				field:  "Event",
+			return GetReportMetaRequestValidationError{
+				field:  "ExecutionTime",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -20418,19 +21441,3947 @@ func (m *TelemetryRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return TelemetryRequestMultiError(errors)
+		return GetReportMetaRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// TelemetryRequestMultiError is an error wrapping multiple validation errors
-// returned by TelemetryRequest.ValidateAll() if the designated constraints
-// aren't met.
-type TelemetryRequestMultiError []error
+// GetReportMetaRequestMultiError is an error wrapping multiple validation
+// errors returned by GetReportMetaRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetReportMetaRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetReportMetaRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetReportMetaRequestMultiError) AllErrors() []error { return m }
+
+// GetReportMetaRequestValidationError is the validation error returned by
+// GetReportMetaRequest.Validate if the designated constraints aren't met.
+type GetReportMetaRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetReportMetaRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetReportMetaRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetReportMetaRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetReportMetaRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetReportMetaRequestValidationError) ErrorName() string {
+	return "GetReportMetaRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetReportMetaRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetReportMetaRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetReportMetaRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetReportMetaRequestValidationError{}
+
+// Validate checks the field values on GetReportMetaResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetReportMetaResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetReportMetaResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetReportMetaResponseMultiError, or nil if none found.
+func (m *GetReportMetaResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetReportMetaResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OpenUrl
+
+	// no validation rules for ExportUrl
+
+	// no validation rules for EditUrl
+
+	if len(errors) > 0 {
+		return GetReportMetaResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetReportMetaResponseMultiError is an error wrapping multiple validation
+// errors returned by GetReportMetaResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetReportMetaResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetReportMetaResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetReportMetaResponseMultiError) AllErrors() []error { return m }
+
+// GetReportMetaResponseValidationError is the validation error returned by
+// GetReportMetaResponse.Validate if the designated constraints aren't met.
+type GetReportMetaResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetReportMetaResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetReportMetaResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetReportMetaResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetReportMetaResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetReportMetaResponseValidationError) ErrorName() string {
+	return "GetReportMetaResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetReportMetaResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetReportMetaResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetReportMetaResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetReportMetaResponseValidationError{}
+
+// Validate checks the field values on GetAlertMetaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAlertMetaRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAlertMetaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAlertMetaRequestMultiError, or nil if none found.
+func (m *GetAlertMetaRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAlertMetaRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ProjectId
+
+	// no validation rules for Branch
+
+	// no validation rules for Alert
+
+	// no validation rules for Annotations
+
+	switch v := m.QueryFor.(type) {
+	case *GetAlertMetaRequest_QueryForUserId:
+		if v == nil {
+			err := GetAlertMetaRequestValidationError{
+				field:  "QueryFor",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for QueryForUserId
+	case *GetAlertMetaRequest_QueryForUserEmail:
+		if v == nil {
+			err := GetAlertMetaRequestValidationError{
+				field:  "QueryFor",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for QueryForUserEmail
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return GetAlertMetaRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAlertMetaRequestMultiError is an error wrapping multiple validation
+// errors returned by GetAlertMetaRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetAlertMetaRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAlertMetaRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAlertMetaRequestMultiError) AllErrors() []error { return m }
+
+// GetAlertMetaRequestValidationError is the validation error returned by
+// GetAlertMetaRequest.Validate if the designated constraints aren't met.
+type GetAlertMetaRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAlertMetaRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAlertMetaRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAlertMetaRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAlertMetaRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAlertMetaRequestValidationError) ErrorName() string {
+	return "GetAlertMetaRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAlertMetaRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAlertMetaRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAlertMetaRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAlertMetaRequestValidationError{}
+
+// Validate checks the field values on GetAlertMetaResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAlertMetaResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAlertMetaResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAlertMetaResponseMultiError, or nil if none found.
+func (m *GetAlertMetaResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAlertMetaResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OpenUrl
+
+	// no validation rules for EditUrl
+
+	if all {
+		switch v := interface{}(m.GetQueryForAttributes()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetAlertMetaResponseValidationError{
+					field:  "QueryForAttributes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetAlertMetaResponseValidationError{
+					field:  "QueryForAttributes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQueryForAttributes()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetAlertMetaResponseValidationError{
+				field:  "QueryForAttributes",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetAlertMetaResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAlertMetaResponseMultiError is an error wrapping multiple validation
+// errors returned by GetAlertMetaResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetAlertMetaResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAlertMetaResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAlertMetaResponseMultiError) AllErrors() []error { return m }
+
+// GetAlertMetaResponseValidationError is the validation error returned by
+// GetAlertMetaResponse.Validate if the designated constraints aren't met.
+type GetAlertMetaResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAlertMetaResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAlertMetaResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAlertMetaResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAlertMetaResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAlertMetaResponseValidationError) ErrorName() string {
+	return "GetAlertMetaResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAlertMetaResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAlertMetaResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAlertMetaResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAlertMetaResponseValidationError{}
+
+// Validate checks the field values on CreateReportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateReportRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateReportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateReportRequestMultiError, or nil if none found.
+func (m *CreateReportRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateReportRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Organization
+
+	// no validation rules for Project
+
+	if m.GetOptions() == nil {
+		err := CreateReportRequestValidationError{
+			field:  "Options",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateReportRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateReportRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateReportRequestValidationError{
+				field:  "Options",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateReportRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateReportRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateReportRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateReportRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateReportRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateReportRequestMultiError) AllErrors() []error { return m }
+
+// CreateReportRequestValidationError is the validation error returned by
+// CreateReportRequest.Validate if the designated constraints aren't met.
+type CreateReportRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateReportRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateReportRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateReportRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateReportRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateReportRequestValidationError) ErrorName() string {
+	return "CreateReportRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateReportRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateReportRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateReportRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateReportRequestValidationError{}
+
+// Validate checks the field values on CreateReportResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateReportResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateReportResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateReportResponseMultiError, or nil if none found.
+func (m *CreateReportResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateReportResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return CreateReportResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateReportResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateReportResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateReportResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateReportResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateReportResponseMultiError) AllErrors() []error { return m }
+
+// CreateReportResponseValidationError is the validation error returned by
+// CreateReportResponse.Validate if the designated constraints aren't met.
+type CreateReportResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateReportResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateReportResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateReportResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateReportResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateReportResponseValidationError) ErrorName() string {
+	return "CreateReportResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateReportResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateReportResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateReportResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateReportResponseValidationError{}
+
+// Validate checks the field values on EditReportRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *EditReportRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EditReportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EditReportRequestMultiError, or nil if none found.
+func (m *EditReportRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EditReportRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Organization
+
+	// no validation rules for Project
+
+	// no validation rules for Name
+
+	if m.GetOptions() == nil {
+		err := EditReportRequestValidationError{
+			field:  "Options",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EditReportRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EditReportRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EditReportRequestValidationError{
+				field:  "Options",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return EditReportRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// EditReportRequestMultiError is an error wrapping multiple validation errors
+// returned by EditReportRequest.ValidateAll() if the designated constraints
+// aren't met.
+type EditReportRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EditReportRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EditReportRequestMultiError) AllErrors() []error { return m }
+
+// EditReportRequestValidationError is the validation error returned by
+// EditReportRequest.Validate if the designated constraints aren't met.
+type EditReportRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EditReportRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EditReportRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EditReportRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EditReportRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EditReportRequestValidationError) ErrorName() string {
+	return "EditReportRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EditReportRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEditReportRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EditReportRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EditReportRequestValidationError{}
+
+// Validate checks the field values on EditReportResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EditReportResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EditReportResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EditReportResponseMultiError, or nil if none found.
+func (m *EditReportResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EditReportResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return EditReportResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// EditReportResponseMultiError is an error wrapping multiple validation errors
+// returned by EditReportResponse.ValidateAll() if the designated constraints
+// aren't met.
+type EditReportResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EditReportResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EditReportResponseMultiError) AllErrors() []error { return m }
+
+// EditReportResponseValidationError is the validation error returned by
+// EditReportResponse.Validate if the designated constraints aren't met.
+type EditReportResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EditReportResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EditReportResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EditReportResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EditReportResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EditReportResponseValidationError) ErrorName() string {
+	return "EditReportResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EditReportResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEditReportResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EditReportResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EditReportResponseValidationError{}
+
+// Validate checks the field values on UnsubscribeReportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UnsubscribeReportRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnsubscribeReportRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnsubscribeReportRequestMultiError, or nil if none found.
+func (m *UnsubscribeReportRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnsubscribeReportRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Organization
+
+	// no validation rules for Project
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return UnsubscribeReportRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnsubscribeReportRequestMultiError is an error wrapping multiple validation
+// errors returned by UnsubscribeReportRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UnsubscribeReportRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnsubscribeReportRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnsubscribeReportRequestMultiError) AllErrors() []error { return m }
+
+// UnsubscribeReportRequestValidationError is the validation error returned by
+// UnsubscribeReportRequest.Validate if the designated constraints aren't met.
+type UnsubscribeReportRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnsubscribeReportRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnsubscribeReportRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnsubscribeReportRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnsubscribeReportRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnsubscribeReportRequestValidationError) ErrorName() string {
+	return "UnsubscribeReportRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnsubscribeReportRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnsubscribeReportRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnsubscribeReportRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnsubscribeReportRequestValidationError{}
+
+// Validate checks the field values on UnsubscribeReportResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UnsubscribeReportResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnsubscribeReportResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnsubscribeReportResponseMultiError, or nil if none found.
+func (m *UnsubscribeReportResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnsubscribeReportResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return UnsubscribeReportResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnsubscribeReportResponseMultiError is an error wrapping multiple validation
+// errors returned by UnsubscribeReportResponse.ValidateAll() if the
+// designated constraints aren't met.
+type UnsubscribeReportResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnsubscribeReportResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnsubscribeReportResponseMultiError) AllErrors() []error { return m }
+
+// UnsubscribeReportResponseValidationError is the validation error returned by
+// UnsubscribeReportResponse.Validate if the designated constraints aren't met.
+type UnsubscribeReportResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnsubscribeReportResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnsubscribeReportResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnsubscribeReportResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnsubscribeReportResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnsubscribeReportResponseValidationError) ErrorName() string {
+	return "UnsubscribeReportResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnsubscribeReportResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnsubscribeReportResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnsubscribeReportResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnsubscribeReportResponseValidationError{}
+
+// Validate checks the field values on DeleteReportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteReportRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteReportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteReportRequestMultiError, or nil if none found.
+func (m *DeleteReportRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteReportRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Organization
+
+	// no validation rules for Project
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return DeleteReportRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteReportRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteReportRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteReportRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteReportRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteReportRequestMultiError) AllErrors() []error { return m }
+
+// DeleteReportRequestValidationError is the validation error returned by
+// DeleteReportRequest.Validate if the designated constraints aren't met.
+type DeleteReportRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteReportRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteReportRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteReportRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteReportRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteReportRequestValidationError) ErrorName() string {
+	return "DeleteReportRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteReportRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteReportRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteReportRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteReportRequestValidationError{}
+
+// Validate checks the field values on DeleteReportResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteReportResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteReportResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteReportResponseMultiError, or nil if none found.
+func (m *DeleteReportResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteReportResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteReportResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteReportResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteReportResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteReportResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteReportResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteReportResponseMultiError) AllErrors() []error { return m }
+
+// DeleteReportResponseValidationError is the validation error returned by
+// DeleteReportResponse.Validate if the designated constraints aren't met.
+type DeleteReportResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteReportResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteReportResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteReportResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteReportResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteReportResponseValidationError) ErrorName() string {
+	return "DeleteReportResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteReportResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteReportResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteReportResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteReportResponseValidationError{}
+
+// Validate checks the field values on TriggerReportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TriggerReportRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TriggerReportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TriggerReportRequestMultiError, or nil if none found.
+func (m *TriggerReportRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TriggerReportRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Organization
+
+	// no validation rules for Project
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return TriggerReportRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TriggerReportRequestMultiError is an error wrapping multiple validation
+// errors returned by TriggerReportRequest.ValidateAll() if the designated
+// constraints aren't met.
+type TriggerReportRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TriggerReportRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TriggerReportRequestMultiError) AllErrors() []error { return m }
+
+// TriggerReportRequestValidationError is the validation error returned by
+// TriggerReportRequest.Validate if the designated constraints aren't met.
+type TriggerReportRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TriggerReportRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TriggerReportRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TriggerReportRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TriggerReportRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TriggerReportRequestValidationError) ErrorName() string {
+	return "TriggerReportRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TriggerReportRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTriggerReportRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TriggerReportRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TriggerReportRequestValidationError{}
+
+// Validate checks the field values on TriggerReportResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TriggerReportResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TriggerReportResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TriggerReportResponseMultiError, or nil if none found.
+func (m *TriggerReportResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TriggerReportResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return TriggerReportResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// TriggerReportResponseMultiError is an error wrapping multiple validation
+// errors returned by TriggerReportResponse.ValidateAll() if the designated
+// constraints aren't met.
+type TriggerReportResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TriggerReportResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TriggerReportResponseMultiError) AllErrors() []error { return m }
+
+// TriggerReportResponseValidationError is the validation error returned by
+// TriggerReportResponse.Validate if the designated constraints aren't met.
+type TriggerReportResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TriggerReportResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TriggerReportResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TriggerReportResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TriggerReportResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TriggerReportResponseValidationError) ErrorName() string {
+	return "TriggerReportResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TriggerReportResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTriggerReportResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TriggerReportResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TriggerReportResponseValidationError{}
+
+// Validate checks the field values on GenerateReportYAMLRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GenerateReportYAMLRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenerateReportYAMLRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GenerateReportYAMLRequestMultiError, or nil if none found.
+func (m *GenerateReportYAMLRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenerateReportYAMLRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Organization
+
+	// no validation rules for Project
+
+	if m.GetOptions() == nil {
+		err := GenerateReportYAMLRequestValidationError{
+			field:  "Options",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GenerateReportYAMLRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GenerateReportYAMLRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GenerateReportYAMLRequestValidationError{
+				field:  "Options",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GenerateReportYAMLRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GenerateReportYAMLRequestMultiError is an error wrapping multiple validation
+// errors returned by GenerateReportYAMLRequest.ValidateAll() if the
+// designated constraints aren't met.
+type GenerateReportYAMLRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenerateReportYAMLRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenerateReportYAMLRequestMultiError) AllErrors() []error { return m }
+
+// GenerateReportYAMLRequestValidationError is the validation error returned by
+// GenerateReportYAMLRequest.Validate if the designated constraints aren't met.
+type GenerateReportYAMLRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenerateReportYAMLRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenerateReportYAMLRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenerateReportYAMLRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenerateReportYAMLRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenerateReportYAMLRequestValidationError) ErrorName() string {
+	return "GenerateReportYAMLRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GenerateReportYAMLRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenerateReportYAMLRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenerateReportYAMLRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenerateReportYAMLRequestValidationError{}
+
+// Validate checks the field values on GenerateReportYAMLResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GenerateReportYAMLResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenerateReportYAMLResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GenerateReportYAMLResponseMultiError, or nil if none found.
+func (m *GenerateReportYAMLResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenerateReportYAMLResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Yaml
+
+	if len(errors) > 0 {
+		return GenerateReportYAMLResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GenerateReportYAMLResponseMultiError is an error wrapping multiple
+// validation errors returned by GenerateReportYAMLResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GenerateReportYAMLResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenerateReportYAMLResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenerateReportYAMLResponseMultiError) AllErrors() []error { return m }
+
+// GenerateReportYAMLResponseValidationError is the validation error returned
+// by GenerateReportYAMLResponse.Validate if the designated constraints aren't met.
+type GenerateReportYAMLResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenerateReportYAMLResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenerateReportYAMLResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenerateReportYAMLResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenerateReportYAMLResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenerateReportYAMLResponseValidationError) ErrorName() string {
+	return "GenerateReportYAMLResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GenerateReportYAMLResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenerateReportYAMLResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenerateReportYAMLResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenerateReportYAMLResponseValidationError{}
+
+// Validate checks the field values on CreateAlertRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateAlertRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateAlertRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateAlertRequestMultiError, or nil if none found.
+func (m *CreateAlertRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateAlertRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Organization
+
+	// no validation rules for Project
+
+	if m.GetOptions() == nil {
+		err := CreateAlertRequestValidationError{
+			field:  "Options",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateAlertRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateAlertRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateAlertRequestValidationError{
+				field:  "Options",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateAlertRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateAlertRequestMultiError is an error wrapping multiple validation errors
+// returned by CreateAlertRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreateAlertRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateAlertRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateAlertRequestMultiError) AllErrors() []error { return m }
+
+// CreateAlertRequestValidationError is the validation error returned by
+// CreateAlertRequest.Validate if the designated constraints aren't met.
+type CreateAlertRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateAlertRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateAlertRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateAlertRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateAlertRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateAlertRequestValidationError) ErrorName() string {
+	return "CreateAlertRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateAlertRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateAlertRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateAlertRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateAlertRequestValidationError{}
+
+// Validate checks the field values on CreateAlertResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateAlertResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateAlertResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateAlertResponseMultiError, or nil if none found.
+func (m *CreateAlertResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateAlertResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return CreateAlertResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateAlertResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateAlertResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateAlertResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateAlertResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateAlertResponseMultiError) AllErrors() []error { return m }
+
+// CreateAlertResponseValidationError is the validation error returned by
+// CreateAlertResponse.Validate if the designated constraints aren't met.
+type CreateAlertResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateAlertResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateAlertResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateAlertResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateAlertResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateAlertResponseValidationError) ErrorName() string {
+	return "CreateAlertResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateAlertResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateAlertResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateAlertResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateAlertResponseValidationError{}
+
+// Validate checks the field values on EditAlertRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *EditAlertRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EditAlertRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EditAlertRequestMultiError, or nil if none found.
+func (m *EditAlertRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EditAlertRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Organization
+
+	// no validation rules for Project
+
+	// no validation rules for Name
+
+	if m.GetOptions() == nil {
+		err := EditAlertRequestValidationError{
+			field:  "Options",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EditAlertRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EditAlertRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EditAlertRequestValidationError{
+				field:  "Options",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return EditAlertRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// EditAlertRequestMultiError is an error wrapping multiple validation errors
+// returned by EditAlertRequest.ValidateAll() if the designated constraints
+// aren't met.
+type EditAlertRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EditAlertRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EditAlertRequestMultiError) AllErrors() []error { return m }
+
+// EditAlertRequestValidationError is the validation error returned by
+// EditAlertRequest.Validate if the designated constraints aren't met.
+type EditAlertRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EditAlertRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EditAlertRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EditAlertRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EditAlertRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EditAlertRequestValidationError) ErrorName() string { return "EditAlertRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e EditAlertRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEditAlertRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EditAlertRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EditAlertRequestValidationError{}
+
+// Validate checks the field values on EditAlertResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *EditAlertResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EditAlertResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EditAlertResponseMultiError, or nil if none found.
+func (m *EditAlertResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EditAlertResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return EditAlertResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// EditAlertResponseMultiError is an error wrapping multiple validation errors
+// returned by EditAlertResponse.ValidateAll() if the designated constraints
+// aren't met.
+type EditAlertResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EditAlertResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EditAlertResponseMultiError) AllErrors() []error { return m }
+
+// EditAlertResponseValidationError is the validation error returned by
+// EditAlertResponse.Validate if the designated constraints aren't met.
+type EditAlertResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EditAlertResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EditAlertResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EditAlertResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EditAlertResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EditAlertResponseValidationError) ErrorName() string {
+	return "EditAlertResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EditAlertResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEditAlertResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EditAlertResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EditAlertResponseValidationError{}
+
+// Validate checks the field values on UnsubscribeAlertRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UnsubscribeAlertRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnsubscribeAlertRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnsubscribeAlertRequestMultiError, or nil if none found.
+func (m *UnsubscribeAlertRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnsubscribeAlertRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Organization
+
+	// no validation rules for Project
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return UnsubscribeAlertRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnsubscribeAlertRequestMultiError is an error wrapping multiple validation
+// errors returned by UnsubscribeAlertRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UnsubscribeAlertRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnsubscribeAlertRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnsubscribeAlertRequestMultiError) AllErrors() []error { return m }
+
+// UnsubscribeAlertRequestValidationError is the validation error returned by
+// UnsubscribeAlertRequest.Validate if the designated constraints aren't met.
+type UnsubscribeAlertRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnsubscribeAlertRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnsubscribeAlertRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnsubscribeAlertRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnsubscribeAlertRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnsubscribeAlertRequestValidationError) ErrorName() string {
+	return "UnsubscribeAlertRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnsubscribeAlertRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnsubscribeAlertRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnsubscribeAlertRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnsubscribeAlertRequestValidationError{}
+
+// Validate checks the field values on UnsubscribeAlertResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UnsubscribeAlertResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnsubscribeAlertResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnsubscribeAlertResponseMultiError, or nil if none found.
+func (m *UnsubscribeAlertResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnsubscribeAlertResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return UnsubscribeAlertResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnsubscribeAlertResponseMultiError is an error wrapping multiple validation
+// errors returned by UnsubscribeAlertResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UnsubscribeAlertResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnsubscribeAlertResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnsubscribeAlertResponseMultiError) AllErrors() []error { return m }
+
+// UnsubscribeAlertResponseValidationError is the validation error returned by
+// UnsubscribeAlertResponse.Validate if the designated constraints aren't met.
+type UnsubscribeAlertResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnsubscribeAlertResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnsubscribeAlertResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnsubscribeAlertResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnsubscribeAlertResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnsubscribeAlertResponseValidationError) ErrorName() string {
+	return "UnsubscribeAlertResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnsubscribeAlertResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnsubscribeAlertResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnsubscribeAlertResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnsubscribeAlertResponseValidationError{}
+
+// Validate checks the field values on DeleteAlertRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteAlertRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteAlertRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteAlertRequestMultiError, or nil if none found.
+func (m *DeleteAlertRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteAlertRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Organization
+
+	// no validation rules for Project
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return DeleteAlertRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteAlertRequestMultiError is an error wrapping multiple validation errors
+// returned by DeleteAlertRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteAlertRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteAlertRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteAlertRequestMultiError) AllErrors() []error { return m }
+
+// DeleteAlertRequestValidationError is the validation error returned by
+// DeleteAlertRequest.Validate if the designated constraints aren't met.
+type DeleteAlertRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteAlertRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteAlertRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteAlertRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteAlertRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteAlertRequestValidationError) ErrorName() string {
+	return "DeleteAlertRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteAlertRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteAlertRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteAlertRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteAlertRequestValidationError{}
+
+// Validate checks the field values on DeleteAlertResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteAlertResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteAlertResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteAlertResponseMultiError, or nil if none found.
+func (m *DeleteAlertResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteAlertResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteAlertResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteAlertResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteAlertResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteAlertResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteAlertResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteAlertResponseMultiError) AllErrors() []error { return m }
+
+// DeleteAlertResponseValidationError is the validation error returned by
+// DeleteAlertResponse.Validate if the designated constraints aren't met.
+type DeleteAlertResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteAlertResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteAlertResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteAlertResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteAlertResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteAlertResponseValidationError) ErrorName() string {
+	return "DeleteAlertResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteAlertResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteAlertResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteAlertResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteAlertResponseValidationError{}
+
+// Validate checks the field values on GenerateAlertYAMLRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GenerateAlertYAMLRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenerateAlertYAMLRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GenerateAlertYAMLRequestMultiError, or nil if none found.
+func (m *GenerateAlertYAMLRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenerateAlertYAMLRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Organization
+
+	// no validation rules for Project
+
+	if m.GetOptions() == nil {
+		err := GenerateAlertYAMLRequestValidationError{
+			field:  "Options",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GenerateAlertYAMLRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GenerateAlertYAMLRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GenerateAlertYAMLRequestValidationError{
+				field:  "Options",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GenerateAlertYAMLRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GenerateAlertYAMLRequestMultiError is an error wrapping multiple validation
+// errors returned by GenerateAlertYAMLRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GenerateAlertYAMLRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenerateAlertYAMLRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenerateAlertYAMLRequestMultiError) AllErrors() []error { return m }
+
+// GenerateAlertYAMLRequestValidationError is the validation error returned by
+// GenerateAlertYAMLRequest.Validate if the designated constraints aren't met.
+type GenerateAlertYAMLRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenerateAlertYAMLRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenerateAlertYAMLRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenerateAlertYAMLRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenerateAlertYAMLRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenerateAlertYAMLRequestValidationError) ErrorName() string {
+	return "GenerateAlertYAMLRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GenerateAlertYAMLRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenerateAlertYAMLRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenerateAlertYAMLRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenerateAlertYAMLRequestValidationError{}
+
+// Validate checks the field values on GenerateAlertYAMLResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GenerateAlertYAMLResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenerateAlertYAMLResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GenerateAlertYAMLResponseMultiError, or nil if none found.
+func (m *GenerateAlertYAMLResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenerateAlertYAMLResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Yaml
+
+	if len(errors) > 0 {
+		return GenerateAlertYAMLResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GenerateAlertYAMLResponseMultiError is an error wrapping multiple validation
+// errors returned by GenerateAlertYAMLResponse.ValidateAll() if the
+// designated constraints aren't met.
+type GenerateAlertYAMLResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenerateAlertYAMLResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenerateAlertYAMLResponseMultiError) AllErrors() []error { return m }
+
+// GenerateAlertYAMLResponseValidationError is the validation error returned by
+// GenerateAlertYAMLResponse.Validate if the designated constraints aren't met.
+type GenerateAlertYAMLResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenerateAlertYAMLResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenerateAlertYAMLResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenerateAlertYAMLResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenerateAlertYAMLResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenerateAlertYAMLResponseValidationError) ErrorName() string {
+	return "GenerateAlertYAMLResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GenerateAlertYAMLResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenerateAlertYAMLResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenerateAlertYAMLResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenerateAlertYAMLResponseValidationError{}
+
+// Validate checks the field values on GetAlertYAMLRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAlertYAMLRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAlertYAMLRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAlertYAMLRequestMultiError, or nil if none found.
+func (m *GetAlertYAMLRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAlertYAMLRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Organization
+
+	// no validation rules for Project
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return GetAlertYAMLRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAlertYAMLRequestMultiError is an error wrapping multiple validation
+// errors returned by GetAlertYAMLRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetAlertYAMLRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAlertYAMLRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAlertYAMLRequestMultiError) AllErrors() []error { return m }
+
+// GetAlertYAMLRequestValidationError is the validation error returned by
+// GetAlertYAMLRequest.Validate if the designated constraints aren't met.
+type GetAlertYAMLRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAlertYAMLRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAlertYAMLRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAlertYAMLRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAlertYAMLRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAlertYAMLRequestValidationError) ErrorName() string {
+	return "GetAlertYAMLRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAlertYAMLRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAlertYAMLRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAlertYAMLRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAlertYAMLRequestValidationError{}
+
+// Validate checks the field values on GetAlertYAMLResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAlertYAMLResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAlertYAMLResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAlertYAMLResponseMultiError, or nil if none found.
+func (m *GetAlertYAMLResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAlertYAMLResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Yaml
+
+	if len(errors) > 0 {
+		return GetAlertYAMLResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAlertYAMLResponseMultiError is an error wrapping multiple validation
+// errors returned by GetAlertYAMLResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetAlertYAMLResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAlertYAMLResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAlertYAMLResponseMultiError) AllErrors() []error { return m }
+
+// GetAlertYAMLResponseValidationError is the validation error returned by
+// GetAlertYAMLResponse.Validate if the designated constraints aren't met.
+type GetAlertYAMLResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAlertYAMLResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAlertYAMLResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAlertYAMLResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAlertYAMLResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAlertYAMLResponseValidationError) ErrorName() string {
+	return "GetAlertYAMLResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAlertYAMLResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAlertYAMLResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAlertYAMLResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAlertYAMLResponseValidationError{}
+
+// Validate checks the field values on TelemetryRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TelemetryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TelemetryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TelemetryRequestMultiError, or nil if none found.
+func (m *TelemetryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TelemetryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Value
+
+	if all {
+		switch v := interface{}(m.GetEvent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TelemetryRequestValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TelemetryRequestValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEvent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TelemetryRequestValidationError{
+				field:  "Event",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return TelemetryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TelemetryRequestMultiError is an error wrapping multiple validation errors
+// returned by TelemetryRequest.ValidateAll() if the designated constraints
+// aren't met.
+type TelemetryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TelemetryRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TelemetryRequestMultiError) AllErrors() []error { return m }
+
+// TelemetryRequestValidationError is the validation error returned by
+// TelemetryRequest.Validate if the designated constraints aren't met.
+type TelemetryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TelemetryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TelemetryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TelemetryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TelemetryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TelemetryRequestValidationError) ErrorName() string { return "TelemetryRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TelemetryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTelemetryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TelemetryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TelemetryRequestValidationError{}
+
+// Validate checks the field values on TelemetryResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TelemetryResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TelemetryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TelemetryResponseMultiError, or nil if none found.
+func (m *TelemetryResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TelemetryResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return TelemetryResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// TelemetryResponseMultiError is an error wrapping multiple validation errors
+// returned by TelemetryResponse.ValidateAll() if the designated constraints
+// aren't met.
+type TelemetryResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TelemetryResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TelemetryResponseMultiError) AllErrors() []error { return m }
+
+// TelemetryResponseValidationError is the validation error returned by
+// TelemetryResponse.Validate if the designated constraints aren't met.
+type TelemetryResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TelemetryResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TelemetryResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TelemetryResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TelemetryResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TelemetryResponseValidationError) ErrorName() string {
+	return "TelemetryResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TelemetryResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTelemetryResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TelemetryResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TelemetryResponseValidationError{}
+
+// Validate checks the field values on User with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *User) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on User with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in UserMultiError, or nil if none found.
+func (m *User) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *User) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Email
+
+	// no validation rules for DisplayName
+
+	// no validation rules for PhotoUrl
+
+	if all {
+		switch v := interface{}(m.GetQuotas()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserValidationError{
+					field:  "Quotas",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserValidationError{
+					field:  "Quotas",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQuotas()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserValidationError{
+				field:  "Quotas",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserValidationError{
+					field:  "CreatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserValidationError{
+					field:  "CreatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserValidationError{
+				field:  "CreatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserValidationError{
+					field:  "UpdatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserValidationError{
+					field:  "UpdatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserValidationError{
+				field:  "UpdatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UserMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserMultiError is an error wrapping multiple validation errors returned by
+// User.ValidateAll() if the designated constraints aren't met.
+type UserMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserMultiError) AllErrors() []error { return m }
+
+// UserValidationError is the validation error returned by User.Validate if the
+// designated constraints aren't met.
+type UserValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserValidationError) ErrorName() string { return "UserValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUser.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserValidationError{}
+
+// Validate checks the field values on Service with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Service) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Service with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ServiceMultiError, or nil if none found.
+func (m *Service) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Service) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for OrgId
+
+	// no validation rules for OrgName
+
+	if all {
+		switch v := interface{}(m.GetCreatedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ServiceValidationError{
+					field:  "CreatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ServiceValidationError{
+					field:  "CreatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ServiceValidationError{
+				field:  "CreatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ServiceValidationError{
+					field:  "UpdatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ServiceValidationError{
+					field:  "UpdatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ServiceValidationError{
+				field:  "UpdatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ServiceMultiError(errors)
+	}
+
+	return nil
+}
+
+// ServiceMultiError is an error wrapping multiple validation errors returned
+// by Service.ValidateAll() if the designated constraints aren't met.
+type ServiceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ServiceMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ServiceMultiError) AllErrors() []error { return m }
+
+// ServiceValidationError is the validation error returned by Service.Validate
+// if the designated constraints aren't met.
+type ServiceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ServiceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ServiceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ServiceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ServiceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ServiceValidationError) ErrorName() string { return "ServiceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ServiceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sService.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ServiceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ServiceValidationError{}
+
+// Validate checks the field values on Organization with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Organization) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Organization with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OrganizationMultiError, or
+// nil if none found.
+func (m *Organization) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Organization) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Description
+
+	if all {
+		switch v := interface{}(m.GetQuotas()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrganizationValidationError{
+					field:  "Quotas",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrganizationValidationError{
+					field:  "Quotas",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQuotas()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrganizationValidationError{
+				field:  "Quotas",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrganizationValidationError{
+					field:  "CreatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrganizationValidationError{
+					field:  "CreatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrganizationValidationError{
+				field:  "CreatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrganizationValidationError{
+					field:  "UpdatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrganizationValidationError{
+					field:  "UpdatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrganizationValidationError{
+				field:  "UpdatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return OrganizationMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrganizationMultiError is an error wrapping multiple validation errors
+// returned by Organization.ValidateAll() if the designated constraints aren't met.
+type OrganizationMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TelemetryRequestMultiError) Error() string {
+func (m OrganizationMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -20439,11 +25390,11 @@ func (m TelemetryRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TelemetryRequestMultiError) AllErrors() []error { return m }
+func (m OrganizationMultiError) AllErrors() []error { return m }
 
-// TelemetryRequestValidationError is the validation error returned by
-// TelemetryRequest.Validate if the designated constraints aren't met.
-type TelemetryRequestValidationError struct {
+// OrganizationValidationError is the validation error returned by
+// Organization.Validate if the designated constraints aren't met.
+type OrganizationValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -20451,22 +25402,22 @@ type TelemetryRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e TelemetryRequestValidationError) Field() string { return e.field }
+func (e OrganizationValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TelemetryRequestValidationError) Reason() string { return e.reason }
+func (e OrganizationValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TelemetryRequestValidationError) Cause() error { return e.cause }
+func (e OrganizationValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TelemetryRequestValidationError) Key() bool { return e.key }
+func (e OrganizationValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TelemetryRequestValidationError) ErrorName() string { return "TelemetryRequestValidationError" }
+func (e OrganizationValidationError) ErrorName() string { return "OrganizationValidationError" }
 
 // Error satisfies the builtin error interface
-func (e TelemetryRequestValidationError) Error() string {
+func (e OrganizationValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -20478,14 +25429,14 @@ func (e TelemetryRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTelemetryRequest.%s: %s%s",
+		"invalid %sOrganization.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TelemetryRequestValidationError{}
+var _ error = OrganizationValidationError{}
 
 var _ interface {
 	Field() string
@@ -20493,44 +25444,45 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TelemetryRequestValidationError{}
+} = OrganizationValidationError{}
 
-// Validate checks the field values on TelemetryResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *TelemetryResponse) Validate() error {
+// Validate checks the field values on UserQuotas with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UserQuotas) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TelemetryResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// TelemetryResponseMultiError, or nil if none found.
-func (m *TelemetryResponse) ValidateAll() error {
+// ValidateAll checks the field values on UserQuotas with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UserQuotasMultiError, or
+// nil if none found.
+func (m *UserQuotas) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TelemetryResponse) validate(all bool) error {
+func (m *UserQuotas) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for SingleuserOrgs
+
 	if len(errors) > 0 {
-		return TelemetryResponseMultiError(errors)
+		return UserQuotasMultiError(errors)
 	}
 
 	return nil
 }
 
-// TelemetryResponseMultiError is an error wrapping multiple validation errors
-// returned by TelemetryResponse.ValidateAll() if the designated constraints
-// aren't met.
-type TelemetryResponseMultiError []error
+// UserQuotasMultiError is an error wrapping multiple validation errors
+// returned by UserQuotas.ValidateAll() if the designated constraints aren't met.
+type UserQuotasMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TelemetryResponseMultiError) Error() string {
+func (m UserQuotasMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -20539,11 +25491,11 @@ func (m TelemetryResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TelemetryResponseMultiError) AllErrors() []error { return m }
+func (m UserQuotasMultiError) AllErrors() []error { return m }
 
-// TelemetryResponseValidationError is the validation error returned by
-// TelemetryResponse.Validate if the designated constraints aren't met.
-type TelemetryResponseValidationError struct {
+// UserQuotasValidationError is the validation error returned by
+// UserQuotas.Validate if the designated constraints aren't met.
+type UserQuotasValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -20551,24 +25503,22 @@ type TelemetryResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e TelemetryResponseValidationError) Field() string { return e.field }
+func (e UserQuotasValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TelemetryResponseValidationError) Reason() string { return e.reason }
+func (e UserQuotasValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TelemetryResponseValidationError) Cause() error { return e.cause }
+func (e UserQuotasValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TelemetryResponseValidationError) Key() bool { return e.key }
+func (e UserQuotasValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TelemetryResponseValidationError) ErrorName() string {
-	return "TelemetryResponseValidationError"
-}
+func (e UserQuotasValidationError) ErrorName() string { return "UserQuotasValidationError" }
 
 // Error satisfies the builtin error interface
-func (e TelemetryResponseValidationError) Error() string {
+func (e UserQuotasValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -20580,14 +25530,14 @@ func (e TelemetryResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTelemetryResponse.%s: %s%s",
+		"invalid %sUserQuotas.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TelemetryResponseValidationError{}
+var _ error = UserQuotasValidationError{}
 
 var _ interface {
 	Field() string
@@ -20595,137 +25545,54 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TelemetryResponseValidationError{}
+} = UserQuotasValidationError{}
 
-// Validate checks the field values on User with the rules defined in the proto
-// definition for this message. If any rules are violated, the first error
-// encountered is returned, or nil if there are no violations.
-func (m *User) Validate() error {
+// Validate checks the field values on OrganizationQuotas with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *OrganizationQuotas) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on User with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in UserMultiError, or nil if none found.
-func (m *User) ValidateAll() error {
+// ValidateAll checks the field values on OrganizationQuotas with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OrganizationQuotasMultiError, or nil if none found.
+func (m *OrganizationQuotas) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *User) validate(all bool) error {
+func (m *OrganizationQuotas) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	// no validation rules for Email
-
-	// no validation rules for DisplayName
+	// no validation rules for Projects
 
-	// no validation rules for PhotoUrl
+	// no validation rules for Deployments
 
-	if all {
-		switch v := interface{}(m.GetQuotas()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UserValidationError{
-					field:  "Quotas",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, UserValidationError{
-					field:  "Quotas",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetQuotas()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UserValidationError{
-				field:  "Quotas",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for SlotsTotal
 
-	if all {
-		switch v := interface{}(m.GetCreatedOn()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UserValidationError{
-					field:  "CreatedOn",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, UserValidationError{
-					field:  "CreatedOn",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetCreatedOn()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UserValidationError{
-				field:  "CreatedOn",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for SlotsPerDeployment
 
-	if all {
-		switch v := interface{}(m.GetUpdatedOn()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UserValidationError{
-					field:  "UpdatedOn",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, UserValidationError{
-					field:  "UpdatedOn",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetUpdatedOn()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UserValidationError{
-				field:  "UpdatedOn",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for OutstandingInvites
 
 	if len(errors) > 0 {
-		return UserMultiError(errors)
+		return OrganizationQuotasMultiError(errors)
 	}
 
 	return nil
 }
 
-// UserMultiError is an error wrapping multiple validation errors returned by
-// User.ValidateAll() if the designated constraints aren't met.
-type UserMultiError []error
+// OrganizationQuotasMultiError is an error wrapping multiple validation errors
+// returned by OrganizationQuotas.ValidateAll() if the designated constraints
+// aren't met.
+type OrganizationQuotasMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UserMultiError) Error() string {
+func (m OrganizationQuotasMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -20734,11 +25601,11 @@ func (m UserMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UserMultiError) AllErrors() []error { return m }
+func (m OrganizationQuotasMultiError) AllErrors() []error { return m }
 
-// UserValidationError is the validation error returned by User.Validate if the
-// designated constraints aren't met.
-type UserValidationError struct {
+// OrganizationQuotasValidationError is the validation error returned by
+// OrganizationQuotas.Validate if the designated constraints aren't met.
+type OrganizationQuotasValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -20746,22 +25613,24 @@ type UserValidationError struct {
 }
 
 // Field function returns field value.
-func (e UserValidationError) Field() string { return e.field }
+func (e OrganizationQuotasValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UserValidationError) Reason() string { return e.reason }
+func (e OrganizationQuotasValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UserValidationError) Cause() error { return e.cause }
+func (e OrganizationQuotasValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UserValidationError) Key() bool { return e.key }
+func (e OrganizationQuotasValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UserValidationError) ErrorName() string { return "UserValidationError" }
+func (e OrganizationQuotasValidationError) ErrorName() string {
+	return "OrganizationQuotasValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e UserValidationError) Error() string {
+func (e OrganizationQuotasValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -20773,14 +25642,14 @@ func (e UserValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUser.%s: %s%s",
+		"invalid %sOrganizationQuotas.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UserValidationError{}
+var _ error = OrganizationQuotasValidationError{}
 
 var _ interface {
 	Field() string
@@ -20788,23 +25657,23 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UserValidationError{}
+} = OrganizationQuotasValidationError{}
 
-// Validate checks the field values on Service with the rules defined in the
+// Validate checks the field values on Project with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *Service) Validate() error {
+func (m *Project) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Service with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in ServiceMultiError, or nil if none found.
-func (m *Service) ValidateAll() error {
+// ValidateAll checks the field values on Project with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ProjectMultiError, or nil if none found.
+func (m *Project) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Service) validate(all bool) error {
+func (m *Project) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -20819,11 +25688,43 @@ func (m *Service) validate(all bool) error {
 
 	// no validation rules for OrgName
 
+	// no validation rules for Description
+
+	// no validation rules for Public
+
+	// no validation rules for CreatedByUserId
+
+	// no validation rules for Provisioner
+
+	// no validation rules for GithubUrl
+
+	// no validation rules for Subpath
+
+	// no validation rules for ProdBranch
+
+	// no validation rules for ProdOlapDriver
+
+	// no validation rules for ProdOlapDsn
+
+	// no validation rules for ProdSlots
+
+	// no validation rules for ProdDeploymentId
+
+	// no validation rules for FrontendUrl
+
+	// no validation rules for ProdTtlSeconds
+
+	// no validation rules for Annotations
+
+	// no validation rules for ProdVersion
+
+	// no validation rules for PreviewDeployments
+
 	if all {
 		switch v := interface{}(m.GetCreatedOn()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ServiceValidationError{
+				errors = append(errors, ProjectValidationError{
 					field:  "CreatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -20831,7 +25732,7 @@ func (m *Service) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ServiceValidationError{
+				errors = append(errors, ProjectValidationError{
 					field:  "CreatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -20840,7 +25741,7 @@ func (m *Service) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetCreatedOn()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ServiceValidationError{
+			return ProjectValidationError{
 				field:  "CreatedOn",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -20852,7 +25753,7 @@ func (m *Service) validate(all bool) error {
 		switch v := interface{}(m.GetUpdatedOn()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ServiceValidationError{
+				errors = append(errors, ProjectValidationError{
 					field:  "UpdatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -20860,7 +25761,7 @@ func (m *Service) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ServiceValidationError{
+				errors = append(errors, ProjectValidationError{
 					field:  "UpdatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -20869,7 +25770,7 @@ func (m *Service) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetUpdatedOn()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ServiceValidationError{
+			return ProjectValidationError{
 				field:  "UpdatedOn",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -20878,18 +25779,18 @@ func (m *Service) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return ServiceMultiError(errors)
+		return ProjectMultiError(errors)
 	}
 
 	return nil
 }
 
-// ServiceMultiError is an error wrapping multiple validation errors returned
-// by Service.ValidateAll() if the designated constraints aren't met.
-type ServiceMultiError []error
+// ProjectMultiError is an error wrapping multiple validation errors returned
+// by Project.ValidateAll() if the designated constraints aren't met.
+type ProjectMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ServiceMultiError) Error() string {
+func (m ProjectMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -20898,11 +25799,11 @@ func (m ServiceMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ServiceMultiError) AllErrors() []error { return m }
+func (m ProjectMultiError) AllErrors() []error { return m }
 
-// ServiceValidationError is the validation error returned by Service.Validate
+// ProjectValidationError is the validation error returned by Project.Validate
 // if the designated constraints aren't met.
-type ServiceValidationError struct {
+type ProjectValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -20910,22 +25811,22 @@ type ServiceValidationError struct {
 }
 
 // Field function returns field value.
-func (e ServiceValidationError) Field() string { return e.field }
+func (e ProjectValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ServiceValidationError) Reason() string { return e.reason }
+func (e ProjectValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ServiceValidationError) Cause() error { return e.cause }
+func (e ProjectValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ServiceValidationError) Key() bool { return e.key }
+func (e ProjectValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ServiceValidationError) ErrorName() string { return "ServiceValidationError" }
+func (e ProjectValidationError) ErrorName() string { return "ProjectValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ServiceValidationError) Error() string {
+func (e ProjectValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -20937,14 +25838,14 @@ func (e ServiceValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sService.%s: %s%s",
+		"invalid %sProject.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ServiceValidationError{}
+var _ error = ProjectValidationError{}
 
 var _ interface {
 	Field() string
@@ -20952,24 +25853,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ServiceValidationError{}
+} = ProjectValidationError{}
 
-// Validate checks the field values on Organization with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on Deployment with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *Organization) Validate() error {
+func (m *Deployment) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Organization with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in OrganizationMultiError, or
+// ValidateAll checks the field values on Deployment with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DeploymentMultiError, or
 // nil if none found.
-func (m *Organization) ValidateAll() error {
+func (m *Deployment) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Organization) validate(all bool) error {
+func (m *Deployment) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -20978,33 +25879,51 @@ func (m *Organization) validate(all bool) error {
 
 	// no validation rules for Id
 
-	// no validation rules for Name
+	// no validation rules for ProjectId
 
-	// no validation rules for Description
+	// no validation rules for Slots
+
+	// no validation rules for Branch
+
+	// no validation rules for RuntimeHost
+
+	// no validation rules for RuntimeInstanceId
+
+	// no validation rules for Status
+
+	// no validation rules for StatusMessage
+
+	// no validation rules for Environment
+
+	// no validation rules for Preview
+
+	// no validation rules for PullRequestNumber
+
+	// no validation rules for PullRequestUrl
 
 	if all {
-		switch v := interface{}(m.GetQuotas()).(type) {
+		switch v := interface{}(m.GetClosedOn()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, OrganizationValidationError{
-					field:  "Quotas",
+				errors = append(errors, DeploymentValidationError{
+					field:  "ClosedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, OrganizationValidationError{
-					field:  "Quotas",
+				errors = append(errors, DeploymentValidationError{
+					field:  "ClosedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetQuotas()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetClosedOn()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return OrganizationValidationError{
-				field:  "Quotas",
+			return DeploymentValidationError{
+				field:  "ClosedOn",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -21015,7 +25934,7 @@ func (m *Organization) validate(all bool) error {
 		switch v := interface{}(m.GetCreatedOn()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, OrganizationValidationError{
+				errors = append(errors, DeploymentValidationError{
 					field:  "CreatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -21023,7 +25942,7 @@ func (m *Organization) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, OrganizationValidationError{
+				errors = append(errors, DeploymentValidationError{
 					field:  "CreatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -21032,7 +25951,7 @@ func (m *Organization) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetCreatedOn()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return OrganizationValidationError{
+			return DeploymentValidationError{
 				field:  "CreatedOn",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -21044,7 +25963,7 @@ func (m *Organization) validate(all bool) error {
 		switch v := interface{}(m.GetUpdatedOn()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, OrganizationValidationError{
+				errors = append(errors, DeploymentValidationError{
 					field:  "UpdatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -21052,7 +25971,7 @@ func (m *Organization) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, OrganizationValidationError{
+				errors = append(errors, DeploymentValidationError{
 					field:  "UpdatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -21061,7 +25980,7 @@ func (m *Organization) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetUpdatedOn()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return OrganizationValidationError{
+			return DeploymentValidationError{
 				field:  "UpdatedOn",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -21070,119 +25989,18 @@ func (m *Organization) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return OrganizationMultiError(errors)
-	}
-
-	return nil
-}
-
-// OrganizationMultiError is an error wrapping multiple validation errors
-// returned by Organization.ValidateAll() if the designated constraints aren't met.
-type OrganizationMultiError []error
-
-// Error returns a concatenation of all the error messages it wraps.
-func (m OrganizationMultiError) Error() string {
-	var msgs []string
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
-	}
-	return strings.Join(msgs, "; ")
-}
-
-// AllErrors returns a list of validation violation errors.
-func (m OrganizationMultiError) AllErrors() []error { return m }
-
-// OrganizationValidationError is the validation error returned by
-// Organization.Validate if the designated constraints aren't met.
-type OrganizationValidationError struct {
-	field  string
-	reason string
-	cause  error
-	key    bool
-}
-
-// Field function returns field value.
-func (e OrganizationValidationError) Field() string { return e.field }
-
-// Reason function returns reason value.
-func (e OrganizationValidationError) Reason() string { return e.reason }
-
-// Cause function returns cause value.
-func (e OrganizationValidationError) Cause() error { return e.cause }
-
-// Key function returns key value.
-func (e OrganizationValidationError) Key() bool { return e.key }
-
-// ErrorName returns error name.
-func (e OrganizationValidationError) ErrorName() string { return "OrganizationValidationError" }
-
-// Error satisfies the builtin error interface
-func (e OrganizationValidationError) Error() string {
-	cause := ""
-	if e.cause != nil {
-		cause = fmt.Sprintf(" | caused by: %v", e.cause)
-	}
-
-	key := ""
-	if e.key {
-		key = "key for "
-	}
-
-	return fmt.Sprintf(
-		"invalid %sOrganization.%s: %s%s",
-		key,
-		e.field,
-		e.reason,
-		cause)
-}
-
-var _ error = OrganizationValidationError{}
-
-var _ interface {
-	Field() string
-	Reason() string
-	Key() bool
-	Cause() error
-	ErrorName() string
-} = OrganizationValidationError{}
-
-// Validate checks the field values on UserQuotas with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *UserQuotas) Validate() error {
-	return m.validate(false)
-}
-
-// ValidateAll checks the field values on UserQuotas with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in UserQuotasMultiError, or
-// nil if none found.
-func (m *UserQuotas) ValidateAll() error {
-	return m.validate(true)
-}
-
-func (m *UserQuotas) validate(all bool) error {
-	if m == nil {
-		return nil
-	}
-
-	var errors []error
-
-	// no validation rules for SingleuserOrgs
-
-	if len(errors) > 0 {
-		return UserQuotasMultiError(errors)
+		return DeploymentMultiError(errors)
 	}
 
 	return nil
 }
 
-// UserQuotasMultiError is an error wrapping multiple validation errors
-// returned by UserQuotas.ValidateAll() if the designated constraints aren't met.
-type UserQuotasMultiError []error
+// DeploymentMultiError is an error wrapping multiple validation errors
+// returned by Deployment.ValidateAll() if the designated constraints aren't met.
+type DeploymentMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UserQuotasMultiError) Error() string {
+func (m DeploymentMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -21191,11 +26009,11 @@ func (m UserQuotasMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UserQuotasMultiError) AllErrors() []error { return m }
-
-// UserQuotasValidationError is the validation error returned by
-// UserQuotas.Validate if the designated constraints aren't met.
-type UserQuotasValidationError struct {
+func (m DeploymentMultiError) AllErrors() []error { return m }
+
+// DeploymentValidationError is the validation error returned by
+// Deployment.Validate if the designated constraints aren't met.
+type DeploymentValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -21203,22 +26021,22 @@ type UserQuotasValidationError struct {
 }
 
 // Field function returns field value.
-func (e UserQuotasValidationError) Field() string { return e.field }
+func (e DeploymentValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UserQuotasValidationError) Reason() string { return e.reason }
+func (e DeploymentValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UserQuotasValidationError) Cause() error { return e.cause }
+func (e DeploymentValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UserQuotasValidationError) Key() bool { return e.key }
+func (e DeploymentValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UserQuotasValidationError) ErrorName() string { return "UserQuotasValidationError" }
+func (e DeploymentValidationError) ErrorName() string { return "DeploymentValidationError" }
 
 // Error satisfies the builtin error interface
-func (e UserQuotasValidationError) Error() string {
+func (e DeploymentValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -21230,14 +26048,14 @@ func (e UserQuotasValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUserQuotas.%s: %s%s",
+		"invalid %sDeployment.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UserQuotasValidationError{}
+var _ error = DeploymentValidationError{}
 
 var _ interface {
 	Field() string
@@ -21245,54 +26063,58 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UserQuotasValidationError{}
+} = DeploymentValidationError{}
 
-// Validate checks the field values on OrganizationQuotas with the rules
+// Validate checks the field values on OrganizationPermissions with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *OrganizationQuotas) Validate() error {
+func (m *OrganizationPermissions) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on OrganizationQuotas with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on OrganizationPermissions with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// OrganizationQuotasMultiError, or nil if none found.
-func (m *OrganizationQuotas) ValidateAll() error {
+// OrganizationPermissionsMultiError, or nil if none found.
+func (m *OrganizationPermissions) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *OrganizationQuotas) validate(all bool) error {
+func (m *OrganizationPermissions) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Projects
+	// no validation rules for ReadOrg
 
-	// no validation rules for Deployments
+	// no validation rules for ManageOrg
 
-	// no validation rules for SlotsTotal
+	// no validation rules for ReadProjects
 
-	// no validation rules for SlotsPerDeployment
+	// no validation rules for CreateProjects
 
-	// no validation rules for OutstandingInvites
+	// no validation rules for ManageProjects
+
+	// no validation rules for ReadOrgMembers
+
+	// no validation rules for ManageOrgMembers
 
 	if len(errors) > 0 {
-		return OrganizationQuotasMultiError(errors)
+		return OrganizationPermissionsMultiError(errors)
 	}
 
 	return nil
 }
 
-// OrganizationQuotasMultiError is an error wrapping multiple validation errors
-// returned by OrganizationQuotas.ValidateAll() if the designated constraints
-// aren't met.
-type OrganizationQuotasMultiError []error
+// OrganizationPermissionsMultiError is an error wrapping multiple validation
+// errors returned by OrganizationPermissions.ValidateAll() if the designated
+// constraints aren't met.
+type OrganizationPermissionsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m OrganizationQuotasMultiError) Error() string {
+func (m OrganizationPermissionsMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -21301,11 +26123,11 @@ func (m OrganizationQuotasMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m OrganizationQuotasMultiError) AllErrors() []error { return m }
+func (m OrganizationPermissionsMultiError) AllErrors() []error { return m }
 
-// OrganizationQuotasValidationError is the validation error returned by
-// OrganizationQuotas.Validate if the designated constraints aren't met.
-type OrganizationQuotasValidationError struct {
+// OrganizationPermissionsValidationError is the validation error returned by
+// OrganizationPermissions.Validate if the designated constraints aren't met.
+type OrganizationPermissionsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -21313,24 +26135,24 @@ type OrganizationQuotasValidationError struct {
 }
 
 // Field function returns field value.
-func (e OrganizationQuotasValidationError) Field() string { return e.field }
+func (e OrganizationPermissionsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e OrganizationQuotasValidationError) Reason() string { return e.reason }
+func (e OrganizationPermissionsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e OrganizationQuotasValidationError) Cause() error { return e.cause }
+func (e OrganizationPermissionsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e OrganizationQuotasValidationError) Key() bool { return e.key }
+func (e OrganizationPermissionsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e OrganizationQuotasValidationError) ErrorName() string {
-	return "OrganizationQuotasValidationError"
+func (e OrganizationPermissionsValidationError) ErrorName() string {
+	return "OrganizationPermissionsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e OrganizationQuotasValidationError) Error() string {
+func (e OrganizationPermissionsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -21342,14 +26164,14 @@ func (e OrganizationQuotasValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sOrganizationQuotas.%s: %s%s",
+		"invalid %sOrganizationPermissions.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = OrganizationQuotasValidationError{}
+var _ error = OrganizationPermissionsValidationError{}
 
 var _ interface {
 	Field() string
@@ -21357,138 +26179,72 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = OrganizationQuotasValidationError{}
+} = OrganizationPermissionsValidationError{}
 
-// Validate checks the field values on Project with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *Project) Validate() error {
+// Validate checks the field values on ProjectPermissions with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ProjectPermissions) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Project with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in ProjectMultiError, or nil if none found.
-func (m *Project) ValidateAll() error {
+// ValidateAll checks the field values on ProjectPermissions with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ProjectPermissionsMultiError, or nil if none found.
+func (m *ProjectPermissions) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Project) validate(all bool) error {
+func (m *ProjectPermissions) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	// no validation rules for Name
-
-	// no validation rules for OrgId
-
-	// no validation rules for OrgName
-
-	// no validation rules for Description
-
-	// no validation rules for Public
-
-	// no validation rules for CreatedByUserId
-
-	// no validation rules for Provisioner
+	// no validation rules for ReadProject
 
-	// no validation rules for GithubUrl
+	// no validation rules for ManageProject
 
-	// no validation rules for Subpath
+	// no validation rules for ReadProd
 
-	// no validation rules for ProdBranch
+	// no validation rules for ReadProdStatus
 
-	// no validation rules for ProdOlapDriver
+	// no validation rules for ManageProd
 
-	// no validation rules for ProdOlapDsn
+	// no validation rules for ReadDev
 
-	// no validation rules for ProdSlots
+	// no validation rules for ReadDevStatus
 
-	// no validation rules for ProdDeploymentId
+	// no validation rules for ManageDev
 
-	// no validation rules for FrontendUrl
+	// no validation rules for ReadProjectMembers
 
-	// no validation rules for ProdTtlSeconds
+	// no validation rules for ManageProjectMembers
 
-	// no validation rules for Annotations
+	// no validation rules for CreateReports
 
-	// no validation rules for ProdVersion
+	// no validation rules for ManageReports
 
-	if all {
-		switch v := interface{}(m.GetCreatedOn()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ProjectValidationError{
-					field:  "CreatedOn",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ProjectValidationError{
-					field:  "CreatedOn",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetCreatedOn()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ProjectValidationError{
-				field:  "CreatedOn",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for CreateAlerts
 
-	if all {
-		switch v := interface{}(m.GetUpdatedOn()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ProjectValidationError{
-					field:  "UpdatedOn",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ProjectValidationError{
-					field:  "UpdatedOn",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetUpdatedOn()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ProjectValidationError{
-				field:  "UpdatedOn",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for ManageAlerts
 
 	if len(errors) > 0 {
-		return ProjectMultiError(errors)
+		return ProjectPermissionsMultiError(errors)
 	}
 
 	return nil
 }
 
-// ProjectMultiError is an error wrapping multiple validation errors returned
-// by Project.ValidateAll() if the designated constraints aren't met.
-type ProjectMultiError []error
+// ProjectPermissionsMultiError is an error wrapping multiple validation errors
+// returned by ProjectPermissions.ValidateAll() if the designated constraints
+// aren't met.
+type ProjectPermissionsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ProjectMultiError) Error() string {
+func (m ProjectPermissionsMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -21497,11 +26253,11 @@ func (m ProjectMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ProjectMultiError) AllErrors() []error { return m }
+func (m ProjectPermissionsMultiError) AllErrors() []error { return m }
 
-// ProjectValidationError is the validation error returned by Project.Validate
-// if the designated constraints aren't met.
-type ProjectValidationError struct {
+// ProjectPermissionsValidationError is the validation error returned by
+// ProjectPermissions.Validate if the designated constraints aren't met.
+type ProjectPermissionsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -21509,22 +26265,24 @@ type ProjectValidationError struct {
 }
 
 // Field function returns field value.
-func (e ProjectValidationError) Field() string { return e.field }
+func (e ProjectPermissionsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ProjectValidationError) Reason() string { return e.reason }
+func (e ProjectPermissionsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ProjectValidationError) Cause() error { return e.cause }
+func (e ProjectPermissionsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ProjectValidationError) Key() bool { return e.key }
+func (e ProjectPermissionsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ProjectValidationError) ErrorName() string { return "ProjectValidationError" }
+func (e ProjectPermissionsValidationError) ErrorName() string {
+	return "ProjectPermissionsValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ProjectValidationError) Error() string {
+func (e ProjectPermissionsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -21536,14 +26294,14 @@ func (e ProjectValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sProject.%s: %s%s",
+		"invalid %sProjectPermissions.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ProjectValidationError{}
+var _ error = ProjectPermissionsValidationError{}
 
 var _ interface {
 	Field() string
@@ -21551,51 +26309,42 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ProjectValidationError{}
+} = ProjectPermissionsValidationError{}
 
-// Validate checks the field values on Deployment with the rules defined in the
+// Validate checks the field values on Member with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *Deployment) Validate() error {
+func (m *Member) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Deployment with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in DeploymentMultiError, or
-// nil if none found.
-func (m *Deployment) ValidateAll() error {
+// ValidateAll checks the field values on Member with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in MemberMultiError, or nil if none found.
+func (m *Member) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Deployment) validate(all bool) error {
+func (m *Member) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	// no validation rules for ProjectId
-
-	// no validation rules for Slots
-
-	// no validation rules for Branch
-
-	// no validation rules for RuntimeHost
+	// no validation rules for UserId
 
-	// no validation rules for RuntimeInstanceId
+	// no validation rules for UserEmail
 
-	// no validation rules for Status
+	// no validation rules for UserName
 
-	// no validation rules for StatusMessage
+	// no validation rules for RoleName
 
 	if all {
 		switch v := interface{}(m.GetCreatedOn()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, DeploymentValidationError{
+				errors = append(errors, MemberValidationError{
 					field:  "CreatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -21603,7 +26352,7 @@ func (m *Deployment) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, DeploymentValidationError{
+				errors = append(errors, MemberValidationError{
 					field:  "CreatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -21612,7 +26361,7 @@ func (m *Deployment) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetCreatedOn()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return DeploymentValidationError{
+			return MemberValidationError{
 				field:  "CreatedOn",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -21624,7 +26373,7 @@ func (m *Deployment) validate(all bool) error {
 		switch v := interface{}(m.GetUpdatedOn()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, DeploymentValidationError{
+				errors = append(errors, MemberValidationError{
 					field:  "UpdatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -21632,7 +26381,7 @@ func (m *Deployment) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, DeploymentValidationError{
+				errors = append(errors, MemberValidationError{
 					field:  "UpdatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -21641,7 +26390,7 @@ func (m *Deployment) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetUpdatedOn()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return DeploymentValidationError{
+			return MemberValidationError{
 				field:  "UpdatedOn",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -21650,18 +26399,18 @@ func (m *Deployment) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return DeploymentMultiError(errors)
+		return MemberMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeploymentMultiError is an error wrapping multiple validation errors
-// returned by Deployment.ValidateAll() if the designated constraints aren't met.
-type DeploymentMultiError []error
+// MemberMultiError is an error wrapping multiple validation errors returned by
+// Member.ValidateAll() if the designated constraints aren't met.
+type MemberMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeploymentMultiError) Error() string {
+func (m MemberMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -21670,11 +26419,11 @@ func (m DeploymentMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeploymentMultiError) AllErrors() []error { return m }
+func (m MemberMultiError) AllErrors() []error { return m }
 
-// DeploymentValidationError is the validation error returned by
-// Deployment.Validate if the designated constraints aren't met.
-type DeploymentValidationError struct {
+// MemberValidationError is the validation error returned by Member.Validate if
+// the designated constraints aren't met.
+type MemberValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -21682,22 +26431,22 @@ type DeploymentValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeploymentValidationError) Field() string { return e.field }
+func (e MemberValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeploymentValidationError) Reason() string { return e.reason }
+func (e MemberValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeploymentValidationError) Cause() error { return e.cause }
+func (e MemberValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeploymentValidationError) Key() bool { return e.key }
+func (e MemberValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeploymentValidationError) ErrorName() string { return "DeploymentValidationError" }
+func (e MemberValidationError) ErrorName() string { return "MemberValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DeploymentValidationError) Error() string {
+func (e MemberValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -21709,14 +26458,14 @@ func (e DeploymentValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeployment.%s: %s%s",
+		"invalid %sMember.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeploymentValidationError{}
+var _ error = MemberValidationError{}
 
 var _ interface {
 	Field() string
@@ -21724,58 +26473,105 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeploymentValidationError{}
+} = MemberValidationError{}
 
-// Validate checks the field values on OrganizationPermissions with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *OrganizationPermissions) Validate() error {
+// Validate checks the field values on Usergroup with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Usergroup) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on OrganizationPermissions with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// OrganizationPermissionsMultiError, or nil if none found.
-func (m *OrganizationPermissions) ValidateAll() error {
+// ValidateAll checks the field values on Usergroup with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UsergroupMultiError, or nil
+// if none found.
+func (m *Usergroup) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *OrganizationPermissions) validate(all bool) error {
+func (m *Usergroup) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ReadOrg
-
-	// no validation rules for ManageOrg
-
-	// no validation rules for ReadProjects
-
-	// no validation rules for CreateProjects
+	// no validation rules for GroupId
 
-	// no validation rules for ManageProjects
+	// no validation rules for GroupName
 
-	// no validation rules for ReadOrgMembers
+	if all {
+		switch v := interface{}(m.GetCreatedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UsergroupValidationError{
+					field:  "CreatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UsergroupValidationError{
+					field:  "CreatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UsergroupValidationError{
+				field:  "CreatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
-	// no validation rules for ManageOrgMembers
+	if all {
+		switch v := interface{}(m.GetUpdatedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UsergroupValidationError{
+					field:  "UpdatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UsergroupValidationError{
+					field:  "UpdatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UsergroupValidationError{
+				field:  "UpdatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return OrganizationPermissionsMultiError(errors)
+		return UsergroupMultiError(errors)
 	}
 
 	return nil
 }
 
-// OrganizationPermissionsMultiError is an error wrapping multiple validation
-// errors returned by OrganizationPermissions.ValidateAll() if the designated
-// constraints aren't met.
-type OrganizationPermissionsMultiError []error
+// UsergroupMultiError is an error wrapping multiple validation errors returned
+// by Usergroup.ValidateAll() if the designated constraints aren't met.
+type UsergroupMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m OrganizationPermissionsMultiError) Error() string {
+func (m UsergroupMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -21784,11 +26580,11 @@ func (m OrganizationPermissionsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m OrganizationPermissionsMultiError) AllErrors() []error { return m }
+func (m UsergroupMultiError) AllErrors() []error { return m }
 
-// OrganizationPermissionsValidationError is the validation error returned by
-// OrganizationPermissions.Validate if the designated constraints aren't met.
-type OrganizationPermissionsValidationError struct {
+// UsergroupValidationError is the validation error returned by
+// Usergroup.Validate if the designated constraints aren't met.
+type UsergroupValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -21796,24 +26592,22 @@ type OrganizationPermissionsValidationError struct {
 }
 
 // Field function returns field value.
-func (e OrganizationPermissionsValidationError) Field() string { return e.field }
+func (e UsergroupValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e OrganizationPermissionsValidationError) Reason() string { return e.reason }
+func (e UsergroupValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e OrganizationPermissionsValidationError) Cause() error { return e.cause }
+func (e UsergroupValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e OrganizationPermissionsValidationError) Key() bool { return e.key }
+func (e UsergroupValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e OrganizationPermissionsValidationError) ErrorName() string {
-	return "OrganizationPermissionsValidationError"
-}
+func (e UsergroupValidationError) ErrorName() string { return "UsergroupValidationError" }
 
 // Error satisfies the builtin error interface
-func (e OrganizationPermissionsValidationError) Error() string {
+func (e UsergroupValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -21825,14 +26619,14 @@ func (e OrganizationPermissionsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sOrganizationPermissions.%s: %s%s",
+		"invalid %sUsergroup.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = OrganizationPermissionsValidationError{}
+var _ error = UsergroupValidationError{}
 
 var _ interface {
 	Field() string
@@ -21840,72 +26634,108 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = OrganizationPermissionsValidationError{}
+} = UsergroupValidationError{}
 
-// Validate checks the field values on ProjectPermissions with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ProjectPermissions) Validate() error {
+// Validate checks the field values on MemberUsergroup with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MemberUsergroup) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ProjectPermissions with the rules
+// ValidateAll checks the field values on MemberUsergroup with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ProjectPermissionsMultiError, or nil if none found.
-func (m *ProjectPermissions) ValidateAll() error {
+// MemberUsergroupMultiError, or nil if none found.
+func (m *MemberUsergroup) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ProjectPermissions) validate(all bool) error {
+func (m *MemberUsergroup) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ReadProject
-
-	// no validation rules for ManageProject
-
-	// no validation rules for ReadProd
-
-	// no validation rules for ReadProdStatus
-
-	// no validation rules for ManageProd
-
-	// no validation rules for ReadDev
-
-	// no validation rules for ReadDevStatus
-
-	// no validation rules for ManageDev
-
-	// no validation rules for ReadProjectMembers
-
-	// no validation rules for ManageProjectMembers
+	// no validation rules for GroupId
 
-	// no validation rules for CreateReports
+	// no validation rules for GroupName
 
-	// no validation rules for ManageReports
+	// no validation rules for RoleName
 
-	// no validation rules for CreateAlerts
+	if all {
+		switch v := interface{}(m.GetCreatedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MemberUsergroupValidationError{
+					field:  "CreatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MemberUsergroupValidationError{
+					field:  "CreatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MemberUsergroupValidationError{
+				field:  "CreatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
-	// no validation rules for ManageAlerts
+	if all {
+		switch v := interface{}(m.GetUpdatedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MemberUsergroupValidationError{
+					field:  "UpdatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MemberUsergroupValidationError{
+					field:  "UpdatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MemberUsergroupValidationError{
+				field:  "UpdatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return ProjectPermissionsMultiError(errors)
+		return MemberUsergroupMultiError(errors)
 	}
 
 	return nil
 }
 
-// ProjectPermissionsMultiError is an error wrapping multiple validation errors
-// returned by ProjectPermissions.ValidateAll() if the designated constraints
+// MemberUsergroupMultiError is an error wrapping multiple validation errors
+// returned by MemberUsergroup.ValidateAll() if the designated constraints
 // aren't met.
-type ProjectPermissionsMultiError []error
+type MemberUsergroupMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ProjectPermissionsMultiError) Error() string {
+func (m MemberUsergroupMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -21914,11 +26744,11 @@ func (m ProjectPermissionsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ProjectPermissionsMultiError) AllErrors() []error { return m }
-
-// ProjectPermissionsValidationError is the validation error returned by
-// ProjectPermissions.Validate if the designated constraints aren't met.
-type ProjectPermissionsValidationError struct {
+func (m MemberUsergroupMultiError) AllErrors() []error { return m }
+
+// MemberUsergroupValidationError is the validation error returned by
+// MemberUsergroup.Validate if the designated constraints aren't met.
+type MemberUsergroupValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -21926,24 +26756,22 @@ type ProjectPermissionsValidationError struct {
 }
 
 // Field function returns field value.
-func (e ProjectPermissionsValidationError) Field() string { return e.field }
+func (e MemberUsergroupValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ProjectPermissionsValidationError) Reason() string { return e.reason }
+func (e MemberUsergroupValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ProjectPermissionsValidationError) Cause() error { return e.cause }
+func (e MemberUsergroupValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ProjectPermissionsValidationError) Key() bool { return e.key }
+func (e MemberUsergroupValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ProjectPermissionsValidationError) ErrorName() string {
-	return "ProjectPermissionsValidationError"
-}
+func (e MemberUsergroupValidationError) ErrorName() string { return "MemberUsergroupValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ProjectPermissionsValidationError) Error() string {
+func (e MemberUsergroupValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -21955,14 +26783,14 @@ func (e ProjectPermissionsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sProjectPermissions.%s: %s%s",
+		"invalid %sMemberUsergroup.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ProjectPermissionsValidationError{}
+var _ error = MemberUsergroupValidationError{}
 
 var _ interface {
 	Field() string
@@ -21970,60 +26798,69 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ProjectPermissionsValidationError{}
+} = MemberUsergroupValidationError{}
 
-// Validate checks the field values on Member with the rules defined in the
+// Validate checks the field values on AuditEvent with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *Member) Validate() error {
+func (m *AuditEvent) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Member with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in MemberMultiError, or nil if none found.
-func (m *Member) ValidateAll() error {
+// ValidateAll checks the field values on AuditEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AuditEventMultiError, or
+// nil if none found.
+func (m *AuditEvent) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Member) validate(all bool) error {
+func (m *AuditEvent) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for UserId
+	// no validation rules for Id
 
-	// no validation rules for UserEmail
+	// no validation rules for OrgId
 
-	// no validation rules for UserName
+	// no validation rules for ProjectId
 
-	// no validation rules for RoleName
+	// no validation rules for ProjectName
+
+	// no validation rules for ActorType
+
+	// no validation rules for ActorId
+
+	// no validation rules for ActorName
+
+	// no validation rules for Action
 
 	if all {
-		switch v := interface{}(m.GetCreatedOn()).(type) {
+		switch v := interface{}(m.GetPayload()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MemberValidationError{
-					field:  "CreatedOn",
+				errors = append(errors, AuditEventValidationError{
+					field:  "Payload",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, MemberValidationError{
-					field:  "CreatedOn",
+				errors = append(errors, AuditEventValidationError{
+					field:  "Payload",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetCreatedOn()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetPayload()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return MemberValidationError{
-				field:  "CreatedOn",
+			return AuditEventValidationError{
+				field:  "Payload",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -22031,28 +26868,86 @@ func (m *Member) validate(all bool) error {
 	}
 
 	if all {
-		switch v := interface{}(m.GetUpdatedOn()).(type) {
+		switch v := interface{}(m.GetBefore()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MemberValidationError{
-					field:  "UpdatedOn",
+				errors = append(errors, AuditEventValidationError{
+					field:  "Before",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, MemberValidationError{
-					field:  "UpdatedOn",
+				errors = append(errors, AuditEventValidationError{
+					field:  "Before",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetUpdatedOn()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetBefore()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return MemberValidationError{
-				field:  "UpdatedOn",
+			return AuditEventValidationError{
+				field:  "Before",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetAfter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuditEventValidationError{
+					field:  "After",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AuditEventValidationError{
+					field:  "After",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAfter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuditEventValidationError{
+				field:  "After",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuditEventValidationError{
+					field:  "CreatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AuditEventValidationError{
+					field:  "CreatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuditEventValidationError{
+				field:  "CreatedOn",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -22060,18 +26955,18 @@ func (m *Member) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return MemberMultiError(errors)
+		return AuditEventMultiError(errors)
 	}
 
 	return nil
 }
 
-// MemberMultiError is an error wrapping multiple validation errors returned by
-// Member.ValidateAll() if the designated constraints aren't met.
-type MemberMultiError []error
+// AuditEventMultiError is an error wrapping multiple validation errors
+// returned by AuditEvent.ValidateAll() if the designated constraints aren't met.
+type AuditEventMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MemberMultiError) Error() string {
+func (m AuditEventMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -22080,11 +26975,11 @@ func (m MemberMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MemberMultiError) AllErrors() []error { return m }
+func (m AuditEventMultiError) AllErrors() []error { return m }
 
-// MemberValidationError is the validation error returned by Member.Validate if
-// the designated constraints aren't met.
-type MemberValidationError struct {
+// AuditEventValidationError is the validation error returned by
+// AuditEvent.Validate if the designated constraints aren't met.
+type AuditEventValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -22092,22 +26987,22 @@ type MemberValidationError struct {
 }
 
 // Field function returns field value.
-func (e MemberValidationError) Field() string { return e.field }
+func (e AuditEventValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MemberValidationError) Reason() string { return e.reason }
+func (e AuditEventValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MemberValidationError) Cause() error { return e.cause }
+func (e AuditEventValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MemberValidationError) Key() bool { return e.key }
+func (e AuditEventValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MemberValidationError) ErrorName() string { return "MemberValidationError" }
+func (e AuditEventValidationError) ErrorName() string { return "AuditEventValidationError" }
 
 // Error satisfies the builtin error interface
-func (e MemberValidationError) Error() string {
+func (e AuditEventValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -22119,14 +27014,14 @@ func (e MemberValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMember.%s: %s%s",
+		"invalid %sAuditEvent.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MemberValidationError{}
+var _ error = AuditEventValidationError{}
 
 var _ interface {
 	Field() string
@@ -22134,7 +27029,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MemberValidationError{}
+} = AuditEventValidationError{}
 
 // Validate checks the field values on UserInvite with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
@@ -22347,6 +27242,191 @@ var _ interface {
 	ErrorName() string
 } = WhitelistedDomainValidationError{}
 
+// Validate checks the field values on SSOProvider with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SSOProvider) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SSOProvider with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SSOProviderMultiError, or
+// nil if none found.
+func (m *SSOProvider) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SSOProvider) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for OrgId
+
+	// no validation rules for Type
+
+	// no validation rules for JitRole
+
+	// no validation rules for OidcIssuerUrl
+
+	// no validation rules for OidcClientId
+
+	// no validation rules for SamlIdpMetadataUrl
+
+	// no validation rules for EmailClaim
+
+	// no validation rules for NameClaim
+
+	// no validation rules for GroupsClaim
+
+	// no validation rules for OidcRedirectUrl
+
+	// no validation rules for SamlEntityId
+
+	// no validation rules for SamlAcsUrl
+
+	if all {
+		switch v := interface{}(m.GetCreatedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SSOProviderValidationError{
+					field:  "CreatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SSOProviderValidationError{
+					field:  "CreatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SSOProviderValidationError{
+				field:  "CreatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SSOProviderValidationError{
+					field:  "UpdatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SSOProviderValidationError{
+					field:  "UpdatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SSOProviderValidationError{
+				field:  "UpdatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for DomainVerificationTxtValue
+
+	if len(errors) > 0 {
+		return SSOProviderMultiError(errors)
+	}
+
+	return nil
+}
+
+// SSOProviderMultiError is an error wrapping multiple validation errors
+// returned by SSOProvider.ValidateAll() if the designated constraints aren't met.
+type SSOProviderMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SSOProviderMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SSOProviderMultiError) AllErrors() []error { return m }
+
+// SSOProviderValidationError is the validation error returned by
+// SSOProvider.Validate if the designated constraints aren't met.
+type SSOProviderValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SSOProviderValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SSOProviderValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SSOProviderValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SSOProviderValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SSOProviderValidationError) ErrorName() string { return "SSOProviderValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SSOProviderValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSSOProvider.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SSOProviderValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SSOProviderValidationError{}
+
 // Validate checks the field values on Bookmark with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
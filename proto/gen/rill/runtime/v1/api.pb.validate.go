@@ -6690,6 +6690,464 @@ var _ interface {
 	ErrorName() string
 } = GetResourceResponseValidationError{}
 
+// Validate checks the field values on GetModelPartitionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetModelPartitionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetModelPartitionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetModelPartitionsRequestMultiError, or nil if none found.
+func (m *GetModelPartitionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetModelPartitionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for InstanceId
+
+	// no validation rules for Model
+
+	// no validation rules for Pending
+
+	// no validation rules for Errored
+
+	if m.GetPageSize() != 0 {
+
+		if m.GetPageSize() > 10000 {
+			err := GetModelPartitionsRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be less than or equal to 10000",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return GetModelPartitionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetModelPartitionsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetModelPartitionsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type GetModelPartitionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetModelPartitionsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetModelPartitionsRequestMultiError) AllErrors() []error { return m }
+
+// GetModelPartitionsRequestValidationError is the validation error returned by
+// GetModelPartitionsRequest.Validate if the designated constraints aren't met.
+type GetModelPartitionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetModelPartitionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetModelPartitionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetModelPartitionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetModelPartitionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetModelPartitionsRequestValidationError) ErrorName() string {
+	return "GetModelPartitionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetModelPartitionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetModelPartitionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetModelPartitionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetModelPartitionsRequestValidationError{}
+
+// Validate checks the field values on GetModelPartitionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetModelPartitionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetModelPartitionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetModelPartitionsResponseMultiError, or nil if none found.
+func (m *GetModelPartitionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetModelPartitionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPartitions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetModelPartitionsResponseValidationError{
+						field:  fmt.Sprintf("Partitions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetModelPartitionsResponseValidationError{
+						field:  fmt.Sprintf("Partitions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetModelPartitionsResponseValidationError{
+					field:  fmt.Sprintf("Partitions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return GetModelPartitionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetModelPartitionsResponseMultiError is an error wrapping multiple
+// validation errors returned by GetModelPartitionsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetModelPartitionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetModelPartitionsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetModelPartitionsResponseMultiError) AllErrors() []error { return m }
+
+// GetModelPartitionsResponseValidationError is the validation error returned
+// by GetModelPartitionsResponse.Validate if the designated constraints aren't met.
+type GetModelPartitionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetModelPartitionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetModelPartitionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetModelPartitionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetModelPartitionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetModelPartitionsResponseValidationError) ErrorName() string {
+	return "GetModelPartitionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetModelPartitionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetModelPartitionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetModelPartitionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetModelPartitionsResponseValidationError{}
+
+// Validate checks the field values on ModelPartition with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ModelPartition) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ModelPartition with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ModelPartitionMultiError,
+// or nil if none found.
+func (m *ModelPartition) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ModelPartition) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Key
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ModelPartitionValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ModelPartitionValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ModelPartitionValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetWatermark()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ModelPartitionValidationError{
+					field:  "Watermark",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ModelPartitionValidationError{
+					field:  "Watermark",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWatermark()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ModelPartitionValidationError{
+				field:  "Watermark",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetExecutedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ModelPartitionValidationError{
+					field:  "ExecutedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ModelPartitionValidationError{
+					field:  "ExecutedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExecutedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ModelPartitionValidationError{
+				field:  "ExecutedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Error
+
+	// no validation rules for ElapsedMs
+
+	if len(errors) > 0 {
+		return ModelPartitionMultiError(errors)
+	}
+
+	return nil
+}
+
+// ModelPartitionMultiError is an error wrapping multiple validation errors
+// returned by ModelPartition.ValidateAll() if the designated constraints
+// aren't met.
+type ModelPartitionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ModelPartitionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ModelPartitionMultiError) AllErrors() []error { return m }
+
+// ModelPartitionValidationError is the validation error returned by
+// ModelPartition.Validate if the designated constraints aren't met.
+type ModelPartitionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ModelPartitionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ModelPartitionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ModelPartitionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ModelPartitionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ModelPartitionValidationError) ErrorName() string { return "ModelPartitionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ModelPartitionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sModelPartition.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ModelPartitionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ModelPartitionValidationError{}
+
 // Validate checks the field values on CreateTriggerRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -7099,6 +7557,8 @@ func (m *ConnectorDriver) validate(all bool) error {
 
 	// no validation rules for ImplementsNotifier
 
+	// no validation rules for ImplementsStreamStore
+
 	if len(errors) > 0 {
 		return ConnectorDriverMultiError(errors)
 	}
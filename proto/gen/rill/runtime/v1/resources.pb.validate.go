@@ -2017,6 +2017,8 @@ func (m *SourceState) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for StreamOffsets
+
 	if len(errors) > 0 {
 		return SourceStateMultiError(errors)
 	}
@@ -2336,6 +2338,39 @@ func (m *ModelSpec) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for PartitionsResolver
+
+	if all {
+		switch v := interface{}(m.GetPartitionsResolverProperties()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ModelSpecValidationError{
+					field:  "PartitionsResolverProperties",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ModelSpecValidationError{
+					field:  "PartitionsResolverProperties",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPartitionsResolverProperties()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ModelSpecValidationError{
+				field:  "PartitionsResolverProperties",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for PartitionsWatermarkField
+
 	// no validation rules for InputConnector
 
 	if all {
@@ -2398,8 +2433,44 @@ func (m *ModelSpec) validate(all bool) error {
 		}
 	}
 
+	for idx, item := range m.GetTests() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ModelSpecValidationError{
+						field:  fmt.Sprintf("Tests[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ModelSpecValidationError{
+						field:  fmt.Sprintf("Tests[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ModelSpecValidationError{
+					field:  fmt.Sprintf("Tests[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	// no validation rules for Trigger
 
+	// no validation rules for TriggerFull
+
 	if len(errors) > 0 {
 		return ModelSpecMultiError(errors)
 	}
@@ -2625,6 +2696,46 @@ func (m *ModelState) validate(all bool) error {
 		}
 	}
 
+	for idx, item := range m.GetTestResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ModelStateValidationError{
+						field:  fmt.Sprintf("TestResults[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ModelStateValidationError{
+						field:  fmt.Sprintf("TestResults[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ModelStateValidationError{
+					field:  fmt.Sprintf("TestResults[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TestHash
+
+	// no validation rules for PartitionsModelId
+
+	// no validation rules for PartitionsHaveErrors
+
 	if len(errors) > 0 {
 		return ModelStateMultiError(errors)
 	}
@@ -2702,100 +2813,93 @@ var _ interface {
 	ErrorName() string
 } = ModelStateValidationError{}
 
-// Validate checks the field values on MetricsViewV2 with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on ModelTest with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *MetricsViewV2) Validate() error {
+func (m *ModelTest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MetricsViewV2 with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in MetricsViewV2MultiError, or
-// nil if none found.
-func (m *MetricsViewV2) ValidateAll() error {
+// ValidateAll checks the field values on ModelTest with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ModelTestMultiError, or nil
+// if none found.
+func (m *ModelTest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MetricsViewV2) validate(all bool) error {
+func (m *ModelTest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetSpec()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MetricsViewV2ValidationError{
-					field:  "Spec",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	// no validation rules for Name
+
+	// no validation rules for Type
+
+	// no validation rules for Severity
+
+	// no validation rules for Column
+
+	for idx, item := range m.GetAcceptedValues() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ModelTestValidationError{
+						field:  fmt.Sprintf("AcceptedValues[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ModelTestValidationError{
+						field:  fmt.Sprintf("AcceptedValues[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, MetricsViewV2ValidationError{
-					field:  "Spec",
+				return ModelTestValidationError{
+					field:  fmt.Sprintf("AcceptedValues[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSpec()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return MetricsViewV2ValidationError{
-				field:  "Spec",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
-	if all {
-		switch v := interface{}(m.GetState()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MetricsViewV2ValidationError{
-					field:  "State",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, MetricsViewV2ValidationError{
-					field:  "State",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetState()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return MetricsViewV2ValidationError{
-				field:  "State",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+	// no validation rules for Sql
+
+	if m.MinRows != nil {
+		// no validation rules for MinRows
+	}
+
+	if m.MaxRows != nil {
+		// no validation rules for MaxRows
 	}
 
 	if len(errors) > 0 {
-		return MetricsViewV2MultiError(errors)
+		return ModelTestMultiError(errors)
 	}
 
 	return nil
 }
 
-// MetricsViewV2MultiError is an error wrapping multiple validation errors
-// returned by MetricsViewV2.ValidateAll() if the designated constraints
-// aren't met.
-type MetricsViewV2MultiError []error
+// ModelTestMultiError is an error wrapping multiple validation errors returned
+// by ModelTest.ValidateAll() if the designated constraints aren't met.
+type ModelTestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MetricsViewV2MultiError) Error() string {
+func (m ModelTestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2804,11 +2908,11 @@ func (m MetricsViewV2MultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MetricsViewV2MultiError) AllErrors() []error { return m }
+func (m ModelTestMultiError) AllErrors() []error { return m }
 
-// MetricsViewV2ValidationError is the validation error returned by
-// MetricsViewV2.Validate if the designated constraints aren't met.
-type MetricsViewV2ValidationError struct {
+// ModelTestValidationError is the validation error returned by
+// ModelTest.Validate if the designated constraints aren't met.
+type ModelTestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2816,22 +2920,22 @@ type MetricsViewV2ValidationError struct {
 }
 
 // Field function returns field value.
-func (e MetricsViewV2ValidationError) Field() string { return e.field }
+func (e ModelTestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MetricsViewV2ValidationError) Reason() string { return e.reason }
+func (e ModelTestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MetricsViewV2ValidationError) Cause() error { return e.cause }
+func (e ModelTestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MetricsViewV2ValidationError) Key() bool { return e.key }
+func (e ModelTestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MetricsViewV2ValidationError) ErrorName() string { return "MetricsViewV2ValidationError" }
+func (e ModelTestValidationError) ErrorName() string { return "ModelTestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e MetricsViewV2ValidationError) Error() string {
+func (e ModelTestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2843,14 +2947,14 @@ func (e MetricsViewV2ValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMetricsViewV2.%s: %s%s",
+		"invalid %sModelTest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MetricsViewV2ValidationError{}
+var _ error = ModelTestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2858,218 +2962,67 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MetricsViewV2ValidationError{}
+} = ModelTestValidationError{}
 
-// Validate checks the field values on MetricsViewSpec with the rules defined
+// Validate checks the field values on ModelTestResult with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *MetricsViewSpec) Validate() error {
+func (m *ModelTestResult) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MetricsViewSpec with the rules
+// ValidateAll checks the field values on ModelTestResult with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// MetricsViewSpecMultiError, or nil if none found.
-func (m *MetricsViewSpec) ValidateAll() error {
+// ModelTestResultMultiError, or nil if none found.
+func (m *ModelTestResult) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MetricsViewSpec) validate(all bool) error {
+func (m *ModelTestResult) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Connector
-
-	// no validation rules for Database
-
-	// no validation rules for DatabaseSchema
+	// no validation rules for Name
 
-	// no validation rules for Table
+	// no validation rules for Status
 
-	// no validation rules for Title
+	// no validation rules for Severity
 
-	// no validation rules for Description
+	// no validation rules for FailedRows
 
-	// no validation rules for TimeDimension
+	// no validation rules for ErrorMessage
 
-	// no validation rules for WatermarkExpression
+	if len(errors) > 0 {
+		return ModelTestResultMultiError(errors)
+	}
 
-	for idx, item := range m.GetDimensions() {
-		_, _ = idx, item
+	return nil
+}
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, MetricsViewSpecValidationError{
-						field:  fmt.Sprintf("Dimensions[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, MetricsViewSpecValidationError{
-						field:  fmt.Sprintf("Dimensions[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return MetricsViewSpecValidationError{
-					field:  fmt.Sprintf("Dimensions[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+// ModelTestResultMultiError is an error wrapping multiple validation errors
+// returned by ModelTestResult.ValidateAll() if the designated constraints
+// aren't met.
+type ModelTestResultMultiError []error
 
+// Error returns a concatenation of all the error messages it wraps.
+func (m ModelTestResultMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
 	}
+	return strings.Join(msgs, "; ")
+}
 
-	for idx, item := range m.GetMeasures() {
-		_, _ = idx, item
+// AllErrors returns a list of validation violation errors.
+func (m ModelTestResultMultiError) AllErrors() []error { return m }
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, MetricsViewSpecValidationError{
-						field:  fmt.Sprintf("Measures[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, MetricsViewSpecValidationError{
-						field:  fmt.Sprintf("Measures[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return MetricsViewSpecValidationError{
-					field:  fmt.Sprintf("Measures[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	// no validation rules for SmallestTimeGrain
-
-	// no validation rules for DefaultTimeRange
-
-	if all {
-		switch v := interface{}(m.GetSecurity()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MetricsViewSpecValidationError{
-					field:  "Security",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, MetricsViewSpecValidationError{
-					field:  "Security",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSecurity()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return MetricsViewSpecValidationError{
-				field:  "Security",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	// no validation rules for FirstDayOfWeek
-
-	// no validation rules for FirstMonthOfYear
-
-	// no validation rules for DefaultComparisonMode
-
-	// no validation rules for DefaultComparisonDimension
-
-	for idx, item := range m.GetAvailableTimeRanges() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, MetricsViewSpecValidationError{
-						field:  fmt.Sprintf("AvailableTimeRanges[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, MetricsViewSpecValidationError{
-						field:  fmt.Sprintf("AvailableTimeRanges[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return MetricsViewSpecValidationError{
-					field:  fmt.Sprintf("AvailableTimeRanges[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	// no validation rules for DefaultTheme
-
-	if len(errors) > 0 {
-		return MetricsViewSpecMultiError(errors)
-	}
-
-	return nil
-}
-
-// MetricsViewSpecMultiError is an error wrapping multiple validation errors
-// returned by MetricsViewSpec.ValidateAll() if the designated constraints
-// aren't met.
-type MetricsViewSpecMultiError []error
-
-// Error returns a concatenation of all the error messages it wraps.
-func (m MetricsViewSpecMultiError) Error() string {
-	var msgs []string
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
-	}
-	return strings.Join(msgs, "; ")
-}
-
-// AllErrors returns a list of validation violation errors.
-func (m MetricsViewSpecMultiError) AllErrors() []error { return m }
-
-// MetricsViewSpecValidationError is the validation error returned by
-// MetricsViewSpec.Validate if the designated constraints aren't met.
-type MetricsViewSpecValidationError struct {
+// ModelTestResultValidationError is the validation error returned by
+// ModelTestResult.Validate if the designated constraints aren't met.
+type ModelTestResultValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3077,22 +3030,22 @@ type MetricsViewSpecValidationError struct {
 }
 
 // Field function returns field value.
-func (e MetricsViewSpecValidationError) Field() string { return e.field }
+func (e ModelTestResultValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MetricsViewSpecValidationError) Reason() string { return e.reason }
+func (e ModelTestResultValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MetricsViewSpecValidationError) Cause() error { return e.cause }
+func (e ModelTestResultValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MetricsViewSpecValidationError) Key() bool { return e.key }
+func (e ModelTestResultValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MetricsViewSpecValidationError) ErrorName() string { return "MetricsViewSpecValidationError" }
+func (e ModelTestResultValidationError) ErrorName() string { return "ModelTestResultValidationError" }
 
 // Error satisfies the builtin error interface
-func (e MetricsViewSpecValidationError) Error() string {
+func (e ModelTestResultValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3104,14 +3057,14 @@ func (e MetricsViewSpecValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMetricsViewSpec.%s: %s%s",
+		"invalid %sModelTestResult.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MetricsViewSpecValidationError{}
+var _ error = ModelTestResultValidationError{}
 
 var _ interface {
 	Field() string
@@ -3119,24 +3072,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MetricsViewSpecValidationError{}
+} = ModelTestResultValidationError{}
 
-// Validate checks the field values on MetricsViewState with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *MetricsViewState) Validate() error {
+// Validate checks the field values on MetricsViewV2 with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MetricsViewV2) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MetricsViewState with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// MetricsViewStateMultiError, or nil if none found.
-func (m *MetricsViewState) ValidateAll() error {
+// ValidateAll checks the field values on MetricsViewV2 with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MetricsViewV2MultiError, or
+// nil if none found.
+func (m *MetricsViewV2) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MetricsViewState) validate(all bool) error {
+func (m *MetricsViewV2) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -3144,50 +3097,77 @@ func (m *MetricsViewState) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetValidSpec()).(type) {
+		switch v := interface{}(m.GetSpec()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MetricsViewStateValidationError{
-					field:  "ValidSpec",
+				errors = append(errors, MetricsViewV2ValidationError{
+					field:  "Spec",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, MetricsViewStateValidationError{
-					field:  "ValidSpec",
+				errors = append(errors, MetricsViewV2ValidationError{
+					field:  "Spec",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetValidSpec()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetSpec()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return MetricsViewStateValidationError{
-				field:  "ValidSpec",
+			return MetricsViewV2ValidationError{
+				field:  "Spec",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	// no validation rules for Streaming
+	if all {
+		switch v := interface{}(m.GetState()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetricsViewV2ValidationError{
+					field:  "State",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetricsViewV2ValidationError{
+					field:  "State",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetState()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetricsViewV2ValidationError{
+				field:  "State",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return MetricsViewStateMultiError(errors)
+		return MetricsViewV2MultiError(errors)
 	}
 
 	return nil
 }
 
-// MetricsViewStateMultiError is an error wrapping multiple validation errors
-// returned by MetricsViewState.ValidateAll() if the designated constraints
+// MetricsViewV2MultiError is an error wrapping multiple validation errors
+// returned by MetricsViewV2.ValidateAll() if the designated constraints
 // aren't met.
-type MetricsViewStateMultiError []error
+type MetricsViewV2MultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MetricsViewStateMultiError) Error() string {
+func (m MetricsViewV2MultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3196,11 +3176,11 @@ func (m MetricsViewStateMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MetricsViewStateMultiError) AllErrors() []error { return m }
+func (m MetricsViewV2MultiError) AllErrors() []error { return m }
 
-// MetricsViewStateValidationError is the validation error returned by
-// MetricsViewState.Validate if the designated constraints aren't met.
-type MetricsViewStateValidationError struct {
+// MetricsViewV2ValidationError is the validation error returned by
+// MetricsViewV2.Validate if the designated constraints aren't met.
+type MetricsViewV2ValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3208,22 +3188,22 @@ type MetricsViewStateValidationError struct {
 }
 
 // Field function returns field value.
-func (e MetricsViewStateValidationError) Field() string { return e.field }
+func (e MetricsViewV2ValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MetricsViewStateValidationError) Reason() string { return e.reason }
+func (e MetricsViewV2ValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MetricsViewStateValidationError) Cause() error { return e.cause }
+func (e MetricsViewV2ValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MetricsViewStateValidationError) Key() bool { return e.key }
+func (e MetricsViewV2ValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MetricsViewStateValidationError) ErrorName() string { return "MetricsViewStateValidationError" }
+func (e MetricsViewV2ValidationError) ErrorName() string { return "MetricsViewV2ValidationError" }
 
 // Error satisfies the builtin error interface
-func (e MetricsViewStateValidationError) Error() string {
+func (e MetricsViewV2ValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3235,14 +3215,14 @@ func (e MetricsViewStateValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMetricsViewState.%s: %s%s",
+		"invalid %sMetricsViewV2.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MetricsViewStateValidationError{}
+var _ error = MetricsViewV2ValidationError{}
 
 var _ interface {
 	Field() string
@@ -3250,24 +3230,780 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MetricsViewStateValidationError{}
+} = MetricsViewV2ValidationError{}
 
-// Validate checks the field values on Migration with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *Migration) Validate() error {
+// Validate checks the field values on MetricsViewSpec with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MetricsViewSpec) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Migration with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in MigrationMultiError, or nil
+// ValidateAll checks the field values on MetricsViewSpec with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MetricsViewSpecMultiError, or nil if none found.
+func (m *MetricsViewSpec) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MetricsViewSpec) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Connector
+
+	// no validation rules for Database
+
+	// no validation rules for DatabaseSchema
+
+	// no validation rules for Table
+
+	// no validation rules for Title
+
+	// no validation rules for Description
+
+	// no validation rules for TimeDimension
+
+	// no validation rules for WatermarkExpression
+
+	for idx, item := range m.GetDimensions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetricsViewSpecValidationError{
+						field:  fmt.Sprintf("Dimensions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetricsViewSpecValidationError{
+						field:  fmt.Sprintf("Dimensions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetricsViewSpecValidationError{
+					field:  fmt.Sprintf("Dimensions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetMeasures() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetricsViewSpecValidationError{
+						field:  fmt.Sprintf("Measures[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetricsViewSpecValidationError{
+						field:  fmt.Sprintf("Measures[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetricsViewSpecValidationError{
+					field:  fmt.Sprintf("Measures[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for SmallestTimeGrain
+
+	// no validation rules for DefaultTimeRange
+
+	if all {
+		switch v := interface{}(m.GetSecurity()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetricsViewSpecValidationError{
+					field:  "Security",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetricsViewSpecValidationError{
+					field:  "Security",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSecurity()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetricsViewSpecValidationError{
+				field:  "Security",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for FirstDayOfWeek
+
+	// no validation rules for FirstMonthOfYear
+
+	// no validation rules for DefaultComparisonMode
+
+	// no validation rules for DefaultComparisonDimension
+
+	for idx, item := range m.GetAvailableTimeRanges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetricsViewSpecValidationError{
+						field:  fmt.Sprintf("AvailableTimeRanges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetricsViewSpecValidationError{
+						field:  fmt.Sprintf("AvailableTimeRanges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetricsViewSpecValidationError{
+					field:  fmt.Sprintf("AvailableTimeRanges[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for DefaultTheme
+
+	if len(errors) > 0 {
+		return MetricsViewSpecMultiError(errors)
+	}
+
+	return nil
+}
+
+// MetricsViewSpecMultiError is an error wrapping multiple validation errors
+// returned by MetricsViewSpec.ValidateAll() if the designated constraints
+// aren't met.
+type MetricsViewSpecMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MetricsViewSpecMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MetricsViewSpecMultiError) AllErrors() []error { return m }
+
+// MetricsViewSpecValidationError is the validation error returned by
+// MetricsViewSpec.Validate if the designated constraints aren't met.
+type MetricsViewSpecValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MetricsViewSpecValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MetricsViewSpecValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MetricsViewSpecValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MetricsViewSpecValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MetricsViewSpecValidationError) ErrorName() string { return "MetricsViewSpecValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MetricsViewSpecValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMetricsViewSpec.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MetricsViewSpecValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MetricsViewSpecValidationError{}
+
+// Validate checks the field values on MetricsViewState with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MetricsViewState) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MetricsViewState with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MetricsViewStateMultiError, or nil if none found.
+func (m *MetricsViewState) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MetricsViewState) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetValidSpec()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetricsViewStateValidationError{
+					field:  "ValidSpec",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetricsViewStateValidationError{
+					field:  "ValidSpec",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetValidSpec()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetricsViewStateValidationError{
+				field:  "ValidSpec",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Streaming
+
+	if len(errors) > 0 {
+		return MetricsViewStateMultiError(errors)
+	}
+
+	return nil
+}
+
+// MetricsViewStateMultiError is an error wrapping multiple validation errors
+// returned by MetricsViewState.ValidateAll() if the designated constraints
+// aren't met.
+type MetricsViewStateMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MetricsViewStateMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MetricsViewStateMultiError) AllErrors() []error { return m }
+
+// MetricsViewStateValidationError is the validation error returned by
+// MetricsViewState.Validate if the designated constraints aren't met.
+type MetricsViewStateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MetricsViewStateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MetricsViewStateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MetricsViewStateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MetricsViewStateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MetricsViewStateValidationError) ErrorName() string { return "MetricsViewStateValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MetricsViewStateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMetricsViewState.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MetricsViewStateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MetricsViewStateValidationError{}
+
+// Validate checks the field values on Migration with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Migration) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Migration with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MigrationMultiError, or nil
 // if none found.
 func (m *Migration) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Migration) validate(all bool) error {
+func (m *Migration) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSpec()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MigrationValidationError{
+					field:  "Spec",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MigrationValidationError{
+					field:  "Spec",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSpec()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MigrationValidationError{
+				field:  "Spec",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetState()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MigrationValidationError{
+					field:  "State",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MigrationValidationError{
+					field:  "State",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetState()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MigrationValidationError{
+				field:  "State",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return MigrationMultiError(errors)
+	}
+
+	return nil
+}
+
+// MigrationMultiError is an error wrapping multiple validation errors returned
+// by Migration.ValidateAll() if the designated constraints aren't met.
+type MigrationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MigrationMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MigrationMultiError) AllErrors() []error { return m }
+
+// MigrationValidationError is the validation error returned by
+// Migration.Validate if the designated constraints aren't met.
+type MigrationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MigrationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MigrationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MigrationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MigrationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MigrationValidationError) ErrorName() string { return "MigrationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MigrationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMigration.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MigrationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MigrationValidationError{}
+
+// Validate checks the field values on MigrationSpec with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MigrationSpec) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MigrationSpec with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MigrationSpecMultiError, or
+// nil if none found.
+func (m *MigrationSpec) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MigrationSpec) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Connector
+
+	// no validation rules for Sql
+
+	// no validation rules for Version
+
+	if len(errors) > 0 {
+		return MigrationSpecMultiError(errors)
+	}
+
+	return nil
+}
+
+// MigrationSpecMultiError is an error wrapping multiple validation errors
+// returned by MigrationSpec.ValidateAll() if the designated constraints
+// aren't met.
+type MigrationSpecMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MigrationSpecMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MigrationSpecMultiError) AllErrors() []error { return m }
+
+// MigrationSpecValidationError is the validation error returned by
+// MigrationSpec.Validate if the designated constraints aren't met.
+type MigrationSpecValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MigrationSpecValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MigrationSpecValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MigrationSpecValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MigrationSpecValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MigrationSpecValidationError) ErrorName() string { return "MigrationSpecValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MigrationSpecValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMigrationSpec.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MigrationSpecValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MigrationSpecValidationError{}
+
+// Validate checks the field values on MigrationState with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MigrationState) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MigrationState with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MigrationStateMultiError,
+// or nil if none found.
+func (m *MigrationState) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MigrationState) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Version
+
+	if len(errors) > 0 {
+		return MigrationStateMultiError(errors)
+	}
+
+	return nil
+}
+
+// MigrationStateMultiError is an error wrapping multiple validation errors
+// returned by MigrationState.ValidateAll() if the designated constraints
+// aren't met.
+type MigrationStateMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MigrationStateMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MigrationStateMultiError) AllErrors() []error { return m }
+
+// MigrationStateValidationError is the validation error returned by
+// MigrationState.Validate if the designated constraints aren't met.
+type MigrationStateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MigrationStateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MigrationStateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MigrationStateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MigrationStateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MigrationStateValidationError) ErrorName() string { return "MigrationStateValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MigrationStateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMigrationState.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MigrationStateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MigrationStateValidationError{}
+
+// Validate checks the field values on Report with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Report) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Report with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ReportMultiError, or nil if none found.
+func (m *Report) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Report) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -3278,7 +4014,7 @@ func (m *Migration) validate(all bool) error {
 		switch v := interface{}(m.GetSpec()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MigrationValidationError{
+				errors = append(errors, ReportValidationError{
 					field:  "Spec",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -3286,7 +4022,7 @@ func (m *Migration) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, MigrationValidationError{
+				errors = append(errors, ReportValidationError{
 					field:  "Spec",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -3295,7 +4031,7 @@ func (m *Migration) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetSpec()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return MigrationValidationError{
+			return ReportValidationError{
 				field:  "Spec",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -3307,7 +4043,7 @@ func (m *Migration) validate(all bool) error {
 		switch v := interface{}(m.GetState()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MigrationValidationError{
+				errors = append(errors, ReportValidationError{
 					field:  "State",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -3315,7 +4051,7 @@ func (m *Migration) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, MigrationValidationError{
+				errors = append(errors, ReportValidationError{
 					field:  "State",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -3324,7 +4060,7 @@ func (m *Migration) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetState()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return MigrationValidationError{
+			return ReportValidationError{
 				field:  "State",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -3333,18 +4069,18 @@ func (m *Migration) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return MigrationMultiError(errors)
+		return ReportMultiError(errors)
 	}
 
 	return nil
 }
 
-// MigrationMultiError is an error wrapping multiple validation errors returned
-// by Migration.ValidateAll() if the designated constraints aren't met.
-type MigrationMultiError []error
+// ReportMultiError is an error wrapping multiple validation errors returned by
+// Report.ValidateAll() if the designated constraints aren't met.
+type ReportMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MigrationMultiError) Error() string {
+func (m ReportMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3353,11 +4089,11 @@ func (m MigrationMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MigrationMultiError) AllErrors() []error { return m }
+func (m ReportMultiError) AllErrors() []error { return m }
 
-// MigrationValidationError is the validation error returned by
-// Migration.Validate if the designated constraints aren't met.
-type MigrationValidationError struct {
+// ReportValidationError is the validation error returned by Report.Validate if
+// the designated constraints aren't met.
+type ReportValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3365,22 +4101,22 @@ type MigrationValidationError struct {
 }
 
 // Field function returns field value.
-func (e MigrationValidationError) Field() string { return e.field }
+func (e ReportValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MigrationValidationError) Reason() string { return e.reason }
+func (e ReportValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MigrationValidationError) Cause() error { return e.cause }
+func (e ReportValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MigrationValidationError) Key() bool { return e.key }
+func (e ReportValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MigrationValidationError) ErrorName() string { return "MigrationValidationError" }
+func (e ReportValidationError) ErrorName() string { return "ReportValidationError" }
 
 // Error satisfies the builtin error interface
-func (e MigrationValidationError) Error() string {
+func (e ReportValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3392,14 +4128,14 @@ func (e MigrationValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMigration.%s: %s%s",
+		"invalid %sReport.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MigrationValidationError{}
+var _ error = ReportValidationError{}
 
 var _ interface {
 	Field() string
@@ -3407,152 +4143,188 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MigrationValidationError{}
+} = ReportValidationError{}
 
-// Validate checks the field values on MigrationSpec with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on ReportSpec with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *MigrationSpec) Validate() error {
+func (m *ReportSpec) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MigrationSpec with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in MigrationSpecMultiError, or
+// ValidateAll checks the field values on ReportSpec with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ReportSpecMultiError, or
 // nil if none found.
-func (m *MigrationSpec) ValidateAll() error {
+func (m *ReportSpec) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MigrationSpec) validate(all bool) error {
+func (m *ReportSpec) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Connector
-
-	// no validation rules for Sql
-
-	// no validation rules for Version
-
-	if len(errors) > 0 {
-		return MigrationSpecMultiError(errors)
-	}
-
-	return nil
-}
+	// no validation rules for Trigger
 
-// MigrationSpecMultiError is an error wrapping multiple validation errors
-// returned by MigrationSpec.ValidateAll() if the designated constraints
-// aren't met.
-type MigrationSpecMultiError []error
+	// no validation rules for Title
 
-// Error returns a concatenation of all the error messages it wraps.
-func (m MigrationSpecMultiError) Error() string {
-	var msgs []string
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
+	if all {
+		switch v := interface{}(m.GetRefreshSchedule()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReportSpecValidationError{
+					field:  "RefreshSchedule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReportSpecValidationError{
+					field:  "RefreshSchedule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRefreshSchedule()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReportSpecValidationError{
+				field:  "RefreshSchedule",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
 	}
-	return strings.Join(msgs, "; ")
-}
 
-// AllErrors returns a list of validation violation errors.
-func (m MigrationSpecMultiError) AllErrors() []error { return m }
+	// no validation rules for TimeoutSeconds
 
-// MigrationSpecValidationError is the validation error returned by
-// MigrationSpec.Validate if the designated constraints aren't met.
-type MigrationSpecValidationError struct {
-	field  string
-	reason string
-	cause  error
-	key    bool
-}
+	// no validation rules for QueryName
 
-// Field function returns field value.
-func (e MigrationSpecValidationError) Field() string { return e.field }
+	// no validation rules for QueryArgsJson
 
-// Reason function returns reason value.
-func (e MigrationSpecValidationError) Reason() string { return e.reason }
+	// no validation rules for ExportLimit
 
-// Cause function returns cause value.
-func (e MigrationSpecValidationError) Cause() error { return e.cause }
+	// no validation rules for ExportFormat
 
-// Key function returns key value.
-func (e MigrationSpecValidationError) Key() bool { return e.key }
+	for idx, item := range m.GetNotifiers() {
+		_, _ = idx, item
 
-// ErrorName returns error name.
-func (e MigrationSpecValidationError) ErrorName() string { return "MigrationSpecValidationError" }
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReportSpecValidationError{
+						field:  fmt.Sprintf("Notifiers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReportSpecValidationError{
+						field:  fmt.Sprintf("Notifiers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReportSpecValidationError{
+					field:  fmt.Sprintf("Notifiers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-// Error satisfies the builtin error interface
-func (e MigrationSpecValidationError) Error() string {
-	cause := ""
-	if e.cause != nil {
-		cause = fmt.Sprintf(" | caused by: %v", e.cause)
 	}
 
-	key := ""
-	if e.key {
-		key = "key for "
-	}
+	// no validation rules for Annotations
 
-	return fmt.Sprintf(
-		"invalid %sMigrationSpec.%s: %s%s",
-		key,
-		e.field,
-		e.reason,
-		cause)
-}
+	// no validation rules for WatermarkInherit
 
-var _ error = MigrationSpecValidationError{}
+	// no validation rules for IntervalsIsoDuration
 
-var _ interface {
-	Field() string
-	Reason() string
-	Key() bool
-	Cause() error
-	ErrorName() string
-} = MigrationSpecValidationError{}
+	// no validation rules for IntervalsLimit
 
-// Validate checks the field values on MigrationState with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *MigrationState) Validate() error {
-	return m.validate(false)
-}
+	// no validation rules for IntervalsCheckUnclosed
 
-// ValidateAll checks the field values on MigrationState with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in MigrationStateMultiError,
-// or nil if none found.
-func (m *MigrationState) ValidateAll() error {
-	return m.validate(true)
-}
+	if all {
+		switch v := interface{}(m.GetBackfillFrom()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReportSpecValidationError{
+					field:  "BackfillFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReportSpecValidationError{
+					field:  "BackfillFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBackfillFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReportSpecValidationError{
+				field:  "BackfillFrom",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
-func (m *MigrationState) validate(all bool) error {
-	if m == nil {
-		return nil
+	if all {
+		switch v := interface{}(m.GetBackfillTo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReportSpecValidationError{
+					field:  "BackfillTo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReportSpecValidationError{
+					field:  "BackfillTo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBackfillTo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReportSpecValidationError{
+				field:  "BackfillTo",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
 	}
 
-	var errors []error
-
-	// no validation rules for Version
-
 	if len(errors) > 0 {
-		return MigrationStateMultiError(errors)
+		return ReportSpecMultiError(errors)
 	}
 
 	return nil
 }
 
-// MigrationStateMultiError is an error wrapping multiple validation errors
-// returned by MigrationState.ValidateAll() if the designated constraints
-// aren't met.
-type MigrationStateMultiError []error
+// ReportSpecMultiError is an error wrapping multiple validation errors
+// returned by ReportSpec.ValidateAll() if the designated constraints aren't met.
+type ReportSpecMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MigrationStateMultiError) Error() string {
+func (m ReportSpecMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3561,11 +4333,11 @@ func (m MigrationStateMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MigrationStateMultiError) AllErrors() []error { return m }
+func (m ReportSpecMultiError) AllErrors() []error { return m }
 
-// MigrationStateValidationError is the validation error returned by
-// MigrationState.Validate if the designated constraints aren't met.
-type MigrationStateValidationError struct {
+// ReportSpecValidationError is the validation error returned by
+// ReportSpec.Validate if the designated constraints aren't met.
+type ReportSpecValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3573,22 +4345,22 @@ type MigrationStateValidationError struct {
 }
 
 // Field function returns field value.
-func (e MigrationStateValidationError) Field() string { return e.field }
+func (e ReportSpecValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MigrationStateValidationError) Reason() string { return e.reason }
+func (e ReportSpecValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MigrationStateValidationError) Cause() error { return e.cause }
+func (e ReportSpecValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MigrationStateValidationError) Key() bool { return e.key }
+func (e ReportSpecValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MigrationStateValidationError) ErrorName() string { return "MigrationStateValidationError" }
+func (e ReportSpecValidationError) ErrorName() string { return "ReportSpecValidationError" }
 
 // Error satisfies the builtin error interface
-func (e MigrationStateValidationError) Error() string {
+func (e ReportSpecValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3600,14 +4372,14 @@ func (e MigrationStateValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMigrationState.%s: %s%s",
+		"invalid %sReportSpec.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MigrationStateValidationError{}
+var _ error = ReportSpecValidationError{}
 
 var _ interface {
 	Field() string
@@ -3615,23 +4387,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MigrationStateValidationError{}
+} = ReportSpecValidationError{}
 
-// Validate checks the field values on Report with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on ReportState with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *Report) Validate() error {
+func (m *ReportState) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Report with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in ReportMultiError, or nil if none found.
-func (m *Report) ValidateAll() error {
+// ValidateAll checks the field values on ReportState with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ReportStateMultiError, or
+// nil if none found.
+func (m *ReportState) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Report) validate(all bool) error {
+func (m *ReportState) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -3639,28 +4412,28 @@ func (m *Report) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetSpec()).(type) {
+		switch v := interface{}(m.GetNextRunOn()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ReportValidationError{
-					field:  "Spec",
+				errors = append(errors, ReportStateValidationError{
+					field:  "NextRunOn",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ReportValidationError{
-					field:  "Spec",
+				errors = append(errors, ReportStateValidationError{
+					field:  "NextRunOn",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetSpec()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetNextRunOn()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ReportValidationError{
-				field:  "Spec",
+			return ReportStateValidationError{
+				field:  "NextRunOn",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -3668,47 +4441,83 @@ func (m *Report) validate(all bool) error {
 	}
 
 	if all {
-		switch v := interface{}(m.GetState()).(type) {
+		switch v := interface{}(m.GetCurrentExecution()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ReportValidationError{
-					field:  "State",
+				errors = append(errors, ReportStateValidationError{
+					field:  "CurrentExecution",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ReportValidationError{
-					field:  "State",
+				errors = append(errors, ReportStateValidationError{
+					field:  "CurrentExecution",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetState()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetCurrentExecution()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ReportValidationError{
-				field:  "State",
+			return ReportStateValidationError{
+				field:  "CurrentExecution",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	for idx, item := range m.GetExecutionHistory() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReportStateValidationError{
+						field:  fmt.Sprintf("ExecutionHistory[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReportStateValidationError{
+						field:  fmt.Sprintf("ExecutionHistory[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReportStateValidationError{
+					field:  fmt.Sprintf("ExecutionHistory[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for ExecutionCount
+
 	if len(errors) > 0 {
-		return ReportMultiError(errors)
+		return ReportStateMultiError(errors)
 	}
 
 	return nil
 }
 
-// ReportMultiError is an error wrapping multiple validation errors returned by
-// Report.ValidateAll() if the designated constraints aren't met.
-type ReportMultiError []error
+// ReportStateMultiError is an error wrapping multiple validation errors
+// returned by ReportState.ValidateAll() if the designated constraints aren't met.
+type ReportStateMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ReportMultiError) Error() string {
+func (m ReportStateMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3717,11 +4526,11 @@ func (m ReportMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ReportMultiError) AllErrors() []error { return m }
+func (m ReportStateMultiError) AllErrors() []error { return m }
 
-// ReportValidationError is the validation error returned by Report.Validate if
-// the designated constraints aren't met.
-type ReportValidationError struct {
+// ReportStateValidationError is the validation error returned by
+// ReportState.Validate if the designated constraints aren't met.
+type ReportStateValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3729,22 +4538,22 @@ type ReportValidationError struct {
 }
 
 // Field function returns field value.
-func (e ReportValidationError) Field() string { return e.field }
+func (e ReportStateValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ReportValidationError) Reason() string { return e.reason }
+func (e ReportStateValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ReportValidationError) Cause() error { return e.cause }
+func (e ReportStateValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ReportValidationError) Key() bool { return e.key }
+func (e ReportStateValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ReportValidationError) ErrorName() string { return "ReportValidationError" }
+func (e ReportStateValidationError) ErrorName() string { return "ReportStateValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ReportValidationError) Error() string {
+func (e ReportStateValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3756,14 +4565,14 @@ func (e ReportValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sReport.%s: %s%s",
+		"invalid %sReportState.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ReportValidationError{}
+var _ error = ReportStateValidationError{}
 
 var _ interface {
 	Field() string
@@ -3771,130 +4580,137 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ReportValidationError{}
+} = ReportStateValidationError{}
 
-// Validate checks the field values on ReportSpec with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *ReportSpec) Validate() error {
+// Validate checks the field values on ReportExecution with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ReportExecution) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ReportSpec with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in ReportSpecMultiError, or
-// nil if none found.
-func (m *ReportSpec) ValidateAll() error {
+// ValidateAll checks the field values on ReportExecution with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReportExecutionMultiError, or nil if none found.
+func (m *ReportExecution) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ReportSpec) validate(all bool) error {
+func (m *ReportExecution) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Trigger
+	// no validation rules for Adhoc
 
-	// no validation rules for Title
+	// no validation rules for ErrorMessage
+
+	if all {
+		switch v := interface{}(m.GetReportTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReportExecutionValidationError{
+					field:  "ReportTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReportExecutionValidationError{
+					field:  "ReportTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReportTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReportExecutionValidationError{
+				field:  "ReportTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetStartedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReportExecutionValidationError{
+					field:  "StartedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReportExecutionValidationError{
+					field:  "StartedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReportExecutionValidationError{
+				field:  "StartedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if all {
-		switch v := interface{}(m.GetRefreshSchedule()).(type) {
+		switch v := interface{}(m.GetFinishedOn()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ReportSpecValidationError{
-					field:  "RefreshSchedule",
+				errors = append(errors, ReportExecutionValidationError{
+					field:  "FinishedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ReportSpecValidationError{
-					field:  "RefreshSchedule",
+				errors = append(errors, ReportExecutionValidationError{
+					field:  "FinishedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetRefreshSchedule()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetFinishedOn()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ReportSpecValidationError{
-				field:  "RefreshSchedule",
+			return ReportExecutionValidationError{
+				field:  "FinishedOn",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	// no validation rules for TimeoutSeconds
-
-	// no validation rules for QueryName
-
-	// no validation rules for QueryArgsJson
-
-	// no validation rules for ExportLimit
-
-	// no validation rules for ExportFormat
-
-	for idx, item := range m.GetNotifiers() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ReportSpecValidationError{
-						field:  fmt.Sprintf("Notifiers[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ReportSpecValidationError{
-						field:  fmt.Sprintf("Notifiers[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ReportSpecValidationError{
-					field:  fmt.Sprintf("Notifiers[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	// no validation rules for Annotations
-
-	// no validation rules for WatermarkInherit
-
-	// no validation rules for IntervalsIsoDuration
-
-	// no validation rules for IntervalsLimit
-
-	// no validation rules for IntervalsCheckUnclosed
+	// no validation rules for Backfill
 
 	if len(errors) > 0 {
-		return ReportSpecMultiError(errors)
+		return ReportExecutionMultiError(errors)
 	}
 
 	return nil
 }
 
-// ReportSpecMultiError is an error wrapping multiple validation errors
-// returned by ReportSpec.ValidateAll() if the designated constraints aren't met.
-type ReportSpecMultiError []error
+// ReportExecutionMultiError is an error wrapping multiple validation errors
+// returned by ReportExecution.ValidateAll() if the designated constraints
+// aren't met.
+type ReportExecutionMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ReportSpecMultiError) Error() string {
+func (m ReportExecutionMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3903,11 +4719,11 @@ func (m ReportSpecMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ReportSpecMultiError) AllErrors() []error { return m }
+func (m ReportExecutionMultiError) AllErrors() []error { return m }
 
-// ReportSpecValidationError is the validation error returned by
-// ReportSpec.Validate if the designated constraints aren't met.
-type ReportSpecValidationError struct {
+// ReportExecutionValidationError is the validation error returned by
+// ReportExecution.Validate if the designated constraints aren't met.
+type ReportExecutionValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3915,22 +4731,22 @@ type ReportSpecValidationError struct {
 }
 
 // Field function returns field value.
-func (e ReportSpecValidationError) Field() string { return e.field }
+func (e ReportExecutionValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ReportSpecValidationError) Reason() string { return e.reason }
+func (e ReportExecutionValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ReportSpecValidationError) Cause() error { return e.cause }
+func (e ReportExecutionValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ReportSpecValidationError) Key() bool { return e.key }
+func (e ReportExecutionValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ReportSpecValidationError) ErrorName() string { return "ReportSpecValidationError" }
+func (e ReportExecutionValidationError) ErrorName() string { return "ReportExecutionValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ReportSpecValidationError) Error() string {
+func (e ReportExecutionValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3942,14 +4758,14 @@ func (e ReportSpecValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sReportSpec.%s: %s%s",
+		"invalid %sReportExecution.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ReportSpecValidationError{}
+var _ error = ReportExecutionValidationError{}
 
 var _ interface {
 	Field() string
@@ -3957,24 +4773,23 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ReportSpecValidationError{}
+} = ReportExecutionValidationError{}
 
-// Validate checks the field values on ReportState with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on Alert with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *ReportState) Validate() error {
+func (m *Alert) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ReportState with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in ReportStateMultiError, or
-// nil if none found.
-func (m *ReportState) ValidateAll() error {
+// ValidateAll checks the field values on Alert with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in AlertMultiError, or nil if none found.
+func (m *Alert) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ReportState) validate(all bool) error {
+func (m *Alert) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -3982,28 +4797,28 @@ func (m *ReportState) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetNextRunOn()).(type) {
+		switch v := interface{}(m.GetSpec()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ReportStateValidationError{
-					field:  "NextRunOn",
+				errors = append(errors, AlertValidationError{
+					field:  "Spec",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ReportStateValidationError{
-					field:  "NextRunOn",
+				errors = append(errors, AlertValidationError{
+					field:  "Spec",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetNextRunOn()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetSpec()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ReportStateValidationError{
-				field:  "NextRunOn",
+			return AlertValidationError{
+				field:  "Spec",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -4011,83 +4826,47 @@ func (m *ReportState) validate(all bool) error {
 	}
 
 	if all {
-		switch v := interface{}(m.GetCurrentExecution()).(type) {
+		switch v := interface{}(m.GetState()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ReportStateValidationError{
-					field:  "CurrentExecution",
+				errors = append(errors, AlertValidationError{
+					field:  "State",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ReportStateValidationError{
-					field:  "CurrentExecution",
+				errors = append(errors, AlertValidationError{
+					field:  "State",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetCurrentExecution()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetState()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ReportStateValidationError{
-				field:  "CurrentExecution",
+			return AlertValidationError{
+				field:  "State",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	for idx, item := range m.GetExecutionHistory() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ReportStateValidationError{
-						field:  fmt.Sprintf("ExecutionHistory[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ReportStateValidationError{
-						field:  fmt.Sprintf("ExecutionHistory[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ReportStateValidationError{
-					field:  fmt.Sprintf("ExecutionHistory[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	// no validation rules for ExecutionCount
-
 	if len(errors) > 0 {
-		return ReportStateMultiError(errors)
+		return AlertMultiError(errors)
 	}
 
 	return nil
 }
 
-// ReportStateMultiError is an error wrapping multiple validation errors
-// returned by ReportState.ValidateAll() if the designated constraints aren't met.
-type ReportStateMultiError []error
+// AlertMultiError is an error wrapping multiple validation errors returned by
+// Alert.ValidateAll() if the designated constraints aren't met.
+type AlertMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ReportStateMultiError) Error() string {
+func (m AlertMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4096,11 +4875,11 @@ func (m ReportStateMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ReportStateMultiError) AllErrors() []error { return m }
+func (m AlertMultiError) AllErrors() []error { return m }
 
-// ReportStateValidationError is the validation error returned by
-// ReportState.Validate if the designated constraints aren't met.
-type ReportStateValidationError struct {
+// AlertValidationError is the validation error returned by Alert.Validate if
+// the designated constraints aren't met.
+type AlertValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4108,22 +4887,22 @@ type ReportStateValidationError struct {
 }
 
 // Field function returns field value.
-func (e ReportStateValidationError) Field() string { return e.field }
+func (e AlertValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ReportStateValidationError) Reason() string { return e.reason }
+func (e AlertValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ReportStateValidationError) Cause() error { return e.cause }
+func (e AlertValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ReportStateValidationError) Key() bool { return e.key }
+func (e AlertValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ReportStateValidationError) ErrorName() string { return "ReportStateValidationError" }
+func (e AlertValidationError) ErrorName() string { return "AlertValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ReportStateValidationError) Error() string {
+func (e AlertValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4135,14 +4914,14 @@ func (e ReportStateValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sReportState.%s: %s%s",
+		"invalid %sAlert.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ReportStateValidationError{}
+var _ error = AlertValidationError{}
 
 var _ interface {
 	Field() string
@@ -4150,86 +4929,146 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ReportStateValidationError{}
+} = AlertValidationError{}
 
-// Validate checks the field values on ReportExecution with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *ReportExecution) Validate() error {
+// Validate checks the field values on AlertSpec with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AlertSpec) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ReportExecution with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ReportExecutionMultiError, or nil if none found.
-func (m *ReportExecution) ValidateAll() error {
+// ValidateAll checks the field values on AlertSpec with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AlertSpecMultiError, or nil
+// if none found.
+func (m *AlertSpec) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ReportExecution) validate(all bool) error {
+func (m *AlertSpec) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Adhoc
+	// no validation rules for Trigger
 
-	// no validation rules for ErrorMessage
+	// no validation rules for Title
 
 	if all {
-		switch v := interface{}(m.GetReportTime()).(type) {
+		switch v := interface{}(m.GetRefreshSchedule()).(type) {
 		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ReportExecutionValidationError{
-					field:  "ReportTime",
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AlertSpecValidationError{
+					field:  "RefreshSchedule",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ReportExecutionValidationError{
-					field:  "ReportTime",
+				errors = append(errors, AlertSpecValidationError{
+					field:  "RefreshSchedule",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetReportTime()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetRefreshSchedule()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ReportExecutionValidationError{
-				field:  "ReportTime",
+			return AlertSpecValidationError{
+				field:  "RefreshSchedule",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	// no validation rules for WatermarkInherit
+
+	// no validation rules for IntervalsIsoDuration
+
+	// no validation rules for IntervalsLimit
+
+	// no validation rules for IntervalsCheckUnclosed
+
+	// no validation rules for TimeoutSeconds
+
+	// no validation rules for QueryName
+
+	// no validation rules for QueryArgsJson
+
+	// no validation rules for NotifyOnRecover
+
+	// no validation rules for NotifyOnFail
+
+	// no validation rules for NotifyOnError
+
+	// no validation rules for Renotify
+
+	// no validation rules for RenotifyAfterSeconds
+
+	for idx, item := range m.GetNotifiers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AlertSpecValidationError{
+						field:  fmt.Sprintf("Notifiers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AlertSpecValidationError{
+						field:  fmt.Sprintf("Notifiers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AlertSpecValidationError{
+					field:  fmt.Sprintf("Notifiers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Annotations
+
 	if all {
-		switch v := interface{}(m.GetStartedOn()).(type) {
+		switch v := interface{}(m.GetBackfillFrom()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ReportExecutionValidationError{
-					field:  "StartedOn",
+				errors = append(errors, AlertSpecValidationError{
+					field:  "BackfillFrom",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ReportExecutionValidationError{
-					field:  "StartedOn",
+				errors = append(errors, AlertSpecValidationError{
+					field:  "BackfillFrom",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetStartedOn()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetBackfillFrom()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ReportExecutionValidationError{
-				field:  "StartedOn",
+			return AlertSpecValidationError{
+				field:  "BackfillFrom",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -4237,48 +5076,117 @@ func (m *ReportExecution) validate(all bool) error {
 	}
 
 	if all {
-		switch v := interface{}(m.GetFinishedOn()).(type) {
+		switch v := interface{}(m.GetBackfillTo()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ReportExecutionValidationError{
-					field:  "FinishedOn",
+				errors = append(errors, AlertSpecValidationError{
+					field:  "BackfillTo",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ReportExecutionValidationError{
-					field:  "FinishedOn",
+				errors = append(errors, AlertSpecValidationError{
+					field:  "BackfillTo",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetFinishedOn()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetBackfillTo()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ReportExecutionValidationError{
-				field:  "FinishedOn",
+			return AlertSpecValidationError{
+				field:  "BackfillTo",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	switch v := m.QueryFor.(type) {
+	case *AlertSpec_QueryForUserId:
+		if v == nil {
+			err := AlertSpecValidationError{
+				field:  "QueryFor",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for QueryForUserId
+	case *AlertSpec_QueryForUserEmail:
+		if v == nil {
+			err := AlertSpecValidationError{
+				field:  "QueryFor",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for QueryForUserEmail
+	case *AlertSpec_QueryForAttributes:
+		if v == nil {
+			err := AlertSpecValidationError{
+				field:  "QueryFor",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetQueryForAttributes()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AlertSpecValidationError{
+						field:  "QueryForAttributes",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AlertSpecValidationError{
+						field:  "QueryForAttributes",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetQueryForAttributes()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AlertSpecValidationError{
+					field:  "QueryForAttributes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
 	if len(errors) > 0 {
-		return ReportExecutionMultiError(errors)
+		return AlertSpecMultiError(errors)
 	}
 
 	return nil
 }
 
-// ReportExecutionMultiError is an error wrapping multiple validation errors
-// returned by ReportExecution.ValidateAll() if the designated constraints
-// aren't met.
-type ReportExecutionMultiError []error
+// AlertSpecMultiError is an error wrapping multiple validation errors returned
+// by AlertSpec.ValidateAll() if the designated constraints aren't met.
+type AlertSpecMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ReportExecutionMultiError) Error() string {
+func (m AlertSpecMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4287,11 +5195,11 @@ func (m ReportExecutionMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ReportExecutionMultiError) AllErrors() []error { return m }
+func (m AlertSpecMultiError) AllErrors() []error { return m }
 
-// ReportExecutionValidationError is the validation error returned by
-// ReportExecution.Validate if the designated constraints aren't met.
-type ReportExecutionValidationError struct {
+// AlertSpecValidationError is the validation error returned by
+// AlertSpec.Validate if the designated constraints aren't met.
+type AlertSpecValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4299,22 +5207,22 @@ type ReportExecutionValidationError struct {
 }
 
 // Field function returns field value.
-func (e ReportExecutionValidationError) Field() string { return e.field }
+func (e AlertSpecValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ReportExecutionValidationError) Reason() string { return e.reason }
+func (e AlertSpecValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ReportExecutionValidationError) Cause() error { return e.cause }
+func (e AlertSpecValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ReportExecutionValidationError) Key() bool { return e.key }
+func (e AlertSpecValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ReportExecutionValidationError) ErrorName() string { return "ReportExecutionValidationError" }
+func (e AlertSpecValidationError) ErrorName() string { return "AlertSpecValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ReportExecutionValidationError) Error() string {
+func (e AlertSpecValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4326,14 +5234,14 @@ func (e ReportExecutionValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sReportExecution.%s: %s%s",
+		"invalid %sAlertSpec.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ReportExecutionValidationError{}
+var _ error = AlertSpecValidationError{}
 
 var _ interface {
 	Field() string
@@ -4341,81 +5249,55 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ReportExecutionValidationError{}
+} = AlertSpecValidationError{}
 
-// Validate checks the field values on Alert with the rules defined in the
+// Validate checks the field values on Notifier with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *Alert) Validate() error {
+func (m *Notifier) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Alert with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in AlertMultiError, or nil if none found.
-func (m *Alert) ValidateAll() error {
+// ValidateAll checks the field values on Notifier with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in NotifierMultiError, or nil
+// if none found.
+func (m *Notifier) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Alert) validate(all bool) error {
+func (m *Notifier) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetSpec()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AlertValidationError{
-					field:  "Spec",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, AlertValidationError{
-					field:  "Spec",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSpec()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return AlertValidationError{
-				field:  "Spec",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Connector
 
 	if all {
-		switch v := interface{}(m.GetState()).(type) {
+		switch v := interface{}(m.GetProperties()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AlertValidationError{
-					field:  "State",
+				errors = append(errors, NotifierValidationError{
+					field:  "Properties",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, AlertValidationError{
-					field:  "State",
+				errors = append(errors, NotifierValidationError{
+					field:  "Properties",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetState()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetProperties()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return AlertValidationError{
-				field:  "State",
+			return NotifierValidationError{
+				field:  "Properties",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -4423,18 +5305,18 @@ func (m *Alert) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return AlertMultiError(errors)
+		return NotifierMultiError(errors)
 	}
 
 	return nil
 }
 
-// AlertMultiError is an error wrapping multiple validation errors returned by
-// Alert.ValidateAll() if the designated constraints aren't met.
-type AlertMultiError []error
+// NotifierMultiError is an error wrapping multiple validation errors returned
+// by Notifier.ValidateAll() if the designated constraints aren't met.
+type NotifierMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AlertMultiError) Error() string {
+func (m NotifierMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4443,11 +5325,11 @@ func (m AlertMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AlertMultiError) AllErrors() []error { return m }
+func (m NotifierMultiError) AllErrors() []error { return m }
 
-// AlertValidationError is the validation error returned by Alert.Validate if
-// the designated constraints aren't met.
-type AlertValidationError struct {
+// NotifierValidationError is the validation error returned by
+// Notifier.Validate if the designated constraints aren't met.
+type NotifierValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4455,22 +5337,22 @@ type AlertValidationError struct {
 }
 
 // Field function returns field value.
-func (e AlertValidationError) Field() string { return e.field }
+func (e NotifierValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AlertValidationError) Reason() string { return e.reason }
+func (e NotifierValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AlertValidationError) Cause() error { return e.cause }
+func (e NotifierValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AlertValidationError) Key() bool { return e.key }
+func (e NotifierValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AlertValidationError) ErrorName() string { return "AlertValidationError" }
+func (e NotifierValidationError) ErrorName() string { return "NotifierValidationError" }
 
 // Error satisfies the builtin error interface
-func (e AlertValidationError) Error() string {
+func (e NotifierValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4482,14 +5364,14 @@ func (e AlertValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAlert.%s: %s%s",
+		"invalid %sNotifier.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AlertValidationError{}
+var _ error = NotifierValidationError{}
 
 var _ interface {
 	Field() string
@@ -4497,206 +5379,141 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AlertValidationError{}
+} = NotifierValidationError{}
 
-// Validate checks the field values on AlertSpec with the rules defined in the
+// Validate checks the field values on AlertState with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *AlertSpec) Validate() error {
+func (m *AlertState) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AlertSpec with the rules defined in
+// ValidateAll checks the field values on AlertState with the rules defined in
 // the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in AlertSpecMultiError, or nil
-// if none found.
-func (m *AlertSpec) ValidateAll() error {
+// result is a list of violation errors wrapped in AlertStateMultiError, or
+// nil if none found.
+func (m *AlertState) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AlertSpec) validate(all bool) error {
+func (m *AlertState) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Trigger
+	// no validation rules for SpecHash
 
-	// no validation rules for Title
+	// no validation rules for RefsHash
 
 	if all {
-		switch v := interface{}(m.GetRefreshSchedule()).(type) {
+		switch v := interface{}(m.GetNextRunOn()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AlertSpecValidationError{
-					field:  "RefreshSchedule",
+				errors = append(errors, AlertStateValidationError{
+					field:  "NextRunOn",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, AlertSpecValidationError{
-					field:  "RefreshSchedule",
+				errors = append(errors, AlertStateValidationError{
+					field:  "NextRunOn",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetRefreshSchedule()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetNextRunOn()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return AlertSpecValidationError{
-				field:  "RefreshSchedule",
+			return AlertStateValidationError{
+				field:  "NextRunOn",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	// no validation rules for WatermarkInherit
-
-	// no validation rules for IntervalsIsoDuration
-
-	// no validation rules for IntervalsLimit
-
-	// no validation rules for IntervalsCheckUnclosed
-
-	// no validation rules for TimeoutSeconds
-
-	// no validation rules for QueryName
-
-	// no validation rules for QueryArgsJson
-
-	// no validation rules for NotifyOnRecover
-
-	// no validation rules for NotifyOnFail
-
-	// no validation rules for NotifyOnError
-
-	// no validation rules for Renotify
-
-	// no validation rules for RenotifyAfterSeconds
-
-	for idx, item := range m.GetNotifiers() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AlertSpecValidationError{
-						field:  fmt.Sprintf("Notifiers[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, AlertSpecValidationError{
-						field:  fmt.Sprintf("Notifiers[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetCurrentExecution()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AlertStateValidationError{
+					field:  "CurrentExecution",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return AlertSpecValidationError{
-					field:  fmt.Sprintf("Notifiers[%v]", idx),
+				errors = append(errors, AlertStateValidationError{
+					field:  "CurrentExecution",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	// no validation rules for Annotations
-
-	switch v := m.QueryFor.(type) {
-	case *AlertSpec_QueryForUserId:
-		if v == nil {
-			err := AlertSpecValidationError{
-				field:  "QueryFor",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-		// no validation rules for QueryForUserId
-	case *AlertSpec_QueryForUserEmail:
-		if v == nil {
-			err := AlertSpecValidationError{
-				field:  "QueryFor",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
+				})
 			}
-			errors = append(errors, err)
 		}
-		// no validation rules for QueryForUserEmail
-	case *AlertSpec_QueryForAttributes:
-		if v == nil {
-			err := AlertSpecValidationError{
-				field:  "QueryFor",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
+	} else if v, ok := interface{}(m.GetCurrentExecution()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AlertStateValidationError{
+				field:  "CurrentExecution",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
-			errors = append(errors, err)
 		}
+	}
+
+	for idx, item := range m.GetExecutionHistory() {
+		_, _ = idx, item
 
 		if all {
-			switch v := interface{}(m.GetQueryForAttributes()).(type) {
+			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AlertSpecValidationError{
-						field:  "QueryForAttributes",
+					errors = append(errors, AlertStateValidationError{
+						field:  fmt.Sprintf("ExecutionHistory[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, AlertSpecValidationError{
-						field:  "QueryForAttributes",
+					errors = append(errors, AlertStateValidationError{
+						field:  fmt.Sprintf("ExecutionHistory[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetQueryForAttributes()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return AlertSpecValidationError{
-					field:  "QueryForAttributes",
+				return AlertStateValidationError{
+					field:  fmt.Sprintf("ExecutionHistory[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	default:
-		_ = v // ensures v is used
 	}
 
+	// no validation rules for ExecutionCount
+
 	if len(errors) > 0 {
-		return AlertSpecMultiError(errors)
+		return AlertStateMultiError(errors)
 	}
 
 	return nil
 }
 
-// AlertSpecMultiError is an error wrapping multiple validation errors returned
-// by AlertSpec.ValidateAll() if the designated constraints aren't met.
-type AlertSpecMultiError []error
+// AlertStateMultiError is an error wrapping multiple validation errors
+// returned by AlertState.ValidateAll() if the designated constraints aren't met.
+type AlertStateMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AlertSpecMultiError) Error() string {
+func (m AlertStateMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4705,11 +5522,11 @@ func (m AlertSpecMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AlertSpecMultiError) AllErrors() []error { return m }
+func (m AlertStateMultiError) AllErrors() []error { return m }
 
-// AlertSpecValidationError is the validation error returned by
-// AlertSpec.Validate if the designated constraints aren't met.
-type AlertSpecValidationError struct {
+// AlertStateValidationError is the validation error returned by
+// AlertState.Validate if the designated constraints aren't met.
+type AlertStateValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4717,22 +5534,22 @@ type AlertSpecValidationError struct {
 }
 
 // Field function returns field value.
-func (e AlertSpecValidationError) Field() string { return e.field }
+func (e AlertStateValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AlertSpecValidationError) Reason() string { return e.reason }
+func (e AlertStateValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AlertSpecValidationError) Cause() error { return e.cause }
+func (e AlertStateValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AlertSpecValidationError) Key() bool { return e.key }
+func (e AlertStateValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AlertSpecValidationError) ErrorName() string { return "AlertSpecValidationError" }
+func (e AlertStateValidationError) ErrorName() string { return "AlertStateValidationError" }
 
 // Error satisfies the builtin error interface
-func (e AlertSpecValidationError) Error() string {
+func (e AlertStateValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4744,14 +5561,14 @@ func (e AlertSpecValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAlertSpec.%s: %s%s",
+		"invalid %sAlertState.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AlertSpecValidationError{}
+var _ error = AlertStateValidationError{}
 
 var _ interface {
 	Field() string
@@ -4759,74 +5576,166 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AlertSpecValidationError{}
+} = AlertStateValidationError{}
 
-// Validate checks the field values on Notifier with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on AlertExecution with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *Notifier) Validate() error {
+func (m *AlertExecution) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Notifier with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in NotifierMultiError, or nil
-// if none found.
-func (m *Notifier) ValidateAll() error {
+// ValidateAll checks the field values on AlertExecution with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AlertExecutionMultiError,
+// or nil if none found.
+func (m *AlertExecution) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Notifier) validate(all bool) error {
+func (m *AlertExecution) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Connector
+	// no validation rules for Adhoc
 
 	if all {
-		switch v := interface{}(m.GetProperties()).(type) {
+		switch v := interface{}(m.GetResult()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, NotifierValidationError{
-					field:  "Properties",
+				errors = append(errors, AlertExecutionValidationError{
+					field:  "Result",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, NotifierValidationError{
-					field:  "Properties",
+				errors = append(errors, AlertExecutionValidationError{
+					field:  "Result",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetProperties()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetResult()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return NotifierValidationError{
-				field:  "Properties",
+			return AlertExecutionValidationError{
+				field:  "Result",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for SentNotifications
+
+	if all {
+		switch v := interface{}(m.GetExecutionTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AlertExecutionValidationError{
+					field:  "ExecutionTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AlertExecutionValidationError{
+					field:  "ExecutionTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExecutionTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AlertExecutionValidationError{
+				field:  "ExecutionTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetStartedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AlertExecutionValidationError{
+					field:  "StartedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AlertExecutionValidationError{
+					field:  "StartedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AlertExecutionValidationError{
+				field:  "StartedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetFinishedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AlertExecutionValidationError{
+					field:  "FinishedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AlertExecutionValidationError{
+					field:  "FinishedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFinishedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AlertExecutionValidationError{
+				field:  "FinishedOn",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	// no validation rules for Backfill
+
 	if len(errors) > 0 {
-		return NotifierMultiError(errors)
+		return AlertExecutionMultiError(errors)
 	}
 
 	return nil
 }
 
-// NotifierMultiError is an error wrapping multiple validation errors returned
-// by Notifier.ValidateAll() if the designated constraints aren't met.
-type NotifierMultiError []error
+// AlertExecutionMultiError is an error wrapping multiple validation errors
+// returned by AlertExecution.ValidateAll() if the designated constraints
+// aren't met.
+type AlertExecutionMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m NotifierMultiError) Error() string {
+func (m AlertExecutionMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4835,11 +5744,11 @@ func (m NotifierMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m NotifierMultiError) AllErrors() []error { return m }
+func (m AlertExecutionMultiError) AllErrors() []error { return m }
 
-// NotifierValidationError is the validation error returned by
-// Notifier.Validate if the designated constraints aren't met.
-type NotifierValidationError struct {
+// AlertExecutionValidationError is the validation error returned by
+// AlertExecution.Validate if the designated constraints aren't met.
+type AlertExecutionValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4847,22 +5756,22 @@ type NotifierValidationError struct {
 }
 
 // Field function returns field value.
-func (e NotifierValidationError) Field() string { return e.field }
+func (e AlertExecutionValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e NotifierValidationError) Reason() string { return e.reason }
+func (e AlertExecutionValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e NotifierValidationError) Cause() error { return e.cause }
+func (e AlertExecutionValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e NotifierValidationError) Key() bool { return e.key }
+func (e AlertExecutionValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e NotifierValidationError) ErrorName() string { return "NotifierValidationError" }
+func (e AlertExecutionValidationError) ErrorName() string { return "AlertExecutionValidationError" }
 
 // Error satisfies the builtin error interface
-func (e NotifierValidationError) Error() string {
+func (e AlertExecutionValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4874,14 +5783,14 @@ func (e NotifierValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sNotifier.%s: %s%s",
+		"invalid %sAlertExecution.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = NotifierValidationError{}
+var _ error = AlertExecutionValidationError{}
 
 var _ interface {
 	Field() string
@@ -4889,141 +5798,77 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = NotifierValidationError{}
+} = AlertExecutionValidationError{}
 
-// Validate checks the field values on AlertState with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *AlertState) Validate() error {
+// Validate checks the field values on AssertionResult with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AssertionResult) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AlertState with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in AlertStateMultiError, or
-// nil if none found.
-func (m *AlertState) ValidateAll() error {
+// ValidateAll checks the field values on AssertionResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AssertionResultMultiError, or nil if none found.
+func (m *AssertionResult) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AlertState) validate(all bool) error {
+func (m *AssertionResult) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for SpecHash
-
-	// no validation rules for RefsHash
-
-	if all {
-		switch v := interface{}(m.GetNextRunOn()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AlertStateValidationError{
-					field:  "NextRunOn",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, AlertStateValidationError{
-					field:  "NextRunOn",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetNextRunOn()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return AlertStateValidationError{
-				field:  "NextRunOn",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Status
 
 	if all {
-		switch v := interface{}(m.GetCurrentExecution()).(type) {
+		switch v := interface{}(m.GetFailRow()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AlertStateValidationError{
-					field:  "CurrentExecution",
+				errors = append(errors, AssertionResultValidationError{
+					field:  "FailRow",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, AlertStateValidationError{
-					field:  "CurrentExecution",
+				errors = append(errors, AssertionResultValidationError{
+					field:  "FailRow",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetCurrentExecution()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetFailRow()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return AlertStateValidationError{
-				field:  "CurrentExecution",
+			return AssertionResultValidationError{
+				field:  "FailRow",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	for idx, item := range m.GetExecutionHistory() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AlertStateValidationError{
-						field:  fmt.Sprintf("ExecutionHistory[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, AlertStateValidationError{
-						field:  fmt.Sprintf("ExecutionHistory[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return AlertStateValidationError{
-					field:  fmt.Sprintf("ExecutionHistory[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	// no validation rules for ExecutionCount
+	// no validation rules for ErrorMessage
 
 	if len(errors) > 0 {
-		return AlertStateMultiError(errors)
+		return AssertionResultMultiError(errors)
 	}
 
 	return nil
 }
 
-// AlertStateMultiError is an error wrapping multiple validation errors
-// returned by AlertState.ValidateAll() if the designated constraints aren't met.
-type AlertStateMultiError []error
+// AssertionResultMultiError is an error wrapping multiple validation errors
+// returned by AssertionResult.ValidateAll() if the designated constraints
+// aren't met.
+type AssertionResultMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AlertStateMultiError) Error() string {
+func (m AssertionResultMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5032,11 +5877,11 @@ func (m AlertStateMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AlertStateMultiError) AllErrors() []error { return m }
+func (m AssertionResultMultiError) AllErrors() []error { return m }
 
-// AlertStateValidationError is the validation error returned by
-// AlertState.Validate if the designated constraints aren't met.
-type AlertStateValidationError struct {
+// AssertionResultValidationError is the validation error returned by
+// AssertionResult.Validate if the designated constraints aren't met.
+type AssertionResultValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5044,22 +5889,22 @@ type AlertStateValidationError struct {
 }
 
 // Field function returns field value.
-func (e AlertStateValidationError) Field() string { return e.field }
+func (e AssertionResultValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AlertStateValidationError) Reason() string { return e.reason }
+func (e AssertionResultValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AlertStateValidationError) Cause() error { return e.cause }
+func (e AssertionResultValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AlertStateValidationError) Key() bool { return e.key }
+func (e AssertionResultValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AlertStateValidationError) ErrorName() string { return "AlertStateValidationError" }
+func (e AssertionResultValidationError) ErrorName() string { return "AssertionResultValidationError" }
 
 // Error satisfies the builtin error interface
-func (e AlertStateValidationError) Error() string {
+func (e AssertionResultValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5071,14 +5916,14 @@ func (e AlertStateValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAlertState.%s: %s%s",
+		"invalid %sAssertionResult.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AlertStateValidationError{}
+var _ error = AssertionResultValidationError{}
 
 var _ interface {
 	Field() string
@@ -5086,164 +5931,201 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AlertStateValidationError{}
+} = AssertionResultValidationError{}
 
-// Validate checks the field values on AlertExecution with the rules defined in
+// Validate checks the field values on PullTrigger with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *AlertExecution) Validate() error {
+func (m *PullTrigger) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AlertExecution with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in AlertExecutionMultiError,
-// or nil if none found.
-func (m *AlertExecution) ValidateAll() error {
+// ValidateAll checks the field values on PullTrigger with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PullTriggerMultiError, or
+// nil if none found.
+func (m *PullTrigger) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AlertExecution) validate(all bool) error {
+func (m *PullTrigger) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Adhoc
-
 	if all {
-		switch v := interface{}(m.GetResult()).(type) {
+		switch v := interface{}(m.GetSpec()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AlertExecutionValidationError{
-					field:  "Result",
+				errors = append(errors, PullTriggerValidationError{
+					field:  "Spec",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, AlertExecutionValidationError{
-					field:  "Result",
+				errors = append(errors, PullTriggerValidationError{
+					field:  "Spec",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetResult()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetSpec()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return AlertExecutionValidationError{
-				field:  "Result",
+			return PullTriggerValidationError{
+				field:  "Spec",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	// no validation rules for SentNotifications
-
 	if all {
-		switch v := interface{}(m.GetExecutionTime()).(type) {
+		switch v := interface{}(m.GetState()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AlertExecutionValidationError{
-					field:  "ExecutionTime",
+				errors = append(errors, PullTriggerValidationError{
+					field:  "State",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, AlertExecutionValidationError{
-					field:  "ExecutionTime",
+				errors = append(errors, PullTriggerValidationError{
+					field:  "State",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetExecutionTime()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetState()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return AlertExecutionValidationError{
-				field:  "ExecutionTime",
+			return PullTriggerValidationError{
+				field:  "State",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	if all {
-		switch v := interface{}(m.GetStartedOn()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AlertExecutionValidationError{
-					field:  "StartedOn",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, AlertExecutionValidationError{
-					field:  "StartedOn",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetStartedOn()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return AlertExecutionValidationError{
-				field:  "StartedOn",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+	if len(errors) > 0 {
+		return PullTriggerMultiError(errors)
 	}
 
-	if all {
-		switch v := interface{}(m.GetFinishedOn()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AlertExecutionValidationError{
-					field:  "FinishedOn",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, AlertExecutionValidationError{
-					field:  "FinishedOn",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetFinishedOn()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return AlertExecutionValidationError{
-				field:  "FinishedOn",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+	return nil
+}
+
+// PullTriggerMultiError is an error wrapping multiple validation errors
+// returned by PullTrigger.ValidateAll() if the designated constraints aren't met.
+type PullTriggerMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PullTriggerMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PullTriggerMultiError) AllErrors() []error { return m }
+
+// PullTriggerValidationError is the validation error returned by
+// PullTrigger.Validate if the designated constraints aren't met.
+type PullTriggerValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PullTriggerValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PullTriggerValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PullTriggerValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PullTriggerValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PullTriggerValidationError) ErrorName() string { return "PullTriggerValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PullTriggerValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
 	}
 
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPullTrigger.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PullTriggerValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PullTriggerValidationError{}
+
+// Validate checks the field values on PullTriggerSpec with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PullTriggerSpec) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PullTriggerSpec with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PullTriggerSpecMultiError, or nil if none found.
+func (m *PullTriggerSpec) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PullTriggerSpec) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
 	if len(errors) > 0 {
-		return AlertExecutionMultiError(errors)
+		return PullTriggerSpecMultiError(errors)
 	}
 
 	return nil
 }
 
-// AlertExecutionMultiError is an error wrapping multiple validation errors
-// returned by AlertExecution.ValidateAll() if the designated constraints
+// PullTriggerSpecMultiError is an error wrapping multiple validation errors
+// returned by PullTriggerSpec.ValidateAll() if the designated constraints
 // aren't met.
-type AlertExecutionMultiError []error
+type PullTriggerSpecMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AlertExecutionMultiError) Error() string {
+func (m PullTriggerSpecMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5252,11 +6134,11 @@ func (m AlertExecutionMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AlertExecutionMultiError) AllErrors() []error { return m }
+func (m PullTriggerSpecMultiError) AllErrors() []error { return m }
 
-// AlertExecutionValidationError is the validation error returned by
-// AlertExecution.Validate if the designated constraints aren't met.
-type AlertExecutionValidationError struct {
+// PullTriggerSpecValidationError is the validation error returned by
+// PullTriggerSpec.Validate if the designated constraints aren't met.
+type PullTriggerSpecValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5264,22 +6146,22 @@ type AlertExecutionValidationError struct {
 }
 
 // Field function returns field value.
-func (e AlertExecutionValidationError) Field() string { return e.field }
+func (e PullTriggerSpecValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AlertExecutionValidationError) Reason() string { return e.reason }
+func (e PullTriggerSpecValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AlertExecutionValidationError) Cause() error { return e.cause }
+func (e PullTriggerSpecValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AlertExecutionValidationError) Key() bool { return e.key }
+func (e PullTriggerSpecValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AlertExecutionValidationError) ErrorName() string { return "AlertExecutionValidationError" }
+func (e PullTriggerSpecValidationError) ErrorName() string { return "PullTriggerSpecValidationError" }
 
 // Error satisfies the builtin error interface
-func (e AlertExecutionValidationError) Error() string {
+func (e PullTriggerSpecValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5291,14 +6173,14 @@ func (e AlertExecutionValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAlertExecution.%s: %s%s",
+		"invalid %sPullTriggerSpec.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AlertExecutionValidationError{}
+var _ error = PullTriggerSpecValidationError{}
 
 var _ interface {
 	Field() string
@@ -5306,77 +6188,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AlertExecutionValidationError{}
+} = PullTriggerSpecValidationError{}
 
-// Validate checks the field values on AssertionResult with the rules defined
+// Validate checks the field values on PullTriggerState with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *AssertionResult) Validate() error {
+func (m *PullTriggerState) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AssertionResult with the rules
+// ValidateAll checks the field values on PullTriggerState with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AssertionResultMultiError, or nil if none found.
-func (m *AssertionResult) ValidateAll() error {
+// PullTriggerStateMultiError, or nil if none found.
+func (m *PullTriggerState) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AssertionResult) validate(all bool) error {
+func (m *PullTriggerState) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Status
-
-	if all {
-		switch v := interface{}(m.GetFailRow()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AssertionResultValidationError{
-					field:  "FailRow",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, AssertionResultValidationError{
-					field:  "FailRow",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetFailRow()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return AssertionResultValidationError{
-				field:  "FailRow",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	// no validation rules for ErrorMessage
-
 	if len(errors) > 0 {
-		return AssertionResultMultiError(errors)
+		return PullTriggerStateMultiError(errors)
 	}
 
 	return nil
 }
 
-// AssertionResultMultiError is an error wrapping multiple validation errors
-// returned by AssertionResult.ValidateAll() if the designated constraints
+// PullTriggerStateMultiError is an error wrapping multiple validation errors
+// returned by PullTriggerState.ValidateAll() if the designated constraints
 // aren't met.
-type AssertionResultMultiError []error
+type PullTriggerStateMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AssertionResultMultiError) Error() string {
+func (m PullTriggerStateMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5385,11 +6234,11 @@ func (m AssertionResultMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AssertionResultMultiError) AllErrors() []error { return m }
+func (m PullTriggerStateMultiError) AllErrors() []error { return m }
 
-// AssertionResultValidationError is the validation error returned by
-// AssertionResult.Validate if the designated constraints aren't met.
-type AssertionResultValidationError struct {
+// PullTriggerStateValidationError is the validation error returned by
+// PullTriggerState.Validate if the designated constraints aren't met.
+type PullTriggerStateValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5397,22 +6246,22 @@ type AssertionResultValidationError struct {
 }
 
 // Field function returns field value.
-func (e AssertionResultValidationError) Field() string { return e.field }
+func (e PullTriggerStateValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AssertionResultValidationError) Reason() string { return e.reason }
+func (e PullTriggerStateValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AssertionResultValidationError) Cause() error { return e.cause }
+func (e PullTriggerStateValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AssertionResultValidationError) Key() bool { return e.key }
+func (e PullTriggerStateValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AssertionResultValidationError) ErrorName() string { return "AssertionResultValidationError" }
+func (e PullTriggerStateValidationError) ErrorName() string { return "PullTriggerStateValidationError" }
 
 // Error satisfies the builtin error interface
-func (e AssertionResultValidationError) Error() string {
+func (e PullTriggerStateValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5424,14 +6273,14 @@ func (e AssertionResultValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAssertionResult.%s: %s%s",
+		"invalid %sPullTriggerState.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AssertionResultValidationError{}
+var _ error = PullTriggerStateValidationError{}
 
 var _ interface {
 	Field() string
@@ -5439,24 +6288,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AssertionResultValidationError{}
+} = PullTriggerStateValidationError{}
 
-// Validate checks the field values on PullTrigger with the rules defined in
+// Validate checks the field values on RefreshTrigger with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *PullTrigger) Validate() error {
+func (m *RefreshTrigger) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PullTrigger with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in PullTriggerMultiError, or
-// nil if none found.
-func (m *PullTrigger) ValidateAll() error {
+// ValidateAll checks the field values on RefreshTrigger with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RefreshTriggerMultiError,
+// or nil if none found.
+func (m *RefreshTrigger) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PullTrigger) validate(all bool) error {
+func (m *RefreshTrigger) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -5467,7 +6316,7 @@ func (m *PullTrigger) validate(all bool) error {
 		switch v := interface{}(m.GetSpec()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, PullTriggerValidationError{
+				errors = append(errors, RefreshTriggerValidationError{
 					field:  "Spec",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -5475,7 +6324,7 @@ func (m *PullTrigger) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, PullTriggerValidationError{
+				errors = append(errors, RefreshTriggerValidationError{
 					field:  "Spec",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -5484,7 +6333,7 @@ func (m *PullTrigger) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetSpec()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return PullTriggerValidationError{
+			return RefreshTriggerValidationError{
 				field:  "Spec",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -5496,7 +6345,7 @@ func (m *PullTrigger) validate(all bool) error {
 		switch v := interface{}(m.GetState()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, PullTriggerValidationError{
+				errors = append(errors, RefreshTriggerValidationError{
 					field:  "State",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -5504,7 +6353,7 @@ func (m *PullTrigger) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, PullTriggerValidationError{
+				errors = append(errors, RefreshTriggerValidationError{
 					field:  "State",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -5513,7 +6362,7 @@ func (m *PullTrigger) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetState()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return PullTriggerValidationError{
+			return RefreshTriggerValidationError{
 				field:  "State",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -5522,18 +6371,19 @@ func (m *PullTrigger) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return PullTriggerMultiError(errors)
+		return RefreshTriggerMultiError(errors)
 	}
 
 	return nil
 }
 
-// PullTriggerMultiError is an error wrapping multiple validation errors
-// returned by PullTrigger.ValidateAll() if the designated constraints aren't met.
-type PullTriggerMultiError []error
+// RefreshTriggerMultiError is an error wrapping multiple validation errors
+// returned by RefreshTrigger.ValidateAll() if the designated constraints
+// aren't met.
+type RefreshTriggerMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PullTriggerMultiError) Error() string {
+func (m RefreshTriggerMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5542,11 +6392,11 @@ func (m PullTriggerMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PullTriggerMultiError) AllErrors() []error { return m }
+func (m RefreshTriggerMultiError) AllErrors() []error { return m }
 
-// PullTriggerValidationError is the validation error returned by
-// PullTrigger.Validate if the designated constraints aren't met.
-type PullTriggerValidationError struct {
+// RefreshTriggerValidationError is the validation error returned by
+// RefreshTrigger.Validate if the designated constraints aren't met.
+type RefreshTriggerValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5554,22 +6404,22 @@ type PullTriggerValidationError struct {
 }
 
 // Field function returns field value.
-func (e PullTriggerValidationError) Field() string { return e.field }
+func (e RefreshTriggerValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PullTriggerValidationError) Reason() string { return e.reason }
+func (e RefreshTriggerValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PullTriggerValidationError) Cause() error { return e.cause }
+func (e RefreshTriggerValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PullTriggerValidationError) Key() bool { return e.key }
+func (e RefreshTriggerValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PullTriggerValidationError) ErrorName() string { return "PullTriggerValidationError" }
+func (e RefreshTriggerValidationError) ErrorName() string { return "RefreshTriggerValidationError" }
 
 // Error satisfies the builtin error interface
-func (e PullTriggerValidationError) Error() string {
+func (e RefreshTriggerValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5581,14 +6431,14 @@ func (e PullTriggerValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPullTrigger.%s: %s%s",
+		"invalid %sRefreshTrigger.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PullTriggerValidationError{}
+var _ error = RefreshTriggerValidationError{}
 
 var _ interface {
 	Field() string
@@ -5596,44 +6446,146 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PullTriggerValidationError{}
+} = RefreshTriggerValidationError{}
 
-// Validate checks the field values on PullTriggerSpec with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *PullTriggerSpec) Validate() error {
+// Validate checks the field values on RefreshTriggerSpec with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RefreshTriggerSpec) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PullTriggerSpec with the rules
+// ValidateAll checks the field values on RefreshTriggerSpec with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// PullTriggerSpecMultiError, or nil if none found.
-func (m *PullTriggerSpec) ValidateAll() error {
+// RefreshTriggerSpecMultiError, or nil if none found.
+func (m *RefreshTriggerSpec) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PullTriggerSpec) validate(all bool) error {
-	if m == nil {
-		return nil
+func (m *RefreshTriggerSpec) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetOnlyNames() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RefreshTriggerSpecValidationError{
+						field:  fmt.Sprintf("OnlyNames[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RefreshTriggerSpecValidationError{
+						field:  fmt.Sprintf("OnlyNames[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RefreshTriggerSpecValidationError{
+					field:  fmt.Sprintf("OnlyNames[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetModels() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RefreshTriggerSpecValidationError{
+						field:  fmt.Sprintf("Models[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RefreshTriggerSpecValidationError{
+						field:  fmt.Sprintf("Models[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RefreshTriggerSpecValidationError{
+					field:  fmt.Sprintf("Models[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetBackfills() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RefreshTriggerSpecValidationError{
+						field:  fmt.Sprintf("Backfills[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RefreshTriggerSpecValidationError{
+						field:  fmt.Sprintf("Backfills[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RefreshTriggerSpecValidationError{
+					field:  fmt.Sprintf("Backfills[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
 	}
 
-	var errors []error
-
 	if len(errors) > 0 {
-		return PullTriggerSpecMultiError(errors)
+		return RefreshTriggerSpecMultiError(errors)
 	}
 
 	return nil
 }
 
-// PullTriggerSpecMultiError is an error wrapping multiple validation errors
-// returned by PullTriggerSpec.ValidateAll() if the designated constraints
+// RefreshTriggerSpecMultiError is an error wrapping multiple validation errors
+// returned by RefreshTriggerSpec.ValidateAll() if the designated constraints
 // aren't met.
-type PullTriggerSpecMultiError []error
+type RefreshTriggerSpecMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PullTriggerSpecMultiError) Error() string {
+func (m RefreshTriggerSpecMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5642,11 +6594,11 @@ func (m PullTriggerSpecMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PullTriggerSpecMultiError) AllErrors() []error { return m }
+func (m RefreshTriggerSpecMultiError) AllErrors() []error { return m }
 
-// PullTriggerSpecValidationError is the validation error returned by
-// PullTriggerSpec.Validate if the designated constraints aren't met.
-type PullTriggerSpecValidationError struct {
+// RefreshTriggerSpecValidationError is the validation error returned by
+// RefreshTriggerSpec.Validate if the designated constraints aren't met.
+type RefreshTriggerSpecValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5654,22 +6606,24 @@ type PullTriggerSpecValidationError struct {
 }
 
 // Field function returns field value.
-func (e PullTriggerSpecValidationError) Field() string { return e.field }
+func (e RefreshTriggerSpecValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PullTriggerSpecValidationError) Reason() string { return e.reason }
+func (e RefreshTriggerSpecValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PullTriggerSpecValidationError) Cause() error { return e.cause }
+func (e RefreshTriggerSpecValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PullTriggerSpecValidationError) Key() bool { return e.key }
+func (e RefreshTriggerSpecValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PullTriggerSpecValidationError) ErrorName() string { return "PullTriggerSpecValidationError" }
+func (e RefreshTriggerSpecValidationError) ErrorName() string {
+	return "RefreshTriggerSpecValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e PullTriggerSpecValidationError) Error() string {
+func (e RefreshTriggerSpecValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5681,14 +6635,14 @@ func (e PullTriggerSpecValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPullTriggerSpec.%s: %s%s",
+		"invalid %sRefreshTriggerSpec.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PullTriggerSpecValidationError{}
+var _ error = RefreshTriggerSpecValidationError{}
 
 var _ interface {
 	Field() string
@@ -5696,44 +6650,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PullTriggerSpecValidationError{}
+} = RefreshTriggerSpecValidationError{}
 
-// Validate checks the field values on PullTriggerState with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *PullTriggerState) Validate() error {
+// Validate checks the field values on RefreshModelTrigger with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RefreshModelTrigger) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PullTriggerState with the rules
+// ValidateAll checks the field values on RefreshModelTrigger with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// PullTriggerStateMultiError, or nil if none found.
-func (m *PullTriggerState) ValidateAll() error {
+// RefreshModelTriggerMultiError, or nil if none found.
+func (m *RefreshModelTrigger) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PullTriggerState) validate(all bool) error {
+func (m *RefreshModelTrigger) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Model
+
+	// no validation rules for Full
+
+	// no validation rules for AllErroredPartitions
+
 	if len(errors) > 0 {
-		return PullTriggerStateMultiError(errors)
+		return RefreshModelTriggerMultiError(errors)
 	}
 
 	return nil
 }
 
-// PullTriggerStateMultiError is an error wrapping multiple validation errors
-// returned by PullTriggerState.ValidateAll() if the designated constraints
-// aren't met.
-type PullTriggerStateMultiError []error
+// RefreshModelTriggerMultiError is an error wrapping multiple validation
+// errors returned by RefreshModelTrigger.ValidateAll() if the designated
+// constraints aren't met.
+type RefreshModelTriggerMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PullTriggerStateMultiError) Error() string {
+func (m RefreshModelTriggerMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5742,11 +6702,11 @@ func (m PullTriggerStateMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PullTriggerStateMultiError) AllErrors() []error { return m }
+func (m RefreshModelTriggerMultiError) AllErrors() []error { return m }
 
-// PullTriggerStateValidationError is the validation error returned by
-// PullTriggerState.Validate if the designated constraints aren't met.
-type PullTriggerStateValidationError struct {
+// RefreshModelTriggerValidationError is the validation error returned by
+// RefreshModelTrigger.Validate if the designated constraints aren't met.
+type RefreshModelTriggerValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5754,22 +6714,24 @@ type PullTriggerStateValidationError struct {
 }
 
 // Field function returns field value.
-func (e PullTriggerStateValidationError) Field() string { return e.field }
+func (e RefreshModelTriggerValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PullTriggerStateValidationError) Reason() string { return e.reason }
+func (e RefreshModelTriggerValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PullTriggerStateValidationError) Cause() error { return e.cause }
+func (e RefreshModelTriggerValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PullTriggerStateValidationError) Key() bool { return e.key }
+func (e RefreshModelTriggerValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PullTriggerStateValidationError) ErrorName() string { return "PullTriggerStateValidationError" }
+func (e RefreshModelTriggerValidationError) ErrorName() string {
+	return "RefreshModelTriggerValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e PullTriggerStateValidationError) Error() string {
+func (e RefreshModelTriggerValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5781,14 +6743,14 @@ func (e PullTriggerStateValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPullTriggerState.%s: %s%s",
+		"invalid %sRefreshModelTrigger.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PullTriggerStateValidationError{}
+var _ error = RefreshModelTriggerValidationError{}
 
 var _ interface {
 	Field() string
@@ -5796,24 +6758,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PullTriggerStateValidationError{}
+} = RefreshModelTriggerValidationError{}
 
-// Validate checks the field values on RefreshTrigger with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *RefreshTrigger) Validate() error {
+// Validate checks the field values on RefreshBackfillTrigger with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RefreshBackfillTrigger) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RefreshTrigger with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in RefreshTriggerMultiError,
-// or nil if none found.
-func (m *RefreshTrigger) ValidateAll() error {
+// ValidateAll checks the field values on RefreshBackfillTrigger with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RefreshBackfillTriggerMultiError, or nil if none found.
+func (m *RefreshBackfillTrigger) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RefreshTrigger) validate(all bool) error {
+func (m *RefreshBackfillTrigger) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -5821,28 +6783,28 @@ func (m *RefreshTrigger) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetSpec()).(type) {
+		switch v := interface{}(m.GetName()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, RefreshTriggerValidationError{
-					field:  "Spec",
+				errors = append(errors, RefreshBackfillTriggerValidationError{
+					field:  "Name",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, RefreshTriggerValidationError{
-					field:  "Spec",
+				errors = append(errors, RefreshBackfillTriggerValidationError{
+					field:  "Name",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetSpec()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetName()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return RefreshTriggerValidationError{
-				field:  "Spec",
+			return RefreshBackfillTriggerValidationError{
+				field:  "Name",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -5850,182 +6812,77 @@ func (m *RefreshTrigger) validate(all bool) error {
 	}
 
 	if all {
-		switch v := interface{}(m.GetState()).(type) {
+		switch v := interface{}(m.GetFrom()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, RefreshTriggerValidationError{
-					field:  "State",
+				errors = append(errors, RefreshBackfillTriggerValidationError{
+					field:  "From",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, RefreshTriggerValidationError{
-					field:  "State",
+				errors = append(errors, RefreshBackfillTriggerValidationError{
+					field:  "From",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetState()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetFrom()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return RefreshTriggerValidationError{
-				field:  "State",
+			return RefreshBackfillTriggerValidationError{
+				field:  "From",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	if len(errors) > 0 {
-		return RefreshTriggerMultiError(errors)
-	}
-
-	return nil
-}
-
-// RefreshTriggerMultiError is an error wrapping multiple validation errors
-// returned by RefreshTrigger.ValidateAll() if the designated constraints
-// aren't met.
-type RefreshTriggerMultiError []error
-
-// Error returns a concatenation of all the error messages it wraps.
-func (m RefreshTriggerMultiError) Error() string {
-	var msgs []string
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
-	}
-	return strings.Join(msgs, "; ")
-}
-
-// AllErrors returns a list of validation violation errors.
-func (m RefreshTriggerMultiError) AllErrors() []error { return m }
-
-// RefreshTriggerValidationError is the validation error returned by
-// RefreshTrigger.Validate if the designated constraints aren't met.
-type RefreshTriggerValidationError struct {
-	field  string
-	reason string
-	cause  error
-	key    bool
-}
-
-// Field function returns field value.
-func (e RefreshTriggerValidationError) Field() string { return e.field }
-
-// Reason function returns reason value.
-func (e RefreshTriggerValidationError) Reason() string { return e.reason }
-
-// Cause function returns cause value.
-func (e RefreshTriggerValidationError) Cause() error { return e.cause }
-
-// Key function returns key value.
-func (e RefreshTriggerValidationError) Key() bool { return e.key }
-
-// ErrorName returns error name.
-func (e RefreshTriggerValidationError) ErrorName() string { return "RefreshTriggerValidationError" }
-
-// Error satisfies the builtin error interface
-func (e RefreshTriggerValidationError) Error() string {
-	cause := ""
-	if e.cause != nil {
-		cause = fmt.Sprintf(" | caused by: %v", e.cause)
-	}
-
-	key := ""
-	if e.key {
-		key = "key for "
-	}
-
-	return fmt.Sprintf(
-		"invalid %sRefreshTrigger.%s: %s%s",
-		key,
-		e.field,
-		e.reason,
-		cause)
-}
-
-var _ error = RefreshTriggerValidationError{}
-
-var _ interface {
-	Field() string
-	Reason() string
-	Key() bool
-	Cause() error
-	ErrorName() string
-} = RefreshTriggerValidationError{}
-
-// Validate checks the field values on RefreshTriggerSpec with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RefreshTriggerSpec) Validate() error {
-	return m.validate(false)
-}
-
-// ValidateAll checks the field values on RefreshTriggerSpec with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// RefreshTriggerSpecMultiError, or nil if none found.
-func (m *RefreshTriggerSpec) ValidateAll() error {
-	return m.validate(true)
-}
-
-func (m *RefreshTriggerSpec) validate(all bool) error {
-	if m == nil {
-		return nil
-	}
-
-	var errors []error
-
-	for idx, item := range m.GetOnlyNames() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, RefreshTriggerSpecValidationError{
-						field:  fmt.Sprintf("OnlyNames[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, RefreshTriggerSpecValidationError{
-						field:  fmt.Sprintf("OnlyNames[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetTo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RefreshBackfillTriggerValidationError{
+					field:  "To",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return RefreshTriggerSpecValidationError{
-					field:  fmt.Sprintf("OnlyNames[%v]", idx),
+				errors = append(errors, RefreshBackfillTriggerValidationError{
+					field:  "To",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RefreshBackfillTriggerValidationError{
+				field:  "To",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
 	if len(errors) > 0 {
-		return RefreshTriggerSpecMultiError(errors)
+		return RefreshBackfillTriggerMultiError(errors)
 	}
 
 	return nil
 }
 
-// RefreshTriggerSpecMultiError is an error wrapping multiple validation errors
-// returned by RefreshTriggerSpec.ValidateAll() if the designated constraints
-// aren't met.
-type RefreshTriggerSpecMultiError []error
+// RefreshBackfillTriggerMultiError is an error wrapping multiple validation
+// errors returned by RefreshBackfillTrigger.ValidateAll() if the designated
+// constraints aren't met.
+type RefreshBackfillTriggerMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RefreshTriggerSpecMultiError) Error() string {
+func (m RefreshBackfillTriggerMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6034,11 +6891,11 @@ func (m RefreshTriggerSpecMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RefreshTriggerSpecMultiError) AllErrors() []error { return m }
+func (m RefreshBackfillTriggerMultiError) AllErrors() []error { return m }
 
-// RefreshTriggerSpecValidationError is the validation error returned by
-// RefreshTriggerSpec.Validate if the designated constraints aren't met.
-type RefreshTriggerSpecValidationError struct {
+// RefreshBackfillTriggerValidationError is the validation error returned by
+// RefreshBackfillTrigger.Validate if the designated constraints aren't met.
+type RefreshBackfillTriggerValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6046,24 +6903,24 @@ type RefreshTriggerSpecValidationError struct {
 }
 
 // Field function returns field value.
-func (e RefreshTriggerSpecValidationError) Field() string { return e.field }
+func (e RefreshBackfillTriggerValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RefreshTriggerSpecValidationError) Reason() string { return e.reason }
+func (e RefreshBackfillTriggerValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RefreshTriggerSpecValidationError) Cause() error { return e.cause }
+func (e RefreshBackfillTriggerValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RefreshTriggerSpecValidationError) Key() bool { return e.key }
+func (e RefreshBackfillTriggerValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RefreshTriggerSpecValidationError) ErrorName() string {
-	return "RefreshTriggerSpecValidationError"
+func (e RefreshBackfillTriggerValidationError) ErrorName() string {
+	return "RefreshBackfillTriggerValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RefreshTriggerSpecValidationError) Error() string {
+func (e RefreshBackfillTriggerValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6075,14 +6932,14 @@ func (e RefreshTriggerSpecValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRefreshTriggerSpec.%s: %s%s",
+		"invalid %sRefreshBackfillTrigger.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RefreshTriggerSpecValidationError{}
+var _ error = RefreshBackfillTriggerValidationError{}
 
 var _ interface {
 	Field() string
@@ -6090,7 +6947,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RefreshTriggerSpecValidationError{}
+} = RefreshBackfillTriggerValidationError{}
 
 // Validate checks the field values on RefreshTriggerState with the rules
 // defined in the proto definition for this message. If any rules are
@@ -7810,7 +8667,227 @@ func (e DashboardSpecValidationError) Key() bool { return e.key }
 func (e DashboardSpecValidationError) ErrorName() string { return "DashboardSpecValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DashboardSpecValidationError) Error() string {
+func (e DashboardSpecValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDashboardSpec.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DashboardSpecValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DashboardSpecValidationError{}
+
+// Validate checks the field values on DashboardState with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DashboardState) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DashboardState with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DashboardStateMultiError,
+// or nil if none found.
+func (m *DashboardState) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DashboardState) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DashboardStateMultiError(errors)
+	}
+
+	return nil
+}
+
+// DashboardStateMultiError is an error wrapping multiple validation errors
+// returned by DashboardState.ValidateAll() if the designated constraints
+// aren't met.
+type DashboardStateMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DashboardStateMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DashboardStateMultiError) AllErrors() []error { return m }
+
+// DashboardStateValidationError is the validation error returned by
+// DashboardState.Validate if the designated constraints aren't met.
+type DashboardStateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DashboardStateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DashboardStateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DashboardStateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DashboardStateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DashboardStateValidationError) ErrorName() string { return "DashboardStateValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DashboardStateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDashboardState.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DashboardStateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DashboardStateValidationError{}
+
+// Validate checks the field values on DashboardItem with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DashboardItem) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DashboardItem with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DashboardItemMultiError, or
+// nil if none found.
+func (m *DashboardItem) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DashboardItem) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Component
+
+	// no validation rules for FontSize
+
+	if m.X != nil {
+		// no validation rules for X
+	}
+
+	if m.Y != nil {
+		// no validation rules for Y
+	}
+
+	if m.Width != nil {
+		// no validation rules for Width
+	}
+
+	if m.Height != nil {
+		// no validation rules for Height
+	}
+
+	if len(errors) > 0 {
+		return DashboardItemMultiError(errors)
+	}
+
+	return nil
+}
+
+// DashboardItemMultiError is an error wrapping multiple validation errors
+// returned by DashboardItem.ValidateAll() if the designated constraints
+// aren't met.
+type DashboardItemMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DashboardItemMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DashboardItemMultiError) AllErrors() []error { return m }
+
+// DashboardItemValidationError is the validation error returned by
+// DashboardItem.Validate if the designated constraints aren't met.
+type DashboardItemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DashboardItemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DashboardItemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DashboardItemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DashboardItemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DashboardItemValidationError) ErrorName() string { return "DashboardItemValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DashboardItemValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7822,14 +8899,14 @@ func (e DashboardSpecValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDashboardSpec.%s: %s%s",
+		"invalid %sDashboardItem.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DashboardSpecValidationError{}
+var _ error = DashboardItemValidationError{}
 
 var _ interface {
 	Field() string
@@ -7837,44 +8914,100 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DashboardSpecValidationError{}
+} = DashboardItemValidationError{}
 
-// Validate checks the field values on DashboardState with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *DashboardState) Validate() error {
+// Validate checks the field values on API with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *API) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DashboardState with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in DashboardStateMultiError,
-// or nil if none found.
-func (m *DashboardState) ValidateAll() error {
+// ValidateAll checks the field values on API with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in APIMultiError, or nil if none found.
+func (m *API) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DashboardState) validate(all bool) error {
+func (m *API) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if all {
+		switch v := interface{}(m.GetSpec()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, APIValidationError{
+					field:  "Spec",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, APIValidationError{
+					field:  "Spec",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSpec()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return APIValidationError{
+				field:  "Spec",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetState()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, APIValidationError{
+					field:  "State",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, APIValidationError{
+					field:  "State",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetState()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return APIValidationError{
+				field:  "State",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return DashboardStateMultiError(errors)
+		return APIMultiError(errors)
 	}
 
 	return nil
 }
 
-// DashboardStateMultiError is an error wrapping multiple validation errors
-// returned by DashboardState.ValidateAll() if the designated constraints
-// aren't met.
-type DashboardStateMultiError []error
+// APIMultiError is an error wrapping multiple validation errors returned by
+// API.ValidateAll() if the designated constraints aren't met.
+type APIMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DashboardStateMultiError) Error() string {
+func (m APIMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7883,11 +9016,11 @@ func (m DashboardStateMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DashboardStateMultiError) AllErrors() []error { return m }
+func (m APIMultiError) AllErrors() []error { return m }
 
-// DashboardStateValidationError is the validation error returned by
-// DashboardState.Validate if the designated constraints aren't met.
-type DashboardStateValidationError struct {
+// APIValidationError is the validation error returned by API.Validate if the
+// designated constraints aren't met.
+type APIValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7895,22 +9028,22 @@ type DashboardStateValidationError struct {
 }
 
 // Field function returns field value.
-func (e DashboardStateValidationError) Field() string { return e.field }
+func (e APIValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DashboardStateValidationError) Reason() string { return e.reason }
+func (e APIValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DashboardStateValidationError) Cause() error { return e.cause }
+func (e APIValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DashboardStateValidationError) Key() bool { return e.key }
+func (e APIValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DashboardStateValidationError) ErrorName() string { return "DashboardStateValidationError" }
+func (e APIValidationError) ErrorName() string { return "APIValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DashboardStateValidationError) Error() string {
+func (e APIValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7922,14 +9055,14 @@ func (e DashboardStateValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDashboardState.%s: %s%s",
+		"invalid %sAPI.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DashboardStateValidationError{}
+var _ error = APIValidationError{}
 
 var _ interface {
 	Field() string
@@ -7937,64 +9070,140 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DashboardStateValidationError{}
+} = APIValidationError{}
 
-// Validate checks the field values on DashboardItem with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on APISpec with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *DashboardItem) Validate() error {
+func (m *APISpec) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DashboardItem with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in DashboardItemMultiError, or
-// nil if none found.
-func (m *DashboardItem) ValidateAll() error {
+// ValidateAll checks the field values on APISpec with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in APISpecMultiError, or nil if none found.
+func (m *APISpec) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DashboardItem) validate(all bool) error {
+func (m *APISpec) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Component
-
-	// no validation rules for FontSize
+	// no validation rules for Resolver
 
-	if m.X != nil {
-		// no validation rules for X
+	if all {
+		switch v := interface{}(m.GetResolverProperties()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, APISpecValidationError{
+					field:  "ResolverProperties",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, APISpecValidationError{
+					field:  "ResolverProperties",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResolverProperties()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return APISpecValidationError{
+				field:  "ResolverProperties",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
 	}
 
-	if m.Y != nil {
-		// no validation rules for Y
-	}
+	// no validation rules for Streaming
+
+	// no validation rules for Description
+
+	for idx, item := range m.GetArguments() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, APISpecValidationError{
+						field:  fmt.Sprintf("Arguments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, APISpecValidationError{
+						field:  fmt.Sprintf("Arguments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return APISpecValidationError{
+					field:  fmt.Sprintf("Arguments[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-	if m.Width != nil {
-		// no validation rules for Width
 	}
 
-	if m.Height != nil {
-		// no validation rules for Height
+	if all {
+		switch v := interface{}(m.GetPagination()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, APISpecValidationError{
+					field:  "Pagination",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, APISpecValidationError{
+					field:  "Pagination",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPagination()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return APISpecValidationError{
+				field:  "Pagination",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
 	}
 
 	if len(errors) > 0 {
-		return DashboardItemMultiError(errors)
+		return APISpecMultiError(errors)
 	}
 
 	return nil
 }
 
-// DashboardItemMultiError is an error wrapping multiple validation errors
-// returned by DashboardItem.ValidateAll() if the designated constraints
-// aren't met.
-type DashboardItemMultiError []error
+// APISpecMultiError is an error wrapping multiple validation errors returned
+// by APISpec.ValidateAll() if the designated constraints aren't met.
+type APISpecMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DashboardItemMultiError) Error() string {
+func (m APISpecMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8003,11 +9212,11 @@ func (m DashboardItemMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DashboardItemMultiError) AllErrors() []error { return m }
+func (m APISpecMultiError) AllErrors() []error { return m }
 
-// DashboardItemValidationError is the validation error returned by
-// DashboardItem.Validate if the designated constraints aren't met.
-type DashboardItemValidationError struct {
+// APISpecValidationError is the validation error returned by APISpec.Validate
+// if the designated constraints aren't met.
+type APISpecValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8015,22 +9224,22 @@ type DashboardItemValidationError struct {
 }
 
 // Field function returns field value.
-func (e DashboardItemValidationError) Field() string { return e.field }
+func (e APISpecValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DashboardItemValidationError) Reason() string { return e.reason }
+func (e APISpecValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DashboardItemValidationError) Cause() error { return e.cause }
+func (e APISpecValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DashboardItemValidationError) Key() bool { return e.key }
+func (e APISpecValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DashboardItemValidationError) ErrorName() string { return "DashboardItemValidationError" }
+func (e APISpecValidationError) ErrorName() string { return "APISpecValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DashboardItemValidationError) Error() string {
+func (e APISpecValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8042,14 +9251,14 @@ func (e DashboardItemValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDashboardItem.%s: %s%s",
+		"invalid %sAPISpec.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DashboardItemValidationError{}
+var _ error = APISpecValidationError{}
 
 var _ interface {
 	Field() string
@@ -8057,100 +9266,124 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DashboardItemValidationError{}
+} = APISpecValidationError{}
 
-// Validate checks the field values on API with the rules defined in the proto
-// definition for this message. If any rules are violated, the first error
-// encountered is returned, or nil if there are no violations.
-func (m *API) Validate() error {
+// Validate checks the field values on APIArgument with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *APIArgument) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on API with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in APIMultiError, or nil if none found.
-func (m *API) ValidateAll() error {
+// ValidateAll checks the field values on APIArgument with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in APIArgumentMultiError, or
+// nil if none found.
+func (m *APIArgument) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *API) validate(all bool) error {
+func (m *APIArgument) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Name
+
+	// no validation rules for Type
+
+	// no validation rules for Description
+
+	// no validation rules for Required
+
 	if all {
-		switch v := interface{}(m.GetSpec()).(type) {
+		switch v := interface{}(m.GetDefaultValue()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, APIValidationError{
-					field:  "Spec",
+				errors = append(errors, APIArgumentValidationError{
+					field:  "DefaultValue",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, APIValidationError{
-					field:  "Spec",
+				errors = append(errors, APIArgumentValidationError{
+					field:  "DefaultValue",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetSpec()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetDefaultValue()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return APIValidationError{
-				field:  "Spec",
+			return APIArgumentValidationError{
+				field:  "DefaultValue",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	if all {
-		switch v := interface{}(m.GetState()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, APIValidationError{
-					field:  "State",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	for idx, item := range m.GetAllowedValues() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, APIArgumentValidationError{
+						field:  fmt.Sprintf("AllowedValues[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, APIArgumentValidationError{
+						field:  fmt.Sprintf("AllowedValues[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, APIValidationError{
-					field:  "State",
+				return APIArgumentValidationError{
+					field:  fmt.Sprintf("AllowedValues[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetState()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return APIValidationError{
-				field:  "State",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
+	}
+
+	// no validation rules for Pattern
+
+	if m.Minimum != nil {
+		// no validation rules for Minimum
+	}
+
+	if m.Maximum != nil {
+		// no validation rules for Maximum
 	}
 
 	if len(errors) > 0 {
-		return APIMultiError(errors)
+		return APIArgumentMultiError(errors)
 	}
 
 	return nil
 }
 
-// APIMultiError is an error wrapping multiple validation errors returned by
-// API.ValidateAll() if the designated constraints aren't met.
-type APIMultiError []error
+// APIArgumentMultiError is an error wrapping multiple validation errors
+// returned by APIArgument.ValidateAll() if the designated constraints aren't met.
+type APIArgumentMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m APIMultiError) Error() string {
+func (m APIArgumentMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8159,11 +9392,11 @@ func (m APIMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m APIMultiError) AllErrors() []error { return m }
+func (m APIArgumentMultiError) AllErrors() []error { return m }
 
-// APIValidationError is the validation error returned by API.Validate if the
-// designated constraints aren't met.
-type APIValidationError struct {
+// APIArgumentValidationError is the validation error returned by
+// APIArgument.Validate if the designated constraints aren't met.
+type APIArgumentValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8171,22 +9404,22 @@ type APIValidationError struct {
 }
 
 // Field function returns field value.
-func (e APIValidationError) Field() string { return e.field }
+func (e APIArgumentValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e APIValidationError) Reason() string { return e.reason }
+func (e APIArgumentValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e APIValidationError) Cause() error { return e.cause }
+func (e APIArgumentValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e APIValidationError) Key() bool { return e.key }
+func (e APIArgumentValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e APIValidationError) ErrorName() string { return "APIValidationError" }
+func (e APIArgumentValidationError) ErrorName() string { return "APIArgumentValidationError" }
 
 // Error satisfies the builtin error interface
-func (e APIValidationError) Error() string {
+func (e APIArgumentValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8198,14 +9431,14 @@ func (e APIValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAPI.%s: %s%s",
+		"invalid %sAPIArgument.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = APIValidationError{}
+var _ error = APIArgumentValidationError{}
 
 var _ interface {
 	Field() string
@@ -8213,73 +9446,86 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = APIValidationError{}
+} = APIArgumentValidationError{}
 
-// Validate checks the field values on APISpec with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on APIPagination with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *APISpec) Validate() error {
+func (m *APIPagination) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on APISpec with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in APISpecMultiError, or nil if none found.
-func (m *APISpec) ValidateAll() error {
+// ValidateAll checks the field values on APIPagination with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in APIPaginationMultiError, or
+// nil if none found.
+func (m *APIPagination) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *APISpec) validate(all bool) error {
+func (m *APIPagination) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Resolver
+	// no validation rules for Mode
 
-	if all {
-		switch v := interface{}(m.GetResolverProperties()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, APISpecValidationError{
-					field:  "ResolverProperties",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	// no validation rules for DefaultPageSize
+
+	// no validation rules for MaxPageSize
+
+	// no validation rules for CursorColumn
+
+	for idx, item := range m.GetOrderBy() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, APIPaginationValidationError{
+						field:  fmt.Sprintf("OrderBy[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, APIPaginationValidationError{
+						field:  fmt.Sprintf("OrderBy[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, APISpecValidationError{
-					field:  "ResolverProperties",
+				return APIPaginationValidationError{
+					field:  fmt.Sprintf("OrderBy[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetResolverProperties()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return APISpecValidationError{
-				field:  "ResolverProperties",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return APISpecMultiError(errors)
+		return APIPaginationMultiError(errors)
 	}
 
 	return nil
 }
 
-// APISpecMultiError is an error wrapping multiple validation errors returned
-// by APISpec.ValidateAll() if the designated constraints aren't met.
-type APISpecMultiError []error
+// APIPaginationMultiError is an error wrapping multiple validation errors
+// returned by APIPagination.ValidateAll() if the designated constraints
+// aren't met.
+type APIPaginationMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m APISpecMultiError) Error() string {
+func (m APIPaginationMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8288,11 +9534,11 @@ func (m APISpecMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m APISpecMultiError) AllErrors() []error { return m }
+func (m APIPaginationMultiError) AllErrors() []error { return m }
 
-// APISpecValidationError is the validation error returned by APISpec.Validate
-// if the designated constraints aren't met.
-type APISpecValidationError struct {
+// APIPaginationValidationError is the validation error returned by
+// APIPagination.Validate if the designated constraints aren't met.
+type APIPaginationValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8300,22 +9546,22 @@ type APISpecValidationError struct {
 }
 
 // Field function returns field value.
-func (e APISpecValidationError) Field() string { return e.field }
+func (e APIPaginationValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e APISpecValidationError) Reason() string { return e.reason }
+func (e APIPaginationValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e APISpecValidationError) Cause() error { return e.cause }
+func (e APIPaginationValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e APISpecValidationError) Key() bool { return e.key }
+func (e APIPaginationValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e APISpecValidationError) ErrorName() string { return "APISpecValidationError" }
+func (e APIPaginationValidationError) ErrorName() string { return "APIPaginationValidationError" }
 
 // Error satisfies the builtin error interface
-func (e APISpecValidationError) Error() string {
+func (e APIPaginationValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8327,14 +9573,14 @@ func (e APISpecValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAPISpec.%s: %s%s",
+		"invalid %sAPIPagination.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = APISpecValidationError{}
+var _ error = APIPaginationValidationError{}
 
 var _ interface {
 	Field() string
@@ -8342,7 +9588,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = APISpecValidationError{}
+} = APIPaginationValidationError{}
 
 // Validate checks the field values on APIState with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
@@ -9246,6 +10492,10 @@ func (m *MetricsViewSpec_MeasureV2) validate(all bool) error {
 
 	// no validation rules for ValidPercentOfTotal
 
+	// no validation rules for Type
+
+	// no validation rules for Window
+
 	if len(errors) > 0 {
 		return MetricsViewSpec_MeasureV2MultiError(errors)
 	}
@@ -9858,3 +11108,109 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = MetricsViewSpec_SecurityV2_FieldConditionV2ValidationError{}
+
+// Validate checks the field values on APIPagination_Sort with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *APIPagination_Sort) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on APIPagination_Sort with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// APIPagination_SortMultiError, or nil if none found.
+func (m *APIPagination_Sort) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *APIPagination_Sort) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Column
+
+	// no validation rules for Desc
+
+	if len(errors) > 0 {
+		return APIPagination_SortMultiError(errors)
+	}
+
+	return nil
+}
+
+// APIPagination_SortMultiError is an error wrapping multiple validation errors
+// returned by APIPagination_Sort.ValidateAll() if the designated constraints
+// aren't met.
+type APIPagination_SortMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m APIPagination_SortMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m APIPagination_SortMultiError) AllErrors() []error { return m }
+
+// APIPagination_SortValidationError is the validation error returned by
+// APIPagination_Sort.Validate if the designated constraints aren't met.
+type APIPagination_SortValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e APIPagination_SortValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e APIPagination_SortValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e APIPagination_SortValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e APIPagination_SortValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e APIPagination_SortValidationError) ErrorName() string {
+	return "APIPagination_SortValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e APIPagination_SortValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAPIPagination_Sort.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = APIPagination_SortValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = APIPagination_SortValidationError{}
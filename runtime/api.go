@@ -2,6 +2,11 @@ package runtime
 
 import (
 	"context"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"sync"
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"google.golang.org/protobuf/types/known/structpb"
@@ -47,3 +52,164 @@ func (r *Runtime) APIForName(ctx context.Context, instanceID, name string) (*run
 
 	return resource.GetApi(), nil
 }
+
+// ValidateAPIArgs validates args against the arguments declared in an API spec.
+// It updates args in-place, filling in defaults for missing args and coercing values to the declared types.
+// Coercion enables args passed as strings (such as URL query parameters) to be used as numbers or booleans in templates.
+// Args that are not declared in the spec are left untouched.
+func ValidateAPIArgs(spec *runtimev1.APISpec, args map[string]any) error {
+	for _, arg := range spec.Arguments {
+		v, ok := args[arg.Name]
+		if !ok || v == nil {
+			if arg.Required {
+				return fmt.Errorf("missing required argument %q", arg.Name)
+			}
+			if arg.DefaultValue == nil {
+				continue
+			}
+			v = arg.DefaultValue.AsInterface()
+		}
+
+		v, err := coerceAPIArg(arg, v)
+		if err != nil {
+			return err
+		}
+
+		if len(arg.AllowedValues) > 0 {
+			var found bool
+			for _, av := range arg.AllowedValues {
+				if apiArgEqual(av.AsInterface(), v) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return fmt.Errorf("invalid argument %q: value %v is not one of the allowed values", arg.Name, v)
+			}
+		}
+
+		args[arg.Name] = v
+	}
+	return nil
+}
+
+// coerceAPIArg converts v to the Go type corresponding to the arg's declared type and checks the arg's bounds and pattern.
+// Integers are returned as int64, numbers as float64, booleans as bool and strings as string.
+func coerceAPIArg(arg *runtimev1.APIArgument, v any) (any, error) {
+	switch arg.Type {
+	case runtimev1.APIArgument_TYPE_INTEGER:
+		var i int64
+		switch v := v.(type) {
+		case string:
+			var err error
+			i, err = strconv.ParseInt(v, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid argument %q: expected an integer, got %q", arg.Name, v)
+			}
+		case float64:
+			if v != math.Trunc(v) {
+				return nil, fmt.Errorf("invalid argument %q: expected an integer, got %v", arg.Name, v)
+			}
+			i = int64(v)
+		case int:
+			i = int64(v)
+		case int64:
+			i = v
+		default:
+			return nil, fmt.Errorf("invalid argument %q: expected an integer, got %T", arg.Name, v)
+		}
+		if err := checkAPIArgBounds(arg, float64(i)); err != nil {
+			return nil, err
+		}
+		return i, nil
+	case runtimev1.APIArgument_TYPE_NUMBER:
+		var f float64
+		switch v := v.(type) {
+		case string:
+			var err error
+			f, err = strconv.ParseFloat(v, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid argument %q: expected a number, got %q", arg.Name, v)
+			}
+		case float64:
+			f = v
+		case int:
+			f = float64(v)
+		case int64:
+			f = float64(v)
+		default:
+			return nil, fmt.Errorf("invalid argument %q: expected a number, got %T", arg.Name, v)
+		}
+		if err := checkAPIArgBounds(arg, f); err != nil {
+			return nil, err
+		}
+		return f, nil
+	case runtimev1.APIArgument_TYPE_BOOLEAN:
+		switch v := v.(type) {
+		case string:
+			b, err := strconv.ParseBool(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid argument %q: expected a boolean, got %q", arg.Name, v)
+			}
+			return b, nil
+		case bool:
+			return v, nil
+		default:
+			return nil, fmt.Errorf("invalid argument %q: expected a boolean, got %T", arg.Name, v)
+		}
+	default: // Strings
+		s, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("invalid argument %q: expected a string, got %T", arg.Name, v)
+		}
+		if arg.Pattern != "" {
+			re, err := apiArgPattern(arg.Pattern)
+			if err != nil {
+				return nil, fmt.Errorf("invalid argument %q: invalid pattern: %w", arg.Name, err)
+			}
+			if !re.MatchString(s) {
+				return nil, fmt.Errorf("invalid argument %q: value %q does not match the pattern %q", arg.Name, s, arg.Pattern)
+			}
+		}
+		return s, nil
+	}
+}
+
+// apiArgPatterns caches the compiled regular expressions of API argument patterns.
+// Patterns are validated when the API is parsed, so the cache is bounded by the patterns declared in projects.
+var apiArgPatterns sync.Map
+
+// apiArgPattern returns the compiled regular expression for an API argument's pattern.
+// Each pattern is only compiled once.
+func apiArgPattern(pattern string) (*regexp.Regexp, error) {
+	if re, ok := apiArgPatterns.Load(pattern); ok {
+		return re.(*regexp.Regexp), nil
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	apiArgPatterns.Store(pattern, re)
+	return re, nil
+}
+
+func checkAPIArgBounds(arg *runtimev1.APIArgument, f float64) error {
+	if arg.Minimum != nil && f < *arg.Minimum {
+		return fmt.Errorf("invalid argument %q: value %v is less than the minimum %v", arg.Name, f, *arg.Minimum)
+	}
+	if arg.Maximum != nil && f > *arg.Maximum {
+		return fmt.Errorf("invalid argument %q: value %v is greater than the maximum %v", arg.Name, f, *arg.Maximum)
+	}
+	return nil
+}
+
+// apiArgEqual compares a value from an arg's allowed values (decoded from a structpb.Value) with a coerced arg value.
+func apiArgEqual(allowed, v any) bool {
+	switch v := v.(type) {
+	case int64:
+		f, ok := allowed.(float64)
+		return ok && f == float64(v)
+	default:
+		return allowed == v
+	}
+}
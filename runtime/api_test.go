@@ -0,0 +1,88 @@
+package runtime
+
+import (
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestValidateAPIArgs(t *testing.T) {
+	minLimit := 1.0
+	maxLimit := 100.0
+	spec := &runtimev1.APISpec{
+		Arguments: []*runtimev1.APIArgument{
+			{Name: "country", Type: runtimev1.APIArgument_TYPE_STRING, Required: true, Pattern: "^[A-Z]{2}$"},
+			{Name: "limit", Type: runtimev1.APIArgument_TYPE_INTEGER, DefaultValue: structpb.NewNumberValue(10), Minimum: &minLimit, Maximum: &maxLimit},
+			{Name: "ratio", Type: runtimev1.APIArgument_TYPE_NUMBER},
+			{Name: "verbose", Type: runtimev1.APIArgument_TYPE_BOOLEAN},
+			{Name: "sort", Type: runtimev1.APIArgument_TYPE_STRING, AllowedValues: []*structpb.Value{structpb.NewStringValue("asc"), structpb.NewStringValue("desc")}},
+		},
+	}
+
+	tt := []struct {
+		args    map[string]any
+		want    map[string]any
+		wantErr string
+	}{
+		{
+			args: map[string]any{"country": "DK"},
+			want: map[string]any{"country": "DK", "limit": int64(10)},
+		},
+		{
+			args: map[string]any{"country": "DK", "limit": "20", "ratio": "0.5", "verbose": "true", "sort": "asc", "other": "x"},
+			want: map[string]any{"country": "DK", "limit": int64(20), "ratio": 0.5, "verbose": true, "sort": "asc", "other": "x"},
+		},
+		{
+			args: map[string]any{"country": "DK", "limit": float64(20), "verbose": false},
+			want: map[string]any{"country": "DK", "limit": int64(20), "verbose": false},
+		},
+		{
+			args:    map[string]any{},
+			wantErr: `missing required argument "country"`,
+		},
+		{
+			args:    map[string]any{"country": "Denmark"},
+			wantErr: "does not match the pattern",
+		},
+		{
+			args:    map[string]any{"country": "DK", "limit": "ten"},
+			wantErr: "expected an integer",
+		},
+		{
+			args:    map[string]any{"country": "DK", "limit": 1.5},
+			wantErr: "expected an integer",
+		},
+		{
+			args:    map[string]any{"country": "DK", "limit": "1000"},
+			wantErr: "greater than the maximum",
+		},
+		{
+			args:    map[string]any{"country": "DK", "sort": "up"},
+			wantErr: "not one of the allowed values",
+		},
+	}
+
+	for _, tc := range tt {
+		err := ValidateAPIArgs(spec, tc.args)
+		if tc.wantErr != "" {
+			require.ErrorContains(t, err, tc.wantErr)
+			continue
+		}
+		require.NoError(t, err)
+		require.Equal(t, tc.want, tc.args)
+	}
+}
+
+func TestAPIArgPattern(t *testing.T) {
+	re1, err := apiArgPattern("^[a-z]+$")
+	require.NoError(t, err)
+	re2, err := apiArgPattern("^[a-z]+$")
+	require.NoError(t, err)
+	require.Same(t, re1, re2)
+	require.True(t, re1.MatchString("abc"))
+
+	_, err = apiArgPattern("[")
+	require.Error(t, err)
+}
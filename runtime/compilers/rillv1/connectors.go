@@ -220,6 +220,9 @@ func (a *connectorAnalyzer) analyzeResourceNotifiers(r *Resource, notifiers []*r
 			if len(props.Users) == 0 && len(props.Channels) == 0 {
 				anonAccess = true
 			}
+		} else if n.Connector == "webhook" {
+			// Webhook notifier doesn't require credentials (the signing secret is optional)
+			anonAccess = true
 		}
 		err := a.trackConnector(n.Connector, r, anonAccess)
 		if err != nil {
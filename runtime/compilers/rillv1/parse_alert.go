@@ -4,12 +4,16 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net"
 	"net/mail"
+	"net/url"
 	"strings"
 	"time"
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime/drivers/slack"
+	"github.com/rilldata/rill/runtime/drivers/webhook"
+	"github.com/rilldata/rill/runtime/pkg/httputil"
 	"github.com/rilldata/rill/runtime/pkg/pbutil"
 	"google.golang.org/protobuf/types/known/structpb"
 )
@@ -25,8 +29,9 @@ type AlertYAML struct {
 		Limit         uint   `yaml:"limit"`
 		CheckUnclosed bool   `yaml:"check_unclosed"`
 	} `yaml:"intervals"`
-	Timeout string `yaml:"timeout"`
-	Query   struct {
+	Timeout   string              `yaml:"timeout"`
+	Condition *AlertConditionYAML `yaml:"condition"`
+	Query     struct {
 		Name     string         `yaml:"name"`
 		Args     map[string]any `yaml:"args"`
 		ArgsJSON string         `yaml:"args_json"`
@@ -43,6 +48,7 @@ type AlertYAML struct {
 	RenotifyAfter string `yaml:"renotify_after"`
 	Notify        struct {
 		Email struct {
+			Connector  string   `yaml:"connector"`
 			Recipients []string `yaml:"recipients"`
 		} `yaml:"email"`
 		Slack struct {
@@ -50,6 +56,11 @@ type AlertYAML struct {
 			Channels []string `yaml:"channels"`
 			Webhooks []string `yaml:"webhooks"`
 		} `yaml:"slack"`
+		Webhook struct {
+			Connector string            `yaml:"connector"`
+			URLs      []string          `yaml:"urls"`
+			Headers   map[string]string `yaml:"headers"`
+		} `yaml:"webhook"`
 	} `yaml:"notify"`
 	Annotations map[string]string `yaml:"annotations"`
 	// Backwards compatibility
@@ -63,6 +74,18 @@ type AlertYAML struct {
 	} `yaml:"email"`
 }
 
+// AlertConditionYAML is the raw structure of a declarative alert condition on a metrics view.
+// It is compiled into a metrics view query that returns rows only when the condition is met.
+type AlertConditionYAML struct {
+	MetricsView string   `yaml:"metrics_view"`
+	Measure     string   `yaml:"measure"`
+	Dimension   string   `yaml:"dimension"`
+	Window      string   `yaml:"window"`
+	CompareTo   string   `yaml:"compare_to"` // options: "previous_period"
+	Operator    string   `yaml:"operator"`   // options: "gt", "gte", "lt", "lte", "eq", "neq"
+	Value       *float64 `yaml:"value"`
+}
+
 // parseAlert parses an alert definition and adds the resulting resource to p.Resources.
 func (p *Parser) parseAlert(node *Node) error {
 	// Parse YAML
@@ -116,6 +139,18 @@ func (p *Parser) parseAlert(node *Node) error {
 		}
 	}
 
+	// Compile a declarative condition into a query
+	if tmp.Condition != nil {
+		if tmp.Query.Name != "" || len(tmp.Query.Args) > 0 || tmp.Query.ArgsJSON != "" {
+			return errors.New(`cannot set both "condition" and "query.name", "query.args" or "query.args_json"`)
+		}
+		tmp.Query.Name, tmp.Query.ArgsJSON, err = compileAlertCondition(tmp.Condition)
+		if err != nil {
+			return err
+		}
+		node.Refs = append(node.Refs, ResourceName{Kind: ResourceKindMetricsView, Name: tmp.Condition.MetricsView})
+	}
+
 	// Query name
 	if tmp.Query.Name == "" {
 		return fmt.Errorf(`invalid value %q for property "query.name"`, tmp.Query.Name)
@@ -198,6 +233,11 @@ func (p *Parser) parseAlert(node *Node) error {
 				return fmt.Errorf("invalid recipient email address %q", email)
 			}
 		}
+		// Validate webhook URLs
+		err = validateWebhook(tmp.Notify.Webhook.URLs, tmp.Notify.Webhook.Headers)
+		if err != nil {
+			return err
+		}
 		// Validate renotify_after
 		if tmp.RenotifyAfter != "" {
 			renotifyAfter, err = parseDuration(tmp.RenotifyAfter)
@@ -295,8 +335,12 @@ func (p *Parser) parseAlert(node *Node) error {
 			if err != nil {
 				return fmt.Errorf("encountered invalid property type: %w", err)
 			}
+			connector := tmp.Notify.Email.Connector
+			if connector == "" {
+				connector = "email"
+			}
 			r.AlertSpec.Notifiers = append(r.AlertSpec.Notifiers, &runtimev1.Notifier{
-				Connector:  "email",
+				Connector:  connector,
 				Properties: props,
 			})
 		}
@@ -311,9 +355,144 @@ func (p *Parser) parseAlert(node *Node) error {
 				Properties: props,
 			})
 		}
+		// Webhook settings
+		if len(tmp.Notify.Webhook.URLs) > 0 {
+			props, err := structpb.NewStruct(webhook.EncodeProps(tmp.Notify.Webhook.URLs, tmp.Notify.Webhook.Headers))
+			if err != nil {
+				return fmt.Errorf("encountered invalid property type: %w", err)
+			}
+			connector := tmp.Notify.Webhook.Connector
+			if connector == "" {
+				connector = "webhook"
+			}
+			r.AlertSpec.Notifiers = append(r.AlertSpec.Notifiers, &runtimev1.Notifier{
+				Connector:  connector,
+				Properties: props,
+			})
+		}
 	}
 
 	r.AlertSpec.Annotations = tmp.Annotations
 
 	return nil
 }
+
+// compileAlertCondition compiles a declarative alert condition into the name and JSON args of a metrics view query.
+// The query only returns rows that match the condition, and the most extreme row is returned first so it's used as the alert's fail row.
+// When comparing to the previous period, the condition's value is a percent change.
+func compileAlertCondition(c *AlertConditionYAML) (string, string, error) {
+	if c.MetricsView == "" {
+		return "", "", errors.New(`missing property "condition.metrics_view"`)
+	}
+	if c.Measure == "" {
+		return "", "", errors.New(`missing property "condition.measure"`)
+	}
+	if c.Value == nil {
+		return "", "", errors.New(`missing property "condition.value"`)
+	}
+
+	var op string
+	var desc bool
+	switch strings.ToLower(c.Operator) {
+	case "gt":
+		op, desc = "OPERATION_GT", true
+	case "gte":
+		op, desc = "OPERATION_GTE", true
+	case "lt":
+		op = "OPERATION_LT"
+	case "lte":
+		op = "OPERATION_LTE"
+	case "eq":
+		op = "OPERATION_EQ"
+	case "neq":
+		op = "OPERATION_NEQ"
+	default:
+		return "", "", fmt.Errorf(`invalid value %q for property "condition.operator"`, c.Operator)
+	}
+
+	if c.Window != "" {
+		err := validateISO8601(c.Window, true, false)
+		if err != nil {
+			return "", "", fmt.Errorf(`invalid value %q for property "condition.window"`, c.Window)
+		}
+	}
+
+	having := func(ident string, val float64) map[string]any {
+		return map[string]any{
+			"cond": map[string]any{
+				"op":    op,
+				"exprs": []any{map[string]any{"ident": ident}, map[string]any{"val": val}},
+			},
+		}
+	}
+
+	var name string
+	var args map[string]any
+	switch strings.ToLower(c.CompareTo) {
+	case "":
+		name = "MetricsViewAggregation"
+		args = map[string]any{
+			"metrics_view": c.MetricsView,
+			"measures":     []any{map[string]any{"name": c.Measure}},
+			"having":       having(c.Measure, *c.Value),
+			"sort":         []any{map[string]any{"name": c.Measure, "desc": desc}},
+			"limit":        1,
+		}
+		if c.Dimension != "" {
+			args["dimensions"] = []any{map[string]any{"name": c.Dimension}}
+		}
+		if c.Window != "" {
+			args["time_range"] = map[string]any{"iso_duration": c.Window}
+		}
+	case "previous_period":
+		if c.Window == "" {
+			return "", "", errors.New(`property "condition.window" is required when comparing to the previous period`)
+		}
+		alias := c.Measure + "__delta_rel"
+		name = "MetricsViewComparison"
+		args = map[string]any{
+			"metrics_view_name":     c.MetricsView,
+			"measures":              []any{map[string]any{"name": c.Measure}},
+			"comparison_measures":   []any{c.Measure},
+			"time_range":            map[string]any{"iso_duration": c.Window},
+			"comparison_time_range": map[string]any{"iso_duration": c.Window, "iso_offset": c.Window},
+			"aliases":               []any{map[string]any{"name": c.Measure, "type": "METRICS_VIEW_COMPARISON_MEASURE_TYPE_REL_DELTA", "alias": alias}},
+			"having":                having(alias, *c.Value/100),
+			"sort":                  []any{map[string]any{"name": c.Measure, "sort_type": "METRICS_VIEW_COMPARISON_MEASURE_TYPE_REL_DELTA", "desc": desc}},
+			"limit":                 1,
+		}
+		if c.Dimension != "" {
+			args["dimension"] = map[string]any{"name": c.Dimension}
+		}
+	default:
+		return "", "", fmt.Errorf(`invalid value %q for property "condition.compare_to"`, c.CompareTo)
+	}
+
+	data, err := json.Marshal(args)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to serialize condition to JSON: %w", err)
+	}
+	return name, string(data), nil
+}
+
+// validateWebhook validates the properties of a webhook notifier.
+// The URLs must be absolute HTTP(S) URLs that don't target local or private addresses (hostnames are also checked when the notifier connects).
+// Headers that carry credentials must be configured on the webhook connector, so they are not stored in the resource spec.
+func validateWebhook(urls []string, headers map[string]string) error {
+	for _, u := range urls {
+		parsed, err := url.Parse(u)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			return fmt.Errorf("invalid webhook URL %q", u)
+		}
+		host := parsed.Hostname()
+		if ip := net.ParseIP(host); strings.EqualFold(host, "localhost") || (ip != nil && !httputil.IsPublicIP(ip)) {
+			return fmt.Errorf("invalid webhook URL %q: must not target a local or private address", u)
+		}
+	}
+	for k := range headers {
+		if webhook.IsSensitiveHeader(k) {
+			return fmt.Errorf("webhook header %q may contain credentials: set it in the webhook connector's \"headers\" property instead", k)
+		}
+	}
+	return nil
+}
@@ -2,15 +2,49 @@ package rillv1
 
 import (
 	"fmt"
+	"math"
+	"regexp"
+	"strings"
 
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"google.golang.org/protobuf/types/known/structpb"
 )
 
 // APIYAML is the raw structure of a API resource defined in YAML (does not include common fields)
 type APIYAML struct {
-	DataYAML `yaml:",inline" mapstructure:",squash"`
+	DataYAML    `yaml:",inline" mapstructure:",squash"`
+	Description string             `yaml:"description"`
+	Streaming   bool               `yaml:"streaming"`
+	Arguments   []*APIArgumentYAML `yaml:"arguments"`
+	Pagination  *struct {
+		Mode            string   `yaml:"mode"`
+		DefaultPageSize uint32   `yaml:"default_page_size"`
+		MaxPageSize     uint32   `yaml:"max_page_size"`
+		CursorColumn    string   `yaml:"cursor_column"`
+		OrderBy         []string `yaml:"order_by"`
+	} `yaml:"pagination"`
 }
 
+// APIArgumentYAML is the raw structure of a typed arg declared in an API's "arguments" property.
+type APIArgumentYAML struct {
+	Name          string   `yaml:"name"`
+	Type          string   `yaml:"type"`
+	Description   string   `yaml:"description"`
+	Required      bool     `yaml:"required"`
+	Default       any      `yaml:"default"`
+	AllowedValues []any    `yaml:"allowed_values"`
+	Minimum       *float64 `yaml:"minimum"`
+	Maximum       *float64 `yaml:"maximum"`
+	Pattern       string   `yaml:"pattern"`
+}
+
+// Names of the args used by the runtime to paginate APIs.
+// Paginated APIs can't declare arguments with these names.
+const (
+	APIPageSizeArg  = "page_size"
+	APIPageTokenArg = "page_token"
+)
+
 // parseAPI parses an API definition and adds the resulting resource to p.Resources.
 func (p *Parser) parseAPI(node *Node) error {
 	// Parse YAML
@@ -35,6 +69,56 @@ func (p *Parser) parseAPI(node *Node) error {
 	}
 	node.Refs = append(node.Refs, resolverRefs...)
 
+	// Parse pagination
+	var pagination *runtimev1.APIPagination
+	if tmp.Pagination != nil {
+		pagination = &runtimev1.APIPagination{
+			DefaultPageSize: tmp.Pagination.DefaultPageSize,
+			MaxPageSize:     tmp.Pagination.MaxPageSize,
+		}
+		switch strings.ToLower(tmp.Pagination.Mode) {
+		case "", "offset":
+			// The order of a query's output is not guaranteed to be the same across pages, so offset pagination needs an explicit sort
+			if len(tmp.Pagination.OrderBy) == 0 {
+				return fmt.Errorf(`"pagination" must specify "order_by" in offset mode`)
+			}
+			for _, o := range tmp.Pagination.OrderBy {
+				fields := strings.Fields(o)
+				if len(fields) == 0 || len(fields) > 2 || len(fields) == 2 && !strings.EqualFold(fields[1], "asc") && !strings.EqualFold(fields[1], "desc") {
+					return fmt.Errorf(`invalid "order_by" entry %q (expected a column name optionally followed by "asc" or "desc")`, o)
+				}
+				pagination.OrderBy = append(pagination.OrderBy, &runtimev1.APIPagination_Sort{
+					Column: fields[0],
+					Desc:   len(fields) == 2 && strings.EqualFold(fields[1], "desc"),
+				})
+			}
+			pagination.Mode = runtimev1.APIPagination_MODE_OFFSET
+		case "cursor":
+			if tmp.Pagination.CursorColumn == "" {
+				return fmt.Errorf(`"pagination" must specify a "cursor_column" in cursor mode`)
+			}
+			if len(tmp.Pagination.OrderBy) > 0 {
+				return fmt.Errorf(`"pagination" can't specify "order_by" in cursor mode`)
+			}
+			pagination.Mode = runtimev1.APIPagination_MODE_CURSOR
+			pagination.CursorColumn = tmp.Pagination.CursorColumn
+		default:
+			return fmt.Errorf(`invalid pagination mode %q (expected "offset" or "cursor")`, tmp.Pagination.Mode)
+		}
+		if pagination.MaxPageSize != 0 && pagination.DefaultPageSize > pagination.MaxPageSize {
+			return fmt.Errorf(`"pagination" has "default_page_size" greater than "max_page_size"`)
+		}
+		if tmp.Streaming {
+			return fmt.Errorf(`"streaming" can't be used together with "pagination"`)
+		}
+	}
+
+	// Parse arguments
+	args, err := parseAPIArguments(tmp.Arguments, pagination != nil)
+	if err != nil {
+		return err
+	}
+
 	r, err := p.insertResource(ResourceKindAPI, node.Name, node.Paths, node.Refs...)
 	if err != nil {
 		return err
@@ -43,10 +127,127 @@ func (p *Parser) parseAPI(node *Node) error {
 
 	r.APISpec.Resolver = resolver
 	r.APISpec.ResolverProperties = resolverProps
+	r.APISpec.Streaming = tmp.Streaming
+	r.APISpec.Description = tmp.Description
+	r.APISpec.Arguments = args
+	r.APISpec.Pagination = pagination
 
 	return nil
 }
 
+// parseAPIArguments parses and validates the args declared in an API's "arguments" property.
+func parseAPIArguments(raw []*APIArgumentYAML, paginated bool) ([]*runtimev1.APIArgument, error) {
+	var res []*runtimev1.APIArgument
+	names := make(map[string]bool)
+	for i, tmp := range raw {
+		if tmp == nil {
+			return nil, fmt.Errorf("invalid argument at index %d: empty definition", i)
+		}
+		if tmp.Name == "" {
+			return nil, fmt.Errorf(`invalid argument at index %d: missing "name"`, i)
+		}
+		if names[tmp.Name] {
+			return nil, fmt.Errorf("invalid argument at index %d: duplicate name %q", i, tmp.Name)
+		}
+		names[tmp.Name] = true
+		if paginated && (tmp.Name == APIPageSizeArg || tmp.Name == APIPageTokenArg) {
+			return nil, fmt.Errorf("invalid argument at index %d: the name %q is reserved for pagination", i, tmp.Name)
+		}
+
+		arg := &runtimev1.APIArgument{
+			Name:        tmp.Name,
+			Description: tmp.Description,
+			Required:    tmp.Required,
+			Minimum:     tmp.Minimum,
+			Maximum:     tmp.Maximum,
+			Pattern:     tmp.Pattern,
+		}
+
+		switch strings.ToLower(tmp.Type) {
+		case "", "string":
+			arg.Type = runtimev1.APIArgument_TYPE_STRING
+		case "integer", "int":
+			arg.Type = runtimev1.APIArgument_TYPE_INTEGER
+		case "number":
+			arg.Type = runtimev1.APIArgument_TYPE_NUMBER
+		case "boolean", "bool":
+			arg.Type = runtimev1.APIArgument_TYPE_BOOLEAN
+		default:
+			return nil, fmt.Errorf(`invalid argument %q: invalid type %q (expected "string", "integer", "number" or "boolean")`, tmp.Name, tmp.Type)
+		}
+
+		if tmp.Minimum != nil && tmp.Maximum != nil && *tmp.Minimum > *tmp.Maximum {
+			return nil, fmt.Errorf(`invalid argument %q: "minimum" is greater than "maximum"`, tmp.Name)
+		}
+		if (tmp.Minimum != nil || tmp.Maximum != nil) && arg.Type != runtimev1.APIArgument_TYPE_INTEGER && arg.Type != runtimev1.APIArgument_TYPE_NUMBER {
+			return nil, fmt.Errorf(`invalid argument %q: "minimum" and "maximum" can only be used with integer and number types`, tmp.Name)
+		}
+		if tmp.Pattern != "" {
+			if arg.Type != runtimev1.APIArgument_TYPE_STRING {
+				return nil, fmt.Errorf(`invalid argument %q: "pattern" can only be used with the string type`, tmp.Name)
+			}
+			if _, err := regexp.Compile(tmp.Pattern); err != nil {
+				return nil, fmt.Errorf(`invalid argument %q: invalid "pattern": %w`, tmp.Name, err)
+			}
+		}
+
+		for _, v := range tmp.AllowedValues {
+			if !apiArgumentValueMatchesType(v, arg.Type) {
+				return nil, fmt.Errorf(`invalid argument %q: value %v in "allowed_values" does not match the argument's type`, tmp.Name, v)
+			}
+			pv, err := structpb.NewValue(v)
+			if err != nil {
+				return nil, fmt.Errorf(`invalid argument %q: invalid value in "allowed_values": %w`, tmp.Name, err)
+			}
+			arg.AllowedValues = append(arg.AllowedValues, pv)
+		}
+
+		if tmp.Default != nil {
+			if tmp.Required {
+				return nil, fmt.Errorf(`invalid argument %q: a required argument can't have a "default"`, tmp.Name)
+			}
+			if !apiArgumentValueMatchesType(tmp.Default, arg.Type) {
+				return nil, fmt.Errorf(`invalid argument %q: "default" does not match the argument's type`, tmp.Name)
+			}
+			pv, err := structpb.NewValue(tmp.Default)
+			if err != nil {
+				return nil, fmt.Errorf(`invalid argument %q: invalid "default": %w`, tmp.Name, err)
+			}
+			arg.DefaultValue = pv
+		}
+
+		res = append(res, arg)
+	}
+	return res, nil
+}
+
+// apiArgumentValueMatchesType returns true if a value decoded from YAML is valid for an argument type.
+func apiArgumentValueMatchesType(v any, t runtimev1.APIArgument_Type) bool {
+	switch t {
+	case runtimev1.APIArgument_TYPE_STRING:
+		_, ok := v.(string)
+		return ok
+	case runtimev1.APIArgument_TYPE_INTEGER:
+		switch v := v.(type) {
+		case int, int64, uint64:
+			return true
+		case float64:
+			return v == math.Trunc(v)
+		}
+		return false
+	case runtimev1.APIArgument_TYPE_NUMBER:
+		switch v.(type) {
+		case int, int64, uint64, float64:
+			return true
+		}
+		return false
+	case runtimev1.APIArgument_TYPE_BOOLEAN:
+		_, ok := v.(bool)
+		return ok
+	}
+	return false
+}
+
 // DataYAML is the raw YAML structure of a sub-property for defining a data resolver and properties.
 // It is used across multiple resources, usually under "data:", but inlined for APIs.
 type DataYAML struct {
@@ -3,6 +3,7 @@ package rillv1
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -52,6 +53,9 @@ type MetricsViewYAML struct {
 		FormatD3            string `yaml:"format_d3"`
 		Ignore              bool   `yaml:"ignore"`
 		ValidPercentOfTotal bool   `yaml:"valid_percent_of_total"`
+		Type                string `yaml:"type"`
+		Measure             string `yaml:"measure"`
+		Window              uint32 `yaml:"window"`
 	}
 	DefaultMeasures []string `yaml:"default_measures"`
 	Security        *struct {
@@ -163,6 +167,16 @@ var comparisonModesMap = map[string]runtimev1.MetricsViewSpec_ComparisonMode{
 }
 var validComparisonModes = []string{"none", "time", "dimension"}
 
+var measureTypesMap = map[string]runtimev1.MetricsViewSpec_MeasureType{
+	"":                runtimev1.MetricsViewSpec_MEASURE_TYPE_UNSPECIFIED,
+	"simple":          runtimev1.MetricsViewSpec_MEASURE_TYPE_SIMPLE,
+	"derived":         runtimev1.MetricsViewSpec_MEASURE_TYPE_DERIVED,
+	"running_total":   runtimev1.MetricsViewSpec_MEASURE_TYPE_RUNNING_TOTAL,
+	"moving_average":  runtimev1.MetricsViewSpec_MEASURE_TYPE_MOVING_AVERAGE,
+	"share_of_parent": runtimev1.MetricsViewSpec_MEASURE_TYPE_SHARE_OF_PARENT,
+}
+var validMeasureTypes = []string{"simple", "derived", "running_total", "moving_average", "share_of_parent"}
+
 const (
 	nameIsMeasure   uint8 = 1
 	nameIsDimension uint8 = 2
@@ -252,6 +266,10 @@ func (p *Parser) parseMetricsView(node *Node) error {
 	}
 
 	measureCount := 0
+	var measureNames []string
+	measureTypes := make(map[string]runtimev1.MetricsViewSpec_MeasureType)
+	measureExprs := make(map[string]string)
+	measureBases := make(map[string]string)
 	for i, measure := range tmp.Measures {
 		if measure == nil || measure.Ignore {
 			continue
@@ -273,11 +291,43 @@ func (p *Parser) parseMetricsView(node *Node) error {
 		if measure.FormatPreset != "" && measure.FormatD3 != "" {
 			return fmt.Errorf(`cannot set both "format_preset" and "format_d3" for a measure`)
 		}
+
+		typ, ok := measureTypesMap[strings.ToLower(measure.Type)]
+		if !ok {
+			return fmt.Errorf("invalid type %q for measure %q. allowed values: %s", measure.Type, measure.Name, strings.Join(validMeasureTypes, ","))
+		}
+		measureNames = append(measureNames, measure.Name)
+		measureTypes[measure.Name] = typ
+		measureExprs[measure.Name] = measure.Expression
+		measureBases[measure.Name] = measure.Measure
+
+		if isWindowMeasureType(typ) {
+			if measure.Measure == "" || measure.Expression != "" {
+				return fmt.Errorf(`measure %q of type %q must set "measure" and not "expression"`, measure.Name, measure.Type)
+			}
+		} else if measure.Measure != "" {
+			return fmt.Errorf(`"measure" can only be set for window measures, found in measure %q`, measure.Name)
+		}
+		if typ == runtimev1.MetricsViewSpec_MEASURE_TYPE_DERIVED && measure.Expression == "" {
+			return fmt.Errorf(`derived measure %q must set "expression"`, measure.Name)
+		}
+		if typ == runtimev1.MetricsViewSpec_MEASURE_TYPE_MOVING_AVERAGE {
+			if measure.Window == 0 {
+				return fmt.Errorf(`moving average measure %q must set a "window" greater than zero`, measure.Name)
+			}
+		} else if measure.Window != 0 {
+			return fmt.Errorf(`"window" can only be set for moving average measures, found in measure %q`, measure.Name)
+		}
 	}
 	if measureCount == 0 {
 		return fmt.Errorf("must define at least one measure")
 	}
 
+	resolvedMeasures, err := resolveMeasureReferences(measureNames, measureTypes, measureExprs, measureBases)
+	if err != nil {
+		return err
+	}
+
 	for _, measure := range tmp.DefaultMeasures {
 		if v, ok := names[strings.ToLower(measure)]; !ok || v != nameIsMeasure {
 			return fmt.Errorf(`measure %q referenced in "default_dimensions" not found`, measure)
@@ -452,14 +502,18 @@ func (p *Parser) parseMetricsView(node *Node) error {
 			continue
 		}
 
+		resolved := resolvedMeasures[measure.Name]
 		spec.Measures = append(spec.Measures, &runtimev1.MetricsViewSpec_MeasureV2{
 			Name:                measure.Name,
-			Expression:          measure.Expression,
+			Expression:          resolved.expression,
 			Label:               measure.Label,
 			Description:         measure.Description,
 			FormatPreset:        measure.FormatPreset,
 			FormatD3:            measure.FormatD3,
 			ValidPercentOfTotal: measure.ValidPercentOfTotal,
+			Type:                measureTypes[measure.Name],
+			ReferencedMeasures:  resolved.refs,
+			Window:              measure.Window,
 		})
 	}
 	spec.DefaultMeasures = tmp.DefaultMeasures
@@ -515,6 +569,307 @@ func (p *Parser) parseMetricsView(node *Node) error {
 	return nil
 }
 
+// resolvedMeasure is the result of resolving a measure's references to other measures.
+type resolvedMeasure struct {
+	expression string
+	refs       []string
+}
+
+// resolveMeasureReferences resolves the references between the measures of a metrics view.
+// The expressions of derived measures are expanded by replacing each reference to another measure with that measure's (parenthesized) expression.
+// Window measures must reference a simple or derived measure, and their expression is left empty.
+func resolveMeasureReferences(names []string, types map[string]runtimev1.MetricsViewSpec_MeasureType, exprs, bases map[string]string) (map[string]resolvedMeasure, error) {
+	res := make(map[string]resolvedMeasure, len(exprs))
+	visiting := make(map[string]bool)
+	var resolve func(name string) (string, error)
+	resolve = func(name string) (string, error) {
+		if r, ok := res[name]; ok {
+			return r.expression, nil
+		}
+		if visiting[name] {
+			return "", fmt.Errorf("measure %q has a circular reference", name)
+		}
+		visiting[name] = true
+		defer delete(visiting, name)
+
+		typ := types[name]
+		if isWindowMeasureType(typ) {
+			base := bases[name]
+			baseTyp, ok := types[base]
+			if !ok {
+				return "", fmt.Errorf("measure %q referenced by measure %q not found", base, name)
+			}
+			if isWindowMeasureType(baseTyp) {
+				return "", fmt.Errorf("measure %q cannot reference window measure %q", name, base)
+			}
+			if _, err := resolve(base); err != nil {
+				return "", err
+			}
+			res[name] = resolvedMeasure{refs: []string{base}}
+			return "", nil
+		}
+
+		if typ != runtimev1.MetricsViewSpec_MEASURE_TYPE_DERIVED {
+			res[name] = resolvedMeasure{expression: exprs[name]}
+			return exprs[name], nil
+		}
+
+		var refs []string
+		expr, err := replaceSQLIdentifiers(exprs[name], func(ident string) (string, bool, error) {
+			refTyp, ok := types[ident]
+			if !ok {
+				return "", false, nil
+			}
+			if isWindowMeasureType(refTyp) {
+				return "", false, fmt.Errorf("measure %q cannot reference window measure %q", name, ident)
+			}
+			refExpr, err := resolve(ident)
+			if err != nil {
+				return "", false, err
+			}
+			if !slices.Contains(refs, ident) {
+				refs = append(refs, ident)
+			}
+			return "(" + refExpr + ")", true, nil
+		})
+		if err != nil {
+			return "", err
+		}
+		if len(refs) == 0 {
+			return "", fmt.Errorf("derived measure %q does not reference any other measures", name)
+		}
+
+		res[name] = resolvedMeasure{expression: expr, refs: refs}
+		return expr, nil
+	}
+
+	for _, name := range names {
+		if _, err := resolve(name); err != nil {
+			return nil, err
+		}
+	}
+
+	return res, nil
+}
+
+// replaceSQLIdentifiers calls fn for every bare or double-quoted identifier in a SQL expression and replaces it with the returned string if fn returns true.
+// Identifiers that are qualified (preceded by a dot) or followed by an opening parenthesis (function calls) are skipped, as is the content of string literals.
+// Identifiers in the arguments of aggregate functions (and their FILTER, OVER and WITHIN GROUP clauses) are also skipped since they refer to columns.
+func replaceSQLIdentifiers(expr string, fn func(ident string) (string, bool, error)) (string, error) {
+	var sb strings.Builder
+	var columnScopes []bool // For each open parenthesis, whether the identifiers it contains refer to columns
+	var call string         // Name of the function call whose opening parenthesis is next
+	inColumnScope := func() bool {
+		return len(columnScopes) > 0 && columnScopes[len(columnScopes)-1]
+	}
+	i := 0
+	for i < len(expr) {
+		c := expr[i]
+		var ident string
+		start := i
+		switch {
+		case c == '\'':
+			// String literal ('' is an escaped quote)
+			i++
+			for i < len(expr) {
+				if expr[i] == '\'' {
+					if i+1 < len(expr) && expr[i+1] == '\'' {
+						i += 2
+						continue
+					}
+					i++
+					break
+				}
+				i++
+			}
+			sb.WriteString(expr[start:i])
+			continue
+		case c >= '0' && c <= '9':
+			// Numeric literal
+			for i < len(expr) && (isIdentChar(expr[i]) || expr[i] == '.') {
+				i++
+			}
+			sb.WriteString(expr[start:i])
+			continue
+		case c == '"':
+			end := strings.IndexByte(expr[i+1:], '"')
+			if end < 0 {
+				sb.WriteString(expr[start:])
+				return sb.String(), nil
+			}
+			ident = expr[i+1 : i+1+end]
+			i += end + 2
+		case isIdentChar(c):
+			for i < len(expr) && isIdentChar(expr[i]) {
+				i++
+			}
+			ident = expr[start:i]
+		case c == '(':
+			columnScopes = append(columnScopes, inColumnScope() || sqlColumnScopeFunctions[call])
+			call = ""
+			sb.WriteByte(c)
+			i++
+			continue
+		case c == ')':
+			if len(columnScopes) > 0 {
+				columnScopes = columnScopes[:len(columnScopes)-1]
+			}
+			sb.WriteByte(c)
+			i++
+			continue
+		default:
+			sb.WriteByte(c)
+			i++
+			continue
+		}
+
+		qualified := start > 0 && expr[start-1] == '.'
+		if strings.HasPrefix(strings.TrimLeft(expr[i:], " \t\n"), "(") {
+			call = strings.ToLower(ident)
+			sb.WriteString(expr[start:i])
+			continue
+		}
+		call = ""
+		if qualified || inColumnScope() {
+			sb.WriteString(expr[start:i])
+			continue
+		}
+
+		replacement, ok, err := fn(ident)
+		if err != nil {
+			return "", err
+		}
+		if ok {
+			sb.WriteString(replacement)
+		} else {
+			sb.WriteString(expr[start:i])
+		}
+	}
+	return sb.String(), nil
+}
+
+// sqlColumnScopeFunctions contains the lowercase names of aggregate functions and clauses whose arguments refer to columns rather than measures.
+// Since metrics views are parsed without knowing the OLAP dialect, it includes the aggregate functions of all supported OLAP connectors.
+var sqlColumnScopeFunctions = map[string]bool{
+	// DuckDB and standard SQL
+	"any_value":             true,
+	"approx_count_distinct": true,
+	"approx_quantile":       true,
+	"arg_max":               true,
+	"arg_min":               true,
+	"array_agg":             true,
+	"avg":                   true,
+	"bool_and":              true,
+	"bool_or":               true,
+	"count":                 true,
+	"count_if":              true,
+	"filter":                true,
+	"first":                 true,
+	"group":                 true,
+	"last":                  true,
+	"list":                  true,
+	"max":                   true,
+	"max_by":                true,
+	"median":                true,
+	"min":                   true,
+	"min_by":                true,
+	"mode":                  true,
+	"over":                  true,
+	"quantile":              true,
+	"quantile_cont":         true,
+	"quantile_disc":         true,
+	"stddev":                true,
+	"stddev_pop":            true,
+	"stddev_samp":           true,
+	"string_agg":            true,
+	"sum":                   true,
+	"var_pop":               true,
+	"var_samp":              true,
+	"variance":              true,
+	// ClickHouse
+	"any":             true,
+	"anylast":         true,
+	"argmax":          true,
+	"argmin":          true,
+	"avgif":           true,
+	"countif":         true,
+	"grouparray":      true,
+	"groupuniqarray":  true,
+	"maxif":           true,
+	"minif":           true,
+	"quantileexact":   true,
+	"quantiles":       true,
+	"quantiletdigest": true,
+	"stddevpop":       true,
+	"stddevsamp":      true,
+	"sumif":           true,
+	"topk":            true,
+	"uniq":            true,
+	"uniqcombined":    true,
+	"uniqcombined64":  true,
+	"uniqexact":       true,
+	"uniqhll12":       true,
+	"uniqif":          true,
+	"uniqtheta":       true,
+	"varpop":          true,
+	"varsamp":         true,
+	// Druid
+	"approx_count_distinct_builtin":  true,
+	"approx_count_distinct_ds_hll":   true,
+	"approx_count_distinct_ds_theta": true,
+	"approx_quantile_ds":             true,
+	"bit_and":                        true,
+	"bit_or":                         true,
+	"bit_xor":                        true,
+	"ds_hll":                         true,
+	"ds_quantiles_sketch":            true,
+	"ds_theta":                       true,
+	"earliest":                       true,
+	"earliest_by":                    true,
+	"latest":                         true,
+	"latest_by":                      true,
+	// Pinot
+	"distinctavg":              true,
+	"distinctcount":            true,
+	"distinctcountbitmap":      true,
+	"distinctcounthll":         true,
+	"distinctcountrawhll":      true,
+	"distinctcountthetasketch": true,
+	"distinctsum":              true,
+	"firstwithtime":            true,
+	"lastwithtime":             true,
+	"minmaxrange":              true,
+	"percentile":               true,
+	"percentileest":            true,
+	"percentiletdigest":        true,
+	"sumprecision":             true,
+	// Postgres
+	"corr":            true,
+	"covar_pop":       true,
+	"covar_samp":      true,
+	"every":           true,
+	"json_agg":        true,
+	"jsonb_agg":       true,
+	"percentile_cont": true,
+	"percentile_disc": true,
+	"regr_intercept":  true,
+	"regr_slope":      true,
+}
+
+func isIdentChar(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
+
+// isWindowMeasureType returns true for measure types that are computed with a window function over another measure.
+func isWindowMeasureType(t runtimev1.MetricsViewSpec_MeasureType) bool {
+	switch t {
+	case runtimev1.MetricsViewSpec_MEASURE_TYPE_RUNNING_TOTAL, runtimev1.MetricsViewSpec_MEASURE_TYPE_MOVING_AVERAGE, runtimev1.MetricsViewSpec_MEASURE_TYPE_SHARE_OF_PARENT:
+		return true
+	default:
+		return false
+	}
+}
+
 // parseTimeGrain parses a YAML time grain string
 func parseTimeGrain(s string) (runtimev1.TimeGrain, error) {
 	switch strings.ToLower(s) {
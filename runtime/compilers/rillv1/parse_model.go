@@ -13,17 +13,38 @@ import (
 
 // ModelYAML is the raw structure of a Model resource defined in YAML (does not include common fields)
 type ModelYAML struct {
-	commonYAML      `yaml:",inline" mapstructure:",squash"` // Only to avoid loading common fields into InputProperties
-	Refresh         *ScheduleYAML                           `yaml:"refresh"`
-	Timeout         string                                  `yaml:"timeout"`
-	Incremental     bool                                    `yaml:"incremental"`
-	State           *DataYAML                               `yaml:"state"`
-	InputProperties map[string]any                          `yaml:",inline" mapstructure:",remain"`
-	Output          struct {
+	commonYAML          `yaml:",inline" mapstructure:",squash"` // Only to avoid loading common fields into InputProperties
+	Refresh             *ScheduleYAML                           `yaml:"refresh"`
+	Timeout             string                                  `yaml:"timeout"`
+	Incremental         bool                                    `yaml:"incremental"`
+	State               *DataYAML                               `yaml:"state"`
+	Partitions          *DataYAML                               `yaml:"partitions"`
+	PartitionsWatermark string                                  `yaml:"partitions_watermark"`
+	InputProperties     map[string]any                          `yaml:",inline" mapstructure:",remain"`
+	Output              struct {
 		Connector  string         `yaml:"connector"`
 		Properties map[string]any `yaml:",inline" mapstructure:",remain"`
 	} `yaml:"output"`
-	Materialize *bool `yaml:"materialize"`
+	Materialize *bool            `yaml:"materialize"`
+	Tests       []*ModelTestYAML `yaml:"tests"`
+}
+
+// ModelTestYAML is the raw structure of a data test defined in a model's "tests" property.
+// Exactly one of the test types (not_null, unique, accepted_values, row_count or sql) must be set.
+type ModelTestYAML struct {
+	Name           string `yaml:"name"`
+	Severity       string `yaml:"severity"`
+	NotNull        string `yaml:"not_null"`
+	Unique         string `yaml:"unique"`
+	AcceptedValues *struct {
+		Column string `yaml:"column"`
+		Values []any  `yaml:"values"`
+	} `yaml:"accepted_values"`
+	RowCount *struct {
+		Min *uint64 `yaml:"min"`
+		Max *uint64 `yaml:"max"`
+	} `yaml:"row_count"`
+	SQL string `yaml:"sql"`
 }
 
 // parseModel parses a model definition and adds the resulting resource to p.Resources.
@@ -62,6 +83,25 @@ func (p *Parser) parseModel(node *Node) error {
 		node.Refs = append(node.Refs, refs...)
 	}
 
+	// Parse partitions resolver
+	var partitionsResolver string
+	var partitionsResolverProps *structpb.Struct
+	if tmp.Partitions != nil {
+		// Partitions are only executed once, so the model must be incremental to not re-execute all of them on every refresh
+		if !tmp.Incremental {
+			return errors.New(`"partitions" can only be set when "incremental" is true`)
+		}
+
+		var refs []ResourceName
+		partitionsResolver, partitionsResolverProps, refs, err = p.parseDataYAML(tmp.Partitions)
+		if err != nil {
+			return fmt.Errorf(`failed to parse "partitions": %w`, err)
+		}
+		node.Refs = append(node.Refs, refs...)
+	} else if tmp.PartitionsWatermark != "" {
+		return errors.New(`"partitions_watermark" can only be set when "partitions" is configured`)
+	}
+
 	// Build input details
 	inputConnector := node.Connector
 	inputProps := tmp.InputProperties
@@ -113,6 +153,12 @@ func (p *Parser) parseModel(node *Node) error {
 		}
 	}
 
+	// Parse tests
+	tests, err := parseModelTests(tmp.Tests)
+	if err != nil {
+		return err
+	}
+
 	// Insert the model
 	r, err := p.insertResource(ResourceKindModel, node.Name, node.Paths, node.Refs...)
 	if err != nil {
@@ -133,15 +179,110 @@ func (p *Parser) parseModel(node *Node) error {
 	r.ModelSpec.IncrementalStateResolver = incrementalStateResolver
 	r.ModelSpec.IncrementalStateResolverProperties = incrementalStateResolverProps
 
+	r.ModelSpec.PartitionsResolver = partitionsResolver
+	r.ModelSpec.PartitionsResolverProperties = partitionsResolverProps
+	r.ModelSpec.PartitionsWatermarkField = tmp.PartitionsWatermark
+
 	r.ModelSpec.InputConnector = inputConnector
 	r.ModelSpec.InputProperties = inputPropsPB
 
 	r.ModelSpec.OutputConnector = outputConnector
 	r.ModelSpec.OutputProperties = outputPropsPB
 
+	r.ModelSpec.Tests = tests
+
 	return nil
 }
 
+// parseModelTests parses and validates the tests defined in a model's "tests" property.
+func parseModelTests(raw []*ModelTestYAML) ([]*runtimev1.ModelTest, error) {
+	var res []*runtimev1.ModelTest
+	names := make(map[string]bool)
+	for i, tmp := range raw {
+		if tmp == nil {
+			return nil, fmt.Errorf("invalid test at index %d: empty definition", i)
+		}
+
+		test := &runtimev1.ModelTest{}
+		n := 0
+		if tmp.NotNull != "" {
+			test.Type = runtimev1.ModelTest_TYPE_NOT_NULL
+			test.Column = tmp.NotNull
+			test.Name = "not_null_" + tmp.NotNull
+			n++
+		}
+		if tmp.Unique != "" {
+			test.Type = runtimev1.ModelTest_TYPE_UNIQUE
+			test.Column = tmp.Unique
+			test.Name = "unique_" + tmp.Unique
+			n++
+		}
+		if tmp.AcceptedValues != nil {
+			if tmp.AcceptedValues.Column == "" {
+				return nil, fmt.Errorf(`invalid test at index %d: "accepted_values" must specify a "column"`, i)
+			}
+			if len(tmp.AcceptedValues.Values) == 0 {
+				return nil, fmt.Errorf(`invalid test at index %d: "accepted_values" must specify at least one value`, i)
+			}
+			for _, v := range tmp.AcceptedValues.Values {
+				pv, err := structpb.NewValue(v)
+				if err != nil {
+					return nil, fmt.Errorf(`invalid test at index %d: invalid value in "accepted_values": %w`, i, err)
+				}
+				test.AcceptedValues = append(test.AcceptedValues, pv)
+			}
+			test.Type = runtimev1.ModelTest_TYPE_ACCEPTED_VALUES
+			test.Column = tmp.AcceptedValues.Column
+			test.Name = "accepted_values_" + tmp.AcceptedValues.Column
+			n++
+		}
+		if tmp.RowCount != nil {
+			if tmp.RowCount.Min == nil && tmp.RowCount.Max == nil {
+				return nil, fmt.Errorf(`invalid test at index %d: "row_count" must specify "min" or "max"`, i)
+			}
+			if tmp.RowCount.Min != nil && tmp.RowCount.Max != nil && *tmp.RowCount.Min > *tmp.RowCount.Max {
+				return nil, fmt.Errorf(`invalid test at index %d: "row_count" has "min" greater than "max"`, i)
+			}
+			test.Type = runtimev1.ModelTest_TYPE_ROW_COUNT
+			test.MinRows = tmp.RowCount.Min
+			test.MaxRows = tmp.RowCount.Max
+			test.Name = "row_count"
+			n++
+		}
+		if tmp.SQL != "" {
+			if tmp.Name == "" {
+				return nil, fmt.Errorf(`invalid test at index %d: "sql" tests must specify a "name"`, i)
+			}
+			test.Type = runtimev1.ModelTest_TYPE_SQL
+			test.Sql = strings.TrimSpace(tmp.SQL)
+			n++
+		}
+		if n != 1 {
+			return nil, fmt.Errorf(`invalid test at index %d: must specify exactly one of "not_null", "unique", "accepted_values", "row_count" or "sql"`, i)
+		}
+
+		if tmp.Name != "" {
+			test.Name = tmp.Name
+		}
+		if names[test.Name] {
+			return nil, fmt.Errorf("invalid test at index %d: duplicate test name %q", i, test.Name)
+		}
+		names[test.Name] = true
+
+		switch strings.ToLower(tmp.Severity) {
+		case "", "error":
+			test.Severity = runtimev1.ModelTest_SEVERITY_ERROR
+		case "warn", "warning":
+			test.Severity = runtimev1.ModelTest_SEVERITY_WARN
+		default:
+			return nil, fmt.Errorf(`invalid test at index %d: invalid severity %q (expected "error" or "warn")`, i, tmp.Severity)
+		}
+
+		res = append(res, test)
+	}
+	return res, nil
+}
+
 // inferSQLRefs attempts to infer table references from the node's SQL.
 // The provided node must have a non-empty SQL field.
 func (p *Parser) inferSQLRefs(node *Node) ([]ResourceName, error) {
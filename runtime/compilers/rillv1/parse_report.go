@@ -10,6 +10,7 @@ import (
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime/drivers/slack"
+	"github.com/rilldata/rill/runtime/drivers/webhook"
 	"github.com/rilldata/rill/runtime/pkg/pbutil"
 	"google.golang.org/protobuf/types/known/structpb"
 )
@@ -40,6 +41,7 @@ type ReportYAML struct {
 	} `yaml:"email"`
 	Notify struct {
 		Email struct {
+			Connector  string   `yaml:"connector"`
 			Recipients []string `yaml:"recipients"`
 		} `yaml:"email"`
 		Slack struct {
@@ -47,6 +49,11 @@ type ReportYAML struct {
 			Channels []string `yaml:"channels"`
 			Webhooks []string `yaml:"webhooks"`
 		} `yaml:"slack"`
+		Webhook struct {
+			Connector string            `yaml:"connector"`
+			URLs      []string          `yaml:"urls"`
+			Headers   map[string]string `yaml:"headers"`
+		} `yaml:"webhook"`
 	} `yaml:"notify"`
 	Annotations map[string]string `yaml:"annotations"`
 }
@@ -153,7 +160,7 @@ func (p *Parser) parseReport(node *Node) error {
 		}
 	} else {
 		if len(tmp.Notify.Email.Recipients) == 0 && len(tmp.Notify.Slack.Channels) == 0 &&
-			len(tmp.Notify.Slack.Users) == 0 && len(tmp.Notify.Slack.Webhooks) == 0 {
+			len(tmp.Notify.Slack.Users) == 0 && len(tmp.Notify.Slack.Webhooks) == 0 && len(tmp.Notify.Webhook.URLs) == 0 {
 			return fmt.Errorf(`missing notification recipients`)
 		}
 		for _, email := range tmp.Notify.Email.Recipients {
@@ -168,6 +175,10 @@ func (p *Parser) parseReport(node *Node) error {
 				return fmt.Errorf("invalid recipient email address %q", email)
 			}
 		}
+		err = validateWebhook(tmp.Notify.Webhook.URLs, tmp.Notify.Webhook.Headers)
+		if err != nil {
+			return err
+		}
 	}
 
 	// Track report
@@ -217,8 +228,12 @@ func (p *Parser) parseReport(node *Node) error {
 			if err != nil {
 				return fmt.Errorf("encountered invalid property type: %w", err)
 			}
+			connector := tmp.Notify.Email.Connector
+			if connector == "" {
+				connector = "email"
+			}
 			r.ReportSpec.Notifiers = append(r.ReportSpec.Notifiers, &runtimev1.Notifier{
-				Connector:  "email",
+				Connector:  connector,
 				Properties: props,
 			})
 		}
@@ -233,6 +248,21 @@ func (p *Parser) parseReport(node *Node) error {
 				Properties: props,
 			})
 		}
+		// Webhook settings
+		if len(tmp.Notify.Webhook.URLs) > 0 {
+			props, err := structpb.NewStruct(webhook.EncodeProps(tmp.Notify.Webhook.URLs, tmp.Notify.Webhook.Headers))
+			if err != nil {
+				return fmt.Errorf("encountered invalid property type: %w", err)
+			}
+			connector := tmp.Notify.Webhook.Connector
+			if connector == "" {
+				connector = "webhook"
+			}
+			r.ReportSpec.Notifiers = append(r.ReportSpec.Notifiers, &runtimev1.Notifier{
+				Connector:  connector,
+				Properties: props,
+			})
+		}
 	}
 
 	r.ReportSpec.Annotations = tmp.Annotations
@@ -250,6 +280,10 @@ func parseExportFormat(s string) (runtimev1.ExportFormat, error) {
 		return runtimev1.ExportFormat_EXPORT_FORMAT_XLSX, nil
 	case "parquet":
 		return runtimev1.ExportFormat_EXPORT_FORMAT_PARQUET, nil
+	case "jsonl":
+		return runtimev1.ExportFormat_EXPORT_FORMAT_JSONL, nil
+	case "arrow":
+		return runtimev1.ExportFormat_EXPORT_FORMAT_ARROW, nil
 	default:
 		if val, ok := runtimev1.ExportFormat_value[s]; ok {
 			return runtimev1.ExportFormat(val), nil
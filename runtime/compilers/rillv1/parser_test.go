@@ -943,6 +943,139 @@ SELECT * FROM t2
 	requireResourcesAndErrors(t, p, resources, nil)
 }
 
+func TestModelTests(t *testing.T) {
+	ctx := context.Background()
+
+	files := map[string]string{
+		`rill.yaml`: ``,
+		`models/m1.yaml`: `
+type: model
+sql: SELECT * FROM t1
+tests:
+  - not_null: id
+  - unique: id
+    severity: warn
+  - accepted_values:
+      column: status
+      values: [open, closed]
+  - row_count:
+      min: 1
+  - name: positive_amount
+    sql: SELECT * FROM m1 WHERE amount < 0
+`,
+		`models/m2.yaml`: `
+type: model
+sql: SELECT * FROM t2
+tests:
+  - not_null: id
+    unique: id
+`,
+		`models/m3.yaml`: `
+type: model
+sql: SELECT * FROM t3
+tests:
+  - sql: SELECT * FROM m3 WHERE amount < 0
+`,
+	}
+
+	min := uint64(1)
+	resources := []*Resource{
+		{
+			Name:  ResourceName{Kind: ResourceKindModel, Name: "m1"},
+			Paths: []string{"/models/m1.yaml"},
+			ModelSpec: &runtimev1.ModelSpec{
+				RefreshSchedule: &runtimev1.Schedule{RefUpdate: true},
+				InputConnector:  "duckdb",
+				InputProperties: must(structpb.NewStruct(map[string]any{"sql": "SELECT * FROM t1"})),
+				OutputConnector: "duckdb",
+				Tests: []*runtimev1.ModelTest{
+					{Name: "not_null_id", Type: runtimev1.ModelTest_TYPE_NOT_NULL, Severity: runtimev1.ModelTest_SEVERITY_ERROR, Column: "id"},
+					{Name: "unique_id", Type: runtimev1.ModelTest_TYPE_UNIQUE, Severity: runtimev1.ModelTest_SEVERITY_WARN, Column: "id"},
+					{Name: "accepted_values_status", Type: runtimev1.ModelTest_TYPE_ACCEPTED_VALUES, Severity: runtimev1.ModelTest_SEVERITY_ERROR, Column: "status", AcceptedValues: []*structpb.Value{structpb.NewStringValue("open"), structpb.NewStringValue("closed")}},
+					{Name: "row_count", Type: runtimev1.ModelTest_TYPE_ROW_COUNT, Severity: runtimev1.ModelTest_SEVERITY_ERROR, MinRows: &min},
+					{Name: "positive_amount", Type: runtimev1.ModelTest_TYPE_SQL, Severity: runtimev1.ModelTest_SEVERITY_ERROR, Sql: "SELECT * FROM m1 WHERE amount < 0"},
+				},
+			},
+		},
+	}
+
+	errors := []*runtimev1.ParseError{
+		{
+			Message:  `must specify exactly one of "not_null", "unique", "accepted_values", "row_count" or "sql"`,
+			FilePath: "/models/m2.yaml",
+		},
+		{
+			Message:  `"sql" tests must specify a "name"`,
+			FilePath: "/models/m3.yaml",
+		},
+	}
+
+	repo := makeRepo(t, files)
+	p, err := Parse(ctx, repo, "", "", "duckdb")
+	require.NoError(t, err)
+	requireResourcesAndErrors(t, p, resources, errors)
+}
+
+func TestModelPartitions(t *testing.T) {
+	ctx := context.Background()
+
+	files := map[string]string{
+		`rill.yaml`: ``,
+		`models/m1.yaml`: `
+type: model
+incremental: true
+partitions:
+  sql: SELECT range AS num, now() AS updated_on FROM range(0, 10)
+partitions_watermark: updated_on
+sql: SELECT {{ .partition.num }} AS num
+`,
+		`models/m2.yaml`: `
+type: model
+sql: SELECT 1
+partitions_watermark: updated_on
+`,
+		`models/m3.yaml`: `
+type: model
+partitions:
+  sql: SELECT range AS num FROM range(0, 10)
+sql: SELECT {{ .partition.num }} AS num
+`,
+	}
+
+	resources := []*Resource{
+		{
+			Name:  ResourceName{Kind: ResourceKindModel, Name: "m1"},
+			Paths: []string{"/models/m1.yaml"},
+			ModelSpec: &runtimev1.ModelSpec{
+				RefreshSchedule:              &runtimev1.Schedule{RefUpdate: true},
+				Incremental:                  true,
+				PartitionsResolver:           "sql",
+				PartitionsResolverProperties: must(structpb.NewStruct(map[string]any{"sql": "SELECT range AS num, now() AS updated_on FROM range(0, 10)", "connector": ""})),
+				PartitionsWatermarkField:     "updated_on",
+				InputConnector:               "duckdb",
+				InputProperties:              must(structpb.NewStruct(map[string]any{"sql": "SELECT {{ .partition.num }} AS num"})),
+				OutputConnector:              "duckdb",
+			},
+		},
+	}
+
+	errors := []*runtimev1.ParseError{
+		{
+			Message:  `"partitions_watermark" can only be set when "partitions" is configured`,
+			FilePath: "/models/m2.yaml",
+		},
+		{
+			Message:  `"partitions" can only be set when "incremental" is true`,
+			FilePath: "/models/m3.yaml",
+		},
+	}
+
+	repo := makeRepo(t, files)
+	p, err := Parse(ctx, repo, "", "", "duckdb")
+	require.NoError(t, err)
+	requireResourcesAndErrors(t, p, resources, errors)
+}
+
 func TestProjectDashboardDefaults(t *testing.T) {
 	ctx := context.Background()
 	repo := makeRepo(t, map[string]string{
@@ -1155,6 +1288,62 @@ notify:
 
 annotations:
   foo: bar
+`,
+		`reports/r3.yaml`: `
+type: report
+title: My Report
+
+refresh:
+  cron: 0 * * * *
+
+query:
+  name: MetricsViewToplist
+  args:
+    metrics_view: mv1
+
+export:
+  format: csv
+
+notify:
+  email:
+    connector: smtp_relay
+    recipients:
+      - user_1@example.com
+  webhook:
+    urls:
+      - https://example.com/hook
+    headers:
+      X-Team: data
+`,
+		`reports/r4.yaml`: `
+type: report
+title: My Report
+refresh:
+  cron: 0 * * * *
+query:
+  name: MetricsViewToplist
+export:
+  format: csv
+notify:
+  webhook:
+    urls:
+      - https://example.com/hook
+    headers:
+      Authorization: Bearer token
+`,
+		`reports/r5.yaml`: `
+type: report
+title: My Report
+refresh:
+  cron: 0 * * * *
+query:
+  name: MetricsViewToplist
+export:
+  format: csv
+notify:
+  webhook:
+    urls:
+      - http://169.254.169.254/latest/meta-data
 `,
 	})
 
@@ -1207,11 +1396,38 @@ annotations:
 				IntervalsLimit:       10,
 			},
 		},
+		{
+			Name:  ResourceName{Kind: ResourceKindReport, Name: "r3"},
+			Paths: []string{"/reports/r3.yaml"},
+			ReportSpec: &runtimev1.ReportSpec{
+				Title: "My Report",
+				RefreshSchedule: &runtimev1.Schedule{
+					RefUpdate: true,
+					Cron:      "0 * * * *",
+				},
+				QueryName:     "MetricsViewToplist",
+				QueryArgsJson: `{"metrics_view":"mv1"}`,
+				ExportFormat:  runtimev1.ExportFormat_EXPORT_FORMAT_CSV,
+				Notifiers: []*runtimev1.Notifier{
+					{Connector: "smtp_relay", Properties: must(structpb.NewStruct(map[string]any{"recipients": []any{"user_1@example.com"}}))},
+					{Connector: "webhook", Properties: must(structpb.NewStruct(map[string]any{"urls": []any{"https://example.com/hook"}, "headers": map[string]any{"X-Team": "data"}}))},
+				},
+			},
+		},
 	}
 
 	p, err := Parse(ctx, repo, "", "", "duckdb")
 	require.NoError(t, err)
-	requireResourcesAndErrors(t, p, resources, nil)
+	requireResourcesAndErrors(t, p, resources, []*runtimev1.ParseError{
+		{
+			Message:  `webhook header "Authorization" may contain credentials: set it in the webhook connector's "headers" property instead`,
+			FilePath: "/reports/r4.yaml",
+		},
+		{
+			Message:  `invalid webhook URL "http://169.254.169.254/latest/meta-data": must not target a local or private address`,
+			FilePath: "/reports/r5.yaml",
+		},
+	})
 }
 
 func TestAlert(t *testing.T) {
@@ -1300,6 +1516,117 @@ annotations:
 	requireResourcesAndErrors(t, p, resources, nil)
 }
 
+func TestAlertCondition(t *testing.T) {
+	ctx := context.Background()
+	repo := makeRepo(t, map[string]string{
+		`rill.yaml`: ``,
+		`alerts/a1.yaml`: `
+type: alert
+condition:
+  metrics_view: mv1
+  measure: revenue
+  dimension: country
+  window: P1D
+  operator: gt
+  value: 1000
+notify:
+  email:
+    recipients:
+      - benjamin@example.com
+`,
+		`alerts/a2.yaml`: `
+type: alert
+condition:
+  metrics_view: mv1
+  measure: revenue
+  dimension: country
+  window: P1W
+  compare_to: previous_period
+  operator: lt
+  value: -20
+notify:
+  email:
+    recipients:
+      - benjamin@example.com
+`,
+		`alerts/a3.yaml`: `
+type: alert
+condition:
+  metrics_view: mv1
+  measure: revenue
+  window: P1W
+  compare_to: previous_period
+  operator: lt
+  value: -20
+notify:
+  email:
+    recipients:
+      - benjamin@example.com
+`,
+		`alerts/a4.yaml`: `
+type: alert
+condition:
+  metrics_view: mv1
+  measure: revenue
+  operator: gt
+  value: 1000
+query:
+  name: MetricsViewAggregation
+`,
+	})
+
+	notifiers := []*runtimev1.Notifier{{Connector: "email", Properties: must(structpb.NewStruct(map[string]any{"recipients": []any{"benjamin@example.com"}}))}}
+	resources := []*Resource{
+		{
+			Name:  ResourceName{Kind: ResourceKindAlert, Name: "a1"},
+			Paths: []string{"/alerts/a1.yaml"},
+			Refs:  []ResourceName{{Kind: ResourceKindMetricsView, Name: "mv1"}},
+			AlertSpec: &runtimev1.AlertSpec{
+				RefreshSchedule: &runtimev1.Schedule{RefUpdate: true},
+				QueryName:       "MetricsViewAggregation",
+				QueryArgsJson:   `{"dimensions":[{"name":"country"}],"having":{"cond":{"exprs":[{"ident":"revenue"},{"val":1000}],"op":"OPERATION_GT"}},"limit":1,"measures":[{"name":"revenue"}],"metrics_view":"mv1","sort":[{"desc":true,"name":"revenue"}],"time_range":{"iso_duration":"P1D"}}`,
+				NotifyOnFail:    true,
+				Notifiers:       notifiers,
+			},
+		},
+		{
+			Name:  ResourceName{Kind: ResourceKindAlert, Name: "a2"},
+			Paths: []string{"/alerts/a2.yaml"},
+			Refs:  []ResourceName{{Kind: ResourceKindMetricsView, Name: "mv1"}},
+			AlertSpec: &runtimev1.AlertSpec{
+				RefreshSchedule: &runtimev1.Schedule{RefUpdate: true},
+				QueryName:       "MetricsViewComparison",
+				QueryArgsJson:   `{"aliases":[{"alias":"revenue__delta_rel","name":"revenue","type":"METRICS_VIEW_COMPARISON_MEASURE_TYPE_REL_DELTA"}],"comparison_measures":["revenue"],"comparison_time_range":{"iso_duration":"P1W","iso_offset":"P1W"},"dimension":{"name":"country"},"having":{"cond":{"exprs":[{"ident":"revenue__delta_rel"},{"val":-0.2}],"op":"OPERATION_LT"}},"limit":1,"measures":[{"name":"revenue"}],"metrics_view_name":"mv1","sort":[{"desc":false,"name":"revenue","sort_type":"METRICS_VIEW_COMPARISON_MEASURE_TYPE_REL_DELTA"}],"time_range":{"iso_duration":"P1W"}}`,
+				NotifyOnFail:    true,
+				Notifiers:       notifiers,
+			},
+		},
+		{
+			Name:  ResourceName{Kind: ResourceKindAlert, Name: "a3"},
+			Paths: []string{"/alerts/a3.yaml"},
+			Refs:  []ResourceName{{Kind: ResourceKindMetricsView, Name: "mv1"}},
+			AlertSpec: &runtimev1.AlertSpec{
+				RefreshSchedule: &runtimev1.Schedule{RefUpdate: true},
+				QueryName:       "MetricsViewComparison",
+				QueryArgsJson:   `{"aliases":[{"alias":"revenue__delta_rel","name":"revenue","type":"METRICS_VIEW_COMPARISON_MEASURE_TYPE_REL_DELTA"}],"comparison_measures":["revenue"],"comparison_time_range":{"iso_duration":"P1W","iso_offset":"P1W"},"having":{"cond":{"exprs":[{"ident":"revenue__delta_rel"},{"val":-0.2}],"op":"OPERATION_LT"}},"limit":1,"measures":[{"name":"revenue"}],"metrics_view_name":"mv1","sort":[{"desc":false,"name":"revenue","sort_type":"METRICS_VIEW_COMPARISON_MEASURE_TYPE_REL_DELTA"}],"time_range":{"iso_duration":"P1W"}}`,
+				NotifyOnFail:    true,
+				Notifiers:       notifiers,
+			},
+		},
+	}
+
+	errors := []*runtimev1.ParseError{
+		{
+			Message:  `cannot set both "condition" and "query.name", "query.args" or "query.args_json"`,
+			FilePath: "/alerts/a4.yaml",
+		},
+	}
+
+	p, err := Parse(ctx, repo, "", "", "duckdb")
+	require.NoError(t, err)
+	requireResourcesAndErrors(t, p, resources, errors)
+}
+
 func TestMetricsViewAvoidSelfCyclicRef(t *testing.T) {
 	ctx := context.Background()
 	repo := makeRepo(t, map[string]string{
@@ -1339,6 +1666,123 @@ measures:
 	requireResourcesAndErrors(t, p, resources, nil)
 }
 
+func TestMetricsViewMeasureTypes(t *testing.T) {
+	ctx := context.Background()
+	repo := makeRepo(t, map[string]string{
+		`rill.yaml`: ``,
+		`dashboards/d1.yaml`: `
+model: d1
+timeseries: t
+dimensions:
+  - name: a
+    column: a
+measures:
+  - name: revenue
+    expression: sum(price)
+  - name: orders
+    expression: count(*)
+  - name: aov
+    type: derived
+    expression: revenue / nullif("orders", 0)
+  - name: aov_pct
+    type: derived
+    expression: aov * 100 + sum(discount) - 'revenue'
+  - name: discount
+    expression: sum(discount)
+  - name: net
+    type: derived
+    expression: revenue - discount - sum(discount) FILTER (WHERE revenue > 0)
+  - name: revenue_running
+    type: running_total
+    measure: revenue
+  - name: aov_7d
+    type: moving_average
+    measure: aov
+    window: 7
+  - name: revenue_share
+    type: share_of_parent
+    measure: revenue
+  - name: revenue_per_distinct_order
+    type: derived
+    expression: revenue / APPROX_COUNT_DISTINCT_DS_HLL(orders) + uniq(revenue)
+`,
+		`dashboards/d2.yaml`: `
+model: d2
+measures:
+  - name: x
+    type: derived
+    expression: y + 1
+  - name: y
+    type: derived
+    expression: x * 2
+`,
+		`dashboards/d3.yaml`: `
+model: d3
+measures:
+  - name: x
+    expression: count(*)
+  - name: y
+    type: running_total
+    measure: z
+`,
+		`dashboards/d4.yaml`: `
+model: d4
+measures:
+  - name: x
+    expression: count(*)
+  - name: y
+    type: moving_average
+    measure: x
+`,
+	})
+
+	resources := []*Resource{
+		{
+			Name:  ResourceName{Kind: ResourceKindMetricsView, Name: "d1"},
+			Paths: []string{"/dashboards/d1.yaml"},
+			MetricsViewSpec: &runtimev1.MetricsViewSpec{
+				Connector:     "duckdb",
+				Table:         "d1",
+				TimeDimension: "t",
+				Dimensions: []*runtimev1.MetricsViewSpec_DimensionV2{
+					{Name: "a", Column: "a"},
+				},
+				Measures: []*runtimev1.MetricsViewSpec_MeasureV2{
+					{Name: "revenue", Expression: "sum(price)"},
+					{Name: "orders", Expression: "count(*)"},
+					{Name: "aov", Expression: "(sum(price)) / nullif((count(*)), 0)", Type: runtimev1.MetricsViewSpec_MEASURE_TYPE_DERIVED, ReferencedMeasures: []string{"revenue", "orders"}},
+					{Name: "aov_pct", Expression: "((sum(price)) / nullif((count(*)), 0)) * 100 + sum(discount) - 'revenue'", Type: runtimev1.MetricsViewSpec_MEASURE_TYPE_DERIVED, ReferencedMeasures: []string{"aov"}},
+					{Name: "discount", Expression: "sum(discount)"},
+					{Name: "net", Expression: "(sum(price)) - (sum(discount)) - sum(discount) FILTER (WHERE revenue > 0)", Type: runtimev1.MetricsViewSpec_MEASURE_TYPE_DERIVED, ReferencedMeasures: []string{"revenue", "discount"}},
+					{Name: "revenue_running", Type: runtimev1.MetricsViewSpec_MEASURE_TYPE_RUNNING_TOTAL, ReferencedMeasures: []string{"revenue"}},
+					{Name: "aov_7d", Type: runtimev1.MetricsViewSpec_MEASURE_TYPE_MOVING_AVERAGE, ReferencedMeasures: []string{"aov"}, Window: 7},
+					{Name: "revenue_share", Type: runtimev1.MetricsViewSpec_MEASURE_TYPE_SHARE_OF_PARENT, ReferencedMeasures: []string{"revenue"}},
+					{Name: "revenue_per_distinct_order", Expression: "(sum(price)) / APPROX_COUNT_DISTINCT_DS_HLL(orders) + uniq(revenue)", Type: runtimev1.MetricsViewSpec_MEASURE_TYPE_DERIVED, ReferencedMeasures: []string{"revenue"}},
+				},
+			},
+		},
+	}
+
+	errors := []*runtimev1.ParseError{
+		{
+			Message:  `measure "x" has a circular reference`,
+			FilePath: "/dashboards/d2.yaml",
+		},
+		{
+			Message:  `measure "z" referenced by measure "y" not found`,
+			FilePath: "/dashboards/d3.yaml",
+		},
+		{
+			Message:  `moving average measure "y" must set a "window" greater than zero`,
+			FilePath: "/dashboards/d4.yaml",
+		},
+	}
+
+	p, err := Parse(ctx, repo, "", "", "duckdb")
+	require.NoError(t, err)
+	requireResourcesAndErrors(t, p, resources, errors)
+}
+
 func TestTheme(t *testing.T) {
 	ctx := context.Background()
 	repo := makeRepo(t, map[string]string{
@@ -1497,6 +1941,34 @@ sql: select * from m1
 		`apis/a2.yaml`: `
 type: api
 metrics_sql: select * from m1
+streaming: true
+`,
+		// api a3
+		`apis/a3.yaml`: `
+type: api
+description: Bids by country
+sql: select * from m1 where country = '{{ .args.country }}'
+arguments:
+  - name: country
+    required: true
+    pattern: "^[A-Z]{2}$"
+  - name: min_price
+    type: number
+    default: 0
+    minimum: 0
+  - name: sort
+    allowed_values: [asc, desc]
+pagination:
+  mode: cursor
+  cursor_column: id
+  max_page_size: 1000
+`,
+		// api a4
+		`apis/a4.yaml`: `
+type: api
+sql: select * from m1
+pagination:
+  order_by: [country, id DESC]
 `,
 	})
 
@@ -1525,6 +1997,41 @@ metrics_sql: select * from m1
 			APISpec: &runtimev1.APISpec{
 				Resolver:           "metrics_sql",
 				ResolverProperties: must(structpb.NewStruct(map[string]any{"sql": "select * from m1"})),
+				Streaming:          true,
+			},
+		},
+		{
+			Name:  ResourceName{Kind: ResourceKindAPI, Name: "a3"},
+			Paths: []string{"/apis/a3.yaml"},
+			APISpec: &runtimev1.APISpec{
+				Resolver:           "sql",
+				ResolverProperties: must(structpb.NewStruct(map[string]any{"sql": "select * from m1 where country = '{{ .args.country }}'"})),
+				Description:        "Bids by country",
+				Arguments: []*runtimev1.APIArgument{
+					{Name: "country", Type: runtimev1.APIArgument_TYPE_STRING, Required: true, Pattern: "^[A-Z]{2}$"},
+					{Name: "min_price", Type: runtimev1.APIArgument_TYPE_NUMBER, DefaultValue: structpb.NewNumberValue(0), Minimum: asPtr(0.0)},
+					{Name: "sort", Type: runtimev1.APIArgument_TYPE_STRING, AllowedValues: []*structpb.Value{structpb.NewStringValue("asc"), structpb.NewStringValue("desc")}},
+				},
+				Pagination: &runtimev1.APIPagination{
+					Mode:         runtimev1.APIPagination_MODE_CURSOR,
+					MaxPageSize:  1000,
+					CursorColumn: "id",
+				},
+			},
+		},
+		{
+			Name:  ResourceName{Kind: ResourceKindAPI, Name: "a4"},
+			Paths: []string{"/apis/a4.yaml"},
+			APISpec: &runtimev1.APISpec{
+				Resolver:           "sql",
+				ResolverProperties: must(structpb.NewStruct(map[string]any{"sql": "select * from m1"})),
+				Pagination: &runtimev1.APIPagination{
+					Mode: runtimev1.APIPagination_MODE_OFFSET,
+					OrderBy: []*runtimev1.APIPagination_Sort{
+						{Column: "country"},
+						{Column: "id", Desc: true},
+					},
+				},
 			},
 		},
 	}
@@ -1534,6 +2041,88 @@ metrics_sql: select * from m1
 	requireResourcesAndErrors(t, p, resources, nil)
 }
 
+func TestAPIErrors(t *testing.T) {
+	ctx := context.Background()
+	repo := makeRepo(t, map[string]string{
+		`rill.yaml`: ``,
+		`apis/a1.yaml`: `
+type: api
+sql: select 1
+arguments:
+  - name: limit
+    type: integer
+    default: ten
+`,
+		`apis/a2.yaml`: `
+type: api
+sql: select 1
+arguments:
+  - name: page_size
+    type: integer
+pagination:
+  mode: offset
+  order_by: [id]
+`,
+		`apis/a3.yaml`: `
+type: api
+sql: select 1
+pagination:
+  mode: cursor
+`,
+		`apis/a4.yaml`: `
+type: api
+sql: select 1
+streaming: true
+pagination:
+  mode: offset
+  order_by: [id]
+`,
+		`apis/a5.yaml`: `
+type: api
+sql: select 1
+pagination:
+  mode: offset
+`,
+		`apis/a6.yaml`: `
+type: api
+sql: select 1
+pagination:
+  order_by: [id descending]
+`,
+	})
+
+	errors := []*runtimev1.ParseError{
+		{
+			Message:  `invalid argument "limit": "default" does not match the argument's type`,
+			FilePath: "/apis/a1.yaml",
+		},
+		{
+			Message:  `invalid argument at index 0: the name "page_size" is reserved for pagination`,
+			FilePath: "/apis/a2.yaml",
+		},
+		{
+			Message:  `"pagination" must specify a "cursor_column" in cursor mode`,
+			FilePath: "/apis/a3.yaml",
+		},
+		{
+			Message:  `"streaming" can't be used together with "pagination"`,
+			FilePath: "/apis/a4.yaml",
+		},
+		{
+			Message:  `"pagination" must specify "order_by" in offset mode`,
+			FilePath: "/apis/a5.yaml",
+		},
+		{
+			Message:  `invalid "order_by" entry "id descending" (expected a column name optionally followed by "asc" or "desc")`,
+			FilePath: "/apis/a6.yaml",
+		},
+	}
+
+	p, err := Parse(ctx, repo, "", "", "duckdb")
+	require.NoError(t, err)
+	requireResourcesAndErrors(t, p, nil, errors)
+}
+
 func TestKindBackwardsCompatibility(t *testing.T) {
 	files := map[string]string{
 		// rill.yaml
@@ -2,6 +2,7 @@ package runtime
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"maps"
 	"path/filepath"
@@ -9,6 +10,7 @@ import (
 	"strings"
 
 	"github.com/rilldata/rill/runtime/drivers"
+	emaildriver "github.com/rilldata/rill/runtime/drivers/email"
 )
 
 var ErrAdminNotConfigured = fmt.Errorf("an admin service is not configured for this instance")
@@ -182,6 +184,27 @@ func (r *Runtime) Catalog(ctx context.Context, instanceID string) (drivers.Catal
 	return store, release, nil
 }
 
+// Notifier returns a notifier for the given connector, configured with the given notifier properties.
+// If the connector is not configured to send notifications itself (e.g. an email connector without an SMTP server),
+// it falls back to a notifier that uses the runtime's default email client.
+func (r *Runtime) Notifier(ctx context.Context, instanceID, connector string, props map[string]any) (drivers.Notifier, func(), error) {
+	conn, release, err := r.AcquireHandle(ctx, instanceID, connector)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	n, err := conn.AsNotifier(props)
+	if errors.Is(err, drivers.ErrNotifierNotConfigured) && conn.Driver() == "email" {
+		n, err = emaildriver.NewNotifier(r.Email, props)
+	}
+	if err != nil {
+		release()
+		return nil, nil, err
+	}
+
+	return n, release, nil
+}
+
 func (r *Runtime) ConnectorConfig(ctx context.Context, instanceID, name string) (*ConnectorConfig, error) {
 	inst, err := r.Instance(ctx, instanceID)
 	if err != nil {
@@ -6,12 +6,16 @@ import (
 	"testing"
 	"time"
 
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime"
 	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/rilldata/rill/runtime/testruntime"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/types/known/structpb"
+
+	// Load the resolvers used by partitioned models
+	_ "github.com/rilldata/rill/runtime/resolvers"
 )
 
 func TestComplete(t *testing.T) {
@@ -290,6 +294,72 @@ path: data/foo.csv
 	testruntime.RequireOLAPTableCount(t, rt, id, "foo", 1)
 }
 
+func TestStreamSourceFromLatest(t *testing.T) {
+	cluster, err := kafka.NewMockCluster(1)
+	require.NoError(t, err)
+	defer cluster.Close()
+
+	topic := "events"
+	producer, err := kafka.NewProducer(&kafka.ConfigMap{"bootstrap.servers": cluster.BootstrapServers()})
+	require.NoError(t, err)
+	defer producer.Close()
+	_, err = producer.GetMetadata(&topic, false, 10000)
+	require.NoError(t, err)
+
+	produce := func(ids ...int) {
+		delivered := make(chan kafka.Event, len(ids))
+		for _, id := range ids {
+			err := producer.Produce(&kafka.Message{
+				TopicPartition: kafka.TopicPartition{Topic: &topic, Partition: 0},
+				Value:          []byte(fmt.Sprintf(`{"id": %d}`, id)),
+			}, delivered)
+			require.NoError(t, err)
+		}
+		for range ids {
+			msg := (<-delivered).(*kafka.Message)
+			require.NoError(t, msg.TopicPartition.Error)
+		}
+	}
+
+	// Records published before the source is created are skipped
+	produce(0, 1)
+
+	rt, id := testruntime.NewInstanceWithOptions(t, testruntime.InstanceOptions{
+		Files: map[string]string{
+			"rill.yaml": fmt.Sprintf(`
+connectors:
+- name: kafka
+  type: kafka
+  defaults:
+    brokers: %q
+`, cluster.BootstrapServers()),
+			"sources/events.yaml": `
+type: source
+connector: kafka
+topic: events
+start_offset: latest
+`,
+		},
+	})
+	testruntime.ReconcileParserAndWait(t, rt, id)
+	testruntime.RequireReconcileState(t, rt, id, 2, 0, 0)
+
+	// The first batch has no records, so no table is created yet
+	testruntime.RequireNoOLAPTable(t, rt, id, "events")
+
+	// The next batch resumes from the offsets of the first batch instead of from the latest offsets
+	produce(2, 3, 4)
+	testruntime.RefreshAndWait(t, rt, id, &runtimev1.ResourceName{Kind: runtime.ResourceKindSource, Name: "events"})
+	testruntime.RequireReconcileState(t, rt, id, 2, 0, 0)
+	testruntime.RequireOLAPTableCount(t, rt, id, "events", 3)
+
+	// Later batches append to the table
+	produce(5)
+	testruntime.RefreshAndWait(t, rt, id, &runtimev1.ResourceName{Kind: runtime.ResourceKindSource, Name: "events"})
+	testruntime.RequireReconcileState(t, rt, id, 2, 0, 0)
+	testruntime.RequireOLAPTableCount(t, rt, id, "events", 4)
+}
+
 func TestSimultaneousDeleteRenameCreate(t *testing.T) {
 	// Add bar and foo
 	rt, id := testruntime.NewInstance(t)
@@ -1020,6 +1090,181 @@ path: data/bar.csv
 	testruntime.RequireResource(t, rt, id, metricsRes)
 }
 
+func TestModelTests(t *testing.T) {
+	rt, id := testruntime.NewInstanceWithOptions(t, testruntime.InstanceOptions{
+		Files:        map[string]string{"rill.yaml": ""},
+		StageChanges: true,
+	})
+	testruntime.PutFiles(t, rt, id, map[string]string{
+		"/models/bar.yaml": `
+type: model
+materialize: true
+sql: SELECT * FROM (VALUES (1, 'open'), (2, 'closed')) t(id, status)
+tests:
+  - not_null: id
+  - accepted_values:
+      column: status
+      values: [open, closed]
+  - row_count:
+      min: 3
+    severity: warn
+`,
+	})
+	testruntime.ReconcileParserAndWait(t, rt, id)
+	testruntime.RequireReconcileState(t, rt, id, 2, 0, 0)
+
+	ctrl, err := rt.Controller(context.Background(), id)
+	require.NoError(t, err)
+	name := &runtimev1.ResourceName{Kind: runtime.ResourceKindModel, Name: "bar"}
+
+	// The warning doesn't fail the model
+	r, err := ctrl.Get(context.Background(), name, false)
+	require.NoError(t, err)
+	results := r.GetModel().State.TestResults
+	require.Len(t, results, 3)
+	require.Equal(t, runtimev1.AssertionStatus_ASSERTION_STATUS_PASS, results[0].Status)
+	require.Equal(t, runtimev1.AssertionStatus_ASSERTION_STATUS_PASS, results[1].Status)
+	require.Equal(t, runtimev1.AssertionStatus_ASSERTION_STATUS_FAIL, results[2].Status)
+	require.Equal(t, uint64(2), results[2].FailedRows)
+
+	// A failing test with severity error blocks the new output
+	testruntime.PutFiles(t, rt, id, map[string]string{
+		"/models/bar.yaml": `
+type: model
+materialize: true
+sql: SELECT * FROM (VALUES (1, 'open'), (NULL, 'closed'), (3, 'pending')) t(id, status)
+tests:
+  - not_null: id
+  - accepted_values:
+      column: status
+      values: [open, closed]
+`,
+	})
+	testruntime.ReconcileParserAndWait(t, rt, id)
+	testruntime.RequireReconcileState(t, rt, id, 2, 1, 0)
+
+	r, err = ctrl.Get(context.Background(), name, false)
+	require.NoError(t, err)
+	require.Contains(t, r.Meta.ReconcileError, `model test "not_null_id" failed`)
+	results = r.GetModel().State.TestResults
+	require.Len(t, results, 2)
+	require.Equal(t, runtimev1.AssertionStatus_ASSERTION_STATUS_FAIL, results[0].Status)
+	require.Equal(t, uint64(1), results[0].FailedRows)
+	require.Equal(t, runtimev1.AssertionStatus_ASSERTION_STATUS_FAIL, results[1].Status)
+	require.Equal(t, uint64(1), results[1].FailedRows)
+
+	// The previous output is retained
+	olap, release, err := rt.OLAP(context.Background(), id, "")
+	require.NoError(t, err)
+	defer release()
+	res, err := olap.Execute(context.Background(), &drivers.Statement{Query: "SELECT count(*) FROM bar"})
+	require.NoError(t, err)
+	var n int
+	require.True(t, res.Next())
+	require.NoError(t, res.Scan(&n))
+	require.NoError(t, res.Close())
+	require.Equal(t, 2, n)
+}
+
+func TestModelPartitions(t *testing.T) {
+	rt, id := testruntime.NewInstance(t)
+	testruntime.PutFiles(t, rt, id, map[string]string{
+		"/models/bar.yaml": `
+type: model
+incremental: true
+partitions:
+  sql: SELECT range AS num FROM range(0, 3)
+sql: SELECT {{ .partition.num }} AS num
+`,
+	})
+	testruntime.ReconcileParserAndWait(t, rt, id)
+	testruntime.RequireReconcileState(t, rt, id, 2, 0, 0)
+
+	ctrl, err := rt.Controller(context.Background(), id)
+	require.NoError(t, err)
+	name := &runtimev1.ResourceName{Kind: runtime.ResourceKindModel, Name: "bar"}
+
+	r, err := ctrl.Get(context.Background(), name, false)
+	require.NoError(t, err)
+	partitionsModelID := r.GetModel().State.PartitionsModelId
+	require.NotEmpty(t, partitionsModelID)
+	require.False(t, r.GetModel().State.PartitionsHaveErrors)
+
+	catalog, release, err := rt.Catalog(context.Background(), id)
+	require.NoError(t, err)
+	defer release()
+
+	partitions, err := catalog.FindModelPartitions(context.Background(), &drivers.FindModelPartitionsOptions{ModelID: partitionsModelID})
+	require.NoError(t, err)
+	require.Len(t, partitions, 3)
+	for _, p := range partitions {
+		require.NotNil(t, p.ExecutedOn)
+		require.Empty(t, p.Error)
+	}
+	testruntime.RequireOLAPTableCount(t, rt, id, "bar", 3)
+
+	// Refreshing a specific partition only re-executes that partition (appending its rows again)
+	trgName := &runtimev1.ResourceName{Kind: runtime.ResourceKindRefreshTrigger, Name: "trigger_partitions"}
+	err = ctrl.Create(context.Background(), trgName, nil, nil, nil, true, &runtimev1.Resource{
+		Resource: &runtimev1.Resource_RefreshTrigger{
+			RefreshTrigger: &runtimev1.RefreshTrigger{
+				Spec: &runtimev1.RefreshTriggerSpec{
+					Models: []*runtimev1.RefreshModelTrigger{{Model: "bar", Partitions: []string{partitions[2].Key}}},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.NoError(t, ctrl.WaitUntilIdle(context.Background(), false))
+	testruntime.RequireOLAPTableCount(t, rt, id, "bar", 4)
+
+	// A full refresh resets the model and its partitions
+	trgName = &runtimev1.ResourceName{Kind: runtime.ResourceKindRefreshTrigger, Name: "trigger_full"}
+	err = ctrl.Create(context.Background(), trgName, nil, nil, nil, true, &runtimev1.Resource{
+		Resource: &runtimev1.Resource_RefreshTrigger{
+			RefreshTrigger: &runtimev1.RefreshTrigger{
+				Spec: &runtimev1.RefreshTriggerSpec{
+					Models: []*runtimev1.RefreshModelTrigger{{Model: "bar", Full: true}},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.NoError(t, ctrl.WaitUntilIdle(context.Background(), false))
+	testruntime.RequireOLAPTableCount(t, rt, id, "bar", 3)
+
+	r, err = ctrl.Get(context.Background(), name, false)
+	require.NoError(t, err)
+	require.NotEqual(t, partitionsModelID, r.GetModel().State.PartitionsModelId)
+	require.False(t, r.GetModel().Spec.TriggerFull)
+	partitions, err = catalog.FindModelPartitions(context.Background(), &drivers.FindModelPartitionsOptions{ModelID: partitionsModelID})
+	require.NoError(t, err)
+	require.Len(t, partitions, 0)
+
+	// A failing partition is recorded, but doesn't prevent the other partitions from executing
+	testruntime.PutFiles(t, rt, id, map[string]string{
+		"/models/bar.yaml": `
+type: model
+incremental: true
+partitions:
+  sql: SELECT range AS num FROM range(0, 3)
+sql: SELECT CASE WHEN {{ .partition.num }} = 1 THEN error('boom') ELSE {{ .partition.num }} END AS num
+`,
+	})
+	testruntime.ReconcileParserAndWait(t, rt, id)
+	testruntime.RequireReconcileState(t, rt, id, 2, 1, 0)
+
+	r, err = ctrl.Get(context.Background(), name, false)
+	require.NoError(t, err)
+	require.Contains(t, r.Meta.ReconcileError, "some partitions failed to execute")
+	require.True(t, r.GetModel().State.PartitionsHaveErrors)
+	partitions, err = catalog.FindModelPartitions(context.Background(), &drivers.FindModelPartitionsOptions{ModelID: r.GetModel().State.PartitionsModelId, WhereErrored: true})
+	require.NoError(t, err)
+	require.Len(t, partitions, 1)
+	require.Contains(t, partitions[0].Error, "boom")
+	testruntime.RequireOLAPTableCount(t, rt, id, "bar", 2)
+}
+
 func TestWatch(t *testing.T) {
 	// Create instance with watch
 	// Create source, wait and verify
@@ -194,6 +194,11 @@ func (h *Handle) AsFileStore() (drivers.FileStore, bool) {
 	return nil, false
 }
 
+// AsStreamStore implements drivers.Handle.
+func (h *Handle) AsStreamStore() (drivers.StreamStore, bool) {
+	return nil, false
+}
+
 // AsModelExecutor implements drivers.Handle.
 func (h *Handle) AsModelExecutor(instanceID string, opts *drivers.ModelExecutorOptions) (drivers.ModelExecutor, bool) {
 	return nil, false
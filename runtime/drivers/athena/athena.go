@@ -194,6 +194,11 @@ func (c *Connection) AsFileStore() (drivers.FileStore, bool) {
 	return nil, false
 }
 
+// AsStreamStore implements drivers.Handle.
+func (c *Connection) AsStreamStore() (drivers.StreamStore, bool) {
+	return nil, false
+}
+
 // AsSQLStore implements drivers.Connection.
 func (c *Connection) AsSQLStore() (drivers.SQLStore, bool) {
 	return c, true
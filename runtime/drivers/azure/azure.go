@@ -222,6 +222,11 @@ func (c *Connection) AsFileStore() (drivers.FileStore, bool) {
 	return nil, false
 }
 
+// AsStreamStore implements drivers.Handle.
+func (c *Connection) AsStreamStore() (drivers.StreamStore, bool) {
+	return nil, false
+}
+
 // AsSQLStore implements drivers.Connection.
 func (c *Connection) AsSQLStore() (drivers.SQLStore, bool) {
 	return nil, false
@@ -269,6 +274,7 @@ func (c *Connection) DownloadFiles(ctx context.Context, props map[string]any) (d
 		GlobPageSize:          conf.GlobPageSize,
 		GlobPattern:           conf.url.Path,
 		ExtractPolicy:         conf.extractPolicy,
+		Table:                 conf.tableOptions,
 		BatchSizeBytes:        int64(batchSize.Bytes()),
 		KeepFilesUntilClose:   conf.BatchSize == "-1",
 	}
@@ -317,8 +323,12 @@ type sourceProperties struct {
 	GlobMaxObjectsListed  int64          `mapstructure:"glob.max_objects_listed"`
 	GlobPageSize          int            `mapstructure:"glob.page_size"`
 	BatchSize             string         `mapstructure:"batch_size"`
+	Format                string         `mapstructure:"format"`
+	SnapshotID            string         `mapstructure:"snapshot_id"`
+	SnapshotTimestamp     string         `mapstructure:"snapshot_timestamp"`
 	url                   *globutil.URL
 	extractPolicy         *rillblob.ExtractPolicy
+	tableOptions          *rillblob.TableOptions
 }
 
 func parseSourceProperties(props map[string]any) (*sourceProperties, error) {
@@ -339,6 +349,12 @@ func parseSourceProperties(props map[string]any) (*sourceProperties, error) {
 	}
 
 	conf.url = bucketURL
+
+	conf.tableOptions, err = rillblob.ParseTableOptions(conf.Format, conf.SnapshotID, conf.SnapshotTimestamp)
+	if err != nil {
+		return nil, err
+	}
+
 	return conf, nil
 }
 
@@ -194,6 +194,11 @@ func (c *Connection) AsFileStore() (drivers.FileStore, bool) {
 	return nil, false
 }
 
+// AsStreamStore implements drivers.Handle.
+func (c *Connection) AsStreamStore() (drivers.StreamStore, bool) {
+	return nil, false
+}
+
 // AsNotifier implements drivers.Connection.
 func (c *Connection) AsNotifier(properties map[string]any) (drivers.Notifier, error) {
 	return nil, drivers.ErrNotNotifier
@@ -57,6 +57,8 @@ type Options struct {
 	Format string
 	// TempDir where temporary files should be stored
 	TempDir string
+	// Table configures reading a table in an open table format. If set, GlobPattern is the path of the table's root directory.
+	Table *TableOptions
 }
 
 // sets defaults if not set by user
@@ -117,6 +119,10 @@ var _ drivers.FileIterator = &blobIterator{}
 // Calling Close() on the iterator will also close the bucket.
 func NewIterator(ctx context.Context, bucket *blob.Bucket, opts Options, l *zap.Logger) (drivers.FileIterator, error) {
 	opts.validate()
+	if opts.Table != nil {
+		// The data files of all supported table formats are Parquet files
+		opts.Format = "parquet"
+	}
 
 	tempDir, err := os.MkdirTemp(opts.TempDir, "blob_ingestion")
 	if err != nil {
@@ -258,6 +264,10 @@ func (it *blobIterator) plan() ([]*objectWithPlan, error) {
 		return nil, err
 	}
 
+	if it.opts.Table != nil {
+		return it.planTable(planner)
+	}
+
 	listOpts, ok := listOptions(it.opts.GlobPattern)
 	if !ok {
 		it.logger.Debug("glob pattern corresponds to single object", zap.String("glob", it.opts.GlobPattern))
@@ -311,6 +321,38 @@ func (it *blobIterator) plan() ([]*objectWithPlan, error) {
 	return items, nil
 }
 
+// planTable plans the download of the data files of a table in an open table format.
+// Deleted files and files of other snapshots are never listed, so they are not downloaded.
+func (it *blobIterator) planTable(planner *planner) ([]*objectWithPlan, error) {
+	objs, err := planTable(it.ctx, it.bucket, it.opts.GlobPattern, it.opts.Table)
+	if err != nil {
+		return nil, err
+	}
+
+	var size int64
+	var matchCount int
+	for _, obj := range objs {
+		size += obj.Size
+		matchCount++
+		if !planner.add(obj) {
+			break
+		}
+	}
+	if err := it.opts.validateLimits(size, matchCount, int64(len(objs))); err != nil {
+		return nil, err
+	}
+
+	items := planner.items()
+	if len(items) == 0 {
+		return nil, fmt.Errorf("no data files found for %s table %q", it.opts.Table.Format, it.opts.GlobPattern)
+	}
+
+	it.logger.Debug("planner completed", zap.String("table", it.opts.GlobPattern), zap.String("format", string(it.opts.Table.Format)),
+		zap.Int("matched", matchCount), zap.Int64("bytes_matched", size), zap.Int64("batch_size", it.opts.BatchSizeBytes),
+		observability.ZapCtx(it.ctx))
+	return items, nil
+}
+
 func (it *blobIterator) downloadFiles() {
 	// Ensure the downloadsCh is closed when the function returns.
 	// This unblocks waiting calls to Next() or Close().
@@ -0,0 +1,387 @@
+package blob
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/apache/arrow/go/v14/arrow"
+	"github.com/apache/arrow/go/v14/arrow/array"
+	"github.com/apache/arrow/go/v14/arrow/memory"
+	"github.com/apache/arrow/go/v14/parquet"
+	"github.com/apache/arrow/go/v14/parquet/file"
+	"github.com/apache/arrow/go/v14/parquet/pqarrow"
+	"gocloud.dev/blob"
+)
+
+// deltaAction is an action in the transaction log of a Delta table.
+// Only the fields needed to compute the live data files of a version are decoded.
+type deltaAction struct {
+	Add      *deltaAdd      `json:"add"`
+	Remove   *deltaRemove   `json:"remove"`
+	MetaData *deltaMetaData `json:"metaData"`
+}
+
+type deltaAdd struct {
+	Path            string             `json:"path"`
+	Size            int64              `json:"size"`
+	PartitionValues map[string]*string `json:"partitionValues"`
+	DeletionVector  json.RawMessage    `json:"deletionVector"`
+}
+
+type deltaRemove struct {
+	Path string `json:"path"`
+}
+
+type deltaMetaData struct {
+	Configuration map[string]string `json:"configuration"`
+}
+
+// deltaLogFile is a commit or checkpoint file in the _delta_log directory of a Delta table.
+type deltaLogFile struct {
+	obj     *blob.ListObject
+	version int64
+	// parts is the number of parts of a multi-part checkpoint. It is 1 for single-part checkpoints and 0 for commits.
+	parts int
+}
+
+// planDeltaTable returns the data files of a version of the Delta table at root.
+// It replays the table's transaction log from the latest checkpoint before the version.
+func planDeltaTable(ctx context.Context, bucket *blob.Bucket, root string, opts *TableOptions) ([]*blob.ListObject, error) {
+	logDir := path.Join(root, "_delta_log") + "/"
+	objs, err := listTableObjects(ctx, bucket, logDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the delta log: %w", err)
+	}
+
+	commits := make(map[int64]*deltaLogFile)
+	checkpoints := make(map[int64][]*deltaLogFile)
+	latest := int64(-1)
+	for _, obj := range objs {
+		f, ok := parseDeltaLogFile(obj, strings.TrimPrefix(obj.Key, logDir))
+		if !ok {
+			continue
+		}
+		if f.parts == 0 {
+			commits[f.version] = f
+		} else {
+			checkpoints[f.version] = append(checkpoints[f.version], f)
+		}
+		if f.version > latest {
+			latest = f.version
+		}
+	}
+	if latest < 0 {
+		return nil, fmt.Errorf("no delta table found at %q", root)
+	}
+
+	// Resolve the version to read
+	version := latest
+	if opts.SnapshotID != "" {
+		version, err = strconv.ParseInt(opts.SnapshotID, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid delta table version %q", opts.SnapshotID)
+		}
+		if version > latest {
+			return nil, fmt.Errorf("delta table version %d does not exist (latest version is %d)", version, latest)
+		}
+	} else if !opts.Timestamp.IsZero() {
+		// The timestamp of a version is the modification time of its commit file
+		version = -1
+		for v, f := range commits {
+			if !f.obj.ModTime.After(opts.Timestamp) && v > version {
+				version = v
+			}
+		}
+		if version < 0 {
+			return nil, fmt.Errorf("no version of the delta table was committed at or before %s", opts.Timestamp)
+		}
+	}
+
+	// Find the latest complete checkpoint at or before the version
+	start := int64(-1)
+	var checkpoint []*deltaLogFile
+	for v, files := range checkpoints {
+		if v <= version && v > start && files[0].parts == len(files) {
+			start = v
+			checkpoint = files
+		}
+	}
+
+	// Replay the log
+	live := make(map[string]*deltaAdd)
+	var configuration map[string]string
+	apply := func(a *deltaAction) error {
+		switch {
+		case a.Add != nil:
+			if len(a.Add.DeletionVector) > 0 && string(a.Add.DeletionVector) != "null" {
+				return errors.New("delta tables with deletion vectors are not supported")
+			}
+			live[a.Add.Path] = a.Add
+		case a.Remove != nil:
+			delete(live, a.Remove.Path)
+		case a.MetaData != nil:
+			configuration = a.MetaData.Configuration
+		}
+		return nil
+	}
+
+	for _, f := range checkpoint {
+		actions, err := readDeltaCheckpoint(ctx, bucket, f.obj)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read delta checkpoint %q: %w", f.obj.Key, err)
+		}
+		for _, a := range actions {
+			if err := apply(a); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	for v := start + 1; v <= version; v++ {
+		f, ok := commits[v]
+		if !ok {
+			return nil, fmt.Errorf("the delta log is missing the commit for version %d", v)
+		}
+		data, err := bucket.ReadAll(ctx, f.obj.Key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read delta commit %q: %w", f.obj.Key, err)
+		}
+		s := bufio.NewScanner(bytes.NewReader(data))
+		s.Buffer(nil, len(data)+1)
+		for s.Scan() {
+			line := bytes.TrimSpace(s.Bytes())
+			if len(line) == 0 {
+				continue
+			}
+			a := &deltaAction{}
+			if err := json.Unmarshal(line, a); err != nil {
+				return nil, fmt.Errorf("invalid action in delta commit %q: %w", f.obj.Key, err)
+			}
+			if err := apply(a); err != nil {
+				return nil, err
+			}
+		}
+		if err := s.Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	if mode := configuration["delta.columnMapping.mode"]; mode != "" && mode != "none" {
+		return nil, fmt.Errorf("delta tables with column mapping mode %q are not supported", mode)
+	}
+
+	// Resolve the keys of the data files
+	res := make([]*blob.ListObject, 0, len(live))
+	for p, add := range live {
+		p, err := unescapeTablePath(p)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkDeltaPartitionPath(p, add.PartitionValues); err != nil {
+			return nil, err
+		}
+		key, err := tableFileKey(root, p)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, &blob.ListObject{Key: key, Size: add.Size})
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Key < res[j].Key })
+	return res, nil
+}
+
+// checkDeltaPartitionPath checks that the partition values of a data file are encoded as hive partitions (key=value directories) in its path.
+// The data files don't contain the partition columns, so they are only ingested if they can be read from the path.
+func checkDeltaPartitionPath(p string, values map[string]*string) error {
+	if len(values) == 0 {
+		return nil
+	}
+
+	dirs := make(map[string]string)
+	segments := strings.Split(p, "/")
+	for _, seg := range segments[:len(segments)-1] {
+		k, v, ok := strings.Cut(seg, "=")
+		if !ok {
+			continue
+		}
+		if uv, err := url.PathUnescape(v); err == nil {
+			v = uv
+		}
+		dirs[k] = v
+	}
+
+	for k, v := range values {
+		want := "__HIVE_DEFAULT_PARTITION__"
+		if v != nil {
+			want = *v
+		}
+		if got, ok := dirs[k]; !ok || got != want {
+			return fmt.Errorf("delta tables with partition values that are not in the data file paths are not supported (partition column %q of file %q)", k, p)
+		}
+	}
+	return nil
+}
+
+// parseDeltaLogFile parses the version of a commit file (00000000000000000010.json),
+// a checkpoint (00000000000000000010.checkpoint.parquet) or a part of a multi-part checkpoint (00000000000000000010.checkpoint.0000000001.0000000002.parquet).
+func parseDeltaLogFile(obj *blob.ListObject, name string) (*deltaLogFile, bool) {
+	prefix, rest, ok := strings.Cut(name, ".")
+	if !ok || len(prefix) != 20 {
+		return nil, false
+	}
+	version, err := strconv.ParseInt(prefix, 10, 64)
+	if err != nil {
+		return nil, false
+	}
+
+	if rest == "json" {
+		return &deltaLogFile{obj: obj, version: version}, true
+	}
+	if rest == "checkpoint.parquet" {
+		return &deltaLogFile{obj: obj, version: version, parts: 1}, true
+	}
+	parts := strings.Split(rest, ".")
+	if len(parts) == 4 && parts[0] == "checkpoint" && parts[3] == "parquet" {
+		n, err := strconv.Atoi(parts[2])
+		if err != nil {
+			return nil, false
+		}
+		return &deltaLogFile{obj: obj, version: version, parts: n}, true
+	}
+	return nil, false
+}
+
+// readDeltaCheckpoint reads the add, remove and metaData actions from a checkpoint file.
+func readDeltaCheckpoint(ctx context.Context, bucket *blob.Bucket, obj *blob.ListObject) ([]*deltaAction, error) {
+	mem := memory.NewCheckedAllocator(memory.DefaultAllocator)
+	pf, err := file.NewParquetReader(NewBlobObjectReader(ctx, bucket, obj), file.WithReadProps(parquet.NewReaderProperties(mem)))
+	if err != nil {
+		return nil, err
+	}
+	defer pf.Close()
+
+	fr, err := pqarrow.NewFileReader(pf, pqarrow.ArrowReadProperties{BatchSize: _batchSize}, mem)
+	if err != nil {
+		return nil, err
+	}
+	tbl, err := fr.ReadTable(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tbl.Release()
+
+	var res []*deltaAction
+	schema := tbl.Schema()
+	for i := 0; i < int(tbl.NumCols()); i++ {
+		name := schema.Field(i).Name
+		for _, chunk := range tbl.Column(i).Data().Chunks() {
+			st, ok := chunk.(*array.Struct)
+			if !ok {
+				continue
+			}
+			switch name {
+			case "add":
+				paths := structString(st, "path")
+				sizes, _ := structField(st, "size").(*array.Int64)
+				partitionValues, _ := structField(st, "partitionValues").(*array.Map)
+				dvs := structField(st, "deletionVector")
+				for j := 0; j < st.Len(); j++ {
+					if st.IsNull(j) || paths == nil {
+						continue
+					}
+					add := &deltaAdd{Path: paths.Value(j), PartitionValues: mapNullableStrings(partitionValues, j)}
+					if sizes != nil {
+						add.Size = sizes.Value(j)
+					}
+					if dvs != nil && dvs.IsValid(j) {
+						add.DeletionVector = json.RawMessage("{}")
+					}
+					res = append(res, &deltaAction{Add: add})
+				}
+			case "remove":
+				paths := structString(st, "path")
+				for j := 0; j < st.Len(); j++ {
+					if st.IsNull(j) || paths == nil {
+						continue
+					}
+					res = append(res, &deltaAction{Remove: &deltaRemove{Path: paths.Value(j)}})
+				}
+			case "metaData":
+				conf, _ := structField(st, "configuration").(*array.Map)
+				for j := 0; j < st.Len(); j++ {
+					if st.IsNull(j) {
+						continue
+					}
+					res = append(res, &deltaAction{MetaData: &deltaMetaData{Configuration: mapStrings(conf, j)}})
+				}
+			}
+		}
+	}
+	return res, nil
+}
+
+// structField returns the child array of a struct array with the given name, or nil if it doesn't exist.
+func structField(st *array.Struct, name string) arrow.Array {
+	idx, ok := st.DataType().(*arrow.StructType).FieldIdx(name)
+	if !ok {
+		return nil
+	}
+	return st.Field(idx)
+}
+
+func structString(st *array.Struct, name string) *array.String {
+	s, _ := structField(st, name).(*array.String)
+	return s
+}
+
+// mapStrings returns the entries of a map<string, string> at index i.
+func mapStrings(m *array.Map, i int) map[string]string {
+	if m == nil || m.IsNull(i) {
+		return nil
+	}
+	keys, ok1 := m.Keys().(*array.String)
+	items, ok2 := m.Items().(*array.String)
+	if !ok1 || !ok2 {
+		return nil
+	}
+	res := make(map[string]string)
+	start, end := m.ValueOffsets(i)
+	for j := start; j < end; j++ {
+		if items.IsValid(int(j)) {
+			res[keys.Value(int(j))] = items.Value(int(j))
+		}
+	}
+	return res
+}
+
+// mapNullableStrings returns the entries of a map<string, string> at index i, with nil for null values.
+func mapNullableStrings(m *array.Map, i int) map[string]*string {
+	if m == nil || m.IsNull(i) {
+		return nil
+	}
+	keys, ok1 := m.Keys().(*array.String)
+	items, ok2 := m.Items().(*array.String)
+	if !ok1 || !ok2 {
+		return nil
+	}
+	res := make(map[string]*string)
+	start, end := m.ValueOffsets(i)
+	for j := start; j < end; j++ {
+		var v *string
+		if items.IsValid(int(j)) {
+			s := items.Value(int(j))
+			v = &s
+		}
+		res[keys.Value(int(j))] = v
+	}
+	return res
+}
@@ -0,0 +1,241 @@
+package blob
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/rilldata/rill/runtime/pkg/avro"
+	"gocloud.dev/blob"
+	"gocloud.dev/gcerrors"
+)
+
+// icebergMetadata is a table metadata file of an Iceberg table.
+// Only the fields needed to resolve a snapshot are decoded.
+type icebergMetadata struct {
+	FormatVersion     int               `json:"format-version"`
+	CurrentSnapshotID *int64            `json:"current-snapshot-id"`
+	Snapshots         []icebergSnapshot `json:"snapshots"`
+	SnapshotLog       []struct {
+		SnapshotID  int64 `json:"snapshot-id"`
+		TimestampMS int64 `json:"timestamp-ms"`
+	} `json:"snapshot-log"`
+}
+
+type icebergSnapshot struct {
+	SnapshotID   int64  `json:"snapshot-id"`
+	TimestampMS  int64  `json:"timestamp-ms"`
+	ManifestList string `json:"manifest-list"`
+	// Manifests is only used by v1 tables that don't write a manifest list.
+	Manifests []string `json:"manifests"`
+}
+
+// planIcebergTable returns the data files of a snapshot of the Iceberg table at root.
+// The root is the table's location, or the path of a specific metadata file of the table.
+func planIcebergTable(ctx context.Context, bucket *blob.Bucket, root string, opts *TableOptions) ([]*blob.ListObject, error) {
+	metadataKey := root
+	if !strings.HasSuffix(root, ".metadata.json") {
+		var err error
+		metadataKey, err = icebergCurrentMetadataKey(ctx, bucket, root)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	data, err := bucket.ReadAll(ctx, metadataKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read iceberg metadata %q: %w", metadataKey, err)
+	}
+	md := &icebergMetadata{}
+	if err := json.Unmarshal(data, md); err != nil {
+		return nil, fmt.Errorf("invalid iceberg metadata %q: %w", metadataKey, err)
+	}
+
+	snapshot, err := md.snapshot(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// Find the manifests of the snapshot
+	type manifest struct {
+		path    string
+		deletes bool
+	}
+	var manifests []manifest
+	if snapshot.ManifestList != "" {
+		records, err := readIcebergAvro(ctx, bucket, root, snapshot.ManifestList)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read iceberg manifest list: %w", err)
+		}
+		for _, r := range records {
+			p, _ := r["manifest_path"].(string)
+			content, _ := r["content"].(int64)
+			manifests = append(manifests, manifest{path: p, deletes: content != 0})
+		}
+	} else {
+		for _, p := range snapshot.Manifests {
+			manifests = append(manifests, manifest{path: p})
+		}
+	}
+
+	// Collect the live data files from the manifests
+	var res []*blob.ListObject
+	for _, m := range manifests {
+		entries, err := readIcebergAvro(ctx, bucket, root, m.path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read iceberg manifest: %w", err)
+		}
+		for _, e := range entries {
+			// Status 2 means the file was deleted in the snapshot
+			if status, _ := e["status"].(int64); status == 2 {
+				continue
+			}
+			df, _ := e["data_file"].(map[string]any)
+			if df == nil {
+				return nil, fmt.Errorf("invalid entry in iceberg manifest %q", m.path)
+			}
+			if content, _ := df["content"].(int64); m.deletes || content != 0 {
+				return nil, errors.New("iceberg tables with delete files are not supported")
+			}
+			if format, _ := df["file_format"].(string); !strings.EqualFold(format, "parquet") {
+				return nil, fmt.Errorf("iceberg data files in format %q are not supported", format)
+			}
+
+			p, _ := df["file_path"].(string)
+			key, err := tableFileKey(root, p)
+			if err != nil {
+				return nil, err
+			}
+			size, _ := df["file_size_in_bytes"].(int64)
+			res = append(res, &blob.ListObject{Key: key, Size: size})
+		}
+	}
+
+	sort.Slice(res, func(i, j int) bool { return res[i].Key < res[j].Key })
+	return res, nil
+}
+
+// snapshot returns the snapshot selected by the options.
+func (md *icebergMetadata) snapshot(opts *TableOptions) (*icebergSnapshot, error) {
+	var id int64
+	switch {
+	case opts.SnapshotID != "":
+		var err error
+		id, err = strconv.ParseInt(opts.SnapshotID, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid iceberg snapshot ID %q", opts.SnapshotID)
+		}
+	case !opts.Timestamp.IsZero():
+		// The snapshot log records when each snapshot became the table's current snapshot
+		ts := opts.Timestamp.UnixMilli()
+		found := false
+		for _, e := range md.SnapshotLog {
+			if e.TimestampMS <= ts {
+				id = e.SnapshotID
+				found = true
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("no snapshot of the iceberg table was current at %s", opts.Timestamp)
+		}
+	default:
+		if md.CurrentSnapshotID == nil || *md.CurrentSnapshotID == -1 {
+			return nil, errors.New("the iceberg table has no snapshots")
+		}
+		id = *md.CurrentSnapshotID
+	}
+
+	for i := range md.Snapshots {
+		if md.Snapshots[i].SnapshotID == id {
+			return &md.Snapshots[i], nil
+		}
+	}
+	return nil, fmt.Errorf("iceberg snapshot %d does not exist", id)
+}
+
+// icebergCurrentMetadataKey returns the key of the current metadata file of the Iceberg table at root.
+// It uses the version hint if present, and otherwise the metadata file with the highest version.
+func icebergCurrentMetadataKey(ctx context.Context, bucket *blob.Bucket, root string) (string, error) {
+	metadataDir := path.Join(root, "metadata")
+
+	hint, err := bucket.ReadAll(ctx, path.Join(metadataDir, "version-hint.text"))
+	if err == nil {
+		v, err := strconv.Atoi(string(bytes.TrimSpace(hint)))
+		if err == nil {
+			key := path.Join(metadataDir, fmt.Sprintf("v%d.metadata.json", v))
+			if ok, _ := bucket.Exists(ctx, key); ok {
+				return key, nil
+			}
+		}
+	} else if gcerrors.Code(err) != gcerrors.NotFound {
+		return "", fmt.Errorf("failed to read iceberg version hint: %w", err)
+	}
+
+	objs, err := listTableObjects(ctx, bucket, metadataDir+"/")
+	if err != nil {
+		return "", fmt.Errorf("failed to list iceberg metadata: %w", err)
+	}
+	var key string
+	latest := -1
+	for _, obj := range objs {
+		v, ok := icebergMetadataVersion(path.Base(obj.Key))
+		if ok && v > latest {
+			latest = v
+			key = obj.Key
+		}
+	}
+	if key == "" {
+		return "", fmt.Errorf("no iceberg table found at %q", root)
+	}
+	return key, nil
+}
+
+// icebergMetadataVersion parses the version of a metadata file named like "v3.metadata.json" or "00003-<uuid>.metadata.json".
+func icebergMetadataVersion(name string) (int, bool) {
+	name, ok := strings.CutSuffix(name, ".metadata.json")
+	if !ok {
+		return 0, false
+	}
+	name = strings.TrimPrefix(name, "v")
+	if i := strings.IndexByte(name, '-'); i >= 0 {
+		name = name[:i]
+	}
+	v, err := strconv.Atoi(name)
+	if err != nil {
+		return 0, false
+	}
+	return v, true
+}
+
+// readIcebergAvro reads the records of an Avro manifest or manifest list.
+func readIcebergAvro(ctx context.Context, bucket *blob.Bucket, root, p string) ([]map[string]any, error) {
+	key, err := tableFileKey(root, p)
+	if err != nil {
+		return nil, err
+	}
+	r, err := bucket.NewReader(ctx, key, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	records, _, err := avro.ReadOCF(r)
+	if err != nil {
+		return nil, fmt.Errorf("%q: %w", key, err)
+	}
+	res := make([]map[string]any, 0, len(records))
+	for _, rec := range records {
+		m, ok := rec.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("%q: unexpected record type %T", key, rec)
+		}
+		res = append(res, m)
+	}
+	return res, nil
+}
@@ -0,0 +1,119 @@
+package blob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/rilldata/rill/runtime/pkg/fileutil"
+	"github.com/rilldata/rill/runtime/pkg/globutil"
+	"gocloud.dev/blob"
+)
+
+// TableFormat is an open table format that tracks the data files of a table in metadata files stored alongside the data.
+type TableFormat string
+
+const (
+	TableFormatDelta   TableFormat = "delta"
+	TableFormatIceberg TableFormat = "iceberg"
+)
+
+// TableOptions configures resolving the data files of a table in an open table format.
+// Instead of listing the objects matching a glob, the iterator reads the table's metadata and only downloads the data files of one snapshot of the table.
+type TableOptions struct {
+	Format TableFormat
+	// SnapshotID pins the snapshot to read. For Delta tables, it is the table version.
+	SnapshotID string
+	// Timestamp selects the latest snapshot committed at or before the timestamp. It is ignored if SnapshotID is set.
+	Timestamp time.Time
+}
+
+// ParseTableOptions parses the table options for a source's "format", "snapshot_id" and "snapshot_timestamp" properties.
+// It returns nil if the format is not an open table format.
+func ParseTableOptions(format, snapshotID, snapshotTimestamp string) (*TableOptions, error) {
+	f := TableFormat(strings.ToLower(format))
+	if f != TableFormatDelta && f != TableFormatIceberg {
+		if snapshotID != "" || snapshotTimestamp != "" {
+			return nil, errors.New(`"snapshot_id" and "snapshot_timestamp" can only be set when "format" is "delta" or "iceberg"`)
+		}
+		return nil, nil
+	}
+
+	opts := &TableOptions{Format: f, SnapshotID: snapshotID}
+	if snapshotTimestamp != "" {
+		if snapshotID != "" {
+			return nil, errors.New(`"snapshot_id" and "snapshot_timestamp" can't both be set`)
+		}
+		t, err := time.Parse(time.RFC3339Nano, snapshotTimestamp)
+		if err != nil {
+			return nil, fmt.Errorf(`invalid "snapshot_timestamp": %w`, err)
+		}
+		opts.Timestamp = t
+	}
+	return opts, nil
+}
+
+// planTable returns the data files of the table at the root path.
+func planTable(ctx context.Context, bucket *blob.Bucket, root string, opts *TableOptions) ([]*blob.ListObject, error) {
+	if fileutil.IsGlob(root) {
+		return nil, fmt.Errorf("the path of a %s table can't be a glob pattern", opts.Format)
+	}
+	root = strings.TrimSuffix(root, "/")
+
+	switch opts.Format {
+	case TableFormatDelta:
+		return planDeltaTable(ctx, bucket, root, opts)
+	case TableFormatIceberg:
+		return planIcebergTable(ctx, bucket, root, opts)
+	default:
+		return nil, fmt.Errorf("unsupported table format %q", opts.Format)
+	}
+}
+
+// tableFileKey returns the bucket key of a file referenced in a table's metadata.
+// The path may be an absolute URI or relative to the table's root.
+func tableFileKey(root, p string) (string, error) {
+	if strings.Contains(p, "://") {
+		u, err := globutil.ParseBucketURL(p)
+		if err != nil {
+			return "", err
+		}
+		return u.Path, nil
+	}
+	if root == "" {
+		return p, nil
+	}
+	return path.Join(root, p), nil
+}
+
+// unescapeTablePath decodes a URI-encoded path in a table's metadata.
+func unescapeTablePath(p string) (string, error) {
+	res, err := url.PathUnescape(p)
+	if err != nil {
+		return "", fmt.Errorf("invalid path %q: %w", p, err)
+	}
+	return res, nil
+}
+
+// listTableObjects lists the objects in the bucket with the given prefix.
+func listTableObjects(ctx context.Context, bucket *blob.Bucket, prefix string) ([]*blob.ListObject, error) {
+	var res []*blob.ListObject
+	it := bucket.List(&blob.ListOptions{Prefix: prefix})
+	for {
+		obj, err := it.Next(ctx)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return res, nil
+			}
+			return nil, err
+		}
+		if !obj.IsDir {
+			res = append(res, obj)
+		}
+	}
+}
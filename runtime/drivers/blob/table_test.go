@@ -0,0 +1,297 @@
+package blob
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"gocloud.dev/blob"
+)
+
+func TestParseTableOptions(t *testing.T) {
+	opts, err := ParseTableOptions("parquet", "", "")
+	require.NoError(t, err)
+	require.Nil(t, opts)
+
+	_, err = ParseTableOptions("", "1", "")
+	require.Error(t, err)
+
+	opts, err = ParseTableOptions("Delta", "3", "")
+	require.NoError(t, err)
+	require.Equal(t, &TableOptions{Format: TableFormatDelta, SnapshotID: "3"}, opts)
+
+	opts, err = ParseTableOptions("iceberg", "", "2024-01-02T03:04:05Z")
+	require.NoError(t, err)
+	require.Equal(t, TableFormatIceberg, opts.Format)
+	require.Equal(t, time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), opts.Timestamp)
+
+	_, err = ParseTableOptions("iceberg", "1", "2024-01-02T03:04:05Z")
+	require.Error(t, err)
+
+	_, err = ParseTableOptions("iceberg", "", "yesterday")
+	require.Error(t, err)
+}
+
+func TestPlanDeltaTable(t *testing.T) {
+	ctx := context.Background()
+	bucket, err := blob.OpenBucket(ctx, "mem://")
+	require.NoError(t, err)
+	defer bucket.Close()
+
+	write := func(key, data string) {
+		require.NoError(t, bucket.WriteAll(ctx, key, []byte(data), nil))
+	}
+	write("tbl/_delta_log/00000000000000000000.json", `{"metaData":{"id":"1","configuration":{}}}
+{"add":{"path":"country=US/a.parquet","size":10,"partitionValues":{"country":"US"}}}
+{"add":{"path":"country=DK/b%20c.parquet","size":20,"partitionValues":{"country":"DK"}}}
+`)
+	write("tbl/country=US/a.parquet", "a")
+	write("tbl/country=DK/b c.parquet", "b")
+	time.Sleep(10 * time.Millisecond)
+	between := time.Now()
+	time.Sleep(10 * time.Millisecond)
+	write("tbl/_delta_log/00000000000000000001.json", `{"remove":{"path":"country=US/a.parquet"}}
+{"add":{"path":"country=US/d.parquet","size":30,"partitionValues":{"country":"US"}}}
+`)
+	write("tbl/country=US/d.parquet", "d")
+
+	tests := []struct {
+		name    string
+		opts    *TableOptions
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "latest",
+			opts: &TableOptions{Format: TableFormatDelta},
+			want: []string{"tbl/country=DK/b c.parquet", "tbl/country=US/d.parquet"},
+		},
+		{
+			name: "version",
+			opts: &TableOptions{Format: TableFormatDelta, SnapshotID: "0"},
+			want: []string{"tbl/country=DK/b c.parquet", "tbl/country=US/a.parquet"},
+		},
+		{
+			name: "timestamp",
+			opts: &TableOptions{Format: TableFormatDelta, Timestamp: between},
+			want: []string{"tbl/country=DK/b c.parquet", "tbl/country=US/a.parquet"},
+		},
+		{
+			name:    "missing version",
+			opts:    &TableOptions{Format: TableFormatDelta, SnapshotID: "2"},
+			wantErr: true,
+		},
+		{
+			name:    "before first commit",
+			opts:    &TableOptions{Format: TableFormatDelta, Timestamp: between.Add(-time.Hour)},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			objs, err := planTable(ctx, bucket, "tbl/", tt.opts)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, objectKeys(objs))
+		})
+	}
+
+	// Partition values that are not encoded in the data file paths can't be ingested
+	write("unencoded/_delta_log/00000000000000000000.json", `{"metaData":{"id":"2","configuration":{}}}
+{"add":{"path":"part-0.parquet","size":10,"partitionValues":{"country":"US"}}}
+`)
+	_, err = planTable(ctx, bucket, "unencoded/", &TableOptions{Format: TableFormatDelta})
+	require.ErrorContains(t, err, `partition column "country"`)
+
+	_, err = planTable(ctx, bucket, "tbl/*", &TableOptions{Format: TableFormatDelta})
+	require.Error(t, err)
+	_, err = planTable(ctx, bucket, "missing", &TableOptions{Format: TableFormatDelta})
+	require.Error(t, err)
+}
+
+func TestPlanIcebergTable(t *testing.T) {
+	ctx := context.Background()
+	bucket, err := blob.OpenBucket(ctx, "mem://")
+	require.NoError(t, err)
+	defer bucket.Close()
+
+	write := func(key string, data []byte) {
+		require.NoError(t, bucket.WriteAll(ctx, key, data, nil))
+	}
+
+	// Snapshot 1 adds a and b; snapshot 2 deletes a and adds c
+	write("tbl/metadata/m1.avro", icebergManifest(
+		icebergEntry{status: 1, path: "s3://bucket/tbl/data/a.parquet", size: 10},
+		icebergEntry{status: 1, path: "s3://bucket/tbl/data/b.parquet", size: 20},
+	))
+	write("tbl/metadata/m2.avro", icebergManifest(
+		icebergEntry{status: 2, path: "s3://bucket/tbl/data/a.parquet", size: 10},
+		icebergEntry{status: 0, path: "s3://bucket/tbl/data/b.parquet", size: 20},
+		icebergEntry{status: 1, path: "s3://bucket/tbl/data/c.parquet", size: 30},
+	))
+	write("tbl/metadata/m3.avro", icebergManifest(
+		icebergEntry{status: 1, content: 1, path: "s3://bucket/tbl/data/del.parquet", size: 5},
+	))
+	write("tbl/metadata/snap-1.avro", icebergManifestList("s3://bucket/tbl/metadata/m1.avro"))
+	write("tbl/metadata/snap-2.avro", icebergManifestList("s3://bucket/tbl/metadata/m2.avro"))
+	write("tbl/metadata/snap-3.avro", icebergManifestList("s3://bucket/tbl/metadata/m2.avro", "s3://bucket/tbl/metadata/m3.avro"))
+
+	metadata := func(current int) []byte {
+		return []byte(fmt.Sprintf(`{
+			"format-version": 2,
+			"current-snapshot-id": %d,
+			"snapshots": [
+				{"snapshot-id": 1, "timestamp-ms": 1000, "manifest-list": "s3://bucket/tbl/metadata/snap-1.avro"},
+				{"snapshot-id": 2, "timestamp-ms": 2000, "manifest-list": "s3://bucket/tbl/metadata/snap-2.avro"},
+				{"snapshot-id": 3, "timestamp-ms": 3000, "manifest-list": "s3://bucket/tbl/metadata/snap-3.avro"}
+			],
+			"snapshot-log": [
+				{"snapshot-id": 1, "timestamp-ms": 1000},
+				{"snapshot-id": 2, "timestamp-ms": 2000},
+				{"snapshot-id": 3, "timestamp-ms": 3000}
+			]
+		}`, current))
+	}
+	write("tbl/metadata/00001-6d0c.metadata.json", metadata(1))
+	write("tbl/metadata/00002-a3f1.metadata.json", metadata(2))
+	write("tbl/metadata/00003-b7e2.metadata.json", metadata(3))
+
+	tests := []struct {
+		name    string
+		root    string
+		opts    *TableOptions
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "metadata file",
+			root: "tbl/metadata/00002-a3f1.metadata.json",
+			opts: &TableOptions{Format: TableFormatIceberg},
+			want: []string{"tbl/data/b.parquet", "tbl/data/c.parquet"},
+		},
+		{
+			name: "snapshot ID",
+			root: "tbl",
+			opts: &TableOptions{Format: TableFormatIceberg, SnapshotID: "1"},
+			want: []string{"tbl/data/a.parquet", "tbl/data/b.parquet"},
+		},
+		{
+			name: "timestamp",
+			root: "tbl",
+			opts: &TableOptions{Format: TableFormatIceberg, Timestamp: time.UnixMilli(2500)},
+			want: []string{"tbl/data/b.parquet", "tbl/data/c.parquet"},
+		},
+		{
+			name:    "delete files",
+			root:    "tbl",
+			opts:    &TableOptions{Format: TableFormatIceberg},
+			wantErr: true,
+		},
+		{
+			name:    "unknown snapshot",
+			root:    "tbl",
+			opts:    &TableOptions{Format: TableFormatIceberg, SnapshotID: "4"},
+			wantErr: true,
+		},
+		{
+			name:    "before first snapshot",
+			root:    "tbl",
+			opts:    &TableOptions{Format: TableFormatIceberg, Timestamp: time.UnixMilli(500)},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			objs, err := planTable(ctx, bucket, tt.root, tt.opts)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, objectKeys(objs))
+		})
+	}
+
+	// The version hint takes precedence over listing the metadata files
+	write("tbl/metadata/v1.metadata.json", metadata(1))
+	write("tbl/metadata/version-hint.text", []byte("1\n"))
+	objs, err := planTable(ctx, bucket, "tbl", &TableOptions{Format: TableFormatIceberg})
+	require.NoError(t, err)
+	require.Equal(t, []string{"tbl/data/a.parquet", "tbl/data/b.parquet"}, objectKeys(objs))
+}
+
+func objectKeys(objs []*blob.ListObject) []string {
+	res := make([]string, len(objs))
+	for i, obj := range objs {
+		res[i] = obj.Key
+	}
+	return res
+}
+
+type icebergEntry struct {
+	status  int64
+	content int64
+	path    string
+	size    int64
+}
+
+// icebergManifest encodes a manifest with a minimal subset of the manifest entry fields.
+func icebergManifest(entries ...icebergEntry) []byte {
+	schema := `{"type":"record","name":"manifest_entry","fields":[
+		{"name":"status","type":"int"},
+		{"name":"data_file","type":{"type":"record","name":"r2","fields":[
+			{"name":"content","type":"int"},
+			{"name":"file_path","type":"string"},
+			{"name":"file_format","type":"string"},
+			{"name":"file_size_in_bytes","type":"long"}
+		]}}
+	]}`
+	var records []byte
+	for _, e := range entries {
+		records = binary.AppendVarint(records, e.status)
+		records = binary.AppendVarint(records, e.content)
+		records = appendAvroString(records, e.path)
+		records = appendAvroString(records, "PARQUET")
+		records = binary.AppendVarint(records, e.size)
+	}
+	return avroOCF(schema, len(entries), records)
+}
+
+// icebergManifestList encodes a manifest list of data manifests.
+func icebergManifestList(paths ...string) []byte {
+	schema := `{"type":"record","name":"manifest_file","fields":[
+		{"name":"manifest_path","type":"string"},
+		{"name":"content","type":"int"}
+	]}`
+	var records []byte
+	for _, p := range paths {
+		records = appendAvroString(records, p)
+		records = binary.AppendVarint(records, 0)
+	}
+	return avroOCF(schema, len(paths), records)
+}
+
+func avroOCF(schema string, n int, records []byte) []byte {
+	sync := make([]byte, 16)
+	data := []byte{'O', 'b', 'j', 1}
+	data = binary.AppendVarint(data, 1)
+	data = appendAvroString(data, "avro.schema")
+	data = appendAvroString(data, schema)
+	data = binary.AppendVarint(data, 0)
+	data = append(data, sync...)
+	data = binary.AppendVarint(data, int64(n))
+	data = binary.AppendVarint(data, int64(len(records)))
+	data = append(data, records...)
+	return append(data, sync...)
+}
+
+func appendAvroString(b []byte, s string) []byte {
+	b = binary.AppendVarint(b, int64(len(s)))
+	return append(b, s...)
+}
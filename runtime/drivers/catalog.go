@@ -3,6 +3,7 @@ package drivers
 import (
 	"context"
 	"errors"
+	"time"
 )
 
 // Constants representing the kinds of catalog objects.
@@ -36,6 +37,12 @@ type CatalogStore interface {
 	UpdateResource(ctx context.Context, v int64, r Resource) error
 	DeleteResource(ctx context.Context, v int64, k, n string) error
 	DeleteResources(ctx context.Context) error
+
+	FindModelPartitions(ctx context.Context, opts *FindModelPartitionsOptions) ([]ModelPartition, error)
+	FindModelPartitionsByKeys(ctx context.Context, modelID string, keys []string) ([]ModelPartition, error)
+	InsertModelPartition(ctx context.Context, modelID string, partition ModelPartition) error
+	UpdateModelPartition(ctx context.Context, modelID string, partition ModelPartition) error
+	DeleteModelPartitions(ctx context.Context, modelID string) error
 }
 
 // Resource is an entry in a catalog store
@@ -44,3 +51,34 @@ type Resource struct {
 	Name string
 	Data []byte
 }
+
+// ModelPartition represents a partition of a model stored in a catalog store.
+// A model's partitions are identified by the model's partitions ID (not its name), which changes every time the model is reset.
+type ModelPartition struct {
+	// Key is a unique identifier for the partition. It is derived from the partition's data.
+	Key string
+	// DataJSON is the partition's data, serialized as a JSON object.
+	DataJSON []byte
+	// Index is the partition's position in the partitions resolver's most recent output.
+	Index int
+	// Watermark is the partition's watermark, if any. Partitions are re-executed when their watermark increases.
+	Watermark *time.Time
+	// ExecutedOn is the time the partition was last executed. It is nil for partitions that are pending execution.
+	ExecutedOn *time.Time
+	// Error is the error that occurred during the partition's last execution, if any.
+	Error string
+	// Elapsed is the duration of the partition's last execution.
+	Elapsed time.Duration
+}
+
+// FindModelPartitionsOptions is used to filter and paginate model partitions.
+// If both WherePending and WhereErrored are set, partitions that are either pending or errored are returned.
+// Results are ordered by Index and Key. AfterIndex and AfterKey can be used to paginate.
+type FindModelPartitionsOptions struct {
+	ModelID      string
+	WherePending bool
+	WhereErrored bool
+	AfterIndex   int
+	AfterKey     string
+	Limit        int
+}
@@ -1,9 +1,95 @@
 package drivers_test
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/stretchr/testify/require"
 )
 
-func testCatalog(t *testing.T, catalog drivers.CatalogStore) {}
+func testCatalog(t *testing.T, catalog drivers.CatalogStore) {
+	t.Run("model_partitions", func(t *testing.T) { testCatalogModelPartitions(t, catalog) })
+}
+
+func testCatalogModelPartitions(t *testing.T, catalog drivers.CatalogStore) {
+	ctx := context.Background()
+	now := time.Now().Truncate(time.Millisecond)
+
+	// Insert partitions for two models
+	for i, k := range []string{"a", "b", "c"} {
+		err := catalog.InsertModelPartition(ctx, "m1", drivers.ModelPartition{
+			Key:      k,
+			DataJSON: []byte(`{"key":"` + k + `"}`),
+			Index:    i + 1,
+		})
+		require.NoError(t, err)
+	}
+	err := catalog.InsertModelPartition(ctx, "m2", drivers.ModelPartition{Key: "a", DataJSON: []byte(`{}`), Index: 1})
+	require.NoError(t, err)
+
+	// All partitions are pending
+	ps, err := catalog.FindModelPartitions(ctx, &drivers.FindModelPartitionsOptions{ModelID: "m1", WherePending: true})
+	require.NoError(t, err)
+	require.Len(t, ps, 3)
+	require.Equal(t, "a", ps[0].Key)
+	require.Equal(t, `{"key":"a"}`, string(ps[0].DataJSON))
+	require.Nil(t, ps[0].ExecutedOn)
+
+	// Mark one as executed and one as errored
+	ps[0].ExecutedOn = &now
+	ps[0].Elapsed = 2 * time.Second
+	err = catalog.UpdateModelPartition(ctx, "m1", ps[0])
+	require.NoError(t, err)
+	ps[1].ExecutedOn = &now
+	ps[1].Error = "failed"
+	err = catalog.UpdateModelPartition(ctx, "m1", ps[1])
+	require.NoError(t, err)
+
+	ps, err = catalog.FindModelPartitions(ctx, &drivers.FindModelPartitionsOptions{ModelID: "m1", WherePending: true})
+	require.NoError(t, err)
+	require.Len(t, ps, 1)
+	require.Equal(t, "c", ps[0].Key)
+
+	ps, err = catalog.FindModelPartitions(ctx, &drivers.FindModelPartitionsOptions{ModelID: "m1", WhereErrored: true})
+	require.NoError(t, err)
+	require.Len(t, ps, 1)
+	require.Equal(t, "b", ps[0].Key)
+	require.Equal(t, "failed", ps[0].Error)
+
+	ps, err = catalog.FindModelPartitions(ctx, &drivers.FindModelPartitionsOptions{ModelID: "m1", WherePending: true, WhereErrored: true})
+	require.NoError(t, err)
+	require.Len(t, ps, 2)
+
+	// Pagination
+	ps, err = catalog.FindModelPartitions(ctx, &drivers.FindModelPartitionsOptions{ModelID: "m1", Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, ps, 2)
+	require.Equal(t, 2*time.Second, ps[0].Elapsed)
+	require.Equal(t, now.UnixMilli(), ps[0].ExecutedOn.UnixMilli())
+	ps, err = catalog.FindModelPartitions(ctx, &drivers.FindModelPartitionsOptions{ModelID: "m1", AfterIndex: ps[1].Index, AfterKey: ps[1].Key, Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, ps, 1)
+	require.Equal(t, "c", ps[0].Key)
+
+	// Find by keys
+	ps, err = catalog.FindModelPartitionsByKeys(ctx, "m1", []string{"c", "a", "x"})
+	require.NoError(t, err)
+	require.Len(t, ps, 2)
+	require.Equal(t, "a", ps[0].Key)
+	require.Equal(t, "c", ps[1].Key)
+
+	// Delete
+	err = catalog.DeleteModelPartitions(ctx, "m1")
+	require.NoError(t, err)
+	ps, err = catalog.FindModelPartitions(ctx, &drivers.FindModelPartitionsOptions{ModelID: "m1"})
+	require.NoError(t, err)
+	require.Len(t, ps, 0)
+	ps, err = catalog.FindModelPartitions(ctx, &drivers.FindModelPartitionsOptions{ModelID: "m2"})
+	require.NoError(t, err)
+	require.Len(t, ps, 1)
+
+	err = catalog.DeleteModelPartitions(ctx, "m2")
+	require.NoError(t, err)
+}
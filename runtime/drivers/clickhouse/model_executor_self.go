@@ -14,10 +14,6 @@ type selfToSelfExecutor struct {
 }
 
 func (e *selfToSelfExecutor) Execute(ctx context.Context) (*drivers.ModelResult, error) {
-	if e.opts.Incremental {
-		return nil, fmt.Errorf("clickhouse: incremental models are not supported")
-	}
-
 	olap, ok := e.c.AsOLAP(e.c.instanceID)
 	if !ok {
 		return nil, fmt.Errorf("output connector is not OLAP")
@@ -52,29 +48,46 @@ func (e *selfToSelfExecutor) Execute(ctx context.Context) (*drivers.ModelResult,
 
 	asView := !materialize
 	tableName := outputProps.Table
-	stagingTableName := tableName
-	if e.opts.Env.StageChanges {
-		stagingTableName = stagingTableNameFor(tableName)
-	}
 
-	// Drop the staging view/table if it exists.
-	// NOTE: This intentionally drops the end table if not staging changes.
-	if t, err := olap.InformationSchema().Lookup(ctx, "", "", stagingTableName); err == nil {
-		_ = olap.DropTable(ctx, stagingTableName, t.View)
-	}
+	if !e.opts.IncrementalRun {
+		// Prepare for ingesting into the staging view/table.
+		// NOTE: This intentionally drops the end table if not staging changes.
+		stagingTableName := tableName
+		if e.opts.Env.StageChanges {
+			stagingTableName = stagingTableNameFor(tableName)
+		}
+		if t, err := olap.InformationSchema().Lookup(ctx, "", "", stagingTableName); err == nil {
+			_ = olap.DropTable(ctx, stagingTableName, t.View)
+		}
 
-	// Create the table
-	err := olap.CreateTableAsSelect(ctx, stagingTableName, asView, inputProps.SQL)
-	if err != nil {
-		_ = olap.DropTable(ctx, stagingTableName, asView)
-		return nil, fmt.Errorf("failed to create model: %w", err)
-	}
+		// Create the table
+		err := e.c.createTableAsSelect(ctx, stagingTableName, asView, inputProps.SQL, outputProps)
+		if err != nil {
+			_ = olap.DropTable(ctx, stagingTableName, asView)
+			return nil, fmt.Errorf("failed to create model: %w", err)
+		}
 
-	// Rename the staging table to the final table name
-	if stagingTableName != tableName {
-		err = olapForceRenameTable(ctx, olap, stagingTableName, asView, tableName)
+		// Test the output before promoting it
+		if e.opts.TestOutput != nil {
+			err = e.opts.TestOutput(ctx, stagingTableName)
+			if err != nil {
+				_ = olap.DropTable(ctx, stagingTableName, asView)
+				return nil, err
+			}
+		}
+
+		// Rename the staging table to the final table name
+		if stagingTableName != tableName {
+			err = olapForceRenameTable(ctx, olap, stagingTableName, asView, tableName)
+			if err != nil {
+				return nil, fmt.Errorf("failed to rename staged model: %w", err)
+			}
+		}
+	} else {
+		// Insert into the table. Inserting by name adds new columns to the table, so the model's schema can evolve between runs.
+		err := olap.InsertTableAsSelect(ctx, tableName, inputProps.SQL, true, true, outputProps.IncrementalStrategy, outputProps.UniqueKey)
 		if err != nil {
-			return nil, fmt.Errorf("failed to rename staged model: %w", err)
+			return nil, fmt.Errorf("failed to incrementally insert into table: %w", err)
 		}
 	}
 
@@ -85,7 +98,7 @@ func (e *selfToSelfExecutor) Execute(ctx context.Context) (*drivers.ModelResult,
 		UsedModelName: usedModelName,
 	}
 	resultPropsMap := map[string]interface{}{}
-	err = mapstructure.WeakDecode(resultProps, &resultPropsMap)
+	err := mapstructure.WeakDecode(resultProps, &resultPropsMap)
 	if err != nil {
 		return nil, fmt.Errorf("failed to encode result properties: %w", err)
 	}
@@ -21,14 +21,162 @@ func (p *ModelInputProperties) Validate() error {
 }
 
 type ModelOutputProperties struct {
-	Table       string `mapstructure:"table"`
-	Materialize *bool  `mapstructure:"materialize"`
+	Table               string                      `mapstructure:"table"`
+	Materialize         *bool                       `mapstructure:"materialize"`
+	UniqueKey           []string                    `mapstructure:"unique_key"`
+	IncrementalStrategy drivers.IncrementalStrategy `mapstructure:"incremental_strategy"`
+	// Engine is the table engine to use for materialized models. Defaults to MergeTree, or ReplacingMergeTree for incremental models that merge on a unique key.
+	Engine string `mapstructure:"engine"`
+	// OrderBy is the sorting key of the table. Defaults to the unique key if set, otherwise no sorting.
+	// If both are set, the sorting key must start with the unique key columns.
+	OrderBy string `mapstructure:"order_by"`
+	// PartitionBy is the partition key of the table. If set, incremental models that merge replace entire partitions.
+	PartitionBy string `mapstructure:"partition_by"`
+	// TTL is the table's TTL expression.
+	TTL string `mapstructure:"ttl"`
 }
 
 func (p *ModelOutputProperties) Validate(opts *drivers.ModelExecutorOptions) error {
+	hasTableOptions := p.Engine != "" || p.OrderBy != "" || p.PartitionBy != "" || p.TTL != ""
+
+	if opts.Incremental || hasTableOptions {
+		if p.Materialize != nil && !*p.Materialize {
+			if opts.Incremental {
+				return fmt.Errorf("incremental models must be materialized")
+			}
+			return fmt.Errorf(`the "engine", "order_by", "partition_by" and "ttl" properties can only be set for materialized models`)
+		}
+		p.Materialize = boolPtr(true)
+	}
+
+	switch p.IncrementalStrategy {
+	case drivers.IncrementalStrategyUnspecified, drivers.IncrementalStrategyAppend, drivers.IncrementalStrategyMerge:
+	default:
+		return fmt.Errorf("invalid incremental strategy %q", p.IncrementalStrategy)
+	}
+
+	if p.IncrementalStrategy == drivers.IncrementalStrategyMerge && len(p.UniqueKey) == 0 && p.PartitionBy == "" {
+		return fmt.Errorf(`must specify a "unique_key" or "partition_by" when "incremental_strategy" is %q`, p.IncrementalStrategy)
+	}
+
+	if p.IncrementalStrategy == drivers.IncrementalStrategyUnspecified {
+		if len(p.UniqueKey) == 0 {
+			p.IncrementalStrategy = drivers.IncrementalStrategyAppend
+		} else {
+			p.IncrementalStrategy = drivers.IncrementalStrategyMerge
+		}
+	}
+
+	// Incremental models that merge on a unique key (rather than replacing partitions) need an engine that deduplicates on the sorting key.
+	mergeOnKey := opts.Incremental && p.IncrementalStrategy == drivers.IncrementalStrategyMerge && p.PartitionBy == ""
+	if p.Engine == "" {
+		if mergeOnKey {
+			p.Engine = "ReplacingMergeTree"
+		} else {
+			p.Engine = "MergeTree"
+		}
+	}
+	if mergeOnKey && !strings.Contains(p.Engine, "ReplacingMergeTree") {
+		return fmt.Errorf(`incremental models with "incremental_strategy" %q must use a ReplacingMergeTree engine or set "partition_by"`, p.IncrementalStrategy)
+	}
+
+	if !strings.Contains(p.Engine, "MergeTree") {
+		if p.OrderBy != "" || p.PartitionBy != "" || p.TTL != "" {
+			return fmt.Errorf(`the "order_by", "partition_by" and "ttl" properties are only supported for MergeTree engines`)
+		}
+		return nil
+	}
+
+	if p.OrderBy == "" {
+		if len(p.UniqueKey) > 0 {
+			keys := make([]string, len(p.UniqueKey))
+			for i, k := range p.UniqueKey {
+				keys[i] = safeSQLName(k)
+			}
+			p.OrderBy = fmt.Sprintf("(%s)", strings.Join(keys, ", "))
+		} else {
+			p.OrderBy = "tuple()"
+		}
+	} else if len(p.UniqueKey) > 0 {
+		// ReplacingMergeTree deduplicates rows with the same sorting key, so the sorting key must be exactly the unique key.
+		// Extra columns would keep rows with the same unique key apart.
+		cols := orderByColumns(p.OrderBy)
+		if len(cols) != len(p.UniqueKey) {
+			return fmt.Errorf(`"order_by" must equal the "unique_key" columns`)
+		}
+		for i, k := range p.UniqueKey {
+			if cols[i] != k {
+				return fmt.Errorf(`"order_by" must equal the "unique_key" columns`)
+			}
+		}
+	}
+
 	return nil
 }
 
+// orderByColumns splits a sorting key expression into its top-level elements.
+// Elements that are quoted identifiers are unquoted.
+func orderByColumns(expr string) []string {
+	expr = strings.TrimSpace(expr)
+	if strings.HasPrefix(expr, "(") && strings.HasSuffix(expr, ")") {
+		expr = expr[1 : len(expr)-1]
+	}
+
+	var cols []string
+	var depth, start int
+	var quote rune
+	for i, r := range expr {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '"' || r == '`':
+			quote = r
+		case r == '(':
+			depth++
+		case r == ')':
+			depth--
+		case r == ',' && depth == 0:
+			cols = append(cols, unquoteIdentifier(expr[start:i]))
+			start = i + 1
+		}
+	}
+	cols = append(cols, unquoteIdentifier(expr[start:]))
+	return cols
+}
+
+// unquoteIdentifier trims whitespace and removes the quotes from a quoted identifier.
+func unquoteIdentifier(s string) string {
+	s = strings.TrimSpace(s)
+	if len(s) >= 2 && (s[0] == '"' || s[0] == '`') && s[len(s)-1] == s[0] {
+		q := s[:1]
+		return strings.ReplaceAll(s[1:len(s)-1], q+q, q)
+	}
+	return s
+}
+
+// tableDefinition returns the clauses that define the engine and keys of a table created for the output properties.
+// It must be called after Validate.
+func (p *ModelOutputProperties) tableDefinition() string {
+	var b strings.Builder
+	b.WriteString("ENGINE = ")
+	b.WriteString(p.Engine)
+	if p.PartitionBy != "" {
+		b.WriteString(" PARTITION BY ")
+		b.WriteString(p.PartitionBy)
+	}
+	if p.OrderBy != "" {
+		b.WriteString(" ORDER BY ")
+		b.WriteString(p.OrderBy)
+	}
+	if p.TTL != "" {
+		b.WriteString(" TTL ")
+		b.WriteString(p.TTL)
+	}
+	return b.String()
+}
+
 type ModelResultProperties struct {
 	Table         string `mapstructure:"table"`
 	View          bool   `mapstructure:"view"`
@@ -136,3 +284,7 @@ func olapForceRenameTable(ctx context.Context, olap drivers.OLAPStore, fromName
 	// Do the rename
 	return olap.RenameTable(ctx, fromName, toName, fromIsView)
 }
+
+func boolPtr(b bool) *bool {
+	return &b
+}
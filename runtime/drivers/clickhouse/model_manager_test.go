@@ -0,0 +1,113 @@
+package clickhouse
+
+import (
+	"testing"
+
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModelOutputPropertiesValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		props       ModelOutputProperties
+		incremental bool
+		definition  string
+		strategy    drivers.IncrementalStrategy
+		err         string
+	}{
+		{
+			name:       "default",
+			props:      ModelOutputProperties{Materialize: boolPtr(true)},
+			definition: "ENGINE = MergeTree ORDER BY tuple()",
+			strategy:   drivers.IncrementalStrategyAppend,
+		},
+		{
+			name:       "table options",
+			props:      ModelOutputProperties{OrderBy: "(id, ts)", PartitionBy: "toYYYYMM(ts)", TTL: "ts + INTERVAL 1 DAY"},
+			definition: "ENGINE = MergeTree PARTITION BY toYYYYMM(ts) ORDER BY (id, ts) TTL ts + INTERVAL 1 DAY",
+			strategy:   drivers.IncrementalStrategyAppend,
+		},
+		{
+			name:        "merge on unique key",
+			props:       ModelOutputProperties{UniqueKey: []string{"id", "region"}},
+			incremental: true,
+			definition:  `ENGINE = ReplacingMergeTree ORDER BY ("id", "region")`,
+			strategy:    drivers.IncrementalStrategyMerge,
+		},
+		{
+			name:        "merge on partitions",
+			props:       ModelOutputProperties{IncrementalStrategy: drivers.IncrementalStrategyMerge, PartitionBy: "day"},
+			incremental: true,
+			definition:  "ENGINE = MergeTree PARTITION BY day ORDER BY tuple()",
+			strategy:    drivers.IncrementalStrategyMerge,
+		},
+		{
+			name:        "merge without key",
+			props:       ModelOutputProperties{IncrementalStrategy: drivers.IncrementalStrategyMerge},
+			incremental: true,
+			err:         `must specify a "unique_key" or "partition_by"`,
+		},
+		{
+			name:        "merge on key with non-replacing engine",
+			props:       ModelOutputProperties{UniqueKey: []string{"id"}, Engine: "MergeTree"},
+			incremental: true,
+			err:         "must use a ReplacingMergeTree engine",
+		},
+		{
+			name:  "table options on view",
+			props: ModelOutputProperties{Materialize: boolPtr(false), OrderBy: "id"},
+			err:   "can only be set for materialized models",
+		},
+		{
+			name:        "incremental view",
+			props:       ModelOutputProperties{Materialize: boolPtr(false)},
+			incremental: true,
+			err:         "incremental models must be materialized",
+		},
+		{
+			name:        "order by equal to unique key",
+			props:       ModelOutputProperties{UniqueKey: []string{"id", "region"}, OrderBy: "(`id`, \"region\")"},
+			incremental: true,
+			definition:  "ENGINE = ReplacingMergeTree ORDER BY (`id`, \"region\")",
+			strategy:    drivers.IncrementalStrategyMerge,
+		},
+		{
+			name:        "order by with extra columns",
+			props:       ModelOutputProperties{UniqueKey: []string{"id"}, OrderBy: "(id, toDate(ts))"},
+			incremental: true,
+			err:         `"order_by" must equal the "unique_key" columns`,
+		},
+		{
+			name:        "order by not matching unique key",
+			props:       ModelOutputProperties{UniqueKey: []string{"id"}, OrderBy: "(ts)"},
+			incremental: true,
+			err:         `"order_by" must equal the "unique_key" columns`,
+		},
+		{
+			name:        "order by shorter than unique key",
+			props:       ModelOutputProperties{UniqueKey: []string{"id", "region"}, OrderBy: "id"},
+			incremental: true,
+			err:         `"order_by" must equal the "unique_key" columns`,
+		},
+		{
+			name:  "keys on non-MergeTree engine",
+			props: ModelOutputProperties{Engine: "Memory", OrderBy: "id"},
+			err:   "only supported for MergeTree engines",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.props.Validate(&drivers.ModelExecutorOptions{Incremental: tt.incremental})
+			if tt.err != "" {
+				require.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, *tt.props.Materialize)
+			require.Equal(t, tt.definition, tt.props.tableDefinition())
+			require.Equal(t, tt.strategy, tt.props.IncrementalStrategy)
+		})
+	}
+}
@@ -193,16 +193,27 @@ func (c *connection) Execute(ctx context.Context, stmt *drivers.Statement) (res
 
 // AddTableColumn implements drivers.OLAPStore.
 func (c *connection) AddTableColumn(ctx context.Context, tableName, columnName, typ string) error {
-	return fmt.Errorf("clickhouse: data transformation not yet supported")
+	return c.Exec(ctx, &drivers.Statement{
+		Query:    fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s", safeSQLName(tableName), safeSQLName(columnName), typ),
+		Priority: 100,
+	})
 }
 
 // AlterTableColumn implements drivers.OLAPStore.
 func (c *connection) AlterTableColumn(ctx context.Context, tableName, columnName, newType string) error {
-	return fmt.Errorf("clickhouse: data transformation not yet supported")
+	return c.Exec(ctx, &drivers.Statement{
+		Query:    fmt.Sprintf("ALTER TABLE %s MODIFY COLUMN %s %s", safeSQLName(tableName), safeSQLName(columnName), newType),
+		Priority: 100,
+	})
 }
 
 // CreateTableAsSelect implements drivers.OLAPStore.
 func (c *connection) CreateTableAsSelect(ctx context.Context, name string, view bool, sql string) error {
+	return c.createTableAsSelect(ctx, name, view, sql, &ModelOutputProperties{Engine: "MergeTree", OrderBy: "tuple()"})
+}
+
+// createTableAsSelect creates a view or table from a SELECT statement. Tables are created with the engine and keys of the output properties.
+func (c *connection) createTableAsSelect(ctx context.Context, name string, view bool, sql string, outputProps *ModelOutputProperties) error {
 	if view {
 		return c.Exec(ctx, &drivers.Statement{
 			Query:    fmt.Sprintf("CREATE OR REPLACE VIEW %s AS %s", safeSQLName(name), sql),
@@ -210,14 +221,213 @@ func (c *connection) CreateTableAsSelect(ctx context.Context, name string, view
 		})
 	}
 	return c.Exec(ctx, &drivers.Statement{
-		Query:    fmt.Sprintf("CREATE OR REPLACE TABLE %s ENGINE = MergeTree ORDER BY tuple() AS %s", safeSQLName(name), sql),
-		Priority: 100,
+		Query:       fmt.Sprintf("CREATE OR REPLACE TABLE %s %s AS %s", safeSQLName(name), outputProps.tableDefinition(), sql),
+		Priority:    100,
+		LongRunning: true,
 	})
 }
 
 // InsertTableAsSelect implements drivers.OLAPStore.
+// If byName is true, columns in the SELECT statement that are missing from the table are added to it, and columns with a different type are altered.
+// The merge strategy depends on the table: tables with a ReplacingMergeTree engine deduplicate rows with the same sorting key in the background,
+// and partitioned tables have the partitions that are present in the new data replaced.
 func (c *connection) InsertTableAsSelect(ctx context.Context, name, sql string, byName, inPlace bool, strategy drivers.IncrementalStrategy, uniqueKey []string) error {
-	return fmt.Errorf("clickhouse: data transformation not yet supported")
+	c.logger.Debug("insert table", zap.String("name", name), zap.Bool("byName", byName), zap.String("strategy", string(strategy)), zap.Strings("uniqueKey", uniqueKey))
+
+	var cols string
+	if byName {
+		names, err := c.evolveTableSchema(ctx, name, sql)
+		if err != nil {
+			return err
+		}
+		cols = strings.Join(names, ", ")
+	}
+
+	switch strategy {
+	case drivers.IncrementalStrategyAppend:
+		return c.insertSelect(ctx, name, cols, sql)
+	case drivers.IncrementalStrategyMerge:
+		engine, partitionKey, err := c.tableEngine(ctx, name)
+		if err != nil {
+			return err
+		}
+		if strings.Contains(engine, "ReplacingMergeTree") {
+			return c.insertSelect(ctx, name, cols, sql)
+		}
+		if partitionKey != "" {
+			return c.replacePartitions(ctx, name, cols, sql)
+		}
+		return fmt.Errorf("incremental insert strategy %q requires a ReplacingMergeTree engine or a partitioned table, but table %q has engine %q", strategy, name, engine)
+	default:
+		return fmt.Errorf("incremental insert strategy %q not supported", strategy)
+	}
+}
+
+// insertSelect inserts the result of a SELECT statement into a table. If cols is not empty, the columns are inserted by name.
+func (c *connection) insertSelect(ctx context.Context, name, cols, sql string) error {
+	var query string
+	if cols == "" {
+		query = fmt.Sprintf("INSERT INTO %s %s", safeSQLName(name), sql)
+	} else {
+		query = fmt.Sprintf("INSERT INTO %s (%s) SELECT %s FROM (%s\n)", safeSQLName(name), cols, cols, sql)
+	}
+	return c.Exec(ctx, &drivers.Statement{
+		Query:       query,
+		Priority:    100,
+		LongRunning: true,
+	})
+}
+
+// replacePartitions inserts the result of a SELECT statement into a staging table with the same structure as the table,
+// and then replaces the table's partitions with the partitions of the staging table.
+func (c *connection) replacePartitions(ctx context.Context, name, cols, sql string) error {
+	stagingName := "__rill_tmp_insert_" + name
+	err := c.Exec(ctx, &drivers.Statement{
+		Query:    fmt.Sprintf("DROP TABLE IF EXISTS %s", safeSQLName(stagingName)),
+		Priority: 100,
+	})
+	if err != nil {
+		return err
+	}
+	err = c.Exec(ctx, &drivers.Statement{
+		Query:    fmt.Sprintf("CREATE TABLE %s AS %s", safeSQLName(stagingName), safeSQLName(name)),
+		Priority: 100,
+	})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		// Using context.Background() to ensure the staging table is dropped even if the context is cancelled
+		err := c.Exec(context.Background(), &drivers.Statement{
+			Query:    fmt.Sprintf("DROP TABLE IF EXISTS %s", safeSQLName(stagingName)),
+			Priority: 100,
+		})
+		if err != nil {
+			c.logger.Error("clickhouse: failed to drop staging table", zap.String("name", stagingName), zap.Error(err))
+		}
+	}()
+
+	err = c.insertSelect(ctx, stagingName, cols, sql)
+	if err != nil {
+		return err
+	}
+
+	partitions, err := c.activePartitions(ctx, stagingName)
+	if err != nil {
+		return err
+	}
+	for _, partition := range partitions {
+		err = c.Exec(ctx, &drivers.Statement{
+			Query:       fmt.Sprintf("ALTER TABLE %s REPLACE PARTITION ID '%s' FROM %s", safeSQLName(name), strings.ReplaceAll(partition, "'", "''"), safeSQLName(stagingName)),
+			Priority:    100,
+			LongRunning: true,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// evolveTableSchema adds the columns of a SELECT statement that are missing from a table and alters the columns that have a different type.
+// It returns the escaped names of the SELECT statement's columns.
+func (c *connection) evolveTableSchema(ctx context.Context, name, sql string) ([]string, error) {
+	newCols, err := c.queryColumns(ctx, fmt.Sprintf("DESCRIBE TABLE (%s\n)", sql))
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe query: %w", err)
+	}
+	existingCols, err := c.queryColumns(ctx, "SELECT name, type FROM system.columns WHERE database = currentDatabase() AND table = ?", name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get columns of table %q: %w", name, err)
+	}
+
+	existing := make(map[string]string, len(existingCols))
+	for _, col := range existingCols {
+		existing[col.name] = col.typ
+	}
+
+	names := make([]string, len(newCols))
+	for i, col := range newCols {
+		names[i] = safeSQLName(col.name)
+
+		typ, ok := existing[col.name]
+		if !ok {
+			err = c.AddTableColumn(ctx, name, col.name, col.typ)
+		} else if typ != col.typ {
+			err = c.AlterTableColumn(ctx, name, col.name, col.typ)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return names, nil
+}
+
+type column struct {
+	name string
+	typ  string
+}
+
+// queryColumns runs a query where the first two columns of each row are a column name and type.
+func (c *connection) queryColumns(ctx context.Context, query string, args ...any) ([]column, error) {
+	conn, release, err := c.acquireMetaConn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = release() }()
+
+	rows, err := conn.QueryxContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []column
+	for rows.Next() {
+		vals, err := rows.SliceScan()
+		if err != nil {
+			return nil, err
+		}
+		if len(vals) < 2 {
+			return nil, fmt.Errorf("expected a column name and type, got %d values", len(vals))
+		}
+		res = append(res, column{name: fmt.Sprint(vals[0]), typ: fmt.Sprint(vals[1])})
+	}
+	return res, rows.Err()
+}
+
+// tableEngine returns the engine and partition key of a table.
+func (c *connection) tableEngine(ctx context.Context, name string) (string, string, error) {
+	conn, release, err := c.acquireMetaConn(ctx)
+	if err != nil {
+		return "", "", err
+	}
+	defer func() { _ = release() }()
+
+	var engine, partitionKey string
+	err = conn.QueryRowxContext(ctx, "SELECT engine, partition_key FROM system.tables WHERE database = currentDatabase() AND name = ?", name).Scan(&engine, &partitionKey)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to get engine of table %q: %w", name, err)
+	}
+	return engine, partitionKey, nil
+}
+
+// activePartitions returns the IDs of the partitions that contain data in a table.
+func (c *connection) activePartitions(ctx context.Context, name string) ([]string, error) {
+	conn, release, err := c.acquireMetaConn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = release() }()
+
+	var partitions []string
+	err = conn.SelectContext(ctx, &partitions, "SELECT DISTINCT partition_id FROM system.parts WHERE database = currentDatabase() AND table = ? AND active", name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get partitions of table %q: %w", name, err)
+	}
+	return partitions, nil
 }
 
 // DropTable implements drivers.OLAPStore.
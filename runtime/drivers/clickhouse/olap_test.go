@@ -0,0 +1,96 @@
+package clickhouse
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/activity"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/clickhouse"
+	"go.uber.org/zap"
+)
+
+func TestInsertTableAsSelect(t *testing.T) {
+	if testing.Short() {
+		t.Skip("clickhouse: skipping test in short mode")
+	}
+
+	ctx := context.Background()
+	clickHouseContainer, err := clickhouse.RunContainer(ctx,
+		testcontainers.WithImage("clickhouse/clickhouse-server:latest"),
+		clickhouse.WithUsername("clickhouse"),
+		clickhouse.WithPassword("clickhouse"),
+		clickhouse.WithConfigFile("../../testruntime/testdata/clickhouse-config.xml"),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		err := clickHouseContainer.Terminate(ctx)
+		require.NoError(t, err)
+	})
+
+	host, err := clickHouseContainer.Host(ctx)
+	require.NoError(t, err)
+	port, err := clickHouseContainer.MappedPort(ctx, "9000/tcp")
+	require.NoError(t, err)
+
+	conn, err := driver{}.Open("default", map[string]any{"dsn": fmt.Sprintf("clickhouse://clickhouse:clickhouse@%v:%v", host, port.Port())}, activity.NewNoopClient(), zap.NewNop())
+	require.NoError(t, err)
+	c := conn.(*connection)
+	t.Run("testInsertAppend", func(t *testing.T) { testInsertAppend(t, c) })
+	t.Run("testInsertMergeOnKey", func(t *testing.T) { testInsertMergeOnKey(t, c) })
+	t.Run("testInsertMergePartitions", func(t *testing.T) { testInsertMergePartitions(t, c) })
+}
+
+func testInsertAppend(t *testing.T, c *connection) {
+	ctx := context.Background()
+	props := &ModelOutputProperties{Materialize: boolPtr(true)}
+	require.NoError(t, props.Validate(&drivers.ModelExecutorOptions{Incremental: true}))
+
+	err := c.createTableAsSelect(ctx, "append_tbl", false, "SELECT 1 AS id", props)
+	require.NoError(t, err)
+
+	// Inserting by name adds the new column to the table
+	err = c.InsertTableAsSelect(ctx, "append_tbl", "SELECT 2 AS id, 'b' AS name", true, true, drivers.IncrementalStrategyAppend, nil)
+	require.NoError(t, err)
+
+	var count int
+	require.NoError(t, c.db.QueryRowxContext(ctx, "SELECT count() FROM append_tbl WHERE name = 'b'").Scan(&count))
+	require.Equal(t, 1, count)
+	require.NoError(t, c.db.QueryRowxContext(ctx, "SELECT count() FROM append_tbl").Scan(&count))
+	require.Equal(t, 2, count)
+}
+
+func testInsertMergeOnKey(t *testing.T, c *connection) {
+	ctx := context.Background()
+	props := &ModelOutputProperties{UniqueKey: []string{"id"}}
+	require.NoError(t, props.Validate(&drivers.ModelExecutorOptions{Incremental: true}))
+
+	err := c.createTableAsSelect(ctx, "merge_key_tbl", false, "SELECT 1 AS id, 'a' AS name UNION ALL SELECT 2, 'b'", props)
+	require.NoError(t, err)
+
+	err = c.InsertTableAsSelect(ctx, "merge_key_tbl", "SELECT 2 AS id, 'c' AS name", true, true, props.IncrementalStrategy, props.UniqueKey)
+	require.NoError(t, err)
+
+	var name string
+	require.NoError(t, c.db.QueryRowxContext(ctx, "SELECT name FROM merge_key_tbl FINAL WHERE id = 2").Scan(&name))
+	require.Equal(t, "c", name)
+}
+
+func testInsertMergePartitions(t *testing.T, c *connection) {
+	ctx := context.Background()
+	props := &ModelOutputProperties{IncrementalStrategy: drivers.IncrementalStrategyMerge, PartitionBy: "day"}
+	require.NoError(t, props.Validate(&drivers.ModelExecutorOptions{Incremental: true}))
+
+	err := c.createTableAsSelect(ctx, "merge_part_tbl", false, "SELECT 1 AS day, 10 AS val UNION ALL SELECT 2, 20", props)
+	require.NoError(t, err)
+
+	err = c.InsertTableAsSelect(ctx, "merge_part_tbl", "SELECT 2 AS day, 30 AS val", true, true, props.IncrementalStrategy, nil)
+	require.NoError(t, err)
+
+	var sum int
+	require.NoError(t, c.db.QueryRowxContext(ctx, "SELECT sum(val) FROM merge_part_tbl").Scan(&sum))
+	require.Equal(t, 40, sum)
+}
@@ -121,6 +121,10 @@ type Handle interface {
 	// NOTE: The file store can probably be merged with the ObjectStore interface.
 	AsFileStore() (FileStore, bool)
 
+	// AsStreamStore returns a StreamStore if the driver can serve as such, otherwise returns false.
+	// A stream store can consume records from a continuously updated stream, such as a Kafka topic.
+	AsStreamStore() (StreamStore, bool)
+
 	// AsModelExecutor returns a ModelExecutor capable of building a model.
 	// Since models may move data between connectors, the model executor can be seem as a "meta driver" that uses handles on other connectors.
 	// The provided options provides both an input connector and an output connector. One or both of these will be the receiver itself.
@@ -0,0 +1,102 @@
+package druid
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/rilldata/rill/runtime/drivers"
+)
+
+type selfToSelfExecutor struct {
+	c    *connection
+	opts *drivers.ModelExecutorOptions
+}
+
+func (e *selfToSelfExecutor) Execute(ctx context.Context) (*drivers.ModelResult, error) {
+	inputProps := &ModelInputProperties{}
+	if err := mapstructure.WeakDecode(e.opts.InputProperties, inputProps); err != nil {
+		return nil, fmt.Errorf("failed to parse input properties: %w", err)
+	}
+	if err := inputProps.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid input properties: %w", err)
+	}
+
+	outputProps := &ModelOutputProperties{}
+	if err := mapstructure.WeakDecode(e.opts.OutputProperties, outputProps); err != nil {
+		return nil, fmt.Errorf("failed to parse output properties: %w", err)
+	}
+	if err := outputProps.Validate(e.opts); err != nil {
+		return nil, fmt.Errorf("invalid output properties: %w", err)
+	}
+
+	usedModelName := false
+	if outputProps.Table == "" {
+		outputProps.Table = e.opts.ModelName
+		usedModelName = true
+	}
+	tableName := outputProps.Table
+
+	var p drivers.Progress = drivers.NoOpProgress{}
+	if e.opts.Progress != nil {
+		p = e.opts.Progress
+	}
+
+	if !e.opts.IncrementalRun {
+		// Druid atomically replaces all segments of the datasource, but we build into a staging datasource if changes must be staged or the output must be tested before it is promoted.
+		stagingTableName := tableName
+		if e.opts.Env.StageChanges || e.opts.TestOutput != nil {
+			stagingTableName = stagingTableNameFor(tableName)
+		}
+
+		// Create the datasource
+		err := e.c.createTableAsSelect(ctx, stagingTableName, inputProps.SQL, outputProps, p)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create model: %w", err)
+		}
+
+		// Test the output before promoting it
+		if e.opts.TestOutput != nil {
+			err = e.opts.TestOutput(ctx, stagingTableName)
+			if err != nil {
+				if stagingTableName != tableName {
+					_ = e.c.dropDatasource(ctx, stagingTableName)
+				}
+				return nil, err
+			}
+		}
+
+		// Copy the staging datasource to the final datasource
+		if stagingTableName != tableName {
+			err = e.c.renameTable(ctx, stagingTableName, tableName, outputProps.PartitionBy, p)
+			if err != nil {
+				return nil, fmt.Errorf("failed to rename staged model: %w", err)
+			}
+		}
+	} else {
+		// Insert into the datasource
+		err := e.c.insertTableAsSelect(ctx, tableName, inputProps.SQL, outputProps, p)
+		if err != nil {
+			return nil, fmt.Errorf("failed to incrementally insert into table: %w", err)
+		}
+	}
+
+	// Build result props
+	resultProps := &ModelResultProperties{
+		Table:         tableName,
+		UsedModelName: usedModelName,
+		PartitionBy:   outputProps.PartitionBy,
+	}
+	resultPropsMap := map[string]interface{}{}
+	err := mapstructure.WeakDecode(resultProps, &resultPropsMap)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode result properties: %w", err)
+	}
+
+	// Done
+	return &drivers.ModelResult{
+		Connector:  e.opts.OutputConnector,
+		Properties: resultPropsMap,
+		Table:      tableName,
+	}, nil
+}
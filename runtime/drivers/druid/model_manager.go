@@ -0,0 +1,153 @@
+package druid
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/rilldata/rill/runtime/drivers"
+)
+
+// defaultPartitionBy is the default segment granularity of datasources created by models.
+const defaultPartitionBy = "DAY"
+
+// segmentGranularityPeriods maps the supported segment granularities to ISO 8601 periods.
+// An empty period means that all data is in a single time chunk.
+var segmentGranularityPeriods = map[string]string{
+	"HOUR":     "PT1H",
+	"DAY":      "P1D",
+	"MONTH":    "P1M",
+	"YEAR":     "P1Y",
+	"ALL":      "",
+	"ALL TIME": "",
+}
+
+type ModelInputProperties struct {
+	SQL string `mapstructure:"sql"`
+}
+
+func (p *ModelInputProperties) Validate() error {
+	if p.SQL == "" {
+		return fmt.Errorf("missing property 'sql'")
+	}
+	return nil
+}
+
+type ModelOutputProperties struct {
+	Table               string                      `mapstructure:"table"`
+	Materialize         *bool                       `mapstructure:"materialize"`
+	UniqueKey           []string                    `mapstructure:"unique_key"`
+	IncrementalStrategy drivers.IncrementalStrategy `mapstructure:"incremental_strategy"`
+	// PartitionBy is the segment granularity of the datasource (HOUR, DAY, MONTH, YEAR or ALL). Defaults to DAY.
+	// Incremental models with the merge strategy replace entire time chunks of this granularity.
+	PartitionBy string `mapstructure:"partition_by"`
+	// ClusteredBy is the list of columns to sort and partition the data by within each time chunk.
+	ClusteredBy []string `mapstructure:"clustered_by"`
+}
+
+func (p *ModelOutputProperties) Validate(opts *drivers.ModelExecutorOptions) error {
+	if p.Materialize != nil && !*p.Materialize {
+		return fmt.Errorf("models on Druid are always materialized")
+	}
+
+	if len(p.UniqueKey) > 0 {
+		return fmt.Errorf(`"unique_key" is not supported on Druid; the %q strategy replaces the time chunks that contain new data`, drivers.IncrementalStrategyMerge)
+	}
+
+	switch p.IncrementalStrategy {
+	case drivers.IncrementalStrategyUnspecified:
+		p.IncrementalStrategy = drivers.IncrementalStrategyAppend
+	case drivers.IncrementalStrategyAppend, drivers.IncrementalStrategyMerge:
+	default:
+		return fmt.Errorf("invalid incremental strategy %q", p.IncrementalStrategy)
+	}
+
+	p.PartitionBy = strings.ToUpper(strings.TrimSpace(p.PartitionBy))
+	if p.PartitionBy == "" {
+		p.PartitionBy = defaultPartitionBy
+	}
+	if _, ok := segmentGranularityPeriods[p.PartitionBy]; !ok {
+		return fmt.Errorf(`invalid "partition_by" %q: must be one of HOUR, DAY, MONTH, YEAR or ALL`, p.PartitionBy)
+	}
+	if p.IncrementalStrategy == drivers.IncrementalStrategyMerge && segmentGranularityPeriods[p.PartitionBy] == "" {
+		// All data is in a single time chunk, so merging new data would replace the entire datasource.
+		return fmt.Errorf(`"partition_by" %q is not supported when "incremental_strategy" is %q`, p.PartitionBy, drivers.IncrementalStrategyMerge)
+	}
+
+	return nil
+}
+
+// partitioningClauses returns the PARTITIONED BY and CLUSTERED BY clauses of an ingestion query for the output properties.
+// It must be called after Validate.
+func (p *ModelOutputProperties) partitioningClauses() string {
+	var b strings.Builder
+	b.WriteString("PARTITIONED BY ")
+	b.WriteString(p.PartitionBy)
+	if len(p.ClusteredBy) > 0 {
+		cols := make([]string, len(p.ClusteredBy))
+		for i, col := range p.ClusteredBy {
+			cols[i] = safeSQLName(col)
+		}
+		b.WriteString(" CLUSTERED BY ")
+		b.WriteString(strings.Join(cols, ", "))
+	}
+	return b.String()
+}
+
+type ModelResultProperties struct {
+	Table         string `mapstructure:"table"`
+	UsedModelName bool   `mapstructure:"used_model_name"`
+	PartitionBy   string `mapstructure:"partition_by"`
+}
+
+func (c *connection) Rename(ctx context.Context, res *drivers.ModelResult, newName string, env *drivers.ModelEnv) (*drivers.ModelResult, error) {
+	resProps := &ModelResultProperties{}
+	if err := mapstructure.WeakDecode(res.Properties, resProps); err != nil {
+		return nil, fmt.Errorf("failed to parse previous result properties: %w", err)
+	}
+
+	if !resProps.UsedModelName {
+		return res, nil
+	}
+
+	if resProps.PartitionBy == "" {
+		resProps.PartitionBy = defaultPartitionBy
+	}
+	err := c.renameTable(ctx, resProps.Table, newName, resProps.PartitionBy, drivers.NoOpProgress{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to rename model: %w", err)
+	}
+
+	resProps.Table = newName
+	resPropsMap := map[string]interface{}{}
+	err = mapstructure.WeakDecode(resProps, &resPropsMap)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode result properties: %w", err)
+	}
+
+	return &drivers.ModelResult{
+		Connector:  res.Connector,
+		Properties: resPropsMap,
+		Table:      newName,
+	}, nil
+}
+
+func (c *connection) Exists(ctx context.Context, res *drivers.ModelResult) (bool, error) {
+	_, err := c.InformationSchema().Lookup(ctx, "", "", res.Table)
+	return err == nil, nil
+}
+
+func (c *connection) Delete(ctx context.Context, res *drivers.ModelResult) error {
+	if _, err := c.InformationSchema().Lookup(ctx, "", "", stagingTableNameFor(res.Table)); err == nil {
+		_ = c.dropDatasource(ctx, stagingTableNameFor(res.Table))
+	}
+
+	return c.dropDatasource(ctx, res.Table)
+}
+
+// stagingTableNameFor returns a stable temporary datasource name for a destination datasource.
+// By using a stable temporary name, we can ensure proper garbage collection without managing additional state.
+func stagingTableNameFor(table string) string {
+	return "__rill_tmp_model_" + table
+}
@@ -0,0 +1,206 @@
+package druid
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/rilldata/rill/runtime/drivers"
+	"go.uber.org/zap"
+)
+
+// msqTaskPollInterval is the interval at which the status of a multi-stage query (MSQ) task is polled.
+var msqTaskPollInterval = 2 * time.Second
+
+type msqTaskRequest struct {
+	Query   string         `json:"query"`
+	Context map[string]any `json:"context,omitempty"`
+}
+
+type msqTaskResponse struct {
+	TaskID string `json:"taskId"`
+	State  string `json:"state"`
+}
+
+type taskStatusResponse struct {
+	Status struct {
+		Status   string `json:"status"`
+		ErrorMsg string `json:"errorMsg"`
+	} `json:"status"`
+}
+
+type msqTaskReport struct {
+	MultiStageQuery struct {
+		Payload struct {
+			Stages []struct {
+				StageNumber int    `json:"stageNumber"`
+				Phase       string `json:"phase"`
+			} `json:"stages"`
+		} `json:"payload"`
+	} `json:"multiStageQuery"`
+}
+
+// apiError is the error body returned by the Druid APIs.
+type apiError struct {
+	Error        string `json:"error"`
+	ErrorMessage string `json:"errorMessage"`
+}
+
+// executeMSQ runs a SQL-based ingestion statement (such as INSERT or REPLACE) as a multi-stage query task.
+// It returns once the task has completed and its segments have been loaded.
+// The progress of the task is reported as the number of completed query stages.
+func (c *connection) executeMSQ(ctx context.Context, query string, p drivers.Progress) error {
+	if c.config.LogQueries {
+		c.logger.Info("druid ingestion query", zap.String("sql", query))
+	}
+
+	req := &msqTaskRequest{
+		Query: query,
+		Context: map[string]any{
+			// Wait for segments to be loaded by historicals, so the output is queryable once the task completes.
+			"waitUntilSegmentsLoad": true,
+		},
+	}
+	var res msqTaskResponse
+	err := c.sendAPIRequest(ctx, http.MethodPost, "/druid/v2/sql/task", req, &res)
+	if err != nil {
+		return fmt.Errorf("failed to submit ingestion task: %w", err)
+	}
+	if res.TaskID == "" {
+		return errors.New("failed to submit ingestion task: no task ID returned")
+	}
+
+	totalStages := 0
+	completedStages := 0
+	ticker := time.NewTicker(msqTaskPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			c.shutdownTask(res.TaskID)
+			return ctx.Err()
+		case <-ticker.C:
+		}
+
+		var status taskStatusResponse
+		err := c.sendAPIRequest(ctx, http.MethodGet, fmt.Sprintf("/druid/indexer/v1/task/%s/status", url.PathEscape(res.TaskID)), nil, &status)
+		if err != nil {
+			if ctx.Err() != nil {
+				c.shutdownTask(res.TaskID)
+				return ctx.Err()
+			}
+			return fmt.Errorf("failed to get status of ingestion task %q: %w", res.TaskID, err)
+		}
+
+		// Report progress. The report may not be available until the task has started, so errors are ignored.
+		var report msqTaskReport
+		err = c.sendAPIRequest(ctx, http.MethodGet, fmt.Sprintf("/druid/indexer/v1/task/%s/reports", url.PathEscape(res.TaskID)), nil, &report)
+		if err == nil {
+			stages := report.MultiStageQuery.Payload.Stages
+			if len(stages) > totalStages {
+				totalStages = len(stages)
+				p.Target(int64(totalStages), drivers.ProgressUnitStage)
+			}
+			n := 0
+			for _, s := range stages {
+				if s.Phase == "FINISHED" {
+					n++
+				}
+			}
+			if n > completedStages {
+				p.Observe(int64(n-completedStages), drivers.ProgressUnitStage)
+				completedStages = n
+			}
+		}
+
+		switch status.Status.Status {
+		case "SUCCESS":
+			return nil
+		case "FAILED":
+			if status.Status.ErrorMsg != "" {
+				return fmt.Errorf("ingestion task %q failed: %s", res.TaskID, status.Status.ErrorMsg)
+			}
+			return fmt.Errorf("ingestion task %q failed", res.TaskID)
+		}
+	}
+}
+
+// shutdownTask requests Druid to stop a running task.
+func (c *connection) shutdownTask(taskID string) {
+	// Using context.Background() since the task should be stopped even if the caller's context was cancelled
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	err := c.sendAPIRequest(ctx, http.MethodPost, fmt.Sprintf("/druid/indexer/v1/task/%s/shutdown", url.PathEscape(taskID)), nil, nil)
+	if err != nil {
+		c.logger.Warn("druid: failed to shutdown ingestion task", zap.String("task_id", taskID), zap.Error(err))
+	}
+}
+
+// dropDatasource marks all segments of a datasource as unused, which removes it from queries.
+func (c *connection) dropDatasource(ctx context.Context, name string) error {
+	err := c.sendAPIRequest(ctx, http.MethodDelete, fmt.Sprintf("/druid/coordinator/v1/datasources/%s", url.PathEscape(name)), nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to drop datasource %q: %w", name, err)
+	}
+	return nil
+}
+
+// sendAPIRequest sends a request to the Druid HTTP API. The body and out are encoded and decoded as JSON if not nil.
+func (c *connection) sendAPIRequest(ctx context.Context, method, path string, body, out any) error {
+	reqURL, err := url.JoinPath(c.apiURL, path)
+	if err != nil {
+		return err
+	}
+
+	var reqBody io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, reqURL, reqBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode >= http.StatusBadRequest {
+		var apiErr apiError
+		if json.Unmarshal(resBody, &apiErr) == nil && apiErr.ErrorMessage != "" {
+			return fmt.Errorf("request failed with status %d: %s", res.StatusCode, apiErr.ErrorMessage)
+		}
+		if apiErr.Error != "" {
+			return fmt.Errorf("request failed with status %d: %s", res.StatusCode, apiErr.Error)
+		}
+		return fmt.Errorf("request failed with status %d", res.StatusCode)
+	}
+
+	if out != nil && len(resBody) > 0 {
+		err = json.Unmarshal(resBody, out)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
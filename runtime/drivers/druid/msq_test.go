@@ -0,0 +1,184 @@
+package druid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/activity"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// fakeDruid is a stand-in for the Druid SQL, task and coordinator APIs.
+type fakeDruid struct {
+	mu            sync.Mutex
+	queries       []string
+	dropped       []string
+	failTasks     bool
+	timeChunks    [][]string
+	statusPolls   map[string]int
+	submittedTask int
+}
+
+func newFakeDruid(t *testing.T) (*fakeDruid, *httptest.Server) {
+	f := &fakeDruid{statusPolls: map[string]int{}}
+	srv := httptest.NewServer(http.HandlerFunc(f.handle))
+	t.Cleanup(srv.Close)
+	return f, srv
+}
+
+func (f *fakeDruid) handle(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch {
+	case r.Method == http.MethodPost && r.URL.Path == "/druid/v2/sql/task":
+		var req msqTaskRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		f.queries = append(f.queries, req.Query)
+		f.submittedTask++
+		_ = json.NewEncoder(w).Encode(msqTaskResponse{TaskID: fmt.Sprintf("task-%d", f.submittedTask), State: "RUNNING"})
+	case r.Method == http.MethodPost && r.URL.Path == "/druid/v2/sql":
+		// Responds to the query for the time chunks of new data in the arrayLines format
+		_, _ = fmt.Fprintln(w, `["start","end"]`)
+		_, _ = fmt.Fprintln(w, `["VARCHAR","VARCHAR"]`)
+		for _, chunk := range f.timeChunks {
+			b, _ := json.Marshal(chunk)
+			_, _ = fmt.Fprintln(w, string(b))
+		}
+	case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/status"):
+		id := strings.Split(strings.TrimPrefix(r.URL.Path, "/druid/indexer/v1/task/"), "/")[0]
+		f.statusPolls[id]++
+		status := "RUNNING"
+		if f.statusPolls[id] > 1 {
+			status = "SUCCESS"
+			if f.failTasks {
+				status = "FAILED"
+			}
+		}
+		_, _ = fmt.Fprintf(w, `{"task":%q,"status":{"status":%q,"errorMsg":"boom"}}`, id, status)
+	case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/reports"):
+		id := strings.Split(strings.TrimPrefix(r.URL.Path, "/druid/indexer/v1/task/"), "/")[0]
+		phase := "READING_INPUT"
+		if f.statusPolls[id] > 1 {
+			phase = "FINISHED"
+		}
+		_, _ = fmt.Fprintf(w, `{"multiStageQuery":{"payload":{"stages":[{"stageNumber":0,"phase":"FINISHED"},{"stageNumber":1,"phase":%q}]}}}`, phase)
+	case r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, "/druid/coordinator/v1/datasources/"):
+		f.dropped = append(f.dropped, strings.TrimPrefix(r.URL.Path, "/druid/coordinator/v1/datasources/"))
+		_, _ = fmt.Fprint(w, `{"numChangedSegments":1}`)
+	default:
+		http.Error(w, `{"error":"not found"}`, http.StatusNotFound)
+	}
+}
+
+type recordingProgress struct {
+	target   int64
+	observed int64
+}
+
+func (p *recordingProgress) Target(val int64, unit drivers.ProgressUnit) {
+	p.target = val
+}
+
+func (p *recordingProgress) Observe(val int64, unit drivers.ProgressUnit) {
+	p.observed += val
+}
+
+func TestModelExecutorMSQ(t *testing.T) {
+	msqTaskPollInterval = 10 * time.Millisecond
+
+	f, srv := newFakeDruid(t)
+	conn, err := driver{}.Open("default", map[string]any{"dsn": srv.URL + "/druid/v2/sql"}, activity.NewNoopClient(), zap.NewNop())
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, conn.Close()) })
+
+	execute := func(incrementalRun bool, outputProps map[string]any, p drivers.Progress) error {
+		opts := &drivers.ModelExecutorOptions{
+			Env:              &drivers.ModelEnv{},
+			ModelName:        "events",
+			InputHandle:      conn,
+			InputConnector:   "druid",
+			InputProperties:  map[string]any{"sql": "SELECT * FROM src"},
+			OutputHandle:     conn,
+			OutputConnector:  "druid",
+			OutputProperties: outputProps,
+			Incremental:      true,
+			IncrementalRun:   incrementalRun,
+			Progress:         p,
+		}
+		executor, ok := conn.AsModelExecutor("default", opts)
+		require.True(t, ok)
+		_, err := executor.Execute(context.Background())
+		return err
+	}
+
+	// Initial run replaces the whole datasource
+	p := &recordingProgress{}
+	err = execute(false, map[string]any{"partition_by": "hour", "clustered_by": []string{"country"}}, p)
+	require.NoError(t, err)
+	require.Equal(t, "REPLACE INTO \"events\" OVERWRITE ALL\nSELECT * FROM src\nPARTITIONED BY HOUR CLUSTERED BY \"country\"", f.queries[0])
+	require.Equal(t, int64(2), p.target)
+	require.Equal(t, int64(2), p.observed)
+
+	// Append inserts new rows
+	err = execute(true, map[string]any{}, nil)
+	require.NoError(t, err)
+	require.Equal(t, "INSERT INTO \"events\"\nSELECT * FROM src\nPARTITIONED BY DAY", f.queries[1])
+
+	// Merge replaces the time chunks that contain new data
+	f.timeChunks = [][]string{
+		{"2024-01-02T00:00:00.000Z", "2024-01-03T00:00:00.000Z"},
+		{"2024-01-01T00:00:00.000Z", "2024-01-02T00:00:00.000Z"},
+	}
+	err = execute(true, map[string]any{"incremental_strategy": "merge"}, nil)
+	require.NoError(t, err)
+	require.Equal(t, "REPLACE INTO \"events\" OVERWRITE WHERE __time >= TIMESTAMP '2024-01-01 00:00:00' AND __time < TIMESTAMP '2024-01-03 00:00:00'\nSELECT * FROM src\nPARTITIONED BY DAY", f.queries[2])
+
+	// Merge doesn't replace the time chunks between non-contiguous new data
+	f.timeChunks = [][]string{
+		{"2024-01-01T00:00:00.000Z", "2024-01-02T00:00:00.000Z"},
+		{"2024-01-05T00:00:00.000Z", "2024-01-06T00:00:00.000Z"},
+	}
+	err = execute(true, map[string]any{"incremental_strategy": "merge"}, nil)
+	require.NoError(t, err)
+	require.Equal(t, "REPLACE INTO \"events\" OVERWRITE WHERE (__time >= TIMESTAMP '2024-01-01 00:00:00' AND __time < TIMESTAMP '2024-01-02 00:00:00') OR (__time >= TIMESTAMP '2024-01-05 00:00:00' AND __time < TIMESTAMP '2024-01-06 00:00:00')\nSELECT * FROM src\nPARTITIONED BY DAY", f.queries[3])
+
+	// Merge without new data doesn't submit a task
+	f.timeChunks = nil
+	err = execute(true, map[string]any{"incremental_strategy": "merge"}, nil)
+	require.NoError(t, err)
+	require.Len(t, f.queries, 4)
+
+	// Unique keys are not supported
+	err = execute(true, map[string]any{"incremental_strategy": "merge", "unique_key": []string{"id"}}, nil)
+	require.ErrorContains(t, err, "unique_key")
+
+	// Merge can't replace a single time chunk when all data is in one
+	err = execute(true, map[string]any{"incremental_strategy": "merge", "partition_by": "all"}, nil)
+	require.ErrorContains(t, err, `"partition_by" "ALL" is not supported`)
+	require.Len(t, f.queries, 4)
+
+	// Failed tasks return an error
+	f.failTasks = true
+	err = execute(false, map[string]any{}, nil)
+	require.ErrorContains(t, err, "boom")
+	f.failTasks = false
+
+	// Dropping a table drops the datasource through the coordinator
+	olap, ok := conn.AsOLAP("default")
+	require.True(t, ok)
+	require.NoError(t, olap.DropTable(context.Background(), "events", false))
+	require.Equal(t, []string{"events"}, f.dropped)
+}
@@ -3,6 +3,7 @@ package druid
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -21,33 +22,156 @@ const (
 var _ drivers.OLAPStore = &connection{}
 
 // AddTableColumn implements drivers.OLAPStore.
+// Druid derives a datasource's columns from its segments, so columns are added by ingesting data that contains them.
 func (c *connection) AddTableColumn(ctx context.Context, tableName, columnName, typ string) error {
-	return fmt.Errorf("druid: data transformation not yet supported")
+	return fmt.Errorf("druid: adding columns is not supported")
 }
 
 // AlterTableColumn implements drivers.OLAPStore.
 func (c *connection) AlterTableColumn(ctx context.Context, tableName, columnName, newType string) error {
-	return fmt.Errorf("druid: data transformation not yet supported")
+	return fmt.Errorf("druid: altering columns is not supported")
 }
 
 // CreateTableAsSelect implements drivers.OLAPStore.
 func (c *connection) CreateTableAsSelect(ctx context.Context, name string, view bool, sql string) error {
-	return fmt.Errorf("druid: data transformation not yet supported")
+	if view {
+		return fmt.Errorf("druid: views are not supported")
+	}
+	return c.createTableAsSelect(ctx, name, sql, &ModelOutputProperties{PartitionBy: defaultPartitionBy}, drivers.NoOpProgress{})
+}
+
+// createTableAsSelect creates or replaces a datasource with the result of a SELECT statement using SQL-based ingestion.
+func (c *connection) createTableAsSelect(ctx context.Context, name, sql string, outputProps *ModelOutputProperties, p drivers.Progress) error {
+	query := fmt.Sprintf("REPLACE INTO %s OVERWRITE ALL\n%s\n%s", safeSQLName(name), sql, outputProps.partitioningClauses())
+	return c.executeMSQ(ctx, query, p)
 }
 
 // InsertTableAsSelect implements drivers.OLAPStore.
+// The merge strategy replaces the time chunks of the datasource that overlap with the new data.
 func (c *connection) InsertTableAsSelect(ctx context.Context, name, sql string, byName, inPlace bool, strategy drivers.IncrementalStrategy, uniqueKey []string) error {
-	return fmt.Errorf("druid: data transformation not yet supported")
+	if len(uniqueKey) > 0 {
+		return fmt.Errorf("druid: unique keys are not supported")
+	}
+	return c.insertTableAsSelect(ctx, name, sql, &ModelOutputProperties{IncrementalStrategy: strategy, PartitionBy: defaultPartitionBy}, drivers.NoOpProgress{})
+}
+
+// insertTableAsSelect inserts the result of a SELECT statement into a datasource using SQL-based ingestion.
+func (c *connection) insertTableAsSelect(ctx context.Context, name, sql string, outputProps *ModelOutputProperties, p drivers.Progress) error {
+	switch outputProps.IncrementalStrategy {
+	case drivers.IncrementalStrategyAppend:
+		query := fmt.Sprintf("INSERT INTO %s\n%s\n%s", safeSQLName(name), sql, outputProps.partitioningClauses())
+		return c.executeMSQ(ctx, query, p)
+	case drivers.IncrementalStrategyMerge:
+		overwrite, ok, err := c.overwriteClause(ctx, sql, outputProps.PartitionBy)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			// No new data, so there is nothing to replace
+			return nil
+		}
+		query := fmt.Sprintf("REPLACE INTO %s %s\n%s\n%s", safeSQLName(name), overwrite, sql, outputProps.partitioningClauses())
+		return c.executeMSQ(ctx, query, p)
+	default:
+		return fmt.Errorf("druid: incremental insert strategy %q not supported", outputProps.IncrementalStrategy)
+	}
+}
+
+// overwriteClause returns the OVERWRITE clause for replacing the time chunks that contain the result of a SELECT statement.
+// The time chunks are determined by the segment granularity. Only chunks that contain new rows are replaced, so gaps in the new data don't drop existing data.
+// It returns false if the SELECT statement doesn't return any rows.
+func (c *connection) overwriteClause(ctx context.Context, selectSQL, partitionBy string) (string, bool, error) {
+	period := segmentGranularityPeriods[partitionBy]
+	if period == "" {
+		return "OVERWRITE ALL", true, nil
+	}
+
+	query := fmt.Sprintf(
+		"SELECT TIME_FORMAT(t), TIME_FORMAT(TIME_SHIFT(t, '%s', 1)) FROM (SELECT TIME_FLOOR(__time, '%s') AS t FROM (%s\n) GROUP BY 1)",
+		period, period, selectSQL,
+	)
+	rows, err := c.db.QueryxContext(ctx, query)
+	if err != nil {
+		return "", false, fmt.Errorf("failed to get time chunks of new data: %w", err)
+	}
+	defer rows.Close()
+
+	var chunks [][2]time.Time
+	for rows.Next() {
+		var start, end string
+		if err := rows.Scan(&start, &end); err != nil {
+			return "", false, fmt.Errorf("failed to get time chunks of new data: %w", err)
+		}
+		startTime, err := time.Parse(time.RFC3339, start)
+		if err != nil {
+			return "", false, fmt.Errorf("failed to parse start of time chunk %q: %w", start, err)
+		}
+		endTime, err := time.Parse(time.RFC3339, end)
+		if err != nil {
+			return "", false, fmt.Errorf("failed to parse end of time chunk %q: %w", end, err)
+		}
+		chunks = append(chunks, [2]time.Time{startTime, endTime})
+	}
+	if err := rows.Err(); err != nil {
+		return "", false, fmt.Errorf("failed to get time chunks of new data: %w", err)
+	}
+	if len(chunks) == 0 {
+		return "", false, nil
+	}
+
+	// Merge adjacent chunks into intervals to keep the clause short
+	sort.Slice(chunks, func(i, j int) bool { return chunks[i][0].Before(chunks[j][0]) })
+	intervals := chunks[:1]
+	for _, chunk := range chunks[1:] {
+		last := &intervals[len(intervals)-1]
+		if !chunk[0].After(last[1]) {
+			if chunk[1].After(last[1]) {
+				last[1] = chunk[1]
+			}
+			continue
+		}
+		intervals = append(intervals, chunk)
+	}
+
+	const layout = "2006-01-02 15:04:05"
+	conds := make([]string, len(intervals))
+	for i, iv := range intervals {
+		conds[i] = fmt.Sprintf("__time >= TIMESTAMP '%s' AND __time < TIMESTAMP '%s'", iv[0].UTC().Format(layout), iv[1].UTC().Format(layout))
+	}
+	if len(conds) == 1 {
+		return "OVERWRITE WHERE " + conds[0], true, nil
+	}
+	return "OVERWRITE WHERE (" + strings.Join(conds, ") OR (") + ")", true, nil
 }
 
 // DropTable implements drivers.OLAPStore.
 func (c *connection) DropTable(ctx context.Context, name string, view bool) error {
-	return fmt.Errorf("druid: data transformation not yet supported")
+	if view {
+		return fmt.Errorf("druid: views are not supported")
+	}
+	return c.dropDatasource(ctx, name)
 }
 
 // RenameTable implements drivers.OLAPStore.
+// Druid can't rename datasources, so the data is copied to a new datasource and the old datasource is dropped.
 func (c *connection) RenameTable(ctx context.Context, name, newName string, view bool) error {
-	return fmt.Errorf("druid: data transformation not yet supported")
+	if view {
+		return fmt.Errorf("druid: views are not supported")
+	}
+	return c.renameTable(ctx, name, newName, defaultPartitionBy, drivers.NoOpProgress{})
+}
+
+// renameTable copies a datasource to a new datasource with the given segment granularity and drops the old datasource.
+func (c *connection) renameTable(ctx context.Context, name, newName, partitionBy string, p drivers.Progress) error {
+	if name == newName {
+		return nil
+	}
+	props := &ModelOutputProperties{PartitionBy: partitionBy}
+	err := c.createTableAsSelect(ctx, newName, fmt.Sprintf("SELECT * FROM %s", safeSQLName(name)), props, p)
+	if err != nil {
+		return err
+	}
+	return c.dropDatasource(ctx, name)
 }
 
 func (c *connection) Dialect() drivers.Dialect {
@@ -326,3 +450,7 @@ func (retryErrClassifier) Classify(err error) retrier.Action {
 
 	return retrier.Fail
 }
+
+func safeSQLName(name string) string {
+	return drivers.DialectDruid.EscapeIdentifier(name)
+}
@@ -3,6 +3,7 @@ package duckdb
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/rilldata/rill/runtime/drivers"
@@ -188,3 +189,142 @@ func (c *connection) DeleteResources(ctx context.Context) error {
 
 	return nil
 }
+
+func (c *connection) FindModelPartitions(ctx context.Context, opts *drivers.FindModelPartitionsOptions) ([]drivers.ModelPartition, error) {
+	var qry strings.Builder
+	qry.WriteString("SELECT key, data_json, idx, watermark, executed_on, error, elapsed_ms FROM rill.model_partitions WHERE model_id=? AND (idx>? OR (idx=? AND key>?))")
+	args := []any{opts.ModelID, opts.AfterIndex, opts.AfterIndex, opts.AfterKey}
+
+	if opts.WherePending && opts.WhereErrored {
+		qry.WriteString(" AND (executed_on IS NULL OR error != '')")
+	} else if opts.WherePending {
+		qry.WriteString(" AND executed_on IS NULL")
+	} else if opts.WhereErrored {
+		qry.WriteString(" AND error != ''")
+	}
+
+	qry.WriteString(" ORDER BY idx, key")
+	if opts.Limit > 0 {
+		qry.WriteString(" LIMIT ?")
+		args = append(args, opts.Limit)
+	}
+
+	return c.queryModelPartitions(ctx, qry.String(), args...)
+}
+
+func (c *connection) FindModelPartitionsByKeys(ctx context.Context, modelID string, keys []string) ([]drivers.ModelPartition, error) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
+
+	args := []any{modelID}
+	for _, k := range keys {
+		args = append(args, k)
+	}
+
+	qry := fmt.Sprintf(
+		"SELECT key, data_json, idx, watermark, executed_on, error, elapsed_ms FROM rill.model_partitions WHERE model_id=? AND key IN (%s) ORDER BY idx, key",
+		strings.TrimSuffix(strings.Repeat("?,", len(keys)), ","),
+	)
+
+	return c.queryModelPartitions(ctx, qry, args...)
+}
+
+func (c *connection) InsertModelPartition(ctx context.Context, modelID string, partition drivers.ModelPartition) error {
+	conn, release, err := c.acquireMetaConn(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = release() }()
+
+	_, err = conn.ExecContext(
+		ctx,
+		"INSERT INTO rill.model_partitions(model_id, key, data_json, idx, watermark, executed_on, error, elapsed_ms) VALUES (?, ?, ?, ?, ?, ?, ?, ?)",
+		modelID,
+		partition.Key,
+		partition.DataJSON,
+		partition.Index,
+		partition.Watermark,
+		partition.ExecutedOn,
+		partition.Error,
+		partition.Elapsed.Milliseconds(),
+	)
+	if err != nil {
+		return c.checkErr(err)
+	}
+
+	return nil
+}
+
+func (c *connection) UpdateModelPartition(ctx context.Context, modelID string, partition drivers.ModelPartition) error {
+	conn, release, err := c.acquireMetaConn(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = release() }()
+
+	_, err = conn.ExecContext(
+		ctx,
+		"UPDATE rill.model_partitions SET data_json=?, idx=?, watermark=?, executed_on=?, error=?, elapsed_ms=? WHERE model_id=? AND key=?",
+		partition.DataJSON,
+		partition.Index,
+		partition.Watermark,
+		partition.ExecutedOn,
+		partition.Error,
+		partition.Elapsed.Milliseconds(),
+		modelID,
+		partition.Key,
+	)
+	if err != nil {
+		return c.checkErr(err)
+	}
+
+	return nil
+}
+
+func (c *connection) DeleteModelPartitions(ctx context.Context, modelID string) error {
+	conn, release, err := c.acquireMetaConn(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = release() }()
+
+	_, err = conn.ExecContext(ctx, "DELETE FROM rill.model_partitions WHERE model_id=?", modelID)
+	if err != nil {
+		return c.checkErr(err)
+	}
+
+	return nil
+}
+
+func (c *connection) queryModelPartitions(ctx context.Context, qry string, args ...any) ([]drivers.ModelPartition, error) {
+	conn, release, err := c.acquireMetaConn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = release() }()
+
+	rows, err := conn.QueryxContext(ctx, qry, args...)
+	if err != nil {
+		return nil, c.checkErr(err)
+	}
+	defer rows.Close()
+
+	var res []drivers.ModelPartition
+	for rows.Next() {
+		var elapsedMs int64
+		r := drivers.ModelPartition{}
+		err := rows.Scan(&r.Key, &r.DataJSON, &r.Index, &r.Watermark, &r.ExecutedOn, &r.Error, &elapsedMs)
+		if err != nil {
+			return nil, c.checkErr(err)
+		}
+		r.Elapsed = time.Duration(elapsedMs) * time.Millisecond
+		res = append(res, r)
+	}
+
+	if rows.Err() != nil {
+		return nil, c.checkErr(rows.Err())
+	}
+
+	return res, nil
+}
@@ -108,7 +108,7 @@ func Test_specialCharInPath(t *testing.T) {
 }
 
 func TestOverrides(t *testing.T) {
-	cfgMap := map[string]any{"path": "duck.db", "memory_limit_gb": "4", "cpu": "2", "max_memory_gb_override": "2", "threads_override": "10"}
+	cfgMap := map[string]any{"path": filepath.Join(t.TempDir(), "duck.db"), "memory_limit_gb": "4", "cpu": "2", "max_memory_gb_override": "2", "threads_override": "10"}
 	handle, err := Driver{}.Open("default", cfgMap, activity.NewNoopClient(), zap.NewNop())
 	require.NoError(t, err)
 
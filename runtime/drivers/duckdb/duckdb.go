@@ -377,6 +377,9 @@ func (c *connection) AsModelExecutor(instanceID string, opts *drivers.ModelExecu
 		if sqlstore, ok := opts.InputHandle.AsSQLStore(); ok {
 			return &sqlStoreToSelfExecutor{c, sqlstore, opts}, true
 		}
+		if store, ok := opts.InputHandle.AsObjectStore(); ok {
+			return &objectStoreToSelfExecutor{c, store, opts}, true
+		}
 	}
 	return nil, false
 }
@@ -405,6 +408,9 @@ func (c *connection) AsTransporter(from, to drivers.Handle) (drivers.Transporter
 		if store, ok := from.AsFileStore(); ok {
 			return NewFileStoreToDuckDB(store, olap, c.logger), true
 		}
+		if store, ok := from.AsStreamStore(); ok {
+			return NewStreamStoreToDuckDB(store, olap, c.logger), true
+		}
 	}
 	return nil, false
 }
@@ -413,6 +419,11 @@ func (c *connection) AsFileStore() (drivers.FileStore, bool) {
 	return nil, false
 }
 
+// AsStreamStore implements drivers.Handle.
+func (c *connection) AsStreamStore() (drivers.StreamStore, bool) {
+	return nil, false
+}
+
 // AsNotifier implements drivers.Connection.
 func (c *connection) AsNotifier(properties map[string]any) (drivers.Notifier, error) {
 	return nil, drivers.ErrNotNotifier
@@ -0,0 +1,12 @@
+CREATE TABLE rill.model_partitions (
+	model_id TEXT NOT NULL,
+	key TEXT NOT NULL,
+	data_json BLOB NOT NULL,
+	idx INTEGER NOT NULL,
+	watermark TIMESTAMPTZ,
+	executed_on TIMESTAMPTZ,
+	error TEXT NOT NULL,
+	elapsed_ms INTEGER NOT NULL
+);
+
+CREATE UNIQUE INDEX model_partitions_model_id_key_idx ON rill.model_partitions (model_id, key);
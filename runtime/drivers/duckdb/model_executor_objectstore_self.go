@@ -0,0 +1,183 @@
+package duckdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/fileutil"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"go.uber.org/zap"
+)
+
+type objectStoreToSelfExecutor struct {
+	c           *connection
+	objectStore drivers.ObjectStore
+	opts        *drivers.ModelExecutorOptions
+}
+
+var _ drivers.ModelExecutor = &objectStoreToSelfExecutor{}
+
+func (e *objectStoreToSelfExecutor) Execute(ctx context.Context) (*drivers.ModelResult, error) {
+	olap, ok := e.c.AsOLAP(e.c.instanceID)
+	if !ok {
+		return nil, fmt.Errorf("output connector is not OLAP")
+	}
+
+	outputProps := &ModelOutputProperties{}
+	if err := mapstructure.WeakDecode(e.opts.OutputProperties, outputProps); err != nil {
+		return nil, fmt.Errorf("failed to parse output properties: %w", err)
+	}
+	if err := outputProps.Validate(e.opts); err != nil {
+		return nil, fmt.Errorf("invalid output properties: %w", err)
+	}
+
+	usedModelName := false
+	if outputProps.Table == "" {
+		outputProps.Table = e.opts.ModelName
+		usedModelName = true
+	}
+
+	tableName := outputProps.Table
+	stagingTableName := tableName
+	if !e.opts.IncrementalRun {
+		if e.opts.Env.StageChanges {
+			stagingTableName = stagingTableNameFor(tableName)
+		}
+
+		// NOTE: This intentionally drops the end table if not staging changes.
+		if t, err := olap.InformationSchema().Lookup(ctx, "", "", stagingTableName); err == nil {
+			_ = olap.DropTable(ctx, stagingTableName, t.View)
+		}
+	}
+
+	err := e.queryAndInsert(ctx, olap, stagingTableName, outputProps)
+	if err != nil {
+		if !e.opts.IncrementalRun {
+			_ = olap.DropTable(ctx, stagingTableName, false)
+		}
+		return nil, err
+	}
+
+	if !e.opts.IncrementalRun {
+		// Test the output before promoting it
+		if e.opts.TestOutput != nil {
+			err = e.opts.TestOutput(ctx, stagingTableName)
+			if err != nil {
+				_ = olap.DropTable(ctx, stagingTableName, false)
+				return nil, err
+			}
+		}
+
+		if stagingTableName != tableName {
+			err = olapForceRenameTable(ctx, olap, stagingTableName, false, tableName)
+			if err != nil {
+				return nil, fmt.Errorf("failed to rename staged model: %w", err)
+			}
+		}
+	}
+
+	resultProps := &ModelResultProperties{
+		Table:         tableName,
+		UsedModelName: usedModelName,
+	}
+	resultPropsMap := map[string]interface{}{}
+	err = mapstructure.WeakDecode(resultProps, &resultPropsMap)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode result properties: %w", err)
+	}
+
+	// Done
+	return &drivers.ModelResult{
+		Connector:  e.opts.OutputConnector,
+		Properties: resultPropsMap,
+		Table:      tableName,
+	}, nil
+}
+
+func (e *objectStoreToSelfExecutor) queryAndInsert(ctx context.Context, olap drivers.OLAPStore, outputTable string, outputProps *ModelOutputProperties) (err error) {
+	start := time.Now()
+	e.c.logger.Debug("duckdb: objectstore transfer started", zap.String("model", e.opts.ModelName), observability.ZapCtx(ctx))
+	defer func() {
+		e.c.logger.Debug("duckdb: objectstore transfer finished", zap.Duration("elapsed", time.Since(start)), zap.Bool("success", err == nil), zap.Error(err), observability.ZapCtx(ctx))
+	}()
+
+	srcCfg, err := parseFileSourceProperties(e.opts.InputProperties)
+	if err != nil {
+		return err
+	}
+
+	iter, err := e.objectStore.DownloadFiles(ctx, e.opts.InputProperties)
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	size, ok := iter.Size(drivers.ProgressUnitByte)
+	if ok && !sizeWithinStorageLimits(olap, size) {
+		return drivers.ErrStorageLimitExceeded
+	}
+
+	// Open table formats resolve to data files in a regular file format
+	var format string
+	if iter.Format() != "" {
+		format = "." + iter.Format()
+	} else if srcCfg.Format != "" {
+		format = "." + srcCfg.Format
+	}
+
+	create := !e.opts.IncrementalRun
+	for {
+		files, err := iter.Next()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return err
+		}
+
+		if format == "" {
+			format = fileutil.FullExt(files[0])
+		}
+
+		from, err := sourceReader(files, format, srcCfg.DuckDB)
+		if err != nil {
+			return err
+		}
+		qry := fmt.Sprintf("SELECT * FROM %s", from)
+
+		if !create && e.opts.IncrementalRun {
+			err := olap.InsertTableAsSelect(ctx, outputTable, qry, false, true, outputProps.IncrementalStrategy, outputProps.UniqueKey)
+			if err != nil {
+				return fmt.Errorf("failed to incrementally insert into table: %w", err)
+			}
+			continue
+		}
+
+		if !create {
+			err := olap.InsertTableAsSelect(ctx, outputTable, qry, false, true, drivers.IncrementalStrategyAppend, nil)
+			if err != nil {
+				return fmt.Errorf("failed to insert into table: %w", err)
+			}
+			continue
+		}
+
+		err = olap.CreateTableAsSelect(ctx, outputTable, false, qry)
+		if err != nil {
+			return fmt.Errorf("failed to create table: %w", err)
+		}
+
+		create = false
+	}
+
+	// We were supposed to create the table, but didn't get any data
+	if create {
+		return drivers.ErrNoRows
+	}
+
+	return nil
+}
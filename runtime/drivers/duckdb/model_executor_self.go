@@ -69,6 +69,15 @@ func (e *selfToSelfExecutor) Execute(ctx context.Context) (*drivers.ModelResult,
 			return nil, fmt.Errorf("failed to create model: %w", err)
 		}
 
+		// Test the output before promoting it
+		if e.opts.TestOutput != nil {
+			err = e.opts.TestOutput(ctx, stagingTableName)
+			if err != nil {
+				_ = olap.DropTable(ctx, stagingTableName, asView)
+				return nil, err
+			}
+		}
+
 		// Rename the staging table to the final table name
 		if stagingTableName != tableName {
 			err = olapForceRenameTable(ctx, olap, stagingTableName, asView, tableName)
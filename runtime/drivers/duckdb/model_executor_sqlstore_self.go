@@ -65,6 +65,15 @@ func (e *sqlStoreToSelfExecutor) Execute(ctx context.Context) (*drivers.ModelRes
 	}
 
 	if !e.opts.IncrementalRun {
+		// Test the output before promoting it
+		if e.opts.TestOutput != nil {
+			err = e.opts.TestOutput(ctx, stagingTableName)
+			if err != nil {
+				_ = olap.DropTable(ctx, stagingTableName, false)
+				return nil, err
+			}
+		}
+
 		if stagingTableName != tableName {
 			err = olapForceRenameTable(ctx, olap, stagingTableName, false, tableName)
 			if err != nil {
@@ -64,7 +64,10 @@ func (t *objectStoreToDuckDB) Transfer(ctx context.Context, srcProps, sinkProps
 	opts.Progress.Target(size, drivers.ProgressUnitByte)
 	appendToTable := false
 	var format string
-	if srcCfg.Format != "" {
+	if iterator.Format() != "" {
+		// Open table formats resolve to data files in a regular file format
+		format = fmt.Sprintf(".%s", iterator.Format())
+	} else if srcCfg.Format != "" {
 		format = fmt.Sprintf(".%s", srcCfg.Format)
 	}
 
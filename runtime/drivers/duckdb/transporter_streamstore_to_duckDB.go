@@ -0,0 +1,103 @@
+package duckdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/rilldata/rill/runtime/drivers"
+	"go.uber.org/zap"
+)
+
+type streamStoreToDuckDB struct {
+	to     *connection
+	from   drivers.StreamStore
+	logger *zap.Logger
+}
+
+func NewStreamStoreToDuckDB(from drivers.StreamStore, to *connection, logger *zap.Logger) drivers.Transporter {
+	return &streamStoreToDuckDB{
+		to:     to,
+		from:   from,
+		logger: logger,
+	}
+}
+
+var (
+	_ drivers.Transporter       = &streamStoreToDuckDB{}
+	_ drivers.StreamTransporter = &streamStoreToDuckDB{}
+)
+
+// Transfer implements drivers.Transporter.
+// It ingests the stream from its configured start position into a new table.
+func (t *streamStoreToDuckDB) Transfer(ctx context.Context, srcProps, sinkProps map[string]any, opts *drivers.TransferOptions) error {
+	sinkCfg, err := parseSinkProperties(sinkProps)
+	if err != nil {
+		return err
+	}
+
+	_ = t.to.DropTable(ctx, sinkCfg.Table, false)
+
+	_, _, err = t.TransferBatch(ctx, srcProps, sinkProps, nil, opts)
+	return err
+}
+
+// TransferBatch implements drivers.StreamTransporter.
+func (t *streamStoreToDuckDB) TransferBatch(ctx context.Context, srcProps, sinkProps map[string]any, offsets map[string]int64, opts *drivers.TransferOptions) (map[string]int64, int64, error) {
+	sinkCfg, err := parseSinkProperties(sinkProps)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	logger := t.logger.With(zap.String("source", sinkCfg.Table))
+
+	batch, err := t.from.ReadBatch(ctx, srcProps, offsets)
+	if err != nil {
+		return nil, 0, err
+	}
+	if batch.Path == "" {
+		return batch.Offsets, 0, nil
+	}
+	defer os.Remove(batch.Path)
+
+	size := fileSize([]string{batch.Path})
+	if !sizeWithinStorageLimits(t.to, size) {
+		return nil, 0, drivers.ErrStorageLimitExceeded
+	}
+	opts.Progress.Target(batch.Records, drivers.ProgressUnitRecord)
+
+	sqlFunc := func(paths []string) (string, error) {
+		from, err := sourceReader(paths, ".ndjson", nil)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("SELECT * FROM %s", from), nil
+	}
+
+	_, err = t.to.InformationSchema().Lookup(ctx, "", "", sinkCfg.Table)
+	if err != nil {
+		if !errors.Is(err, drivers.ErrNotFound) {
+			return nil, 0, err
+		}
+		qry, err := sqlFunc([]string{batch.Path})
+		if err != nil {
+			return nil, 0, err
+		}
+		err = t.to.CreateTableAsSelect(ctx, sinkCfg.Table, false, qry)
+		if err != nil {
+			return nil, 0, err
+		}
+	} else {
+		// Records are semi-structured, so later batches may add fields or widen their types
+		a := newAppender(t.to, sinkCfg, true, logger, sqlFunc)
+		err = a.appendData(ctx, []string{batch.Path})
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+
+	logger.Debug("ingested stream batch", zap.Int64("records", batch.Records), zap.Int64("bytes", size))
+	opts.Progress.Observe(batch.Records, drivers.ProgressUnitRecord)
+	return batch.Offsets, batch.Records, nil
+}
@@ -97,6 +97,11 @@ func parseFileSourceProperties(props map[string]any) (*fileSourceProperties, err
 		cfg.IngestAllowSchemaRelaxation = nil
 	}
 
+	// The partition values of Iceberg tables are stored in the data files, so the paths of the files shouldn't be parsed as hive partitions
+	if strings.EqualFold(cfg.Format, "iceberg") && !hasKey(cfg.DuckDB, "hive_partitioning") {
+		cfg.DuckDB["hive_partitioning"] = false
+	}
+
 	if cfg.AllowSchemaRelaxation {
 		if val, ok := cfg.DuckDB["union_by_name"].(bool); ok && !val {
 			return nil, fmt.Errorf("can't set `union_by_name` and `allow_schema_relaxation` at the same time")
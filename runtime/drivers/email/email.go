@@ -0,0 +1,225 @@
+package email
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/activity"
+	"github.com/rilldata/rill/runtime/pkg/email"
+	"go.uber.org/zap"
+)
+
+// ErrNotConfigured is returned by AsNotifier when the connector has no SMTP server configured.
+// It wraps drivers.ErrNotifierNotConfigured, so callers can fall back to a notifier created with NewNotifier and a default email client.
+var ErrNotConfigured = fmt.Errorf("email connector does not have an SMTP server configured: %w", drivers.ErrNotifierNotConfigured)
+
+var spec = drivers.Spec{
+	DisplayName: "Email",
+	Description: "Email Notifier",
+	ConfigProperties: []*drivers.PropertySpec{
+		{
+			Key:         "smtp_host",
+			Type:        drivers.StringPropertyType,
+			DisplayName: "SMTP host",
+			Placeholder: "smtp.example.com",
+		},
+		{
+			Key:         "smtp_port",
+			Type:        drivers.NumberPropertyType,
+			DisplayName: "SMTP port",
+			Default:     "587",
+		},
+		{
+			Key:         "smtp_username",
+			Type:        drivers.StringPropertyType,
+			DisplayName: "SMTP username",
+		},
+		{
+			Key:         "smtp_password",
+			Type:        drivers.StringPropertyType,
+			DisplayName: "SMTP password",
+			Secret:      true,
+		},
+		{
+			Key:         "sender_email",
+			Type:        drivers.StringPropertyType,
+			DisplayName: "Sender email",
+			Placeholder: "noreply@example.com",
+		},
+		{
+			Key:         "sender_name",
+			Type:        drivers.StringPropertyType,
+			DisplayName: "Sender name",
+		},
+		{
+			Key:         "bcc",
+			Type:        drivers.StringPropertyType,
+			DisplayName: "BCC",
+			Description: "Email address to BCC on all sent emails",
+		},
+	},
+	ImplementsNotifier: true,
+}
+
+func init() {
+	drivers.Register("email", driver{})
+	drivers.RegisterAsConnector("email", driver{})
+}
+
+type driver struct{}
+
+func (d driver) Spec() drivers.Spec {
+	return spec
+}
+
+func (d driver) Open(instanceID string, config map[string]any, client *activity.Client, logger *zap.Logger) (drivers.Handle, error) {
+	if instanceID == "" {
+		return nil, fmt.Errorf("email driver can't be shared")
+	}
+	conf := &configProperties{}
+	err := mapstructure.WeakDecode(config, conf)
+	if err != nil {
+		return nil, err
+	}
+
+	// If an SMTP server is not configured, the handle is still valid, but AsNotifier will return ErrNotConfigured.
+	var emailClient *email.Client
+	if conf.SMTPHost != "" {
+		if conf.SMTPPort == 0 {
+			conf.SMTPPort = 587
+		}
+		sender, err := email.NewSMTPSender(&email.SMTPOptions{
+			SMTPHost:     conf.SMTPHost,
+			SMTPPort:     conf.SMTPPort,
+			SMTPUsername: conf.SMTPUsername,
+			SMTPPassword: conf.SMTPPassword,
+			FromEmail:    conf.SenderEmail,
+			FromName:     conf.SenderName,
+			BCC:          conf.BCC,
+		})
+		if err != nil {
+			return nil, err
+		}
+		emailClient = email.New(sender)
+	}
+
+	conn := &handle{
+		config: conf,
+		logger: logger,
+		client: emailClient,
+	}
+	return conn, nil
+}
+
+func (d driver) Drop(config map[string]any, logger *zap.Logger) error {
+	return nil
+}
+
+func (d driver) HasAnonymousSourceAccess(ctx context.Context, props map[string]any, logger *zap.Logger) (bool, error) {
+	return false, fmt.Errorf("not implemented")
+}
+
+func (d driver) TertiarySourceConnectors(ctx context.Context, src map[string]any, logger *zap.Logger) ([]string, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+type handle struct {
+	config *configProperties
+	logger *zap.Logger
+	client *email.Client
+}
+
+var _ drivers.Handle = &handle{}
+
+func (h *handle) Driver() string {
+	return "email"
+}
+
+func (h *handle) Config() map[string]any {
+	return map[string]any{}
+}
+
+func (h *handle) Migrate(ctx context.Context) error {
+	return nil
+}
+
+func (h *handle) MigrationStatus(ctx context.Context) (current, desired int, err error) {
+	return 0, 0, nil
+}
+
+func (h *handle) Close() error {
+	return nil
+}
+
+func (h *handle) AsRegistry() (drivers.RegistryStore, bool) {
+	return nil, false
+}
+
+func (h *handle) AsCatalogStore(instanceID string) (drivers.CatalogStore, bool) {
+	return nil, false
+}
+
+func (h *handle) AsRepoStore(instanceID string) (drivers.RepoStore, bool) {
+	return nil, false
+}
+
+func (h *handle) AsAdmin(instanceID string) (drivers.AdminService, bool) {
+	return nil, false
+}
+
+func (h *handle) AsAI(instanceID string) (drivers.AIService, bool) {
+	return nil, false
+}
+
+func (h *handle) AsSQLStore() (drivers.SQLStore, bool) {
+	return nil, false
+}
+
+func (h *handle) AsOLAP(instanceID string) (drivers.OLAPStore, bool) {
+	return nil, false
+}
+
+func (h *handle) AsObjectStore() (drivers.ObjectStore, bool) {
+	return nil, false
+}
+
+func (h *handle) AsFileStore() (drivers.FileStore, bool) {
+	return nil, false
+}
+
+// AsStreamStore implements drivers.Handle.
+func (h *handle) AsStreamStore() (drivers.StreamStore, bool) {
+	return nil, false
+}
+
+func (h *handle) AsModelExecutor(instanceID string, opts *drivers.ModelExecutorOptions) (drivers.ModelExecutor, bool) {
+	return nil, false
+}
+
+// AsModelManager implements drivers.Handle.
+func (h *handle) AsModelManager(instanceID string) (drivers.ModelManager, bool) {
+	return nil, false
+}
+
+func (h *handle) AsTransporter(from, to drivers.Handle) (drivers.Transporter, bool) {
+	return nil, false
+}
+
+func (h *handle) AsNotifier(properties map[string]any) (drivers.Notifier, error) {
+	if h.client == nil {
+		return nil, ErrNotConfigured
+	}
+	return NewNotifier(h.client, properties)
+}
+
+type configProperties struct {
+	SMTPHost     string `mapstructure:"smtp_host"`
+	SMTPPort     int    `mapstructure:"smtp_port"`
+	SMTPUsername string `mapstructure:"smtp_username"`
+	SMTPPassword string `mapstructure:"smtp_password"`
+	SenderEmail  string `mapstructure:"sender_email"`
+	SenderName   string `mapstructure:"sender_name"`
+	BCC          string `mapstructure:"bcc"`
+}
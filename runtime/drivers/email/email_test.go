@@ -0,0 +1,99 @@
+package email
+
+import (
+	"testing"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/activity"
+	"github.com/rilldata/rill/runtime/pkg/email"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestAsNotifier(t *testing.T) {
+	// Without an SMTP server, AsNotifier returns an error that lets callers fall back to a default client
+	h, err := driver{}.Open("default", map[string]any{}, activity.NewNoopClient(), zap.NewNop())
+	require.NoError(t, err)
+	_, err = h.AsNotifier(EncodeProps([]string{"foo@example.com"}))
+	require.ErrorIs(t, err, ErrNotConfigured)
+	require.ErrorIs(t, err, drivers.ErrNotifierNotConfigured)
+
+	// With an SMTP server, the handle creates its own notifier
+	h, err = driver{}.Open("default", map[string]any{
+		"smtp_host":     "smtp.example.com",
+		"smtp_port":     "2525",
+		"smtp_password": "secret",
+		"sender_email":  "noreply@example.com",
+	}, activity.NewNoopClient(), zap.NewNop())
+	require.NoError(t, err)
+	require.Equal(t, 2525, h.(*handle).config.SMTPPort)
+	n, err := h.AsNotifier(EncodeProps([]string{"foo@example.com"}))
+	require.NoError(t, err)
+	require.NotNil(t, n)
+
+	// The SMTP port defaults to 587
+	h, err = driver{}.Open("default", map[string]any{
+		"smtp_host":     "smtp.example.com",
+		"smtp_password": "secret",
+		"sender_email":  "noreply@example.com",
+	}, activity.NewNoopClient(), zap.NewNop())
+	require.NoError(t, err)
+	require.Equal(t, 587, h.(*handle).config.SMTPPort)
+
+	// An invalid sender email is rejected
+	_, err = driver{}.Open("default", map[string]any{
+		"smtp_host":     "smtp.example.com",
+		"smtp_password": "secret",
+		"sender_email":  "invalid",
+	}, activity.NewNoopClient(), zap.NewNop())
+	require.Error(t, err)
+
+	// The driver can't be shared across instances
+	_, err = driver{}.Open("", map[string]any{}, activity.NewNoopClient(), zap.NewNop())
+	require.Error(t, err)
+}
+
+func TestNotifier(t *testing.T) {
+	sender := email.NewTestSender()
+	n, err := NewNotifier(email.New(sender), EncodeProps([]string{"foo@example.com", "bar@example.com"}))
+	require.NoError(t, err)
+
+	err = n.SendAlertStatus(&drivers.AlertStatus{
+		Title:         "My alert",
+		ExecutionTime: time.Now(),
+		Status:        runtimev1.AssertionStatus_ASSERTION_STATUS_FAIL,
+		OpenLink:      "https://example.com/open",
+		EditLink:      "https://example.com/edit",
+	})
+	require.NoError(t, err)
+
+	err = n.SendScheduledReport(&drivers.ScheduledReport{
+		Title:          "My report",
+		ReportTime:     time.Now(),
+		DownloadFormat: "CSV",
+		OpenLink:       "https://example.com/open",
+		DownloadLink:   "https://example.com/download",
+		EditLink:       "https://example.com/edit",
+	})
+	require.NoError(t, err)
+
+	emails := sender.(*email.TestSender).Emails
+	require.Len(t, emails, 4)
+	require.Equal(t, "foo@example.com", emails[0].ToEmail)
+	require.Equal(t, "bar@example.com", emails[1].ToEmail)
+	require.Contains(t, emails[0].Subject, "My alert")
+	require.Equal(t, "foo@example.com", emails[2].ToEmail)
+	require.Equal(t, "bar@example.com", emails[3].ToEmail)
+	require.Contains(t, emails[2].Subject, "My report")
+}
+
+func TestDecodeProps(t *testing.T) {
+	props, err := DecodeProps(EncodeProps([]string{"foo@example.com"}))
+	require.NoError(t, err)
+	require.Equal(t, []string{"foo@example.com"}, props.Recipients)
+
+	_, err = DecodeProps(map[string]any{"recipients": map[string]any{"foo": "bar"}})
+	require.Error(t, err)
+}
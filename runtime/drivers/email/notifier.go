@@ -0,0 +1,86 @@
+package email
+
+import (
+	"fmt"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/email"
+	"github.com/rilldata/rill/runtime/pkg/pbutil"
+)
+
+type notifier struct {
+	client *email.Client
+	props  *NotifierProperties
+}
+
+type NotifierProperties struct {
+	Recipients []string `mapstructure:"recipients"`
+}
+
+// NewNotifier creates a notifier that sends emails to the recipients in propsMap using the provided client.
+func NewNotifier(client *email.Client, propsMap map[string]any) (drivers.Notifier, error) {
+	props, err := DecodeProps(propsMap)
+	if err != nil {
+		return nil, err
+	}
+	return &notifier{
+		client: client,
+		props:  props,
+	}, nil
+}
+
+func (n *notifier) SendAlertStatus(s *drivers.AlertStatus) error {
+	for _, recipient := range n.props.Recipients {
+		err := n.client.SendAlertStatus(&email.AlertStatus{
+			ToEmail:        recipient,
+			ToName:         "",
+			Title:          s.Title,
+			ExecutionTime:  s.ExecutionTime,
+			Status:         s.Status,
+			IsRecover:      s.IsRecover,
+			FailRow:        s.FailRow,
+			ExecutionError: s.ExecutionError,
+			OpenLink:       s.OpenLink,
+			EditLink:       s.EditLink,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to send email to %q: %w", recipient, err)
+		}
+	}
+	return nil
+}
+
+func (n *notifier) SendScheduledReport(s *drivers.ScheduledReport) error {
+	for _, recipient := range n.props.Recipients {
+		err := n.client.SendScheduledReport(&email.ScheduledReport{
+			ToEmail:        recipient,
+			ToName:         "",
+			Title:          s.Title,
+			ReportTime:     s.ReportTime,
+			DownloadFormat: s.DownloadFormat,
+			OpenLink:       s.OpenLink,
+			DownloadLink:   s.DownloadLink,
+			EditLink:       s.EditLink,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to send email to %q: %w", recipient, err)
+		}
+	}
+	return nil
+}
+
+func EncodeProps(recipients []string) map[string]any {
+	return map[string]any{
+		"recipients": pbutil.ToSliceAny(recipients),
+	}
+}
+
+func DecodeProps(propsMap map[string]any) (*NotifierProperties, error) {
+	props := &NotifierProperties{}
+	err := mapstructure.WeakDecode(propsMap, props)
+	if err != nil {
+		return nil, err
+	}
+	return props, nil
+}
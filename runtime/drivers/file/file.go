@@ -222,6 +222,11 @@ func (c *connection) AsFileStore() (drivers.FileStore, bool) {
 	return c, true
 }
 
+// AsStreamStore implements drivers.Handle.
+func (c *connection) AsStreamStore() (drivers.StreamStore, bool) {
+	return nil, false
+}
+
 // AsSQLStore implements drivers.Connection.
 func (c *connection) AsSQLStore() (drivers.SQLStore, bool) {
 	return nil, false
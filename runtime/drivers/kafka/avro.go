@@ -0,0 +1,71 @@
+package kafka
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/schemaregistry"
+	"github.com/rilldata/rill/runtime/pkg/avro"
+)
+
+// avroDecoder decodes Avro records encoded with the schema registry wire format:
+// a zero magic byte, followed by a 4-byte big-endian schema ID and the Avro binary encoding of the record.
+// Schemas are fetched from the schema registry and cached by ID.
+type avroDecoder struct {
+	registry schemaregistry.Client
+	subject  string
+
+	mu      sync.Mutex
+	schemas map[int]*avro.Schema
+}
+
+func newAvroDecoder(registry schemaregistry.Client, subject string) *avroDecoder {
+	return &avroDecoder{
+		registry: registry,
+		subject:  subject,
+		schemas:  make(map[int]*avro.Schema),
+	}
+}
+
+// Decode decodes a record into values that can be marshalled to JSON.
+func (d *avroDecoder) Decode(data []byte) (any, error) {
+	if len(data) < 5 || data[0] != 0 {
+		return nil, errors.New("avro: record is not encoded with the schema registry wire format")
+	}
+	id := int(binary.BigEndian.Uint32(data[1:5]))
+
+	schema, err := d.schema(id)
+	if err != nil {
+		return nil, err
+	}
+
+	v, err := avro.NewReader(data[5:]).Read(schema)
+	if err != nil {
+		return nil, fmt.Errorf("avro: failed to decode record with schema ID %d: %w", id, err)
+	}
+	return v, nil
+}
+
+func (d *avroDecoder) schema(id int) (*avro.Schema, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if t, ok := d.schemas[id]; ok {
+		return t, nil
+	}
+
+	info, err := d.registry.GetBySubjectAndID(d.subject, id)
+	if err != nil {
+		return nil, fmt.Errorf("avro: failed to get schema with ID %d: %w", id, err)
+	}
+
+	t, err := avro.ParseSchema(info.Schema)
+	if err != nil {
+		return nil, fmt.Errorf("avro: failed to parse schema with ID %d: %w", id, err)
+	}
+
+	d.schemas[id] = t
+	return t, nil
+}
@@ -0,0 +1,64 @@
+package kafka
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"testing"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/schemaregistry"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAvroDecode(t *testing.T) {
+	registry, err := schemaregistry.NewClient(schemaregistry.NewConfig("mock://avro"))
+	require.NoError(t, err)
+
+	schema := `{
+		"type": "record",
+		"name": "Event",
+		"namespace": "rill.test",
+		"fields": [
+			{"name": "id", "type": "long"},
+			{"name": "name", "type": "string"},
+			{"name": "tags", "type": {"type": "array", "items": "string"}},
+			{"name": "kind", "type": {"type": "enum", "name": "Kind", "symbols": ["A", "B"]}},
+			{"name": "score", "type": ["null", "double"]},
+			{"name": "day", "type": {"type": "int", "logicalType": "date"}}
+		]
+	}`
+	id, err := registry.Register("events-value", schemaregistry.SchemaInfo{Schema: schema}, false)
+	require.NoError(t, err)
+
+	// Wire format header
+	data := []byte{0}
+	data = binary.BigEndian.AppendUint32(data, uint32(id))
+	// id: long 42
+	data = binary.AppendVarint(data, 42)
+	// name: string "foo"
+	data = binary.AppendVarint(data, 3)
+	data = append(data, "foo"...)
+	// tags: one block with two items, then an empty block
+	data = binary.AppendVarint(data, 2)
+	data = binary.AppendVarint(data, 1)
+	data = append(data, "x"...)
+	data = binary.AppendVarint(data, 1)
+	data = append(data, "y"...)
+	data = binary.AppendVarint(data, 0)
+	// kind: enum index 1
+	data = binary.AppendVarint(data, 1)
+	// score: union index 0 (null)
+	data = binary.AppendVarint(data, 0)
+	// day: 1 day after the epoch
+	data = binary.AppendVarint(data, 1)
+
+	d := newAvroDecoder(registry, "events-value")
+	v, err := d.Decode(data)
+	require.NoError(t, err)
+
+	b, err := json.Marshal(v)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"id":42,"name":"foo","tags":["x","y"],"kind":"B","score":null,"day":"1970-01-02"}`, string(b))
+
+	_, err = d.Decode([]byte("not avro"))
+	require.Error(t, err)
+}
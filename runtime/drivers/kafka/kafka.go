@@ -0,0 +1,304 @@
+package kafka
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/confluentinc/confluent-kafka-go/v2/schemaregistry"
+	"github.com/mitchellh/mapstructure"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/activity"
+	"go.uber.org/zap"
+)
+
+func init() {
+	drivers.Register("kafka", driver{})
+	drivers.RegisterAsConnector("kafka", driver{})
+}
+
+var spec = drivers.Spec{
+	DisplayName: "Apache Kafka",
+	Description: "Continuously ingest records from a Kafka topic.",
+	DocsURL:     "https://docs.rilldata.com/reference/connectors/kafka",
+	ConfigProperties: []*drivers.PropertySpec{
+		{
+			Key:         "brokers",
+			Type:        drivers.StringPropertyType,
+			Required:    true,
+			DisplayName: "Brokers",
+			Description: "Comma-separated list of Kafka brokers.",
+			Placeholder: "localhost:9092",
+		},
+		{
+			Key:         "security_protocol",
+			Type:        drivers.StringPropertyType,
+			DisplayName: "Security protocol",
+			Description: "One of plaintext, ssl, sasl_plaintext or sasl_ssl.",
+			Placeholder: "sasl_ssl",
+		},
+		{
+			Key:         "sasl_mechanism",
+			Type:        drivers.StringPropertyType,
+			DisplayName: "SASL mechanism",
+			Description: "One of PLAIN, SCRAM-SHA-256 or SCRAM-SHA-512.",
+			Placeholder: "PLAIN",
+		},
+		{
+			Key:         "sasl_username",
+			Type:        drivers.StringPropertyType,
+			DisplayName: "SASL username",
+		},
+		{
+			Key:         "sasl_password",
+			Type:        drivers.StringPropertyType,
+			DisplayName: "SASL password",
+			Secret:      true,
+		},
+		{
+			Key:         "schema_registry_url",
+			Type:        drivers.StringPropertyType,
+			DisplayName: "Schema registry URL",
+			Description: "URL of the schema registry for decoding Avro records.",
+			Placeholder: "https://schema-registry.example.com",
+		},
+		{
+			Key:         "schema_registry_username",
+			Type:        drivers.StringPropertyType,
+			DisplayName: "Schema registry username",
+		},
+		{
+			Key:         "schema_registry_password",
+			Type:        drivers.StringPropertyType,
+			DisplayName: "Schema registry password",
+			Secret:      true,
+		},
+	},
+	SourceProperties: []*drivers.PropertySpec{
+		{
+			Key:         "topic",
+			Type:        drivers.StringPropertyType,
+			Required:    true,
+			DisplayName: "Topic",
+			Description: "Kafka topic to consume.",
+			Placeholder: "events",
+		},
+		{
+			Key:         "format",
+			Type:        drivers.StringPropertyType,
+			DisplayName: "Format",
+			Description: "Format of the record values: json or avro.",
+			Default:     "json",
+		},
+		{
+			Key:         "start_offset",
+			Type:        drivers.StringPropertyType,
+			DisplayName: "Start offset",
+			Description: "Where to start consuming when the source is first ingested: earliest or latest.",
+			Default:     "earliest",
+		},
+	},
+	ImplementsStreamStore: true,
+}
+
+type driver struct{}
+
+type configProperties struct {
+	Brokers                string `mapstructure:"brokers"`
+	SecurityProtocol       string `mapstructure:"security_protocol"`
+	SASLMechanism          string `mapstructure:"sasl_mechanism"`
+	SASLUsername           string `mapstructure:"sasl_username"`
+	SASLPassword           string `mapstructure:"sasl_password"`
+	SchemaRegistryURL      string `mapstructure:"schema_registry_url"`
+	SchemaRegistryUsername string `mapstructure:"schema_registry_username"`
+	SchemaRegistryPassword string `mapstructure:"schema_registry_password"`
+}
+
+func (d driver) Open(instanceID string, config map[string]any, client *activity.Client, logger *zap.Logger) (drivers.Handle, error) {
+	if instanceID == "" {
+		return nil, errors.New("kafka driver can't be shared")
+	}
+
+	conf := &configProperties{}
+	err := mapstructure.WeakDecode(config, conf)
+	if err != nil {
+		return nil, err
+	}
+
+	return &connection{
+		config:     conf,
+		configProp: config,
+		logger:     logger,
+	}, nil
+}
+
+func (d driver) Spec() drivers.Spec {
+	return spec
+}
+
+func (d driver) HasAnonymousSourceAccess(ctx context.Context, src map[string]any, logger *zap.Logger) (bool, error) {
+	return false, nil
+}
+
+func (d driver) TertiarySourceConnectors(ctx context.Context, src map[string]any, logger *zap.Logger) ([]string, error) {
+	return nil, nil
+}
+
+type connection struct {
+	config     *configProperties
+	configProp map[string]any
+	logger     *zap.Logger
+
+	// registry is the schema registry client. It is created on first use.
+	registryMu sync.Mutex
+	registry   schemaregistry.Client
+}
+
+// Migrate implements drivers.Handle.
+func (c *connection) Migrate(ctx context.Context) (err error) {
+	return nil
+}
+
+// MigrationStatus implements drivers.Handle.
+func (c *connection) MigrationStatus(ctx context.Context) (current, desired int, err error) {
+	return 0, 0, nil
+}
+
+// Driver implements drivers.Handle.
+func (c *connection) Driver() string {
+	return "kafka"
+}
+
+// Config implements drivers.Handle.
+func (c *connection) Config() map[string]any {
+	return c.configProp
+}
+
+// Close implements drivers.Handle.
+func (c *connection) Close() error {
+	return nil
+}
+
+// AsRegistry implements drivers.Handle.
+func (c *connection) AsRegistry() (drivers.RegistryStore, bool) {
+	return nil, false
+}
+
+// AsCatalogStore implements drivers.Handle.
+func (c *connection) AsCatalogStore(instanceID string) (drivers.CatalogStore, bool) {
+	return nil, false
+}
+
+// AsRepoStore implements drivers.Handle.
+func (c *connection) AsRepoStore(instanceID string) (drivers.RepoStore, bool) {
+	return nil, false
+}
+
+// AsAdmin implements drivers.Handle.
+func (c *connection) AsAdmin(instanceID string) (drivers.AdminService, bool) {
+	return nil, false
+}
+
+// AsAI implements drivers.Handle.
+func (c *connection) AsAI(instanceID string) (drivers.AIService, bool) {
+	return nil, false
+}
+
+// AsOLAP implements drivers.Handle.
+func (c *connection) AsOLAP(instanceID string) (drivers.OLAPStore, bool) {
+	return nil, false
+}
+
+// AsObjectStore implements drivers.Handle.
+func (c *connection) AsObjectStore() (drivers.ObjectStore, bool) {
+	return nil, false
+}
+
+// AsModelExecutor implements drivers.Handle.
+func (c *connection) AsModelExecutor(instanceID string, opts *drivers.ModelExecutorOptions) (drivers.ModelExecutor, bool) {
+	return nil, false
+}
+
+// AsModelManager implements drivers.Handle.
+func (c *connection) AsModelManager(instanceID string) (drivers.ModelManager, bool) {
+	return nil, false
+}
+
+// AsTransporter implements drivers.Handle.
+func (c *connection) AsTransporter(from, to drivers.Handle) (drivers.Transporter, bool) {
+	return nil, false
+}
+
+// AsFileStore implements drivers.Handle.
+func (c *connection) AsFileStore() (drivers.FileStore, bool) {
+	return nil, false
+}
+
+// AsStreamStore implements drivers.Handle.
+func (c *connection) AsStreamStore() (drivers.StreamStore, bool) {
+	return c, true
+}
+
+// AsSQLStore implements drivers.Handle.
+func (c *connection) AsSQLStore() (drivers.SQLStore, bool) {
+	return nil, false
+}
+
+// AsNotifier implements drivers.Handle.
+func (c *connection) AsNotifier(properties map[string]any) (drivers.Notifier, error) {
+	return nil, drivers.ErrNotNotifier
+}
+
+// consumerConfig returns the librdkafka configuration for a consumer.
+func (c *connection) consumerConfig() (*kafka.ConfigMap, error) {
+	if c.config.Brokers == "" {
+		return nil, fmt.Errorf("kafka: missing property 'brokers'")
+	}
+	cfg := &kafka.ConfigMap{
+		"bootstrap.servers": c.config.Brokers,
+		// Offsets are tracked by Rill, so the group is only used for identification and never commits offsets.
+		"group.id":           "rill",
+		"enable.auto.commit": false,
+		"auto.offset.reset":  "earliest",
+	}
+	if c.config.SecurityProtocol != "" {
+		_ = cfg.SetKey("security.protocol", c.config.SecurityProtocol)
+	}
+	if c.config.SASLMechanism != "" {
+		_ = cfg.SetKey("sasl.mechanism", c.config.SASLMechanism)
+	}
+	if c.config.SASLUsername != "" {
+		_ = cfg.SetKey("sasl.username", c.config.SASLUsername)
+		_ = cfg.SetKey("sasl.password", c.config.SASLPassword)
+	}
+	return cfg, nil
+}
+
+// schemaRegistry returns a client for the configured schema registry.
+func (c *connection) schemaRegistry() (schemaregistry.Client, error) {
+	c.registryMu.Lock()
+	defer c.registryMu.Unlock()
+
+	if c.registry != nil {
+		return c.registry, nil
+	}
+
+	if c.config.SchemaRegistryURL == "" {
+		return nil, fmt.Errorf("kafka: property 'schema_registry_url' is required for the avro format")
+	}
+
+	var cfg *schemaregistry.Config
+	if c.config.SchemaRegistryUsername != "" {
+		cfg = schemaregistry.NewConfigWithAuthentication(c.config.SchemaRegistryURL, c.config.SchemaRegistryUsername, c.config.SchemaRegistryPassword)
+	} else {
+		cfg = schemaregistry.NewConfig(c.config.SchemaRegistryURL)
+	}
+	client, err := schemaregistry.NewClient(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("kafka: failed to create schema registry client: %w", err)
+	}
+	c.registry = client
+	return client, nil
+}
@@ -0,0 +1,283 @@
+package kafka
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/mitchellh/mapstructure"
+	"github.com/rilldata/rill/runtime/drivers"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultMaxBatchRecords = 1_000_000
+	metadataTimeout        = 10 * time.Second
+	pollTimeout            = 100 * time.Millisecond
+	// batchIdleTimeout is the maximum time to wait for new records before returning an incomplete batch.
+	batchIdleTimeout = 30 * time.Second
+)
+
+type sourceProperties struct {
+	Topic string `mapstructure:"topic"`
+	// Format of the record values: "json" (default) or "avro".
+	Format string `mapstructure:"format"`
+	// StartOffset is where to start consuming partitions without a committed offset: "earliest" (default) or "latest".
+	StartOffset string `mapstructure:"start_offset"`
+	// MaxBatchRecords is the maximum number of records to read in a single batch.
+	MaxBatchRecords int64 `mapstructure:"max_batch_records"`
+	// IncludeMetadata adds the partition, offset, timestamp and key of each record as columns prefixed with "_kafka_".
+	IncludeMetadata bool `mapstructure:"include_metadata"`
+}
+
+func parseSourceProperties(props map[string]any) (*sourceProperties, error) {
+	conf := &sourceProperties{}
+	err := mapstructure.WeakDecode(props, conf)
+	if err != nil {
+		return nil, err
+	}
+	if conf.Topic == "" {
+		return nil, fmt.Errorf("missing property 'topic'")
+	}
+	switch conf.Format {
+	case "":
+		conf.Format = "json"
+	case "json", "avro":
+	default:
+		return nil, fmt.Errorf("invalid format %q: must be json or avro", conf.Format)
+	}
+	switch conf.StartOffset {
+	case "":
+		conf.StartOffset = "earliest"
+	case "earliest", "latest":
+	default:
+		return nil, fmt.Errorf("invalid start_offset %q: must be earliest or latest", conf.StartOffset)
+	}
+	if conf.MaxBatchRecords <= 0 {
+		conf.MaxBatchRecords = defaultMaxBatchRecords
+	}
+	return conf, nil
+}
+
+var _ drivers.StreamStore = &connection{}
+
+// ReadBatch implements drivers.StreamStore.
+// The offsets are keyed by partition number. Offsets are tracked by the caller, so no offsets are committed to Kafka.
+func (c *connection) ReadBatch(ctx context.Context, props map[string]any, offsets map[string]int64) (*drivers.StreamBatch, error) {
+	srcProps, err := parseSourceProperties(props)
+	if err != nil {
+		return nil, err
+	}
+
+	var avro *avroDecoder
+	if srcProps.Format == "avro" {
+		registry, err := c.schemaRegistry()
+		if err != nil {
+			return nil, err
+		}
+		// Uses the default subject naming strategy for record values
+		avro = newAvroDecoder(registry, srcProps.Topic+"-value")
+	}
+
+	cfg, err := c.consumerConfig()
+	if err != nil {
+		return nil, err
+	}
+	consumer, err := kafka.NewConsumer(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("kafka: failed to create consumer: %w", err)
+	}
+	defer consumer.Close()
+
+	// Find the range of offsets to read for each partition.
+	// We only read up to the end of each partition at the start of the batch, so a batch always completes on busy topics.
+	md, err := consumer.GetMetadata(&srcProps.Topic, false, int(metadataTimeout.Milliseconds()))
+	if err != nil {
+		return nil, fmt.Errorf("kafka: failed to get metadata for topic %q: %w", srcProps.Topic, err)
+	}
+	tmd, ok := md.Topics[srcProps.Topic]
+	if !ok || tmd.Error.Code() != kafka.ErrNoError {
+		return nil, fmt.Errorf("kafka: topic %q not found", srcProps.Topic)
+	}
+
+	next := make(map[string]int64, len(tmd.Partitions))
+	ends := make(map[int32]int64)
+	var assignments []kafka.TopicPartition
+	for _, p := range tmd.Partitions {
+		low, high, err := consumer.QueryWatermarkOffsets(srcProps.Topic, p.ID, int(metadataTimeout.Milliseconds()))
+		if err != nil {
+			return nil, fmt.Errorf("kafka: failed to get offsets for partition %d: %w", p.ID, err)
+		}
+
+		key := strconv.Itoa(int(p.ID))
+		start, ok := offsets[key]
+		if !ok {
+			if srcProps.StartOffset == "latest" {
+				start = high
+			} else {
+				start = low
+			}
+		} else if start < low {
+			// The records have been deleted by the topic's retention policy
+			c.logger.Warn("kafka: records were deleted before they were ingested", zap.String("topic", srcProps.Topic), zap.Int32("partition", p.ID), zap.Int64("offset", start), zap.Int64("low", low))
+			start = low
+		}
+
+		next[key] = start
+		if start < high {
+			ends[p.ID] = high
+			assignments = append(assignments, kafka.TopicPartition{Topic: &srcProps.Topic, Partition: p.ID, Offset: kafka.Offset(start)})
+		}
+	}
+
+	batch := &drivers.StreamBatch{Offsets: next}
+	if len(assignments) == 0 {
+		return batch, nil
+	}
+
+	err = consumer.Assign(assignments)
+	if err != nil {
+		return nil, fmt.Errorf("kafka: failed to assign partitions: %w", err)
+	}
+
+	f, err := os.CreateTemp("", "kafka*.ndjson")
+	if err != nil {
+		return nil, err
+	}
+	success := false
+	defer func() {
+		if !success {
+			_ = f.Close()
+			_ = os.Remove(f.Name())
+		}
+	}()
+	w := bufio.NewWriter(f)
+
+	lastRecord := time.Now()
+	for len(ends) > 0 && batch.Records < srcProps.MaxBatchRecords {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
+		switch e := consumer.Poll(int(pollTimeout.Milliseconds())).(type) {
+		case *kafka.Message:
+			p := e.TopicPartition.Partition
+			offset := int64(e.TopicPartition.Offset)
+			end, ok := ends[p]
+			if !ok || offset >= end {
+				// Published after the batch started
+				continue
+			}
+			lastRecord = time.Now()
+
+			err := c.writeRecord(w, e, srcProps, avro)
+			if err != nil {
+				return nil, fmt.Errorf("kafka: failed to decode record at partition %d offset %d: %w", p, offset, err)
+			}
+			batch.Records++
+
+			next[strconv.Itoa(int(p))] = offset + 1
+			if offset+1 >= end {
+				delete(ends, p)
+			}
+		case kafka.Error:
+			if e.IsFatal() {
+				return nil, fmt.Errorf("kafka: %w", e)
+			}
+			c.logger.Debug("kafka: consumer error", zap.Error(e))
+		case nil:
+			// Some offsets are never delivered (such as transaction markers), so we use the consumer's position to detect the end of partitions.
+			positions, err := consumer.Position(assignments)
+			if err == nil {
+				for _, pos := range positions {
+					end, ok := ends[pos.Partition]
+					if ok && pos.Offset >= 0 && int64(pos.Offset) >= end {
+						next[strconv.Itoa(int(pos.Partition))] = end
+						delete(ends, pos.Partition)
+					}
+				}
+			}
+			if len(ends) > 0 && time.Since(lastRecord) > batchIdleTimeout {
+				c.logger.Warn("kafka: timed out waiting for records, ingesting an incomplete batch", zap.String("topic", srcProps.Topic))
+				ends = nil
+			}
+		}
+	}
+
+	if err := w.Flush(); err != nil {
+		return nil, err
+	}
+	if err := f.Close(); err != nil {
+		return nil, err
+	}
+
+	if batch.Records == 0 {
+		_ = os.Remove(f.Name())
+		success = true
+		return batch, nil
+	}
+
+	success = true
+	batch.Path = f.Name()
+	return batch, nil
+}
+
+// writeRecord writes a record's value as a line of JSON. Values that are not JSON objects are written to a "value" field.
+func (c *connection) writeRecord(w *bufio.Writer, msg *kafka.Message, props *sourceProperties, avro *avroDecoder) error {
+	var v any
+	if avro != nil {
+		var err error
+		v, err = avro.Decode(msg.Value)
+		if err != nil {
+			return err
+		}
+	} else {
+		value := bytes.TrimSpace(msg.Value)
+		if !props.IncludeMetadata && len(value) > 0 && value[0] == '{' {
+			// Fast path for JSON objects
+			var buf bytes.Buffer
+			err := json.Compact(&buf, value)
+			if err != nil {
+				return err
+			}
+			buf.WriteByte('\n')
+			_, err = w.Write(buf.Bytes())
+			return err
+		}
+
+		if len(value) > 0 {
+			dec := json.NewDecoder(bytes.NewReader(value))
+			dec.UseNumber()
+			err := dec.Decode(&v)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	row, ok := v.(map[string]any)
+	if !ok {
+		row = map[string]any{"value": v}
+	}
+
+	if props.IncludeMetadata {
+		row["_kafka_partition"] = msg.TopicPartition.Partition
+		row["_kafka_offset"] = int64(msg.TopicPartition.Offset)
+		row["_kafka_timestamp"] = msg.Timestamp.UTC().Format(time.RFC3339Nano)
+		row["_kafka_key"] = string(msg.Key)
+	}
+
+	b, err := json.Marshal(row)
+	if err != nil {
+		return err
+	}
+	b = append(b, '\n')
+	_, err = w.Write(b)
+	return err
+}
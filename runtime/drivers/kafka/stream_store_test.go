@@ -0,0 +1,101 @@
+package kafka
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestReadBatch(t *testing.T) {
+	cluster, err := kafka.NewMockCluster(1)
+	require.NoError(t, err)
+	defer cluster.Close()
+
+	ctx := context.Background()
+	topic := "events"
+
+	producer, err := kafka.NewProducer(&kafka.ConfigMap{"bootstrap.servers": cluster.BootstrapServers()})
+	require.NoError(t, err)
+	defer producer.Close()
+
+	// The mock cluster creates topics with four partitions on first use
+	_, err = producer.GetMetadata(&topic, false, 10000)
+	require.NoError(t, err)
+
+	produce := func(from, to int) {
+		delivered := make(chan kafka.Event, to-from)
+		for i := from; i < to; i++ {
+			err := producer.Produce(&kafka.Message{
+				TopicPartition: kafka.TopicPartition{Topic: &topic, Partition: int32(i % 2)},
+				Value:          []byte(fmt.Sprintf(`{"id": %d}`, i)),
+			}, delivered)
+			require.NoError(t, err)
+		}
+		for i := from; i < to; i++ {
+			msg := (<-delivered).(*kafka.Message)
+			require.NoError(t, msg.TopicPartition.Error)
+		}
+	}
+
+	handle, err := driver{}.Open("default", map[string]any{"brokers": cluster.BootstrapServers()}, nil, zap.NewNop())
+	require.NoError(t, err)
+	defer handle.Close()
+	store, ok := handle.AsStreamStore()
+	require.True(t, ok)
+
+	props := map[string]any{"topic": topic}
+
+	// First batch reads from the earliest offsets
+	produce(0, 10)
+	batch, err := store.ReadBatch(ctx, props, nil)
+	require.NoError(t, err)
+	require.Equal(t, int64(10), batch.Records)
+	require.Equal(t, map[string]int64{"0": 5, "1": 5, "2": 0, "3": 0}, batch.Offsets)
+	require.ElementsMatch(t, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, readIDs(t, batch))
+
+	// Next batch only reads new records
+	produce(10, 14)
+	batch, err = store.ReadBatch(ctx, props, batch.Offsets)
+	require.NoError(t, err)
+	require.Equal(t, int64(4), batch.Records)
+	require.Equal(t, map[string]int64{"0": 7, "1": 7, "2": 0, "3": 0}, batch.Offsets)
+	require.ElementsMatch(t, []int{10, 11, 12, 13}, readIDs(t, batch))
+
+	// Empty batch when there are no new records
+	batch, err = store.ReadBatch(ctx, props, batch.Offsets)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), batch.Records)
+	require.Empty(t, batch.Path)
+	require.Equal(t, map[string]int64{"0": 7, "1": 7, "2": 0, "3": 0}, batch.Offsets)
+
+	// Unknown topic
+	_, err = store.ReadBatch(ctx, map[string]any{"topic": "missing"}, nil)
+	require.Error(t, err)
+}
+
+func readIDs(t *testing.T, batch *drivers.StreamBatch) []int {
+	f, err := os.Open(batch.Path)
+	require.NoError(t, err)
+	defer os.Remove(batch.Path)
+	defer f.Close()
+
+	var ids []int
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		var row struct {
+			ID int `json:"id"`
+		}
+		require.NoError(t, json.Unmarshal(s.Bytes(), &row))
+		ids = append(ids, row.ID)
+	}
+	require.NoError(t, s.Err())
+	return ids
+}
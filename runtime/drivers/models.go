@@ -38,4 +38,10 @@ type ModelExecutorOptions struct {
 	Incremental      bool
 	IncrementalRun   bool
 	PreviousResult   *ModelResult
+	// TestOutput is an optional callback that executors should invoke after building a new output and before promoting it.
+	// The provided table may be a staging table. If it returns an error, the executor must discard the new output and return the error.
+	// Executors that can't stage outputs may ignore it, in which case the caller tests the promoted output and fails the build if the tests don't pass.
+	TestOutput func(ctx context.Context, table string) error
+	// Progress is an optional interface that executors can use to report the progress of long-running builds.
+	Progress Progress
 }
@@ -1,11 +1,16 @@
 package drivers
 
 import (
+	"errors"
 	"time"
 
 	"github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 )
 
+// ErrNotifierNotConfigured is returned by AsNotifier when a connector can't send notifications with its own configuration.
+// Callers may fall back to a notifier that uses the runtime's default configuration instead.
+var ErrNotifierNotConfigured = errors.New("notifier is not configured")
+
 // Notifier sends notifications.
 type Notifier interface {
 	SendAlertStatus(s *AlertStatus) error
@@ -13,9 +18,6 @@ type Notifier interface {
 }
 
 type AlertStatus struct {
-	// TODO: Remove ToEmail, ToName once email notifier is created
-	ToEmail        string
-	ToName         string
 	Title          string
 	ExecutionTime  time.Time
 	Status         runtimev1.AssertionStatus
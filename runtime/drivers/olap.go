@@ -124,6 +124,7 @@ const (
 	DialectDruid
 	DialectClickHouse
 	DialectPinot
+	DialectPostgres
 )
 
 func (d Dialect) String() string {
@@ -138,6 +139,8 @@ func (d Dialect) String() string {
 		return "clickhouse"
 	case DialectPinot:
 		return "pinot"
+	case DialectPostgres:
+		return "postgres"
 	default:
 		panic("not implemented")
 	}
@@ -224,6 +227,55 @@ func (d Dialect) MetricsViewDimensionExpression(dimension *runtimev1.MetricsView
 	return d.EscapeIdentifier(dimension.Name)
 }
 
+// MetricsViewWindowMeasureExpression returns a window function expression that computes a window measure (such as a running total) over base.
+// The partitionBy and orderBy expressions must be valid in the select list of the query the expression is used in.
+// An orderBy expression is required for running totals and moving averages.
+func (d Dialect) MetricsViewWindowMeasureExpression(measure *runtimev1.MetricsViewSpec_MeasureV2, base string, partitionBy []string, orderBy string) (string, error) {
+	var partitionClause string
+	if len(partitionBy) > 0 {
+		partitionClause = "PARTITION BY " + strings.Join(partitionBy, ", ")
+	}
+
+	// Druid and Postgres use integer arithmetic for averages and/or division of integer columns
+	fractional := base
+	switch d {
+	case DialectDruid:
+		fractional = fmt.Sprintf("CAST(%s AS DOUBLE)", base)
+	case DialectPostgres:
+		fractional = fmt.Sprintf("CAST(%s AS DOUBLE PRECISION)", base)
+	}
+
+	switch measure.Type {
+	case runtimev1.MetricsViewSpec_MEASURE_TYPE_RUNNING_TOTAL:
+		if orderBy == "" {
+			return "", fmt.Errorf("running total measure %q requires the time dimension to be queried with a time grain", measure.Name)
+		}
+		return fmt.Sprintf("SUM(%s) OVER (%s ORDER BY %s ROWS BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW)", base, partitionClause, orderBy), nil
+	case runtimev1.MetricsViewSpec_MEASURE_TYPE_MOVING_AVERAGE:
+		if orderBy == "" {
+			return "", fmt.Errorf("moving average measure %q requires the time dimension to be queried with a time grain", measure.Name)
+		}
+		if measure.Window == 0 {
+			return "", fmt.Errorf("moving average measure %q does not have a window", measure.Name)
+		}
+		return fmt.Sprintf("AVG(%s) OVER (%s ORDER BY %s ROWS BETWEEN %d PRECEDING AND CURRENT ROW)", fractional, partitionClause, orderBy, measure.Window-1), nil
+	case runtimev1.MetricsViewSpec_MEASURE_TYPE_SHARE_OF_PARENT:
+		return fmt.Sprintf("%s / NULLIF(SUM(%s) OVER (%s), 0)", fractional, base, partitionClause), nil
+	default:
+		return "", fmt.Errorf("measure %q is not a window measure", measure.Name)
+	}
+}
+
+// IsMetricsViewWindowMeasure returns true if the measure is computed with a window function over another measure.
+func IsMetricsViewWindowMeasure(measure *runtimev1.MetricsViewSpec_MeasureV2) bool {
+	switch measure.Type {
+	case runtimev1.MetricsViewSpec_MEASURE_TYPE_RUNNING_TOTAL, runtimev1.MetricsViewSpec_MEASURE_TYPE_MOVING_AVERAGE, runtimev1.MetricsViewSpec_MEASURE_TYPE_SHARE_OF_PARENT:
+		return true
+	default:
+		return false
+	}
+}
+
 func tempName(prefix string) string {
 	return prefix + strings.ReplaceAll(uuid.New().String(), "-", "")
 }
@@ -3,6 +3,7 @@ package drivers_test
 import (
 	"testing"
 
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/stretchr/testify/require"
 )
@@ -10,3 +11,23 @@ import (
 func testOLAP(t *testing.T, olap drivers.OLAPStore) {
 	require.True(t, true)
 }
+
+func TestMetricsViewWindowMeasureExpression(t *testing.T) {
+	share := &runtimev1.MetricsViewSpec_MeasureV2{Name: "share", Type: runtimev1.MetricsViewSpec_MEASURE_TYPE_SHARE_OF_PARENT}
+	avg := &runtimev1.MetricsViewSpec_MeasureV2{Name: "avg", Type: runtimev1.MetricsViewSpec_MEASURE_TYPE_MOVING_AVERAGE, Window: 7}
+
+	expr, err := drivers.DialectDuckDB.MetricsViewWindowMeasureExpression(share, "x", []string{"a"}, "")
+	require.NoError(t, err)
+	require.Equal(t, "x / NULLIF(SUM(x) OVER (PARTITION BY a), 0)", expr)
+
+	expr, err = drivers.DialectPostgres.MetricsViewWindowMeasureExpression(share, "x", nil, "")
+	require.NoError(t, err)
+	require.Equal(t, "CAST(x AS DOUBLE PRECISION) / NULLIF(SUM(x) OVER (), 0)", expr)
+
+	expr, err = drivers.DialectPostgres.MetricsViewWindowMeasureExpression(avg, "x", nil, "t")
+	require.NoError(t, err)
+	require.Equal(t, "AVG(CAST(x AS DOUBLE PRECISION)) OVER ( ORDER BY t ROWS BETWEEN 6 PRECEDING AND CURRENT ROW)", expr)
+
+	_, err = drivers.DialectPostgres.MetricsViewWindowMeasureExpression(avg, "x", nil, "")
+	require.Error(t, err)
+}
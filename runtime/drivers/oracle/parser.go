@@ -0,0 +1,207 @@
+package oracle
+
+import (
+	"database/sql"
+	"fmt"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	goora "github.com/sijms/go-ora/v2"
+)
+
+type mapper interface {
+	runtimeType() *runtimev1.Type
+	// dest returns a pointer to a destination value that can be used in Rows.Scan
+	dest() any
+	// value dereferences a pointer created by dest
+	value(p any) (any, error)
+}
+
+// getDBTypeNameToMapperMap returns the mappers by the type names reported by go-ora.
+// NUMBER columns are mapped by numberMapper.
+// refer https://github.com/sijms/go-ora/blob/master/v2/parameter.go for base types
+func getDBTypeNameToMapperMap() map[string]mapper {
+	m := make(map[string]mapper)
+
+	char := charMapper{}
+	bytes := byteMapper{}
+	date := dateMapper{}
+
+	// boolean
+	m[goora.Boolean.String()] = boolMapper{}
+
+	// numeric
+	m[goora.IBFloat.String()] = floatMapper{code: runtimev1.Type_CODE_FLOAT32}
+	m[goora.IBDouble.String()] = floatMapper{code: runtimev1.Type_CODE_FLOAT64}
+
+	// string
+	// NCHAR is reported for VARCHAR2 and NVARCHAR2 columns
+	m[goora.NCHAR.String()] = char
+	m[goora.CHAR.String()] = char
+	m[goora.LONG.String()] = char
+	m[goora.OCIClobLocator.String()] = char
+	m[goora.ROWID.String()] = char
+	m[goora.UROWID.String()] = char
+	// Intervals are scanned as their string representation
+	m[goora.IntervalYM_DTY.String()] = char
+	m[goora.IntervalDS_DTY.String()] = char
+
+	// binary
+	m[goora.RAW.String()] = bytes
+	m[goora.LongRaw.String()] = bytes
+	m[goora.OCIBlobLocator.String()] = bytes
+
+	// date and time
+	m[goora.DATE.String()] = date
+	m[goora.TIMESTAMP.String()] = date
+	m[goora.TimeStampDTY.String()] = date
+	m[goora.TIMESTAMPTZ.String()] = date
+	m[goora.TimeStampTZ_DTY.String()] = date
+	m[goora.TimeStampeLTZ.String()] = date
+	m[goora.TimeStampLTZ_DTY.String()] = date
+
+	return m
+}
+
+// numberMapper returns the mapper for a NUMBER column with the given precision and scale.
+// go-ora reports a scale of 255 for floating-point numbers, which includes NUMBER columns without a precision.
+func numberMapper(precision, scale int64) mapper {
+	switch {
+	case scale == 0 && precision > 0 && precision <= 18:
+		// NUMBER(p) with up to 18 digits always fits in an int64
+		return intMapper{}
+	case scale == 255:
+		// FLOAT(b) and NUMBER without a precision, which can hold any magnitude and scale, so DOUBLE is the closest fit
+		return floatMapper{code: runtimev1.Type_CODE_FLOAT64}
+	default:
+		// NUMBER values are scanned as their string representation
+		// They are stored as string without losing precision
+		return charMapper{}
+	}
+}
+
+type intMapper struct{}
+
+func (m intMapper) runtimeType() *runtimev1.Type {
+	return &runtimev1.Type{Code: runtimev1.Type_CODE_INT64}
+}
+
+func (m intMapper) dest() any {
+	return new(sql.NullInt64)
+}
+
+func (m intMapper) value(p any) (any, error) {
+	v, ok := p.(*sql.NullInt64)
+	if !ok {
+		return nil, fmt.Errorf("intMapper: unsupported value type %v", p)
+	}
+	if !v.Valid {
+		return nil, nil
+	}
+	return v.Int64, nil
+}
+
+type floatMapper struct {
+	code runtimev1.Type_Code
+}
+
+func (m floatMapper) runtimeType() *runtimev1.Type {
+	return &runtimev1.Type{Code: m.code}
+}
+
+func (m floatMapper) dest() any {
+	return new(sql.NullFloat64)
+}
+
+func (m floatMapper) value(p any) (any, error) {
+	v, ok := p.(*sql.NullFloat64)
+	if !ok {
+		return nil, fmt.Errorf("floatMapper: unsupported value type %v", p)
+	}
+	if !v.Valid {
+		return nil, nil
+	}
+	if m.code == runtimev1.Type_CODE_FLOAT32 {
+		return float32(v.Float64), nil
+	}
+	return v.Float64, nil
+}
+
+type boolMapper struct{}
+
+func (m boolMapper) runtimeType() *runtimev1.Type {
+	return &runtimev1.Type{Code: runtimev1.Type_CODE_BOOL}
+}
+
+func (m boolMapper) dest() any {
+	return new(sql.NullBool)
+}
+
+func (m boolMapper) value(p any) (any, error) {
+	switch v := p.(type) {
+	case *sql.NullBool:
+		return v.Value()
+	default:
+		return nil, fmt.Errorf("boolMapper: unsupported value type %v", v)
+	}
+}
+
+type charMapper struct{}
+
+func (m charMapper) runtimeType() *runtimev1.Type {
+	return &runtimev1.Type{Code: runtimev1.Type_CODE_STRING}
+}
+
+func (m charMapper) dest() any {
+	return new(sql.NullString)
+}
+
+func (m charMapper) value(p any) (any, error) {
+	switch v := p.(type) {
+	case *sql.NullString:
+		return v.Value()
+	default:
+		return nil, fmt.Errorf("charMapper: unsupported value type %v", v)
+	}
+}
+
+type byteMapper struct{}
+
+func (m byteMapper) runtimeType() *runtimev1.Type {
+	return &runtimev1.Type{Code: runtimev1.Type_CODE_BYTES}
+}
+
+func (m byteMapper) dest() any {
+	return &[]byte{}
+}
+
+func (m byteMapper) value(p any) (any, error) {
+	switch v := p.(type) {
+	case *[]byte:
+		if *v == nil {
+			return nil, nil
+		}
+		return *v, nil
+	default:
+		return nil, fmt.Errorf("byteMapper: unsupported value type %v", v)
+	}
+}
+
+type dateMapper struct{}
+
+func (m dateMapper) runtimeType() *runtimev1.Type {
+	// Use runtimev1.Type_CODE_DATE once DATE is supported by DuckDB appender
+	return &runtimev1.Type{Code: runtimev1.Type_CODE_TIMESTAMP}
+}
+
+func (m dateMapper) dest() any {
+	return new(sql.NullTime)
+}
+
+func (m dateMapper) value(p any) (any, error) {
+	switch v := p.(type) {
+	case *sql.NullTime:
+		return v.Value()
+	default:
+		return nil, fmt.Errorf("dateMapper: unsupported value type %v", v)
+	}
+}
@@ -0,0 +1,39 @@
+package oracle
+
+import (
+	"database/sql"
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNumberMapper(t *testing.T) {
+	tests := []struct {
+		name      string
+		precision int64
+		scale     int64
+		code      runtimev1.Type_Code
+		src       string
+		want      any
+	}{
+		{"NUMBER(10)", 10, 0, runtimev1.Type_CODE_INT64, "1234567890", int64(1234567890)},
+		{"NUMBER(38)", 38, 0, runtimev1.Type_CODE_STRING, "12345678901234567890123", "12345678901234567890123"},
+		{"NUMBER(10,2)", 10, 2, runtimev1.Type_CODE_STRING, "12.34", "12.34"},
+		{"NUMBER", 0, 255, runtimev1.Type_CODE_FLOAT64, "0.1", float64(0.1)},
+		{"FLOAT", 38, 255, runtimev1.Type_CODE_FLOAT64, "1.5", float64(1.5)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := numberMapper(tt.precision, tt.scale)
+			require.Equal(t, tt.code, m.runtimeType().Code)
+
+			// go-ora returns NUMBER values as strings
+			dest := m.dest()
+			require.NoError(t, dest.(sql.Scanner).Scan(tt.src))
+			v, err := m.value(dest)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, v)
+		})
+	}
+}
@@ -160,6 +160,11 @@ func (c *connection) AsFileStore() (drivers.FileStore, bool) {
 	return nil, false
 }
 
+// AsStreamStore implements drivers.Handle.
+func (c *connection) AsStreamStore() (drivers.StreamStore, bool) {
+	return nil, false
+}
+
 func (c *connection) AsSQLStore() (drivers.SQLStore, bool) {
 	return nil, false
 }
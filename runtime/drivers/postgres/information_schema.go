@@ -0,0 +1,161 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/jmoiron/sqlx"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+)
+
+type informationSchema struct {
+	c *connection
+}
+
+func (c *connection) InformationSchema() drivers.InformationSchema {
+	return informationSchema{c: c}
+}
+
+func (i informationSchema) All(ctx context.Context) ([]*drivers.Table, error) {
+	conn, release, err := i.c.acquireMetaConn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = release() }()
+
+	// We read from pg_catalog instead of information_schema because information_schema only lists
+	// relations the current user has privileges on and doesn't include materialized views.
+	// Postgres does not support cross-database queries, so all tables belong to the current database.
+	q := `
+		SELECT
+			current_database() AS DATABASE,
+			n.nspname AS SCHEMA,
+			n.nspname = current_schema() AS is_default_schema,
+			c.relname AS NAME,
+			c.relkind IN ('v', 'm') AS is_view,
+			a.attname AS COLUMN_NAME,
+			t.typname AS COLUMN_TYPE_NAME
+		FROM pg_catalog.pg_class c
+		JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace
+		JOIN pg_catalog.pg_attribute a ON a.attrelid = c.oid AND a.attnum > 0 AND NOT a.attisdropped
+		JOIN pg_catalog.pg_type t ON t.oid = a.atttypid
+		WHERE c.relkind IN ('r', 'v', 'm', 'p', 'f')
+			AND n.nspname NOT IN ('pg_catalog', 'information_schema', 'pg_toast')
+			AND n.nspname NOT LIKE 'pg_temp_%'
+		ORDER BY SCHEMA, NAME, a.attnum
+	`
+
+	rows, err := conn.QueryxContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tables, err := i.scanTables(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	return tables, nil
+}
+
+func (i informationSchema) Lookup(ctx context.Context, db, schema, name string) (*drivers.Table, error) {
+	conn, release, err := i.c.acquireMetaConn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = release() }()
+
+	// Unquoted identifiers are folded to lower case in Postgres, so we match names case insensitively.
+	q := `
+		SELECT
+			current_database() AS DATABASE,
+			n.nspname AS SCHEMA,
+			n.nspname = current_schema() AS is_default_schema,
+			c.relname AS NAME,
+			c.relkind IN ('v', 'm') AS is_view,
+			a.attname AS COLUMN_NAME,
+			t.typname AS COLUMN_TYPE_NAME
+		FROM pg_catalog.pg_class c
+		JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace
+		JOIN pg_catalog.pg_attribute a ON a.attrelid = c.oid AND a.attnum > 0 AND NOT a.attisdropped
+		JOIN pg_catalog.pg_type t ON t.oid = a.atttypid
+		WHERE c.relkind IN ('r', 'v', 'm', 'p', 'f')
+			AND (coalesce($1, '') = '' OR lower($1) = lower(current_database()))
+			AND lower(n.nspname) = lower(coalesce(nullif($2, ''), current_schema()))
+			AND lower(c.relname) = lower($3)
+		ORDER BY SCHEMA, NAME, a.attnum
+	`
+
+	rows, err := conn.QueryxContext(ctx, q, db, schema, name)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tables, err := i.scanTables(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tables) == 0 {
+		return nil, drivers.ErrNotFound
+	}
+
+	return tables[0], nil
+}
+
+func (i informationSchema) scanTables(rows *sqlx.Rows) ([]*drivers.Table, error) {
+	var res []*drivers.Table
+
+	for rows.Next() {
+		var database string
+		var databaseSchema string
+		var isDefaultSchema bool
+		var name string
+		var isView bool
+		var columnName string
+		var columnTypeName string
+
+		err := rows.Scan(&database, &databaseSchema, &isDefaultSchema, &name, &isView, &columnName, &columnTypeName)
+		if err != nil {
+			return nil, err
+		}
+
+		// set t to res[len(res)-1] if it's the same table, else set t to a new table and append it
+		var t *drivers.Table
+		if len(res) > 0 {
+			t = res[len(res)-1]
+			if !(t.DatabaseSchema == databaseSchema && t.Name == name) {
+				t = nil
+			}
+		}
+		if t == nil {
+			t = &drivers.Table{
+				Database:                database,
+				DatabaseSchema:          databaseSchema,
+				IsDefaultDatabase:       true,
+				IsDefaultDatabaseSchema: isDefaultSchema,
+				Name:                    name,
+				View:                    isView,
+				Schema:                  &runtimev1.StructType{},
+			}
+			res = append(res, t)
+		}
+
+		// Types not natively handled by the driver (enums, composites, ranges, etc.) are returned as strings
+		colType := databaseTypeToPB(columnTypeName)
+
+		// append column
+		t.Schema.Fields = append(t.Schema.Fields, &runtimev1.StructType_Field{
+			Name: columnName,
+			Type: colType,
+		})
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
@@ -0,0 +1,626 @@
+package postgres
+
+import (
+	"context"
+	dbsql "database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
+)
+
+// Create instruments
+var (
+	meter                 = otel.Meter("github.com/rilldata/rill/runtime/drivers/postgres")
+	queriesCounter        = observability.Must(meter.Int64Counter("queries"))
+	queueLatencyHistogram = observability.Must(meter.Int64Histogram("queue_latency", metric.WithUnit("ms")))
+	queryLatencyHistogram = observability.Must(meter.Int64Histogram("query_latency", metric.WithUnit("ms")))
+	totalLatencyHistogram = observability.Must(meter.Int64Histogram("total_latency", metric.WithUnit("ms")))
+)
+
+var _ drivers.OLAPStore = &connection{}
+
+func (c *connection) Dialect() drivers.Dialect {
+	return drivers.DialectPostgres
+}
+
+func (c *connection) WithConnection(ctx context.Context, priority int, longRunning, tx bool, fn drivers.WithConnectionFunc) error {
+	// Check not nested
+	if connFromContext(ctx) != nil {
+		panic("nested WithConnection")
+	}
+
+	// Acquire connection
+	conn, release, err := c.acquireOLAPConn(ctx, priority)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = release() }()
+
+	if tx {
+		if _, err := conn.ExecContext(ctx, "BEGIN"); err != nil {
+			return err
+		}
+	}
+
+	// Call fn with connection embedded in context
+	wrappedCtx := contextWithConn(ctx, conn)
+	ensuredCtx := contextWithConn(context.Background(), conn)
+	err = fn(wrappedCtx, ensuredCtx, conn.Conn)
+
+	if tx {
+		if err != nil {
+			_, _ = conn.ExecContext(ensuredCtx, "ROLLBACK")
+			return err
+		}
+		_, err = conn.ExecContext(ensuredCtx, "COMMIT")
+	}
+	return err
+}
+
+func (c *connection) Exec(ctx context.Context, stmt *drivers.Statement) error {
+	// Log query if enabled (usually disabled)
+	if c.olapConfig.LogQueries {
+		c.logger.Info("postgres query", zap.String("sql", stmt.Query), zap.Any("args", stmt.Args))
+	}
+
+	query := rebind(stmt.Query)
+
+	// We use the meta conn for dry run queries
+	if stmt.DryRun {
+		conn, release, err := c.acquireMetaConn(ctx)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = release() }()
+
+		_, err = conn.ExecContext(ctx, fmt.Sprintf("EXPLAIN %s", query), stmt.Args...)
+		return err
+	}
+
+	conn, release, err := c.acquireOLAPConn(ctx, stmt.Priority)
+	if err != nil {
+		return err
+	}
+
+	var cancelFunc context.CancelFunc
+	if stmt.ExecutionTimeout != 0 {
+		ctx, cancelFunc = context.WithTimeout(ctx, stmt.ExecutionTimeout)
+	}
+	defer func() {
+		if cancelFunc != nil {
+			cancelFunc()
+		}
+		_ = release()
+	}()
+	_, err = conn.ExecContext(ctx, query, stmt.Args...)
+	return err
+}
+
+func (c *connection) Execute(ctx context.Context, stmt *drivers.Statement) (res *drivers.Result, outErr error) {
+	// Log query if enabled (usually disabled)
+	if c.olapConfig.LogQueries {
+		c.logger.Info("postgres query", zap.String("sql", stmt.Query), zap.Any("args", stmt.Args))
+	}
+
+	query := rebind(stmt.Query)
+
+	// We use the meta conn for dry run queries
+	if stmt.DryRun {
+		conn, release, err := c.acquireMetaConn(ctx)
+		if err != nil {
+			return nil, err
+		}
+		defer func() { _ = release() }()
+
+		_, err = conn.ExecContext(ctx, fmt.Sprintf("EXPLAIN %s", query), stmt.Args...)
+		return nil, err
+	}
+
+	// Gather metrics only for actual queries
+	var acquiredTime time.Time
+	acquired := false
+	start := time.Now()
+	defer func() {
+		totalLatency := time.Since(start).Milliseconds()
+		queueLatency := acquiredTime.Sub(start).Milliseconds()
+
+		attrs := []attribute.KeyValue{
+			attribute.Bool("cancelled", errors.Is(outErr, context.Canceled)),
+			attribute.Bool("failed", outErr != nil),
+			attribute.String("instance_id", c.instanceID),
+		}
+
+		attrSet := attribute.NewSet(attrs...)
+
+		queriesCounter.Add(ctx, 1, metric.WithAttributeSet(attrSet))
+		queueLatencyHistogram.Record(ctx, queueLatency, metric.WithAttributeSet(attrSet))
+		totalLatencyHistogram.Record(ctx, totalLatency, metric.WithAttributeSet(attrSet))
+		if acquired {
+			// Only track query latency when not cancelled in queue
+			queryLatencyHistogram.Record(ctx, totalLatency-queueLatency, metric.WithAttributeSet(attrSet))
+		}
+
+		if c.activity != nil {
+			c.activity.RecordMetric(ctx, "postgres_queue_latency_ms", float64(queueLatency), attrs...)
+			c.activity.RecordMetric(ctx, "postgres_total_latency_ms", float64(totalLatency), attrs...)
+			if acquired {
+				c.activity.RecordMetric(ctx, "postgres_query_latency_ms", float64(totalLatency-queueLatency), attrs...)
+			}
+		}
+	}()
+
+	// Acquire connection
+	conn, release, err := c.acquireOLAPConn(ctx, stmt.Priority)
+	acquiredTime = time.Now()
+	if err != nil {
+		return nil, err
+	}
+	acquired = true
+
+	// NOTE: We can't just "defer release()" because release() will block until rows.Close() is called.
+	// We must be careful to make sure release() is called on all code paths.
+
+	var cancelFunc context.CancelFunc
+	if stmt.ExecutionTimeout != 0 {
+		ctx, cancelFunc = context.WithTimeout(ctx, stmt.ExecutionTimeout)
+	}
+
+	rows, err := conn.QueryxContext(ctx, query, stmt.Args...)
+	if err != nil {
+		if cancelFunc != nil {
+			cancelFunc()
+		}
+		_ = release()
+		return nil, err
+	}
+
+	schema, err := rowsToSchema(rows)
+	if err != nil {
+		if cancelFunc != nil {
+			cancelFunc()
+		}
+		_ = rows.Close()
+		_ = release()
+		return nil, err
+	}
+
+	res = &drivers.Result{Rows: rows, Schema: schema}
+	res.SetCleanupFunc(func() error {
+		if cancelFunc != nil {
+			cancelFunc()
+		}
+		return release()
+	})
+
+	return res, nil
+}
+
+func (c *connection) EstimateSize() (int64, bool) {
+	return 0, false
+}
+
+// AddTableColumn implements drivers.OLAPStore.
+func (c *connection) AddTableColumn(ctx context.Context, tableName, columnName, typ string) error {
+	return c.Exec(ctx, &drivers.Statement{
+		Query:    fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s", safeSQLName(tableName), safeSQLName(columnName), typ),
+		Priority: 1,
+	})
+}
+
+// AlterTableColumn implements drivers.OLAPStore.
+func (c *connection) AlterTableColumn(ctx context.Context, tableName, columnName, newType string) error {
+	return c.Exec(ctx, &drivers.Statement{
+		Query:    fmt.Sprintf("ALTER TABLE %[1]s ALTER COLUMN %[2]s TYPE %[3]s USING %[2]s::%[3]s", safeSQLName(tableName), safeSQLName(columnName), newType),
+		Priority: 1,
+	})
+}
+
+// CreateTableAsSelect implements drivers.OLAPStore.
+func (c *connection) CreateTableAsSelect(ctx context.Context, name string, view bool, sql string) error {
+	if view {
+		return c.Exec(ctx, &drivers.Statement{
+			Query:       fmt.Sprintf("CREATE OR REPLACE VIEW %s AS (%s\n)", safeSQLName(name), sql),
+			Priority:    1,
+			LongRunning: true,
+		})
+	}
+
+	// Postgres does not support CREATE OR REPLACE TABLE, so we drop the old table in the same transaction.
+	return c.WithConnection(ctx, 1, true, true, func(ctx, ensuredCtx context.Context, _ *dbsql.Conn) error {
+		err := c.Exec(ctx, &drivers.Statement{
+			Query:    fmt.Sprintf("DROP TABLE IF EXISTS %s", safeSQLName(name)),
+			Priority: 1,
+		})
+		if err != nil {
+			return err
+		}
+
+		return c.Exec(ctx, &drivers.Statement{
+			Query:       fmt.Sprintf("CREATE TABLE %s AS (%s\n)", safeSQLName(name), sql),
+			Priority:    1,
+			LongRunning: true,
+		})
+	})
+}
+
+// InsertTableAsSelect implements drivers.OLAPStore.
+func (c *connection) InsertTableAsSelect(ctx context.Context, name, sql string, byName, inPlace bool, strategy drivers.IncrementalStrategy, uniqueKey []string) error {
+	if strategy != drivers.IncrementalStrategyAppend && strategy != drivers.IncrementalStrategyMerge {
+		return fmt.Errorf("incremental insert strategy %q not supported", strategy)
+	}
+
+	// Temporary tables are scoped to a session, so the whole insert must run on a single connection.
+	return c.WithConnection(ctx, 1, true, true, func(ctx, ensuredCtx context.Context, _ *dbsql.Conn) error {
+		// Stage the new data in a temporary table
+		tmp := safeSQLName(strings.ReplaceAll(uuid.New().String(), "-", ""))
+		err := c.Exec(ctx, &drivers.Statement{
+			Query:       fmt.Sprintf("CREATE TEMPORARY TABLE %s ON COMMIT DROP AS (%s\n)", tmp, sql),
+			Priority:    1,
+			LongRunning: true,
+		})
+		if err != nil {
+			return err
+		}
+
+		// Postgres does not support INSERT ... BY NAME, so we build an explicit column list from the staged data
+		var cols string
+		if byName {
+			names, err := c.columnNames(ctx, tmp)
+			if err != nil {
+				return err
+			}
+			cols = fmt.Sprintf("(%s)", strings.Join(names, ", "))
+		}
+
+		if strategy == drivers.IncrementalStrategyMerge {
+			// Drop the rows from the target table where the unique key is present in the temporary table
+			where := ""
+			for i, key := range uniqueKey {
+				key = safeSQLName(key)
+				if i != 0 {
+					where += " AND "
+				}
+				where += fmt.Sprintf("base.%s = tmp.%s", key, key)
+			}
+			err = c.Exec(ctx, &drivers.Statement{
+				Query:       fmt.Sprintf("DELETE FROM %s base WHERE EXISTS (SELECT 1 FROM %s tmp WHERE %s)", safeSQLName(name), tmp, where),
+				Priority:    1,
+				LongRunning: true,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		// Insert the new data into the target table
+		selectCols := "*"
+		if byName {
+			selectCols = strings.Trim(cols, "()")
+		}
+		return c.Exec(ctx, &drivers.Statement{
+			Query:       fmt.Sprintf("INSERT INTO %s %s SELECT %s FROM %s", safeSQLName(name), cols, selectCols, tmp),
+			Priority:    1,
+			LongRunning: true,
+		})
+	})
+}
+
+// DropTable implements drivers.OLAPStore.
+func (c *connection) DropTable(ctx context.Context, name string, view bool) error {
+	var typ string
+	if view {
+		typ = "VIEW"
+	} else {
+		typ = "TABLE"
+	}
+	return c.Exec(ctx, &drivers.Statement{
+		Query:    fmt.Sprintf("DROP %s IF EXISTS %s", typ, safeSQLName(name)),
+		Priority: 100,
+	})
+}
+
+// RenameTable implements drivers.OLAPStore.
+func (c *connection) RenameTable(ctx context.Context, name, newName string, view bool) error {
+	var typ string
+	if view {
+		typ = "VIEW"
+	} else {
+		typ = "TABLE"
+	}
+
+	// Postgres does not allow renaming onto an existing relation, so we drop the target in the same transaction.
+	return c.WithConnection(ctx, 100, false, true, func(ctx, ensuredCtx context.Context, _ *dbsql.Conn) error {
+		err := c.Exec(ctx, &drivers.Statement{
+			Query:    fmt.Sprintf("DROP %s IF EXISTS %s", typ, safeSQLName(newName)),
+			Priority: 100,
+		})
+		if err != nil {
+			return err
+		}
+
+		return c.Exec(ctx, &drivers.Statement{
+			Query:    fmt.Sprintf("ALTER %s %s RENAME TO %s", typ, safeSQLName(name), safeSQLName(newName)),
+			Priority: 100,
+		})
+	})
+}
+
+// columnNames returns the escaped column names of a table or view.
+func (c *connection) columnNames(ctx context.Context, safeName string) ([]string, error) {
+	res, err := c.Execute(ctx, &drivers.Statement{
+		Query:    fmt.Sprintf("SELECT * FROM %s LIMIT 0", safeName),
+		Priority: 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+
+	names := make([]string, len(res.Schema.Fields))
+	for i, f := range res.Schema.Fields {
+		names[i] = safeSQLName(f.Name)
+	}
+	return names, nil
+}
+
+// acquireMetaConn gets a connection from the pool for "meta" queries like information schema (i.e. fast queries).
+// It returns a function that puts the connection back in the pool (if applicable).
+func (c *connection) acquireMetaConn(ctx context.Context) (*sqlx.Conn, func() error, error) {
+	// Try to get conn from context (means the call is wrapped in WithConnection)
+	conn := connFromContext(ctx)
+	if conn != nil {
+		return conn, func() error { return nil }, nil
+	}
+
+	// Acquire semaphore
+	err := c.metaSem.Acquire(ctx, 1)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Get new conn
+	conn, releaseConn, err := c.acquireConn(ctx)
+	if err != nil {
+		c.metaSem.Release(1)
+		return nil, nil, err
+	}
+
+	// Build release func
+	release := func() error {
+		err := releaseConn()
+		c.metaSem.Release(1)
+		return err
+	}
+
+	return conn, release, nil
+}
+
+// acquireOLAPConn gets a connection from the pool for OLAP queries (i.e. slow queries).
+// It returns a function that puts the connection back in the pool (if applicable).
+func (c *connection) acquireOLAPConn(ctx context.Context, priority int) (*sqlx.Conn, func() error, error) {
+	// Try to get conn from context (means the call is wrapped in WithConnection)
+	conn := connFromContext(ctx)
+	if conn != nil {
+		return conn, func() error { return nil }, nil
+	}
+
+	// Acquire semaphore
+	err := c.olapSem.Acquire(ctx, priority)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Get new conn
+	conn, releaseConn, err := c.acquireConn(ctx)
+	if err != nil {
+		c.olapSem.Release()
+		return nil, nil, err
+	}
+
+	// Build release func
+	release := func() error {
+		err := releaseConn()
+		c.olapSem.Release()
+		return err
+	}
+
+	return conn, release, nil
+}
+
+// acquireConn returns a Postgres connection. It should only be used internally in acquireMetaConn and acquireOLAPConn.
+func (c *connection) acquireConn(ctx context.Context) (*sqlx.Conn, func() error, error) {
+	conn, err := c.db.Connx(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	release := func() error {
+		return conn.Close()
+	}
+	return conn, release, nil
+}
+
+func rowsToSchema(r *sqlx.Rows) (*runtimev1.StructType, error) {
+	if r == nil {
+		return nil, nil
+	}
+
+	cts, err := r.ColumnTypes()
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make([]*runtimev1.StructType_Field, len(cts))
+	for i, ct := range cts {
+		fields[i] = &runtimev1.StructType_Field{
+			Name: ct.Name(),
+			Type: databaseTypeToPB(ct.DatabaseTypeName()),
+		}
+	}
+
+	return &runtimev1.StructType{Fields: fields}, nil
+}
+
+// databaseTypeToPB converts Postgres type names to Rill's generic schema type.
+// Unlike the mappers used for ingestion, it describes the values returned by the pgx database/sql driver.
+// Unknown types (like enums or other custom types) are returned as strings by the driver.
+func databaseTypeToPB(dbt string) *runtimev1.Type {
+	dbt = strings.ToLower(dbt)
+	t := &runtimev1.Type{Nullable: true}
+
+	// Arrays are returned in their text representation
+	if strings.HasPrefix(dbt, "_") {
+		t.Code = runtimev1.Type_CODE_STRING
+		return t
+	}
+
+	switch dbt {
+	case "bool":
+		t.Code = runtimev1.Type_CODE_BOOL
+	case "int2":
+		t.Code = runtimev1.Type_CODE_INT16
+	case "int4":
+		t.Code = runtimev1.Type_CODE_INT32
+	case "int8":
+		t.Code = runtimev1.Type_CODE_INT64
+	case "float4":
+		t.Code = runtimev1.Type_CODE_FLOAT32
+	case "float8":
+		t.Code = runtimev1.Type_CODE_FLOAT64
+	case "numeric":
+		t.Code = runtimev1.Type_CODE_DECIMAL
+	case "date":
+		t.Code = runtimev1.Type_CODE_DATE
+	case "timestamp", "timestamptz":
+		t.Code = runtimev1.Type_CODE_TIMESTAMP
+	case "time", "timetz":
+		t.Code = runtimev1.Type_CODE_TIME
+	case "interval":
+		t.Code = runtimev1.Type_CODE_STRING
+	case "json", "jsonb":
+		t.Code = runtimev1.Type_CODE_JSON
+	case "uuid":
+		t.Code = runtimev1.Type_CODE_UUID
+	case "bytea":
+		t.Code = runtimev1.Type_CODE_BYTES
+	default:
+		t.Code = runtimev1.Type_CODE_STRING
+	}
+
+	return t
+}
+
+// rebind converts the "?" placeholders used by the query builders to Postgres' positional "$n" placeholders.
+// Question marks in string literals, quoted identifiers and comments are left as is.
+// NOTE: This means the "?" JSONB operators can't be used in metrics view expressions.
+func rebind(query string) string {
+	var b strings.Builder
+	b.Grow(len(query) + 8)
+	n := 0
+	for i := 0; i < len(query); i++ {
+		c := query[i]
+		switch {
+		case (c == 'E' || c == 'e') && i+1 < len(query) && query[i+1] == '\'' && (i == 0 || !isIdentifierChar(query[i-1])):
+			// Copy the escape string constant. Quotes can be escaped with a backslash as well as by doubling them.
+			end := i + 2
+			for end < len(query) && query[end] != '\'' {
+				if query[end] == '\\' {
+					end++
+				}
+				end++
+			}
+			if end >= len(query) {
+				b.WriteString(query[i:])
+				return b.String()
+			}
+			b.WriteString(query[i : end+1])
+			i = end
+		case c == '$' && (i == 0 || !isIdentifierChar(query[i-1])):
+			// Copy the dollar-quoted string constant, if any.
+			tag, ok := dollarQuoteTag(query[i:])
+			if !ok {
+				b.WriteByte(c)
+				break
+			}
+			end := strings.Index(query[i+len(tag):], tag)
+			if end < 0 {
+				b.WriteString(query[i:])
+				return b.String()
+			}
+			end += i + 2*len(tag)
+			b.WriteString(query[i:end])
+			i = end - 1
+		case c == '\'' || c == '"':
+			// Copy the quoted string or identifier. Quotes are escaped by doubling them, which the loop handles as two adjacent quoted strings.
+			end := strings.IndexByte(query[i+1:], c)
+			if end < 0 {
+				b.WriteString(query[i:])
+				return b.String()
+			}
+			end += i + 2
+			b.WriteString(query[i:end])
+			i = end - 1
+		case c == '-' && i+1 < len(query) && query[i+1] == '-':
+			end := strings.IndexByte(query[i:], '\n')
+			if end < 0 {
+				b.WriteString(query[i:])
+				return b.String()
+			}
+			b.WriteString(query[i : i+end])
+			i += end - 1
+		case c == '/' && i+1 < len(query) && query[i+1] == '*':
+			end := strings.Index(query[i+2:], "*/")
+			if end < 0 {
+				b.WriteString(query[i:])
+				return b.String()
+			}
+			end += i + 4
+			b.WriteString(query[i:end])
+			i = end - 1
+		case c == '?':
+			n++
+			b.WriteByte('$')
+			b.WriteString(strconv.Itoa(n))
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
+
+// dollarQuoteTag returns the opening tag of a dollar-quoted string constant at the start of s, such as $$ or $body$.
+func dollarQuoteTag(s string) (string, bool) {
+	for i := 1; i < len(s); i++ {
+		c := s[i]
+		if c == '$' {
+			return s[:i+1], true
+		}
+		if !isIdentifierChar(c) || (i == 1 && c >= '0' && c <= '9') {
+			return "", false
+		}
+	}
+	return "", false
+}
+
+func isIdentifierChar(c byte) bool {
+	return c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c >= 0x80
+}
+
+func safeSQLName(name string) string {
+	if name == "" {
+		return name
+	}
+	return fmt.Sprintf("\"%s\"", strings.ReplaceAll(name, "\"", "\"\""))
+}
@@ -0,0 +1,36 @@
+package postgres
+
+import (
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRebind(t *testing.T) {
+	require.Equal(t, "SELECT * FROM tbl WHERE a = $1 AND b IN ($2, $3)", rebind("SELECT * FROM tbl WHERE a = ? AND b IN (?, ?)"))
+	require.Equal(t, "SELECT timezone($1::TEXT, ts)", rebind("SELECT timezone(?::TEXT, ts)"))
+	require.Equal(t, "SELECT 'a?''b?' AS \"c?\"\"d\" FROM tbl WHERE x = $1", rebind("SELECT 'a?''b?' AS \"c?\"\"d\" FROM tbl WHERE x = ?"))
+	require.Equal(t, "SELECT $1 -- why?\n, $2 /* what? */ , '?", rebind("SELECT ? -- why?\n, ? /* what? */ , '?"))
+	require.Equal(t, `SELECT E'it\'s?', e'\\', $1`, rebind(`SELECT E'it\'s?', e'\\', ?`))
+	require.Equal(t, "SELECT node'?' = $1", rebind("SELECT node'?' = ?"))
+	require.Equal(t, "SELECT $$it's?$$, $fn$ $$? $fn$, $1", rebind("SELECT $$it's?$$, $fn$ $$? $fn$, ?"))
+	require.Equal(t, "SELECT $1, a$b, $2", rebind("SELECT ?, a$b, ?"))
+}
+
+func TestDatabaseTypeToPB(t *testing.T) {
+	cases := map[string]runtimev1.Type_Code{
+		"INT4":        runtimev1.Type_CODE_INT32,
+		"INT8":        runtimev1.Type_CODE_INT64,
+		"FLOAT8":      runtimev1.Type_CODE_FLOAT64,
+		"NUMERIC":     runtimev1.Type_CODE_DECIMAL,
+		"TIMESTAMPTZ": runtimev1.Type_CODE_TIMESTAMP,
+		"date":        runtimev1.Type_CODE_DATE,
+		"varchar":     runtimev1.Type_CODE_STRING,
+		"_int4":       runtimev1.Type_CODE_STRING,
+		"my_enum":     runtimev1.Type_CODE_STRING,
+	}
+	for dbt, code := range cases {
+		require.Equal(t, code, databaseTypeToPB(dbt).Code, dbt)
+	}
+}
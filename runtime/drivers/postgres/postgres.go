@@ -3,10 +3,17 @@ package postgres
 import (
 	"context"
 	"errors"
+	"fmt"
 
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/jmoiron/sqlx"
+	"github.com/mitchellh/mapstructure"
 	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/rilldata/rill/runtime/pkg/activity"
+	"github.com/rilldata/rill/runtime/pkg/priorityqueue"
 	"go.uber.org/zap"
+	"golang.org/x/sync/semaphore"
 )
 
 func init() {
@@ -43,18 +50,67 @@ var spec = drivers.Spec{
 		},
 	},
 	ImplementsSQLStore: true,
+	ImplementsOLAP:     true,
 }
 
+var maxOpenConnections = 20
+
 type driver struct{}
 
+type configProperties struct {
+	// DatabaseURL is the connection string. When set, the connection can also be used as an OLAP store.
+	DatabaseURL string `mapstructure:"database_url"`
+	// LogQueries controls whether to log the raw SQL passed to OLAP.Execute.
+	LogQueries bool `mapstructure:"log_queries"`
+}
+
 func (d driver) Open(instanceID string, config map[string]any, client *activity.Client, logger *zap.Logger) (drivers.Handle, error) {
 	if instanceID == "" {
 		return nil, errors.New("postgres driver can't be shared")
 	}
-	// actual db connection is opened during query
-	return &connection{
-		config: config,
-	}, nil
+
+	conf := &configProperties{}
+	err := mapstructure.WeakDecode(config, conf)
+	if err != nil {
+		return nil, err
+	}
+
+	conn := &connection{
+		config:     config,
+		olapConfig: conf,
+		logger:     logger,
+		activity:   client,
+		instanceID: instanceID,
+	}
+
+	// Sources may pass their own database_url, so the connection used for ingestion is opened during query.
+	// When the connector is configured with a database_url, we also open a pool for serving OLAP queries.
+	// NOTE: This does not connect to the database. Connections are established lazily when the first query is executed.
+	if conf.DatabaseURL != "" {
+		db, err := openDB(conf.DatabaseURL)
+		if err != nil {
+			return nil, err
+		}
+		conn.db = db
+		conn.metaSem = semaphore.NewWeighted(1)
+		conn.olapSem = priorityqueue.NewSemaphore(maxOpenConnections - 1)
+	}
+
+	return conn, nil
+}
+
+// openDB opens a database/sql pool for the given connection string.
+// All sessions use UTC so that TIMESTAMP columns are interpreted consistently with the other OLAP drivers.
+func openDB(dsn string) (*sqlx.DB, error) {
+	cfg, err := pgx.ParseConfig(dsn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse database_url: %w", err)
+	}
+	cfg.RuntimeParams["timezone"] = "UTC"
+
+	db := sqlx.NewDb(stdlib.OpenDB(*cfg), "pgx")
+	db.SetMaxOpenConns(maxOpenConnections)
+	return db, nil
 }
 
 func (d driver) Spec() drivers.Spec {
@@ -70,7 +126,18 @@ func (d driver) TertiarySourceConnectors(ctx context.Context, src map[string]any
 }
 
 type connection struct {
-	config map[string]any
+	config     map[string]any
+	olapConfig *configProperties
+	logger     *zap.Logger
+	activity   *activity.Client
+	instanceID string
+
+	// db is only set if the connector is configured with a database_url.
+	db *sqlx.DB
+	// This driver may issue both OLAP and "meta" queries (like catalog info).
+	// Like the other OLAP drivers, we gate queries with semaphores that limit the number of concurrent queries of each type.
+	metaSem *semaphore.Weighted
+	olapSem *priorityqueue.Semaphore
 }
 
 // Migrate implements drivers.Connection.
@@ -95,6 +162,9 @@ func (c *connection) Config() map[string]any {
 
 // Close implements drivers.Connection.
 func (c *connection) Close() error {
+	if c.db != nil {
+		return c.db.Close()
+	}
 	return nil
 }
 
@@ -124,8 +194,12 @@ func (c *connection) AsAI(instanceID string) (drivers.AIService, bool) {
 }
 
 // AsOLAP implements drivers.Connection.
+// The connection can only be used as an OLAP store if the connector is configured with a database_url.
 func (c *connection) AsOLAP(instanceID string) (drivers.OLAPStore, bool) {
-	return nil, false
+	if c.db == nil {
+		return nil, false
+	}
+	return c, true
 }
 
 // AsObjectStore implements drivers.Connection.
@@ -153,6 +227,11 @@ func (c *connection) AsFileStore() (drivers.FileStore, bool) {
 	return nil, false
 }
 
+// AsStreamStore implements drivers.Handle.
+func (c *connection) AsStreamStore() (drivers.StreamStore, bool) {
+	return nil, false
+}
+
 // AsSQLStore implements drivers.Connection.
 func (c *connection) AsSQLStore() (drivers.SQLStore, bool) {
 	return c, true
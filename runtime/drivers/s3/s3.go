@@ -237,6 +237,11 @@ func (c *Connection) AsFileStore() (drivers.FileStore, bool) {
 	return nil, false
 }
 
+// AsStreamStore implements drivers.Handle.
+func (c *Connection) AsStreamStore() (drivers.StreamStore, bool) {
+	return nil, false
+}
+
 // AsSQLStore implements drivers.Connection.
 func (c *Connection) AsSQLStore() (drivers.SQLStore, bool) {
 	return nil, false
@@ -258,8 +263,12 @@ type sourceProperties struct {
 	S3Endpoint            string         `mapstructure:"endpoint"`
 	Extract               map[string]any `mapstructure:"extract"`
 	BatchSize             string         `mapstructure:"batch_size"`
+	Format                string         `mapstructure:"format"`
+	SnapshotID            string         `mapstructure:"snapshot_id"`
+	SnapshotTimestamp     string         `mapstructure:"snapshot_timestamp"`
 	url                   *globutil.URL
 	extractPolicy         *rillblob.ExtractPolicy
+	tableOptions          *rillblob.TableOptions
 }
 
 func parseSourceProperties(props map[string]any) (*sourceProperties, error) {
@@ -293,6 +302,11 @@ func parseSourceProperties(props map[string]any) (*sourceProperties, error) {
 		return nil, fmt.Errorf("failed to parse extract config: %w", err)
 	}
 
+	conf.tableOptions, err = rillblob.ParseTableOptions(conf.Format, conf.SnapshotID, conf.SnapshotTimestamp)
+	if err != nil {
+		return nil, err
+	}
+
 	return conf, nil
 }
 
@@ -337,6 +351,7 @@ func (c *Connection) DownloadFiles(ctx context.Context, src map[string]any) (dri
 		GlobPageSize:          conf.GlobPageSize,
 		GlobPattern:           conf.url.Path,
 		ExtractPolicy:         conf.extractPolicy,
+		Table:                 conf.tableOptions,
 		BatchSizeBytes:        int64(batchSize.Bytes()),
 		KeepFilesUntilClose:   conf.BatchSize == "-1",
 		RetainFiles:           c.config.RetainFiles,
@@ -129,6 +129,11 @@ func (h *handle) AsFileStore() (drivers.FileStore, bool) {
 	return nil, false
 }
 
+// AsStreamStore implements drivers.Handle.
+func (h *handle) AsStreamStore() (drivers.StreamStore, bool) {
+	return nil, false
+}
+
 func (h *handle) AsModelExecutor(instanceID string, opts *drivers.ModelExecutorOptions) (drivers.ModelExecutor, bool) {
 	return nil, false
 }
@@ -2,6 +2,8 @@ package sqlite
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/rilldata/rill/runtime/drivers"
@@ -140,3 +142,120 @@ func (c *catalogStore) DeleteResources(ctx context.Context) error {
 
 	return nil
 }
+
+func (c *catalogStore) FindModelPartitions(ctx context.Context, opts *drivers.FindModelPartitionsOptions) ([]drivers.ModelPartition, error) {
+	var qry strings.Builder
+	qry.WriteString("SELECT key, data_json, idx, watermark, executed_on, error, elapsed_ms FROM model_partitions WHERE instance_id=? AND model_id=? AND (idx>? OR (idx=? AND key>?))")
+	args := []any{c.instanceID, opts.ModelID, opts.AfterIndex, opts.AfterIndex, opts.AfterKey}
+
+	if opts.WherePending && opts.WhereErrored {
+		qry.WriteString(" AND (executed_on IS NULL OR error != '')")
+	} else if opts.WherePending {
+		qry.WriteString(" AND executed_on IS NULL")
+	} else if opts.WhereErrored {
+		qry.WriteString(" AND error != ''")
+	}
+
+	qry.WriteString(" ORDER BY idx, key")
+	if opts.Limit > 0 {
+		qry.WriteString(" LIMIT ?")
+		args = append(args, opts.Limit)
+	}
+
+	return c.queryModelPartitions(ctx, qry.String(), args...)
+}
+
+func (c *catalogStore) FindModelPartitionsByKeys(ctx context.Context, modelID string, keys []string) ([]drivers.ModelPartition, error) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
+
+	args := []any{c.instanceID, modelID}
+	for _, k := range keys {
+		args = append(args, k)
+	}
+
+	qry := fmt.Sprintf(
+		"SELECT key, data_json, idx, watermark, executed_on, error, elapsed_ms FROM model_partitions WHERE instance_id=? AND model_id=? AND key IN (%s) ORDER BY idx, key",
+		strings.TrimSuffix(strings.Repeat("?,", len(keys)), ","),
+	)
+
+	return c.queryModelPartitions(ctx, qry, args...)
+}
+
+func (c *catalogStore) InsertModelPartition(ctx context.Context, modelID string, partition drivers.ModelPartition) error {
+	_, err := c.db.ExecContext(
+		ctx,
+		"INSERT INTO model_partitions(instance_id, model_id, key, data_json, idx, watermark, executed_on, error, elapsed_ms) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		c.instanceID,
+		modelID,
+		partition.Key,
+		partition.DataJSON,
+		partition.Index,
+		partition.Watermark,
+		partition.ExecutedOn,
+		partition.Error,
+		partition.Elapsed.Milliseconds(),
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *catalogStore) UpdateModelPartition(ctx context.Context, modelID string, partition drivers.ModelPartition) error {
+	_, err := c.db.ExecContext(
+		ctx,
+		"UPDATE model_partitions SET data_json=?, idx=?, watermark=?, executed_on=?, error=?, elapsed_ms=? WHERE instance_id=? AND model_id=? AND key=?",
+		partition.DataJSON,
+		partition.Index,
+		partition.Watermark,
+		partition.ExecutedOn,
+		partition.Error,
+		partition.Elapsed.Milliseconds(),
+		c.instanceID,
+		modelID,
+		partition.Key,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *catalogStore) DeleteModelPartitions(ctx context.Context, modelID string) error {
+	_, err := c.db.ExecContext(ctx, "DELETE FROM model_partitions WHERE instance_id=? AND model_id=?", c.instanceID, modelID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *catalogStore) queryModelPartitions(ctx context.Context, qry string, args ...any) ([]drivers.ModelPartition, error) {
+	rows, err := c.db.QueryxContext(ctx, qry, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var res []drivers.ModelPartition
+	for rows.Next() {
+		var elapsedMs int64
+		r := drivers.ModelPartition{}
+		err := rows.Scan(&r.Key, &r.DataJSON, &r.Index, &r.Watermark, &r.ExecutedOn, &r.Error, &elapsedMs)
+		if err != nil {
+			return nil, err
+		}
+		r.Elapsed = time.Duration(elapsedMs) * time.Millisecond
+		res = append(res, r)
+	}
+
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+
+	return res, nil
+}
@@ -0,0 +1,14 @@
+CREATE TABLE model_partitions (
+	instance_id TEXT NOT NULL,
+	model_id TEXT NOT NULL,
+	key TEXT NOT NULL,
+	data_json BLOB NOT NULL,
+	idx INTEGER NOT NULL,
+	watermark TIMESTAMP,
+	executed_on TIMESTAMP,
+	error TEXT NOT NULL,
+	elapsed_ms INTEGER NOT NULL
+);
+
+CREATE UNIQUE INDEX model_partitions_instance_id_model_id_key_idx ON model_partitions (instance_id, model_id, key);
+CREATE INDEX model_partitions_instance_id_model_id_idx_idx ON model_partitions (instance_id, model_id, idx);
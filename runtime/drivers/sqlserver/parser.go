@@ -0,0 +1,252 @@
+package sqlserver
+
+import (
+	"database/sql"
+	"fmt"
+
+	mssql "github.com/microsoft/go-mssqldb"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+)
+
+type mapper interface {
+	runtimeType() *runtimev1.Type
+	// dest returns a pointer to a destination value that can be used in Rows.Scan
+	dest() any
+	// value dereferences a pointer created by dest
+	value(p any) (any, error)
+}
+
+// refer https://github.com/microsoft/go-mssqldb/blob/main/types.go for base types
+func getDBTypeNameToMapperMap() map[string]mapper {
+	m := make(map[string]mapper)
+
+	char := charMapper{}
+	bytes := byteMapper{}
+	date := dateMapper{}
+
+	// bit
+	m["BIT"] = boolMapper{}
+
+	// numeric
+	m["TINYINT"] = intMapper{code: runtimev1.Type_CODE_UINT8}
+	m["SMALLINT"] = intMapper{code: runtimev1.Type_CODE_INT16}
+	m["INT"] = intMapper{code: runtimev1.Type_CODE_INT32}
+	m["BIGINT"] = intMapper{code: runtimev1.Type_CODE_INT64}
+	m["REAL"] = floatMapper{code: runtimev1.Type_CODE_FLOAT32}
+	m["FLOAT"] = floatMapper{code: runtimev1.Type_CODE_FLOAT64}
+	// DECIMAL and MONEY values are scanned as their string representation
+	// They are stored as string without losing precision
+	m["DECIMAL"] = char
+	m["MONEY"] = char
+	m["SMALLMONEY"] = char
+
+	// string
+	m["CHAR"] = char
+	m["VARCHAR"] = char
+	m["TEXT"] = char
+	m["NCHAR"] = char
+	m["NVARCHAR"] = char
+	m["NTEXT"] = char
+	m["XML"] = char
+
+	// binary
+	m["BINARY"] = bytes
+	m["VARBINARY"] = bytes
+	m["IMAGE"] = bytes
+
+	// date and time
+	m["DATE"] = date
+	m["DATETIME"] = date
+	m["DATETIME2"] = date
+	m["SMALLDATETIME"] = date
+	m["DATETIMEOFFSET"] = date
+	m["TIME"] = timeMapper{}
+
+	// uuid
+	m["UNIQUEIDENTIFIER"] = uuidMapper{}
+
+	return m
+}
+
+type intMapper struct {
+	code runtimev1.Type_Code
+}
+
+func (m intMapper) runtimeType() *runtimev1.Type {
+	return &runtimev1.Type{Code: m.code}
+}
+
+func (m intMapper) dest() any {
+	return new(sql.NullInt64)
+}
+
+func (m intMapper) value(p any) (any, error) {
+	v, ok := p.(*sql.NullInt64)
+	if !ok {
+		return nil, fmt.Errorf("intMapper: unsupported value type %v", p)
+	}
+	if !v.Valid {
+		return nil, nil
+	}
+	switch m.code {
+	case runtimev1.Type_CODE_UINT8:
+		return uint8(v.Int64), nil
+	case runtimev1.Type_CODE_INT16:
+		return int16(v.Int64), nil
+	case runtimev1.Type_CODE_INT32:
+		return int32(v.Int64), nil
+	default:
+		return v.Int64, nil
+	}
+}
+
+type floatMapper struct {
+	code runtimev1.Type_Code
+}
+
+func (m floatMapper) runtimeType() *runtimev1.Type {
+	return &runtimev1.Type{Code: m.code}
+}
+
+func (m floatMapper) dest() any {
+	return new(sql.NullFloat64)
+}
+
+func (m floatMapper) value(p any) (any, error) {
+	v, ok := p.(*sql.NullFloat64)
+	if !ok {
+		return nil, fmt.Errorf("floatMapper: unsupported value type %v", p)
+	}
+	if !v.Valid {
+		return nil, nil
+	}
+	if m.code == runtimev1.Type_CODE_FLOAT32 {
+		return float32(v.Float64), nil
+	}
+	return v.Float64, nil
+}
+
+type boolMapper struct{}
+
+func (m boolMapper) runtimeType() *runtimev1.Type {
+	return &runtimev1.Type{Code: runtimev1.Type_CODE_BOOL}
+}
+
+func (m boolMapper) dest() any {
+	return new(sql.NullBool)
+}
+
+func (m boolMapper) value(p any) (any, error) {
+	switch v := p.(type) {
+	case *sql.NullBool:
+		return v.Value()
+	default:
+		return nil, fmt.Errorf("boolMapper: unsupported value type %v", v)
+	}
+}
+
+type charMapper struct{}
+
+func (m charMapper) runtimeType() *runtimev1.Type {
+	return &runtimev1.Type{Code: runtimev1.Type_CODE_STRING}
+}
+
+func (m charMapper) dest() any {
+	return new(sql.NullString)
+}
+
+func (m charMapper) value(p any) (any, error) {
+	switch v := p.(type) {
+	case *sql.NullString:
+		return v.Value()
+	default:
+		return nil, fmt.Errorf("charMapper: unsupported value type %v", v)
+	}
+}
+
+type byteMapper struct{}
+
+func (m byteMapper) runtimeType() *runtimev1.Type {
+	return &runtimev1.Type{Code: runtimev1.Type_CODE_BYTES}
+}
+
+func (m byteMapper) dest() any {
+	return &[]byte{}
+}
+
+func (m byteMapper) value(p any) (any, error) {
+	switch v := p.(type) {
+	case *[]byte:
+		if *v == nil {
+			return nil, nil
+		}
+		return *v, nil
+	default:
+		return nil, fmt.Errorf("byteMapper: unsupported value type %v", v)
+	}
+}
+
+type dateMapper struct{}
+
+func (m dateMapper) runtimeType() *runtimev1.Type {
+	// Use runtimev1.Type_CODE_DATE once DATE is supported by DuckDB appender
+	return &runtimev1.Type{Code: runtimev1.Type_CODE_TIMESTAMP}
+}
+
+func (m dateMapper) dest() any {
+	return new(sql.NullTime)
+}
+
+func (m dateMapper) value(p any) (any, error) {
+	switch v := p.(type) {
+	case *sql.NullTime:
+		return v.Value()
+	default:
+		return nil, fmt.Errorf("dateMapper: unsupported value type %v", v)
+	}
+}
+
+// timeMapper maps TIME values, which are scanned as a time on 0001-01-01, to their string representation.
+type timeMapper struct{}
+
+func (m timeMapper) runtimeType() *runtimev1.Type {
+	return &runtimev1.Type{Code: runtimev1.Type_CODE_STRING}
+}
+
+func (m timeMapper) dest() any {
+	return new(sql.NullTime)
+}
+
+func (m timeMapper) value(p any) (any, error) {
+	switch v := p.(type) {
+	case *sql.NullTime:
+		if !v.Valid {
+			return nil, nil
+		}
+		return v.Time.Format("15:04:05.9999999"), nil
+	default:
+		return nil, fmt.Errorf("timeMapper: unsupported value type %v", v)
+	}
+}
+
+type uuidMapper struct{}
+
+func (m uuidMapper) runtimeType() *runtimev1.Type {
+	return &runtimev1.Type{Code: runtimev1.Type_CODE_UUID}
+}
+
+func (m uuidMapper) dest() any {
+	return new(mssql.NullUniqueIdentifier)
+}
+
+func (m uuidMapper) value(p any) (any, error) {
+	switch v := p.(type) {
+	case *mssql.NullUniqueIdentifier:
+		if !v.Valid {
+			return nil, nil
+		}
+		return v.UUID.String(), nil
+	default:
+		return nil, fmt.Errorf("uuidMapper: unsupported value type %v", v)
+	}
+}
@@ -0,0 +1,75 @@
+package sqlserver
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMappers(t *testing.T) {
+	m := getDBTypeNameToMapperMap()
+
+	tests := []struct {
+		typeName string
+		code     runtimev1.Type_Code
+		src      any
+		want     any
+	}{
+		{"BIT", runtimev1.Type_CODE_BOOL, true, true},
+		{"TINYINT", runtimev1.Type_CODE_UINT8, int64(255), uint8(255)},
+		{"SMALLINT", runtimev1.Type_CODE_INT16, int64(-2), int16(-2)},
+		{"INT", runtimev1.Type_CODE_INT32, int64(3), int32(3)},
+		{"BIGINT", runtimev1.Type_CODE_INT64, int64(4), int64(4)},
+		{"REAL", runtimev1.Type_CODE_FLOAT32, float64(1.5), float32(1.5)},
+		{"FLOAT", runtimev1.Type_CODE_FLOAT64, float64(2.5), float64(2.5)},
+		{"DECIMAL", runtimev1.Type_CODE_STRING, []byte("12345678901234567890.12"), "12345678901234567890.12"},
+		{"NVARCHAR", runtimev1.Type_CODE_STRING, "hello", "hello"},
+		{"VARBINARY", runtimev1.Type_CODE_BYTES, []byte{1, 2}, []byte{1, 2}},
+		{"DATETIME2", runtimev1.Type_CODE_TIMESTAMP, time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)},
+		{"TIME", runtimev1.Type_CODE_STRING, time.Date(1, 1, 1, 13, 14, 15, 500000000, time.UTC), "13:14:15.5"},
+		{"UNIQUEIDENTIFIER", runtimev1.Type_CODE_UUID, []byte{0x67, 0x45, 0x23, 0x01, 0xab, 0x89, 0xef, 0xcd, 0x01, 0x23, 0x45, 0x67, 0x89, 0xab, 0xcd, 0xef}, "01234567-89AB-CDEF-0123-456789ABCDEF"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.typeName, func(t *testing.T) {
+			mapper, ok := m[tt.typeName]
+			require.True(t, ok)
+			require.Equal(t, tt.code, mapper.runtimeType().Code)
+
+			dest := mapper.dest()
+			require.NoError(t, convertAssign(dest, tt.src))
+			v, err := mapper.value(dest)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, v)
+
+			// NULL values
+			dest = mapper.dest()
+			require.NoError(t, convertAssign(dest, nil))
+			v, err = mapper.value(dest)
+			require.NoError(t, err)
+			require.Nil(t, v)
+		})
+	}
+
+	_, ok := m["SQL_VARIANT"]
+	require.False(t, ok)
+}
+
+// convertAssign assigns src to dest the way Rows.Scan does.
+func convertAssign(dest, src any) error {
+	switch d := dest.(type) {
+	case *[]byte:
+		if src == nil {
+			*d = nil
+			return nil
+		}
+		*d = src.([]byte)
+		return nil
+	case sql.Scanner:
+		return d.Scan(src)
+	default:
+		panic("unexpected destination")
+	}
+}
@@ -0,0 +1,30 @@
+package drivers
+
+import "context"
+
+// StreamStore is implemented by drivers that can consume records from a continuously updated stream, such as a Kafka topic.
+// Streams are ingested in micro-batches. Each batch resumes from the offsets returned by the previous batch.
+type StreamStore interface {
+	// ReadBatch reads the records published after the given offsets and writes them to a newline-delimited JSON file.
+	// It returns once it has read up to the end of the stream at the time of the call, or when it has read the maximum number of records for a batch.
+	// If offsets is empty, it starts from the position configured in props.
+	ReadBatch(ctx context.Context, props map[string]any, offsets map[string]int64) (*StreamBatch, error)
+}
+
+// StreamBatch is a batch of records read from a StreamStore.
+type StreamBatch struct {
+	// Path is the local path of a newline-delimited JSON file with the batch's records. It is empty if the batch has no records.
+	// The caller is responsible for removing the file.
+	Path string
+	// Records is the number of records in the batch.
+	Records int64
+	// Offsets are the offsets to resume reading from in the next batch.
+	Offsets map[string]int64
+}
+
+// StreamTransporter is implemented by transporters that can ingest a stream of records in micro-batches.
+type StreamTransporter interface {
+	// TransferBatch appends the records published after the given offsets to the sink table, creating the table if it doesn't exist.
+	// It returns the offsets to resume from in the next batch and the number of records ingested.
+	TransferBatch(ctx context.Context, srcProps, sinkProps map[string]any, offsets map[string]int64, opts *TransferOptions) (map[string]int64, int64, error)
+}
@@ -2,6 +2,7 @@ package drivers
 
 import (
 	"context"
+	"fmt"
 )
 
 // Transporter implements logic for moving data between two connectors
@@ -38,4 +39,21 @@ const (
 	ProgressUnitByte ProgressUnit = iota
 	ProgressUnitFile
 	ProgressUnitRecord
+	// ProgressUnitStage counts the stages of a multi-stage query.
+	ProgressUnitStage
 )
+
+func (u ProgressUnit) String() string {
+	switch u {
+	case ProgressUnitByte:
+		return "byte"
+	case ProgressUnitFile:
+		return "file"
+	case ProgressUnitRecord:
+		return "record"
+	case ProgressUnitStage:
+		return "stage"
+	default:
+		return fmt.Sprintf("unknown(%d)", int(u))
+	}
+}
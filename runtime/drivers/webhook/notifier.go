@@ -0,0 +1,266 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/eapache/go-resiliency/retrier"
+	"github.com/mitchellh/mapstructure"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/httputil"
+	"github.com/rilldata/rill/runtime/pkg/pbutil"
+)
+
+const (
+	// SignatureHeader is the header that carries the HMAC-SHA256 signature of the timestamp and payload.
+	SignatureHeader = "X-Rill-Signature"
+	// TimestampHeader is the header that carries the Unix time (in seconds) at which the request was signed.
+	// Receivers should reject requests with old timestamps to prevent replay attacks.
+	TimestampHeader = "X-Rill-Timestamp"
+
+	// MaskedHeaderValue replaces header values in notifier properties returned by APIs.
+	MaskedHeaderValue = "********"
+
+	requestTimeout = 30 * time.Second
+	retryN         = 3
+	retryWait      = time.Second
+)
+
+type notifier struct {
+	secret  string
+	headers map[string]string
+	props   *NotifierProperties
+	client  *http.Client
+	retry   *retrier.Retrier
+}
+
+type NotifierProperties struct {
+	URLs    []string          `mapstructure:"urls"`
+	Headers map[string]string `mapstructure:"headers"`
+}
+
+// newNotifier creates a notifier for the given properties.
+// The headers are configured on the connector and take precedence over the headers in the properties.
+func newNotifier(secret string, headers map[string]string, propsMap map[string]any) (*notifier, error) {
+	props, err := DecodeProps(propsMap)
+	if err != nil {
+		return nil, err
+	}
+	n := &notifier{
+		secret:  secret,
+		headers: headers,
+		props:   props,
+		client:  httputil.NewPublicClient(requestTimeout),
+		retry:   retrier.New(retrier.ExponentialBackoff(retryN, retryWait), retryErrClassifier{}),
+	}
+	return n, nil
+}
+
+// AlertStatusPayload is the JSON payload sent for alert notifications.
+type AlertStatusPayload struct {
+	Type           string         `json:"type"`
+	Title          string         `json:"title"`
+	ExecutionTime  time.Time      `json:"execution_time"`
+	Status         string         `json:"status"`
+	IsRecover      bool           `json:"is_recover"`
+	FailRow        map[string]any `json:"fail_row,omitempty"`
+	ExecutionError string         `json:"execution_error,omitempty"`
+	OpenLink       string         `json:"open_link,omitempty"`
+	EditLink       string         `json:"edit_link,omitempty"`
+}
+
+// ScheduledReportPayload is the JSON payload sent for report notifications.
+type ScheduledReportPayload struct {
+	Type           string    `json:"type"`
+	Title          string    `json:"title"`
+	ReportTime     time.Time `json:"report_time"`
+	DownloadFormat string    `json:"download_format"`
+	OpenLink       string    `json:"open_link,omitempty"`
+	DownloadLink   string    `json:"download_link,omitempty"`
+	EditLink       string    `json:"edit_link,omitempty"`
+}
+
+func (n *notifier) SendAlertStatus(s *drivers.AlertStatus) error {
+	return n.send(&AlertStatusPayload{
+		Type:           "alert_status",
+		Title:          s.Title,
+		ExecutionTime:  s.ExecutionTime,
+		Status:         formatAssertionStatus(s.Status),
+		IsRecover:      s.IsRecover,
+		FailRow:        s.FailRow,
+		ExecutionError: s.ExecutionError,
+		OpenLink:       s.OpenLink,
+		EditLink:       s.EditLink,
+	})
+}
+
+func (n *notifier) SendScheduledReport(s *drivers.ScheduledReport) error {
+	return n.send(&ScheduledReportPayload{
+		Type:           "scheduled_report",
+		Title:          s.Title,
+		ReportTime:     s.ReportTime,
+		DownloadFormat: s.DownloadFormat,
+		OpenLink:       s.OpenLink,
+		DownloadLink:   s.DownloadLink,
+		EditLink:       s.EditLink,
+	})
+}
+
+func (n *notifier) send(payload any) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("webhook payload error: %w", err)
+	}
+
+	for _, u := range n.props.URLs {
+		err := n.retry.Run(func() error { return n.post(u, body) })
+		if err != nil {
+			return fmt.Errorf("webhook error: %w", err)
+		}
+	}
+	return nil
+}
+
+func (n *notifier) post(u string, body []byte) error {
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	for k, v := range n.props.Headers {
+		req.Header.Set(k, v)
+	}
+	for k, v := range n.headers {
+		req.Header.Set(k, v)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if n.secret != "" {
+		ts := time.Now().Unix()
+		req.Header.Set(TimestampHeader, strconv.FormatInt(ts, 10))
+		req.Header.Set(SignatureHeader, Sign(n.secret, ts, body))
+	}
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode/100 != 2 {
+		return &statusError{url: u, statusCode: resp.StatusCode}
+	}
+	return nil
+}
+
+// Sign returns the value of the signature header for a payload sent at the given Unix timestamp.
+// The signed message is the timestamp and the payload joined by a period.
+// Receivers can verify a payload by computing the same value from the timestamp header and comparing it to the signature header.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// IsSensitiveHeader returns true for headers that typically carry credentials.
+// Such headers must be configured on the webhook connector instead of in the notifier properties, which are stored in resource specs.
+func IsSensitiveHeader(name string) bool {
+	name = strings.ToLower(name)
+	switch name {
+	case "authorization", "proxy-authorization", "cookie":
+		return true
+	}
+	for _, s := range []string{"token", "secret", "password", "api-key", "apikey", "signature"} {
+		if strings.Contains(name, s) {
+			return true
+		}
+	}
+	return false
+}
+
+func formatAssertionStatus(s runtimev1.AssertionStatus) string {
+	return strings.ToLower(strings.TrimPrefix(s.String(), "ASSERTION_STATUS_"))
+}
+
+func EncodeProps(urls []string, headers map[string]string) map[string]any {
+	hs := make(map[string]any, len(headers))
+	for k, v := range headers {
+		hs[k] = v
+	}
+	return map[string]any{
+		"urls":    pbutil.ToSliceAny(urls),
+		"headers": hs,
+	}
+}
+
+func DecodeProps(propsMap map[string]any) (*NotifierProperties, error) {
+	props := &NotifierProperties{}
+	err := mapstructure.WeakDecode(propsMap, props)
+	if err != nil {
+		return nil, err
+	}
+	return props, nil
+}
+
+// MaskProps returns a copy of the notifier properties with the header values masked.
+func MaskProps(propsMap map[string]any) map[string]any {
+	res := make(map[string]any, len(propsMap))
+	for k, v := range propsMap {
+		res[k] = v
+	}
+	if hs, ok := propsMap["headers"].(map[string]any); ok {
+		masked := make(map[string]any, len(hs))
+		for k := range hs {
+			masked[k] = MaskedHeaderValue
+		}
+		res["headers"] = masked
+	}
+	return res
+}
+
+// statusError is returned when a webhook responds with a non-2xx status code.
+type statusError struct {
+	url        string
+	statusCode int
+}
+
+func (e *statusError) Error() string {
+	return fmt.Sprintf("request to %q failed with status code %d", e.url, e.statusCode)
+}
+
+// retryErrClassifier classifies webhook request errors as retryable or not.
+type retryErrClassifier struct{}
+
+func (retryErrClassifier) Classify(err error) retrier.Action {
+	if err == nil {
+		return retrier.Succeed
+	}
+
+	if errors.Is(err, httputil.ErrNonPublicAddress) {
+		return retrier.Fail
+	}
+
+	var statusErr *statusError
+	if errors.As(err, &statusErr) && statusErr.statusCode/100 == 4 && statusErr.statusCode != http.StatusTooManyRequests {
+		// Any 4xx error apart from 429 is non retryable
+		return retrier.Fail
+	}
+
+	return retrier.Retry
+}
@@ -0,0 +1,142 @@
+package webhook
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/activity"
+	"github.com/rilldata/rill/runtime/pkg/httputil"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestSendAlertStatus(t *testing.T) {
+	var body []byte
+	var headers http.Header
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ = io.ReadAll(r.Body)
+		headers = r.Header
+	}))
+	defer srv.Close()
+
+	n, err := newNotifier("secret", map[string]string{"Authorization": "Bearer token", "X-Foo": "baz"}, EncodeProps([]string{srv.URL}, map[string]string{"X-Foo": "bar", "X-Team": "data"}))
+	require.NoError(t, err)
+	n.client = srv.Client()
+
+	err = n.SendAlertStatus(&drivers.AlertStatus{
+		Title:         "Foobar",
+		ExecutionTime: time.Date(2024, 1, 27, 0, 0, 0, 0, time.UTC),
+		Status:        runtimev1.AssertionStatus_ASSERTION_STATUS_FAIL,
+		FailRow:       map[string]any{"hello": "world"},
+		OpenLink:      "https://example.com",
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, "application/json", headers.Get("Content-Type"))
+	require.Equal(t, "baz", headers.Get("X-Foo"))
+	require.Equal(t, "data", headers.Get("X-Team"))
+	require.Equal(t, "Bearer token", headers.Get("Authorization"))
+
+	ts, err := strconv.ParseInt(headers.Get(TimestampHeader), 10, 64)
+	require.NoError(t, err)
+	require.WithinDuration(t, time.Now(), time.Unix(ts, 0), time.Minute)
+	require.Equal(t, Sign("secret", ts, body), headers.Get(SignatureHeader))
+	require.NotEqual(t, Sign("secret", ts-1, body), headers.Get(SignatureHeader))
+
+	payload := &AlertStatusPayload{}
+	require.NoError(t, json.Unmarshal(body, payload))
+	require.Equal(t, "alert_status", payload.Type)
+	require.Equal(t, "Foobar", payload.Title)
+	require.Equal(t, "fail", payload.Status)
+	require.Equal(t, map[string]any{"hello": "world"}, payload.FailRow)
+	require.Equal(t, "https://example.com", payload.OpenLink)
+}
+
+func TestSendScheduledReportRetries(t *testing.T) {
+	calls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		require.Empty(t, r.Header.Get(SignatureHeader))
+		require.Empty(t, r.Header.Get(TimestampHeader))
+	}))
+	defer srv.Close()
+
+	n, err := newNotifier("", nil, EncodeProps([]string{srv.URL}, nil))
+	require.NoError(t, err)
+	n.client = srv.Client()
+
+	err = n.SendScheduledReport(&drivers.ScheduledReport{
+		Title:          "Foobar",
+		ReportTime:     time.Date(2024, 1, 27, 0, 0, 0, 0, time.UTC),
+		DownloadFormat: "CSV",
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, calls)
+}
+
+func TestSendNoRetryOnClientError(t *testing.T) {
+	calls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer srv.Close()
+
+	n, err := newNotifier("", nil, EncodeProps([]string{srv.URL}, nil))
+	require.NoError(t, err)
+	n.client = srv.Client()
+
+	err = n.SendScheduledReport(&drivers.ScheduledReport{Title: "Foobar"})
+	require.ErrorContains(t, err, "status code 400")
+	require.Equal(t, 1, calls)
+}
+
+func TestSendRejectsPrivateAddresses(t *testing.T) {
+	calls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+	}))
+	defer srv.Close()
+
+	n, err := newNotifier("", nil, EncodeProps([]string{srv.URL}, nil))
+	require.NoError(t, err)
+
+	err = n.SendScheduledReport(&drivers.ScheduledReport{Title: "Foobar"})
+	require.ErrorIs(t, err, httputil.ErrNonPublicAddress)
+	require.Equal(t, 0, calls)
+}
+
+func TestOpenHeaders(t *testing.T) {
+	h, err := driver{}.Open("default", map[string]any{"headers": `{"Authorization": "Bearer token"}`}, activity.NewNoopClient(), zap.NewNop())
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"Authorization": "Bearer token"}, h.(*handle).headers)
+
+	_, err = driver{}.Open("default", map[string]any{"headers": `Authorization: Bearer token`}, activity.NewNoopClient(), zap.NewNop())
+	require.ErrorContains(t, err, "invalid webhook headers")
+}
+
+func TestMaskProps(t *testing.T) {
+	props := EncodeProps([]string{"https://example.com"}, map[string]string{"X-Team": "data"})
+	masked := MaskProps(props)
+	require.Equal(t, map[string]any{"X-Team": MaskedHeaderValue}, masked["headers"])
+	require.Equal(t, props["urls"], masked["urls"])
+	require.Equal(t, map[string]any{"X-Team": "data"}, props["headers"])
+}
+
+func TestIsSensitiveHeader(t *testing.T) {
+	require.True(t, IsSensitiveHeader("Authorization"))
+	require.True(t, IsSensitiveHeader("X-Api-Key"))
+	require.True(t, IsSensitiveHeader("X-Auth-Token"))
+	require.False(t, IsSensitiveHeader("X-Team"))
+}
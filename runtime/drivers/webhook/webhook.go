@@ -0,0 +1,175 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/activity"
+	"go.uber.org/zap"
+)
+
+var spec = drivers.Spec{
+	DisplayName: "Webhook",
+	Description: "Webhook Notifier",
+	ConfigProperties: []*drivers.PropertySpec{
+		{
+			Key:         "secret",
+			Type:        drivers.StringPropertyType,
+			DisplayName: "Signing secret",
+			Description: "If set, payloads are signed with HMAC-SHA256 and the signature is sent in the X-Rill-Signature header",
+			Secret:      true,
+		},
+		{
+			Key:         "headers",
+			Type:        drivers.StringPropertyType,
+			DisplayName: "Headers",
+			Description: "JSON object of headers to send with every request, such as credentials for the receiving service",
+			Placeholder: `{"Authorization": "Bearer <token>"}`,
+			Secret:      true,
+		},
+	},
+	ImplementsNotifier: true,
+}
+
+func init() {
+	drivers.Register("webhook", driver{})
+	drivers.RegisterAsConnector("webhook", driver{})
+}
+
+type driver struct{}
+
+func (d driver) Spec() drivers.Spec {
+	return spec
+}
+
+func (d driver) Open(instanceID string, config map[string]any, client *activity.Client, logger *zap.Logger) (drivers.Handle, error) {
+	if instanceID == "" {
+		return nil, fmt.Errorf("webhook driver can't be shared")
+	}
+	conf := &configProperties{}
+	err := mapstructure.WeakDecode(config, conf)
+	if err != nil {
+		return nil, err
+	}
+
+	var headers map[string]string
+	if conf.Headers != "" {
+		err = json.Unmarshal([]byte(conf.Headers), &headers)
+		if err != nil {
+			return nil, fmt.Errorf("invalid webhook headers: must be a JSON object of strings: %w", err)
+		}
+	}
+
+	conn := &handle{
+		config:  conf,
+		headers: headers,
+		logger:  logger,
+	}
+	return conn, nil
+}
+
+func (d driver) Drop(config map[string]any, logger *zap.Logger) error {
+	return nil
+}
+
+func (d driver) HasAnonymousSourceAccess(ctx context.Context, props map[string]any, logger *zap.Logger) (bool, error) {
+	return false, fmt.Errorf("not implemented")
+}
+
+func (d driver) TertiarySourceConnectors(ctx context.Context, src map[string]any, logger *zap.Logger) ([]string, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+type handle struct {
+	config  *configProperties
+	headers map[string]string
+	logger  *zap.Logger
+}
+
+var _ drivers.Handle = &handle{}
+
+func (h *handle) Driver() string {
+	return "webhook"
+}
+
+func (h *handle) Config() map[string]any {
+	return map[string]any{}
+}
+
+func (h *handle) Migrate(ctx context.Context) error {
+	return nil
+}
+
+func (h *handle) MigrationStatus(ctx context.Context) (current, desired int, err error) {
+	return 0, 0, nil
+}
+
+func (h *handle) Close() error {
+	return nil
+}
+
+func (h *handle) AsRegistry() (drivers.RegistryStore, bool) {
+	return nil, false
+}
+
+func (h *handle) AsCatalogStore(instanceID string) (drivers.CatalogStore, bool) {
+	return nil, false
+}
+
+func (h *handle) AsRepoStore(instanceID string) (drivers.RepoStore, bool) {
+	return nil, false
+}
+
+func (h *handle) AsAdmin(instanceID string) (drivers.AdminService, bool) {
+	return nil, false
+}
+
+func (h *handle) AsAI(instanceID string) (drivers.AIService, bool) {
+	return nil, false
+}
+
+func (h *handle) AsSQLStore() (drivers.SQLStore, bool) {
+	return nil, false
+}
+
+func (h *handle) AsOLAP(instanceID string) (drivers.OLAPStore, bool) {
+	return nil, false
+}
+
+func (h *handle) AsObjectStore() (drivers.ObjectStore, bool) {
+	return nil, false
+}
+
+func (h *handle) AsFileStore() (drivers.FileStore, bool) {
+	return nil, false
+}
+
+// AsStreamStore implements drivers.Handle.
+func (h *handle) AsStreamStore() (drivers.StreamStore, bool) {
+	return nil, false
+}
+
+func (h *handle) AsModelExecutor(instanceID string, opts *drivers.ModelExecutorOptions) (drivers.ModelExecutor, bool) {
+	return nil, false
+}
+
+// AsModelManager implements drivers.Handle.
+func (h *handle) AsModelManager(instanceID string) (drivers.ModelManager, bool) {
+	return nil, false
+}
+
+func (h *handle) AsTransporter(from, to drivers.Handle) (drivers.Transporter, bool) {
+	return nil, false
+}
+
+func (h *handle) AsNotifier(properties map[string]any) (drivers.Notifier, error) {
+	return newNotifier(h.config.Secret, h.headers, properties)
+}
+
+type configProperties struct {
+	Secret  string `mapstructure:"secret"`
+	Headers string `mapstructure:"headers"`
+}
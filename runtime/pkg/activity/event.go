@@ -34,6 +34,7 @@ const (
 	EventTypeLog        = "log"
 	EventTypeMetric     = "metric"
 	EventTypeBehavioral = "behavioral"
+	EventTypeAudit      = "audit"
 )
 
 // Constants for common event attribute keys.
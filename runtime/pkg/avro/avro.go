@@ -0,0 +1,358 @@
+// Package avro decodes data in the Apache Avro binary encoding.
+// It supports decoding single values given a schema and reading object container files.
+package avro
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+	"time"
+)
+
+// Schema is a parsed Avro schema.
+type Schema struct {
+	// Type is a primitive type name or one of "record", "enum", "array", "map", "union" and "fixed".
+	Type        string
+	LogicalType string
+	Scale       int
+	Fields      []Field
+	Symbols     []string
+	Items       *Schema
+	Branches    []*Schema
+	Size        int
+}
+
+type Field struct {
+	Name string
+	Type *Schema
+}
+
+// ParseSchema parses an Avro schema from its JSON representation.
+func ParseSchema(schema string) (*Schema, error) {
+	var v any
+	err := json.Unmarshal([]byte(schema), &v)
+	if err != nil {
+		return nil, err
+	}
+	p := &schemaParser{names: make(map[string]*Schema)}
+	return p.parse(v, "")
+}
+
+type schemaParser struct {
+	names map[string]*Schema
+}
+
+func (p *schemaParser) parse(v any, namespace string) (*Schema, error) {
+	switch v := v.(type) {
+	case string:
+		switch v {
+		case "null", "boolean", "int", "long", "float", "double", "bytes", "string":
+			return &Schema{Type: v}, nil
+		}
+		// Reference to a named type
+		if t, ok := p.names[fullName(v, namespace)]; ok {
+			return t, nil
+		}
+		if t, ok := p.names[v]; ok {
+			return t, nil
+		}
+		return nil, fmt.Errorf("unknown type %q", v)
+	case []any:
+		t := &Schema{Type: "union"}
+		for _, b := range v {
+			bt, err := p.parse(b, namespace)
+			if err != nil {
+				return nil, err
+			}
+			t.Branches = append(t.Branches, bt)
+		}
+		return t, nil
+	case map[string]any:
+		typ, _ := v["type"].(string)
+		logicalType, _ := v["logicalType"].(string)
+		scale, _ := v["scale"].(float64)
+
+		// Named types may define a new namespace for nested types
+		name, _ := v["name"].(string)
+		if ns, ok := v["namespace"].(string); ok {
+			namespace = ns
+		}
+		if i := strings.LastIndex(name, "."); i >= 0 {
+			namespace = name[:i]
+		}
+
+		switch typ {
+		case "record", "error":
+			t := &Schema{Type: "record"}
+			p.names[fullName(name, namespace)] = t // Register before parsing fields to support recursive types
+			fields, _ := v["fields"].([]any)
+			for _, f := range fields {
+				fm, ok := f.(map[string]any)
+				if !ok {
+					return nil, fmt.Errorf("invalid field in record %q", name)
+				}
+				fname, _ := fm["name"].(string)
+				ft, err := p.parse(fm["type"], namespace)
+				if err != nil {
+					return nil, fmt.Errorf("field %q: %w", fname, err)
+				}
+				t.Fields = append(t.Fields, Field{Name: fname, Type: ft})
+			}
+			return t, nil
+		case "enum":
+			t := &Schema{Type: "enum"}
+			symbols, _ := v["symbols"].([]any)
+			for _, s := range symbols {
+				str, _ := s.(string)
+				t.Symbols = append(t.Symbols, str)
+			}
+			p.names[fullName(name, namespace)] = t
+			return t, nil
+		case "fixed":
+			size, _ := v["size"].(float64)
+			t := &Schema{Type: "fixed", Size: int(size), LogicalType: logicalType, Scale: int(scale)}
+			p.names[fullName(name, namespace)] = t
+			return t, nil
+		case "array":
+			items, err := p.parse(v["items"], namespace)
+			if err != nil {
+				return nil, err
+			}
+			return &Schema{Type: "array", Items: items}, nil
+		case "map":
+			values, err := p.parse(v["values"], namespace)
+			if err != nil {
+				return nil, err
+			}
+			return &Schema{Type: "map", Items: values}, nil
+		default:
+			t, err := p.parse(v["type"], namespace)
+			if err != nil {
+				return nil, err
+			}
+			if logicalType != "" {
+				// Copy to avoid mutating a shared named type
+				cp := *t
+				cp.LogicalType = logicalType
+				cp.Scale = int(scale)
+				t = &cp
+			}
+			return t, nil
+		}
+	default:
+		return nil, fmt.Errorf("invalid schema %v", v)
+	}
+}
+
+func fullName(name, namespace string) string {
+	if strings.Contains(name, ".") || namespace == "" {
+		return name
+	}
+	return namespace + "." + name
+}
+
+// Reader reads values from an Avro binary encoding.
+// Values are decoded to types that can be marshalled to JSON.
+type Reader struct {
+	buf []byte
+	pos int
+}
+
+// NewReader returns a Reader that reads values from b.
+func NewReader(b []byte) *Reader {
+	return &Reader{buf: b}
+}
+
+// Read reads a value with schema t.
+func (r *Reader) Read(t *Schema) (any, error) {
+	return r.read(t)
+}
+
+// Remaining returns the number of bytes that haven't been read yet.
+func (r *Reader) Remaining() int {
+	return len(r.buf) - r.pos
+}
+
+func (r *Reader) read(t *Schema) (any, error) {
+	switch t.Type {
+	case "null":
+		return nil, nil
+	case "boolean":
+		b, err := r.readBytes(1)
+		if err != nil {
+			return nil, err
+		}
+		return b[0] != 0, nil
+	case "int", "long":
+		n, err := r.readLong()
+		if err != nil {
+			return nil, err
+		}
+		switch t.LogicalType {
+		case "date":
+			return time.Unix(n*86400, 0).UTC().Format(time.DateOnly), nil
+		case "timestamp-millis", "local-timestamp-millis":
+			return time.UnixMilli(n).UTC().Format(time.RFC3339Nano), nil
+		case "timestamp-micros", "local-timestamp-micros":
+			return time.UnixMicro(n).UTC().Format(time.RFC3339Nano), nil
+		}
+		return n, nil
+	case "float":
+		b, err := r.readBytes(4)
+		if err != nil {
+			return nil, err
+		}
+		return math.Float32frombits(binary.LittleEndian.Uint32(b)), nil
+	case "double":
+		b, err := r.readBytes(8)
+		if err != nil {
+			return nil, err
+		}
+		return math.Float64frombits(binary.LittleEndian.Uint64(b)), nil
+	case "bytes", "fixed":
+		var b []byte
+		var err error
+		if t.Type == "fixed" {
+			b, err = r.readBytes(t.Size)
+		} else {
+			b, err = r.readLengthPrefixed()
+		}
+		if err != nil {
+			return nil, err
+		}
+		if t.LogicalType == "decimal" {
+			return decimal(b, t.Scale), nil
+		}
+		return append([]byte(nil), b...), nil
+	case "string":
+		b, err := r.readLengthPrefixed()
+		if err != nil {
+			return nil, err
+		}
+		return string(b), nil
+	case "record":
+		res := make(map[string]any, len(t.Fields))
+		for _, f := range t.Fields {
+			v, err := r.read(f.Type)
+			if err != nil {
+				return nil, fmt.Errorf("field %q: %w", f.Name, err)
+			}
+			res[f.Name] = v
+		}
+		return res, nil
+	case "enum":
+		i, err := r.readLong()
+		if err != nil {
+			return nil, err
+		}
+		if i < 0 || int(i) >= len(t.Symbols) {
+			return nil, fmt.Errorf("enum index %d out of range", i)
+		}
+		return t.Symbols[i], nil
+	case "union":
+		i, err := r.readLong()
+		if err != nil {
+			return nil, err
+		}
+		if i < 0 || int(i) >= len(t.Branches) {
+			return nil, fmt.Errorf("union index %d out of range", i)
+		}
+		return r.read(t.Branches[i])
+	case "array":
+		res := []any{}
+		err := r.readBlocks(func() error {
+			v, err := r.read(t.Items)
+			if err != nil {
+				return err
+			}
+			res = append(res, v)
+			return nil
+		})
+		return res, err
+	case "map":
+		res := map[string]any{}
+		err := r.readBlocks(func() error {
+			k, err := r.readLengthPrefixed()
+			if err != nil {
+				return err
+			}
+			v, err := r.read(t.Items)
+			if err != nil {
+				return err
+			}
+			res[string(k)] = v
+			return nil
+		})
+		return res, err
+	default:
+		return nil, fmt.Errorf("unsupported type %q", t.Type)
+	}
+}
+
+// readBlocks reads the blocks of an array or map, calling fn for each item.
+func (r *Reader) readBlocks(fn func() error) error {
+	for {
+		n, err := r.readLong()
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return nil
+		}
+		if n < 0 {
+			// A negative count is followed by the block's size in bytes
+			n = -n
+			if _, err := r.readLong(); err != nil {
+				return err
+			}
+		}
+		for i := int64(0); i < n; i++ {
+			if err := fn(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// readLong reads a zig-zag encoded variable-length integer.
+func (r *Reader) readLong() (int64, error) {
+	v, n := binary.Varint(r.buf[r.pos:])
+	if n <= 0 {
+		return 0, errors.New("invalid variable-length integer")
+	}
+	r.pos += n
+	return v, nil
+}
+
+func (r *Reader) readLengthPrefixed() ([]byte, error) {
+	n, err := r.readLong()
+	if err != nil {
+		return nil, err
+	}
+	if n < 0 {
+		return nil, fmt.Errorf("invalid length %d", n)
+	}
+	return r.readBytes(int(n))
+}
+
+func (r *Reader) readBytes(n int) ([]byte, error) {
+	if r.pos+n > len(r.buf) {
+		return nil, errors.New("unexpected end of record")
+	}
+	b := r.buf[r.pos : r.pos+n]
+	r.pos += n
+	return b, nil
+}
+
+// decimal converts the two's-complement big-endian encoding of an Avro decimal to a JSON number.
+func decimal(b []byte, scale int) json.Number {
+	v := new(big.Int).SetBytes(b)
+	if len(b) > 0 && b[0]&0x80 != 0 {
+		v.Sub(v, new(big.Int).Lsh(big.NewInt(1), uint(len(b)*8)))
+	}
+	return json.Number(new(big.Rat).SetFrac(v, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(scale)), nil)).FloatString(scale))
+}
@@ -0,0 +1,133 @@
+package avro
+
+import (
+	"bytes"
+	"compress/flate"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+)
+
+// ocfMagic is the header of an Avro object container file.
+var ocfMagic = []byte{'O', 'b', 'j', 1}
+
+// ReadOCF reads all records from an Avro object container file.
+// It returns the records decoded with the writer's schema embedded in the file and the file's metadata.
+func ReadOCF(r io.Reader) ([]any, map[string][]byte, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !bytes.HasPrefix(data, ocfMagic) {
+		return nil, nil, errors.New("avro: not an object container file")
+	}
+	rd := NewReader(data[len(ocfMagic):])
+
+	// Read the file metadata
+	meta := make(map[string][]byte)
+	err = rd.readBlocks(func() error {
+		k, err := rd.readLengthPrefixed()
+		if err != nil {
+			return err
+		}
+		v, err := rd.readLengthPrefixed()
+		if err != nil {
+			return err
+		}
+		meta[string(k)] = v
+		return nil
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("avro: invalid file header: %w", err)
+	}
+	sync, err := rd.readBytes(16)
+	if err != nil {
+		return nil, nil, fmt.Errorf("avro: invalid file header: %w", err)
+	}
+
+	schema, err := ParseSchema(string(meta["avro.schema"]))
+	if err != nil {
+		return nil, nil, fmt.Errorf("avro: invalid schema in file header: %w", err)
+	}
+	codec := string(meta["avro.codec"])
+
+	// Read the data blocks
+	var res []any
+	for rd.Remaining() > 0 {
+		n, err := rd.readLong()
+		if err != nil {
+			return nil, nil, err
+		}
+		size, err := rd.readLong()
+		if err != nil {
+			return nil, nil, err
+		}
+		if size < 0 {
+			return nil, nil, fmt.Errorf("avro: invalid block size %d", size)
+		}
+		block, err := rd.readBytes(int(size))
+		if err != nil {
+			return nil, nil, err
+		}
+		marker, err := rd.readBytes(16)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !bytes.Equal(marker, sync) {
+			return nil, nil, errors.New("avro: invalid sync marker")
+		}
+
+		block, err = decompressBlock(codec, block)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		br := NewReader(block)
+		for i := int64(0); i < n; i++ {
+			v, err := br.Read(schema)
+			if err != nil {
+				return nil, nil, fmt.Errorf("avro: failed to read record: %w", err)
+			}
+			res = append(res, v)
+		}
+	}
+
+	return res, meta, nil
+}
+
+// decompressBlock decompresses a data block of an object container file.
+func decompressBlock(codec string, block []byte) ([]byte, error) {
+	switch codec {
+	case "", "null":
+		return block, nil
+	case "deflate":
+		return io.ReadAll(flate.NewReader(bytes.NewReader(block)))
+	case "snappy":
+		// The compressed data is followed by the CRC32 checksum of the uncompressed data
+		if len(block) < 4 {
+			return nil, errors.New("avro: invalid snappy block")
+		}
+		res, err := snappy.Decode(nil, block[:len(block)-4])
+		if err != nil {
+			return nil, err
+		}
+		if crc32.ChecksumIEEE(res) != binary.BigEndian.Uint32(block[len(block)-4:]) {
+			return nil, errors.New("avro: snappy block checksum mismatch")
+		}
+		return res, nil
+	case "zstandard":
+		dec, err := zstd.NewReader(nil)
+		if err != nil {
+			return nil, err
+		}
+		defer dec.Close()
+		return dec.DecodeAll(block, nil)
+	default:
+		return nil, fmt.Errorf("avro: unsupported codec %q", codec)
+	}
+}
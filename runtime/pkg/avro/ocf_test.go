@@ -0,0 +1,81 @@
+package avro
+
+import (
+	"bytes"
+	"compress/flate"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadOCF(t *testing.T) {
+	schema := `{
+		"type": "record",
+		"name": "manifest_file",
+		"fields": [
+			{"name": "manifest_path", "type": "string"},
+			{"name": "added_snapshot_id", "type": ["null", "long"]},
+			{"name": "partitions", "type": {"type": "array", "items": {"type": "record", "name": "r508", "fields": [{"name": "contains_null", "type": "boolean"}]}}}
+		]
+	}`
+
+	for _, codec := range []string{"null", "deflate"} {
+		t.Run(codec, func(t *testing.T) {
+			// Two records
+			var records []byte
+			records = appendString(records, "s3://bucket/a.avro")
+			records = binary.AppendVarint(records, 1)
+			records = binary.AppendVarint(records, 10)
+			records = binary.AppendVarint(records, 1)
+			records = append(records, 1)
+			records = binary.AppendVarint(records, 0)
+			records = appendString(records, "s3://bucket/b.avro")
+			records = binary.AppendVarint(records, 0)
+			records = binary.AppendVarint(records, 0)
+
+			block := records
+			if codec == "deflate" {
+				var buf bytes.Buffer
+				w, err := flate.NewWriter(&buf, flate.DefaultCompression)
+				require.NoError(t, err)
+				_, err = w.Write(records)
+				require.NoError(t, err)
+				require.NoError(t, w.Close())
+				block = buf.Bytes()
+			}
+
+			sync := bytes.Repeat([]byte{0xAB}, 16)
+			data := append([]byte{}, ocfMagic...)
+			data = binary.AppendVarint(data, 2)
+			data = appendString(data, "avro.schema")
+			data = appendString(data, schema)
+			data = appendString(data, "avro.codec")
+			data = appendString(data, codec)
+			data = binary.AppendVarint(data, 0)
+			data = append(data, sync...)
+			data = binary.AppendVarint(data, 2)
+			data = binary.AppendVarint(data, int64(len(block)))
+			data = append(data, block...)
+			data = append(data, sync...)
+
+			res, meta, err := ReadOCF(bytes.NewReader(data))
+			require.NoError(t, err)
+			require.Equal(t, codec, string(meta["avro.codec"]))
+			require.Equal(t, []any{
+				map[string]any{"manifest_path": "s3://bucket/a.avro", "added_snapshot_id": int64(10), "partitions": []any{map[string]any{"contains_null": true}}},
+				map[string]any{"manifest_path": "s3://bucket/b.avro", "added_snapshot_id": nil, "partitions": []any{}},
+			}, res)
+
+			// Corrupt sync marker
+			data[len(data)-1] = 0
+			_, _, err = ReadOCF(bytes.NewReader(data))
+			require.Error(t, err)
+		})
+	}
+}
+
+func appendString(b []byte, s string) []byte {
+	b = binary.AppendVarint(b, int64(len(s)))
+	return append(b, s...)
+}
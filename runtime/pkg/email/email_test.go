@@ -7,7 +7,6 @@ import (
 
 	"github.com/google/uuid"
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
-	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/stretchr/testify/require"
 )
 
@@ -56,7 +55,7 @@ func TestAlertFail(t *testing.T) {
 	mock := &mockSender{}
 	client := New(mock)
 
-	opts := &drivers.AlertStatus{
+	opts := &AlertStatus{
 		ToEmail:       uuid.New().String(),
 		ToName:        uuid.New().String(),
 		Title:         "Foobar",
@@ -84,7 +83,7 @@ func TestAlertRecover(t *testing.T) {
 	mock := &mockSender{}
 	client := New(mock)
 
-	opts := &drivers.AlertStatus{
+	opts := &AlertStatus{
 		ToEmail:       uuid.New().String(),
 		ToName:        uuid.New().String(),
 		Title:         "Foobar",
@@ -109,7 +108,7 @@ func TestAlertError(t *testing.T) {
 	mock := &mockSender{}
 	client := New(mock)
 
-	opts := &drivers.AlertStatus{
+	opts := &AlertStatus{
 		ToEmail:        uuid.New().String(),
 		ToName:         uuid.New().String(),
 		Title:          "Foobar",
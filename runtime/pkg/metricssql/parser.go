@@ -98,6 +98,16 @@ type transformer struct {
 	dimsToExpr    map[string]string
 	measureToExpr map[string]string
 	priority      int
+	dialect       drivers.Dialect
+
+	// windowMeasures are the window measures of the metrics view (such as running totals)
+	windowMeasures map[string]*runtimev1.MetricsViewSpec_MeasureV2
+	// selectedWindows are the window measures referenced in the query. They are compiled to placeholders that are replaced after the select list is transformed.
+	selectedWindows []*runtimev1.MetricsViewSpec_MeasureV2
+	// timeDimExpr is the expression of the selected dimension that uses the time dimension
+	timeDimExpr string
+	// otherDimExprs are the expressions of the other selected dimensions
+	otherDimExprs []string
 }
 
 func (t *transformer) transformSelectStmt(ctx context.Context, node *ast.SelectStmt) (string, error) {
@@ -174,7 +184,8 @@ func (t *transformer) transformSelectStmt(ctx context.Context, node *ast.SelectS
 		sb.WriteString(" LIMIT ")
 		sb.WriteString(limit)
 	}
-	return sb.String(), nil
+
+	return t.replaceWindowPlaceholders(sb.String())
 }
 
 func (t *transformer) transformSelectStmtColumns(ctx context.Context, node *ast.SelectStmt) (string, string, error) {
@@ -219,6 +230,11 @@ func (t *transformer) transformSelectStmtColumns(ctx context.Context, node *ast.
 
 			if res.types[0] == "DIMENSION" {
 				groupByList = append(groupByList, res.expr)
+				if t.timeDimExpr == "" && t.referencesTimeDimension(res) {
+					t.timeDimExpr = res.expr
+				} else {
+					t.otherDimExprs = append(t.otherDimExprs, res.expr)
+				}
 			} else {
 				hasMeasure = true
 			}
@@ -264,10 +280,14 @@ func (t *transformer) transformFromClause(ctx context.Context, node *ast.TableRe
 }
 
 func (t *transformer) transformHavingClause(ctx context.Context, node *ast.HavingClause) (string, error) {
+	n := len(t.selectedWindows)
 	res, err := t.transformExprNode(ctx, node.Expr)
 	if err != nil {
 		return "", err
 	}
+	if len(t.selectedWindows) != n {
+		return "", fmt.Errorf("metrics sql: window measures are not supported in the having clause")
+	}
 	return res.expr, nil
 }
 
@@ -350,6 +370,10 @@ func (t *transformer) transformColumnNameExpr(_ context.Context, node *ast.Colum
 	}
 
 	col := node.Name.Name.O
+	if window, ok := t.windowMeasures[col]; ok {
+		t.selectedWindows = append(t.selectedWindows, window)
+		return exprResult{expr: windowPlaceholder(len(t.selectedWindows) - 1), columns: []string{restore(node.Name)}, types: []string{"MEASURE"}}, nil
+	}
 	if colExpr, ok := t.measureToExpr[col]; ok {
 		return exprResult{expr: colExpr, columns: []string{restore(node.Name)}, types: []string{"MEASURE"}}, nil
 	}
@@ -704,8 +728,14 @@ func (t *transformer) fromQueryForMetricsView(ctx context.Context, mv *runtimev1
 		return "", err
 	}
 
+	t.dialect = dialect
 	t.measureToExpr = make(map[string]string, len(spec.Measures))
+	t.windowMeasures = make(map[string]*runtimev1.MetricsViewSpec_MeasureV2)
 	for _, measure := range spec.Measures {
+		if drivers.IsMetricsViewWindowMeasure(measure) {
+			t.windowMeasures[measure.Name] = measure
+			continue
+		}
 		t.measureToExpr[measure.Name] = measure.Expression
 	}
 
@@ -733,6 +763,13 @@ func (t *transformer) fromQueryForMetricsView(ctx context.Context, mv *runtimev1
 			}
 		}
 
+		for measure := range t.windowMeasures {
+			if !slices.Contains(security.Include, measure) {
+				delete(t.windowMeasures, measure)
+				t.measureToExpr[measure] = "null"
+			}
+		}
+
 		for dimension := range t.dimsToExpr {
 			if !slices.Contains(security.Include, dimension) { // dimensions not part of include clause should not be accessible
 				t.dimsToExpr[dimension] = "null"
@@ -744,6 +781,7 @@ func (t *transformer) fromQueryForMetricsView(ctx context.Context, mv *runtimev1
 		if _, ok := t.dimsToExpr[exclude]; ok {
 			t.dimsToExpr[exclude] = "null"
 		} else {
+			delete(t.windowMeasures, exclude)
 			t.measureToExpr[exclude] = "null"
 		}
 	}
@@ -755,6 +793,52 @@ func (t *transformer) fromQueryForMetricsView(ctx context.Context, mv *runtimev1
 	return fmt.Sprintf("(%s)", sql), nil
 }
 
+// referencesTimeDimension returns true if the expression references the metrics view's time dimension.
+func (t *transformer) referencesTimeDimension(res exprResult) bool {
+	if t.metricsView.Spec.TimeDimension == "" {
+		return false
+	}
+	for _, col := range res.columns {
+		if strings.Trim(col, "\"") == t.metricsView.Spec.TimeDimension {
+			return true
+		}
+	}
+	return false
+}
+
+// replaceWindowPlaceholders replaces the placeholders of the selected window measures with window functions over the measures they reference.
+// The windows are ordered by the selected time dimension and partitioned by the other selected dimensions.
+func (t *transformer) replaceWindowPlaceholders(sql string) (string, error) {
+	for i, window := range t.selectedWindows {
+		base, ok := t.measureToExpr[window.ReferencedMeasures[0]]
+		if !ok {
+			return "", fmt.Errorf("metrics sql: measure %q referenced by window measure %q not found", window.ReferencedMeasures[0], window.Name)
+		}
+
+		var partitionBy []string
+		var orderBy string
+		if window.Type == runtimev1.MetricsViewSpec_MEASURE_TYPE_SHARE_OF_PARENT {
+			if t.timeDimExpr != "" {
+				partitionBy = []string{t.timeDimExpr}
+			}
+		} else {
+			partitionBy = t.otherDimExprs
+			orderBy = t.timeDimExpr
+		}
+
+		expr, err := t.dialect.MetricsViewWindowMeasureExpression(window, base, partitionBy, orderBy)
+		if err != nil {
+			return "", fmt.Errorf("metrics sql: %w", err)
+		}
+		sql = strings.ReplaceAll(sql, windowPlaceholder(i), expr)
+	}
+	return sql, nil
+}
+
+func windowPlaceholder(i int) string {
+	return fmt.Sprintf("__rill_window_measure_%d__", i)
+}
+
 func opToString(op opcode.Op) string {
 	var sb strings.Builder
 	op.Format(&sb)
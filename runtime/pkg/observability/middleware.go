@@ -284,6 +284,12 @@ func (rw *wrappedResponseWriter) WriteHeader(code int) {
 	rw.wroteHeader = true
 }
 
+// Unwrap returns the underlying response writer.
+// It enables http.ResponseController to access optional interfaces like http.Flusher on the underlying writer.
+func (rw *wrappedResponseWriter) Unwrap() http.ResponseWriter {
+	return rw.ResponseWriter
+}
+
 // logFieldsContextKey is used to set and get request log fields in the context.
 type logFieldsContextKey struct{}
 
@@ -6,6 +6,7 @@ import (
 	"math/big"
 	"net"
 	"reflect"
+	"strconv"
 	"time"
 	"unicode/utf8"
 
@@ -117,6 +118,14 @@ func ToValue(v any, t *runtimev1.Type) (*structpb.Value, error) {
 		}
 	case net.IP:
 		return structpb.NewStringValue(v.String()), nil
+	case string:
+		// Some drivers (like Postgres) return decimals as strings.
+		// Evil cast to float until frontend can deal with bigs.
+		if t != nil && t.Code == runtimev1.Type_CODE_DECIMAL {
+			if f, err := strconv.ParseFloat(v, 64); err == nil {
+				return structpb.NewNumberValue(f), nil
+			}
+		}
 	// pointers to base types
 	case *bool:
 		return structpb.NewBoolValue(*v), nil
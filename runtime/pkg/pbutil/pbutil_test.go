@@ -105,3 +105,20 @@ func intPtr(v uint8) *uint8 {
 func listPtr(v *[]int16) **[]int16 {
 	return &v
 }
+
+func TestToValueDecimalString(t *testing.T) {
+	decimal := &runtimev1.Type{Code: runtimev1.Type_CODE_DECIMAL}
+	str := &runtimev1.Type{Code: runtimev1.Type_CODE_STRING}
+
+	v, err := ToValue("123.45", decimal)
+	require.NoError(t, err)
+	require.Equal(t, 123.45, v.GetNumberValue())
+
+	v, err = ToValue("123.45", str)
+	require.NoError(t, err)
+	require.Equal(t, "123.45", v.GetStringValue())
+
+	v, err = ToValue("NaN-ish", decimal)
+	require.NoError(t, err)
+	require.Equal(t, "NaN-ish", v.GetStringValue())
+}
@@ -0,0 +1,109 @@
+package querycache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dgraph-io/ristretto"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+)
+
+var (
+	meter                = otel.Meter("github.com/rilldata/rill/runtime/pkg/querycache")
+	memoryItemCountGauge = observability.Must(meter.Int64ObservableGauge("query_cache.items"))
+	memorySizeBytesGauge = observability.Must(meter.Int64ObservableGauge("query_cache.size", metric.WithUnit("bytes")))
+)
+
+// ErrNotEncodable is returned by a Codec for values that it can't encode.
+// Caches that store encoded values silently skip such values.
+var ErrNotEncodable = errors.New("querycache: value can't be encoded")
+
+// Cache stores query results by key.
+// Keys are expected to change when the underlying data changes, so entries are never explicitly invalidated.
+type Cache interface {
+	// Get returns the cached value for a key. It returns false if the key is not in the cache.
+	Get(ctx context.Context, key string) (any, bool, error)
+	// Set stores a value in the cache. The size is the estimated size of the value in bytes.
+	// The cache may drop the value, for example if it's too large.
+	Set(ctx context.Context, key string, val any, size int64) error
+	// Name is a short identifier for the cache backend, used in metrics.
+	Name() string
+	// Close releases the cache's resources.
+	Close() error
+}
+
+// Codec encodes values for caches that store them outside of the process.
+type Codec interface {
+	// Encode serializes a value. It returns ErrNotEncodable if the value's type is not supported.
+	Encode(val any) ([]byte, error)
+	// Decode deserializes a value previously serialized with Encode.
+	Decode(data []byte) (any, error)
+}
+
+// Memory is an in-process cache that stores values without encoding them.
+type Memory struct {
+	cache   *ristretto.Cache
+	ttl     time.Duration
+	metrics metric.Registration
+}
+
+var _ Cache = (*Memory)(nil)
+
+// NewMemory creates an in-process cache holding at most sizeInBytes of values.
+// If ttl is zero, entries only expire when evicted to make room for new entries.
+func NewMemory(sizeInBytes int64, ttl time.Duration) (*Memory, error) {
+	if sizeInBytes <= 100 {
+		return nil, fmt.Errorf("invalid cache size should be greater than 100: %v", sizeInBytes)
+	}
+	cache, err := ristretto.NewCache(&ristretto.Config{
+		// Use 5% of cache memory for storing counters. Each counter takes roughly 3 bytes.
+		// Recommended value is 10x the number of items in cache when full.
+		// Tune this again based on metrics.
+		NumCounters: int64(float64(sizeInBytes) * 0.05 / 3),
+		MaxCost:     int64(float64(sizeInBytes) * 0.95),
+		BufferItems: 64,
+		Metrics:     true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	metrics, err := meter.RegisterCallback(func(ctx context.Context, observer metric.Observer) error {
+		observer.ObserveInt64(memoryItemCountGauge, int64(cache.Metrics.KeysAdded()-cache.Metrics.KeysEvicted()))
+		observer.ObserveInt64(memorySizeBytesGauge, int64(cache.Metrics.CostAdded()-cache.Metrics.CostEvicted()))
+		return nil
+	}, memoryItemCountGauge, memorySizeBytesGauge)
+	if err != nil {
+		cache.Close()
+		return nil, err
+	}
+
+	return &Memory{
+		cache:   cache,
+		ttl:     ttl,
+		metrics: metrics,
+	}, nil
+}
+
+func (m *Memory) Get(ctx context.Context, key string) (any, bool, error) {
+	val, ok := m.cache.Get(key)
+	return val, ok, nil
+}
+
+func (m *Memory) Set(ctx context.Context, key string, val any, size int64) error {
+	m.cache.SetWithTTL(key, val, size, m.ttl)
+	return nil
+}
+
+func (m *Memory) Name() string {
+	return "memory"
+}
+
+func (m *Memory) Close() error {
+	m.cache.Close()
+	return m.metrics.Unregister()
+}
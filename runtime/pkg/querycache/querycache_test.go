@@ -0,0 +1,98 @@
+package querycache
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemory(t *testing.T) {
+	ctx := context.Background()
+	c, err := NewMemory(1024*1024, 0)
+	require.NoError(t, err)
+	defer c.Close()
+
+	_, ok, err := c.Get(ctx, "foo")
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	require.NoError(t, c.Set(ctx, "foo", []int{1, 2, 3}, 24))
+	c.cache.Wait()
+
+	val, ok, err := c.Get(ctx, "foo")
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, []int{1, 2, 3}, val)
+
+	_, err = NewMemory(100, 0)
+	require.Error(t, err)
+}
+
+func TestRedis(t *testing.T) {
+	mr, err := miniredis.Run()
+	require.NoError(t, err)
+	defer mr.Close()
+
+	opts, err := redis.ParseURL("redis://" + mr.Addr())
+	require.NoError(t, err)
+	c := NewRedis(redis.NewClient(opts), intCodec{}, &RedisOptions{
+		KeyPrefix:         "test:",
+		TTL:               time.Minute,
+		MaxEntrySizeBytes: 4,
+	})
+	defer c.Close()
+
+	ctx := context.Background()
+	_, ok, err := c.Get(ctx, "foo")
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// Values are encoded with the codec and stored with a TTL
+	require.NoError(t, c.Set(ctx, "foo", 42, 8))
+	val, ok, err := c.Get(ctx, "foo")
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, 42, val)
+	keys := mr.Keys()
+	require.Len(t, keys, 1)
+	require.Regexp(t, "^test:[0-9a-f]{64}$", keys[0])
+	require.Equal(t, time.Minute, mr.TTL(keys[0]))
+
+	// Another cache using the same Redis database shares the entries
+	c2 := NewRedis(redis.NewClient(opts), intCodec{}, &RedisOptions{KeyPrefix: "test:"})
+	defer c2.Close()
+	val, ok, err = c2.Get(ctx, "foo")
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, 42, val)
+
+	// Values that can't be encoded or are too large are skipped
+	require.NoError(t, c.Set(ctx, "bar", "not an int", 8))
+	require.NoError(t, c.Set(ctx, "baz", 123456, 8))
+	require.Len(t, mr.Keys(), 1)
+
+	// Entries expire
+	mr.FastForward(2 * time.Minute)
+	_, ok, err = c.Get(ctx, "foo")
+	require.NoError(t, err)
+	require.False(t, ok)
+}
+
+type intCodec struct{}
+
+func (intCodec) Encode(val any) ([]byte, error) {
+	i, ok := val.(int)
+	if !ok {
+		return nil, ErrNotEncodable
+	}
+	return []byte(strconv.Itoa(i)), nil
+}
+
+func (intCodec) Decode(data []byte) (any, error) {
+	return strconv.Atoi(string(data))
+}
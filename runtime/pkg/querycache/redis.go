@@ -0,0 +1,89 @@
+package querycache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// RedisOptions configures a Redis cache.
+type RedisOptions struct {
+	// KeyPrefix is prepended to all keys. It can be used to share a Redis database with other services.
+	KeyPrefix string
+	// TTL is the expiration time of entries. If zero, entries only expire when evicted by Redis' maxmemory policy.
+	TTL time.Duration
+	// MaxEntrySizeBytes is the maximum size of an encoded entry. Larger values are not cached. If zero, there is no limit.
+	MaxEntrySizeBytes int64
+}
+
+// Redis is a cache that stores encoded values in Redis or any server that implements the Redis protocol.
+// Since the data lives outside the process, it is shared by all runtime replicas that use the same Redis database and survives restarts.
+type Redis struct {
+	client redis.UniversalClient
+	codec  Codec
+	opts   *RedisOptions
+}
+
+var _ Cache = (*Redis)(nil)
+
+// NewRedis creates a cache backed by the given Redis client. The cache takes ownership of the client.
+func NewRedis(client redis.UniversalClient, codec Codec, opts *RedisOptions) *Redis {
+	if opts == nil {
+		opts = &RedisOptions{}
+	}
+	return &Redis{
+		client: client,
+		codec:  codec,
+		opts:   opts,
+	}
+}
+
+func (r *Redis) Get(ctx context.Context, key string) (any, bool, error) {
+	data, err := r.client.Get(ctx, r.redisKey(key)).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+
+	val, err := r.codec.Decode(data)
+	if err != nil {
+		return nil, false, err
+	}
+	return val, true, nil
+}
+
+func (r *Redis) Set(ctx context.Context, key string, val any, size int64) error {
+	data, err := r.codec.Encode(val)
+	if err != nil {
+		if errors.Is(err, ErrNotEncodable) {
+			return nil
+		}
+		return err
+	}
+
+	if r.opts.MaxEntrySizeBytes > 0 && int64(len(data)) > r.opts.MaxEntrySizeBytes {
+		return nil
+	}
+
+	return r.client.Set(ctx, r.redisKey(key), data, r.opts.TTL).Err()
+}
+
+func (r *Redis) Name() string {
+	return "redis"
+}
+
+func (r *Redis) Close() error {
+	return r.client.Close()
+}
+
+// redisKey hashes the key since cache keys can be very large.
+func (r *Redis) redisKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return r.opts.KeyPrefix + hex.EncodeToString(sum[:])
+}
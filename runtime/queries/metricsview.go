@@ -1,8 +1,10 @@
 package queries
 
 import (
+	"bufio"
 	"context"
 	"encoding/csv"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -13,6 +15,7 @@ import (
 
 	"github.com/apache/arrow/go/v14/arrow"
 	"github.com/apache/arrow/go/v14/arrow/array"
+	"github.com/apache/arrow/go/v14/arrow/ipc"
 	"github.com/apache/arrow/go/v14/arrow/memory"
 	"github.com/apache/arrow/go/v14/parquet/pqarrow"
 	"github.com/google/uuid"
@@ -146,6 +149,9 @@ func resolveMeasures(mv *runtimev1.MetricsViewSpec, inlines []*runtimev1.InlineM
 		if !found {
 			return nil, fmt.Errorf("measure does not exist: '%s'", n)
 		}
+		if drivers.IsMetricsViewWindowMeasure(ms[i]) {
+			return nil, fmt.Errorf("measure '%s' is a window measure, which is only supported in aggregation queries", n)
+		}
 	}
 
 	return ms, nil
@@ -373,7 +379,10 @@ func (builder *ExpressionBuilder) buildLikeExpression(cond *runtimev1.Condition)
 
 	var clause string
 	// Build [NOT] len(list_filter("dim", x -> x ILIKE ?)) > 0
-	if unnest && builder.dialect != drivers.DialectDruid && builder.dialect != drivers.DialectPinot {
+	if unnest && builder.dialect == drivers.DialectPostgres {
+		// Build [NOT] EXISTS (SELECT 1 FROM unnest("dim") x WHERE x ILIKE ?)
+		clause = fmt.Sprintf("%s EXISTS (SELECT 1 FROM unnest(%s) AS u(x) WHERE x ILIKE %s)", notKeyword, leftExpr, rightExpr)
+	} else if unnest && builder.dialect != drivers.DialectDruid && builder.dialect != drivers.DialectPinot {
 		clause = fmt.Sprintf("%s len(list_filter((%s), x -> x ILIKE %s)) > 0", notKeyword, leftExpr, rightExpr)
 	} else {
 		if builder.dialect == drivers.DialectDruid || builder.dialect == drivers.DialectPinot {
@@ -437,7 +446,10 @@ func (builder *ExpressionBuilder) buildInExpression(cond *runtimev1.Condition) (
 		questionMarks := strings.Join(valClauses, ",")
 		var clause string
 		// Build [NOT] list_has_any("dim", ARRAY[?, ?, ...])
-		if unnest && builder.dialect != drivers.DialectDruid {
+		if unnest && builder.dialect == drivers.DialectPostgres {
+			// Build [NOT] ("dim" && ARRAY[?, ?, ...])
+			clause = fmt.Sprintf("%s ((%s) && ARRAY[%s])", notKeyword, leftExpr, questionMarks)
+		} else if unnest && builder.dialect != drivers.DialectDruid {
 			clause = fmt.Sprintf("%s list_has_any((%s), ARRAY[%s])", notKeyword, leftExpr, questionMarks)
 		} else {
 			clause = fmt.Sprintf("(%s) %s IN (%s)", leftExpr, notKeyword, questionMarks)
@@ -625,13 +637,24 @@ func metricsViewDimension(mv *runtimev1.MetricsViewSpec, dimName string) (*runti
 	return nil, fmt.Errorf("dimension %s not found", dimName)
 }
 
-func metricsViewMeasureExpression(mv *runtimev1.MetricsViewSpec, measureName string) (string, error) {
+func metricsViewMeasure(mv *runtimev1.MetricsViewSpec, measureName string) (*runtimev1.MetricsViewSpec_MeasureV2, error) {
 	for _, measure := range mv.Measures {
 		if strings.EqualFold(measure.Name, measureName) {
-			return measure.Expression, nil
+			return measure, nil
 		}
 	}
-	return "", fmt.Errorf("measure %s not found", measureName)
+	return nil, fmt.Errorf("measure %s not found", measureName)
+}
+
+func metricsViewMeasureExpression(mv *runtimev1.MetricsViewSpec, measureName string) (string, error) {
+	measure, err := metricsViewMeasure(mv, measureName)
+	if err != nil {
+		return "", err
+	}
+	if drivers.IsMetricsViewWindowMeasure(measure) {
+		return "", fmt.Errorf("measure %s is a window measure, which is only supported in aggregation queries", measureName)
+	}
+	return measure.Expression, nil
 }
 
 func WriteCSV(meta []*runtimev1.MetricsViewColumn, data []*structpb.Struct, writer io.Writer) error {
@@ -722,7 +745,93 @@ func WriteXLSX(meta []*runtimev1.MetricsViewColumn, data []*structpb.Struct, wri
 	return err
 }
 
+func WriteJSONL(meta []*runtimev1.MetricsViewColumn, data []*structpb.Struct, writer io.Writer) error {
+	w := bufio.NewWriter(writer)
+	for _, structs := range data {
+		err := w.WriteByte('{')
+		if err != nil {
+			return err
+		}
+		for i, field := range meta {
+			if i > 0 {
+				err = w.WriteByte(',')
+				if err != nil {
+					return err
+				}
+			}
+
+			key, err := json.Marshal(field.Name)
+			if err != nil {
+				return err
+			}
+			var val any
+			if pbvalue := structs.Fields[field.Name]; pbvalue != nil {
+				val = pbvalue.AsInterface()
+			}
+			value, err := json.Marshal(val)
+			if err != nil {
+				return err
+			}
+
+			_, err = w.Write(key)
+			if err != nil {
+				return err
+			}
+			err = w.WriteByte(':')
+			if err != nil {
+				return err
+			}
+			_, err = w.Write(value)
+			if err != nil {
+				return err
+			}
+		}
+		_, err = w.WriteString("}\n")
+		if err != nil {
+			return err
+		}
+	}
+	return w.Flush()
+}
+
 func WriteParquet(meta []*runtimev1.MetricsViewColumn, data []*structpb.Struct, ioWriter io.Writer) error {
+	rec, err := buildArrowRecord(meta, data)
+	if err != nil {
+		return err
+	}
+	defer rec.Release()
+
+	parquetwriter, err := pqarrow.NewFileWriter(rec.Schema(), ioWriter, nil, pqarrow.ArrowWriterProperties{})
+	if err != nil {
+		return err
+	}
+
+	defer parquetwriter.Close()
+
+	err = parquetwriter.Write(rec)
+	return err
+}
+
+// WriteArrow writes the data in the Arrow IPC streaming format.
+func WriteArrow(meta []*runtimev1.MetricsViewColumn, data []*structpb.Struct, ioWriter io.Writer) error {
+	rec, err := buildArrowRecord(meta, data)
+	if err != nil {
+		return err
+	}
+	defer rec.Release()
+
+	w := ipc.NewWriter(ioWriter, ipc.WithSchema(rec.Schema()))
+	err = w.Write(rec)
+	if err != nil {
+		_ = w.Close()
+		return err
+	}
+	return w.Close()
+}
+
+// buildArrowRecord converts the data to a single Arrow record.
+// The caller is responsible for releasing the record.
+func buildArrowRecord(meta []*runtimev1.MetricsViewColumn, data []*structpb.Struct) (arrow.Record, error) {
 	fields := make([]arrow.Field, 0, len(meta))
 	for _, f := range meta {
 		arrowField := arrow.Field{}
@@ -803,14 +912,14 @@ func WriteParquet(meta []*runtimev1.MetricsViewColumn, data []*structpb.Struct,
 			case runtimev1.Type_CODE_TIMESTAMP, runtimev1.Type_CODE_DATE, runtimev1.Type_CODE_TIME:
 				tmp, err := arrow.TimestampFromString(v.GetStringValue(), arrow.Microsecond)
 				if err != nil {
-					return err
+					return nil, err
 				}
 
 				recordBuilder.Field(idx).(*array.TimestampBuilder).Append(tmp)
 			case runtimev1.Type_CODE_ARRAY, runtimev1.Type_CODE_MAP, runtimev1.Type_CODE_STRUCT:
 				bts, err := protojson.Marshal(v)
 				if err != nil {
-					return err
+					return nil, err
 				}
 
 				recordBuilder.Field(idx).(*array.StringBuilder).Append(string(bts))
@@ -818,16 +927,7 @@ func WriteParquet(meta []*runtimev1.MetricsViewColumn, data []*structpb.Struct,
 		}
 	}
 
-	parquetwriter, err := pqarrow.NewFileWriter(schema, ioWriter, nil, pqarrow.ArrowWriterProperties{})
-	if err != nil {
-		return err
-	}
-
-	defer parquetwriter.Close()
-
-	rec := recordBuilder.NewRecord()
-	err = parquetwriter.Write(rec)
-	return err
+	return recordBuilder.NewRecord(), nil
 }
 
 func DuckDBCopyExport(ctx context.Context, w io.Writer, opts *runtime.ExportOptions, sql string, args []any, filename string, olap drivers.OLAPStore, exportFormat runtimev1.ExportFormat) error {
@@ -837,6 +937,8 @@ func DuckDBCopyExport(ctx context.Context, w io.Writer, opts *runtime.ExportOpti
 		extension = "parquet"
 	case runtimev1.ExportFormat_EXPORT_FORMAT_CSV:
 		extension = "csv"
+	case runtimev1.ExportFormat_EXPORT_FORMAT_JSONL:
+		extension = "jsonl"
 	}
 
 	tmpPath := fmt.Sprintf("export_%s.%s", uuid.New().String(), extension)
@@ -844,8 +946,11 @@ func DuckDBCopyExport(ctx context.Context, w io.Writer, opts *runtime.ExportOpti
 	defer os.Remove(tmpPath)
 
 	sql = fmt.Sprintf("COPY (%s) TO '%s'", sql, tmpPath)
-	if extension == "csv" {
+	switch extension {
+	case "csv":
 		sql += " (FORMAT CSV, HEADER)"
+	case "jsonl":
+		sql += " (FORMAT JSON)"
 	}
 
 	rows, err := olap.Execute(ctx, &drivers.Statement{
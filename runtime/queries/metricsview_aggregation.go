@@ -90,7 +90,7 @@ func (q *MetricsViewAggregation) Resolve(ctx context.Context, rt *runtime.Runtim
 	}
 	defer release()
 
-	if olap.Dialect() != drivers.DialectDuckDB && olap.Dialect() != drivers.DialectDruid && olap.Dialect() != drivers.DialectClickHouse && olap.Dialect() != drivers.DialectPinot {
+	if olap.Dialect() != drivers.DialectDuckDB && olap.Dialect() != drivers.DialectDruid && olap.Dialect() != drivers.DialectClickHouse && olap.Dialect() != drivers.DialectPinot && olap.Dialect() != drivers.DialectPostgres {
 		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
 	}
 
@@ -489,6 +489,10 @@ func (q *MetricsViewAggregation) Export(ctx context.Context, rt *runtime.Runtime
 		return WriteXLSX(meta, q.Result.Data, w)
 	case runtimev1.ExportFormat_EXPORT_FORMAT_PARQUET:
 		return WriteParquet(meta, q.Result.Data, w)
+	case runtimev1.ExportFormat_EXPORT_FORMAT_JSONL:
+		return WriteJSONL(meta, q.Result.Data, w)
+	case runtimev1.ExportFormat_EXPORT_FORMAT_ARROW:
+		return WriteArrow(meta, q.Result.Data, w)
 	}
 
 	return nil
@@ -555,10 +559,40 @@ func (q *MetricsViewAggregation) buildMetricsAggregationSQL(mv *runtimev1.Metric
 		selectArgs = append(selectArgs, exprArgs...)
 	}
 
+	// Window measures are computed in an outer query over the measures they reference
+	var windowMeasures []*runtimev1.MetricsViewSpec_MeasureV2
+	selectedMeasures := make(map[string]bool, len(q.Measures))
+	for _, m := range q.Measures {
+		if m.BuiltinMeasure != runtimev1.BuiltinMeasure_BUILTIN_MEASURE_UNSPECIFIED {
+			continue
+		}
+		measure, err := metricsViewMeasure(mv, m.Name)
+		if err != nil {
+			return "", nil, err
+		}
+		if drivers.IsMetricsViewWindowMeasure(measure) {
+			windowMeasures = append(windowMeasures, measure)
+		} else {
+			selectedMeasures[m.Name] = true
+		}
+	}
+	if len(windowMeasures) > 0 {
+		if filterCount > 0 {
+			return "", nil, errors.New("window measures cannot be combined with measure filters")
+		}
+		if len(q.PivotOn) > 0 {
+			return "", nil, errors.New("window measures are not supported for pivot queries")
+		}
+	}
+
 	for _, m := range q.Measures {
 		sn := safeName(m.Name)
 		switch m.BuiltinMeasure {
 		case runtimev1.BuiltinMeasure_BUILTIN_MEASURE_UNSPECIFIED:
+			if !selectedMeasures[m.Name] {
+				continue
+			}
+
 			expr, err := metricsViewMeasureExpression(mv, m.Name)
 			if err != nil {
 				return "", nil, err
@@ -581,6 +615,20 @@ func (q *MetricsViewAggregation) buildMetricsAggregationSQL(mv *runtimev1.Metric
 		}
 	}
 
+	// Select the measures referenced by window measures that were not requested directly
+	for _, m := range windowMeasures {
+		base := m.ReferencedMeasures[0]
+		if selectedMeasures[base] {
+			continue
+		}
+		expr, err := metricsViewMeasureExpression(mv, base)
+		if err != nil {
+			return "", nil, err
+		}
+		selectCols = append(selectCols, fmt.Sprintf("%s as %s", expr, safeName(base)))
+		selectedMeasures[base] = true
+	}
+
 	groupClause := ""
 	if len(groupCols) > 0 {
 		groupClause = "GROUP BY " + strings.Join(groupCols, ", ")
@@ -654,7 +702,7 @@ func (q *MetricsViewAggregation) buildMetricsAggregationSQL(mv *runtimev1.Metric
 		if s.Desc {
 			sortCriterion += " DESC"
 		}
-		if dialect == drivers.DialectDuckDB {
+		if dialect == drivers.DialectDuckDB || dialect == drivers.DialectPostgres {
 			sortCriterion += " NULLS LAST"
 		}
 		sortingCriteria = append(sortingCriteria, sortCriterion)
@@ -720,6 +768,9 @@ func (q *MetricsViewAggregation) buildMetricsAggregationSQL(mv *runtimev1.Metric
 			This JOIN mirrors functionality of SELECT d1, d2, d3, m1 FILTER (WHERE d4 = 'Safari') FROM t WHERE... GROUP BY d1, d2, d3
 			bacause FILTER cannot be applied for arbitrary measure, ie sum(a)/1000
 		*/
+		if len(windowMeasures) > 0 {
+			return q.buildWindowMeasuresSQL(mv, windowMeasures, selectCols, unnestClauses, whereClause, groupClause, orderClause, limitClause, selectArgs, whereArgs, extraWhereClause, extraWhereClauseArgs, dialect)
+		}
 		if filterCount == 1 {
 			return q.buildMeasureFilterSQL(mv, unnestClauses, selectCols, limitClause, orderClause, havingClause, whereClause, groupClause, args, selectArgs, whereArgs, havingClauseArgs, extraWhereClause, extraWhereClauseArgs, dialect)
 		}
@@ -844,6 +895,130 @@ func (q *MetricsViewAggregation) buildMeasureFilterSQL(mv *runtimev1.MetricsView
 	return sql, args, nil
 }
 
+// buildWindowMeasuresSQL builds a query for window measures (such as running totals).
+// The window functions are applied in an outer query over the aggregated dimensions and measures, ordered by the time dimension.
+func (q *MetricsViewAggregation) buildWindowMeasuresSQL(mv *runtimev1.MetricsViewSpec, windowMeasures []*runtimev1.MetricsViewSpec_MeasureV2, selectCols, unnestClauses []string, whereClause, groupClause, orderClause, limitClause string, selectArgs, whereArgs []any, extraWhereClause string, extraWhereClauseArgs []any, dialect drivers.Dialect) (string, []any, error) {
+	// Find the time dimension to order by. The remaining dimensions partition the windows.
+	var timeCol string
+	var dimCols []string
+	for _, d := range q.Dimensions {
+		name := d.Name
+		if d.TimeGrain != runtimev1.TimeGrain_TIME_GRAIN_UNSPECIFIED && d.Alias != "" {
+			name = d.Alias
+		}
+		if timeCol == "" && d.TimeGrain != runtimev1.TimeGrain_TIME_GRAIN_UNSPECIFIED && d.Name == mv.TimeDimension {
+			timeCol = safeName(name)
+			continue
+		}
+		dimCols = append(dimCols, safeName(name))
+	}
+
+	outerCols := make([]string, 0, q.cols())
+	for _, d := range q.Dimensions {
+		name := d.Name
+		if d.TimeGrain != runtimev1.TimeGrain_TIME_GRAIN_UNSPECIFIED && d.Alias != "" {
+			name = d.Alias
+		}
+		outerCols = append(outerCols, safeName(name))
+	}
+	for _, m := range q.Measures {
+		var window *runtimev1.MetricsViewSpec_MeasureV2
+		for _, wm := range windowMeasures {
+			if wm.Name == m.Name {
+				window = wm
+				break
+			}
+		}
+		if window == nil {
+			outerCols = append(outerCols, safeName(m.Name))
+			continue
+		}
+
+		var partitionBy []string
+		var orderBy string
+		if window.Type == runtimev1.MetricsViewSpec_MEASURE_TYPE_SHARE_OF_PARENT {
+			if timeCol != "" {
+				partitionBy = []string{timeCol}
+			}
+		} else {
+			partitionBy = dimCols
+			orderBy = timeCol
+		}
+		expr, err := dialect.MetricsViewWindowMeasureExpression(window, safeName(window.ReferencedMeasures[0]), partitionBy, orderBy)
+		if err != nil {
+			return "", nil, err
+		}
+		outerCols = append(outerCols, fmt.Sprintf("%s as %s", expr, safeName(m.Name)))
+	}
+
+	if extraWhereClause != "" {
+		extraWhereClause = "WHERE " + extraWhereClause
+	}
+
+	// Apache Druid only applies window functions to GROUP BY queries.
+	// Grouping by all the inner columns does not change the result since they are already unique.
+	druidGroupBy := ""
+	if dialect == drivers.DialectDruid {
+		innerCols := make([]string, 0, len(selectCols))
+		if timeCol != "" {
+			innerCols = append(innerCols, timeCol)
+		}
+		innerCols = append(innerCols, dimCols...)
+		seen := make(map[string]bool)
+		for _, m := range q.Measures {
+			name := m.Name
+			for _, wm := range windowMeasures {
+				if wm.Name == m.Name {
+					name = wm.ReferencedMeasures[0]
+					break
+				}
+			}
+			if !seen[name] {
+				seen[name] = true
+				innerCols = append(innerCols, safeName(name))
+			}
+		}
+		druidGroupBy = "GROUP BY " + strings.Join(innerCols, ", ")
+	}
+
+	/*
+		SQL example:
+		SELECT * FROM (
+			SELECT "ts", "pub", SUM("revenue") OVER (PARTITION BY "pub" ORDER BY "ts" ROWS BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW) as "revenue_running" FROM (
+				SELECT date_trunc('DAY', "timestamp") as "ts", ("publisher") as "pub", SUM(bid_price) as "revenue" FROM "ad_bids" WHERE 1=1 GROUP BY 1, 2
+			) window_base
+		) window_outer
+		WHERE "revenue_running" > 10 -- the having clause is applied after the window measures are evaluated
+		ORDER BY "ts" NULLS LAST
+		OFFSET 0
+	*/
+	sql := fmt.Sprintf("SELECT %[1]s FROM (SELECT %[2]s FROM %[3]s %[4]s %[5]s %[6]s) %[7]s %[8]s",
+		strings.Join(outerCols, ", "),       // 1
+		strings.Join(selectCols, ", "),      // 2
+		escapeMetricsViewTable(dialect, mv), // 3
+		strings.Join(unnestClauses, ""),     // 4
+		whereClause,                         // 5
+		groupClause,                         // 6
+		tempName("window_base"),             // 7
+		druidGroupBy,                        // 8
+	)
+	sql = fmt.Sprintf("SELECT * FROM (%[1]s) %[2]s %[3]s %[4]s %[5]s OFFSET %[6]d",
+		sql,                      // 1
+		tempName("window_outer"), // 2
+		extraWhereClause,         // 3
+		orderClause,              // 4
+		limitClause,              // 5
+		q.Offset,                 // 6
+	)
+
+	var args []any
+	args = append(args, selectArgs...)
+	args = append(args, whereArgs...)
+	args = append(args, extraWhereClauseArgs...)
+
+	return sql, args, nil
+}
+
 func (q *MetricsViewAggregation) buildTimestampExpr(mv *runtimev1.MetricsViewSpec, dim *runtimev1.MetricsViewAggregationDimension, dialect drivers.Dialect) (string, []any, error) {
 	var col string
 	if dim.Name == mv.TimeDimension {
@@ -882,6 +1057,12 @@ func (q *MetricsViewAggregation) buildTimestampExpr(mv *runtimev1.MetricsViewSpe
 	case drivers.DialectPinot:
 		// ToDateTime format truncates millis to secs because we don't support that, for example timeseries api does timestamppb.New(ts) which truncates to seconds
 		return fmt.Sprintf("ToDateTime(date_trunc('%s', %s, 'MILLISECONDS', ?), 'yyyy-MM-dd''T''HH:mm:ss''Z''')", dialect.ConvertToDateTruncSpecifier(dim.TimeGrain), col), []any{dim.TimeZone}, nil
+	case drivers.DialectPostgres:
+		// The Postgres driver sets the session time zone to UTC, so TIMESTAMP columns are interpreted as UTC when cast to TIMESTAMPTZ
+		if dim.TimeZone == "" || dim.TimeZone == "UTC" || dim.TimeZone == "Etc/UTC" {
+			return fmt.Sprintf("date_trunc('%s', %s::TIMESTAMPTZ)", dialect.ConvertToDateTruncSpecifier(dim.TimeGrain), col), nil, nil
+		}
+		return fmt.Sprintf("timezone(?::TEXT, date_trunc('%s', timezone(?::TEXT, %s::TIMESTAMPTZ)))", dialect.ConvertToDateTruncSpecifier(dim.TimeGrain), col), []any{dim.TimeZone, dim.TimeZone}, nil
 	default:
 		return "", nil, fmt.Errorf("unsupported dialect %q", dialect)
 	}
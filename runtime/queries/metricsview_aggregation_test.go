@@ -2196,6 +2196,136 @@ measures:
 	require.Equal(t, "a,null,1", fieldsToString(q.Result.Data[0], "a", "b", "count"))
 }
 
+func TestMetricsViewsAggregation_window_measures(t *testing.T) {
+	rt, instanceID := testruntime.NewInstanceWithOptions(t, testruntime.InstanceOptions{
+		Files: map[string]string{
+			"rill.yaml": "",
+			"sales.sql": `
+SELECT ts, pub, revenue::DOUBLE AS revenue, qty FROM (VALUES
+	('2024-01-01'::TIMESTAMP, 'a', 10.0, 1),
+	('2024-01-01'::TIMESTAMP, 'b', 30.0, 3),
+	('2024-01-02'::TIMESTAMP, 'a', 20.0, 2),
+	('2024-01-03'::TIMESTAMP, 'a', 30.0, 3),
+	('2024-01-03'::TIMESTAMP, 'b', 10.0, 1),
+) t(ts, pub, revenue, qty)
+`,
+			"sales_metrics.yaml": `
+type: metrics_view
+model: sales
+timeseries: ts
+dimensions:
+  - name: pub
+    column: pub
+measures:
+  - name: revenue
+    expression: SUM(revenue)
+  - name: qty
+    expression: SUM(qty)
+  - name: price
+    type: derived
+    expression: revenue / qty
+  - name: revenue_running
+    type: running_total
+    measure: revenue
+  - name: revenue_2d
+    type: moving_average
+    measure: revenue
+    window: 2
+  - name: revenue_share
+    type: share_of_parent
+    measure: revenue
+`,
+		},
+	})
+
+	q := &queries.MetricsViewAggregation{
+		MetricsViewName: "sales_metrics",
+		Dimensions: []*runtimev1.MetricsViewAggregationDimension{
+			{Name: "pub"},
+			{Name: "ts", TimeGrain: runtimev1.TimeGrain_TIME_GRAIN_DAY},
+		},
+		Measures: []*runtimev1.MetricsViewAggregationMeasure{
+			{Name: "price"},
+			{Name: "revenue_running"},
+			{Name: "revenue_2d"},
+			{Name: "revenue_share"},
+		},
+		Sort: []*runtimev1.MetricsViewAggregationSort{
+			{Name: "pub"},
+			{Name: "ts"},
+		},
+	}
+	err := q.Resolve(context.Background(), rt, instanceID, 0)
+	require.NoError(t, err)
+	rows := q.Result.Data
+	require.Len(t, rows, 5)
+	require.Len(t, rows[0].Fields, 6)
+
+	fields := []string{"pub", "ts", "price", "revenue_running", "revenue_2d"}
+	require.Equal(t, "a,2024-01-01T00:00:00Z,10,10,10", fieldsToString(rows[0], fields...))
+	require.Equal(t, "a,2024-01-02T00:00:00Z,10,30,15", fieldsToString(rows[1], fields...))
+	require.Equal(t, "a,2024-01-03T00:00:00Z,10,60,25", fieldsToString(rows[2], fields...))
+	require.Equal(t, "b,2024-01-01T00:00:00Z,10,30,30", fieldsToString(rows[3], fields...))
+	require.Equal(t, "b,2024-01-03T00:00:00Z,10,40,20", fieldsToString(rows[4], fields...))
+
+	require.Equal(t, 0.25, rows[0].Fields["revenue_share"].GetNumberValue())
+	require.Equal(t, 1.0, rows[1].Fields["revenue_share"].GetNumberValue())
+	require.Equal(t, 0.75, rows[3].Fields["revenue_share"].GetNumberValue())
+
+	// Having filters are applied after the window measures are computed
+	q = &queries.MetricsViewAggregation{
+		MetricsViewName: "sales_metrics",
+		Dimensions: []*runtimev1.MetricsViewAggregationDimension{
+			{Name: "pub"},
+			{Name: "ts", TimeGrain: runtimev1.TimeGrain_TIME_GRAIN_DAY},
+		},
+		Measures: []*runtimev1.MetricsViewAggregationMeasure{
+			{Name: "revenue_running"},
+		},
+		Sort: []*runtimev1.MetricsViewAggregationSort{
+			{Name: "pub"},
+			{Name: "ts"},
+		},
+		Having: &runtimev1.Expression{
+			Expression: &runtimev1.Expression_Cond{
+				Cond: &runtimev1.Condition{
+					Op: runtimev1.Operation_OPERATION_GT,
+					Exprs: []*runtimev1.Expression{
+						{
+							Expression: &runtimev1.Expression_Ident{
+								Ident: "revenue_running",
+							},
+						},
+						{
+							Expression: &runtimev1.Expression_Val{
+								Val: structpb.NewNumberValue(20),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	err = q.Resolve(context.Background(), rt, instanceID, 0)
+	require.NoError(t, err)
+	rows = q.Result.Data
+	require.Len(t, rows, 4)
+	fields = []string{"pub", "ts", "revenue_running"}
+	require.Equal(t, "a,2024-01-02T00:00:00Z,30", fieldsToString(rows[0], fields...))
+	require.Equal(t, "a,2024-01-03T00:00:00Z,60", fieldsToString(rows[1], fields...))
+	require.Equal(t, "b,2024-01-01T00:00:00Z,30", fieldsToString(rows[2], fields...))
+	require.Equal(t, "b,2024-01-03T00:00:00Z,40", fieldsToString(rows[3], fields...))
+
+	// Running totals require the time dimension
+	q = &queries.MetricsViewAggregation{
+		MetricsViewName: "sales_metrics",
+		Dimensions:      []*runtimev1.MetricsViewAggregationDimension{{Name: "pub"}},
+		Measures:        []*runtimev1.MetricsViewAggregationMeasure{{Name: "revenue_running"}},
+	}
+	err = q.Resolve(context.Background(), rt, instanceID, 0)
+	require.ErrorContains(t, err, "requires the time dimension")
+}
+
 func fieldsToString(row *structpb.Struct, args ...string) string {
 	s := make([]string, 0, len(args))
 	for _, arg := range args {
@@ -20,7 +20,7 @@ import (
 
 type MetricsViewComparison struct {
 	MetricsViewName     string                                         `json:"metrics_view_name,omitempty"`
-	DimensionName       string                                         `json:"dimension_name,omitempty"`
+	DimensionName       string                                         `json:"dimension_name,omitempty"` // Optional. If empty, the query returns a single row for all the data.
 	Measures            []*runtimev1.MetricsViewAggregationMeasure     `json:"measures,omitempty"`
 	ComparisonMeasures  []string                                       `json:"comparison_measures,omitempty"`
 	TimeRange           *runtimev1.TimeRange                           `json:"base_time_range,omitempty"`
@@ -47,6 +47,9 @@ type metricsViewMeasureMeta struct {
 	expand     bool // whether the measure has derived measures like comparison, delta etc
 }
 
+// unsplitDimensionName is the name of the constant dimension used when the query isn't split by a dimension.
+const unsplitDimensionName = "__unsplit"
+
 var _ runtime.Query = &MetricsViewComparison{}
 
 func (q *MetricsViewComparison) Key() string {
@@ -81,7 +84,7 @@ func (q *MetricsViewComparison) UnmarshalResult(v any) error {
 
 func (q *MetricsViewComparison) Resolve(ctx context.Context, rt *runtime.Runtime, instanceID string, priority int) error {
 	// Resolve metrics view
-	mv, security, err := resolveMVAndSecurityFromAttributes(ctx, rt, instanceID, q.MetricsViewName, q.SecurityAttributes, []*runtimev1.MetricsViewAggregationDimension{{Name: q.DimensionName}}, q.Measures)
+	mv, security, err := resolveMVAndSecurityFromAttributes(ctx, rt, instanceID, q.MetricsViewName, q.SecurityAttributes, q.dimensions(), q.Measures)
 	if err != nil {
 		return err
 	}
@@ -92,7 +95,7 @@ func (q *MetricsViewComparison) Resolve(ctx context.Context, rt *runtime.Runtime
 	}
 	defer release()
 
-	if olap.Dialect() != drivers.DialectDuckDB && olap.Dialect() != drivers.DialectDruid && olap.Dialect() != drivers.DialectClickHouse && olap.Dialect() != drivers.DialectPinot {
+	if olap.Dialect() != drivers.DialectDuckDB && olap.Dialect() != drivers.DialectDruid && olap.Dialect() != drivers.DialectClickHouse && olap.Dialect() != drivers.DialectPinot && olap.Dialect() != drivers.DialectPostgres {
 		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
 	}
 
@@ -118,7 +121,8 @@ func (q *MetricsViewComparison) Resolve(ctx context.Context, rt *runtime.Runtime
 		// execute toplist for base and get dim list
 		// create and add filter and execute comprison toplist
 		// remove strict limits in comp toplist sql
-		if drivers.DialectDruid != olap.Dialect() || q.Exact {
+		// an unsplit query returns a single row, so it is always exact
+		if drivers.DialectDruid != olap.Dialect() || q.Exact || q.DimensionName == "" {
 			return q.executeComparisonToplist(ctx, olap, mv, priority, security)
 		}
 
@@ -126,7 +130,7 @@ func (q *MetricsViewComparison) Resolve(ctx context.Context, rt *runtime.Runtime
 	}
 
 	// general toplist
-	if drivers.DialectDruid != olap.Dialect() || q.Exact {
+	if drivers.DialectDruid != olap.Dialect() || q.Exact || q.DimensionName == "" {
 		return q.executeToplist(ctx, olap, mv, priority, security)
 	}
 
@@ -312,9 +316,12 @@ func (q *MetricsViewComparison) executeToplist(ctx context.Context, olap drivers
 			})
 		}
 
-		dv, err := pbutil.ToValue(values[0], safeFieldType(rows.Schema, 0))
-		if err != nil {
-			return err
+		var dv *structpb.Value
+		if q.DimensionName != "" {
+			dv, err = pbutil.ToValue(values[0], safeFieldType(rows.Schema, 0))
+			if err != nil {
+				return err
+			}
 		}
 
 		data = append(data, &runtimev1.MetricsViewComparisonRow{
@@ -397,9 +404,12 @@ func (q *MetricsViewComparison) executeComparisonToplist(ctx context.Context, ol
 			}
 		}
 
-		dv, err := pbutil.ToValue(values[0], safeFieldType(rows.Schema, 0))
-		if err != nil {
-			return err
+		var dv *structpb.Value
+		if q.DimensionName != "" {
+			dv, err = pbutil.ToValue(values[0], safeFieldType(rows.Schema, 0))
+			if err != nil {
+				return err
+			}
 		}
 
 		data = append(data, &runtimev1.MetricsViewComparisonRow{
@@ -416,7 +426,7 @@ func (q *MetricsViewComparison) executeComparisonToplist(ctx context.Context, ol
 }
 
 func (q *MetricsViewComparison) buildMetricsTopListSQL(mv *runtimev1.MetricsViewSpec, dialect drivers.Dialect, policy *runtime.ResolvedMetricsViewSecurity, export bool) (string, []any, error) {
-	dim, err := metricsViewDimension(mv, q.DimensionName)
+	dim, err := q.dimension(mv)
 	if err != nil {
 		return "", nil, err
 	}
@@ -528,7 +538,7 @@ func (q *MetricsViewComparison) buildMetricsTopListSQL(mv *runtimev1.MetricsView
 			if s.Desc {
 				clause += " DESC"
 			}
-			if dialect == drivers.DialectDuckDB {
+			if dialect == drivers.DialectDuckDB || dialect == drivers.DialectPostgres {
 				clause += " NULLS LAST"
 			}
 			orderClauses = append(orderClauses, clause)
@@ -538,7 +548,7 @@ func (q *MetricsViewComparison) buildMetricsTopListSQL(mv *runtimev1.MetricsView
 		if s.Desc {
 			clause += " DESC"
 		}
-		if dialect == drivers.DialectDuckDB {
+		if dialect == drivers.DialectDuckDB || dialect == drivers.DialectPostgres {
 			clause += " NULLS LAST"
 		}
 		orderClauses = append(orderClauses, clause)
@@ -587,7 +597,7 @@ func (q *MetricsViewComparison) buildMetricsTopListSQL(mv *runtimev1.MetricsView
 }
 
 func (q *MetricsViewComparison) buildMetricsComparisonTopListSQL(mv *runtimev1.MetricsViewSpec, dialect drivers.Dialect, policy *runtime.ResolvedMetricsViewSecurity, export bool) (string, []any, error) {
-	dim, err := metricsViewDimension(mv, q.DimensionName)
+	dim, err := q.dimension(mv)
 	if err != nil {
 		return "", nil, err
 	}
@@ -641,6 +651,12 @@ func (q *MetricsViewComparison) buildMetricsComparisonTopListSQL(mv *runtimev1.M
 		}
 	}
 
+	relDeltaExpr := "CAST((base.%[1]s - comparison.%[1]s) AS DOUBLE)/comparison.%[1]s"
+	if dialect == drivers.DialectPostgres {
+		// Postgres has no DOUBLE type alias and errors on division by zero
+		relDeltaExpr = "CAST((base.%[1]s - comparison.%[1]s) AS DOUBLE PRECISION)/NULLIF(comparison.%[1]s, 0)"
+	}
+
 	var finalSelectCols []string
 	var labelCols []string
 	for _, m := range q.Measures {
@@ -649,14 +665,14 @@ func (q *MetricsViewComparison) buildMetricsComparisonTopListSQL(mv *runtimev1.M
 		if dialect != drivers.DialectDruid {
 			if q.measuresMeta[m.Name].expand {
 				columnsTuple = fmt.Sprintf(
-					"base.%[1]s AS %[1]s, comparison.%[1]s AS %[2]s, base.%[1]s - comparison.%[1]s AS %[3]s, CAST((base.%[1]s - comparison.%[1]s) AS DOUBLE)/comparison.%[1]s AS %[4]s",
+					"base.%[1]s AS %[1]s, comparison.%[1]s AS %[2]s, base.%[1]s - comparison.%[1]s AS %[3]s, "+relDeltaExpr+" AS %[4]s",
 					safeName(m.Name),
 					safeName(m.Name+"__previous"),
 					safeName(m.Name+"__delta_abs"),
 					safeName(m.Name+"__delta_rel"),
 				)
 				labelTuple = fmt.Sprintf(
-					"base.%[1]s AS %[5]s, comparison.%[1]s AS %[2]s, base.%[1]s - comparison.%[1]s AS %[3]s, CAST((base.%[1]s - comparison.%[1]s) AS DOUBLE)/comparison.%[1]s AS %[4]s",
+					"base.%[1]s AS %[5]s, comparison.%[1]s AS %[2]s, base.%[1]s - comparison.%[1]s AS %[3]s, "+relDeltaExpr+" AS %[4]s",
 					safeName(m.Name),
 					safeName(labelMap[m.Name]+" (prev)"),
 					safeName(labelMap[m.Name]+" (Δ)"),
@@ -763,7 +779,7 @@ func (q *MetricsViewComparison) buildMetricsComparisonTopListSQL(mv *runtimev1.M
 			if s.Desc {
 				ending += " DESC"
 			}
-			if dialect == drivers.DialectDuckDB {
+			if dialect == drivers.DialectDuckDB || dialect == drivers.DialectPostgres {
 				ending += " NULLS LAST"
 			}
 			clause += ending
@@ -796,7 +812,7 @@ func (q *MetricsViewComparison) buildMetricsComparisonTopListSQL(mv *runtimev1.M
 		if s.Desc {
 			ending += " DESC"
 		}
-		if dialect == drivers.DialectDuckDB {
+		if dialect == drivers.DialectDuckDB || dialect == drivers.DialectPostgres {
 			ending += " NULLS LAST"
 		}
 		orderClause += ending
@@ -864,7 +880,7 @@ func (q *MetricsViewComparison) buildMetricsComparisonTopListSQL(mv *runtimev1.M
 		OFFSET 0
 	*/
 
-	finalDimName := safeName(q.DimensionName)
+	finalDimName := safeName(dim.Name)
 	if export && dim.Label != "" {
 		finalDimName = safeName(dim.Label)
 	}
@@ -873,6 +889,9 @@ func (q *MetricsViewComparison) buildMetricsComparisonTopListSQL(mv *runtimev1.M
 		var joinOnClause string
 		if dialect == drivers.DialectClickHouse {
 			joinOnClause = fmt.Sprintf("isNotDistinctFrom(base.%[1]s, comparison.%[1]s)", colName)
+		} else if dialect == drivers.DialectPostgres {
+			// Postgres only supports FULL JOIN on hash or merge joinable conditions, which rules out OR and IS NOT DISTINCT FROM
+			joinOnClause = fmt.Sprintf("COALESCE(base.%[1]s::TEXT, '') = COALESCE(comparison.%[1]s::TEXT, '') AND (base.%[1]s IS NULL) = (comparison.%[1]s IS NULL)", colName)
 		} else {
 			joinOnClause = fmt.Sprintf("base.%[1]s = comparison.%[1]s OR (base.%[1]s is null and comparison.%[1]s is null)", colName)
 		}
@@ -909,7 +928,7 @@ func (q *MetricsViewComparison) buildMetricsComparisonTopListSQL(mv *runtimev1.M
 					%[7]s
 					OFFSET
 						%[8]d
-				) WHERE %[15]s 
+				) filtered WHERE %[15]s 
 			`,
 				subSelectClause,                     // 1
 				colName,                             // 2
@@ -1076,7 +1095,7 @@ func (q *MetricsViewComparison) buildMetricsComparisonTopListSQL(mv *runtimev1.M
 
 func (q *MetricsViewComparison) Export(ctx context.Context, rt *runtime.Runtime, instanceID string, w io.Writer, opts *runtime.ExportOptions) error {
 	// Resolve metrics view
-	mv, security, err := resolveMVAndSecurityFromAttributes(ctx, rt, instanceID, q.MetricsViewName, q.SecurityAttributes, []*runtimev1.MetricsViewAggregationDimension{{Name: q.DimensionName}}, q.Measures)
+	mv, security, err := resolveMVAndSecurityFromAttributes(ctx, rt, instanceID, q.MetricsViewName, q.SecurityAttributes, q.dimensions(), q.Measures)
 	if err != nil {
 		return err
 	}
@@ -1089,7 +1108,7 @@ func (q *MetricsViewComparison) Export(ctx context.Context, rt *runtime.Runtime,
 
 	switch olap.Dialect() {
 	case drivers.DialectDuckDB:
-		if opts.Format == runtimev1.ExportFormat_EXPORT_FORMAT_CSV || opts.Format == runtimev1.ExportFormat_EXPORT_FORMAT_PARQUET {
+		if opts.Format == runtimev1.ExportFormat_EXPORT_FORMAT_CSV || opts.Format == runtimev1.ExportFormat_EXPORT_FORMAT_PARQUET || opts.Format == runtimev1.ExportFormat_EXPORT_FORMAT_JSONL {
 			// temporary backwards compatibility
 			if q.Filter != nil {
 				if q.Where != nil {
@@ -1125,7 +1144,7 @@ func (q *MetricsViewComparison) Export(ctx context.Context, rt *runtime.Runtime,
 				return err
 			}
 		}
-	case drivers.DialectDruid, drivers.DialectClickHouse, drivers.DialectPinot:
+	case drivers.DialectDruid, drivers.DialectClickHouse, drivers.DialectPinot, drivers.DialectPostgres:
 		if err := q.generalExport(ctx, rt, instanceID, w, opts, mv); err != nil {
 			return err
 		}
@@ -1247,6 +1266,10 @@ func (q *MetricsViewComparison) generalExport(ctx context.Context, rt *runtime.R
 		return WriteXLSX(meta, data, w)
 	case runtimev1.ExportFormat_EXPORT_FORMAT_PARQUET:
 		return WriteParquet(meta, data, w)
+	case runtimev1.ExportFormat_EXPORT_FORMAT_JSONL:
+		return WriteJSONL(meta, data, w)
+	case runtimev1.ExportFormat_EXPORT_FORMAT_ARROW:
+		return WriteArrow(meta, data, w)
 	}
 
 	return nil
@@ -1341,6 +1364,23 @@ func validateMeasureAliases(aliases []*runtimev1.MetricsViewComparisonMeasureAli
 	return nil
 }
 
+// dimensions returns the dimensions the query is split by.
+func (q *MetricsViewComparison) dimensions() []*runtimev1.MetricsViewAggregationDimension {
+	if q.DimensionName == "" {
+		return nil
+	}
+	return []*runtimev1.MetricsViewAggregationDimension{{Name: q.DimensionName}}
+}
+
+// dimension returns the dimension the query is split by.
+// If the query isn't split by a dimension, it returns a constant dimension that puts all rows in a single group.
+func (q *MetricsViewComparison) dimension(mv *runtimev1.MetricsViewSpec) (*runtimev1.MetricsViewSpec_DimensionV2, error) {
+	if q.DimensionName == "" {
+		return &runtimev1.MetricsViewSpec_DimensionV2{Name: unsplitDimensionName, Expression: "1"}, nil
+	}
+	return metricsViewDimension(mv, q.DimensionName)
+}
+
 func isTimeRangeNil(tr *runtimev1.TimeRange) bool {
 	return tr == nil || (tr.Start == nil && tr.End == nil)
 }
@@ -90,7 +90,19 @@ func (q *MetricsViewSchema) buildMetricsViewDataTypesSQL(mv *runtimev1.MetricsVi
 
 	var measures []string
 	for _, meas := range mv.Measures {
-		measures = append(measures, fmt.Sprintf("%s as %s", meas.Expression, safeName(meas.Name)))
+		expr := meas.Expression
+		if drivers.IsMetricsViewWindowMeasure(meas) {
+			// Compute the window over the whole result to get the window measure's data type
+			base, err := metricsViewMeasureExpression(mv, meas.ReferencedMeasures[0])
+			if err != nil {
+				continue
+			}
+			expr, err = dialect.MetricsViewWindowMeasureExpression(meas, "("+base+")", nil, "1")
+			if err != nil {
+				continue
+			}
+		}
+		measures = append(measures, fmt.Sprintf("%s as %s", expr, safeName(meas.Name)))
 	}
 
 	groups := make([]string, len(dimensions))
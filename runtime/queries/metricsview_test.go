@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/apache/arrow/go/v14/arrow/array"
+	"github.com/apache/arrow/go/v14/arrow/ipc"
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/stretchr/testify/require"
 	"github.com/xuri/excelize/v2"
@@ -454,3 +456,81 @@ func Test_writeXLSX_quotes(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, "a\"", v)
 }
+
+func Test_writeJSONL(t *testing.T) {
+	meta := []*runtimev1.MetricsViewColumn{
+		{
+			Name: "b",
+		},
+		{
+			Name: "a",
+		},
+		{
+			Name: "c",
+		},
+	}
+	data := []*structpb.Struct{
+		{
+			Fields: map[string]*structpb.Value{
+				"a": structpb.NewStringValue("x\"y"),
+				"b": structpb.NewNumberValue(2.5),
+				"c": structpb.NewNullValue(),
+			},
+		},
+		{
+			Fields: map[string]*structpb.Value{
+				"a": structpb.NewBoolValue(true),
+				"b": structpb.NewListValue(&structpb.ListValue{Values: []*structpb.Value{structpb.NewNumberValue(1)}}),
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+
+	err := WriteJSONL(meta, data, &buf)
+	require.NoError(t, err)
+	require.Equal(t, "{\"b\":2.5,\"a\":\"x\\\"y\",\"c\":null}\n{\"b\":[1],\"a\":true,\"c\":null}\n", buf.String())
+}
+
+func Test_writeArrow(t *testing.T) {
+	meta := []*runtimev1.MetricsViewColumn{
+		{
+			Name: "str",
+			Type: runtimev1.Type_CODE_STRING.String(),
+		},
+		{
+			Name: "num",
+			Type: runtimev1.Type_CODE_FLOAT64.String(),
+		},
+	}
+	data := []*structpb.Struct{
+		{
+			Fields: map[string]*structpb.Value{
+				"str": structpb.NewStringValue("a"),
+				"num": structpb.NewNumberValue(1.5),
+			},
+		},
+		{
+			Fields: map[string]*structpb.Value{
+				"str": structpb.NewStringValue("b"),
+				"num": structpb.NewNumberValue(2),
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+
+	err := WriteArrow(meta, data, &buf)
+	require.NoError(t, err)
+
+	r, err := ipc.NewReader(&buf)
+	require.NoError(t, err)
+	defer r.Release()
+	require.Equal(t, []string{"str", "num"}, []string{r.Schema().Field(0).Name, r.Schema().Field(1).Name})
+	require.True(t, r.Next())
+	rec := r.Record()
+	require.Equal(t, int64(2), rec.NumRows())
+	require.Equal(t, "b", rec.Column(0).(*array.String).Value(1))
+	require.Equal(t, 1.5, rec.Column(1).(*array.Float64).Value(0))
+	require.False(t, r.Next())
+}
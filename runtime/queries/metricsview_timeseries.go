@@ -262,6 +262,10 @@ func (q *MetricsViewTimeSeries) Export(ctx context.Context, rt *runtime.Runtime,
 		return WriteXLSX(meta, tmp, w)
 	case runtimev1.ExportFormat_EXPORT_FORMAT_PARQUET:
 		return WriteParquet(meta, tmp, w)
+	case runtimev1.ExportFormat_EXPORT_FORMAT_JSONL:
+		return WriteJSONL(meta, tmp, w)
+	case runtimev1.ExportFormat_EXPORT_FORMAT_ARROW:
+		return WriteArrow(meta, tmp, w)
 	}
 
 	return nil
@@ -357,6 +361,9 @@ func (q *MetricsViewTimeSeries) buildMetricsTimeseriesSQL(olap drivers.OLAPStore
 		sql = q.buildPinotSQL(mv, tsAlias, selectCols, whereClause, havingClause)
 	case drivers.DialectClickHouse:
 		sql = q.buildClickHouseSQL(mv, tsAlias, selectCols, whereClause, havingClause, timezone)
+	case drivers.DialectPostgres:
+		args = append([]any{timezone, timezone}, args...)
+		sql = q.buildPostgresSQL(mv, tsAlias, selectCols, whereClause, havingClause)
 	default:
 		return "", "", nil, fmt.Errorf("not available for dialect '%s'", olap.Dialect())
 	}
@@ -467,6 +474,38 @@ func (q *MetricsViewTimeSeries) buildClickHouseSQL(mv *runtimev1.MetricsViewSpec
 	return sql
 }
 
+func (q *MetricsViewTimeSeries) buildPostgresSQL(mv *runtimev1.MetricsViewSpec, tsAlias string, selectCols []string, whereClause, havingClause string) string {
+	dateTruncSpecifier := drivers.DialectPostgres.ConvertToDateTruncSpecifier(q.TimeGranularity)
+
+	shift := "" // shift to accommodate FirstDayOfWeek or FirstMonthOfYear
+	if q.TimeGranularity == runtimev1.TimeGrain_TIME_GRAIN_WEEK && mv.FirstDayOfWeek > 1 {
+		offset := 8 - mv.FirstDayOfWeek
+		shift = fmt.Sprintf("%d DAY", offset)
+	} else if q.TimeGranularity == runtimev1.TimeGrain_TIME_GRAIN_YEAR && mv.FirstMonthOfYear > 1 {
+		offset := 13 - mv.FirstMonthOfYear
+		shift = fmt.Sprintf("%d MONTH", offset)
+	}
+
+	// The time zone is passed as the first two args.
+	// The Postgres driver sets the session time zone to UTC, so TIMESTAMP columns are interpreted as UTC when cast to TIMESTAMPTZ.
+	timeClause := fmt.Sprintf("timezone(?::TEXT, date_trunc('%s', timezone(?::TEXT, %s::TIMESTAMPTZ)))", dateTruncSpecifier, safeName(mv.TimeDimension))
+	if shift != "" {
+		timeClause = fmt.Sprintf("timezone(?::TEXT, date_trunc('%[1]s', timezone(?::TEXT, %[2]s::TIMESTAMPTZ) + INTERVAL '%[3]s') - INTERVAL '%[3]s')", dateTruncSpecifier, safeName(mv.TimeDimension), shift)
+	}
+
+	sql := fmt.Sprintf(
+		`SELECT %s AS %s, %s FROM %s WHERE %s GROUP BY 1 %s ORDER BY 1`,
+		timeClause,
+		tsAlias,
+		strings.Join(selectCols, ", "),
+		escapeMetricsViewTable(drivers.DialectPostgres, mv),
+		whereClause,
+		havingClause,
+	)
+
+	return sql
+}
+
 func (q *MetricsViewTimeSeries) buildDuckDBSQL(mv *runtimev1.MetricsViewSpec, tsAlias string, selectCols []string, whereClause, havingClause, timezone string) string {
 	dateTruncSpecifier := drivers.DialectDuckDB.ConvertToDateTruncSpecifier(q.TimeGranularity)
 
@@ -42,7 +42,7 @@ func ProtoToQuery(q *runtimev1.Query, attrs map[string]any) (runtime.Query, erro
 		req := r.MetricsViewComparisonRequest
 		return &MetricsViewComparison{
 			MetricsViewName:     req.MetricsViewName,
-			DimensionName:       req.Dimension.Name,
+			DimensionName:       req.GetDimension().GetName(),
 			Measures:            req.Measures,
 			ComparisonMeasures:  req.ComparisonMeasures,
 			TimeRange:           req.TimeRange,
@@ -105,6 +105,10 @@ func ProtoFromJSON(qryName, qryArgsJSON string, executionTime *time.Time) (*runt
 		}
 		if executionTime != nil {
 			req.TimeRange = overrideTimeRange(req.TimeRange, *executionTime)
+			// A comparison time range given as an offset is relative to the execution time as well
+			if req.ComparisonTimeRange != nil && req.ComparisonTimeRange.Start == nil && req.ComparisonTimeRange.IsoOffset != "" {
+				req.ComparisonTimeRange = overrideTimeRange(req.ComparisonTimeRange, *executionTime)
+			}
 		}
 	default:
 		return nil, fmt.Errorf("query %q not supported for reports", qryName)
@@ -2,27 +2,30 @@ package runtime
 
 import (
 	"context"
+	"encoding/binary"
 	"fmt"
 	"io"
 	"strings"
 	"time"
 
-	"github.com/dgraph-io/ristretto"
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/rilldata/rill/runtime/pkg/observability"
+	"github.com/rilldata/rill/runtime/pkg/querycache"
 	"github.com/rilldata/rill/runtime/pkg/singleflight"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
 )
 
 var (
 	meter                        = otel.Meter("github.com/rilldata/rill/runtime")
-	queryCacheHitsCounter        = observability.Must(meter.Int64ObservableCounter("query_cache.hits"))
-	queryCacheMissesCounter      = observability.Must(meter.Int64ObservableCounter("query_cache.misses"))
-	queryCacheItemCountGauge     = observability.Must(meter.Int64ObservableGauge("query_cache.items"))
-	queryCacheSizeBytesGauge     = observability.Must(meter.Int64ObservableGauge("query_cache.size", metric.WithUnit("bytes")))
+	queryCacheHitsCounter        = observability.Must(meter.Int64Counter("query_cache.hits"))
+	queryCacheMissesCounter      = observability.Must(meter.Int64Counter("query_cache.misses"))
+	queryCacheErrorsCounter      = observability.Must(meter.Int64Counter("query_cache.errors"))
 	queryCacheEntrySizeHistogram = observability.Must(meter.Int64Histogram("query_cache.entry_size", metric.WithUnit("bytes")))
 )
 
@@ -119,7 +122,7 @@ func (r *Runtime) Query(ctx context.Context, instanceID string, query Query, pri
 	}.String()
 
 	// Try to get from cache
-	if val, ok := r.queryCache.cache.Get(key); ok {
+	if val, ok := r.queryCache.get(ctx, key); ok {
 		observability.AddRequestAttributes(ctx, attribute.Bool("query.cache_hit", true))
 		return query.UnmarshalResult(val)
 	}
@@ -129,9 +132,10 @@ func (r *Runtime) Query(ctx context.Context, instanceID string, query Query, pri
 	owner := false
 	val, err := r.queryCache.singleflight.Do(ctx, key, func(ctx context.Context) (any, error) {
 		// Try cache again
-		if val, ok := r.queryCache.cache.Get(key); ok {
+		if val, ok := r.queryCache.get(ctx, key); ok {
 			return val, nil
 		}
+		r.queryCache.recordMiss(ctx)
 
 		// Load
 		err := query.Resolve(ctx, r, instanceID, priority)
@@ -141,7 +145,7 @@ func (r *Runtime) Query(ctx context.Context, instanceID string, query Query, pri
 
 		owner = true
 		res := query.MarshalResult()
-		r.queryCache.cache.Set(key, res.Value, res.Bytes)
+		r.queryCache.set(ctx, key, res.Value, res.Bytes)
 		queryCacheEntrySizeHistogram.Record(ctx, res.Bytes, metric.WithAttributes(attribute.String("query", queryName(query))))
 		return res.Value, nil
 	})
@@ -165,47 +169,129 @@ func (k queryCacheKey) String() string {
 	return fmt.Sprintf("inst:%s deps:%s qry:%s", k.instanceID, k.dependencyKey, k.queryKey)
 }
 
+// queryCache wraps the configured querycache.Cache with singleflight loading, metrics and error handling.
+// Cache errors are logged and treated as misses, so an unavailable external cache never fails a query.
 type queryCache struct {
-	cache        *ristretto.Cache
+	cache        querycache.Cache
 	singleflight *singleflight.Group[string, any]
-	metrics      metric.Registration
+	logger       *zap.Logger
+	attrs        metric.MeasurementOption
 }
 
-func newQueryCache(sizeInBytes int64) *queryCache {
-	if sizeInBytes <= 100 {
-		panic(fmt.Sprintf("invalid cache size should be greater than 100: %v", sizeInBytes))
-	}
-	cache, err := ristretto.NewCache(&ristretto.Config{
-		// Use 5% of cache memory for storing counters. Each counter takes roughly 3 bytes.
-		// Recommended value is 10x the number of items in cache when full.
-		// Tune this again based on metrics.
-		NumCounters: int64(float64(sizeInBytes) * 0.05 / 3),
-		MaxCost:     int64(float64(sizeInBytes) * 0.95),
-		BufferItems: 64,
-		Metrics:     true,
-	})
+func newQueryCache(cache querycache.Cache, logger *zap.Logger) *queryCache {
+	return &queryCache{
+		cache:        cache,
+		singleflight: &singleflight.Group[string, any]{},
+		logger:       logger,
+		attrs:        metric.WithAttributes(attribute.String("backend", cache.Name())),
+	}
+}
+
+// get looks up a key in the cache and counts hits.
+// Misses are not counted since callers look up the key again under singleflight before loading it; they should call recordMiss before loading instead.
+func (c *queryCache) get(ctx context.Context, key string) (any, bool) {
+	val, ok, err := c.cache.Get(ctx, key)
 	if err != nil {
-		panic(err)
+		queryCacheErrorsCounter.Add(ctx, 1, c.attrs)
+		c.logger.Warn("query cache get failed", zap.String("backend", c.cache.Name()), zap.Error(err), observability.ZapCtx(ctx))
+		ok = false
+	}
+	if ok {
+		queryCacheHitsCounter.Add(ctx, 1, c.attrs)
 	}
+	return val, ok
+}
 
-	metrics := observability.Must(meter.RegisterCallback(func(ctx context.Context, observer metric.Observer) error {
-		observer.ObserveInt64(queryCacheHitsCounter, int64(cache.Metrics.Hits()))
-		observer.ObserveInt64(queryCacheMissesCounter, int64(cache.Metrics.Misses()))
-		observer.ObserveInt64(queryCacheItemCountGauge, int64(cache.Metrics.KeysAdded()-cache.Metrics.KeysEvicted()))
-		observer.ObserveInt64(queryCacheSizeBytesGauge, int64(cache.Metrics.CostAdded()-cache.Metrics.CostEvicted()))
-		return nil
-	}, queryCacheHitsCounter, queryCacheMissesCounter, queryCacheItemCountGauge, queryCacheSizeBytesGauge))
+// recordMiss counts a cache miss.
+func (c *queryCache) recordMiss(ctx context.Context) {
+	queryCacheMissesCounter.Add(ctx, 1, c.attrs)
+}
 
-	return &queryCache{
-		cache:        cache,
-		singleflight: &singleflight.Group[string, any]{},
-		metrics:      metrics,
+func (c *queryCache) set(ctx context.Context, key string, val any, size int64) {
+	err := c.cache.Set(ctx, key, val, size)
+	if err != nil {
+		queryCacheErrorsCounter.Add(ctx, 1, c.attrs)
+		c.logger.Warn("query cache set failed", zap.String("backend", c.cache.Name()), zap.Error(err), observability.ZapCtx(ctx))
 	}
 }
 
 func (c *queryCache) close() error {
-	c.cache.Close()
-	return c.metrics.Unregister()
+	return c.cache.Close()
+}
+
+// QueryCacheCodec encodes cached query and resolver results for caches that store them outside of the process.
+// It supports resolver results and query results that are proto messages. Other query results are not cached by such caches.
+var QueryCacheCodec querycache.Codec = queryCacheCodec{}
+
+type queryCacheCodec struct{}
+
+const (
+	queryCacheCodecResolveResult byte = 1
+	queryCacheCodecProto         byte = 2
+)
+
+func (queryCacheCodec) Encode(val any) ([]byte, error) {
+	switch val := val.(type) {
+	case ResolveResult:
+		schema, err := proto.Marshal(val.Schema)
+		if err != nil {
+			return nil, err
+		}
+		buf := make([]byte, 0, 1+binary.MaxVarintLen64+len(schema)+len(val.Data))
+		buf = append(buf, queryCacheCodecResolveResult)
+		buf = binary.AppendUvarint(buf, uint64(len(schema)))
+		buf = append(buf, schema...)
+		buf = append(buf, val.Data...)
+		return buf, nil
+	case proto.Message:
+		// A nil message can't be distinguished from an empty message after encoding
+		if !val.ProtoReflect().IsValid() {
+			return nil, querycache.ErrNotEncodable
+		}
+		a, err := anypb.New(val)
+		if err != nil {
+			return nil, err
+		}
+		data, err := proto.Marshal(a)
+		if err != nil {
+			return nil, err
+		}
+		return append([]byte{queryCacheCodecProto}, data...), nil
+	default:
+		return nil, querycache.ErrNotEncodable
+	}
+}
+
+func (queryCacheCodec) Decode(data []byte) (any, error) {
+	if len(data) == 0 {
+		return nil, fmt.Errorf("invalid query cache entry: empty")
+	}
+
+	switch data[0] {
+	case queryCacheCodecResolveResult:
+		n, w := binary.Uvarint(data[1:])
+		if w <= 0 || uint64(len(data)-1-w) < n {
+			return nil, fmt.Errorf("invalid query cache entry: bad schema length")
+		}
+		schema := &runtimev1.StructType{}
+		err := proto.Unmarshal(data[1+w:1+w+int(n)], schema)
+		if err != nil {
+			return nil, err
+		}
+		return ResolveResult{
+			Data:   data[1+w+int(n):],
+			Schema: schema,
+		}, nil
+	case queryCacheCodecProto:
+		a := &anypb.Any{}
+		err := proto.Unmarshal(data[1:], a)
+		if err != nil {
+			return nil, err
+		}
+		return a.UnmarshalNew()
+	default:
+		return nil, fmt.Errorf("invalid query cache entry: unknown type %d", data[0])
+	}
 }
 
 func queryName(q Query) string {
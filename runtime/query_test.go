@@ -0,0 +1,46 @@
+package runtime
+
+import (
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/pkg/querycache"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestQueryCacheCodec(t *testing.T) {
+	// Resolver results
+	rr := ResolveResult{
+		Data: []byte(`[{"a":1}]`),
+		Schema: &runtimev1.StructType{Fields: []*runtimev1.StructType_Field{
+			{Name: "a", Type: &runtimev1.Type{Code: runtimev1.Type_CODE_INT64}},
+		}},
+	}
+	data, err := QueryCacheCodec.Encode(rr)
+	require.NoError(t, err)
+	val, err := QueryCacheCodec.Decode(data)
+	require.NoError(t, err)
+	res, ok := val.(ResolveResult)
+	require.True(t, ok)
+	require.Equal(t, rr.Data, res.Data)
+	require.True(t, proto.Equal(rr.Schema, res.Schema))
+
+	// Query results that are proto messages
+	tk := &runtimev1.TopK{Entries: []*runtimev1.TopK_Entry{{Count: 10}}}
+	data, err = QueryCacheCodec.Encode(tk)
+	require.NoError(t, err)
+	val, err = QueryCacheCodec.Decode(data)
+	require.NoError(t, err)
+	require.IsType(t, &runtimev1.TopK{}, val)
+	require.True(t, proto.Equal(tk, val.(proto.Message)))
+
+	// Other query results
+	_, err = QueryCacheCodec.Encode(10.0)
+	require.ErrorIs(t, err, querycache.ErrNotEncodable)
+	_, err = QueryCacheCodec.Encode((*runtimev1.TopK)(nil))
+	require.ErrorIs(t, err, querycache.ErrNotEncodable)
+
+	_, err = QueryCacheCodec.Decode([]byte{99})
+	require.Error(t, err)
+}
@@ -20,6 +20,7 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
 	"golang.org/x/exp/slices"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -99,6 +100,22 @@ func (r *AlertReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceNa
 		}
 	}
 
+	// Run a requested backfill before handling the regular schedule.
+	// Since clearing the backfill from the spec doesn't retrigger the reconciler, we retrigger explicitly to continue with the regular schedule.
+	if a.Spec.BackfillFrom != nil && a.Spec.BackfillTo != nil {
+		backfillErr := r.executeBackfill(ctx, self, a)
+		if errors.Is(backfillErr, context.Canceled) {
+			return runtime.ReconcileResult{Err: backfillErr}
+		}
+
+		err = r.clearBackfill(ctx, n, a.Spec.BackfillFrom, a.Spec.BackfillTo)
+		if err != nil {
+			return runtime.ReconcileResult{Err: fmt.Errorf("failed to clear backfill: %w", err)}
+		}
+
+		return runtime.ReconcileResult{Err: backfillErr, Retrigger: time.Now()}
+	}
+
 	// Exit early if disabled
 	if a.Spec.RefreshSchedule != nil && a.Spec.RefreshSchedule.Disable {
 		return runtime.ReconcileResult{}
@@ -371,6 +388,69 @@ func (r *AlertReconciler) setTriggerFalse(ctx context.Context, n *runtimev1.Reso
 	return r.C.UpdateSpec(ctx, self.Meta.Name, self)
 }
 
+// clearBackfill clears the backfill range from the alert's spec.
+// If the range was extended by another refresh trigger while the backfill was running, it is left for the next invocation.
+func (r *AlertReconciler) clearBackfill(ctx context.Context, n *runtimev1.ResourceName, from, to *timestamppb.Timestamp) error {
+	r.C.Lock(ctx)
+	defer r.C.Unlock(ctx)
+
+	self, err := r.C.Get(ctx, n, false)
+	if err != nil {
+		return err
+	}
+
+	a := self.GetAlert()
+	if a == nil {
+		return fmt.Errorf("not an alert")
+	}
+
+	if !proto.Equal(a.Spec.BackfillFrom, from) || !proto.Equal(a.Spec.BackfillTo, to) {
+		return nil
+	}
+
+	a.Spec.BackfillFrom = nil
+	a.Spec.BackfillTo = nil
+	return r.C.UpdateSpec(ctx, self.Meta.Name, self)
+}
+
+// advanceBackfill moves the start of the backfill range in the alert's spec past an execution time that has completed,
+// so a backfill that is interrupted and retried doesn't check it (and send its notifications) again.
+// It also updates a.Spec, which is used to clear the backfill when it completes.
+func (r *AlertReconciler) advanceBackfill(ctx context.Context, n *runtimev1.ResourceName, a *runtimev1.Alert, t time.Time) error {
+	r.C.Lock(ctx)
+	defer r.C.Unlock(ctx)
+
+	self, err := r.C.Get(ctx, n, false)
+	if err != nil {
+		return err
+	}
+
+	cur := self.GetAlert()
+	if cur == nil {
+		return fmt.Errorf("not an alert")
+	}
+
+	// If the range was extended by another refresh trigger, it's run again from its new start on the next invocation
+	if !proto.Equal(cur.Spec.BackfillFrom, a.Spec.BackfillFrom) || !proto.Equal(cur.Spec.BackfillTo, a.Spec.BackfillTo) {
+		return nil
+	}
+
+	if t.Before(cur.Spec.BackfillTo.AsTime()) {
+		cur.Spec.BackfillFrom = timestamppb.New(t)
+	} else {
+		cur.Spec.BackfillFrom = nil
+		cur.Spec.BackfillTo = nil
+	}
+	err = r.C.UpdateSpec(ctx, self.Meta.Name, self)
+	if err != nil {
+		return err
+	}
+
+	a.Spec.BackfillFrom = cur.Spec.BackfillFrom
+	a.Spec.BackfillTo = cur.Spec.BackfillTo
+	return nil
+}
+
 // executeAll runs queries and (maybe) sends notifications for the alert. It also adds entries to a.State.ExecutionHistory.
 // By default, an alert is checked once for the current watermark, but if a.Spec.IntervalsIsoDuration is set, it will be checked *for each* interval that has elapsed since the previous execution watermark.
 func (r *AlertReconciler) executeAll(ctx context.Context, self *runtimev1.Resource, a *runtimev1.Alert, triggerTime time.Time, adhocTrigger bool) error {
@@ -383,17 +463,9 @@ func (r *AlertReconciler) executeAll(ctx context.Context, self *runtimev1.Resour
 	defer cancel()
 
 	// Get admin metadata for the alert (if an admin service is not configured, alerts will still work, the notifications just won't have open/edit links).
-	var adminMeta *drivers.AlertMetadata
-	admin, release, err := r.C.Runtime.Admin(ctx, r.C.InstanceID)
-	if err != nil && !errors.Is(err, runtime.ErrAdminNotConfigured) {
-		return fmt.Errorf("failed to get admin client: %w", err)
-	}
-	if err == nil { // Connected successfully
-		defer release()
-		adminMeta, err = admin.GetAlertMetadata(ctx, self.Meta.Name.Name, a.Spec.Annotations, a.Spec.GetQueryForUserId(), a.Spec.GetQueryForUserEmail())
-		if err != nil {
-			return fmt.Errorf("failed to get alert metadata: %w", err)
-		}
+	adminMeta, err := r.adminMetadata(ctx, self, a)
+	if err != nil {
+		return err
 	}
 
 	// Run alert queries and send notifications
@@ -438,6 +510,111 @@ func (r *AlertReconciler) executeAll(ctx context.Context, self *runtimev1.Resour
 	return executeErr
 }
 
+// adminMetadata fetches the alert's metadata from the admin service. It returns nil if an admin service is not configured.
+func (r *AlertReconciler) adminMetadata(ctx context.Context, self *runtimev1.Resource, a *runtimev1.Alert) (*drivers.AlertMetadata, error) {
+	admin, release, err := r.C.Runtime.Admin(ctx, r.C.InstanceID)
+	if err != nil {
+		if errors.Is(err, runtime.ErrAdminNotConfigured) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get admin client: %w", err)
+	}
+	defer release()
+
+	meta, err := admin.GetAlertMetadata(ctx, self.Meta.Name.Name, a.Spec.Annotations, a.Spec.GetQueryForUserId(), a.Spec.GetQueryForUserEmail())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get alert metadata: %w", err)
+	}
+	return meta, nil
+}
+
+// executeBackfill checks the alert for each execution time in the backfill range of the alert's spec.
+// Each execution is subject to the alert's timeout. Notifications for backfilled executions are based only on previous backfilled executions.
+func (r *AlertReconciler) executeBackfill(ctx context.Context, self *runtimev1.Resource, a *runtimev1.Alert) error {
+	adminMeta, err := r.adminMetadata(ctx, self, a)
+	if err != nil {
+		return err
+	}
+
+	from, to := a.Spec.BackfillFrom.AsTime(), a.Spec.BackfillTo.AsTime()
+	ts, err := calculateBackfillExecutionTimes(a.Spec.IntervalsIsoDuration, a.Spec.RefreshSchedule, from, to)
+	if err == nil {
+		err = checkRefs(ctx, r.C, self.Meta.Refs)
+	}
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			return err
+		}
+		a.State.CurrentExecution = &runtimev1.AlertExecution{
+			Result: &runtimev1.AssertionResult{
+				Status:       runtimev1.AssertionStatus_ASSERTION_STATUS_ERROR,
+				ErrorMessage: fmt.Sprintf("Alert backfill failed: %s", err.Error()),
+			},
+			StartedOn: timestamppb.Now(),
+			Backfill:  true,
+		}
+		popErr := r.popCurrentExecution(ctx, self, a, adminMeta)
+		if popErr != nil {
+			return popErr
+		}
+		return fmt.Errorf("alert backfill failed: %w", err)
+	}
+
+	r.C.Logger.Info("Backfilling alert", zap.String("name", self.Meta.Name.Name), zap.Time("from", from), zap.Time("to", to), zap.Int("executions", len(ts)))
+
+	timeout := alertCheckDefaultTimeout
+	if a.Spec.TimeoutSeconds > 0 {
+		timeout = time.Duration(a.Spec.TimeoutSeconds) * time.Second
+	}
+
+	var failed int
+	var lastErr error
+	for _, t := range ts {
+		err := func() error {
+			ctx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+			return r.executeSingle(ctx, self, a, adminMeta, t, false, true)
+		}()
+		if err != nil {
+			// If the backfill was cancelled, pretend the current execution never happened. The backfill will be retried from this execution.
+			if ctx.Err() != nil {
+				if a.State.CurrentExecution != nil {
+					a.State.CurrentExecution = nil
+					err := r.C.UpdateState(ctx, self.Meta.Name, self)
+					if err != nil {
+						return err
+					}
+				}
+				return ctx.Err()
+			}
+
+			// The execution timed out. Add it to the execution history and continue with the next execution time.
+			failed++
+			lastErr = err
+			if a.State.CurrentExecution != nil {
+				a.State.CurrentExecution.Result = &runtimev1.AssertionResult{
+					Status:       runtimev1.AssertionStatus_ASSERTION_STATUS_ERROR,
+					ErrorMessage: fmt.Sprintf("Alert check failed: %s", err.Error()),
+				}
+				err = r.popCurrentExecution(ctx, self, a, adminMeta)
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		err = r.advanceBackfill(ctx, self.Meta.Name, a, t)
+		if err != nil {
+			return err
+		}
+	}
+
+	if lastErr != nil {
+		return fmt.Errorf("%d of %d backfilled alert checks failed: %w", failed, len(ts), lastErr)
+	}
+	return nil
+}
+
 // executeAllWrapped is called by executeAll, which wraps it with timeout and writing of errors to the execution history.
 func (r *AlertReconciler) executeAllWrapped(ctx context.Context, self *runtimev1.Resource, a *runtimev1.Alert, adminMeta *drivers.AlertMetadata, triggerTime time.Time, adhocTrigger bool) error {
 	// Check refs
@@ -459,10 +636,10 @@ func (r *AlertReconciler) executeAllWrapped(ctx context.Context, self *runtimev1
 		// If !ok, no watermark could be computed. So we'll just stick to triggerTime.
 	}
 
-	// Evaluate previous watermark (if any)
+	// Evaluate previous watermark (if any). Backfills are ignored since they run for historical times.
 	var previousWatermark time.Time
 	for _, e := range a.State.ExecutionHistory {
-		if e.ExecutionTime != nil {
+		if e.ExecutionTime != nil && !e.Backfill {
 			previousWatermark = e.ExecutionTime.AsTime()
 			break
 		}
@@ -483,7 +660,7 @@ func (r *AlertReconciler) executeAllWrapped(ctx context.Context, self *runtimev1
 
 	// Evaluate alert for each execution time
 	for _, t := range ts {
-		err := r.executeSingle(ctx, self, a, adminMeta, t, adhocTrigger)
+		err := r.executeSingle(ctx, self, a, adminMeta, t, adhocTrigger, false)
 		if err != nil {
 			return err
 		}
@@ -493,12 +670,13 @@ func (r *AlertReconciler) executeAllWrapped(ctx context.Context, self *runtimev1
 }
 
 // executeSingleAlert runs the alert query and maybe sends notifications for a single execution time.
-func (r *AlertReconciler) executeSingle(ctx context.Context, self *runtimev1.Resource, a *runtimev1.Alert, adminMeta *drivers.AlertMetadata, executionTime time.Time, adhocTrigger bool) error {
+func (r *AlertReconciler) executeSingle(ctx context.Context, self *runtimev1.Resource, a *runtimev1.Alert, adminMeta *drivers.AlertMetadata, executionTime time.Time, adhocTrigger, backfill bool) error {
 	// Create new execution and save in State.CurrentExecution
 	a.State.CurrentExecution = &runtimev1.AlertExecution{
 		Adhoc:         adhocTrigger,
 		ExecutionTime: timestamppb.New(executionTime),
 		StartedOn:     timestamppb.Now(),
+		Backfill:      backfill,
 	}
 	err := r.C.UpdateState(ctx, self.Meta.Name, self)
 	if err != nil {
@@ -600,10 +778,18 @@ func (r *AlertReconciler) popCurrentExecution(ctx context.Context, self *runtime
 
 	current := a.State.CurrentExecution
 
+	// Regular and backfilled executions are evaluated for notifications independently, since backfills run for historical times.
+	history := make([]*runtimev1.AlertExecution, 0, len(a.State.ExecutionHistory))
+	for _, e := range a.State.ExecutionHistory {
+		if e.Backfill == current.Backfill {
+			history = append(history, e)
+		}
+	}
+
 	// td represents the amount of time since we last sent a notification for the current status AND where all intervening executions have returned the same status.
 	var td *time.Duration
 	if current.ExecutionTime != nil {
-		for _, prev := range a.State.ExecutionHistory {
+		for _, prev := range history {
 			if prev.Result.Status != current.Result.Status {
 				break
 			}
@@ -662,10 +848,10 @@ func (r *AlertReconciler) popCurrentExecution(ctx context.Context, self *runtime
 			}
 
 			// Check this is a recovery, i.e. that the previous status was something other than a PASS
-			if len(a.State.ExecutionHistory) == 0 {
+			if len(history) == 0 {
 				break
 			}
-			prev := a.State.ExecutionHistory[0]
+			prev := history[0]
 			if prev.Result.Status == runtimev1.AssertionStatus_ASSERTION_STATUS_PASS {
 				break
 			}
@@ -718,50 +904,32 @@ func (r *AlertReconciler) popCurrentExecution(ctx context.Context, self *runtime
 		}
 
 		for _, notifier := range a.Spec.Notifiers {
-			switch notifier.Connector {
-			// TODO: transform email client to notifier
-			case "email":
-				recipients := pbutil.ToSliceString(notifier.Properties.AsMap()["recipients"])
-				for _, recipient := range recipients {
-					msg.ToEmail = recipient
-					err := r.C.Runtime.Email.SendAlertStatus(msg)
-					if err != nil {
-						notificationErr = fmt.Errorf("failed to send email to %q: %w", recipient, err)
-						break
-					}
+			err := func() (outErr error) {
+				n, release, err := r.C.Runtime.Notifier(ctx, r.C.InstanceID, notifier.Connector, notifier.Properties.AsMap())
+				if err != nil {
+					return err
 				}
-			default:
-				err := func() (outErr error) {
-					conn, release, err := r.C.Runtime.AcquireHandle(ctx, r.C.InstanceID, notifier.Connector)
-					if err != nil {
-						return err
-					}
-					defer release()
-					n, err := conn.AsNotifier(notifier.Properties.AsMap())
-					if err != nil {
-						return err
+				defer release()
+				start := time.Now()
+				defer func() {
+					totalLatency := time.Since(start).Milliseconds()
+
+					if r.C.Activity != nil {
+						r.C.Activity.RecordMetric(ctx, "notifier_total_latency_ms", float64(totalLatency),
+							attribute.Bool("failed", outErr != nil),
+							attribute.String("connector", notifier.Connector),
+							attribute.String("notification_type", "alert_status"),
+						)
 					}
-					start := time.Now()
-					defer func() {
-						totalLatency := time.Since(start).Milliseconds()
-
-						if r.C.Activity != nil {
-							r.C.Activity.RecordMetric(ctx, "notifier_total_latency_ms", float64(totalLatency),
-								attribute.Bool("failed", outErr != nil),
-								attribute.String("connector", notifier.Connector),
-								attribute.String("notification_type", "alert_status"),
-							)
-						}
-					}()
-					err = n.SendAlertStatus(msg)
-					if err != nil {
-						notificationErr = fmt.Errorf("failed to send %s notification: %w", notifier.Connector, err)
-					}
-					return nil
 				}()
+				err = n.SendAlertStatus(msg)
 				if err != nil {
-					return err
+					notificationErr = fmt.Errorf("failed to send %s notification: %w", notifier.Connector, err)
 				}
+				return nil
+			}()
+			if err != nil {
+				return err
 			}
 		}
 		sentNotifications = true
@@ -782,6 +950,11 @@ func (r *AlertReconciler) popCurrentExecution(ctx context.Context, self *runtime
 	a.State.ExecutionCount++
 
 	if len(a.State.ExecutionHistory) > alertExecutionHistoryLimit {
+		// A backfill may add many executions at once. Retain the latest regular execution since its execution time is the previous watermark for the next regular execution.
+		latest := slices.IndexFunc(a.State.ExecutionHistory, func(e *runtimev1.AlertExecution) bool { return !e.Backfill && e.ExecutionTime != nil })
+		if latest >= alertExecutionHistoryLimit {
+			a.State.ExecutionHistory[alertExecutionHistoryLimit-1] = a.State.ExecutionHistory[latest]
+		}
 		a.State.ExecutionHistory = a.State.ExecutionHistory[:alertExecutionHistoryLimit]
 	}
 
@@ -926,7 +1099,9 @@ func formatMetricsViewAggregationResult(q *queries.MetricsViewAggregation, measu
 func formatMetricsViewComparisonResult(q *queries.MetricsViewComparison, measures []*runtimev1.MetricsViewSpec_MeasureV2, logger *zap.Logger) map[string]any {
 	row := q.Result.Rows[0]
 	res := make(map[string]any)
-	res[q.DimensionName] = row.DimensionValue
+	if q.DimensionName != "" {
+		res[q.DimensionName] = row.DimensionValue.AsInterface()
+	}
 	for _, v := range row.MeasureValues {
 		measureLabel, f := getMeasureLabelAndFormatter(v.MeasureName, measures, logger)
 		res[measureLabel] = formatValue(f, v.BaseValue.AsInterface(), logger)
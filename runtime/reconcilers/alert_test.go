@@ -1,6 +1,7 @@
 package reconcilers_test
 
 import (
+	"context"
 	"fmt"
 	"slices"
 	"testing"
@@ -325,6 +326,225 @@ SELECT '2024-01-04T00:00:00Z'::TIMESTAMP as __time, 'Denmark' as country
 	require.Contains(t, emails[0].Body, "Denmark")
 }
 
+func TestAlertBackfill(t *testing.T) {
+	rt, id := testruntime.NewInstance(t)
+	testruntime.PutFiles(t, rt, id, map[string]string{
+		"/models/bar.sql": `
+SELECT '2024-01-01T00:00:00Z'::TIMESTAMP as __time, 'Denmark' as country
+UNION ALL
+SELECT '2024-01-02T00:00:00Z'::TIMESTAMP as __time, 'Denmark' as country
+UNION ALL
+SELECT '2024-01-03T00:00:00Z'::TIMESTAMP as __time, 'Denmark' as country
+UNION ALL
+SELECT '2024-01-03T12:00:00Z'::TIMESTAMP as __time, 'Denmark' as country
+UNION ALL
+SELECT '2024-01-04T00:00:00Z'::TIMESTAMP as __time, 'Denmark' as country
+`,
+		"/dashboards/mv1.yaml": `
+title: mv1
+model: bar
+timeseries: __time
+dimensions:
+- column: country
+measures:
+- expression: count(*)
+`,
+		"/alerts/a1.yaml": `
+type: alert
+title: Test Alert
+refs:
+- type: MetricsView
+  name: mv1
+watermark: inherit
+intervals:
+  duration: P1D
+query:
+  name: MetricsViewAggregation
+  args:
+    metrics_view: mv1
+    dimensions:
+    - name: country
+    measures:
+    - name: measure_0
+    time_range:
+      iso_duration: P1W
+    having:
+      cond:
+        op: OPERATION_GTE
+        exprs:
+        - ident: measure_0
+        - val: 4
+notify:
+  email:
+    recipients:
+      - somebody@example.com
+`,
+	})
+	testruntime.ReconcileParserAndWait(t, rt, id)
+	testruntime.RequireReconcileState(t, rt, id, 4, 0, 0)
+
+	// The first regular execution fails for the latest interval
+	ctrl, err := rt.Controller(context.Background(), id)
+	require.NoError(t, err)
+	n := &runtimev1.ResourceName{Kind: runtime.ResourceKindAlert, Name: "a1"}
+	r, err := ctrl.Get(context.Background(), n, false)
+	require.NoError(t, err)
+	a := r.GetAlert()
+	require.Len(t, a.State.ExecutionHistory, 1)
+	require.Equal(t, runtimev1.AssertionStatus_ASSERTION_STATUS_FAIL, a.State.ExecutionHistory[0].Result.Status)
+	require.False(t, a.State.ExecutionHistory[0].Backfill)
+
+	// Backfill the alert for the days before
+	err = ctrl.Create(context.Background(), &runtimev1.ResourceName{Kind: runtime.ResourceKindRefreshTrigger, Name: "backfill"}, nil, nil, nil, true, &runtimev1.Resource{
+		Resource: &runtimev1.Resource_RefreshTrigger{
+			RefreshTrigger: &runtimev1.RefreshTrigger{
+				Spec: &runtimev1.RefreshTriggerSpec{
+					Backfills: []*runtimev1.RefreshBackfillTrigger{{
+						Name: n,
+						From: timestamppb.New(time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC)),
+						To:   timestamppb.New(time.Date(2024, 1, 4, 0, 0, 0, 0, time.UTC)),
+					}},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	err = ctrl.WaitUntilIdle(context.Background(), false)
+	require.NoError(t, err)
+
+	// Check it executed for each day in the range and cleared the backfill range
+	r, err = ctrl.Get(context.Background(), n, false)
+	require.NoError(t, err)
+	a = r.GetAlert()
+	require.Nil(t, a.Spec.BackfillFrom)
+	require.Nil(t, a.Spec.BackfillTo)
+	require.Equal(t, uint32(5), a.State.ExecutionCount)
+	require.Len(t, a.State.ExecutionHistory, 5)
+	expected := []struct {
+		day    int
+		status runtimev1.AssertionStatus
+	}{
+		{4, runtimev1.AssertionStatus_ASSERTION_STATUS_FAIL},
+		{3, runtimev1.AssertionStatus_ASSERTION_STATUS_PASS},
+		{2, runtimev1.AssertionStatus_ASSERTION_STATUS_PASS},
+		{1, runtimev1.AssertionStatus_ASSERTION_STATUS_PASS},
+	}
+	for i, e := range expected {
+		ex := a.State.ExecutionHistory[i]
+		require.True(t, ex.Backfill)
+		require.Equal(t, time.Date(2024, 1, e.day, 0, 0, 0, 0, time.UTC), ex.ExecutionTime.AsTime())
+		require.Equal(t, e.status, ex.Result.Status)
+	}
+	require.False(t, a.State.ExecutionHistory[4].Backfill)
+
+	// Check the backfilled failure was notified independently of the regular failure
+	emails := rt.Email.Sender.(*email.TestSender).Emails
+	require.Len(t, emails, 2)
+}
+
+func TestAlertCondition(t *testing.T) {
+	rt, id := testruntime.NewInstance(t)
+	testruntime.PutFiles(t, rt, id, map[string]string{
+		"/models/bar.sql": `
+SELECT '2024-01-01T00:00:00Z'::TIMESTAMP as __time, 'Denmark' as country
+UNION ALL
+SELECT '2024-01-01T06:00:00Z'::TIMESTAMP as __time, 'Denmark' as country
+UNION ALL
+SELECT '2024-01-01T12:00:00Z'::TIMESTAMP as __time, 'Denmark' as country
+UNION ALL
+SELECT '2024-01-01T18:00:00Z'::TIMESTAMP as __time, 'Denmark' as country
+UNION ALL
+SELECT '2024-01-01T00:00:00Z'::TIMESTAMP as __time, 'Norway' as country
+UNION ALL
+SELECT '2024-01-01T12:00:00Z'::TIMESTAMP as __time, 'Norway' as country
+UNION ALL
+SELECT '2024-01-02T00:00:00Z'::TIMESTAMP as __time, 'Denmark' as country
+UNION ALL
+SELECT '2024-01-02T00:00:00Z'::TIMESTAMP as __time, 'Norway' as country
+UNION ALL
+SELECT '2024-01-02T12:00:00Z'::TIMESTAMP as __time, 'Norway' as country
+UNION ALL
+SELECT '2024-01-03T00:00:00Z'::TIMESTAMP as __time, 'Norway' as country
+`,
+		"/dashboards/mv1.yaml": `
+title: mv1
+model: bar
+timeseries: __time
+dimensions:
+- column: country
+measures:
+- expression: count(*)
+`,
+		// Denmark drops from 4 to 1 (-75%) and Norway stays at 2 (0%)
+		"/alerts/split.yaml": `
+type: alert
+watermark: inherit
+condition:
+  metrics_view: mv1
+  measure: measure_0
+  dimension: country
+  window: P1D
+  compare_to: previous_period
+  operator: lt
+  value: -50
+notify:
+  email:
+    recipients:
+      - somebody@example.com
+`,
+		// The total drops from 6 to 3 (-50%)
+		"/alerts/unsplit.yaml": `
+type: alert
+watermark: inherit
+condition:
+  metrics_view: mv1
+  measure: measure_0
+  window: P1D
+  compare_to: previous_period
+  operator: lt
+  value: -40
+notify:
+  email:
+    recipients:
+      - somebody@example.com
+`,
+	})
+	testruntime.ReconcileParserAndWait(t, rt, id)
+	testruntime.RequireReconcileState(t, rt, id, 5, 0, 0)
+
+	ctrl, err := rt.Controller(context.Background(), id)
+	require.NoError(t, err)
+	failRow := func(name string) map[string]any {
+		r, err := ctrl.Get(context.Background(), &runtimev1.ResourceName{Kind: runtime.ResourceKindAlert, Name: name}, false)
+		require.NoError(t, err)
+		a := r.GetAlert()
+		require.Len(t, a.State.ExecutionHistory, 1)
+		ex := a.State.ExecutionHistory[0]
+		require.Equal(t, time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC), ex.ExecutionTime.AsTime())
+		require.Equal(t, runtimev1.AssertionStatus_ASSERTION_STATUS_FAIL, ex.Result.Status, ex.Result.ErrorMessage)
+		return ex.Result.FailRow.AsMap()
+	}
+
+	// The split alert fails for the dimension value that matches the condition
+	row := failRow("split")
+	require.Equal(t, "Denmark", row["country"])
+	require.Equal(t, "1", row["measure_0"])
+	require.Equal(t, "4", row["measure_0 (prev)"])
+	require.Contains(t, row, "measure_0 (Δ%)")
+
+	// The unsplit alert fails for the total and doesn't include a dimension
+	row = failRow("unsplit")
+	require.NotContains(t, row, "country")
+	require.NotContains(t, row, "")
+	require.Equal(t, "3", row["measure_0"])
+	require.Equal(t, "6", row["measure_0 (prev)"])
+	require.Contains(t, row, "measure_0 (Δ%)")
+
+	// Check both alerts were notified
+	emails := rt.Email.Sender.(*email.TestSender).Emails
+	require.Len(t, emails, 2)
+}
+
 func newMetricsView(name, table, timeDim string, measures, dimensions []string) (*runtimev1.MetricsViewV2, *runtimev1.Resource) {
 	metrics := &runtimev1.MetricsViewV2{
 		Spec: &runtimev1.MetricsViewSpec{
@@ -0,0 +1,105 @@
+package reconcilers
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/pkg/duration"
+	"golang.org/x/exp/slices"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// backfillMaxExecutions is the maximum number of executions in a single backfill of a report or alert.
+const backfillMaxExecutions = 1000
+
+// calculateBackfillExecutionTimes calculates the execution times for backfilling a report or alert over the time range (from, to].
+// If the resource is configured to run on intervals, it returns the end time of each interval that ends in the range.
+// Otherwise, it returns the times that the resource's refresh schedule would have run in the range.
+func calculateBackfillExecutionTimes(intervalsISODuration string, schedule *runtimev1.Schedule, from, to time.Time) ([]time.Time, error) {
+	if !from.Before(to) {
+		return nil, fmt.Errorf("invalid backfill range: %s is not before %s", from.Format(time.RFC3339), to.Format(time.RFC3339))
+	}
+
+	if intervalsISODuration == "" {
+		return calculateBackfillScheduleTimes(schedule, from, to)
+	}
+
+	// Parse the interval duration
+	di, err := duration.ParseISO8601(intervalsISODuration)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse interval duration: %w", err)
+	}
+	d, ok := di.(duration.StandardDuration)
+	if !ok {
+		return nil, fmt.Errorf("interval duration %q is not a standard ISO 8601 duration", intervalsISODuration)
+	}
+
+	// Extract time zone
+	tz := time.UTC
+	if schedule != nil && schedule.TimeZone != "" {
+		tz, err = time.LoadLocation(schedule.TimeZone)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load time zone %q: %w", schedule.TimeZone, err)
+		}
+	}
+
+	// Walk backwards from the end of the last closed interval in the range
+	var ts []time.Time
+	for t := d.Truncate(to.In(tz), 1, 1); t.After(from); t = d.Sub(t) {
+		if len(ts) == backfillMaxExecutions {
+			return nil, fmt.Errorf("backfill range contains more than %d intervals", backfillMaxExecutions)
+		}
+		ts = append(ts, t)
+	}
+
+	// Reverse execution times so we run them in chronological order
+	slices.Reverse(ts)
+
+	return ts, nil
+}
+
+// calculateBackfillScheduleTimes returns the times that a refresh schedule would have run in the time range (from, to].
+func calculateBackfillScheduleTimes(schedule *runtimev1.Schedule, from, to time.Time) ([]time.Time, error) {
+	if schedule == nil || (schedule.Cron == "" && schedule.TickerSeconds == 0) {
+		return nil, errors.New("can only backfill if intervals or a cron/ticker refresh schedule is configured")
+	}
+
+	// Backfills are explicitly requested, so we run them even if the schedule is disabled.
+	if schedule.Disable {
+		schedule = proto.Clone(schedule).(*runtimev1.Schedule)
+		schedule.Disable = false
+	}
+
+	var ts []time.Time
+	for t := from; ; {
+		next, err := nextRefreshTime(t, schedule)
+		if err != nil {
+			return nil, err
+		}
+		if next.IsZero() || next.After(to) {
+			break
+		}
+		if len(ts) == backfillMaxExecutions {
+			return nil, fmt.Errorf("backfill range contains more than %d scheduled runs", backfillMaxExecutions)
+		}
+		ts = append(ts, next)
+		t = next
+	}
+
+	return ts, nil
+}
+
+// mergeBackfillRange extends a pending backfill range to also cover the time range (from, to].
+// It returns the new range to store in the spec.
+func mergeBackfillRange(pendingFrom, pendingTo *timestamppb.Timestamp, from, to time.Time) (*timestamppb.Timestamp, *timestamppb.Timestamp) {
+	if pendingFrom != nil && pendingFrom.AsTime().Before(from) {
+		from = pendingFrom.AsTime()
+	}
+	if pendingTo != nil && pendingTo.AsTime().After(to) {
+		to = pendingTo.AsTime()
+	}
+	return timestamppb.New(from), timestamppb.New(to)
+}
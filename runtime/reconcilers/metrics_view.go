@@ -89,15 +89,23 @@ func (r *MetricsViewReconciler) Reconcile(ctx context.Context, n *runtimev1.Reso
 	mv.State.Streaming = false
 	if validateErr == nil {
 		// Find out if the metrics view has a ref to a source or model in the same project.
+		// Sources that ingest from a stream are updated continuously, so they count as streaming.
 		hasInternalRef := false
+		hasStreamingSource := false
 		for _, ref := range self.Meta.Refs {
 			if ref.Kind == runtime.ResourceKindSource || ref.Kind == runtime.ResourceKindModel {
 				hasInternalRef = true
 			}
+			if ref.Kind == runtime.ResourceKindSource {
+				res, err := r.C.Get(ctx, ref, false)
+				if err == nil && res.GetSource().Spec.StreamIngestion {
+					hasStreamingSource = true
+				}
+			}
 		}
 
 		// If not, we assume the metrics view is based on an externally managed table and set the streaming state to true.
-		mv.State.Streaming = !hasInternalRef
+		mv.State.Streaming = !hasInternalRef || hasStreamingSource
 	}
 
 	// Update state. Even if the validation result is unchanged, we always update the state to ensure the state version is incremented.
@@ -11,19 +11,26 @@ import (
 	"io"
 	"sort"
 	"strings"
+	"sync"
 	"time"
 
+	"github.com/google/uuid"
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime"
 	compilerv1 "github.com/rilldata/rill/runtime/compilers/rillv1"
 	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/rilldata/rill/runtime/pkg/pbutil"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-const _defaultModelTimeout = 15 * time.Minute
+const (
+	_defaultModelTimeout      = 15 * time.Minute
+	_modelTestQueryPriority   = 1
+	_modelPartitionsBatchSize = 1000
+)
 
 func init() {
 	runtime.RegisterReconcilerInitializer(runtime.ResourceKindModel, newModelReconciler)
@@ -107,6 +114,12 @@ func (r *ModelReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceNa
 
 	// Handle deletion
 	if self.Meta.DeletedOn != nil {
+		if model.State.PartitionsModelId != "" {
+			err = r.deletePartitions(ctx, model.State.PartitionsModelId)
+			if err != nil {
+				r.C.Logger.Warn("failed to delete model partitions", zap.String("model", n.Name), zap.Error(err))
+			}
+		}
 		if prevManager != nil {
 			err = prevManager.Delete(ctx, prevResult)
 			return runtime.ReconcileResult{Err: err}
@@ -164,6 +177,10 @@ func (r *ModelReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceNa
 	if err != nil {
 		return runtime.ReconcileResult{Err: fmt.Errorf("failed to compute refs hash: %w", err)}
 	}
+	testHash, err := modelTestHash(model.Spec.Tests)
+	if err != nil {
+		return runtime.ReconcileResult{Err: fmt.Errorf("failed to compute test hash: %w", err)}
+	}
 
 	// Compute next time to refresh based on the RefreshSchedule (if any)
 	var refreshOn time.Time
@@ -188,6 +205,7 @@ func (r *ModelReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceNa
 	triggerReset = triggerReset || model.State.RefreshedOn == nil
 	triggerReset = triggerReset || model.State.SpecHash != specHash
 	triggerReset = triggerReset || !exists
+	triggerReset = triggerReset || model.Spec.TriggerFull
 
 	// Decide if we should trigger
 	trigger := triggerReset
@@ -195,9 +213,20 @@ func (r *ModelReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceNa
 	trigger = trigger || !refreshOn.IsZero() && time.Now().After(refreshOn)
 	trigger = trigger || model.State.RefsHash != refsHash
 
-	// Reschedule if we're not triggering
+	// Reschedule if we're not triggering.
+	// If only the tests have changed, we re-run them against the existing output.
 	if !trigger {
-		return runtime.ReconcileResult{Retrigger: refreshOn}
+		if model.State.TestHash != testHash && model.State.ResultTable != "" {
+			results, testErr := r.runTests(ctx, self, model.State.ResultConnector, model.State.ResultTable)
+			model.State.TestResults = results
+			model.State.TestHash = testHash
+			err = r.C.UpdateState(ctx, self.Meta.Name, self)
+			if err != nil {
+				return runtime.ReconcileResult{Err: err}
+			}
+			return runtime.ReconcileResult{Err: errors.Join(testErr, modelPartitionsError(model.State)), Retrigger: refreshOn}
+		}
+		return runtime.ReconcileResult{Err: errors.Join(modelTestsError(model.State.TestResults), modelPartitionsError(model.State)), Retrigger: refreshOn}
 	}
 
 	// If the output connector has changed, drop data in the old output connector (if any).
@@ -235,34 +264,6 @@ func (r *ModelReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceNa
 	}
 	r.C.Logger.Debug("Building model output", args...)
 
-	// Prepare the new execution options
-	inputProps, err := r.resolveTemplatedProps(ctx, self, incrementalState, model.Spec.InputConnector, model.Spec.InputProperties.AsMap())
-	if err != nil {
-		return runtime.ReconcileResult{Err: err}
-	}
-	outputProps, err := r.resolveTemplatedProps(ctx, self, incrementalState, model.Spec.OutputConnector, model.Spec.OutputProperties.AsMap())
-	if err != nil {
-		return runtime.ReconcileResult{Err: err}
-	}
-	opts := &drivers.ModelExecutorOptions{
-		Env:              modelEnv,
-		ModelName:        self.Meta.Name.Name,
-		InputConnector:   model.Spec.InputConnector,
-		InputProperties:  inputProps,
-		OutputConnector:  model.Spec.OutputConnector,
-		OutputProperties: outputProps,
-		Incremental:      model.Spec.Incremental,
-		IncrementalRun:   incrementalRun,
-		PreviousResult:   prevResult,
-	}
-
-	// Open executor for the new output
-	executorConnector, executor, release, err := r.acquireExecutor(ctx, opts)
-	if err != nil {
-		return runtime.ReconcileResult{Err: err}
-	}
-	defer release()
-
 	// Apply the timeout to the ctx
 	timeout := _defaultModelTimeout
 	if model.Spec.TimeoutSeconds > 0 {
@@ -271,17 +272,39 @@ func (r *ModelReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceNa
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	// For safety, double check the ctx before executing the model (there may be some code paths where it's not checked)
-	if ctx.Err() != nil {
-		return runtime.ReconcileResult{Err: ctx.Err()}
-	}
-
 	// Build the output
-	execRes, execErr := executor.Execute(ctx)
+	var executorConnector string
+	var execRes *drivers.ModelResult
+	var execErr error
+	var testResults []*runtimev1.ModelTestResult
+	var testErr error
+	tested := false
+	if model.Spec.PartitionsResolver == "" {
+		// Run the model's tests against the new output before it is promoted.
+		// If a test with severity "error" fails, the executor discards the new output.
+		var testOutput func(ctx context.Context, table string) error
+		if len(model.Spec.Tests) > 0 {
+			testOutput = func(ctx context.Context, table string) error {
+				tested = true
+				testResults, testErr = r.runTests(ctx, self, model.Spec.OutputConnector, table)
+				return testErr
+			}
+		}
+
+		executorConnector, execRes, execErr = r.executeModel(ctx, self, modelEnv, prevResult, incrementalRun, incrementalState, nil, testOutput)
+	} else {
+		executorConnector, execRes, execErr = r.executePartitions(ctx, self, modelEnv, prevResult, incrementalRun, incrementalState)
+	}
 	if execErr != nil {
 		execErr = fmt.Errorf("failed to build output: %w", execErr)
 	}
 
+	// Run the tests against the output if the executor did not do so before promoting it (e.g. for incremental runs).
+	// In this case, failing tests don't prevent the output from being used, but they still fail the reconcile.
+	if execErr == nil && len(model.Spec.Tests) > 0 && !tested {
+		testResults, testErr = r.runTests(ctx, self, execRes.Connector, execRes.Table)
+	}
+
 	// After the model has executed successfully, we re-evaluate the model's incremental state (not to be confused with the resource state)
 	var newIncrementalState *structpb.Struct
 	var newIncrementalStateSchema *runtimev1.StructType
@@ -297,15 +320,18 @@ func (r *ModelReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceNa
 		model.State.RefreshedOn = timestamppb.Now()
 		model.State.IncrementalState = newIncrementalState
 		model.State.IncrementalStateSchema = newIncrementalStateSchema
+		model.State.TestResults = testResults
+		model.State.TestHash = testHash
 		err := r.updateStateWithResult(ctx, self, execRes)
 		if err != nil {
 			return runtime.ReconcileResult{Err: err}
 		}
 	}
 
-	// If the build failed, clear the state only if we're not staging changes
+	// If the build failed, clear the state only if we're not staging changes (or if the previous output was already replaced and dropped).
+	// If we're staging changes, the state keeps describing the previous output, so the results of tests that blocked the new output are only surfaced through execErr.
 	if execErr != nil {
-		if !modelEnv.StageChanges {
+		if !modelEnv.StageChanges || errors.Is(execErr, errModelOutputDropped) {
 			err := r.updateStateClear(ctx, self)
 			if err != nil {
 				return runtime.ReconcileResult{Err: errors.Join(err, execErr)}
@@ -318,8 +344,8 @@ func (r *ModelReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceNa
 		return runtime.ReconcileResult{Err: errors.Join(ctx.Err(), execErr)}
 	}
 
-	// Reset spec.Trigger
-	if model.Spec.Trigger {
+	// Reset spec.Trigger and spec.TriggerFull
+	if model.Spec.Trigger || model.Spec.TriggerFull {
 		err := r.updateTriggerFalse(ctx, n)
 		if err != nil {
 			return runtime.ReconcileResult{Err: errors.Join(err, execErr)}
@@ -332,6 +358,11 @@ func (r *ModelReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceNa
 		return runtime.ReconcileResult{Err: errors.Join(err, execErr)}
 	}
 
+	// Failing tests and partitions that did not block the new output are also reported as an error
+	if execErr == nil {
+		execErr = errors.Join(testErr, modelPartitionsError(model.State))
+	}
+
 	// Note: If the build failed, this is where we return the error.
 	return runtime.ReconcileResult{Err: execErr, Retrigger: refreshOn}
 }
@@ -407,6 +438,32 @@ func (r *ModelReconciler) executionSpecHash(ctx context.Context, refs []*runtime
 		}
 	}
 
+	_, err = hash.Write([]byte(spec.PartitionsResolver))
+	if err != nil {
+		return "", err
+	}
+
+	if spec.PartitionsResolverProperties != nil {
+		err = pbutil.WriteHash(structpb.NewStructValue(spec.PartitionsResolverProperties), hash)
+		if err != nil {
+			return "", err
+		}
+
+		res, err := r.analyzeTemplatedVariables(ctx, spec.PartitionsResolverProperties.AsMap())
+		if err != nil {
+			return "", err
+		}
+		err = hashWriteMapOrdered(hash, res)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	_, err = hash.Write([]byte(spec.PartitionsWatermarkField))
+	if err != nil {
+		return "", err
+	}
+
 	_, err = hash.Write([]byte(spec.InputConnector))
 	if err != nil {
 		return "", err
@@ -525,11 +582,13 @@ func (r *ModelReconciler) updateStateClear(ctx context.Context, self *runtimev1.
 	mdl.State.RefreshedOn = nil
 	mdl.State.IncrementalState = nil
 	mdl.State.IncrementalStateSchema = nil
+	mdl.State.TestResults = nil
+	mdl.State.TestHash = ""
 
 	return r.C.UpdateState(ctx, self.Meta.Name, self)
 }
 
-// updateTriggerFalse sets the model's spec.Trigger to false.
+// updateTriggerFalse sets the model's spec.Trigger and spec.TriggerFull to false.
 // Unlike the State, the Spec may be edited concurrently with a Reconcile call, so we need to read and edit it under a lock.
 func (r *ModelReconciler) updateTriggerFalse(ctx context.Context, n *runtimev1.ResourceName) error {
 	r.C.Lock(ctx)
@@ -546,6 +605,7 @@ func (r *ModelReconciler) updateTriggerFalse(ctx context.Context, n *runtimev1.R
 	}
 
 	model.Spec.Trigger = false
+	model.Spec.TriggerFull = false
 	return r.C.UpdateSpec(ctx, self.Meta.Name, self)
 }
 
@@ -594,6 +654,310 @@ func (r *ModelReconciler) resolveIncrementalState(ctx context.Context, mdl *runt
 	return state, res.Schema, nil
 }
 
+// executeModel executes the model and returns the name of the connector that executed it and the result.
+// Passing a partition is optional. If a partition is provided, its data is available in the model's templated properties.
+// Passing testOutput is optional. If provided, it is passed to the executor to test the new output before it is promoted.
+// If the executor doesn't invoke it on a non-incremental run, testOutput is called on the executor's result instead and a failure fails the execution.
+func (r *ModelReconciler) executeModel(ctx context.Context, self *runtimev1.Resource, env *drivers.ModelEnv, prevResult *drivers.ModelResult, incrementalRun bool, incrementalState, partition map[string]any, testOutput func(ctx context.Context, table string) error) (string, *drivers.ModelResult, error) {
+	mdl := self.GetModel()
+
+	// Prepare the new execution options
+	inputProps, err := r.resolveTemplatedProps(ctx, self, incrementalState, partition, mdl.Spec.InputConnector, mdl.Spec.InputProperties.AsMap())
+	if err != nil {
+		return "", nil, err
+	}
+	outputProps, err := r.resolveTemplatedProps(ctx, self, incrementalState, partition, mdl.Spec.OutputConnector, mdl.Spec.OutputProperties.AsMap())
+	if err != nil {
+		return "", nil, err
+	}
+	var tested bool
+	var executorTestOutput func(ctx context.Context, table string) error
+	if testOutput != nil {
+		executorTestOutput = func(ctx context.Context, table string) error {
+			tested = true
+			return testOutput(ctx, table)
+		}
+	}
+	opts := &drivers.ModelExecutorOptions{
+		Env:              env,
+		ModelName:        self.Meta.Name.Name,
+		InputConnector:   mdl.Spec.InputConnector,
+		InputProperties:  inputProps,
+		OutputConnector:  mdl.Spec.OutputConnector,
+		OutputProperties: outputProps,
+		Incremental:      mdl.Spec.Incremental || mdl.Spec.PartitionsResolver != "",
+		IncrementalRun:   incrementalRun,
+		PreviousResult:   prevResult,
+		TestOutput:       executorTestOutput,
+		Progress:         &modelProgress{logger: r.C.Logger, model: self.Meta.Name.Name},
+	}
+
+	// Open executor for the new output
+	executorConnector, executor, release, err := r.acquireExecutor(ctx, opts)
+	if err != nil {
+		return "", nil, err
+	}
+	defer release()
+
+	// For safety, double check the ctx before executing the model (there may be some code paths where it's not checked)
+	if ctx.Err() != nil {
+		return "", nil, ctx.Err()
+	}
+
+	res, err := executor.Execute(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+
+	// Executors that can't stage the new output promote it without invoking TestOutput, which means the previous output has already been replaced.
+	// For full builds, we still test it here. If the tests don't pass, we drop the new output so a failing output is never served.
+	// Incremental runs are tested by the caller since the new data has already been merged into the previous output.
+	if testOutput != nil && !tested && !incrementalRun {
+		err = testOutput(ctx, res.Table)
+		if err != nil {
+			dropErr := r.dropOutput(ctx, res)
+			if dropErr != nil {
+				return "", nil, errors.Join(err, fmt.Errorf("failed to drop output that failed tests: %w", dropErr))
+			}
+			return "", nil, fmt.Errorf("%w (connector %q can't stage changes): %w", errModelOutputDropped, res.Connector, err)
+		}
+	}
+
+	return executorConnector, res, nil
+}
+
+// errModelOutputDropped is returned when a new output failed its tests after it had replaced the previous output, and was therefore dropped.
+var errModelOutputDropped = errors.New("the new output failed its tests and was dropped")
+
+// dropOutput deletes a model output using the model manager of its connector.
+func (r *ModelReconciler) dropOutput(ctx context.Context, res *drivers.ModelResult) error {
+	conn, release, err := r.C.AcquireConn(ctx, res.Connector)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	m, ok := conn.AsModelManager(r.C.InstanceID)
+	if !ok {
+		return fmt.Errorf("connector %q does not support model management", res.Connector)
+	}
+	return m.Delete(ctx, res)
+}
+
+// executePartitions executes a partitioned model.
+// It first syncs the partitions returned by the model's partitions resolver to the catalog, and then executes the pending and errored partitions in order.
+// If it's not an incremental run, the partitions are reset and the first partition is executed non-incrementally (replacing any previous output).
+// Errors that occur while executing individual partitions are recorded on the partition in the catalog and flagged by mdl.State.PartitionsHaveErrors.
+// They are only returned if they prevent the model from producing an output.
+func (r *ModelReconciler) executePartitions(ctx context.Context, self *runtimev1.Resource, env *drivers.ModelEnv, prevResult *drivers.ModelResult, incrementalRun bool, incrementalState map[string]any) (string, *drivers.ModelResult, error) {
+	mdl := self.GetModel()
+
+	catalog, release, err := r.C.Runtime.Catalog(ctx, r.C.InstanceID)
+	if err != nil {
+		return "", nil, err
+	}
+	defer release()
+
+	// On resets, we track the partitions under a new ID and delete the previous partitions.
+	// The new ID is persisted immediately to avoid leaking partitions if the execution fails.
+	if !incrementalRun || mdl.State.PartitionsModelId == "" {
+		incrementalRun = false
+		if mdl.State.PartitionsModelId != "" {
+			err = catalog.DeleteModelPartitions(ctx, mdl.State.PartitionsModelId)
+			if err != nil {
+				return "", nil, fmt.Errorf("failed to delete previous partitions: %w", err)
+			}
+		}
+		mdl.State.PartitionsModelId = uuid.NewString()
+		mdl.State.PartitionsHaveErrors = false
+		err = r.C.UpdateState(ctx, self.Meta.Name, self)
+		if err != nil {
+			return "", nil, err
+		}
+	}
+
+	err = r.syncPartitions(ctx, mdl, catalog)
+	if err != nil {
+		return "", nil, err
+	}
+
+	// Execute the pending and errored partitions in order.
+	// For incremental runs where no partitions need to be executed, the previous result is retained.
+	var executorConnector string
+	res := prevResult
+	if incrementalRun {
+		executorConnector = mdl.State.ExecutorConnector
+	}
+	opts := &drivers.FindModelPartitionsOptions{
+		ModelID:      mdl.State.PartitionsModelId,
+		WherePending: true,
+		WhereErrored: true,
+		Limit:        _modelPartitionsBatchSize,
+	}
+	for {
+		partitions, err := catalog.FindModelPartitions(ctx, opts)
+		if err != nil {
+			return "", nil, err
+		}
+		if len(partitions) == 0 {
+			break
+		}
+
+		for _, p := range partitions {
+			var data map[string]any
+			err := json.Unmarshal(p.DataJSON, &data)
+			if err != nil {
+				return "", nil, fmt.Errorf("failed to unmarshal partition data: %w", err)
+			}
+
+			incrementalState["incremental"] = incrementalRun
+			start := time.Now()
+			connector, partitionRes, execErr := r.executeModel(ctx, self, env, res, incrementalRun, incrementalState, data, nil)
+			if ctx.Err() != nil {
+				return "", nil, ctx.Err()
+			}
+
+			now := time.Now()
+			p.ExecutedOn = &now
+			p.Elapsed = time.Since(start)
+			p.Error = ""
+			if execErr != nil {
+				p.Error = execErr.Error()
+				r.C.Logger.Warn("failed to execute model partition", zap.String("model", self.Meta.Name.Name), zap.String("partition", p.Key), zap.Error(execErr))
+			}
+
+			err = catalog.UpdateModelPartition(ctx, mdl.State.PartitionsModelId, p)
+			if err != nil {
+				return "", nil, errors.Join(err, execErr)
+			}
+
+			if execErr != nil {
+				// The first partition of a reset creates the output, so we can't continue if it fails.
+				if !incrementalRun {
+					return "", nil, fmt.Errorf("failed to execute partition %q: %w", p.Key, execErr)
+				}
+				continue
+			}
+
+			executorConnector = connector
+			res = partitionRes
+			incrementalRun = true
+		}
+
+		last := partitions[len(partitions)-1]
+		opts.AfterIndex = last.Index
+		opts.AfterKey = last.Key
+	}
+
+	// If none of the partitions were executed on a reset, there is no output.
+	if !incrementalRun {
+		return "", nil, errors.New("the partitions resolver did not return any partitions")
+	}
+
+	errored, err := catalog.FindModelPartitions(ctx, &drivers.FindModelPartitionsOptions{
+		ModelID:      mdl.State.PartitionsModelId,
+		WhereErrored: true,
+		Limit:        1,
+	})
+	if err != nil {
+		return "", nil, err
+	}
+	mdl.State.PartitionsHaveErrors = len(errored) > 0
+
+	return executorConnector, res, nil
+}
+
+// syncPartitions resolves the model's partitions and syncs them to the catalog.
+// New partitions are inserted as pending. Existing partitions are marked pending if their watermark has increased.
+// Partitions that are no longer returned by the resolver are left untouched.
+func (r *ModelReconciler) syncPartitions(ctx context.Context, mdl *runtimev1.ModelV2, catalog drivers.CatalogStore) error {
+	res, err := r.C.Runtime.Resolve(ctx, &runtime.ResolveOptions{
+		InstanceID:         r.C.InstanceID,
+		Resolver:           mdl.Spec.PartitionsResolver,
+		ResolverProperties: mdl.Spec.PartitionsResolverProperties.AsMap(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to resolve partitions: %w", err)
+	}
+
+	var rows []map[string]any
+	err = json.Unmarshal(res.Data, &rows)
+	if err != nil {
+		return fmt.Errorf("partitions resolver produced invalid JSON: %w", err)
+	}
+
+	seen := make(map[string]bool, len(rows))
+	for i := 0; i < len(rows); i += _modelPartitionsBatchSize {
+		batch := rows[i:min(i+_modelPartitionsBatchSize, len(rows))]
+
+		var partitions []drivers.ModelPartition
+		var keys []string
+		for j, row := range batch {
+			p, err := newModelPartition(row, mdl.Spec.PartitionsWatermarkField)
+			if err != nil {
+				return err
+			}
+			if seen[p.Key] {
+				continue
+			}
+			seen[p.Key] = true
+
+			p.Index = i + j
+			partitions = append(partitions, p)
+			keys = append(keys, p.Key)
+		}
+
+		existing, err := catalog.FindModelPartitionsByKeys(ctx, mdl.State.PartitionsModelId, keys)
+		if err != nil {
+			return err
+		}
+		existingByKey := make(map[string]drivers.ModelPartition, len(existing))
+		for _, p := range existing {
+			existingByKey[p.Key] = p
+		}
+
+		for _, p := range partitions {
+			prev, ok := existingByKey[p.Key]
+			if !ok {
+				err = catalog.InsertModelPartition(ctx, mdl.State.PartitionsModelId, p)
+				if err != nil {
+					return err
+				}
+				continue
+			}
+
+			watermarkIncreased := p.Watermark != nil && (prev.Watermark == nil || p.Watermark.After(*prev.Watermark))
+			if !watermarkIncreased && prev.Index == p.Index {
+				continue
+			}
+
+			prev.Index = p.Index
+			if watermarkIncreased {
+				prev.DataJSON = p.DataJSON
+				prev.Watermark = p.Watermark
+				prev.ExecutedOn = nil
+			}
+			err = catalog.UpdateModelPartition(ctx, mdl.State.PartitionsModelId, prev)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// deletePartitions deletes the partitions tracked under the given partitions ID from the catalog.
+func (r *ModelReconciler) deletePartitions(ctx context.Context, partitionsModelID string) error {
+	catalog, release, err := r.C.Runtime.Catalog(ctx, r.C.InstanceID)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	return catalog.DeleteModelPartitions(ctx, partitionsModelID)
+}
+
 // acquireExecutor acquires a ModelExecutor capable of executing a model with the given execution options.
 // It handles acquiring and setting opts.InputHandle and opts.OutputHandle.
 func (r *ModelReconciler) acquireExecutor(ctx context.Context, opts *drivers.ModelExecutorOptions) (string, drivers.ModelExecutor, func(), error) {
@@ -667,7 +1031,8 @@ func (r *ModelReconciler) newModelEnv(ctx context.Context) (*drivers.ModelEnv, e
 
 // resolveTemplatedProps resolves template tags in strings nested in the provided props.
 // Passing a connector is optional. If a connector is provided, it will be used to inform how values are escaped.
-func (r *ModelReconciler) resolveTemplatedProps(ctx context.Context, self *runtimev1.Resource, incrementalState map[string]any, connector string, props map[string]any) (map[string]any, error) {
+// Passing a partition is optional. If a partition is provided, its data is available in templates as "partition".
+func (r *ModelReconciler) resolveTemplatedProps(ctx context.Context, self *runtimev1.Resource, incrementalState, partition map[string]any, connector string, props map[string]any) (map[string]any, error) {
 	inst, err := r.C.Runtime.Instance(ctx, r.C.InstanceID)
 	if err != nil {
 		return nil, err
@@ -700,6 +1065,9 @@ func (r *ModelReconciler) resolveTemplatedProps(ctx context.Context, self *runti
 			return dialect.EscapeIdentifier(ref.Name), nil
 		},
 	}
+	if partition != nil {
+		td.ExtraProps = map[string]any{"partition": partition}
+	}
 
 	val, err := resolveTemplatedValue(td, props)
 	if err != nil {
@@ -817,3 +1185,269 @@ func hashWriteMapOrdered(w io.Writer, m map[string]string) error {
 
 	return nil
 }
+
+// runTests runs the model's tests against the given table in the given connector.
+// It returns the result of each test and an error if any of the tests with severity "error" did not pass.
+func (r *ModelReconciler) runTests(ctx context.Context, self *runtimev1.Resource, connector, table string) ([]*runtimev1.ModelTestResult, error) {
+	mdl := self.GetModel()
+	if len(mdl.Spec.Tests) == 0 {
+		return nil, nil
+	}
+
+	olap, release, err := r.C.AcquireOLAP(ctx, connector)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	inst, err := r.C.Runtime.Instance(ctx, r.C.InstanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*runtimev1.ModelTestResult, len(mdl.Spec.Tests))
+	for i, test := range mdl.Spec.Tests {
+		res := &runtimev1.ModelTestResult{
+			Name:     test.Name,
+			Severity: test.Severity,
+		}
+		results[i] = res
+
+		query, args, err := modelTestSQL(olap.Dialect(), test, table, func(sql string) (string, error) {
+			return compilerv1.ResolveTemplate(sql, compilerv1.TemplateData{
+				Environment: inst.Environment,
+				User:        map[string]any{},
+				Variables:   inst.ResolveVariables(),
+				ExtraProps: map[string]any{
+					"table": olap.Dialect().EscapeIdentifier(table),
+				},
+				Self: compilerv1.TemplateResource{
+					Meta:  self.Meta,
+					Spec:  mdl.Spec,
+					State: mdl.State,
+				},
+				Resolve: func(ref compilerv1.ResourceName) (string, error) {
+					// References to the model itself resolve to the table under test (which may be a staging table)
+					if ref.Name == self.Meta.Name.Name {
+						return olap.Dialect().EscapeIdentifier(table), nil
+					}
+					return olap.Dialect().EscapeIdentifier(ref.Name), nil
+				},
+			})
+		})
+		if err != nil {
+			res.Status = runtimev1.AssertionStatus_ASSERTION_STATUS_ERROR
+			res.ErrorMessage = err.Error()
+			continue
+		}
+
+		n, err := queryModelTestCount(ctx, olap, query, args)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			res.Status = runtimev1.AssertionStatus_ASSERTION_STATUS_ERROR
+			res.ErrorMessage = err.Error()
+			continue
+		}
+
+		res.Status = runtimev1.AssertionStatus_ASSERTION_STATUS_PASS
+		if test.Type == runtimev1.ModelTest_TYPE_ROW_COUNT {
+			res.FailedRows = n
+			if test.MinRows != nil && n < *test.MinRows || test.MaxRows != nil && n > *test.MaxRows {
+				res.Status = runtimev1.AssertionStatus_ASSERTION_STATUS_FAIL
+			} else {
+				res.FailedRows = 0
+			}
+		} else if n > 0 {
+			res.Status = runtimev1.AssertionStatus_ASSERTION_STATUS_FAIL
+			res.FailedRows = n
+		}
+
+		if res.Status != runtimev1.AssertionStatus_ASSERTION_STATUS_PASS {
+			r.C.Logger.Warn("model test did not pass", zap.String("model", self.Meta.Name.Name), zap.String("test", test.Name), zap.String("status", res.Status.String()), zap.Uint64("failed_rows", res.FailedRows), zap.String("error", res.ErrorMessage))
+		}
+	}
+
+	return results, modelTestsError(results)
+}
+
+// modelTestsError returns an error describing the tests with severity "error" that did not pass.
+// It returns nil if all such tests passed.
+func modelTestsError(results []*runtimev1.ModelTestResult) error {
+	var errs []error
+	for _, res := range results {
+		if res.Severity == runtimev1.ModelTest_SEVERITY_WARN {
+			continue
+		}
+		switch res.Status {
+		case runtimev1.AssertionStatus_ASSERTION_STATUS_FAIL:
+			errs = append(errs, fmt.Errorf("model test %q failed (%d failing rows)", res.Name, res.FailedRows))
+		case runtimev1.AssertionStatus_ASSERTION_STATUS_ERROR:
+			errs = append(errs, fmt.Errorf("model test %q errored: %s", res.Name, res.ErrorMessage))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// modelTestSQL builds a query that returns a single count for the given test.
+// For row_count tests, it returns the number of rows in the table. For all other tests, it returns the number of rows that violate the assertion.
+// The resolveTemplate function is used to resolve templating in the SQL of "sql" tests.
+func modelTestSQL(dialect drivers.Dialect, test *runtimev1.ModelTest, table string, resolveTemplate func(sql string) (string, error)) (string, []any, error) {
+	from := dialect.EscapeIdentifier(table)
+	col := dialect.EscapeIdentifier(test.Column)
+	switch test.Type {
+	case runtimev1.ModelTest_TYPE_NOT_NULL:
+		return fmt.Sprintf("SELECT count(*) FROM %s WHERE %s IS NULL", from, col), nil, nil
+	case runtimev1.ModelTest_TYPE_UNIQUE:
+		return fmt.Sprintf("SELECT count(*) FROM (SELECT %s FROM %s WHERE %s IS NOT NULL GROUP BY %s HAVING count(*) > 1) t", col, from, col, col), nil, nil
+	case runtimev1.ModelTest_TYPE_ACCEPTED_VALUES:
+		if len(test.AcceptedValues) == 0 {
+			return "", nil, errors.New("no accepted values provided")
+		}
+		args := make([]any, len(test.AcceptedValues))
+		for i, v := range test.AcceptedValues {
+			args[i] = v.AsInterface()
+		}
+		placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(args)), ", ")
+		return fmt.Sprintf("SELECT count(*) FROM %s WHERE %s IS NOT NULL AND %s NOT IN (%s)", from, col, col, placeholders), args, nil
+	case runtimev1.ModelTest_TYPE_ROW_COUNT:
+		return fmt.Sprintf("SELECT count(*) FROM %s", from), nil, nil
+	case runtimev1.ModelTest_TYPE_SQL:
+		sql, err := resolveTemplate(test.Sql)
+		if err != nil {
+			return "", nil, fmt.Errorf("failed to resolve template: %w", err)
+		}
+		return fmt.Sprintf("SELECT count(*) FROM (%s) t", sql), nil, nil
+	default:
+		return "", nil, fmt.Errorf("unsupported test type %q", test.Type.String())
+	}
+}
+
+// queryModelTestCount runs a query that returns a single count value.
+func queryModelTestCount(ctx context.Context, olap drivers.OLAPStore, query string, args []any) (uint64, error) {
+	res, err := olap.Execute(ctx, &drivers.Statement{
+		Query:    query,
+		Args:     args,
+		Priority: _modelTestQueryPriority,
+	})
+	if err != nil {
+		return 0, err
+	}
+	defer res.Close()
+
+	var n int64
+	if res.Next() {
+		err = res.Scan(&n)
+		if err != nil {
+			return 0, err
+		}
+	}
+	if err := res.Err(); err != nil {
+		return 0, err
+	}
+	if n < 0 {
+		return 0, fmt.Errorf("unexpected negative count %d", n)
+	}
+	return uint64(n), nil
+}
+
+// modelTestHash computes a hash of the model's tests.
+// It is used to detect when the tests have changed and need to be re-run against the current output.
+// It returns an empty string if the model has no tests.
+func modelTestHash(tests []*runtimev1.ModelTest) (string, error) {
+	if len(tests) == 0 {
+		return "", nil
+	}
+
+	hash := md5.New()
+	for _, t := range tests {
+		b, err := proto.MarshalOptions{Deterministic: true}.Marshal(t)
+		if err != nil {
+			return "", err
+		}
+		_, err = hash.Write(b)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// newModelPartition creates a model partition from a row returned by a partitions resolver.
+// The partition's key is a hash of the row's data, excluding the watermark field (if any).
+func newModelPartition(row map[string]any, watermarkField string) (drivers.ModelPartition, error) {
+	dataJSON, err := json.Marshal(row)
+	if err != nil {
+		return drivers.ModelPartition{}, fmt.Errorf("failed to marshal partition data: %w", err)
+	}
+
+	var watermark *time.Time
+	keyJSON := dataJSON
+	if watermarkField != "" {
+		v, ok := row[watermarkField]
+		if !ok {
+			return drivers.ModelPartition{}, fmt.Errorf("partition is missing the watermark field %q", watermarkField)
+		}
+		s, ok := v.(string)
+		if !ok {
+			return drivers.ModelPartition{}, fmt.Errorf("watermark field %q is not a timestamp", watermarkField)
+		}
+		t, err := time.Parse(time.RFC3339Nano, s)
+		if err != nil {
+			return drivers.ModelPartition{}, fmt.Errorf("watermark field %q is not a valid timestamp: %w", watermarkField, err)
+		}
+		watermark = &t
+
+		tmp := make(map[string]any, len(row))
+		for k, v := range row {
+			if k != watermarkField {
+				tmp[k] = v
+			}
+		}
+		keyJSON, err = json.Marshal(tmp)
+		if err != nil {
+			return drivers.ModelPartition{}, fmt.Errorf("failed to marshal partition data: %w", err)
+		}
+	}
+
+	hash := md5.Sum(keyJSON)
+	return drivers.ModelPartition{
+		Key:       hex.EncodeToString(hash[:]),
+		DataJSON:  dataJSON,
+		Watermark: watermark,
+	}, nil
+}
+
+// modelPartitionsError returns an error if the model has partitions that failed to execute.
+func modelPartitionsError(state *runtimev1.ModelState) error {
+	if !state.PartitionsHaveErrors {
+		return nil
+	}
+	return errors.New("some partitions failed to execute (see the model's partitions for details)")
+}
+
+// modelProgress is a drivers.Progress that logs the progress reported by a model executor.
+type modelProgress struct {
+	logger   *zap.Logger
+	model    string
+	mu       sync.Mutex
+	target   int64
+	observed int64
+}
+
+var _ drivers.Progress = (*modelProgress)(nil)
+
+func (p *modelProgress) Target(val int64, unit drivers.ProgressUnit) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.target = val
+}
+
+func (p *modelProgress) Observe(val int64, unit drivers.ProgressUnit) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.observed += val
+	p.logger.Debug("Model build progress", zap.String("model", p.model), zap.Int64("observed", p.observed), zap.Int64("target", p.target), zap.Stringer("unit", unit))
+}
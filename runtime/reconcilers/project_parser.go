@@ -513,10 +513,20 @@ func (r *ProjectParserReconciler) putParserResourceDef(ctx context.Context, inst
 			res = &runtimev1.Resource{Resource: &runtimev1.Resource_Migration{Migration: &runtimev1.Migration{Spec: def.MigrationSpec}}}
 		}
 	case compilerv1.ResourceKindReport:
+		// A pending backfill is set by refresh triggers, not the parser, so it's preserved when the report is re-parsed
+		if existing != nil && existing.GetReport() != nil {
+			def.ReportSpec.BackfillFrom = existing.GetReport().Spec.BackfillFrom
+			def.ReportSpec.BackfillTo = existing.GetReport().Spec.BackfillTo
+		}
 		if existing == nil || !equalReportSpec(existing.GetReport().Spec, def.ReportSpec) {
 			res = &runtimev1.Resource{Resource: &runtimev1.Resource_Report{Report: &runtimev1.Report{Spec: def.ReportSpec}}}
 		}
 	case compilerv1.ResourceKindAlert:
+		// See note for reports above
+		if existing != nil && existing.GetAlert() != nil {
+			def.AlertSpec.BackfillFrom = existing.GetAlert().Spec.BackfillFrom
+			def.AlertSpec.BackfillTo = existing.GetAlert().Spec.BackfillTo
+		}
 		if existing == nil || !equalAlertSpec(existing.GetAlert().Spec, def.AlertSpec) {
 			res = &runtimev1.Resource{Resource: &runtimev1.Resource_Alert{Alert: &runtimev1.Alert{Spec: def.AlertSpec}}}
 		}
@@ -653,6 +663,10 @@ func applySpecDefaults(inst *drivers.Instance, def *compilerv1.Resource) (*compi
 	switch def.Name.Kind {
 	case compilerv1.ResourceKindSource:
 		def.SourceSpec.StageChanges = cfg.StageChanges
+		def.SourceSpec.StreamIngestion = false
+		if d, ok := drivers.Drivers[connectorDriver(inst, def.SourceSpec.SourceConnector)]; ok {
+			def.SourceSpec.StreamIngestion = d.Spec().ImplementsStreamStore
+		}
 	default:
 		// Nothing to do
 	}
@@ -660,6 +674,28 @@ func applySpecDefaults(inst *drivers.Instance, def *compilerv1.Resource) (*compi
 	return def, nil
 }
 
+// connectorDriver returns the name of the driver used by a connector.
+// It mirrors the lookup in runtime.ConnectorConfig, but doesn't resolve the connector's config.
+func connectorDriver(inst *drivers.Instance, name string) string {
+	driver := ""
+	for _, c := range inst.Connectors {
+		if c.Name == name {
+			driver = c.Type
+			break
+		}
+	}
+	for _, c := range inst.ProjectConnectors {
+		if c.Name == name {
+			driver = c.Type
+			break
+		}
+	}
+	if driver == "" {
+		driver = name
+	}
+	return driver
+}
+
 func equalResourceName(a, b *runtimev1.ResourceName) bool {
 	return a != nil && b != nil && a.Kind == b.Kind && strings.EqualFold(a.Name, b.Name)
 }
@@ -8,6 +8,8 @@ import (
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/drivers"
+	"go.uber.org/zap"
 )
 
 func init() {
@@ -16,6 +18,8 @@ func init() {
 
 // RefreshTriggerReconciler reconciles a RefreshTrigger.
 // When a RefreshTrigger is created, the reconciler will refresh source and model by setting Trigger=true in their specs.
+// For models targeted by a model trigger, it may also request a full refresh or mark specific partitions for re-execution.
+// For reports and alerts targeted by a backfill trigger, it sets the time range to backfill in their specs.
 // After that, it will delete the RefreshTrigger resource.
 type RefreshTriggerReconciler struct {
 	C *runtime.Controller
@@ -76,6 +80,9 @@ func (r *RefreshTriggerReconciler) Reconcile(ctx context.Context, n *runtimev1.R
 		return runtime.ReconcileResult{Err: err}
 	}
 
+	// If neither OnlyNames, Models nor Backfills are specified, all sources and models are refreshed.
+	all := len(trigger.Spec.OnlyNames) == 0 && len(trigger.Spec.Models) == 0 && len(trigger.Spec.Backfills) == 0
+
 	for _, res := range resources {
 		// Check sources and models always; also check reports and alerts if OnlyNames is not empty (i.e. explicitly specified).
 		switch res.Meta.Name.Kind {
@@ -91,8 +98,26 @@ func (r *RefreshTriggerReconciler) Reconcile(ctx context.Context, n *runtimev1.R
 			continue
 		}
 
+		// Apply model triggers
+		var modelTrigger *runtimev1.RefreshModelTrigger
+		if res.Meta.Name.Kind == runtime.ResourceKindModel {
+			for _, mt := range trigger.Spec.Models {
+				if strings.EqualFold(mt.Model, res.Meta.Name.Name) {
+					modelTrigger = mt
+					break
+				}
+			}
+		}
+		if modelTrigger != nil {
+			err = r.applyModelTrigger(ctx, res, modelTrigger)
+			if err != nil {
+				return runtime.ReconcileResult{Err: err}
+			}
+			continue
+		}
+
 		// Check if it's in OnlyNames
-		if len(trigger.Spec.OnlyNames) > 0 {
+		if !all {
 			found := false
 			for _, n := range trigger.Spec.OnlyNames {
 				if strings.EqualFold(n.Name, res.Meta.Name.Name) {
@@ -134,6 +159,14 @@ func (r *RefreshTriggerReconciler) Reconcile(ctx context.Context, n *runtimev1.R
 		}
 	}
 
+	// Apply backfill triggers
+	for _, bt := range trigger.Spec.Backfills {
+		err = r.applyBackfillTrigger(ctx, bt)
+		if err != nil {
+			return runtime.ReconcileResult{Err: err}
+		}
+	}
+
 	err = r.C.Delete(ctx, n)
 	if err != nil {
 		return runtime.ReconcileResult{Err: err}
@@ -141,3 +174,77 @@ func (r *RefreshTriggerReconciler) Reconcile(ctx context.Context, n *runtimev1.R
 
 	return runtime.ReconcileResult{}
 }
+
+// applyModelTrigger applies a model trigger to a model resource.
+// It marks the requested partitions as pending in the catalog and sets Trigger=true (or TriggerFull=true) in the model's spec.
+func (r *RefreshTriggerReconciler) applyModelTrigger(ctx context.Context, res *runtimev1.Resource, mt *runtimev1.RefreshModelTrigger) error {
+	model := res.GetModel()
+
+	if mt.Full {
+		model.Spec.TriggerFull = true
+		return r.C.UpdateSpec(ctx, res.Meta.Name, res)
+	}
+
+	// Mark the requested partitions as pending.
+	// Partitions that errored are always retried when the model is triggered, so AllErroredPartitions doesn't need to mark anything.
+	if len(mt.Partitions) > 0 {
+		if model.State.PartitionsModelId == "" {
+			return fmt.Errorf("can not refresh partitions for model %q because it does not have any partitions", res.Meta.Name.Name)
+		}
+
+		catalog, release, err := r.C.Runtime.Catalog(ctx, r.C.InstanceID)
+		if err != nil {
+			return err
+		}
+		defer release()
+
+		partitions, err := catalog.FindModelPartitionsByKeys(ctx, model.State.PartitionsModelId, mt.Partitions)
+		if err != nil {
+			return err
+		}
+		if len(partitions) != len(mt.Partitions) {
+			r.C.Logger.Warn("refresh trigger: some partitions were not found", zap.String("model", res.Meta.Name.Name), zap.Int("requested", len(mt.Partitions)), zap.Int("found", len(partitions)))
+		}
+
+		for _, p := range partitions {
+			p.ExecutedOn = nil
+			err = catalog.UpdateModelPartition(ctx, model.State.PartitionsModelId, p)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	model.Spec.Trigger = true
+	return r.C.UpdateSpec(ctx, res.Meta.Name, res)
+}
+
+// applyBackfillTrigger sets the time range to backfill in the spec of a report or alert.
+// If the resource already has a pending backfill, the pending range is extended to also cover the new range.
+func (r *RefreshTriggerReconciler) applyBackfillTrigger(ctx context.Context, bt *runtimev1.RefreshBackfillTrigger) error {
+	if bt.Name == nil || bt.From == nil || bt.To == nil {
+		return errors.New("backfill trigger must have a name and a time range")
+	}
+
+	res, err := r.C.Get(ctx, bt.Name, true)
+	if err != nil {
+		if errors.Is(err, drivers.ErrResourceNotFound) {
+			r.C.Logger.Warn("refresh trigger: resource to backfill not found", zap.String("kind", bt.Name.Kind), zap.String("name", bt.Name.Name))
+			return nil
+		}
+		return err
+	}
+
+	switch res.Meta.Name.Kind {
+	case runtime.ResourceKindReport:
+		spec := res.GetReport().Spec
+		spec.BackfillFrom, spec.BackfillTo = mergeBackfillRange(spec.BackfillFrom, spec.BackfillTo, bt.From.AsTime(), bt.To.AsTime())
+	case runtime.ResourceKindAlert:
+		spec := res.GetAlert().Spec
+		spec.BackfillFrom, spec.BackfillTo = mergeBackfillRange(spec.BackfillFrom, spec.BackfillTo, bt.From.AsTime(), bt.To.AsTime())
+	default:
+		return fmt.Errorf("can not backfill resource %q of kind %q", res.Meta.Name.Name, res.Meta.Name.Kind)
+	}
+
+	return r.C.UpdateSpec(ctx, res.Meta.Name, res)
+}
@@ -12,13 +12,12 @@ import (
 	"github.com/rilldata/rill/runtime"
 	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/rilldata/rill/runtime/pkg/duration"
-	"github.com/rilldata/rill/runtime/pkg/email"
-	"github.com/rilldata/rill/runtime/pkg/pbutil"
 	"github.com/rilldata/rill/runtime/queries"
 	"github.com/rilldata/rill/runtime/server"
 	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
 	"golang.org/x/exp/slices"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -100,6 +99,22 @@ func (r *ReportReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceN
 		}
 	}
 
+	// Run a requested backfill before handling the regular schedule.
+	// Since clearing the backfill from the spec doesn't retrigger the reconciler, we retrigger explicitly to continue with the regular schedule.
+	if rep.Spec.BackfillFrom != nil && rep.Spec.BackfillTo != nil {
+		backfillErr := r.executeBackfill(ctx, self, rep)
+		if errors.Is(backfillErr, context.Canceled) {
+			return runtime.ReconcileResult{Err: backfillErr}
+		}
+
+		err = r.clearBackfill(ctx, n, rep.Spec.BackfillFrom, rep.Spec.BackfillTo)
+		if err != nil {
+			return runtime.ReconcileResult{Err: fmt.Errorf("failed to clear backfill: %w", err)}
+		}
+
+		return runtime.ReconcileResult{Err: backfillErr, Retrigger: time.Now()}
+	}
+
 	// Exit early if disabled
 	if rep.Spec.RefreshSchedule != nil && rep.Spec.RefreshSchedule.Disable {
 		return runtime.ReconcileResult{}
@@ -197,6 +212,11 @@ func (r *ReportReconciler) popCurrentExecution(ctx context.Context, self *runtim
 	rep.State.CurrentExecution = nil
 
 	if len(rep.State.ExecutionHistory) > reportExecutionHistoryLimit {
+		// A backfill may add many executions at once. Retain the latest regular execution since its report time is the previous watermark for the next regular execution.
+		latest := slices.IndexFunc(rep.State.ExecutionHistory, func(e *runtimev1.ReportExecution) bool { return !e.Backfill && e.ReportTime != nil })
+		if latest >= reportExecutionHistoryLimit {
+			rep.State.ExecutionHistory[reportExecutionHistoryLimit-1] = rep.State.ExecutionHistory[latest]
+		}
 		rep.State.ExecutionHistory = rep.State.ExecutionHistory[:reportExecutionHistoryLimit]
 	}
 
@@ -223,6 +243,69 @@ func (r *ReportReconciler) setTriggerFalse(ctx context.Context, n *runtimev1.Res
 	return r.C.UpdateSpec(ctx, self.Meta.Name, self)
 }
 
+// clearBackfill clears the backfill range from the report's spec.
+// If the range was extended by another refresh trigger while the backfill was running, it is left for the next invocation.
+func (r *ReportReconciler) clearBackfill(ctx context.Context, n *runtimev1.ResourceName, from, to *timestamppb.Timestamp) error {
+	r.C.Lock(ctx)
+	defer r.C.Unlock(ctx)
+
+	self, err := r.C.Get(ctx, n, false)
+	if err != nil {
+		return err
+	}
+
+	rep := self.GetReport()
+	if rep == nil {
+		return fmt.Errorf("not a report")
+	}
+
+	if !proto.Equal(rep.Spec.BackfillFrom, from) || !proto.Equal(rep.Spec.BackfillTo, to) {
+		return nil
+	}
+
+	rep.Spec.BackfillFrom = nil
+	rep.Spec.BackfillTo = nil
+	return r.C.UpdateSpec(ctx, self.Meta.Name, self)
+}
+
+// advanceBackfill moves the start of the backfill range in the report's spec past an execution time that has completed,
+// so a backfill that is interrupted and retried doesn't send the report for it again.
+// It also updates rep.Spec, which is used to clear the backfill when it completes.
+func (r *ReportReconciler) advanceBackfill(ctx context.Context, n *runtimev1.ResourceName, rep *runtimev1.Report, t time.Time) error {
+	r.C.Lock(ctx)
+	defer r.C.Unlock(ctx)
+
+	self, err := r.C.Get(ctx, n, false)
+	if err != nil {
+		return err
+	}
+
+	cur := self.GetReport()
+	if cur == nil {
+		return fmt.Errorf("not a report")
+	}
+
+	// If the range was extended by another refresh trigger, it's run again from its new start on the next invocation
+	if !proto.Equal(cur.Spec.BackfillFrom, rep.Spec.BackfillFrom) || !proto.Equal(cur.Spec.BackfillTo, rep.Spec.BackfillTo) {
+		return nil
+	}
+
+	if t.Before(cur.Spec.BackfillTo.AsTime()) {
+		cur.Spec.BackfillFrom = timestamppb.New(t)
+	} else {
+		cur.Spec.BackfillFrom = nil
+		cur.Spec.BackfillTo = nil
+	}
+	err = r.C.UpdateSpec(ctx, self.Meta.Name, self)
+	if err != nil {
+		return err
+	}
+
+	rep.Spec.BackfillFrom = cur.Spec.BackfillFrom
+	rep.Spec.BackfillTo = cur.Spec.BackfillTo
+	return nil
+}
+
 // executeAll runs queries and sends reports. It also adds entries to rep.State.ExecutionHistory.
 // By default, a report is checked once for the current watermark, but if rep.Spec.IntervalsIsoDuration is set, it will be checked *for each* interval that has elapsed since the previous execution watermark.
 func (r *ReportReconciler) executeAll(ctx context.Context, self *runtimev1.Resource, rep *runtimev1.Report, triggerTime time.Time, adhocTrigger bool) (bool, error) {
@@ -294,10 +377,10 @@ func (r *ReportReconciler) executeAllWrapped(ctx context.Context, self *runtimev
 		// If !ok, no watermark could be computed. So we'll just stick to triggerTime.
 	}
 
-	// Evaluate previous watermark (if any)
+	// Evaluate previous watermark (if any). Backfills are ignored since they run for historical times.
 	var previousWatermark time.Time
 	for _, e := range rep.State.ExecutionHistory {
-		if e.ReportTime != nil {
+		if e.ReportTime != nil && !e.Backfill {
 			previousWatermark = e.ReportTime.AsTime()
 			break
 		}
@@ -318,7 +401,7 @@ func (r *ReportReconciler) executeAllWrapped(ctx context.Context, self *runtimev
 
 	// Evaluate report for each execution time
 	for _, t := range ts {
-		retry, err := r.executeSingle(ctx, self, rep, t, adhocTrigger)
+		retry, err := r.executeSingle(ctx, self, rep, t, adhocTrigger, false)
 		if err != nil {
 			return retry, err
 		}
@@ -328,12 +411,13 @@ func (r *ReportReconciler) executeAllWrapped(ctx context.Context, self *runtimev
 }
 
 // executeSingle runs the report query and sends notifications for a single execution time.
-func (r *ReportReconciler) executeSingle(ctx context.Context, self *runtimev1.Resource, rep *runtimev1.Report, executionTime time.Time, adhocTrigger bool) (bool, error) {
+func (r *ReportReconciler) executeSingle(ctx context.Context, self *runtimev1.Resource, rep *runtimev1.Report, executionTime time.Time, adhocTrigger, backfill bool) (bool, error) {
 	// Create new execution and save in State.CurrentExecution
 	rep.State.CurrentExecution = &runtimev1.ReportExecution{
 		Adhoc:      adhocTrigger,
 		ReportTime: timestamppb.New(executionTime),
 		StartedOn:  timestamppb.Now(),
+		Backfill:   backfill,
 	}
 	err := r.C.UpdateState(ctx, self.Meta.Name, self)
 	if err != nil {
@@ -350,6 +434,8 @@ func (r *ReportReconciler) executeSingle(ctx context.Context, self *runtimev1.Re
 		if errors.Is(reportErr, context.Canceled) || errors.Is(reportErr, context.DeadlineExceeded) {
 			if dirtyErr {
 				rep.State.CurrentExecution.ErrorMessage = "Report run was interrupted after some notifications were sent. The report will not automatically retry."
+			} else if backfill {
+				rep.State.CurrentExecution.ErrorMessage = "Report run was interrupted. Backfilled report runs do not automatically retry."
 			} else {
 				retry = true
 				rep.State.CurrentExecution.ErrorMessage = "Report run was interrupted. It will automatically retry."
@@ -375,6 +461,69 @@ func (r *ReportReconciler) executeSingle(ctx context.Context, self *runtimev1.Re
 	return retry, reportErr
 }
 
+// executeBackfill runs the report for each execution time in the backfill range of the report's spec.
+// Each execution is subject to the report's timeout. Failed executions are recorded in the execution history and do not stop the backfill.
+func (r *ReportReconciler) executeBackfill(ctx context.Context, self *runtimev1.Resource, rep *runtimev1.Report) error {
+	from, to := rep.Spec.BackfillFrom.AsTime(), rep.Spec.BackfillTo.AsTime()
+	ts, err := calculateBackfillExecutionTimes(rep.Spec.IntervalsIsoDuration, rep.Spec.RefreshSchedule, from, to)
+	if err == nil {
+		err = checkRefs(ctx, r.C, self.Meta.Refs)
+	}
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			return err
+		}
+		rep.State.CurrentExecution = &runtimev1.ReportExecution{
+			ErrorMessage: fmt.Sprintf("Report backfill failed: %v", err),
+			StartedOn:    timestamppb.Now(),
+			FinishedOn:   timestamppb.Now(),
+			Backfill:     true,
+		}
+		popErr := r.popCurrentExecution(ctx, self, rep)
+		if popErr != nil {
+			return popErr
+		}
+		return fmt.Errorf("report backfill failed: %w", err)
+	}
+
+	r.C.Logger.Info("Backfilling report", zap.String("report", self.Meta.Name.Name), zap.Time("from", from), zap.Time("to", to), zap.Int("executions", len(ts)))
+
+	timeout := reportCheckDefaultTimeout
+	if rep.Spec.TimeoutSeconds > 0 {
+		timeout = time.Duration(rep.Spec.TimeoutSeconds) * time.Second
+	}
+
+	var failed int
+	var lastErr error
+	for _, t := range ts {
+		err := func() error {
+			ctx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+			_, err := r.executeSingle(ctx, self, rep, t, false, true)
+			return err
+		}()
+		if ctx.Err() != nil {
+			// The interrupted run has been added to the execution history and is not retried, so skip it when the backfill is retried
+			advanceErr := r.advanceBackfill(context.WithoutCancel(ctx), self.Meta.Name, rep, t)
+			return errors.Join(ctx.Err(), advanceErr)
+		}
+		if err != nil {
+			failed++
+			lastErr = err
+		}
+
+		err = r.advanceBackfill(ctx, self.Meta.Name, rep, t)
+		if err != nil {
+			return err
+		}
+	}
+
+	if lastErr != nil {
+		return fmt.Errorf("%d of %d backfilled report runs failed: %w", failed, len(ts), lastErr)
+	}
+	return nil
+}
+
 // sendReport composes and sends the actual report to the configured recipients.
 // It returns true if an error occurred after some or all notifications were sent.
 func (r *ReportReconciler) sendReport(ctx context.Context, self *runtimev1.Resource, rep *runtimev1.Report, t time.Time) (bool, error) {
@@ -420,66 +569,41 @@ func (r *ReportReconciler) sendReport(ctx context.Context, self *runtimev1.Resou
 
 	sent := false
 	for _, notifier := range rep.Spec.Notifiers {
-		switch notifier.Connector {
-		case "email":
-			recipients := pbutil.ToSliceString(notifier.Properties.AsMap()["recipients"])
-			for _, recipient := range recipients {
-				err := r.C.Runtime.Email.SendScheduledReport(&email.ScheduledReport{
-					ToEmail:        recipient,
-					ToName:         "",
-					Title:          rep.Spec.Title,
-					ReportTime:     t,
-					DownloadFormat: formatExportFormat(rep.Spec.ExportFormat),
-					OpenLink:       meta.OpenURL,
-					DownloadLink:   exportURL.String(),
-					EditLink:       meta.EditURL,
-				})
-				sent = true
-				if err != nil {
-					return true, fmt.Errorf("failed to generate report for %q: %w", recipient, err)
-				}
+		err := func() (outErr error) {
+			n, release, err := r.C.Runtime.Notifier(ctx, r.C.InstanceID, notifier.Connector, notifier.Properties.AsMap())
+			if err != nil {
+				return err
 			}
-		default:
-			err := func() (outErr error) {
-				conn, release, err := r.C.Runtime.AcquireHandle(ctx, r.C.InstanceID, notifier.Connector)
-				if err != nil {
-					return err
-				}
-				defer release()
-				n, err := conn.AsNotifier(notifier.Properties.AsMap())
-				if err != nil {
-					return err
-				}
-				msg := &drivers.ScheduledReport{
-					Title:          rep.Spec.Title,
-					ReportTime:     t,
-					DownloadFormat: formatExportFormat(rep.Spec.ExportFormat),
-					OpenLink:       meta.OpenURL,
-					DownloadLink:   exportURL.String(),
-					EditLink:       meta.EditURL,
-				}
-				start := time.Now()
-				defer func() {
-					totalLatency := time.Since(start).Milliseconds()
-
-					if r.C.Activity != nil {
-						r.C.Activity.RecordMetric(ctx, "notifier_total_latency_ms", float64(totalLatency),
-							attribute.Bool("failed", outErr != nil),
-							attribute.String("connector", notifier.Connector),
-							attribute.String("notification_type", "scheduled_report"),
-						)
-					}
-				}()
-				err = n.SendScheduledReport(msg)
-				sent = true
-				if err != nil {
-					return fmt.Errorf("failed to send %s notification: %w", notifier.Connector, err)
+			defer release()
+			msg := &drivers.ScheduledReport{
+				Title:          rep.Spec.Title,
+				ReportTime:     t,
+				DownloadFormat: formatExportFormat(rep.Spec.ExportFormat),
+				OpenLink:       meta.OpenURL,
+				DownloadLink:   exportURL.String(),
+				EditLink:       meta.EditURL,
+			}
+			start := time.Now()
+			defer func() {
+				totalLatency := time.Since(start).Milliseconds()
+
+				if r.C.Activity != nil {
+					r.C.Activity.RecordMetric(ctx, "notifier_total_latency_ms", float64(totalLatency),
+						attribute.Bool("failed", outErr != nil),
+						attribute.String("connector", notifier.Connector),
+						attribute.String("notification_type", "scheduled_report"),
+					)
 				}
-				return nil
 			}()
+			err = n.SendScheduledReport(msg)
+			sent = true
 			if err != nil {
-				return sent, err
+				return fmt.Errorf("failed to send %s notification: %w", notifier.Connector, err)
 			}
+			return nil
+		}()
+		if err != nil {
+			return sent, err
 		}
 	}
 
@@ -494,6 +618,10 @@ func formatExportFormat(f runtimev1.ExportFormat) string {
 		return "Excel"
 	case runtimev1.ExportFormat_EXPORT_FORMAT_PARQUET:
 		return "Parquet"
+	case runtimev1.ExportFormat_EXPORT_FORMAT_JSONL:
+		return "JSON Lines"
+	case runtimev1.ExportFormat_EXPORT_FORMAT_ARROW:
+		return "Arrow"
 	default:
 		return f.String()
 	}
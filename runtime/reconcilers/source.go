@@ -22,6 +22,9 @@ import (
 
 const _defaultIngestTimeout = 60 * time.Minute
 
+// _defaultStreamIngestInterval is the default interval between micro-batches for sources that ingest from a stream.
+const _defaultStreamIngestInterval = 60 * time.Second
+
 func init() {
 	runtime.RegisterReconcilerInitializer(runtime.ResourceKindSource, newSourceReconciler)
 }
@@ -120,6 +123,7 @@ func (r *SourceReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceN
 			src.State.Table = ""
 			src.State.SpecHash = ""
 			src.State.RefreshedOn = nil
+			src.State.StreamOffsets = nil
 			err = r.C.UpdateState(ctx, self.Meta.Name, self)
 			if err != nil {
 				r.C.Logger.Error("refs check: failed to update state", zap.Any("error", err))
@@ -140,9 +144,10 @@ func (r *SourceReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceN
 	}
 
 	// Compute next time to refresh based on the RefreshSchedule (if any)
+	schedule := r.refreshSchedule(src.Spec)
 	var refreshOn time.Time
 	if src.State.RefreshedOn != nil {
-		refreshOn, err = nextRefreshTime(src.State.RefreshedOn.AsTime(), src.Spec.RefreshSchedule)
+		refreshOn, err = nextRefreshTime(src.State.RefreshedOn.AsTime(), schedule)
 		if err != nil {
 			return runtime.ReconcileResult{Err: err}
 		}
@@ -177,10 +182,22 @@ func (r *SourceReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceN
 	// Prepare for ingestion
 	stagingTableName := tableName
 	connector := src.Spec.SinkConnector
-	if src.Spec.StageChanges {
+	if src.Spec.StageChanges && !src.Spec.StreamIngestion {
 		stagingTableName = r.stagingTableName(tableName)
 	}
 
+	// Streams are ingested in micro-batches that append to the existing table.
+	// If the spec has changed or the table was lost, we start over from the stream's configured start position.
+	// Until the stream has produced any records, there is no table, but we still resume from the offsets of the previous batch.
+	incremental := false
+	if src.Spec.StreamIngestion {
+		incremental = src.State.SpecHash == hash && src.State.Connector == connector && (src.State.Table == "" || tableExists && src.State.Table == tableName)
+		if !incremental {
+			olapDropTableIfExists(ctx, r.C, connector, tableName, false)
+			src.State.StreamOffsets = nil
+		}
+	}
+
 	// Should never happen, but if somehow the staging table was corrupted into a view, drop it
 	if t, ok := olapTableInfo(ctx, r.C, connector, stagingTableName); ok && t.View {
 		olapDropTableIfExists(ctx, r.C, connector, stagingTableName, t.View)
@@ -188,12 +205,12 @@ func (r *SourceReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceN
 
 	// Execute ingestion
 	r.C.Logger.Info("Ingesting source data", zap.String("name", n.Name), zap.String("connector", connector))
-	ingestErr := r.ingestSource(ctx, self, srcConfig, driversSink(stagingTableName))
+	offsets, ingestErr := r.ingestSource(ctx, self, srcConfig, driversSink(stagingTableName), src.State.StreamOffsets)
 	if ingestErr != nil {
 		ingestErr = fmt.Errorf("failed to ingest source: %w", ingestErr)
 	}
 
-	if ingestErr == nil && src.Spec.StageChanges {
+	if ingestErr == nil && stagingTableName != tableName {
 		// Rename staging table to main table
 		err = olapForceRenameTable(ctx, r.C, connector, stagingTableName, false, tableName)
 		if err != nil {
@@ -205,6 +222,7 @@ func (r *SourceReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceN
 	// If ctx was cancelled, we cleanup and exit
 	// If StageChanges is true, we retain the existing table, but still return the error.
 	// If StageChanges is false, we clear the existing table and return the error.
+	// If a micro-batch of a stream failed, we retain the existing table and offsets so the next batch can retry it.
 
 	// ctx will only be cancelled in cases where the Controller guarantees a new call to Reconcile.
 	// We just clean up temp tables and state, then return.
@@ -224,17 +242,26 @@ func (r *SourceReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceN
 		src.State.Table = tableName
 		src.State.SpecHash = hash
 		src.State.RefreshedOn = timestamppb.Now()
-	} else if src.Spec.StageChanges {
+		// The offsets are saved after the batch has been appended, so a batch may be ingested twice if saving the state fails (at-least-once delivery).
+		src.State.StreamOffsets = offsets
+		if src.Spec.StreamIngestion {
+			// The table is only created by the first batch that has records
+			if _, ok := olapTableInfo(ctx, r.C, connector, tableName); !ok {
+				src.State.Table = ""
+			}
+		}
+	} else if stagingTableName != tableName {
 		// Failed ingestion to staging table
 		olapDropTableIfExists(cleanupCtx, r.C, connector, stagingTableName, false)
-	} else {
-		// Failed ingestion to main table
+	} else if !incremental {
+		// Failed ingestion to main table (failed micro-batches of a stream keep the table and offsets)
 		update = true
 		olapDropTableIfExists(cleanupCtx, r.C, connector, tableName, false)
 		src.State.Connector = ""
 		src.State.Table = ""
 		src.State.SpecHash = ""
 		src.State.RefreshedOn = nil
+		src.State.StreamOffsets = nil
 	}
 	if update {
 		err = r.C.UpdateState(ctx, self.Meta.Name, self)
@@ -257,7 +284,7 @@ func (r *SourceReconciler) Reconcile(ctx context.Context, n *runtimev1.ResourceN
 	}
 
 	// Compute next refresh time
-	refreshOn, err = nextRefreshTime(time.Now(), src.Spec.RefreshSchedule)
+	refreshOn, err = nextRefreshTime(time.Now(), schedule)
 	if err != nil {
 		return runtime.ReconcileResult{Err: err}
 	}
@@ -296,6 +323,18 @@ func (r *SourceReconciler) ingestionSpecHash(spec *runtimev1.SourceSpec, srcConf
 	return hex.EncodeToString(hash.Sum(nil)), nil
 }
 
+// refreshSchedule returns the schedule to use for refreshing the source.
+// Sources that ingest from a stream default to a ticker that ingests a new micro-batch on a fixed interval.
+func (r *SourceReconciler) refreshSchedule(spec *runtimev1.SourceSpec) *runtimev1.Schedule {
+	if !spec.StreamIngestion {
+		return spec.RefreshSchedule
+	}
+	if spec.RefreshSchedule != nil && (spec.RefreshSchedule.Cron != "" || spec.RefreshSchedule.TickerSeconds != 0) {
+		return spec.RefreshSchedule
+	}
+	return &runtimev1.Schedule{TickerSeconds: uint32(_defaultStreamIngestInterval.Seconds())}
+}
+
 // stagingTableName returns a stable temporary table name for a destination table.
 // By using a stable temporary table name, we can ensure proper garbage collection without managing additional state.
 func (r *SourceReconciler) stagingTableName(table string) string {
@@ -325,25 +364,26 @@ func (r *SourceReconciler) setTriggerFalse(ctx context.Context, n *runtimev1.Res
 // ingestSource ingests the source into a table with tableName.
 // It does NOT drop the table if ingestion fails after the table has been created.
 // It will return an error if the sink connector is not an OLAP.
-func (r *SourceReconciler) ingestSource(ctx context.Context, self *runtimev1.Resource, srcConfig, sinkConfig map[string]any) (outErr error) {
+// For sources that ingest from a stream, it appends a micro-batch starting at the given offsets and returns the offsets for the next batch.
+func (r *SourceReconciler) ingestSource(ctx context.Context, self *runtimev1.Resource, srcConfig, sinkConfig map[string]any, offsets map[string]int64) (nextOffsets map[string]int64, outErr error) {
 	src := self.GetSource().Spec
 
 	// Get connections and transporter
 	srcConn, release1, err := r.C.AcquireConn(ctx, src.SourceConnector)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	defer release1()
 	sinkConn, release2, err := r.C.AcquireConn(ctx, src.SinkConnector)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	defer release2()
 	t, ok := sinkConn.AsTransporter(srcConn, sinkConn)
 	if !ok {
 		t, ok = srcConn.AsTransporter(srcConn, sinkConn)
 		if !ok {
-			return fmt.Errorf("cannot transfer data between connectors %q and %q", src.SourceConnector, src.SinkConnector)
+			return nil, fmt.Errorf("cannot transfer data between connectors %q and %q", src.SourceConnector, src.SinkConnector)
 		}
 	}
 
@@ -358,7 +398,7 @@ func (r *SourceReconciler) ingestSource(ctx context.Context, self *runtimev1.Res
 	// Get repo root
 	repo, release, err := r.C.Runtime.Repo(ctx, r.C.InstanceID)
 	if err != nil {
-		return fmt.Errorf("failed to access repo: %w", err)
+		return nil, fmt.Errorf("failed to access repo: %w", err)
 	}
 	repoRoot := repo.Root()
 	release()
@@ -387,8 +427,21 @@ func (r *SourceReconciler) ingestSource(ctx context.Context, self *runtimev1.Res
 		// TODO: emit the number of bytes ingested (this might be extracted from a progress)
 	}()
 
+	if src.StreamIngestion {
+		st, ok := t.(drivers.StreamTransporter)
+		if !ok {
+			return nil, fmt.Errorf("cannot ingest a stream from connector %q into connector %q", src.SourceConnector, src.SinkConnector)
+		}
+		nextOffsets, records, err := st.TransferBatch(ctx, srcConfig, sinkConfig, offsets, opts)
+		if err != nil {
+			return nil, err
+		}
+		r.C.Logger.Debug("Ingested stream batch", zap.String("name", self.Meta.Name.Name), zap.Int64("records", records))
+		return nextOffsets, nil
+	}
+
 	err = t.Transfer(ctx, srcConfig, sinkConfig, opts)
-	return err
+	return nil, err
 }
 
 func (r *SourceReconciler) driversSource(ctx context.Context, self *runtimev1.Resource, propsPB *structpb.Struct) (map[string]any, error) {
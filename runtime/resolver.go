@@ -5,6 +5,7 @@ import (
 	"crypto/md5"
 	"encoding/binary"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"io"
 
@@ -46,6 +47,24 @@ type ResolverResult struct {
 	Cache bool
 }
 
+// StreamingResolver is implemented by resolvers that can produce rows incrementally instead of buffering the full result.
+// It is an optional extension of the Resolver interface.
+type StreamingResolver interface {
+	// ResolveStream resolves data for interactive use as an iterator of rows.
+	// Unlike ResolveInteractive, the result is not subject to the interactive row limit and is never cached.
+	ResolveStream(ctx context.Context) (ResolverRows, error)
+}
+
+// ResolverRows is an iterator over the rows produced by a resolver.
+type ResolverRows interface {
+	// Schema is the schema for the rows.
+	Schema() *runtimev1.StructType
+	// Next returns the next row. It returns io.EOF when there are no more rows.
+	Next() (map[string]any, error)
+	// Close releases the resources held by the iterator. It must be called when done with the iterator.
+	Close() error
+}
+
 // ResolverExportOptions are the options passed to a resolver's ResolveExport method.
 type ResolverExportOptions struct {
 	// Format is the format to export the result in.
@@ -62,6 +81,29 @@ type ResolverOptions struct {
 	Args           map[string]any
 	UserAttributes map[string]any
 	ForExport      bool
+	// Pagination limits the output to a page of rows. It is nil if the output should not be paginated.
+	Pagination *ResolverPagination
+}
+
+// ResolverPagination describes a page of a resolver's output.
+// Offset pagination skips Offset rows. Cursor pagination sorts by CursorColumn and skips rows up to and including Cursor.
+type ResolverPagination struct {
+	// Limit is the maximum number of rows to return.
+	Limit int64
+	// Offset is the number of rows to skip in offset pagination.
+	Offset int64
+	// OrderBy is the sort order in offset pagination. It should be stable across pages.
+	OrderBy []ResolverPaginationSort
+	// CursorColumn is the column to sort and paginate by in cursor pagination.
+	CursorColumn string
+	// Cursor is the value of CursorColumn in the last row of the previous page. It is nil for the first page.
+	Cursor any
+}
+
+// ResolverPaginationSort is a column to sort by in offset pagination.
+type ResolverPaginationSort struct {
+	Column string
+	Desc   bool
 }
 
 // ResolverInitializer is a function that initializes a resolver.
@@ -85,6 +127,7 @@ type ResolveOptions struct {
 	ResolverProperties map[string]any
 	Args               map[string]any
 	UserAttributes     map[string]any
+	Pagination         *ResolverPagination
 }
 
 // ResolveResult is subset of ResolverResult that is cached
@@ -96,18 +139,7 @@ type ResolveResult struct {
 // Resolve resolves a query using the given options.
 func (r *Runtime) Resolve(ctx context.Context, opts *ResolveOptions) (ResolveResult, error) {
 	// Initialize the resolver
-	initializer, ok := ResolverInitializers[opts.Resolver]
-	if !ok {
-		return ResolveResult{}, fmt.Errorf("no resolver found for name %q", opts.Resolver)
-	}
-	resolver, err := initializer(ctx, &ResolverOptions{
-		Runtime:        r,
-		InstanceID:     opts.InstanceID,
-		Properties:     opts.ResolverProperties,
-		Args:           opts.Args,
-		UserAttributes: opts.UserAttributes,
-		ForExport:      false,
-	})
+	resolver, err := r.newResolver(ctx, opts)
 	if err != nil {
 		return ResolveResult{}, err
 	}
@@ -146,16 +178,17 @@ func (r *Runtime) Resolve(ctx context.Context, opts *ResolveOptions) (ResolveRes
 	key := fmt.Sprintf("inst:%s:resolver:%s:hash:%s", opts.InstanceID, opts.Resolver, sum)
 
 	// Try to get from cache
-	if val, ok := r.queryCache.cache.Get(key); ok {
+	if val, ok := r.queryCache.get(ctx, key); ok {
 		return val.(ResolveResult), nil
 	}
 
 	// Load with singleflight
 	val, err := r.queryCache.singleflight.Do(ctx, key, func(ctx context.Context) (any, error) {
 		// Try cache again
-		if val, ok := r.queryCache.cache.Get(key); ok {
+		if val, ok := r.queryCache.get(ctx, key); ok {
 			return val, nil
 		}
+		r.queryCache.recordMiss(ctx)
 
 		res, err := resolver.ResolveInteractive(ctx)
 		if err != nil {
@@ -167,7 +200,7 @@ func (r *Runtime) Resolve(ctx context.Context, opts *ResolveOptions) (ResolveRes
 			Schema: res.Schema,
 		}
 		if res.Cache {
-			r.queryCache.cache.Set(key, cRes, int64(len(res.Data)))
+			r.queryCache.set(ctx, key, cRes, int64(len(res.Data)))
 		}
 		return cRes, nil
 	})
@@ -176,3 +209,90 @@ func (r *Runtime) Resolve(ctx context.Context, opts *ResolveOptions) (ResolveRes
 	}
 	return val.(ResolveResult), nil
 }
+
+// ResolveStream resolves a query using the given options and returns an iterator over the resulting rows.
+// Rows are produced as the underlying resolver outputs them, so callers can write them out incrementally.
+// Results are never served from or written to the cache.
+// If the resolver does not implement StreamingResolver, it falls back to iterating over the buffered result of ResolveInteractive.
+func (r *Runtime) ResolveStream(ctx context.Context, opts *ResolveOptions) (ResolverRows, error) {
+	resolver, err := r.newResolver(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	sr, ok := resolver.(StreamingResolver)
+	if !ok {
+		defer resolver.Close()
+		res, err := resolver.ResolveInteractive(ctx)
+		if err != nil {
+			return nil, err
+		}
+		var rows []map[string]any
+		if err := json.Unmarshal(res.Data, &rows); err != nil {
+			return nil, err
+		}
+		return &bufferedRows{schema: res.Schema, rows: rows}, nil
+	}
+
+	rows, err := sr.ResolveStream(ctx)
+	if err != nil {
+		resolver.Close()
+		return nil, err
+	}
+	return &resolverClosingRows{ResolverRows: rows, resolver: resolver}, nil
+}
+
+// newResolver initializes the resolver for the given options.
+func (r *Runtime) newResolver(ctx context.Context, opts *ResolveOptions) (Resolver, error) {
+	initializer, ok := ResolverInitializers[opts.Resolver]
+	if !ok {
+		return nil, fmt.Errorf("no resolver found for name %q", opts.Resolver)
+	}
+	return initializer(ctx, &ResolverOptions{
+		Runtime:        r,
+		InstanceID:     opts.InstanceID,
+		Properties:     opts.ResolverProperties,
+		Args:           opts.Args,
+		UserAttributes: opts.UserAttributes,
+		ForExport:      false,
+		Pagination:     opts.Pagination,
+	})
+}
+
+// resolverClosingRows wraps a ResolverRows and closes the resolver that produced it when the rows are closed.
+type resolverClosingRows struct {
+	ResolverRows
+	resolver Resolver
+}
+
+func (r *resolverClosingRows) Close() error {
+	err := r.ResolverRows.Close()
+	if err2 := r.resolver.Close(); err == nil {
+		err = err2
+	}
+	return err
+}
+
+// bufferedRows implements ResolverRows for an in-memory result.
+type bufferedRows struct {
+	schema *runtimev1.StructType
+	rows   []map[string]any
+	idx    int
+}
+
+func (r *bufferedRows) Schema() *runtimev1.StructType {
+	return r.schema
+}
+
+func (r *bufferedRows) Next() (map[string]any, error) {
+	if r.idx >= len(r.rows) {
+		return nil, io.EOF
+	}
+	row := r.rows[r.idx]
+	r.idx++
+	return row, nil
+}
+
+func (r *bufferedRows) Close() error {
+	return nil
+}
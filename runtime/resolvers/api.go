@@ -61,11 +61,17 @@ func newAPI(ctx context.Context, opts *runtime.ResolverOptions) (runtime.Resolve
 	if !ok {
 		return nil, fmt.Errorf("no resolver found of type %q", api.Spec.Resolver)
 	}
+	// Validate the args against the proxied API's declared arguments
+	if err := runtime.ValidateAPIArgs(api.Spec, opts.Args); err != nil {
+		return nil, err
+	}
+
 	return initializer(ctx, &runtime.ResolverOptions{
 		Runtime:        opts.Runtime,
 		InstanceID:     opts.InstanceID,
 		Properties:     api.Spec.ResolverProperties.AsMap(),
 		Args:           opts.Args,
 		UserAttributes: auth.GetClaims(ctx).Attributes(),
+		Pagination:     opts.Pagination,
 	})
 }
@@ -48,5 +48,6 @@ func newBuiltinMetricsSQL(ctx context.Context, opts *runtime.ResolverOptions) (r
 		},
 		UserAttributes: opts.UserAttributes,
 		ForExport:      opts.ForExport,
+		Pagination:     opts.Pagination,
 	})
 }
@@ -50,5 +50,6 @@ func newBuiltinSQL(ctx context.Context, opts *runtime.ResolverOptions) (runtime.
 		},
 		UserAttributes: opts.UserAttributes,
 		ForExport:      opts.ForExport,
+		Pagination:     opts.Pagination,
 	})
 }
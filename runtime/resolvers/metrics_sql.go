@@ -78,6 +78,7 @@ func newMetricsSQL(ctx context.Context, opts *runtime.ResolverOptions) (runtime.
 		Args:           opts.Args,
 		UserAttributes: opts.UserAttributes,
 		ForExport:      opts.ForExport,
+		Pagination:     opts.Pagination,
 	}
 	return newSQLSimple(ctx, sqlResolverOpts, finalRefs)
 }
@@ -3,6 +3,8 @@ package resolvers
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"io"
 	"testing"
 
 	"github.com/rilldata/rill/runtime"
@@ -34,6 +36,69 @@ func TestSimpleMetricsSQLApi(t *testing.T) {
 	require.Equal(t, nil, rows[0]["pub"])
 }
 
+func TestMetricsSQLApiStream(t *testing.T) {
+	ctx := context.Background()
+	rt, instanceID := testruntime.NewInstanceWithOptions(t, testruntime.InstanceOptions{
+		Files: map[string]string{
+			`rill.yaml`:      ``,
+			`models/foo.sql`: `SELECT range AS a FROM range(1000)`,
+			`dashboards/mv.yaml`: `
+type: metrics_view
+model: foo
+dimensions:
+  - name: a
+    column: a
+measures:
+  - name: cnt
+    expression: COUNT(*)
+`,
+			`apis/bar.yaml`: `
+type: api
+metrics_sql: SELECT a, cnt FROM mv ORDER BY a
+streaming: true
+`,
+		},
+	})
+	testruntime.RequireParseErrors(t, rt, instanceID, nil)
+
+	api, err := rt.APIForName(ctx, instanceID, "bar")
+	require.NoError(t, err)
+	require.True(t, api.Spec.Streaming)
+
+	// The metrics SQL resolver must stream rows instead of falling back to a buffered result
+	initializer := runtime.ResolverInitializers[api.Spec.Resolver]
+	resolver, err := initializer(ctx, &runtime.ResolverOptions{
+		Runtime:    rt,
+		InstanceID: instanceID,
+		Properties: api.Spec.ResolverProperties.AsMap(),
+	})
+	require.NoError(t, err)
+	defer resolver.Close()
+	_, ok := resolver.(runtime.StreamingResolver)
+	require.True(t, ok)
+
+	rows, err := rt.ResolveStream(ctx, &runtime.ResolveOptions{
+		InstanceID:         instanceID,
+		Resolver:           api.Spec.Resolver,
+		ResolverProperties: api.Spec.ResolverProperties.AsMap(),
+	})
+	require.NoError(t, err)
+	defer rows.Close()
+
+	var n int
+	for {
+		row, err := rows.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+		require.EqualValues(t, n, row["a"])
+		require.EqualValues(t, 1, row["cnt"])
+		n++
+	}
+	require.Equal(t, 1000, n)
+}
+
 func TestTemplateMetricsSQLAPI(t *testing.T) {
 	rt, instanceID := testruntime.NewInstanceForProject(t, "ad_bids")
 
@@ -131,3 +196,57 @@ func TestPolicyMetricsSQLAPI(t *testing.T) {
 	require.Equal(t, "msn.com", resp[0]["domain"])
 	require.Equal(t, nil, resp[0]["publisher"])
 }
+
+func TestWindowMeasuresMetricsSQL(t *testing.T) {
+	rt, instanceID := testruntime.NewInstanceWithOptions(t, testruntime.InstanceOptions{
+		Files: map[string]string{
+			"rill.yaml": "",
+			"sales.sql": `
+SELECT ts, pub, revenue::DOUBLE AS revenue FROM (VALUES
+	('2024-01-01'::TIMESTAMP, 'a', 10.0),
+	('2024-01-01'::TIMESTAMP, 'b', 30.0),
+	('2024-01-02'::TIMESTAMP, 'a', 20.0),
+	('2024-01-03'::TIMESTAMP, 'a', 30.0),
+) t(ts, pub, revenue)
+`,
+			"sales_metrics.yaml": `
+type: metrics_view
+model: sales
+timeseries: ts
+dimensions:
+  - name: pub
+    column: pub
+measures:
+  - name: revenue
+    expression: SUM(revenue)
+  - name: revenue_running
+    type: running_total
+    measure: revenue
+  - name: revenue_share
+    type: share_of_parent
+    measure: revenue
+`,
+		},
+	})
+	testruntime.RequireParseErrors(t, rt, instanceID, nil)
+
+	res, err := rt.Resolve(context.Background(), &runtime.ResolveOptions{
+		InstanceID: instanceID,
+		Resolver:   "metrics_sql",
+		ResolverProperties: map[string]any{
+			"sql": "SELECT pub, date_trunc('day', ts) AS day, revenue_running, revenue_share FROM sales_metrics ORDER BY pub, date_trunc('day', ts)",
+		},
+	})
+	require.NoError(t, err)
+
+	var rows []map[string]interface{}
+	require.NoError(t, json.Unmarshal(res.Data, &rows))
+	require.Equal(t, 4, len(rows))
+	require.Equal(t, 10.0, rows[0]["revenue_running"])
+	require.Equal(t, 0.25, rows[0]["revenue_share"])
+	require.Equal(t, 30.0, rows[1]["revenue_running"])
+	require.Equal(t, 1.0, rows[1]["revenue_share"])
+	require.Equal(t, 60.0, rows[2]["revenue_running"])
+	require.Equal(t, "b", rows[3]["pub"])
+	require.Equal(t, 30.0, rows[3]["revenue_running"])
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"strings"
 	"time"
 
 	"github.com/mitchellh/mapstructure"
@@ -23,6 +24,7 @@ func init() {
 
 type sqlResolver struct {
 	sql                 string
+	args                []any
 	refs                []*runtimev1.ResourceName
 	olap                drivers.OLAPStore
 	olapRelease         func()
@@ -73,8 +75,11 @@ func newSQL(ctx context.Context, opts *runtime.ResolverOptions) (runtime.Resolve
 		return nil, err
 	}
 
+	resolvedSQL, sqlArgs := paginateSQL(resolvedSQL, olap.Dialect(), opts.Pagination)
+
 	return &sqlResolver{
 		sql:                 resolvedSQL,
+		args:                sqlArgs,
 		refs:                refs,
 		olap:                olap,
 		olapRelease:         release,
@@ -110,8 +115,11 @@ func newSQLSimple(ctx context.Context, opts *runtime.ResolverOptions, refs []*ru
 		return nil, err
 	}
 
+	sql, sqlArgs := paginateSQL(props.SQL, olap.Dialect(), opts.Pagination)
+
 	return &sqlResolver{
-		sql:                 props.SQL,
+		sql:                 sql,
+		args:                sqlArgs,
 		refs:                refs,
 		olap:                olap,
 		olapRelease:         release,
@@ -126,7 +134,10 @@ func (r *sqlResolver) Close() error {
 }
 
 func (r *sqlResolver) Key() string {
-	return r.sql
+	if len(r.args) == 0 {
+		return r.sql
+	}
+	return fmt.Sprintf("%s%#v", r.sql, r.args)
 }
 
 func (r *sqlResolver) Refs() []*runtimev1.ResourceName {
@@ -136,18 +147,19 @@ func (r *sqlResolver) Refs() []*runtimev1.ResourceName {
 func (r *sqlResolver) Validate(ctx context.Context) error {
 	_, err := r.olap.Execute(ctx, &drivers.Statement{
 		Query:  r.sql,
+		Args:   r.args,
 		DryRun: true,
 	})
 	return err
 }
 
 func (r *sqlResolver) ResolveInteractive(ctx context.Context) (*runtime.ResolverResult, error) {
-	// Wrap the SQL with an outer SELECT to limit the number of rows returned in interactive mode.
 	// Adding +1 to the limit so we can return a nice error message if the limit is exceeded.
-	sql := fmt.Sprintf("SELECT * FROM (%s) LIMIT %d", r.sql, r.interactiveRowLimit+1)
+	sql := limitSQL(r.sql, r.interactiveRowLimit+1)
 
 	res, err := r.olap.Execute(ctx, &drivers.Statement{
 		Query:    sql,
+		Args:     r.args,
 		Priority: r.priority,
 	})
 	if err != nil {
@@ -187,6 +199,49 @@ func (r *sqlResolver) ResolveInteractive(ctx context.Context) (*runtime.Resolver
 	}, nil
 }
 
+// The metrics_sql, builtin_sql and builtin_metrics_sql resolvers also return a *sqlResolver, so they all stream through this method.
+var _ runtime.StreamingResolver = (*sqlResolver)(nil)
+
+func (r *sqlResolver) ResolveStream(ctx context.Context) (runtime.ResolverRows, error) {
+	res, err := r.olap.Execute(ctx, &drivers.Statement{
+		Query:    r.sql,
+		Args:     r.args,
+		Priority: r.priority,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &sqlResolverRows{res: res}, nil
+}
+
+// sqlResolverRows implements runtime.ResolverRows on top of the rows returned by an OLAP query.
+// Rows are scanned one at a time, so the database driver's own buffering determines how far ahead of the consumer the query runs.
+type sqlResolverRows struct {
+	res *drivers.Result
+}
+
+func (r *sqlResolverRows) Schema() *runtimev1.StructType {
+	return r.res.Schema
+}
+
+func (r *sqlResolverRows) Next() (map[string]any, error) {
+	if !r.res.Rows.Next() {
+		if err := r.res.Rows.Err(); err != nil {
+			return nil, err
+		}
+		return nil, io.EOF
+	}
+	row := make(map[string]any)
+	if err := r.res.Rows.MapScan(row); err != nil {
+		return nil, err
+	}
+	return row, nil
+}
+
+func (r *sqlResolverRows) Close() error {
+	return r.res.Close()
+}
+
 func (r *sqlResolver) ResolveExport(ctx context.Context, w io.Writer, opts *runtime.ResolverExportOptions) error {
 	exportOpts := &runtime.ExportOptions{
 		Format:       opts.Format,
@@ -198,8 +253,8 @@ func (r *sqlResolver) ResolveExport(ctx context.Context, w io.Writer, opts *runt
 
 	switch r.olap.Dialect() {
 	case drivers.DialectDuckDB:
-		if opts.Format == runtimev1.ExportFormat_EXPORT_FORMAT_CSV || opts.Format == runtimev1.ExportFormat_EXPORT_FORMAT_PARQUET {
-			return queries.DuckDBCopyExport(ctx, w, exportOpts, r.sql, nil, filename, r.olap, opts.Format)
+		if opts.Format == runtimev1.ExportFormat_EXPORT_FORMAT_CSV || opts.Format == runtimev1.ExportFormat_EXPORT_FORMAT_PARQUET || opts.Format == runtimev1.ExportFormat_EXPORT_FORMAT_JSONL {
+			return queries.DuckDBCopyExport(ctx, w, exportOpts, r.sql, r.args, filename, r.olap, opts.Format)
 		}
 		return r.generalExport(ctx, w, filename, exportOpts)
 	case drivers.DialectDruid, drivers.DialectClickHouse:
@@ -212,6 +267,7 @@ func (r *sqlResolver) ResolveExport(ctx context.Context, w io.Writer, opts *runt
 func (r *sqlResolver) generalExport(ctx context.Context, w io.Writer, filename string, opts *runtime.ExportOptions) error {
 	res, err := r.olap.Execute(ctx, &drivers.Statement{
 		Query:    r.sql,
+		Args:     r.args,
 		Priority: opts.Priority,
 	})
 	if err != nil {
@@ -256,6 +312,10 @@ func (r *sqlResolver) generalExport(ctx context.Context, w io.Writer, filename s
 		return queries.WriteXLSX(meta, data, w)
 	case runtimev1.ExportFormat_EXPORT_FORMAT_PARQUET:
 		return queries.WriteParquet(meta, data, w)
+	case runtimev1.ExportFormat_EXPORT_FORMAT_JSONL:
+		return queries.WriteJSONL(meta, data, w)
+	case runtimev1.ExportFormat_EXPORT_FORMAT_ARROW:
+		return queries.WriteArrow(meta, data, w)
 	}
 
 	return nil
@@ -321,3 +381,43 @@ func resolveTemplate(sqlTemplate string, args map[string]any, inst *drivers.Inst
 	}
 	return sql, refs, nil
 }
+
+// limitSQL wraps a SQL query with an outer SELECT that limits the number of rows it returns.
+// The subquery needs an alias for Postgres (before version 16).
+func limitSQL(sql string, limit int64) string {
+	return fmt.Sprintf("SELECT * FROM (%s) AS limited LIMIT %d", sql, limit)
+}
+
+// paginateSQL wraps a SQL query to return only the requested page of rows.
+// It returns the SQL unchanged if p is nil. The returned args must be passed to the statement for cursor pagination.
+// Cursor pagination skips rows that share the cursor value of the previous page's last row, so the cursor column must be unique.
+// Callers should fetch an extra row and check that it doesn't share the cursor value of the page's last row.
+func paginateSQL(sql string, d drivers.Dialect, p *runtime.ResolverPagination) (string, []any) {
+	if p == nil {
+		return sql, nil
+	}
+
+	// The subquery needs an alias for Postgres (before version 16)
+	if p.CursorColumn == "" {
+		// The order of the subquery's output is not preserved, so the pages are only consistent if sorted by the outer query
+		var orderBy []string
+		for _, o := range p.OrderBy {
+			expr := d.EscapeIdentifier(o.Column)
+			if o.Desc {
+				expr += " DESC"
+			}
+			orderBy = append(orderBy, expr)
+		}
+		var orderClause string
+		if len(orderBy) > 0 {
+			orderClause = "ORDER BY " + strings.Join(orderBy, ", ")
+		}
+		return fmt.Sprintf("SELECT * FROM (%s) AS paginated %s LIMIT %d OFFSET %d", sql, orderClause, p.Limit, p.Offset), nil
+	}
+
+	col := d.EscapeIdentifier(p.CursorColumn)
+	if p.Cursor == nil {
+		return fmt.Sprintf("SELECT * FROM (%s) AS paginated ORDER BY %s LIMIT %d", sql, col, p.Limit), nil
+	}
+	return fmt.Sprintf("SELECT * FROM (%s) AS paginated WHERE %s > ? ORDER BY %s LIMIT %d", sql, col, col, p.Limit), []any{p.Cursor}
+}
@@ -3,6 +3,8 @@ package resolvers
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"io"
 	"testing"
 
 	"github.com/rilldata/rill/runtime"
@@ -37,6 +39,100 @@ func TestSimpleSQLApi(t *testing.T) {
 	require.Equal(t, "2022-03-05T14:49:50.459Z", rows[0]["timestamp"])
 }
 
+func TestSQLApiStream(t *testing.T) {
+	ctx := context.Background()
+	rt, instanceID := testruntime.NewInstanceWithOptions(t, testruntime.InstanceOptions{
+		Files: map[string]string{
+			`rill.yaml`:      ``,
+			`models/foo.sql`: `SELECT range AS a FROM range(1000)`,
+			`apis/bar.yaml`: `
+type: api
+sql: SELECT a FROM foo ORDER BY a
+streaming: true
+`,
+		},
+	})
+
+	api, err := rt.APIForName(ctx, instanceID, "bar")
+	require.NoError(t, err)
+	require.True(t, api.Spec.Streaming)
+
+	rows, err := rt.ResolveStream(ctx, &runtime.ResolveOptions{
+		InstanceID:         instanceID,
+		Resolver:           api.Spec.Resolver,
+		ResolverProperties: api.Spec.ResolverProperties.AsMap(),
+	})
+	require.NoError(t, err)
+	defer rows.Close()
+	require.Len(t, rows.Schema().Fields, 1)
+
+	var n int
+	for {
+		row, err := rows.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+		require.EqualValues(t, n, row["a"])
+		n++
+	}
+	require.Equal(t, 1000, n)
+}
+
+func TestSQLApiPagination(t *testing.T) {
+	ctx := context.Background()
+	rt, instanceID := testruntime.NewInstanceWithOptions(t, testruntime.InstanceOptions{
+		Files: map[string]string{
+			`rill.yaml`:      ``,
+			`models/foo.sql`: `SELECT range AS a FROM range(10)`,
+			`apis/bar.yaml`: `
+type: api
+sql: SELECT a FROM foo ORDER BY a DESC
+`,
+		},
+	})
+
+	api, err := rt.APIForName(ctx, instanceID, "bar")
+	require.NoError(t, err)
+
+	tt := []struct {
+		pagination *runtime.ResolverPagination
+		want       string
+	}{
+		{
+			pagination: &runtime.ResolverPagination{Limit: 3},
+			want:       `[{"a":9},{"a":8},{"a":7}]`,
+		},
+		{
+			pagination: &runtime.ResolverPagination{Limit: 3, Offset: 8},
+			want:       `[{"a":1},{"a":0}]`,
+		},
+		{
+			pagination: &runtime.ResolverPagination{Limit: 3, Offset: 2, OrderBy: []runtime.ResolverPaginationSort{{Column: "a"}}},
+			want:       `[{"a":2},{"a":3},{"a":4}]`,
+		},
+		{
+			pagination: &runtime.ResolverPagination{Limit: 3, CursorColumn: "a"},
+			want:       `[{"a":0},{"a":1},{"a":2}]`,
+		},
+		{
+			pagination: &runtime.ResolverPagination{Limit: 3, CursorColumn: "a", Cursor: int64(2)},
+			want:       `[{"a":3},{"a":4},{"a":5}]`,
+		},
+	}
+
+	for _, tc := range tt {
+		res, err := rt.Resolve(ctx, &runtime.ResolveOptions{
+			InstanceID:         instanceID,
+			Resolver:           api.Spec.Resolver,
+			ResolverProperties: api.Spec.ResolverProperties.AsMap(),
+			Pagination:         tc.pagination,
+		})
+		require.NoError(t, err)
+		require.Equal(t, tc.want, string(res.Data))
+	}
+}
+
 func TestTemplateSQLApi(t *testing.T) {
 	rt, instanceID := testruntime.NewInstanceForProject(t, "ad_bids")
 
@@ -96,3 +192,8 @@ func TestTemplateSQLApi2(t *testing.T) {
 		require.Equal(t, "msn.com", row["domain"])
 	}
 }
+
+func TestLimitSQL(t *testing.T) {
+	// Postgres (before version 16) requires an alias for subqueries in the FROM clause
+	require.Equal(t, "SELECT * FROM (SELECT 1) AS limited LIMIT 11", limitSQL("SELECT 1", 11))
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/rilldata/rill/runtime/pkg/activity"
 	"github.com/rilldata/rill/runtime/pkg/conncache"
 	"github.com/rilldata/rill/runtime/pkg/email"
+	"github.com/rilldata/rill/runtime/pkg/querycache"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
@@ -29,6 +30,12 @@ type Options struct {
 	ControllerLogBufferSizeBytes int64
 	AllowHostAccess              bool
 	DataDir                      string
+	// QueryCacheTTL is the expiration time of entries in the default in-memory query cache. If zero, entries don't expire.
+	QueryCacheTTL time.Duration
+	// QueryCache overrides the default in-memory query cache, e.g. with a cache shared between replicas.
+	// The runtime takes ownership of the cache and closes it on shutdown.
+	// Caches that store values out of process should use QueryCacheCodec to encode them.
+	QueryCache querycache.Cache
 }
 
 type Runtime struct {
@@ -48,12 +55,21 @@ func New(ctx context.Context, opts *Options, logger *zap.Logger, ac *activity.Cl
 		emailClient = email.New(email.NewNoopSender())
 	}
 
+	qc := opts.QueryCache
+	if qc == nil {
+		var err error
+		qc, err = querycache.NewMemory(opts.QueryCacheSizeBytes, opts.QueryCacheTTL)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	rt := &Runtime{
 		Email:          emailClient,
 		opts:           opts,
 		logger:         logger,
 		activity:       ac,
-		queryCache:     newQueryCache(opts.QueryCacheSizeBytes),
+		queryCache:     newQueryCache(qc, logger),
 		securityEngine: newSecurityEngine(opts.SecurityEngineCacheSize, logger),
 	}
 
@@ -1,19 +1,33 @@
 package server
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
 	"net/http"
+	"strconv"
+	"strings"
 
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/compilers/rillv1"
 	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/rilldata/rill/runtime/pkg/httputil"
 	"github.com/rilldata/rill/runtime/pkg/observability"
 	"github.com/rilldata/rill/runtime/server/auth"
 	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
 )
 
+// apiStreamFlushRows is the number of rows to write between flushes when streaming an API response.
+const apiStreamFlushRows = 100
+
+// apiStreamErrorTrailer is the HTTP trailer that carries the error message if a streaming API response fails after it has started.
+const apiStreamErrorTrailer = "X-Rill-Error"
+
 func (s *Server) apiHandler(w http.ResponseWriter, req *http.Request) error {
 	// Parse path parameters
 	ctx := req.Context()
@@ -57,24 +71,342 @@ func (s *Server) apiHandler(w http.ResponseWriter, req *http.Request) error {
 		return httputil.Error(http.StatusInternalServerError, err)
 	}
 
-	// Resolve the API to JSON data
-	res, err := s.runtime.Resolve(ctx, &runtime.ResolveOptions{
+	// Validate the args against the API's declared arguments
+	if err := runtime.ValidateAPIArgs(api.Spec, args); err != nil {
+		return httputil.Error(http.StatusBadRequest, err)
+	}
+
+	resolveOpts := &runtime.ResolveOptions{
 		InstanceID:         instanceID,
 		Resolver:           api.Spec.Resolver,
 		ResolverProperties: api.Spec.ResolverProperties.AsMap(),
 		Args:               args,
 		UserAttributes:     auth.GetClaims(ctx).Attributes(),
-	})
+	}
+
+	// Paginate the output if the API is configured for it
+	if api.Spec.Pagination != nil {
+		return s.paginatedAPI(w, req, api.Spec.Pagination, resolveOpts)
+	}
+
+	// Stream the rows if the API is configured for streaming.
+	// Only streaming APIs may return more rows than the interactive row limit.
+	ndjson := acceptsNDJSON(req)
+	if api.Spec.Streaming {
+		return s.streamAPI(w, req, resolveOpts, ndjson)
+	}
+
+	// Resolve the API to JSON data
+	res, err := s.runtime.Resolve(ctx, resolveOpts)
 	if err != nil {
 		return httputil.Error(http.StatusBadRequest, err)
 	}
 
+	// Convert the response to NDJSON if the client asked for it
+	data := res.Data
+	if ndjson {
+		data, err = jsonArrayToNDJSON(data)
+		if err != nil {
+			return httputil.Error(http.StatusInternalServerError, err)
+		}
+		w.Header().Set("Content-Type", "application/x-ndjson")
+	} else {
+		w.Header().Set("Content-Type", "application/json")
+	}
+
 	// Write the response
+	_, err = w.Write(data)
+	if err != nil {
+		return httputil.Error(http.StatusInternalServerError, err)
+	}
+
+	return nil
+}
+
+// paginatedAPI resolves a page of an API's output and writes it to the response together with a token for the next page.
+// The page is selected using the "page_size" and "page_token" args, which are removed from the args passed to the resolver.
+func (s *Server) paginatedAPI(w http.ResponseWriter, req *http.Request, p *runtimev1.APIPagination, opts *runtime.ResolveOptions) error {
+	pageSize, err := apiPageSize(p, opts.Args[rillv1.APIPageSizeArg])
+	if err != nil {
+		return httputil.Error(http.StatusBadRequest, err)
+	}
+
+	pageToken, _ := opts.Args[rillv1.APIPageTokenArg].(string)
+	tkn := &apiPageToken{}
+	err = unmarshalPageToken(pageToken, tkn)
+	if err != nil {
+		return httputil.Error(http.StatusBadRequest, err)
+	}
+	delete(opts.Args, rillv1.APIPageSizeArg)
+	delete(opts.Args, rillv1.APIPageTokenArg)
+
+	// Request one extra row to determine if there is a next page
+	opts.Pagination = &runtime.ResolverPagination{Limit: pageSize + 1}
+	switch p.Mode {
+	case runtimev1.APIPagination_MODE_CURSOR:
+		opts.Pagination.CursorColumn = p.CursorColumn
+		opts.Pagination.Cursor, err = tkn.cursor()
+		if err != nil {
+			return httputil.Error(http.StatusBadRequest, err)
+		}
+	default:
+		opts.Pagination.Offset = tkn.Offset
+		for _, o := range p.OrderBy {
+			opts.Pagination.OrderBy = append(opts.Pagination.OrderBy, runtime.ResolverPaginationSort{Column: o.Column, Desc: o.Desc})
+		}
+	}
+
+	res, err := s.runtime.Resolve(req.Context(), opts)
+	if err != nil {
+		return httputil.Error(http.StatusBadRequest, err)
+	}
+
+	page, err := newAPIPage(p, tkn, pageSize, res.Data)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(page)
+	if err != nil {
+		return httputil.Error(http.StatusInternalServerError, err)
+	}
+
 	w.Header().Set("Content-Type", "application/json")
-	_, err = w.Write(res.Data)
+	_, err = w.Write(data)
 	if err != nil {
 		return httputil.Error(http.StatusInternalServerError, err)
 	}
 
 	return nil
 }
+
+// newAPIPage builds a page from the JSON rows resolved for the page token tkn.
+// The rows should contain up to pageSize+1 rows, where the extra row indicates that there is a next page.
+// In cursor mode, it errors if the last row of the page and the extra row have the same cursor value, since the next page would skip the rows that share it.
+func newAPIPage(p *runtimev1.APIPagination, tkn *apiPageToken, pageSize int64, data []byte) (*apiPage, error) {
+	var rows []json.RawMessage
+	if err := json.Unmarshal(data, &rows); err != nil {
+		return nil, httputil.Error(http.StatusInternalServerError, err)
+	}
+	if rows == nil {
+		rows = []json.RawMessage{}
+	}
+
+	if int64(len(rows)) <= pageSize {
+		return &apiPage{Data: rows}, nil
+	}
+
+	next := &apiPageToken{}
+	if p.Mode == runtimev1.APIPagination_MODE_CURSOR {
+		last, err := apiRowCursor(rows[pageSize-1], p.CursorColumn)
+		if err != nil {
+			return nil, err
+		}
+		extra, err := apiRowCursor(rows[pageSize], p.CursorColumn)
+		if err != nil {
+			return nil, err
+		}
+		if bytes.Equal(last, extra) {
+			return nil, httputil.Errorf(http.StatusBadRequest, "cursor column %q must be unique: multiple rows have the value %s", p.CursorColumn, last)
+		}
+		next.Cursor = last
+	} else {
+		next.Offset = tkn.Offset + pageSize
+	}
+
+	return &apiPage{Data: rows[:pageSize], NextPageToken: marshalPageToken(next)}, nil
+}
+
+// apiRowCursor returns the JSON value of the cursor column in a row.
+func apiRowCursor(row json.RawMessage, col string) (json.RawMessage, error) {
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(row, &obj); err != nil {
+		return nil, httputil.Error(http.StatusInternalServerError, err)
+	}
+	cursor, ok := obj[col]
+	if !ok {
+		return nil, httputil.Errorf(http.StatusBadRequest, "cursor column %q not found in the API's output", col)
+	}
+	return cursor, nil
+}
+
+// apiPage is the response body of a paginated API.
+type apiPage struct {
+	Data          []json.RawMessage `json:"data"`
+	NextPageToken string            `json:"next_page_token,omitempty"`
+}
+
+// apiPageToken is the pagination cursor for paginated APIs.
+// Offset is used in offset mode. Cursor holds the JSON value of the cursor column in the last row of the previous page in cursor mode.
+type apiPageToken struct {
+	Offset int64           `json:"offset,omitempty"`
+	Cursor json.RawMessage `json:"cursor,omitempty"`
+}
+
+// cursor decodes the cursor value. Integers are decoded as int64 to avoid losing precision.
+func (t *apiPageToken) cursor() (any, error) {
+	if len(t.Cursor) == 0 {
+		return nil, nil
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(t.Cursor))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return nil, fmt.Errorf("failed to parse page token: %w", err)
+	}
+
+	if n, ok := v.(json.Number); ok {
+		if i, err := n.Int64(); err == nil {
+			return i, nil
+		}
+		return n.Float64()
+	}
+	return v, nil
+}
+
+// apiPageSize returns the page size to use for a paginated API given the value of the "page_size" arg.
+func apiPageSize(p *runtimev1.APIPagination, v any) (int64, error) {
+	var n int64
+	switch v := v.(type) {
+	case nil:
+	case string:
+		var err error
+		n, err = strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid page size %q", v)
+		}
+	case float64:
+		n = int64(v)
+	default:
+		return 0, fmt.Errorf("invalid page size %v", v)
+	}
+
+	if n < 0 {
+		return 0, fmt.Errorf("invalid page size %d", n)
+	}
+	if n == 0 {
+		n = int64(p.DefaultPageSize)
+		if n == 0 {
+			n = _defaultPageSize
+		}
+	}
+	if p.MaxPageSize != 0 && n > int64(p.MaxPageSize) {
+		n = int64(p.MaxPageSize)
+	}
+	return n, nil
+}
+
+// streamAPI resolves an API and writes the rows to the response as they are produced.
+// If ndjson is true, each row is written as a JSON object on its own line. Otherwise, the rows are written as a JSON array using chunked transfer encoding.
+// Rows are only pulled from the resolver after the previous row has been written, so a slow client applies backpressure to the query.
+// If the client disconnects, the request context is cancelled, which also cancels the query.
+func (s *Server) streamAPI(w http.ResponseWriter, req *http.Request, opts *runtime.ResolveOptions, ndjson bool) error {
+	ctx := req.Context()
+
+	rows, err := s.runtime.ResolveStream(ctx, opts)
+	if err != nil {
+		return httputil.Error(http.StatusBadRequest, err)
+	}
+	defer rows.Close()
+
+	if ndjson {
+		w.Header().Set("Content-Type", "application/x-ndjson")
+	} else {
+		w.Header().Set("Content-Type", "application/json")
+	}
+	w.Header().Set("Trailer", apiStreamErrorTrailer)
+	w.WriteHeader(http.StatusOK)
+	rc := http.NewResponseController(w)
+
+	var n int
+	for {
+		row, err := rows.Next()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			s.abortStream(ctx, w, err)
+			return nil
+		}
+
+		data, err := json.Marshal(row)
+		if err != nil {
+			s.abortStream(ctx, w, err)
+			return nil
+		}
+		if ndjson {
+			data = append(data, '\n')
+		} else if n == 0 {
+			data = append([]byte{'['}, data...)
+		} else {
+			data = append([]byte{','}, data...)
+		}
+
+		if _, err := w.Write(data); err != nil {
+			// The client has most likely disconnected, so there is no one to report the error to.
+			return nil
+		}
+
+		n++
+		if n%apiStreamFlushRows == 0 {
+			_ = rc.Flush()
+		}
+	}
+
+	if !ndjson {
+		if n == 0 {
+			_, _ = w.Write([]byte("[]"))
+		} else {
+			_, _ = w.Write([]byte("]"))
+		}
+	}
+
+	return nil
+}
+
+// abortStream handles an error that occurs after a streaming response has started.
+// Since the status code has already been sent, the error message is sent in the X-Rill-Error trailer, which is only set for failed responses.
+// No more rows are written after the error, and JSON array responses are left unterminated so clients that don't read trailers fail to parse them.
+func (s *Server) abortStream(ctx context.Context, w http.ResponseWriter, err error) {
+	if ctx.Err() != nil {
+		// The client has disconnected
+		return
+	}
+
+	s.logger.Info("failed to stream API response", zap.Error(err), observability.ZapCtx(ctx))
+
+	// Header values can't contain newlines
+	msg := strings.Join(strings.Fields(err.Error()), " ")
+	w.Header().Set(apiStreamErrorTrailer, msg)
+}
+
+// jsonArrayToNDJSON converts a JSON array of objects to newline-delimited JSON.
+func jsonArrayToNDJSON(data []byte) ([]byte, error) {
+	var rows []json.RawMessage
+	if err := json.Unmarshal(data, &rows); err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	for _, row := range rows {
+		if err := json.Compact(&buf, row); err != nil {
+			return nil, err
+		}
+		buf.WriteByte('\n')
+	}
+	return buf.Bytes(), nil
+}
+
+// acceptsNDJSON returns true if the request's Accept header asks for newline-delimited JSON.
+func acceptsNDJSON(req *http.Request) bool {
+	for _, v := range req.Header.Values("Accept") {
+		for _, t := range strings.Split(v, ",") {
+			t, _, _ = strings.Cut(t, ";")
+			if strings.TrimSpace(t) == "application/x-ndjson" {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,147 @@
+package server_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/rilldata/rill/runtime/pkg/activity"
+	"github.com/rilldata/rill/runtime/pkg/ratelimit"
+	"github.com/rilldata/rill/runtime/server"
+	"github.com/rilldata/rill/runtime/testruntime"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestPaginatedAPI(t *testing.T) {
+	rt, instanceID := testruntime.NewInstanceWithOptions(t, testruntime.InstanceOptions{
+		Files: map[string]string{
+			`rill.yaml`:      ``,
+			`models/foo.sql`: `SELECT range AS id, range % 2 AS parity FROM range(5)`,
+			`apis/offset.yaml`: `
+type: api
+sql: SELECT id FROM foo
+pagination:
+  order_by:
+    - id
+  default_page_size: 2
+  max_page_size: 3
+`,
+			`apis/cursor.yaml`: `
+type: api
+sql: SELECT id FROM foo
+pagination:
+  mode: cursor
+  cursor_column: id
+  default_page_size: 2
+`,
+			`apis/non_unique_cursor.yaml`: `
+type: api
+sql: SELECT parity FROM foo
+pagination:
+  mode: cursor
+  cursor_column: parity
+  default_page_size: 2
+`,
+		},
+	})
+	testruntime.RequireParseErrors(t, rt, instanceID, nil)
+
+	s, err := server.NewServer(context.Background(), &server.Options{}, rt, zap.NewNop(), ratelimit.NewNoop(), activity.NewNoopClient())
+	require.NoError(t, err)
+	handler, err := s.HTTPHandler(context.Background(), nil)
+	require.NoError(t, err)
+	srv := httptest.NewServer(handler)
+	defer srv.Close()
+
+	type page struct {
+		Data          []map[string]any `json:"data"`
+		NextPageToken string           `json:"next_page_token"`
+	}
+	get := func(api string, args url.Values) (int, *page, string) {
+		u := fmt.Sprintf("%s/v1/instances/%s/api/%s?%s", srv.URL, instanceID, api, args.Encode())
+		resp, err := http.Get(u)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		if resp.StatusCode != http.StatusOK {
+			return resp.StatusCode, nil, string(body)
+		}
+		p := &page{}
+		require.NoError(t, json.Unmarshal(body, p))
+		return resp.StatusCode, p, ""
+	}
+	ids := func(p *page) []float64 {
+		var res []float64
+		for _, row := range p.Data {
+			res = append(res, row["id"].(float64))
+		}
+		return res
+	}
+
+	// Offset mode with the default page size
+	status, p, _ := get("offset", url.Values{})
+	require.Equal(t, http.StatusOK, status)
+	require.Equal(t, []float64{0, 1}, ids(p))
+	require.NotEmpty(t, p.NextPageToken)
+
+	status, p, _ = get("offset", url.Values{"page_token": {p.NextPageToken}})
+	require.Equal(t, http.StatusOK, status)
+	require.Equal(t, []float64{2, 3}, ids(p))
+
+	status, p, _ = get("offset", url.Values{"page_token": {p.NextPageToken}})
+	require.Equal(t, http.StatusOK, status)
+	require.Equal(t, []float64{4}, ids(p))
+	require.Empty(t, p.NextPageToken)
+
+	// The page size is clamped to the max page size
+	status, p, _ = get("offset", url.Values{"page_size": {"100"}})
+	require.Equal(t, http.StatusOK, status)
+	require.Equal(t, []float64{0, 1, 2}, ids(p))
+
+	// Invalid page size and token
+	status, _, _ = get("offset", url.Values{"page_size": {"-1"}})
+	require.Equal(t, http.StatusBadRequest, status)
+	status, _, _ = get("offset", url.Values{"page_token": {"invalid"}})
+	require.Equal(t, http.StatusBadRequest, status)
+
+	// Cursor mode
+	var all []float64
+	args := url.Values{}
+	for {
+		status, p, _ = get("cursor", args)
+		require.Equal(t, http.StatusOK, status)
+		all = append(all, ids(p)...)
+		if p.NextPageToken == "" {
+			break
+		}
+		args = url.Values{"page_token": {p.NextPageToken}}
+	}
+	require.Equal(t, []float64{0, 1, 2, 3, 4}, all)
+
+	// Cursor mode on a non-unique column fails instead of skipping rows
+	status, _, msg := get("non_unique_cursor", url.Values{})
+	require.Equal(t, http.StatusBadRequest, status)
+	require.Contains(t, msg, `cursor column \"parity\" must be unique`)
+
+	// The OpenAPI document describes the pagination args
+	resp, err := http.Get(fmt.Sprintf("%s/v1/instances/%s/api/openapi.json", srv.URL, instanceID))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	doc := map[string]any{}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&doc))
+	paths := doc["paths"].(map[string]any)
+	require.Contains(t, paths, "/offset")
+	require.Contains(t, paths, "/cursor")
+	params := paths["/offset"].(map[string]any)["get"].(map[string]any)["parameters"].([]any)
+	require.Len(t, params, 2)
+	require.Equal(t, "page_size", params[0].(map[string]any)["name"])
+	require.Equal(t, 3.0, params[0].(map[string]any)["schema"].(map[string]any)["maximum"])
+}
@@ -0,0 +1,338 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/compilers/rillv1"
+	"github.com/rilldata/rill/runtime/pkg/httputil"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func init() {
+	runtime.RegisterResolverInitializer("test_stream", newTestStreamResolver)
+}
+
+func TestAcceptsNDJSON(t *testing.T) {
+	tests := []struct {
+		accept []string
+		want   bool
+	}{
+		{nil, false},
+		{[]string{"application/json"}, false},
+		{[]string{"application/x-ndjson"}, true},
+		{[]string{"application/json, application/x-ndjson;q=0.9"}, true},
+		{[]string{"text/html", "application/x-ndjson"}, true},
+		{[]string{"application/x-ndjson-foo"}, false},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		for _, v := range tt.accept {
+			req.Header.Add("Accept", v)
+		}
+		require.Equal(t, tt.want, acceptsNDJSON(req), "accept: %v", tt.accept)
+	}
+}
+
+func TestStreamAPI(t *testing.T) {
+	tests := []struct {
+		name        string
+		rows        int
+		failAfter   int
+		ndjson      bool
+		contentType string
+		body        string
+		err         string
+	}{
+		{
+			name:        "json",
+			rows:        3,
+			failAfter:   -1,
+			contentType: "application/json",
+			body:        `[{"n":0},{"n":1},{"n":2}]`,
+		},
+		{
+			name:        "json empty",
+			rows:        0,
+			failAfter:   -1,
+			contentType: "application/json",
+			body:        `[]`,
+		},
+		{
+			name:        "ndjson",
+			rows:        2,
+			failAfter:   -1,
+			ndjson:      true,
+			contentType: "application/x-ndjson",
+			body:        "{\"n\":0}\n{\"n\":1}\n",
+		},
+		{
+			name:        "json error",
+			rows:        3,
+			failAfter:   1,
+			contentType: "application/json",
+			body:        `[{"n":0}`,
+			err:         "stream failed after 1 rows",
+		},
+		{
+			name:        "ndjson error",
+			rows:        3,
+			failAfter:   2,
+			ndjson:      true,
+			contentType: "application/x-ndjson",
+			body:        "{\"n\":0}\n{\"n\":1}\n",
+			err:         "stream failed after 2 rows",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Server{runtime: &runtime.Runtime{}, logger: zap.NewNop()}
+			srv := httptest.NewServer(httputil.Handler(func(w http.ResponseWriter, req *http.Request) error {
+				return s.streamAPI(w, req, &runtime.ResolveOptions{
+					Resolver:           "test_stream",
+					ResolverProperties: map[string]any{"rows": tt.rows, "fail_after": tt.failAfter},
+				}, tt.ndjson)
+			}))
+			defer srv.Close()
+
+			resp, err := http.Get(srv.URL)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			require.Equal(t, http.StatusOK, resp.StatusCode)
+			require.Equal(t, tt.contentType, resp.Header.Get("Content-Type"))
+			require.Equal(t, tt.body, string(body))
+			require.Equal(t, tt.err, resp.Trailer.Get(apiStreamErrorTrailer))
+			if tt.err != "" && !tt.ndjson {
+				require.Error(t, json.Unmarshal(body, &[]any{}))
+			}
+		})
+	}
+}
+
+func TestJSONArrayToNDJSON(t *testing.T) {
+	data, err := jsonArrayToNDJSON([]byte(`[{"a": 1}, {"a": 2}]`))
+	require.NoError(t, err)
+	require.Equal(t, "{\"a\":1}\n{\"a\":2}\n", string(data))
+
+	data, err = jsonArrayToNDJSON([]byte(`[]`))
+	require.NoError(t, err)
+	require.Empty(t, data)
+}
+
+// testStreamResolver is a resolver that streams the rows {"n": 0}, {"n": 1}, ... and optionally fails after a number of rows.
+type testStreamResolver struct {
+	rows      int
+	failAfter int
+}
+
+func newTestStreamResolver(ctx context.Context, opts *runtime.ResolverOptions) (runtime.Resolver, error) {
+	return &testStreamResolver{
+		rows:      opts.Properties["rows"].(int),
+		failAfter: opts.Properties["fail_after"].(int),
+	}, nil
+}
+
+func (r *testStreamResolver) Close() error {
+	return nil
+}
+
+func (r *testStreamResolver) Key() string {
+	return fmt.Sprintf("%d:%d", r.rows, r.failAfter)
+}
+
+func (r *testStreamResolver) Refs() []*runtimev1.ResourceName {
+	return nil
+}
+
+func (r *testStreamResolver) Validate(ctx context.Context) error {
+	return nil
+}
+
+func (r *testStreamResolver) ResolveInteractive(ctx context.Context) (*runtime.ResolverResult, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (r *testStreamResolver) ResolveExport(ctx context.Context, w io.Writer, opts *runtime.ResolverExportOptions) error {
+	return errors.New("not implemented")
+}
+
+func (r *testStreamResolver) ResolveStream(ctx context.Context) (runtime.ResolverRows, error) {
+	return &testStreamRows{resolver: r}, nil
+}
+
+type testStreamRows struct {
+	resolver *testStreamResolver
+	idx      int
+}
+
+func (r *testStreamRows) Schema() *runtimev1.StructType {
+	return &runtimev1.StructType{Fields: []*runtimev1.StructType_Field{{Name: "n", Type: &runtimev1.Type{Code: runtimev1.Type_CODE_INT64}}}}
+}
+
+func (r *testStreamRows) Next() (map[string]any, error) {
+	if r.idx == r.resolver.failAfter {
+		return nil, fmt.Errorf("stream failed\nafter %d rows", r.idx)
+	}
+	if r.idx >= r.resolver.rows {
+		return nil, io.EOF
+	}
+	row := map[string]any{"n": r.idx}
+	r.idx++
+	return row, nil
+}
+
+func (r *testStreamRows) Close() error {
+	return nil
+}
+
+func TestAPIPageSize(t *testing.T) {
+	tests := []struct {
+		name    string
+		p       *runtimev1.APIPagination
+		v       any
+		want    int64
+		wantErr bool
+	}{
+		{name: "default", p: &runtimev1.APIPagination{}, v: nil, want: _defaultPageSize},
+		{name: "spec default", p: &runtimev1.APIPagination{DefaultPageSize: 20}, v: nil, want: 20},
+		{name: "zero uses default", p: &runtimev1.APIPagination{DefaultPageSize: 20}, v: "0", want: 20},
+		{name: "string", p: &runtimev1.APIPagination{}, v: "50", want: 50},
+		{name: "number", p: &runtimev1.APIPagination{}, v: float64(50), want: 50},
+		{name: "clamped to max", p: &runtimev1.APIPagination{MaxPageSize: 30}, v: "50", want: 30},
+		{name: "default clamped to max", p: &runtimev1.APIPagination{MaxPageSize: 30}, v: nil, want: 30},
+		{name: "below max", p: &runtimev1.APIPagination{MaxPageSize: 30}, v: "10", want: 10},
+		{name: "negative", p: &runtimev1.APIPagination{}, v: "-1", wantErr: true},
+		{name: "not a number", p: &runtimev1.APIPagination{}, v: "abc", wantErr: true},
+		{name: "invalid type", p: &runtimev1.APIPagination{}, v: true, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n, err := apiPageSize(tt.p, tt.v)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, n)
+		})
+	}
+}
+
+func TestNewAPIPage(t *testing.T) {
+	offset := &runtimev1.APIPagination{Mode: runtimev1.APIPagination_MODE_OFFSET}
+	cursor := &runtimev1.APIPagination{Mode: runtimev1.APIPagination_MODE_CURSOR, CursorColumn: "id"}
+
+	// Last page in offset mode
+	page, err := newAPIPage(offset, &apiPageToken{Offset: 4}, 2, []byte(`[{"id":5}]`))
+	require.NoError(t, err)
+	require.Len(t, page.Data, 1)
+	require.Empty(t, page.NextPageToken)
+
+	// Empty page
+	page, err = newAPIPage(offset, &apiPageToken{}, 2, []byte(`[]`))
+	require.NoError(t, err)
+	require.NotNil(t, page.Data)
+	require.Empty(t, page.Data)
+	require.Empty(t, page.NextPageToken)
+
+	// Offset mode with a next page
+	page, err = newAPIPage(offset, &apiPageToken{Offset: 4}, 2, []byte(`[{"id":5},{"id":6},{"id":7}]`))
+	require.NoError(t, err)
+	require.Len(t, page.Data, 2)
+	next := &apiPageToken{}
+	require.NoError(t, unmarshalPageToken(page.NextPageToken, next))
+	require.Equal(t, int64(6), next.Offset)
+
+	// Cursor mode with a next page
+	page, err = newAPIPage(cursor, &apiPageToken{}, 2, []byte(`[{"id":5},{"id":6},{"id":7}]`))
+	require.NoError(t, err)
+	require.Len(t, page.Data, 2)
+	next = &apiPageToken{}
+	require.NoError(t, unmarshalPageToken(page.NextPageToken, next))
+	v, err := next.cursor()
+	require.NoError(t, err)
+	require.Equal(t, int64(6), v)
+
+	// Cursor mode where the page ends in the middle of rows with the same cursor value
+	_, err = newAPIPage(cursor, &apiPageToken{}, 2, []byte(`[{"id":5},{"id":6},{"id":6}]`))
+	require.ErrorContains(t, err, `cursor column "id" must be unique`)
+
+	// Cursor mode where the cursor column is missing
+	_, err = newAPIPage(&runtimev1.APIPagination{Mode: runtimev1.APIPagination_MODE_CURSOR, CursorColumn: "foo"}, &apiPageToken{}, 2, []byte(`[{"id":5},{"id":6},{"id":7}]`))
+	require.ErrorContains(t, err, `cursor column "foo" not found`)
+}
+
+func TestOpenAPIDocument(t *testing.T) {
+	maxLimit := 100.0
+	apis := []*runtimev1.Resource{
+		{
+			Meta: &runtimev1.ResourceMeta{Name: &runtimev1.ResourceName{Kind: runtime.ResourceKindAPI, Name: "paged"}},
+			Resource: &runtimev1.Resource_Api{Api: &runtimev1.API{Spec: &runtimev1.APISpec{
+				Description: "A paginated API",
+				Pagination:  &runtimev1.APIPagination{Mode: runtimev1.APIPagination_MODE_OFFSET, MaxPageSize: 50},
+			}}},
+		},
+		{
+			Meta: &runtimev1.ResourceMeta{Name: &runtimev1.ResourceName{Kind: runtime.ResourceKindAPI, Name: "args"}},
+			Resource: &runtimev1.Resource_Api{Api: &runtimev1.API{Spec: &runtimev1.APISpec{
+				Arguments: []*runtimev1.APIArgument{
+					{Name: "country", Type: runtimev1.APIArgument_TYPE_STRING, Required: true, Pattern: "^[A-Z]{2}$"},
+					{Name: "limit", Type: runtimev1.APIArgument_TYPE_INTEGER, Maximum: &maxLimit},
+				},
+			}}},
+		},
+	}
+
+	doc := newOpenAPIDocument(apis)
+	require.Equal(t, "3.0.3", doc.OpenAPI)
+	require.Len(t, doc.Paths, 2)
+
+	// API with args
+	args := doc.Paths["/args"]
+	require.NotNil(t, args)
+	require.Equal(t, "get_args", args.Get.OperationID)
+	require.Equal(t, "post_args", args.Post.OperationID)
+	require.Len(t, args.Get.Parameters, 2)
+	require.Equal(t, "country", args.Get.Parameters[0].Name)
+	require.True(t, args.Get.Parameters[0].Required)
+	require.Equal(t, "string", args.Get.Parameters[0].Schema.Type)
+	require.Equal(t, "^[A-Z]{2}$", args.Get.Parameters[0].Schema.Pattern)
+	require.Equal(t, "integer", args.Get.Parameters[1].Schema.Type)
+	require.Equal(t, &maxLimit, args.Get.Parameters[1].Schema.Maximum)
+	body := args.Post.RequestBody.Content["application/json"].Schema
+	require.Equal(t, []string{"country"}, body.Required)
+	require.Contains(t, body.Properties, "limit")
+	require.Equal(t, "array", args.Get.Responses["200"].Content["application/json"].Schema.Type)
+	require.Contains(t, args.Get.Responses["200"].Content, "application/x-ndjson")
+
+	// Paginated API
+	paged := doc.Paths["/paged"]
+	require.NotNil(t, paged)
+	require.Equal(t, "A paginated API", paged.Get.Description)
+	require.Len(t, paged.Get.Parameters, 2)
+	require.Equal(t, rillv1.APIPageSizeArg, paged.Get.Parameters[0].Name)
+	require.Equal(t, 50.0, *paged.Get.Parameters[0].Schema.Maximum)
+	require.Equal(t, rillv1.APIPageTokenArg, paged.Get.Parameters[1].Name)
+	require.Contains(t, paged.Post.RequestBody.Content["application/json"].Schema.Properties, rillv1.APIPageSizeArg)
+	schema := paged.Get.Responses["200"].Content["application/json"].Schema
+	require.Equal(t, "object", schema.Type)
+	require.Contains(t, schema.Properties, "data")
+	require.Contains(t, schema.Properties, "next_page_token")
+
+	// The document is valid JSON
+	_, err := json.Marshal(doc)
+	require.NoError(t, err)
+}
@@ -151,6 +151,7 @@ func driverSpecToPB(name string, spec drivers.Spec) *runtimev1.ConnectorDriver {
 		ImplementsObjectStore: spec.ImplementsObjectStore,
 		ImplementsFileStore:   spec.ImplementsFileStore,
 		ImplementsNotifier:    spec.ImplementsNotifier,
+		ImplementsStreamStore: spec.ImplementsStreamStore,
 	}
 
 	for _, prop := range spec.ConfigProperties {
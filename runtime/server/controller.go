@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"slices"
@@ -11,9 +12,10 @@ import (
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime"
 	"github.com/rilldata/rill/runtime/drivers"
+	emaildriver "github.com/rilldata/rill/runtime/drivers/email"
 	"github.com/rilldata/rill/runtime/drivers/slack"
+	"github.com/rilldata/rill/runtime/drivers/webhook"
 	"github.com/rilldata/rill/runtime/pkg/observability"
-	"github.com/rilldata/rill/runtime/pkg/pbutil"
 	"github.com/rilldata/rill/runtime/server/auth"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
@@ -21,6 +23,8 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // ListResources implements runtimev1.RuntimeServiceServer
@@ -181,6 +185,91 @@ func (s *Server) GetResource(ctx context.Context, req *runtimev1.GetResourceRequ
 	return &runtimev1.GetResourceResponse{Resource: r}, nil
 }
 
+// GetModelPartitions implements runtimev1.RuntimeServiceServer
+func (s *Server) GetModelPartitions(ctx context.Context, req *runtimev1.GetModelPartitionsRequest) (*runtimev1.GetModelPartitionsResponse, error) {
+	s.addInstanceRequestAttributes(ctx, req.InstanceId)
+	observability.AddRequestAttributes(ctx,
+		attribute.String("args.instance_id", req.InstanceId),
+		attribute.String("args.model", req.Model),
+		attribute.Bool("args.pending", req.Pending),
+		attribute.Bool("args.errored", req.Errored),
+	)
+
+	if !auth.GetClaims(ctx).CanInstance(req.InstanceId, auth.ReadObjects) {
+		return nil, ErrForbidden
+	}
+
+	ctrl, err := s.runtime.Controller(ctx, req.InstanceId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	r, err := ctrl.Get(ctx, &runtimev1.ResourceName{Kind: runtime.ResourceKindModel, Name: req.Model}, false)
+	if err != nil {
+		if errors.Is(err, drivers.ErrResourceNotFound) {
+			return nil, status.Error(codes.NotFound, "model not found")
+		}
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	r, access, err := s.applySecurityPolicy(ctx, req.InstanceId, r)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if !access {
+		return nil, status.Error(codes.NotFound, "model not found")
+	}
+
+	partitionsModelID := r.GetModel().State.PartitionsModelId
+	if partitionsModelID == "" {
+		return &runtimev1.GetModelPartitionsResponse{}, nil
+	}
+
+	opts := &drivers.FindModelPartitionsOptions{
+		ModelID:      partitionsModelID,
+		WherePending: req.Pending,
+		WhereErrored: req.Errored,
+		Limit:        validPageSize(req.PageSize),
+	}
+	tkn := &modelPartitionsPageToken{}
+	err = unmarshalPageToken(req.PageToken, tkn)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	opts.AfterIndex = tkn.Index
+	opts.AfterKey = tkn.Key
+
+	catalog, release, err := s.runtime.Catalog(ctx, req.InstanceId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	defer release()
+
+	partitions, err := catalog.FindModelPartitions(ctx, opts)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	var nextPageToken string
+	if len(partitions) == opts.Limit {
+		last := partitions[len(partitions)-1]
+		nextPageToken = marshalPageToken(&modelPartitionsPageToken{Index: last.Index, Key: last.Key})
+	}
+
+	res := make([]*runtimev1.ModelPartition, len(partitions))
+	for i, p := range partitions {
+		res[i], err = modelPartitionToPB(p)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return &runtimev1.GetModelPartitionsResponse{
+		Partitions:    res,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
 // CreateTrigger implements runtimev1.RuntimeServiceServer
 func (s *Server) CreateTrigger(ctx context.Context, req *runtimev1.CreateTriggerRequest) (*runtimev1.CreateTriggerResponse, error) {
 	s.addInstanceRequestAttributes(ctx, req.InstanceId)
@@ -205,6 +294,14 @@ func (s *Server) CreateTrigger(ctx context.Context, req *runtimev1.CreateTrigger
 		kind = runtime.ResourceKindPullTrigger
 		r.Resource = &runtimev1.Resource_PullTrigger{PullTrigger: &runtimev1.PullTrigger{Spec: trg.PullTriggerSpec}}
 	case *runtimev1.CreateTriggerRequest_RefreshTriggerSpec:
+		for _, bt := range trg.RefreshTriggerSpec.GetBackfills() {
+			if bt.Name == nil || (bt.Name.Kind != runtime.ResourceKindReport && bt.Name.Kind != runtime.ResourceKindAlert) {
+				return nil, status.Error(codes.InvalidArgument, "backfills are only supported for reports and alerts")
+			}
+			if bt.From == nil || bt.To == nil || !bt.From.AsTime().Before(bt.To.AsTime()) {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid backfill range for %q: 'from' must be before 'to'", bt.Name.Name)
+			}
+		}
 		kind = runtime.ResourceKindRefreshTrigger
 		r.Resource = &runtimev1.Resource_RefreshTrigger{RefreshTrigger: &runtimev1.RefreshTrigger{Spec: trg.RefreshTriggerSpec}}
 	}
@@ -229,14 +326,51 @@ func (s *Server) applySecurityPolicy(ctx context.Context, instID string, r *runt
 	case *runtimev1.Resource_MetricsView:
 		return s.applySecurityPolicyMetricsView(ctx, instID, r)
 	case *runtimev1.Resource_Report:
-		return s.applySecurityPolicyReport(ctx, r)
+		r, ok, err := s.applySecurityPolicyReport(ctx, instID, r)
+		if !ok || err != nil {
+			return r, ok, err
+		}
+		return maskNotifierSecrets(r, r.GetReport().Spec.Notifiers)
 	case *runtimev1.Resource_Alert:
-		return s.applySecurityPolicyAlert(ctx, r)
+		r, ok, err := s.applySecurityPolicyAlert(ctx, instID, r)
+		if !ok || err != nil {
+			return r, ok, err
+		}
+		return maskNotifierSecrets(r, r.GetAlert().Spec.Notifiers)
 	default:
 		return r, true, nil
 	}
 }
 
+// maskNotifierSecrets masks the header values of a resource's webhook notifiers, which may contain credentials.
+// It clones the resource before modifying it.
+func maskNotifierSecrets(r *runtimev1.Resource, notifiers []*runtimev1.Notifier) (*runtimev1.Resource, bool, error) {
+	var cloned bool
+	for i, notifier := range notifiers {
+		// Only webhook notifiers have headers
+		if notifier.Properties.GetFields()["headers"] == nil {
+			continue
+		}
+
+		props, err := structpb.NewStruct(webhook.MaskProps(notifier.Properties.AsMap()))
+		if err != nil {
+			return nil, false, err
+		}
+
+		if !cloned {
+			r = proto.Clone(r).(*runtimev1.Resource)
+			cloned = true
+		}
+		switch res := r.Resource.(type) {
+		case *runtimev1.Resource_Report:
+			res.Report.Spec.Notifiers[i].Properties = props
+		case *runtimev1.Resource_Alert:
+			res.Alert.Spec.Notifiers[i].Properties = props
+		}
+	}
+	return r, true, nil
+}
+
 // applySecurityPolicyMetricsView applies relevant security policies to a metrics view.
 func (s *Server) applySecurityPolicyMetricsView(ctx context.Context, instID string, r *runtimev1.Resource) (*runtimev1.Resource, bool, error) {
 	ctx, span := tracer.Start(ctx, "applySecurityPolicyMetricsView", trace.WithAttributes(attribute.String("instance_id", instID), attribute.String("kind", r.Meta.Name.Kind), attribute.String("name", r.Meta.Name.Name)))
@@ -373,7 +507,7 @@ func (s *Server) applySecurityPolicyMetricsViewIncludesAndExcludes(mv *runtimev1
 
 // applySecurityPolicyReport applies security policies to a report.
 // TODO: This implementation is very specific to properties currently set by the admin server. Consider refactoring to a more generic implementation.
-func (s *Server) applySecurityPolicyReport(ctx context.Context, r *runtimev1.Resource) (*runtimev1.Resource, bool, error) {
+func (s *Server) applySecurityPolicyReport(ctx context.Context, instID string, r *runtimev1.Resource) (*runtimev1.Resource, bool, error) {
 	report := r.GetReport()
 	claims := auth.GetClaims(ctx)
 
@@ -396,26 +530,12 @@ func (s *Server) applySecurityPolicyReport(ctx context.Context, r *runtimev1.Res
 	}
 
 	// Allow if the user is a recipient
-	for _, notifier := range report.Spec.Notifiers {
-		switch notifier.Connector {
-		case "email":
-			recipients := pbutil.ToSliceString(notifier.Properties.AsMap()["recipients"])
-			for _, recipient := range recipients {
-				if recipient == email {
-					return r, true, nil
-				}
-			}
-		case "slack":
-			props, err := slack.DecodeProps(notifier.Properties.AsMap())
-			if err != nil {
-				return nil, false, err
-			}
-			for _, user := range props.Users {
-				if user == email {
-					return r, true, nil
-				}
-			}
-		}
+	ok, err := s.isNotifierRecipient(ctx, instID, report.Spec.Notifiers, email)
+	if err != nil {
+		return nil, false, err
+	}
+	if ok {
+		return r, true, nil
 	}
 
 	// Don't allow
@@ -424,7 +544,7 @@ func (s *Server) applySecurityPolicyReport(ctx context.Context, r *runtimev1.Res
 
 // applySecurityPolicyAlert applies security policies to an alert.
 // TODO: This implementation is very specific to properties currently set by the admin server. Consider refactoring to a more generic implementation.
-func (s *Server) applySecurityPolicyAlert(ctx context.Context, r *runtimev1.Resource) (*runtimev1.Resource, bool, error) {
+func (s *Server) applySecurityPolicyAlert(ctx context.Context, instID string, r *runtimev1.Resource) (*runtimev1.Resource, bool, error) {
 	alert := r.GetAlert()
 	claims := auth.GetClaims(ctx)
 
@@ -446,29 +566,92 @@ func (s *Server) applySecurityPolicyAlert(ctx context.Context, r *runtimev1.Reso
 		return r, true, nil
 	}
 
-	// Allow if the user is an email recipient
-	for _, notifier := range alert.Spec.Notifiers {
-		switch notifier.Connector {
-		case "email":
-			recipients := pbutil.ToSliceString(notifier.Properties.AsMap()["recipients"])
-			for _, recipient := range recipients {
-				if recipient == email {
-					return r, true, nil
-				}
-			}
+	// Allow if the user is a recipient
+	ok, err := s.isNotifierRecipient(ctx, instID, alert.Spec.Notifiers, email)
+	if err != nil {
+		return nil, false, err
+	}
+	if ok {
+		return r, true, nil
+	}
+
+	// Don't allow
+	return nil, false, nil
+}
+
+// isNotifierRecipient returns true if the email is a recipient of any of the notifiers.
+// Since notifiers may use connectors with custom names, it resolves the driver of each notifier's connector to decode its properties.
+func (s *Server) isNotifierRecipient(ctx context.Context, instID string, notifiers []*runtimev1.Notifier, email string) (bool, error) {
+	for _, notifier := range notifiers {
+		cfg, err := s.runtime.ConnectorConfig(ctx, instID, notifier.Connector)
+		if err != nil {
+			return false, err
+		}
+
+		var recipients []string
+		switch cfg.Driver {
 		case "slack":
 			props, err := slack.DecodeProps(notifier.Properties.AsMap())
 			if err != nil {
-				return nil, false, err
+				return false, err
 			}
-			for _, user := range props.Users {
-				if user == email {
-					return r, true, nil
-				}
+			recipients = props.Users
+		case "email":
+			props, err := emaildriver.DecodeProps(notifier.Properties.AsMap())
+			if err != nil {
+				return false, err
+			}
+			recipients = props.Recipients
+		case "webhook":
+			// Webhooks don't have recipients that can be matched against the user's email.
+			continue
+		default:
+			// Don't grant access based on notifier properties we don't know how to decode.
+			continue
+		}
+
+		for _, recipient := range recipients {
+			if recipient == email {
+				return true, nil
 			}
 		}
 	}
+	return false, nil
+}
 
-	// Don't allow
-	return nil, false, nil
+// modelPartitionsPageToken is the pagination cursor for GetModelPartitions.
+type modelPartitionsPageToken struct {
+	Index int    `json:"i"`
+	Key   string `json:"k"`
+}
+
+// modelPartitionToPB converts a drivers.ModelPartition to a runtimev1.ModelPartition.
+func modelPartitionToPB(p drivers.ModelPartition) (*runtimev1.ModelPartition, error) {
+	var data map[string]any
+	err := json.Unmarshal(p.DataJSON, &data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal partition data: %w", err)
+	}
+
+	dataPB, err := structpb.NewStruct(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert partition data: %w", err)
+	}
+
+	var watermark, executedOn *timestamppb.Timestamp
+	if p.Watermark != nil {
+		watermark = timestamppb.New(*p.Watermark)
+	}
+	if p.ExecutedOn != nil {
+		executedOn = timestamppb.New(*p.ExecutedOn)
+	}
+
+	return &runtimev1.ModelPartition{
+		Key:        p.Key,
+		Data:       dataPB,
+		Watermark:  watermark,
+		ExecutedOn: executedOn,
+		Error:      p.Error,
+		ElapsedMs:  uint32(p.Elapsed.Milliseconds()),
+	}, nil
 }
@@ -0,0 +1,37 @@
+package server
+
+import (
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers/email"
+	"github.com/rilldata/rill/runtime/drivers/webhook"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestMaskNotifierSecrets(t *testing.T) {
+	emailProps, err := structpb.NewStruct(email.EncodeProps([]string{"foo@example.com"}))
+	require.NoError(t, err)
+	webhookProps, err := structpb.NewStruct(webhook.EncodeProps([]string{"https://example.com"}, map[string]string{"X-Team": "data"}))
+	require.NoError(t, err)
+
+	r := &runtimev1.Resource{Resource: &runtimev1.Resource_Alert{Alert: &runtimev1.Alert{Spec: &runtimev1.AlertSpec{
+		Notifiers: []*runtimev1.Notifier{
+			{Connector: "email", Properties: emailProps},
+			{Connector: "webhook", Properties: webhookProps},
+		},
+	}}}}
+
+	masked, ok, err := maskNotifierSecrets(r, r.GetAlert().Spec.Notifiers)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	notifiers := masked.GetAlert().Spec.Notifiers
+	require.Equal(t, emailProps.AsMap(), notifiers[0].Properties.AsMap())
+	require.Equal(t, map[string]any{"X-Team": webhook.MaskedHeaderValue}, notifiers[1].Properties.AsMap()["headers"])
+	require.Equal(t, []any{"https://example.com"}, notifiers[1].Properties.AsMap()["urls"])
+
+	// The input resource is not modified
+	require.Equal(t, map[string]any{"X-Team": "data"}, r.GetAlert().Spec.Notifiers[1].Properties.AsMap()["headers"])
+}
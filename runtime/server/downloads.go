@@ -311,6 +311,12 @@ func (s *Server) downloadHandler(w http.ResponseWriter, req *http.Request) {
 			case runtimev1.ExportFormat_EXPORT_FORMAT_PARQUET:
 				w.Header().Set("Content-Type", "application/octet-stream")
 				w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s.parquet\"", filename))
+			case runtimev1.ExportFormat_EXPORT_FORMAT_JSONL:
+				w.Header().Set("Content-Type", "application/x-ndjson")
+				w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s.jsonl\"", filename))
+			case runtimev1.ExportFormat_EXPORT_FORMAT_ARROW:
+				w.Header().Set("Content-Type", "application/vnd.apache.arrow.stream")
+				w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s.arrows\"", filename))
 			default:
 				return fmt.Errorf("unsupported format %q", request.Format.String())
 			}
@@ -0,0 +1,302 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/compilers/rillv1"
+	"github.com/rilldata/rill/runtime/pkg/httputil"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"github.com/rilldata/rill/runtime/server/auth"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// openAPIHandler serves an OpenAPI 3 document that describes the custom APIs of an instance.
+// The paths in the document are relative to the custom APIs endpoint, i.e. the directory the document is served from.
+func (s *Server) openAPIHandler(w http.ResponseWriter, req *http.Request) error {
+	ctx := req.Context()
+	instanceID := req.PathValue("instance_id")
+
+	observability.AddRequestAttributes(ctx, attribute.String("args.instance_id", instanceID))
+	s.addInstanceRequestAttributes(ctx, instanceID)
+
+	if !auth.GetClaims(ctx).CanInstance(instanceID, auth.ReadAPI) {
+		return httputil.Errorf(http.StatusForbidden, "does not have access to custom APIs")
+	}
+
+	ctrl, err := s.runtime.Controller(ctx, instanceID)
+	if err != nil {
+		return httputil.Error(http.StatusBadRequest, err)
+	}
+
+	rs, err := ctrl.List(ctx, runtime.ResourceKindAPI, "", false)
+	if err != nil {
+		return httputil.Error(http.StatusInternalServerError, err)
+	}
+
+	data, err := json.Marshal(newOpenAPIDocument(rs))
+	if err != nil {
+		return httputil.Error(http.StatusInternalServerError, err)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, err = w.Write(data)
+	if err != nil {
+		return httputil.Error(http.StatusInternalServerError, err)
+	}
+
+	return nil
+}
+
+// openAPIDocument is the subset of the OpenAPI 3 specification that is used to describe custom APIs.
+// See https://spec.openapis.org/oas/v3.0.3 for details.
+type openAPIDocument struct {
+	OpenAPI    string                      `json:"openapi"`
+	Info       openAPIInfo                 `json:"info"`
+	Servers    []openAPIServer             `json:"servers"`
+	Paths      map[string]*openAPIPathItem `json:"paths"`
+	Components openAPIComponents           `json:"components"`
+	Security   []map[string][]string       `json:"security"`
+}
+
+type openAPIInfo struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+type openAPIServer struct {
+	URL string `json:"url"`
+}
+
+type openAPIPathItem struct {
+	Get  *openAPIOperation `json:"get,omitempty"`
+	Post *openAPIOperation `json:"post,omitempty"`
+}
+
+type openAPIOperation struct {
+	OperationID string                      `json:"operationId"`
+	Summary     string                      `json:"summary,omitempty"`
+	Description string                      `json:"description,omitempty"`
+	Parameters  []*openAPIParameter         `json:"parameters,omitempty"`
+	RequestBody *openAPIRequestBody         `json:"requestBody,omitempty"`
+	Responses   map[string]*openAPIResponse `json:"responses"`
+}
+
+type openAPIParameter struct {
+	Name        string         `json:"name"`
+	In          string         `json:"in"`
+	Description string         `json:"description,omitempty"`
+	Required    bool           `json:"required,omitempty"`
+	Schema      *openAPISchema `json:"schema"`
+}
+
+type openAPIRequestBody struct {
+	Content map[string]*openAPIMediaType `json:"content"`
+}
+
+type openAPIResponse struct {
+	Description string                       `json:"description"`
+	Content     map[string]*openAPIMediaType `json:"content,omitempty"`
+}
+
+type openAPIMediaType struct {
+	Schema *openAPISchema `json:"schema"`
+}
+
+type openAPIComponents struct {
+	Schemas         map[string]*openAPISchema         `json:"schemas"`
+	SecuritySchemes map[string]*openAPISecurityScheme `json:"securitySchemes"`
+}
+
+type openAPISecurityScheme struct {
+	Type   string `json:"type"`
+	Scheme string `json:"scheme"`
+}
+
+type openAPISchema struct {
+	Ref                  string                    `json:"$ref,omitempty"`
+	Type                 string                    `json:"type,omitempty"`
+	Description          string                    `json:"description,omitempty"`
+	Default              any                       `json:"default,omitempty"`
+	Enum                 []any                     `json:"enum,omitempty"`
+	Minimum              *float64                  `json:"minimum,omitempty"`
+	Maximum              *float64                  `json:"maximum,omitempty"`
+	Pattern              string                    `json:"pattern,omitempty"`
+	Items                *openAPISchema            `json:"items,omitempty"`
+	Properties           map[string]*openAPISchema `json:"properties,omitempty"`
+	Required             []string                  `json:"required,omitempty"`
+	AdditionalProperties bool                      `json:"additionalProperties,omitempty"`
+}
+
+// newOpenAPIDocument builds an OpenAPI document for the given API resources.
+// Each API is exposed at "/{name}" with a GET operation that accepts args as query parameters and a POST operation that accepts args as a JSON body.
+func newOpenAPIDocument(apis []*runtimev1.Resource) *openAPIDocument {
+	doc := &openAPIDocument{
+		OpenAPI: "3.0.3",
+		Info: openAPIInfo{
+			Title:   "Custom APIs",
+			Version: "1.0.0",
+		},
+		Servers: []openAPIServer{{URL: "."}},
+		Paths:   make(map[string]*openAPIPathItem),
+		Components: openAPIComponents{
+			Schemas: map[string]*openAPISchema{
+				"Row": {
+					Type:                 "object",
+					AdditionalProperties: true,
+				},
+				"Error": {
+					Type:       "object",
+					Properties: map[string]*openAPISchema{"error": {Type: "string"}},
+				},
+			},
+			SecuritySchemes: map[string]*openAPISecurityScheme{
+				"bearerAuth": {Type: "http", Scheme: "bearer"},
+			},
+		},
+		Security: []map[string][]string{{"bearerAuth": {}}},
+	}
+
+	sort.Slice(apis, func(i, j int) bool {
+		return apis[i].Meta.Name.Name < apis[j].Meta.Name.Name
+	})
+
+	for _, r := range apis {
+		name := r.Meta.Name.Name
+		spec := r.GetApi().Spec
+
+		// Build the args schemas
+		var params []*openAPIParameter
+		body := &openAPISchema{
+			Type:       "object",
+			Properties: make(map[string]*openAPISchema),
+		}
+		for _, arg := range spec.Arguments {
+			schema := newOpenAPIArgumentSchema(arg)
+			params = append(params, &openAPIParameter{
+				Name:        arg.Name,
+				In:          "query",
+				Description: arg.Description,
+				Required:    arg.Required,
+				Schema:      schema,
+			})
+			body.Properties[arg.Name] = schema
+			if arg.Required {
+				body.Required = append(body.Required, arg.Name)
+			}
+		}
+
+		// Build the response schema
+		rows := &openAPISchema{
+			Type:  "array",
+			Items: &openAPISchema{Ref: "#/components/schemas/Row"},
+		}
+		var ok *openAPIResponse
+		if spec.Pagination != nil {
+			minPageSize := 1.0
+			pageSize := &openAPISchema{Type: "integer", Minimum: &minPageSize}
+			if spec.Pagination.MaxPageSize != 0 {
+				maxPageSize := float64(spec.Pagination.MaxPageSize)
+				pageSize.Maximum = &maxPageSize
+			}
+			pageToken := &openAPISchema{Type: "string"}
+			params = append(params,
+				&openAPIParameter{Name: rillv1.APIPageSizeArg, In: "query", Description: "Maximum number of rows to return", Schema: pageSize},
+				&openAPIParameter{Name: rillv1.APIPageTokenArg, In: "query", Description: "Token for the page to return, taken from the next_page_token of the previous page", Schema: pageToken},
+			)
+			body.Properties[rillv1.APIPageSizeArg] = pageSize
+			body.Properties[rillv1.APIPageTokenArg] = pageToken
+
+			ok = &openAPIResponse{
+				Description: "A page of rows",
+				Content: map[string]*openAPIMediaType{
+					"application/json": {Schema: &openAPISchema{
+						Type: "object",
+						Properties: map[string]*openAPISchema{
+							"data":            rows,
+							"next_page_token": {Type: "string", Description: "Token for the next page. Omitted on the last page."},
+						},
+						Required: []string{"data"},
+					}},
+				},
+			}
+		} else {
+			ok = &openAPIResponse{
+				Description: "The rows returned by the API",
+				Content: map[string]*openAPIMediaType{
+					"application/json":     {Schema: rows},
+					"application/x-ndjson": {Schema: &openAPISchema{Ref: "#/components/schemas/Row"}},
+				},
+			}
+		}
+
+		errResponse := func(description string) *openAPIResponse {
+			return &openAPIResponse{
+				Description: description,
+				Content: map[string]*openAPIMediaType{
+					"application/json": {Schema: &openAPISchema{Ref: "#/components/schemas/Error"}},
+				},
+			}
+		}
+		responses := map[string]*openAPIResponse{
+			"200": ok,
+			"400": errResponse("Invalid args or failed query"),
+			"403": errResponse("Not authorized to access the API"),
+			"404": errResponse("API not found"),
+		}
+
+		doc.Paths["/"+name] = &openAPIPathItem{
+			Get: &openAPIOperation{
+				OperationID: "get_" + name,
+				Summary:     name,
+				Description: spec.Description,
+				Parameters:  params,
+				Responses:   responses,
+			},
+			Post: &openAPIOperation{
+				OperationID: "post_" + name,
+				Summary:     name,
+				Description: spec.Description,
+				RequestBody: &openAPIRequestBody{
+					Content: map[string]*openAPIMediaType{"application/json": {Schema: body}},
+				},
+				Responses: responses,
+			},
+		}
+	}
+
+	return doc
+}
+
+// newOpenAPIArgumentSchema returns the OpenAPI schema for a custom API argument.
+func newOpenAPIArgumentSchema(arg *runtimev1.APIArgument) *openAPISchema {
+	schema := &openAPISchema{
+		Description: arg.Description,
+		Minimum:     arg.Minimum,
+		Maximum:     arg.Maximum,
+		Pattern:     arg.Pattern,
+	}
+
+	switch arg.Type {
+	case runtimev1.APIArgument_TYPE_INTEGER:
+		schema.Type = "integer"
+	case runtimev1.APIArgument_TYPE_NUMBER:
+		schema.Type = "number"
+	case runtimev1.APIArgument_TYPE_BOOLEAN:
+		schema.Type = "boolean"
+	default:
+		schema.Type = "string"
+	}
+
+	if arg.DefaultValue != nil {
+		schema.Default = arg.DefaultValue.AsInterface()
+	}
+	for _, v := range arg.AllowedValues {
+		schema.Enum = append(schema.Enum, v.AsInterface())
+	}
+
+	return schema
+}
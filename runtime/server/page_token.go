@@ -0,0 +1,46 @@
+package server
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+)
+
+const _defaultPageSize = 100
+
+// marshalPageToken encodes a pagination cursor as an opaque page token.
+func marshalPageToken(val any) string {
+	bytes, err := json.Marshal(val)
+	if err != nil {
+		panic(err)
+	}
+
+	return base64.URLEncoding.EncodeToString(bytes)
+}
+
+// unmarshalPageToken decodes a page token created with marshalPageToken into val.
+// It leaves val untouched if the token is empty.
+func unmarshalPageToken(tkn string, val any) error {
+	if tkn == "" {
+		return nil
+	}
+
+	bytes, err := base64.URLEncoding.DecodeString(tkn)
+	if err != nil {
+		return fmt.Errorf("failed to parse page token: %w", err)
+	}
+
+	err = json.Unmarshal(bytes, val)
+	if err != nil {
+		return fmt.Errorf("failed to parse page token: %w", err)
+	}
+
+	return nil
+}
+
+func validPageSize(pageSize uint32) int {
+	if pageSize == 0 {
+		return _defaultPageSize
+	}
+	return int(pageSize)
+}
@@ -212,6 +212,10 @@ func (s *Server) HTTPHandler(ctx context.Context, registerAdditionalHandlers fun
 	// Add handler for dynamic APIs, i.e. APIs backed by resolvers (such as custom APIs defined in YAML).
 	observability.MuxHandle(httpMux, "/v1/instances/{instance_id}/api/{name...}", observability.Middleware("runtime", s.logger, auth.HTTPMiddleware(s.aud, httputil.Handler(s.apiHandler))))
 
+	// Add handler for the OpenAPI document describing the dynamic APIs.
+	// NOTE: It takes precedence over an API named "openapi.json" since the pattern is more specific.
+	observability.MuxHandle(httpMux, "/v1/instances/{instance_id}/api/openapi.json", observability.Middleware("runtime", s.logger, auth.HTTPMiddleware(s.aud, httputil.Handler(s.openAPIHandler))))
+
 	// Add handler for resolving component data
 	observability.MuxHandle(httpMux, "/v1/instances/{instance_id}/components/{name}/data", observability.Middleware("runtime", s.logger, auth.HTTPMiddleware(s.aud, httputil.Handler(s.componentDataHandler))))
 
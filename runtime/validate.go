@@ -133,6 +133,10 @@ func validateAllDimensionsAndMeasures(ctx context.Context, olap drivers.OLAPStor
 	}
 	var metricExprs []string
 	for _, m := range mv.Measures {
+		if drivers.IsMetricsViewWindowMeasure(m) {
+			// Window measures don't have an expression. The measure they reference is validated instead.
+			continue
+		}
 		metricExprs = append(metricExprs, "("+m.Expression+")")
 	}
 	var query string
@@ -201,6 +205,9 @@ func validateIndividualDimensionsAndMeasures(ctx context.Context, olap drivers.O
 
 	// Check measure expressions are valid
 	for idx, m := range mv.Measures {
+		if drivers.IsMetricsViewWindowMeasure(m) {
+			continue
+		}
 		idx := idx
 		m := m
 		grp.Go(func() error {
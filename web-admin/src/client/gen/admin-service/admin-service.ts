@@ -31,6 +31,7 @@ import type {
   V1UpdateOrganizationResponse,
   AdminServiceUpdateOrganizationBody,
   V1ListOrganizationInvitesResponse,
+  AdminServiceListAuditEventsParams,
   AdminServiceListOrganizationInvitesParams,
   V1ListOrganizationMembersResponse,
   AdminServiceListOrganizationMembersParams,
@@ -60,6 +61,28 @@ import type {
   V1AddProjectMemberResponse,
   V1RemoveProjectMemberResponse,
   V1SetProjectMemberRoleResponse,
+  V1GetSSOProviderResponse,
+  V1DeleteSSOProviderResponse,
+  V1SetSSOProviderResponse,
+  AdminServiceSetSSOProviderBody,
+  V1ListUsergroupsResponse,
+  AdminServiceListUsergroupsParams,
+  V1CreateUsergroupResponse,
+  AdminServiceCreateUsergroupBody,
+  V1RenameUsergroupResponse,
+  AdminServiceRenameUsergroupBody,
+  V1DeleteUsergroupResponse,
+  V1ListUsergroupMembersResponse,
+  AdminServiceListUsergroupMembersParams,
+  V1AddUsergroupMemberResponse,
+  AdminServiceAddUsergroupMemberBody,
+  V1RemoveUsergroupMemberResponse,
+  V1ListProjectMemberUsergroupsResponse,
+  AdminServiceListProjectMemberUsergroupsParams,
+  V1AddProjectMemberUsergroupResponse,
+  AdminServiceAddProjectMemberUsergroupBody,
+  V1RemoveProjectMemberUsergroupResponse,
+  V1SetProjectMemberUsergroupRoleResponse,
   V1CreateReportResponse,
   AdminServiceCreateReportBodyBody,
   V1GenerateReportYAMLResponse,
@@ -86,6 +109,7 @@ import type {
   V1UpdateProjectResponse,
   AdminServiceUpdateProjectBody,
   V1GetProjectVariablesResponse,
+  AdminServiceGetProjectVariablesParams,
   V1UpdateProjectVariablesResponse,
   AdminServiceUpdateProjectVariablesBody,
   V1ListServicesResponse,
@@ -113,6 +137,8 @@ import type {
   V1SetSuperuserRequest,
   V1SudoUpdateAnnotationsResponse,
   V1SudoUpdateAnnotationsRequest,
+  V1SudoRotateEncryptionKeysResponse,
+  V1SudoRotateEncryptionKeysRequest,
   V1SearchProjectNamesResponse,
   AdminServiceSearchProjectNamesParams,
   V1SudoUpdateOrganizationQuotasResponse,
@@ -126,6 +152,7 @@ import type {
   V1IssueRepresentativeAuthTokenRequest,
   V1GetUserResponse,
   AdminServiceGetUserParams,
+  V1ListAuditEventsResponse,
   V1ListBookmarksResponse,
   AdminServiceListBookmarksParams,
   V1CreateBookmarkResponse,
@@ -631,6 +658,76 @@ export const createAdminServiceUpdateOrganization = <
     TContext
   >(mutationFn, mutationOptions);
 };
+
+/**
+ * @summary ListAuditEvents lists the audit events of an organization, newest first.
+ */
+export const adminServiceListAuditEvents = (
+  organization: string,
+  params?: AdminServiceListAuditEventsParams,
+  signal?: AbortSignal,
+) => {
+  return httpClient<V1ListAuditEventsResponse>({
+    url: `/v1/organizations/${organization}/audit-events`,
+    method: "get",
+    params,
+    signal,
+  });
+};
+
+export const getAdminServiceListAuditEventsQueryKey = (
+  organization: string,
+  params?: AdminServiceListAuditEventsParams,
+) => [
+  `/v1/organizations/${organization}/audit-events`,
+  ...(params ? [params] : []),
+];
+
+export type AdminServiceListAuditEventsQueryResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceListAuditEvents>>
+>;
+export type AdminServiceListAuditEventsQueryError = RpcStatus;
+
+export const createAdminServiceListAuditEvents = <
+  TData = Awaited<ReturnType<typeof adminServiceListAuditEvents>>,
+  TError = RpcStatus,
+>(
+  organization: string,
+  params?: AdminServiceListAuditEventsParams,
+  options?: {
+    query?: CreateQueryOptions<
+      Awaited<ReturnType<typeof adminServiceListAuditEvents>>,
+      TError,
+      TData
+    >;
+  },
+): CreateQueryResult<TData, TError> & { queryKey: QueryKey } => {
+  const { query: queryOptions } = options ?? {};
+
+  const queryKey =
+    queryOptions?.queryKey ??
+    getAdminServiceListAuditEventsQueryKey(organization, params);
+
+  const queryFn: QueryFunction<
+    Awaited<ReturnType<typeof adminServiceListAuditEvents>>
+  > = ({ signal }) =>
+    adminServiceListAuditEvents(organization, params, signal);
+
+  const query = createQuery<
+    Awaited<ReturnType<typeof adminServiceListAuditEvents>>,
+    TError,
+    TData
+  >({
+    queryKey,
+    queryFn,
+    enabled: !!organization,
+    ...queryOptions,
+  }) as CreateQueryResult<TData, TError> & { queryKey: QueryKey };
+
+  query.queryKey = queryKey;
+
+  return query;
+};
 /**
  * @summary ListOrganizationInvites lists all the org invites
  */
@@ -1465,25 +1562,867 @@ export const adminServiceGetGitCredentials = (
   });
 };
 
-export const getAdminServiceGetGitCredentialsQueryKey = (
+export const getAdminServiceGetGitCredentialsQueryKey = (
+  organization: string,
+  project: string,
+) => [`/v1/organizations/${organization}/projects/${project}/git-credentials`];
+
+export type AdminServiceGetGitCredentialsQueryResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceGetGitCredentials>>
+>;
+export type AdminServiceGetGitCredentialsQueryError = RpcStatus;
+
+export const createAdminServiceGetGitCredentials = <
+  TData = Awaited<ReturnType<typeof adminServiceGetGitCredentials>>,
+  TError = RpcStatus,
+>(
+  organization: string,
+  project: string,
+  options?: {
+    query?: CreateQueryOptions<
+      Awaited<ReturnType<typeof adminServiceGetGitCredentials>>,
+      TError,
+      TData
+    >;
+  },
+): CreateQueryResult<TData, TError> & { queryKey: QueryKey } => {
+  const { query: queryOptions } = options ?? {};
+
+  const queryKey =
+    queryOptions?.queryKey ??
+    getAdminServiceGetGitCredentialsQueryKey(organization, project);
+
+  const queryFn: QueryFunction<
+    Awaited<ReturnType<typeof adminServiceGetGitCredentials>>
+  > = ({ signal }) =>
+    adminServiceGetGitCredentials(organization, project, signal);
+
+  const query = createQuery<
+    Awaited<ReturnType<typeof adminServiceGetGitCredentials>>,
+    TError,
+    TData
+  >({
+    queryKey,
+    queryFn,
+    enabled: !!(organization && project),
+    ...queryOptions,
+  }) as CreateQueryResult<TData, TError> & { queryKey: QueryKey };
+
+  query.queryKey = queryKey;
+
+  return query;
+};
+
+/**
+ * @summary GetIFrame returns the iframe URL for the given project
+ */
+export const adminServiceGetIFrame = (
+  organization: string,
+  project: string,
+  adminServiceGetIFrameBody: AdminServiceGetIFrameBody,
+) => {
+  return httpClient<V1GetIFrameResponse>({
+    url: `/v1/organizations/${organization}/projects/${project}/iframe`,
+    method: "post",
+    headers: { "Content-Type": "application/json" },
+    data: adminServiceGetIFrameBody,
+  });
+};
+
+export type AdminServiceGetIFrameMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceGetIFrame>>
+>;
+export type AdminServiceGetIFrameMutationBody = AdminServiceGetIFrameBody;
+export type AdminServiceGetIFrameMutationError = RpcStatus;
+
+export const createAdminServiceGetIFrame = <
+  TError = RpcStatus,
+  TContext = unknown,
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceGetIFrame>>,
+    TError,
+    { organization: string; project: string; data: AdminServiceGetIFrameBody },
+    TContext
+  >;
+}) => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceGetIFrame>>,
+    { organization: string; project: string; data: AdminServiceGetIFrameBody }
+  > = (props) => {
+    const { organization, project, data } = props ?? {};
+
+    return adminServiceGetIFrame(organization, project, data);
+  };
+
+  return createMutation<
+    Awaited<ReturnType<typeof adminServiceGetIFrame>>,
+    TError,
+    { organization: string; project: string; data: AdminServiceGetIFrameBody },
+    TContext
+  >(mutationFn, mutationOptions);
+};
+/**
+ * @summary ListProjectInvites lists all the project invites
+ */
+export const adminServiceListProjectInvites = (
+  organization: string,
+  project: string,
+  params?: AdminServiceListProjectInvitesParams,
+  signal?: AbortSignal,
+) => {
+  return httpClient<V1ListProjectInvitesResponse>({
+    url: `/v1/organizations/${organization}/projects/${project}/invites`,
+    method: "get",
+    params,
+    signal,
+  });
+};
+
+export const getAdminServiceListProjectInvitesQueryKey = (
+  organization: string,
+  project: string,
+  params?: AdminServiceListProjectInvitesParams,
+) => [
+  `/v1/organizations/${organization}/projects/${project}/invites`,
+  ...(params ? [params] : []),
+];
+
+export type AdminServiceListProjectInvitesQueryResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceListProjectInvites>>
+>;
+export type AdminServiceListProjectInvitesQueryError = RpcStatus;
+
+export const createAdminServiceListProjectInvites = <
+  TData = Awaited<ReturnType<typeof adminServiceListProjectInvites>>,
+  TError = RpcStatus,
+>(
+  organization: string,
+  project: string,
+  params?: AdminServiceListProjectInvitesParams,
+  options?: {
+    query?: CreateQueryOptions<
+      Awaited<ReturnType<typeof adminServiceListProjectInvites>>,
+      TError,
+      TData
+    >;
+  },
+): CreateQueryResult<TData, TError> & { queryKey: QueryKey } => {
+  const { query: queryOptions } = options ?? {};
+
+  const queryKey =
+    queryOptions?.queryKey ??
+    getAdminServiceListProjectInvitesQueryKey(organization, project, params);
+
+  const queryFn: QueryFunction<
+    Awaited<ReturnType<typeof adminServiceListProjectInvites>>
+  > = ({ signal }) =>
+    adminServiceListProjectInvites(organization, project, params, signal);
+
+  const query = createQuery<
+    Awaited<ReturnType<typeof adminServiceListProjectInvites>>,
+    TError,
+    TData
+  >({
+    queryKey,
+    queryFn,
+    enabled: !!(organization && project),
+    ...queryOptions,
+  }) as CreateQueryResult<TData, TError> & { queryKey: QueryKey };
+
+  query.queryKey = queryKey;
+
+  return query;
+};
+
+/**
+ * @summary ListProjectMembers lists all the project members
+ */
+export const adminServiceListProjectMembers = (
+  organization: string,
+  project: string,
+  params?: AdminServiceListProjectMembersParams,
+  signal?: AbortSignal,
+) => {
+  return httpClient<V1ListProjectMembersResponse>({
+    url: `/v1/organizations/${organization}/projects/${project}/members`,
+    method: "get",
+    params,
+    signal,
+  });
+};
+
+export const getAdminServiceListProjectMembersQueryKey = (
+  organization: string,
+  project: string,
+  params?: AdminServiceListProjectMembersParams,
+) => [
+  `/v1/organizations/${organization}/projects/${project}/members`,
+  ...(params ? [params] : []),
+];
+
+export type AdminServiceListProjectMembersQueryResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceListProjectMembers>>
+>;
+export type AdminServiceListProjectMembersQueryError = RpcStatus;
+
+export const createAdminServiceListProjectMembers = <
+  TData = Awaited<ReturnType<typeof adminServiceListProjectMembers>>,
+  TError = RpcStatus,
+>(
+  organization: string,
+  project: string,
+  params?: AdminServiceListProjectMembersParams,
+  options?: {
+    query?: CreateQueryOptions<
+      Awaited<ReturnType<typeof adminServiceListProjectMembers>>,
+      TError,
+      TData
+    >;
+  },
+): CreateQueryResult<TData, TError> & { queryKey: QueryKey } => {
+  const { query: queryOptions } = options ?? {};
+
+  const queryKey =
+    queryOptions?.queryKey ??
+    getAdminServiceListProjectMembersQueryKey(organization, project, params);
+
+  const queryFn: QueryFunction<
+    Awaited<ReturnType<typeof adminServiceListProjectMembers>>
+  > = ({ signal }) =>
+    adminServiceListProjectMembers(organization, project, params, signal);
+
+  const query = createQuery<
+    Awaited<ReturnType<typeof adminServiceListProjectMembers>>,
+    TError,
+    TData
+  >({
+    queryKey,
+    queryFn,
+    enabled: !!(organization && project),
+    ...queryOptions,
+  }) as CreateQueryResult<TData, TError> & { queryKey: QueryKey };
+
+  query.queryKey = queryKey;
+
+  return query;
+};
+
+/**
+ * @summary AddProjectMember adds a member to the project
+ */
+export const adminServiceAddProjectMember = (
+  organization: string,
+  project: string,
+  adminServiceAddOrganizationMemberBodyBody: AdminServiceAddOrganizationMemberBodyBody,
+) => {
+  return httpClient<V1AddProjectMemberResponse>({
+    url: `/v1/organizations/${organization}/projects/${project}/members`,
+    method: "post",
+    headers: { "Content-Type": "application/json" },
+    data: adminServiceAddOrganizationMemberBodyBody,
+  });
+};
+
+export type AdminServiceAddProjectMemberMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceAddProjectMember>>
+>;
+export type AdminServiceAddProjectMemberMutationBody =
+  AdminServiceAddOrganizationMemberBodyBody;
+export type AdminServiceAddProjectMemberMutationError = RpcStatus;
+
+export const createAdminServiceAddProjectMember = <
+  TError = RpcStatus,
+  TContext = unknown,
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceAddProjectMember>>,
+    TError,
+    {
+      organization: string;
+      project: string;
+      data: AdminServiceAddOrganizationMemberBodyBody;
+    },
+    TContext
+  >;
+}) => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceAddProjectMember>>,
+    {
+      organization: string;
+      project: string;
+      data: AdminServiceAddOrganizationMemberBodyBody;
+    }
+  > = (props) => {
+    const { organization, project, data } = props ?? {};
+
+    return adminServiceAddProjectMember(organization, project, data);
+  };
+
+  return createMutation<
+    Awaited<ReturnType<typeof adminServiceAddProjectMember>>,
+    TError,
+    {
+      organization: string;
+      project: string;
+      data: AdminServiceAddOrganizationMemberBodyBody;
+    },
+    TContext
+  >(mutationFn, mutationOptions);
+};
+/**
+ * @summary RemoveProjectMember removes member from the project
+ */
+export const adminServiceRemoveProjectMember = (
+  organization: string,
+  project: string,
+  email: string,
+) => {
+  return httpClient<V1RemoveProjectMemberResponse>({
+    url: `/v1/organizations/${organization}/projects/${project}/members/${email}`,
+    method: "delete",
+  });
+};
+
+export type AdminServiceRemoveProjectMemberMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceRemoveProjectMember>>
+>;
+
+export type AdminServiceRemoveProjectMemberMutationError = RpcStatus;
+
+export const createAdminServiceRemoveProjectMember = <
+  TError = RpcStatus,
+  TContext = unknown,
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceRemoveProjectMember>>,
+    TError,
+    { organization: string; project: string; email: string },
+    TContext
+  >;
+}) => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceRemoveProjectMember>>,
+    { organization: string; project: string; email: string }
+  > = (props) => {
+    const { organization, project, email } = props ?? {};
+
+    return adminServiceRemoveProjectMember(organization, project, email);
+  };
+
+  return createMutation<
+    Awaited<ReturnType<typeof adminServiceRemoveProjectMember>>,
+    TError,
+    { organization: string; project: string; email: string },
+    TContext
+  >(mutationFn, mutationOptions);
+};
+/**
+ * @summary SetProjectMemberRole sets the role for the member
+ */
+export const adminServiceSetProjectMemberRole = (
+  organization: string,
+  project: string,
+  email: string,
+  adminServiceSetOrganizationMemberRoleBodyBody: AdminServiceSetOrganizationMemberRoleBodyBody,
+) => {
+  return httpClient<V1SetProjectMemberRoleResponse>({
+    url: `/v1/organizations/${organization}/projects/${project}/members/${email}`,
+    method: "put",
+    headers: { "Content-Type": "application/json" },
+    data: adminServiceSetOrganizationMemberRoleBodyBody,
+  });
+};
+
+export type AdminServiceSetProjectMemberRoleMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceSetProjectMemberRole>>
+>;
+export type AdminServiceSetProjectMemberRoleMutationBody =
+  AdminServiceSetOrganizationMemberRoleBodyBody;
+export type AdminServiceSetProjectMemberRoleMutationError = RpcStatus;
+
+export const createAdminServiceSetProjectMemberRole = <
+  TError = RpcStatus,
+  TContext = unknown,
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceSetProjectMemberRole>>,
+    TError,
+    {
+      organization: string;
+      project: string;
+      email: string;
+      data: AdminServiceSetOrganizationMemberRoleBodyBody;
+    },
+    TContext
+  >;
+}) => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceSetProjectMemberRole>>,
+    {
+      organization: string;
+      project: string;
+      email: string;
+      data: AdminServiceSetOrganizationMemberRoleBodyBody;
+    }
+  > = (props) => {
+    const { organization, project, email, data } = props ?? {};
+
+    return adminServiceSetProjectMemberRole(organization, project, email, data);
+  };
+
+  return createMutation<
+    Awaited<ReturnType<typeof adminServiceSetProjectMemberRole>>,
+    TError,
+    {
+      organization: string;
+      project: string;
+      email: string;
+      data: AdminServiceSetOrganizationMemberRoleBodyBody;
+    },
+    TContext
+  >(mutationFn, mutationOptions);
+};
+/**
+ * @summary GetSSOProvider returns the organization's single sign-on identity provider
+ */
+export const adminServiceGetSSOProvider = (
+  organization: string,
+  signal?: AbortSignal,
+) => {
+  return httpClient<V1GetSSOProviderResponse>({
+    url: `/v1/organizations/${organization}/sso`,
+    method: "get",
+    signal,
+  });
+};
+
+export const getAdminServiceGetSSOProviderQueryKey = (organization: string) => [
+  `/v1/organizations/${organization}/sso`,
+];
+
+export type AdminServiceGetSSOProviderQueryResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceGetSSOProvider>>
+>;
+export type AdminServiceGetSSOProviderQueryError = RpcStatus;
+
+export const createAdminServiceGetSSOProvider = <
+  TData = Awaited<ReturnType<typeof adminServiceGetSSOProvider>>,
+  TError = RpcStatus,
+>(
+  organization: string,
+  options?: {
+    query?: CreateQueryOptions<
+      Awaited<ReturnType<typeof adminServiceGetSSOProvider>>,
+      TError,
+      TData
+    >;
+  },
+): CreateQueryResult<TData, TError> & { queryKey: QueryKey } => {
+  const { query: queryOptions } = options ?? {};
+
+  const queryKey =
+    queryOptions?.queryKey ??
+    getAdminServiceGetSSOProviderQueryKey(organization);
+
+  const queryFn: QueryFunction<
+    Awaited<ReturnType<typeof adminServiceGetSSOProvider>>
+  > = ({ signal }) => adminServiceGetSSOProvider(organization, signal);
+
+  const query = createQuery<
+    Awaited<ReturnType<typeof adminServiceGetSSOProvider>>,
+    TError,
+    TData
+  >({
+    queryKey,
+    queryFn,
+    enabled: !!organization,
+    ...queryOptions,
+  }) as CreateQueryResult<TData, TError> & { queryKey: QueryKey };
+
+  query.queryKey = queryKey;
+
+  return query;
+};
+
+/**
+ * @summary DeleteSSOProvider removes the organization's single sign-on identity provider
+ */
+export const adminServiceDeleteSSOProvider = (organization: string) => {
+  return httpClient<V1DeleteSSOProviderResponse>({
+    url: `/v1/organizations/${organization}/sso`,
+    method: "delete",
+  });
+};
+
+export type AdminServiceDeleteSSOProviderMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceDeleteSSOProvider>>
+>;
+
+export type AdminServiceDeleteSSOProviderMutationError = RpcStatus;
+
+export const createAdminServiceDeleteSSOProvider = <
+  TError = RpcStatus,
+  TContext = unknown,
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceDeleteSSOProvider>>,
+    TError,
+    { organization: string },
+    TContext
+  >;
+}) => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceDeleteSSOProvider>>,
+    { organization: string }
+  > = (props) => {
+    const { organization } = props ?? {};
+
+    return adminServiceDeleteSSOProvider(organization);
+  };
+
+  return createMutation<
+    Awaited<ReturnType<typeof adminServiceDeleteSSOProvider>>,
+    TError,
+    { organization: string },
+    TContext
+  >(mutationFn, mutationOptions);
+};
+/**
+ * @summary SetSSOProvider creates or updates the organization's single sign-on identity provider
+ */
+export const adminServiceSetSSOProvider = (
+  organization: string,
+  adminServiceSetSSOProviderBody: AdminServiceSetSSOProviderBody,
+) => {
+  return httpClient<V1SetSSOProviderResponse>({
+    url: `/v1/organizations/${organization}/sso`,
+    method: "put",
+    headers: { "Content-Type": "application/json" },
+    data: adminServiceSetSSOProviderBody,
+  });
+};
+
+export type AdminServiceSetSSOProviderMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceSetSSOProvider>>
+>;
+export type AdminServiceSetSSOProviderMutationBody =
+  AdminServiceSetSSOProviderBody;
+export type AdminServiceSetSSOProviderMutationError = RpcStatus;
+
+export const createAdminServiceSetSSOProvider = <
+  TError = RpcStatus,
+  TContext = unknown,
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceSetSSOProvider>>,
+    TError,
+    { organization: string; data: AdminServiceSetSSOProviderBody },
+    TContext
+  >;
+}) => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceSetSSOProvider>>,
+    { organization: string; data: AdminServiceSetSSOProviderBody }
+  > = (props) => {
+    const { organization, data } = props ?? {};
+
+    return adminServiceSetSSOProvider(organization, data);
+  };
+
+  return createMutation<
+    Awaited<ReturnType<typeof adminServiceSetSSOProvider>>,
+    TError,
+    { organization: string; data: AdminServiceSetSSOProviderBody },
+    TContext
+  >(mutationFn, mutationOptions);
+};
+/**
+ * @summary ListUsergroups lists the usergroups of an organization
+ */
+export const adminServiceListUsergroups = (
+  organization: string,
+  params?: AdminServiceListUsergroupsParams,
+  signal?: AbortSignal,
+) => {
+  return httpClient<V1ListUsergroupsResponse>({
+    url: `/v1/organizations/${organization}/usergroups`,
+    method: "get",
+    params,
+    signal,
+  });
+};
+
+export const getAdminServiceListUsergroupsQueryKey = (
+  organization: string,
+  params?: AdminServiceListUsergroupsParams,
+) => [
+  `/v1/organizations/${organization}/usergroups`,
+  ...(params ? [params] : []),
+];
+
+export type AdminServiceListUsergroupsQueryResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceListUsergroups>>
+>;
+export type AdminServiceListUsergroupsQueryError = RpcStatus;
+
+export const createAdminServiceListUsergroups = <
+  TData = Awaited<ReturnType<typeof adminServiceListUsergroups>>,
+  TError = RpcStatus,
+>(
+  organization: string,
+  params?: AdminServiceListUsergroupsParams,
+  options?: {
+    query?: CreateQueryOptions<
+      Awaited<ReturnType<typeof adminServiceListUsergroups>>,
+      TError,
+      TData
+    >;
+  },
+): CreateQueryResult<TData, TError> & { queryKey: QueryKey } => {
+  const { query: queryOptions } = options ?? {};
+
+  const queryKey =
+    queryOptions?.queryKey ??
+    getAdminServiceListUsergroupsQueryKey(organization, params);
+
+  const queryFn: QueryFunction<
+    Awaited<ReturnType<typeof adminServiceListUsergroups>>
+  > = ({ signal }) =>
+    adminServiceListUsergroups(organization, params, signal);
+
+  const query = createQuery<
+    Awaited<ReturnType<typeof adminServiceListUsergroups>>,
+    TError,
+    TData
+  >({
+    queryKey,
+    queryFn,
+    enabled: !!organization,
+    ...queryOptions,
+  }) as CreateQueryResult<TData, TError> & { queryKey: QueryKey };
+
+  query.queryKey = queryKey;
+
+  return query;
+};
+
+/**
+ * @summary CreateUsergroup creates a usergroup in an organization
+ */
+export const adminServiceCreateUsergroup = (
+  organization: string,
+  adminServiceCreateUsergroupBody: AdminServiceCreateUsergroupBody,
+) => {
+  return httpClient<V1CreateUsergroupResponse>({
+    url: `/v1/organizations/${organization}/usergroups`,
+    method: "post",
+    headers: { "Content-Type": "application/json" },
+    data: adminServiceCreateUsergroupBody,
+  });
+};
+
+export type AdminServiceCreateUsergroupMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceCreateUsergroup>>
+>;
+export type AdminServiceCreateUsergroupMutationBody =
+  AdminServiceCreateUsergroupBody;
+export type AdminServiceCreateUsergroupMutationError = RpcStatus;
+
+export const createAdminServiceCreateUsergroup = <
+  TError = RpcStatus,
+  TContext = unknown,
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceCreateUsergroup>>,
+    TError,
+    { organization: string; data: AdminServiceCreateUsergroupBody },
+    TContext
+  >;
+}) => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceCreateUsergroup>>,
+    { organization: string; data: AdminServiceCreateUsergroupBody }
+  > = (props) => {
+    const { organization, data } = props ?? {};
+
+    return adminServiceCreateUsergroup(organization, data);
+  };
+
+  return createMutation<
+    Awaited<ReturnType<typeof adminServiceCreateUsergroup>>,
+    TError,
+    { organization: string; data: AdminServiceCreateUsergroupBody },
+    TContext
+  >(mutationFn, mutationOptions);
+};
+/**
+ * @summary RenameUsergroup renames a usergroup
+ */
+export const adminServiceRenameUsergroup = (
+  organization: string,
+  usergroup: string,
+  adminServiceRenameUsergroupBody: AdminServiceRenameUsergroupBody,
+) => {
+  return httpClient<V1RenameUsergroupResponse>({
+    url: `/v1/organizations/${organization}/usergroups/${usergroup}`,
+    method: "put",
+    headers: { "Content-Type": "application/json" },
+    data: adminServiceRenameUsergroupBody,
+  });
+};
+
+export type AdminServiceRenameUsergroupMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceRenameUsergroup>>
+>;
+export type AdminServiceRenameUsergroupMutationBody =
+  AdminServiceRenameUsergroupBody;
+export type AdminServiceRenameUsergroupMutationError = RpcStatus;
+
+export const createAdminServiceRenameUsergroup = <
+  TError = RpcStatus,
+  TContext = unknown,
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceRenameUsergroup>>,
+    TError,
+    {
+      organization: string;
+      usergroup: string;
+      data: AdminServiceRenameUsergroupBody;
+    },
+    TContext
+  >;
+}) => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceRenameUsergroup>>,
+    {
+      organization: string;
+      usergroup: string;
+      data: AdminServiceRenameUsergroupBody;
+    }
+  > = (props) => {
+    const { organization, usergroup, data } = props ?? {};
+
+    return adminServiceRenameUsergroup(organization, usergroup, data);
+  };
+
+  return createMutation<
+    Awaited<ReturnType<typeof adminServiceRenameUsergroup>>,
+    TError,
+    {
+      organization: string;
+      usergroup: string;
+      data: AdminServiceRenameUsergroupBody;
+    },
+    TContext
+  >(mutationFn, mutationOptions);
+};
+/**
+ * @summary DeleteUsergroup deletes a usergroup
+ */
+export const adminServiceDeleteUsergroup = (
+  organization: string,
+  usergroup: string,
+) => {
+  return httpClient<V1DeleteUsergroupResponse>({
+    url: `/v1/organizations/${organization}/usergroups/${usergroup}`,
+    method: "delete",
+  });
+};
+
+export type AdminServiceDeleteUsergroupMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceDeleteUsergroup>>
+>;
+
+export type AdminServiceDeleteUsergroupMutationError = RpcStatus;
+
+export const createAdminServiceDeleteUsergroup = <
+  TError = RpcStatus,
+  TContext = unknown,
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceDeleteUsergroup>>,
+    TError,
+    { organization: string; usergroup: string },
+    TContext
+  >;
+}) => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceDeleteUsergroup>>,
+    { organization: string; usergroup: string }
+  > = (props) => {
+    const { organization, usergroup } = props ?? {};
+
+    return adminServiceDeleteUsergroup(organization, usergroup);
+  };
+
+  return createMutation<
+    Awaited<ReturnType<typeof adminServiceDeleteUsergroup>>,
+    TError,
+    { organization: string; usergroup: string },
+    TContext
+  >(mutationFn, mutationOptions);
+};
+/**
+ * @summary ListUsergroupMembers lists the members of a usergroup
+ */
+export const adminServiceListUsergroupMembers = (
+  organization: string,
+  usergroup: string,
+  params?: AdminServiceListUsergroupMembersParams,
+  signal?: AbortSignal,
+) => {
+  return httpClient<V1ListUsergroupMembersResponse>({
+    url: `/v1/organizations/${organization}/usergroups/${usergroup}/members`,
+    method: "get",
+    params,
+    signal,
+  });
+};
+
+export const getAdminServiceListUsergroupMembersQueryKey = (
   organization: string,
-  project: string,
-) => [`/v1/organizations/${organization}/projects/${project}/git-credentials`];
+  usergroup: string,
+  params?: AdminServiceListUsergroupMembersParams,
+) => [
+  `/v1/organizations/${organization}/usergroups/${usergroup}/members`,
+  ...(params ? [params] : []),
+];
 
-export type AdminServiceGetGitCredentialsQueryResult = NonNullable<
-  Awaited<ReturnType<typeof adminServiceGetGitCredentials>>
+export type AdminServiceListUsergroupMembersQueryResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceListUsergroupMembers>>
 >;
-export type AdminServiceGetGitCredentialsQueryError = RpcStatus;
+export type AdminServiceListUsergroupMembersQueryError = RpcStatus;
 
-export const createAdminServiceGetGitCredentials = <
-  TData = Awaited<ReturnType<typeof adminServiceGetGitCredentials>>,
+export const createAdminServiceListUsergroupMembers = <
+  TData = Awaited<ReturnType<typeof adminServiceListUsergroupMembers>>,
   TError = RpcStatus,
 >(
   organization: string,
-  project: string,
+  usergroup: string,
+  params?: AdminServiceListUsergroupMembersParams,
   options?: {
     query?: CreateQueryOptions<
-      Awaited<ReturnType<typeof adminServiceGetGitCredentials>>,
+      Awaited<ReturnType<typeof adminServiceListUsergroupMembers>>,
       TError,
       TData
     >;
@@ -1493,21 +2432,25 @@ export const createAdminServiceGetGitCredentials = <
 
   const queryKey =
     queryOptions?.queryKey ??
-    getAdminServiceGetGitCredentialsQueryKey(organization, project);
+    getAdminServiceListUsergroupMembersQueryKey(
+      organization,
+      usergroup,
+      params,
+    );
 
   const queryFn: QueryFunction<
-    Awaited<ReturnType<typeof adminServiceGetGitCredentials>>
+    Awaited<ReturnType<typeof adminServiceListUsergroupMembers>>
   > = ({ signal }) =>
-    adminServiceGetGitCredentials(organization, project, signal);
+    adminServiceListUsergroupMembers(organization, usergroup, params, signal);
 
   const query = createQuery<
-    Awaited<ReturnType<typeof adminServiceGetGitCredentials>>,
+    Awaited<ReturnType<typeof adminServiceListUsergroupMembers>>,
     TError,
     TData
   >({
     queryKey,
     queryFn,
-    enabled: !!(organization && project),
+    enabled: !!(organization && usergroup),
     ...queryOptions,
   }) as CreateQueryResult<TData, TError> & { queryKey: QueryKey };
 
@@ -1517,170 +2460,159 @@ export const createAdminServiceGetGitCredentials = <
 };
 
 /**
- * @summary GetIFrame returns the iframe URL for the given project
+ * @summary AddUsergroupMember adds an org member to a usergroup
  */
-export const adminServiceGetIFrame = (
+export const adminServiceAddUsergroupMember = (
   organization: string,
-  project: string,
-  adminServiceGetIFrameBody: AdminServiceGetIFrameBody,
+  usergroup: string,
+  adminServiceAddUsergroupMemberBody: AdminServiceAddUsergroupMemberBody,
 ) => {
-  return httpClient<V1GetIFrameResponse>({
-    url: `/v1/organizations/${organization}/projects/${project}/iframe`,
+  return httpClient<V1AddUsergroupMemberResponse>({
+    url: `/v1/organizations/${organization}/usergroups/${usergroup}/members`,
     method: "post",
     headers: { "Content-Type": "application/json" },
-    data: adminServiceGetIFrameBody,
+    data: adminServiceAddUsergroupMemberBody,
   });
 };
 
-export type AdminServiceGetIFrameMutationResult = NonNullable<
-  Awaited<ReturnType<typeof adminServiceGetIFrame>>
+export type AdminServiceAddUsergroupMemberMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceAddUsergroupMember>>
 >;
-export type AdminServiceGetIFrameMutationBody = AdminServiceGetIFrameBody;
-export type AdminServiceGetIFrameMutationError = RpcStatus;
+export type AdminServiceAddUsergroupMemberMutationBody =
+  AdminServiceAddUsergroupMemberBody;
+export type AdminServiceAddUsergroupMemberMutationError = RpcStatus;
 
-export const createAdminServiceGetIFrame = <
+export const createAdminServiceAddUsergroupMember = <
   TError = RpcStatus,
   TContext = unknown,
 >(options?: {
   mutation?: CreateMutationOptions<
-    Awaited<ReturnType<typeof adminServiceGetIFrame>>,
+    Awaited<ReturnType<typeof adminServiceAddUsergroupMember>>,
     TError,
-    { organization: string; project: string; data: AdminServiceGetIFrameBody },
+    {
+      organization: string;
+      usergroup: string;
+      data: AdminServiceAddUsergroupMemberBody;
+    },
     TContext
   >;
 }) => {
   const { mutation: mutationOptions } = options ?? {};
 
   const mutationFn: MutationFunction<
-    Awaited<ReturnType<typeof adminServiceGetIFrame>>,
-    { organization: string; project: string; data: AdminServiceGetIFrameBody }
+    Awaited<ReturnType<typeof adminServiceAddUsergroupMember>>,
+    {
+      organization: string;
+      usergroup: string;
+      data: AdminServiceAddUsergroupMemberBody;
+    }
   > = (props) => {
-    const { organization, project, data } = props ?? {};
+    const { organization, usergroup, data } = props ?? {};
 
-    return adminServiceGetIFrame(organization, project, data);
+    return adminServiceAddUsergroupMember(organization, usergroup, data);
   };
 
   return createMutation<
-    Awaited<ReturnType<typeof adminServiceGetIFrame>>,
+    Awaited<ReturnType<typeof adminServiceAddUsergroupMember>>,
     TError,
-    { organization: string; project: string; data: AdminServiceGetIFrameBody },
+    {
+      organization: string;
+      usergroup: string;
+      data: AdminServiceAddUsergroupMemberBody;
+    },
     TContext
   >(mutationFn, mutationOptions);
 };
 /**
- * @summary ListProjectInvites lists all the project invites
+ * @summary RemoveUsergroupMember removes a member from a usergroup
  */
-export const adminServiceListProjectInvites = (
+export const adminServiceRemoveUsergroupMember = (
   organization: string,
-  project: string,
-  params?: AdminServiceListProjectInvitesParams,
-  signal?: AbortSignal,
+  usergroup: string,
+  email: string,
 ) => {
-  return httpClient<V1ListProjectInvitesResponse>({
-    url: `/v1/organizations/${organization}/projects/${project}/invites`,
-    method: "get",
-    params,
-    signal,
+  return httpClient<V1RemoveUsergroupMemberResponse>({
+    url: `/v1/organizations/${organization}/usergroups/${usergroup}/members/${email}`,
+    method: "delete",
   });
 };
 
-export const getAdminServiceListProjectInvitesQueryKey = (
-  organization: string,
-  project: string,
-  params?: AdminServiceListProjectInvitesParams,
-) => [
-  `/v1/organizations/${organization}/projects/${project}/invites`,
-  ...(params ? [params] : []),
-];
-
-export type AdminServiceListProjectInvitesQueryResult = NonNullable<
-  Awaited<ReturnType<typeof adminServiceListProjectInvites>>
+export type AdminServiceRemoveUsergroupMemberMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceRemoveUsergroupMember>>
 >;
-export type AdminServiceListProjectInvitesQueryError = RpcStatus;
 
-export const createAdminServiceListProjectInvites = <
-  TData = Awaited<ReturnType<typeof adminServiceListProjectInvites>>,
+export type AdminServiceRemoveUsergroupMemberMutationError = RpcStatus;
+
+export const createAdminServiceRemoveUsergroupMember = <
   TError = RpcStatus,
->(
-  organization: string,
-  project: string,
-  params?: AdminServiceListProjectInvitesParams,
-  options?: {
-    query?: CreateQueryOptions<
-      Awaited<ReturnType<typeof adminServiceListProjectInvites>>,
-      TError,
-      TData
-    >;
-  },
-): CreateQueryResult<TData, TError> & { queryKey: QueryKey } => {
-  const { query: queryOptions } = options ?? {};
+  TContext = unknown,
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceRemoveUsergroupMember>>,
+    TError,
+    { organization: string; usergroup: string; email: string },
+    TContext
+  >;
+}) => {
+  const { mutation: mutationOptions } = options ?? {};
 
-  const queryKey =
-    queryOptions?.queryKey ??
-    getAdminServiceListProjectInvitesQueryKey(organization, project, params);
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceRemoveUsergroupMember>>,
+    { organization: string; usergroup: string; email: string }
+  > = (props) => {
+    const { organization, usergroup, email } = props ?? {};
 
-  const queryFn: QueryFunction<
-    Awaited<ReturnType<typeof adminServiceListProjectInvites>>
-  > = ({ signal }) =>
-    adminServiceListProjectInvites(organization, project, params, signal);
+    return adminServiceRemoveUsergroupMember(organization, usergroup, email);
+  };
 
-  const query = createQuery<
-    Awaited<ReturnType<typeof adminServiceListProjectInvites>>,
+  return createMutation<
+    Awaited<ReturnType<typeof adminServiceRemoveUsergroupMember>>,
     TError,
-    TData
-  >({
-    queryKey,
-    queryFn,
-    enabled: !!(organization && project),
-    ...queryOptions,
-  }) as CreateQueryResult<TData, TError> & { queryKey: QueryKey };
-
-  query.queryKey = queryKey;
-
-  return query;
+    { organization: string; usergroup: string; email: string },
+    TContext
+  >(mutationFn, mutationOptions);
 };
-
 /**
- * @summary ListProjectMembers lists all the project members
+ * @summary ListProjectMemberUsergroups lists the usergroups that have a role on the project
  */
-export const adminServiceListProjectMembers = (
+export const adminServiceListProjectMemberUsergroups = (
   organization: string,
   project: string,
-  params?: AdminServiceListProjectMembersParams,
+  params?: AdminServiceListProjectMemberUsergroupsParams,
   signal?: AbortSignal,
 ) => {
-  return httpClient<V1ListProjectMembersResponse>({
-    url: `/v1/organizations/${organization}/projects/${project}/members`,
+  return httpClient<V1ListProjectMemberUsergroupsResponse>({
+    url: `/v1/organizations/${organization}/projects/${project}/usergroups`,
     method: "get",
     params,
     signal,
   });
 };
 
-export const getAdminServiceListProjectMembersQueryKey = (
+export const getAdminServiceListProjectMemberUsergroupsQueryKey = (
   organization: string,
   project: string,
-  params?: AdminServiceListProjectMembersParams,
+  params?: AdminServiceListProjectMemberUsergroupsParams,
 ) => [
-  `/v1/organizations/${organization}/projects/${project}/members`,
+  `/v1/organizations/${organization}/projects/${project}/usergroups`,
   ...(params ? [params] : []),
 ];
 
-export type AdminServiceListProjectMembersQueryResult = NonNullable<
-  Awaited<ReturnType<typeof adminServiceListProjectMembers>>
+export type AdminServiceListProjectMemberUsergroupsQueryResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceListProjectMemberUsergroups>>
 >;
-export type AdminServiceListProjectMembersQueryError = RpcStatus;
+export type AdminServiceListProjectMemberUsergroupsQueryError = RpcStatus;
 
-export const createAdminServiceListProjectMembers = <
-  TData = Awaited<ReturnType<typeof adminServiceListProjectMembers>>,
+export const createAdminServiceListProjectMemberUsergroups = <
+  TData = Awaited<ReturnType<typeof adminServiceListProjectMemberUsergroups>>,
   TError = RpcStatus,
 >(
   organization: string,
   project: string,
-  params?: AdminServiceListProjectMembersParams,
+  params?: AdminServiceListProjectMemberUsergroupsParams,
   options?: {
     query?: CreateQueryOptions<
-      Awaited<ReturnType<typeof adminServiceListProjectMembers>>,
+      Awaited<ReturnType<typeof adminServiceListProjectMemberUsergroups>>,
       TError,
       TData
     >;
@@ -1690,15 +2622,24 @@ export const createAdminServiceListProjectMembers = <
 
   const queryKey =
     queryOptions?.queryKey ??
-    getAdminServiceListProjectMembersQueryKey(organization, project, params);
+    getAdminServiceListProjectMemberUsergroupsQueryKey(
+      organization,
+      project,
+      params,
+    );
 
   const queryFn: QueryFunction<
-    Awaited<ReturnType<typeof adminServiceListProjectMembers>>
+    Awaited<ReturnType<typeof adminServiceListProjectMemberUsergroups>>
   > = ({ signal }) =>
-    adminServiceListProjectMembers(organization, project, params, signal);
+    adminServiceListProjectMemberUsergroups(
+      organization,
+      project,
+      params,
+      signal,
+    );
 
   const query = createQuery<
-    Awaited<ReturnType<typeof adminServiceListProjectMembers>>,
+    Awaited<ReturnType<typeof adminServiceListProjectMemberUsergroups>>,
     TError,
     TData
   >({
@@ -1714,39 +2655,39 @@ export const createAdminServiceListProjectMembers = <
 };
 
 /**
- * @summary AddProjectMember adds a member to the project
+ * @summary AddProjectMemberUsergroup grants a usergroup a role on the project
  */
-export const adminServiceAddProjectMember = (
+export const adminServiceAddProjectMemberUsergroup = (
   organization: string,
   project: string,
-  adminServiceAddOrganizationMemberBodyBody: AdminServiceAddOrganizationMemberBodyBody,
+  adminServiceAddProjectMemberUsergroupBody: AdminServiceAddProjectMemberUsergroupBody,
 ) => {
-  return httpClient<V1AddProjectMemberResponse>({
-    url: `/v1/organizations/${organization}/projects/${project}/members`,
+  return httpClient<V1AddProjectMemberUsergroupResponse>({
+    url: `/v1/organizations/${organization}/projects/${project}/usergroups`,
     method: "post",
     headers: { "Content-Type": "application/json" },
-    data: adminServiceAddOrganizationMemberBodyBody,
+    data: adminServiceAddProjectMemberUsergroupBody,
   });
 };
 
-export type AdminServiceAddProjectMemberMutationResult = NonNullable<
-  Awaited<ReturnType<typeof adminServiceAddProjectMember>>
+export type AdminServiceAddProjectMemberUsergroupMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceAddProjectMemberUsergroup>>
 >;
-export type AdminServiceAddProjectMemberMutationBody =
-  AdminServiceAddOrganizationMemberBodyBody;
-export type AdminServiceAddProjectMemberMutationError = RpcStatus;
+export type AdminServiceAddProjectMemberUsergroupMutationBody =
+  AdminServiceAddProjectMemberUsergroupBody;
+export type AdminServiceAddProjectMemberUsergroupMutationError = RpcStatus;
 
-export const createAdminServiceAddProjectMember = <
+export const createAdminServiceAddProjectMemberUsergroup = <
   TError = RpcStatus,
   TContext = unknown,
 >(options?: {
   mutation?: CreateMutationOptions<
-    Awaited<ReturnType<typeof adminServiceAddProjectMember>>,
+    Awaited<ReturnType<typeof adminServiceAddProjectMemberUsergroup>>,
     TError,
     {
       organization: string;
       project: string;
-      data: AdminServiceAddOrganizationMemberBodyBody;
+      data: AdminServiceAddProjectMemberUsergroupBody;
     },
     TContext
   >;
@@ -1754,113 +2695,119 @@ export const createAdminServiceAddProjectMember = <
   const { mutation: mutationOptions } = options ?? {};
 
   const mutationFn: MutationFunction<
-    Awaited<ReturnType<typeof adminServiceAddProjectMember>>,
+    Awaited<ReturnType<typeof adminServiceAddProjectMemberUsergroup>>,
     {
       organization: string;
       project: string;
-      data: AdminServiceAddOrganizationMemberBodyBody;
+      data: AdminServiceAddProjectMemberUsergroupBody;
     }
   > = (props) => {
     const { organization, project, data } = props ?? {};
 
-    return adminServiceAddProjectMember(organization, project, data);
+    return adminServiceAddProjectMemberUsergroup(organization, project, data);
   };
 
   return createMutation<
-    Awaited<ReturnType<typeof adminServiceAddProjectMember>>,
+    Awaited<ReturnType<typeof adminServiceAddProjectMemberUsergroup>>,
     TError,
     {
       organization: string;
       project: string;
-      data: AdminServiceAddOrganizationMemberBodyBody;
+      data: AdminServiceAddProjectMemberUsergroupBody;
     },
     TContext
   >(mutationFn, mutationOptions);
 };
 /**
- * @summary RemoveProjectMember removes member from the project
+ * @summary RemoveProjectMemberUsergroup revokes a usergroup's role on the project
  */
-export const adminServiceRemoveProjectMember = (
+export const adminServiceRemoveProjectMemberUsergroup = (
   organization: string,
   project: string,
-  email: string,
+  usergroup: string,
 ) => {
-  return httpClient<V1RemoveProjectMemberResponse>({
-    url: `/v1/organizations/${organization}/projects/${project}/members/${email}`,
+  return httpClient<V1RemoveProjectMemberUsergroupResponse>({
+    url: `/v1/organizations/${organization}/projects/${project}/usergroups/${usergroup}`,
     method: "delete",
   });
 };
 
-export type AdminServiceRemoveProjectMemberMutationResult = NonNullable<
-  Awaited<ReturnType<typeof adminServiceRemoveProjectMember>>
->;
+export type AdminServiceRemoveProjectMemberUsergroupMutationResult =
+  NonNullable<
+    Awaited<ReturnType<typeof adminServiceRemoveProjectMemberUsergroup>>
+  >;
 
-export type AdminServiceRemoveProjectMemberMutationError = RpcStatus;
+export type AdminServiceRemoveProjectMemberUsergroupMutationError = RpcStatus;
 
-export const createAdminServiceRemoveProjectMember = <
+export const createAdminServiceRemoveProjectMemberUsergroup = <
   TError = RpcStatus,
   TContext = unknown,
 >(options?: {
   mutation?: CreateMutationOptions<
-    Awaited<ReturnType<typeof adminServiceRemoveProjectMember>>,
+    Awaited<ReturnType<typeof adminServiceRemoveProjectMemberUsergroup>>,
     TError,
-    { organization: string; project: string; email: string },
+    { organization: string; project: string; usergroup: string },
     TContext
   >;
 }) => {
   const { mutation: mutationOptions } = options ?? {};
 
   const mutationFn: MutationFunction<
-    Awaited<ReturnType<typeof adminServiceRemoveProjectMember>>,
-    { organization: string; project: string; email: string }
+    Awaited<ReturnType<typeof adminServiceRemoveProjectMemberUsergroup>>,
+    { organization: string; project: string; usergroup: string }
   > = (props) => {
-    const { organization, project, email } = props ?? {};
+    const { organization, project, usergroup } = props ?? {};
 
-    return adminServiceRemoveProjectMember(organization, project, email);
+    return adminServiceRemoveProjectMemberUsergroup(
+      organization,
+      project,
+      usergroup,
+    );
   };
 
   return createMutation<
-    Awaited<ReturnType<typeof adminServiceRemoveProjectMember>>,
+    Awaited<ReturnType<typeof adminServiceRemoveProjectMemberUsergroup>>,
     TError,
-    { organization: string; project: string; email: string },
+    { organization: string; project: string; usergroup: string },
     TContext
   >(mutationFn, mutationOptions);
 };
 /**
- * @summary SetProjectMemberRole sets the role for the member
+ * @summary SetProjectMemberUsergroupRole sets the role of a usergroup on the project
  */
-export const adminServiceSetProjectMemberRole = (
+export const adminServiceSetProjectMemberUsergroupRole = (
   organization: string,
   project: string,
-  email: string,
+  usergroup: string,
   adminServiceSetOrganizationMemberRoleBodyBody: AdminServiceSetOrganizationMemberRoleBodyBody,
 ) => {
-  return httpClient<V1SetProjectMemberRoleResponse>({
-    url: `/v1/organizations/${organization}/projects/${project}/members/${email}`,
+  return httpClient<V1SetProjectMemberUsergroupRoleResponse>({
+    url: `/v1/organizations/${organization}/projects/${project}/usergroups/${usergroup}`,
     method: "put",
     headers: { "Content-Type": "application/json" },
     data: adminServiceSetOrganizationMemberRoleBodyBody,
   });
 };
 
-export type AdminServiceSetProjectMemberRoleMutationResult = NonNullable<
-  Awaited<ReturnType<typeof adminServiceSetProjectMemberRole>>
->;
-export type AdminServiceSetProjectMemberRoleMutationBody =
+export type AdminServiceSetProjectMemberUsergroupRoleMutationResult =
+  NonNullable<
+    Awaited<ReturnType<typeof adminServiceSetProjectMemberUsergroupRole>>
+  >;
+export type AdminServiceSetProjectMemberUsergroupRoleMutationBody =
   AdminServiceSetOrganizationMemberRoleBodyBody;
-export type AdminServiceSetProjectMemberRoleMutationError = RpcStatus;
+export type AdminServiceSetProjectMemberUsergroupRoleMutationError = RpcStatus;
 
-export const createAdminServiceSetProjectMemberRole = <
+export const createAdminServiceSetProjectMemberUsergroupRole = <
   TError = RpcStatus,
   TContext = unknown,
 >(options?: {
   mutation?: CreateMutationOptions<
-    Awaited<ReturnType<typeof adminServiceSetProjectMemberRole>>,
+    Awaited<ReturnType<typeof adminServiceSetProjectMemberUsergroupRole>>,
     TError,
     {
       organization: string;
       project: string;
-      email: string;
+      usergroup: string;
       data: AdminServiceSetOrganizationMemberRoleBodyBody;
     },
     TContext
@@ -1869,26 +2816,31 @@ export const createAdminServiceSetProjectMemberRole = <
   const { mutation: mutationOptions } = options ?? {};
 
   const mutationFn: MutationFunction<
-    Awaited<ReturnType<typeof adminServiceSetProjectMemberRole>>,
+    Awaited<ReturnType<typeof adminServiceSetProjectMemberUsergroupRole>>,
     {
       organization: string;
       project: string;
-      email: string;
+      usergroup: string;
       data: AdminServiceSetOrganizationMemberRoleBodyBody;
     }
   > = (props) => {
-    const { organization, project, email, data } = props ?? {};
+    const { organization, project, usergroup, data } = props ?? {};
 
-    return adminServiceSetProjectMemberRole(organization, project, email, data);
+    return adminServiceSetProjectMemberUsergroupRole(
+      organization,
+      project,
+      usergroup,
+      data,
+    );
   };
 
   return createMutation<
-    Awaited<ReturnType<typeof adminServiceSetProjectMemberRole>>,
+    Awaited<ReturnType<typeof adminServiceSetProjectMemberUsergroupRole>>,
     TError,
     {
       organization: string;
       project: string;
-      email: string;
+      usergroup: string;
       data: AdminServiceSetOrganizationMemberRoleBodyBody;
     },
     TContext
@@ -3038,11 +3990,13 @@ export const createAdminServiceUpdateProject = <
 export const adminServiceGetProjectVariables = (
   organizationName: string,
   name: string,
+  params?: AdminServiceGetProjectVariablesParams,
   signal?: AbortSignal,
 ) => {
   return httpClient<V1GetProjectVariablesResponse>({
     url: `/v1/organizations/${organizationName}/projects/${name}/variables`,
     method: "get",
+    params,
     signal,
   });
 };
@@ -3050,7 +4004,11 @@ export const adminServiceGetProjectVariables = (
 export const getAdminServiceGetProjectVariablesQueryKey = (
   organizationName: string,
   name: string,
-) => [`/v1/organizations/${organizationName}/projects/${name}/variables`];
+  params?: AdminServiceGetProjectVariablesParams,
+) => [
+  `/v1/organizations/${organizationName}/projects/${name}/variables`,
+  ...(params ? [params] : []),
+];
 
 export type AdminServiceGetProjectVariablesQueryResult = NonNullable<
   Awaited<ReturnType<typeof adminServiceGetProjectVariables>>
@@ -3063,6 +4021,7 @@ export const createAdminServiceGetProjectVariables = <
 >(
   organizationName: string,
   name: string,
+  params?: AdminServiceGetProjectVariablesParams,
   options?: {
     query?: CreateQueryOptions<
       Awaited<ReturnType<typeof adminServiceGetProjectVariables>>,
@@ -3075,12 +4034,12 @@ export const createAdminServiceGetProjectVariables = <
 
   const queryKey =
     queryOptions?.queryKey ??
-    getAdminServiceGetProjectVariablesQueryKey(organizationName, name);
+    getAdminServiceGetProjectVariablesQueryKey(organizationName, name, params);
 
   const queryFn: QueryFunction<
     Awaited<ReturnType<typeof adminServiceGetProjectVariables>>
   > = ({ signal }) =>
-    adminServiceGetProjectVariables(organizationName, name, signal);
+    adminServiceGetProjectVariables(organizationName, name, params, signal);
 
   const query = createQuery<
     Awaited<ReturnType<typeof adminServiceGetProjectVariables>>,
@@ -4044,6 +5003,57 @@ export const createAdminServiceSudoUpdateAnnotations = <
     TContext
   >(mutationFn, mutationOptions);
 };
+/**
+ * @summary SudoRotateEncryptionKeys endpoint for superusers to re-wrap the encryption keys of stored secrets with the current key encryption key.
+Secrets that were stored before encryption was configured are encrypted.
+ */
+export const adminServiceSudoRotateEncryptionKeys = (
+  v1SudoRotateEncryptionKeysRequest: V1SudoRotateEncryptionKeysRequest,
+) => {
+  return httpClient<V1SudoRotateEncryptionKeysResponse>({
+    url: `/v1/superuser/encryption/rotate`,
+    method: "post",
+    headers: { "Content-Type": "application/json" },
+    data: v1SudoRotateEncryptionKeysRequest,
+  });
+};
+
+export type AdminServiceSudoRotateEncryptionKeysMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceSudoRotateEncryptionKeys>>
+>;
+export type AdminServiceSudoRotateEncryptionKeysMutationBody =
+  V1SudoRotateEncryptionKeysRequest;
+export type AdminServiceSudoRotateEncryptionKeysMutationError = RpcStatus;
+
+export const createAdminServiceSudoRotateEncryptionKeys = <
+  TError = RpcStatus,
+  TContext = unknown,
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceSudoRotateEncryptionKeys>>,
+    TError,
+    { data: V1SudoRotateEncryptionKeysRequest },
+    TContext
+  >;
+}) => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceSudoRotateEncryptionKeys>>,
+    { data: V1SudoRotateEncryptionKeysRequest }
+  > = (props) => {
+    const { data } = props ?? {};
+
+    return adminServiceSudoRotateEncryptionKeys(data);
+  };
+
+  return createMutation<
+    Awaited<ReturnType<typeof adminServiceSudoRotateEncryptionKeys>>,
+    TError,
+    { data: V1SudoRotateEncryptionKeysRequest },
+    TContext
+  >(mutationFn, mutationOptions);
+};
 /**
  * @summary SearchProjectNames returns project names matching the pattern
  */
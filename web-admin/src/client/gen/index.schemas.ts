@@ -68,12 +68,22 @@ export type AdminServiceUpdateServiceBody = {
 
 export type AdminServiceCreateServiceParams = { name?: string };
 
+export type AdminServiceGetProjectVariablesParams = {
+  /** Environment to get variables for, e.g. "dev", "prod" or "staging".
+If empty, returns the variables that apply to all environments. */
+  environment?: string;
+};
+
 export type AdminServiceUpdateProjectVariablesBodyVariables = {
   [key: string]: string;
 };
 
 export type AdminServiceUpdateProjectVariablesBody = {
   variables?: AdminServiceUpdateProjectVariablesBodyVariables;
+  /** Environment to update variables for, e.g. "dev", "prod" or "staging".
+If empty, updates the variables that apply to all environments.
+Variables set for an environment take precedence over variables that apply to all environments. */
+  environment?: string;
 };
 
 export type AdminServiceUpdateProjectBody = {
@@ -86,6 +96,7 @@ export type AdminServiceUpdateProjectBody = {
   newName?: string;
   prodTtlSeconds?: string;
   prodVersion?: string;
+  previewDeployments?: boolean;
 };
 
 export type AdminServiceGetProjectParams = { accessTokenTtlSeconds?: number };
@@ -123,6 +134,16 @@ export type AdminServiceListProjectMembersParams = {
   pageToken?: string;
 };
 
+export type AdminServiceListProjectMemberUsergroupsParams = {
+  pageSize?: number;
+  pageToken?: string;
+};
+
+export type AdminServiceAddProjectMemberUsergroupBody = {
+  usergroup?: string;
+  role?: string;
+};
+
 export type AdminServiceListProjectInvitesParams = {
   pageSize?: number;
   pageToken?: string;
@@ -178,6 +199,19 @@ export type AdminServiceGetDeploymentCredentialsBody = {
   attributes?: AdminServiceGetDeploymentCredentialsBodyAttributes;
 };
 
+export type AdminServiceListAuditEventsParams = {
+  /**
+   * Optional filters
+   */
+  project?: string;
+  actorEmail?: string;
+  action?: string;
+  startTime?: string;
+  endTime?: string;
+  pageSize?: number;
+  pageToken?: string;
+};
+
 export type AdminServiceRemoveOrganizationMemberParams = {
   keepProjectRoles?: boolean;
 };
@@ -192,6 +226,51 @@ export type AdminServiceListOrganizationInvitesParams = {
   pageToken?: string;
 };
 
+export type AdminServiceSetSSOProviderBody = {
+  /** Either "oidc" or "saml" */
+  type?: string;
+  /** Email domains that log in through the provider.
+A domain is only used after it has been verified through a DNS TXT record (see SSOProvider.domain_verification_txt_value) or set by a superuser. */
+  domains?: string[];
+  /** Optional org role for users who log in through the provider but are not yet members of the organization.
+If not set, users must be invited to the organization before they can log in. */
+  jitRole?: string;
+  oidcIssuerUrl?: string;
+  oidcClientId?: string;
+  /** If empty when updating an OIDC provider, the existing client secret is kept */
+  oidcClientSecret?: string;
+  oidcScopes?: string[];
+  /** Either the URL or the XML document of the SAML identity provider's metadata is required for SAML */
+  samlIdpMetadataUrl?: string;
+  samlIdpMetadataXml?: string;
+  /** Optional names of the claims (OIDC) or attributes (SAML) that contain the user's email, name and groups */
+  emailClaim?: string;
+  nameClaim?: string;
+  groupsClaim?: string;
+};
+
+export type AdminServiceListUsergroupsParams = {
+  pageSize?: number;
+  pageToken?: string;
+};
+
+export type AdminServiceCreateUsergroupBody = {
+  name?: string;
+};
+
+export type AdminServiceRenameUsergroupBody = {
+  name?: string;
+};
+
+export type AdminServiceListUsergroupMembersParams = {
+  pageSize?: number;
+  pageToken?: string;
+};
+
+export type AdminServiceAddUsergroupMemberBody = {
+  email?: string;
+};
+
 export type AdminServiceUpdateOrganizationBody = {
   description?: string;
   newName?: string;
@@ -206,6 +285,12 @@ export type AdminServiceGetGithubRepoStatusParams = { githubUrl?: string };
 
 export type AdminServiceTriggerRefreshSourcesBody = {
   sources?: string[];
+  /** Reports to run. If backfill_from and backfill_to are set, they will be backfilled for the time range instead. */
+  reports?: string[];
+  /** Alerts to check. If backfill_from and backfill_to are set, they will be backfilled for the time range instead. */
+  alerts?: string[];
+  backfillFrom?: string;
+  backfillTo?: string;
 };
 
 export type AdminServiceCreateReportBodyBody = {
@@ -232,6 +317,13 @@ export type AdminServiceSetOrganizationMemberRoleBodyBody = {
 
 export type AdminServiceTriggerReconcileBodyBody = { [key: string]: any };
 
+export interface V1Usergroup {
+  groupId?: string;
+  groupName?: string;
+  createdOn?: string;
+  updatedOn?: string;
+}
+
 export interface V1WhitelistedDomain {
   domain?: string;
   role?: string;
@@ -375,6 +467,14 @@ export interface V1SudoUpdateAnnotationsRequest {
   annotations?: V1SudoUpdateAnnotationsRequestAnnotations;
 }
 
+export interface V1SudoRotateEncryptionKeysResponse {
+  rotatedProjects?: string;
+}
+
+export interface V1SudoRotateEncryptionKeysRequest {
+  [key: string]: any;
+}
+
 export interface V1SudoGetResourceResponse {
   user?: V1User;
   org?: V1Organization;
@@ -392,6 +492,14 @@ export interface V1SetSuperuserRequest {
   superuser?: boolean;
 }
 
+export interface V1SetSSOProviderResponse {
+  provider?: V1SSOProvider;
+}
+
+export interface V1SetProjectMemberUsergroupRoleResponse {
+  [key: string]: any;
+}
+
 export interface V1SetProjectMemberRoleResponse {
   [key: string]: any;
 }
@@ -430,6 +538,32 @@ export interface V1SearchProjectNamesResponse {
   nextPageToken?: string;
 }
 
+export interface V1SSOProvider {
+  id?: string;
+  orgId?: string;
+  type?: string;
+  domains?: string[];
+  jitRole?: string;
+  oidcIssuerUrl?: string;
+  oidcClientId?: string;
+  oidcScopes?: string[];
+  samlIdpMetadataUrl?: string;
+  emailClaim?: string;
+  nameClaim?: string;
+  groupsClaim?: string;
+  /** URL that the identity provider must redirect to after an OIDC login */
+  oidcRedirectUrl?: string;
+  /** Entity ID and ACS URL to register with a SAML identity provider (the entity ID also serves the SP metadata) */
+  samlEntityId?: string;
+  samlAcsUrl?: string;
+  createdOn?: string;
+  updatedOn?: string;
+  /** Subset of domains that have been verified. Users with an email in other domains don't log in through the provider. */
+  verifiedDomains?: string[];
+  /** To verify a domain, add a DNS TXT record named "_rill-challenge.<domain>" with this value and set the provider again. */
+  domainVerificationTxtValue?: string;
+}
+
 export interface V1RevokeServiceAuthTokenResponse {
   [key: string]: any;
 }
@@ -462,6 +596,18 @@ export interface V1RemoveProjectWhitelistedDomainResponse {
   [key: string]: any;
 }
 
+export interface V1RenameUsergroupResponse {
+  usergroup?: V1Usergroup;
+}
+
+export interface V1RemoveUsergroupMemberResponse {
+  [key: string]: any;
+}
+
+export interface V1RemoveProjectMemberUsergroupResponse {
+  [key: string]: any;
+}
+
 export interface V1RemoveProjectMemberResponse {
   [key: string]: any;
 }
@@ -528,6 +674,8 @@ export interface V1Project {
   prodTtlSeconds?: string;
   annotations?: V1ProjectAnnotations;
   prodVersion?: string;
+  /** If true, pull requests and pushes to branches other than the prod branch are deployed as preview deployments. */
+  previewDeployments?: boolean;
   createdOn?: string;
   updatedOn?: string;
 }
@@ -564,6 +712,14 @@ export interface V1Organization {
   updatedOn?: string;
 }
 
+export interface V1MemberUsergroup {
+  groupId?: string;
+  groupName?: string;
+  roleName?: string;
+  createdOn?: string;
+  updatedOn?: string;
+}
+
 export interface V1Member {
   userId?: string;
   userEmail?: string;
@@ -573,6 +729,16 @@ export interface V1Member {
   updatedOn?: string;
 }
 
+export interface V1ListUsergroupsResponse {
+  usergroups?: V1Usergroup[];
+  nextPageToken?: string;
+}
+
+export interface V1ListUsergroupMembersResponse {
+  members?: V1User[];
+  nextPageToken?: string;
+}
+
 export interface V1ListWhitelistedDomainsResponse {
   domains?: V1WhitelistedDomain[];
 }
@@ -598,6 +764,11 @@ export interface V1ListProjectWhitelistedDomainsResponse {
   domains?: V1WhitelistedDomain[];
 }
 
+export interface V1ListProjectMemberUsergroupsResponse {
+  usergroups?: V1MemberUsergroup[];
+  nextPageToken?: string;
+}
+
 export interface V1ListProjectMembersResponse {
   members?: V1Member[];
   nextPageToken?: string;
@@ -623,6 +794,11 @@ export interface V1ListOrganizationInvitesResponse {
   nextPageToken?: string;
 }
 
+export interface V1ListAuditEventsResponse {
+  events?: V1AuditEvent[];
+  nextPageToken?: string;
+}
+
 export interface V1ListBookmarksResponse {
   bookmarks?: V1Bookmark[];
 }
@@ -648,6 +824,10 @@ export interface V1GetUserResponse {
   user?: V1User;
 }
 
+export interface V1GetSSOProviderResponse {
+  provider?: V1SSOProvider;
+}
+
 export interface V1GetReportMetaResponse {
   openUrl?: string;
   exportUrl?: string;
@@ -664,6 +844,7 @@ export type V1GetProjectVariablesResponseVariables = { [key: string]: string };
 
 export interface V1GetProjectVariablesResponse {
   variables?: V1GetProjectVariablesResponseVariables;
+  maskedVariables?: string[];
 }
 
 export interface V1GetProjectResponse {
@@ -671,6 +852,8 @@ export interface V1GetProjectResponse {
   prodDeployment?: V1Deployment;
   jwt?: string;
   projectPermissions?: V1ProjectPermissions;
+  /** Preview deployments of other branches. Only returned if the caller can read dev deployments. */
+  previewDeployments?: V1Deployment[];
 }
 
 export interface V1GetOrganizationResponse {
@@ -753,6 +936,8 @@ export const V1ExportFormat = {
   EXPORT_FORMAT_CSV: "EXPORT_FORMAT_CSV",
   EXPORT_FORMAT_XLSX: "EXPORT_FORMAT_XLSX",
   EXPORT_FORMAT_PARQUET: "EXPORT_FORMAT_PARQUET",
+  EXPORT_FORMAT_JSONL: "EXPORT_FORMAT_JSONL",
+  EXPORT_FORMAT_ARROW: "EXPORT_FORMAT_ARROW",
 } as const;
 
 export interface V1EditReportResponse {
@@ -783,14 +968,31 @@ export interface V1Deployment {
   runtimeInstanceId?: string;
   status?: V1DeploymentStatus;
   statusMessage?: string;
+  /** Environment that the deployment's variables and rill.yaml overrides are resolved for. */
+  environment?: string;
+  /** Preview deployments deploy a branch other than the project's prod branch. */
+  preview?: boolean;
+  /** Pull request that a preview deployment was created for (if any). */
+  pullRequestNumber?: string;
+  pullRequestUrl?: string;
+  /** Set when a preview deployment's pull request has been closed. It will be torn down shortly after. */
+  closedOn?: string;
   createdOn?: string;
   updatedOn?: string;
 }
 
+export interface V1DeleteUsergroupResponse {
+  [key: string]: any;
+}
+
 export interface V1DeleteServiceResponse {
   service?: V1Service;
 }
 
+export interface V1DeleteSSOProviderResponse {
+  [key: string]: any;
+}
+
 export interface V1DeleteReportResponse {
   [key: string]: any;
 }
@@ -807,6 +1009,10 @@ export interface V1DeleteAlertResponse {
   [key: string]: any;
 }
 
+export interface V1CreateUsergroupResponse {
+  usergroup?: V1Usergroup;
+}
+
 export interface V1CreateWhitelistedDomainResponse {
   [key: string]: any;
 }
@@ -883,6 +1089,34 @@ export interface V1Bookmark {
   updatedOn?: string;
 }
 
+/**
+ * State of the changed resource before and after the change with secrets redacted (only set for some actions)
+ */
+export type V1AuditEventBefore = { [key: string]: any };
+
+/**
+ * The request with secrets redacted
+ */
+export type V1AuditEventPayload = { [key: string]: any };
+
+export type V1AuditEventAfter = { [key: string]: any };
+
+export interface V1AuditEvent {
+  id?: string;
+  orgId?: string;
+  projectId?: string;
+  projectName?: string;
+  actorType?: string;
+  actorId?: string;
+  actorName?: string;
+  /** Name of the AdminService method that made the change, e.g. "UpdateProjectVariables" */
+  action?: string;
+  payload?: V1AuditEventPayload;
+  before?: V1AuditEventBefore;
+  after?: V1AuditEventAfter;
+  createdOn?: string;
+}
+
 export interface V1AlertOptions {
   title?: string;
   intervalDuration?: string;
@@ -897,6 +1131,14 @@ export interface V1AlertOptions {
   slackWebhooks?: string[];
 }
 
+export interface V1AddUsergroupMemberResponse {
+  [key: string]: any;
+}
+
+export interface V1AddProjectMemberUsergroupResponse {
+  [key: string]: any;
+}
+
 export interface V1AddProjectMemberResponse {
   pendingSignup?: boolean;
 }
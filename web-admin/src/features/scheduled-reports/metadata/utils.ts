@@ -11,6 +11,10 @@ export function exportFormatToPrettyString(format: V1ExportFormat): string {
       return "Excel (XLSX)";
     case V1ExportFormat.EXPORT_FORMAT_PARQUET:
       return "Parquet";
+    case V1ExportFormat.EXPORT_FORMAT_JSONL:
+      return "JSON Lines";
+    case V1ExportFormat.EXPORT_FORMAT_ARROW:
+      return "Arrow";
     default:
       return "Unknown";
   }
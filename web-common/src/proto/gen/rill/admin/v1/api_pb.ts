@@ -513,6 +513,130 @@ export class UpdateOrganizationResponse extends Message<UpdateOrganizationRespon
   }
 }
 
+/**
+ * @generated from message rill.admin.v1.ListAuditEventsRequest
+ */
+export class ListAuditEventsRequest extends Message<ListAuditEventsRequest> {
+  /**
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
+  /**
+   * Optional filters
+   *
+   * @generated from field: string project = 2;
+   */
+  project = "";
+
+  /**
+   * @generated from field: string actor_email = 3;
+   */
+  actorEmail = "";
+
+  /**
+   * @generated from field: string action = 4;
+   */
+  action = "";
+
+  /**
+   * @generated from field: google.protobuf.Timestamp start_time = 5;
+   */
+  startTime?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp end_time = 6;
+   */
+  endTime?: Timestamp;
+
+  /**
+   * @generated from field: uint32 page_size = 7;
+   */
+  pageSize = 0;
+
+  /**
+   * @generated from field: string page_token = 8;
+   */
+  pageToken = "";
+
+  constructor(data?: PartialMessage<ListAuditEventsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.ListAuditEventsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "actor_email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "action", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "start_time", kind: "message", T: Timestamp },
+    { no: 6, name: "end_time", kind: "message", T: Timestamp },
+    { no: 7, name: "page_size", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 8, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListAuditEventsRequest {
+    return new ListAuditEventsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListAuditEventsRequest {
+    return new ListAuditEventsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListAuditEventsRequest {
+    return new ListAuditEventsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListAuditEventsRequest | PlainMessage<ListAuditEventsRequest> | undefined, b: ListAuditEventsRequest | PlainMessage<ListAuditEventsRequest> | undefined): boolean {
+    return proto3.util.equals(ListAuditEventsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.ListAuditEventsResponse
+ */
+export class ListAuditEventsResponse extends Message<ListAuditEventsResponse> {
+  /**
+   * @generated from field: repeated rill.admin.v1.AuditEvent events = 1;
+   */
+  events: AuditEvent[] = [];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken = "";
+
+  constructor(data?: PartialMessage<ListAuditEventsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.ListAuditEventsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "events", kind: "message", T: AuditEvent, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListAuditEventsResponse {
+    return new ListAuditEventsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListAuditEventsResponse {
+    return new ListAuditEventsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListAuditEventsResponse {
+    return new ListAuditEventsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListAuditEventsResponse | PlainMessage<ListAuditEventsResponse> | undefined, b: ListAuditEventsResponse | PlainMessage<ListAuditEventsResponse> | undefined): boolean {
+    return proto3.util.equals(ListAuditEventsResponse, a, b);
+  }
+}
+
 /**
  * @generated from message rill.admin.v1.ListProjectsForOrganizationRequest
  */
@@ -678,6 +802,13 @@ export class GetProjectResponse extends Message<GetProjectResponse> {
    */
   projectPermissions?: ProjectPermissions;
 
+  /**
+   * Preview deployments of other branches. Only returned if the caller can read dev deployments.
+   *
+   * @generated from field: repeated rill.admin.v1.Deployment preview_deployments = 5;
+   */
+  previewDeployments: Deployment[] = [];
+
   constructor(data?: PartialMessage<GetProjectResponse>) {
     super();
     proto3.util.initPartial(data, this);
@@ -690,6 +821,7 @@ export class GetProjectResponse extends Message<GetProjectResponse> {
     { no: 2, name: "prod_deployment", kind: "message", T: Deployment },
     { no: 3, name: "jwt", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 4, name: "project_permissions", kind: "message", T: ProjectPermissions },
+    { no: 5, name: "preview_deployments", kind: "message", T: Deployment, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetProjectResponse {
@@ -821,6 +953,14 @@ export class GetProjectVariablesRequest extends Message<GetProjectVariablesReque
    */
   name = "";
 
+  /**
+   * Environment to get variables for, e.g. "dev", "prod" or "staging".
+   * If empty, returns the variables that apply to all environments.
+   *
+   * @generated from field: string environment = 3;
+   */
+  environment = "";
+
   constructor(data?: PartialMessage<GetProjectVariablesRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -831,6 +971,7 @@ export class GetProjectVariablesRequest extends Message<GetProjectVariablesReque
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "organization_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "environment", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetProjectVariablesRequest {
@@ -859,6 +1000,13 @@ export class GetProjectVariablesResponse extends Message<GetProjectVariablesResp
    */
   variables: { [key: string]: string } = {};
 
+  /**
+   * Names of variables whose values were masked because the caller is not allowed to read secrets.
+   *
+   * @generated from field: repeated string masked_variables = 2;
+   */
+  maskedVariables: string[] = [];
+
   constructor(data?: PartialMessage<GetProjectVariablesResponse>) {
     super();
     proto3.util.initPartial(data, this);
@@ -868,6 +1016,7 @@ export class GetProjectVariablesResponse extends Message<GetProjectVariablesResp
   static readonly typeName = "rill.admin.v1.GetProjectVariablesResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "variables", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+    { no: 2, name: "masked_variables", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetProjectVariablesResponse {
@@ -1934,6 +2083,11 @@ export class UpdateProjectRequest extends Message<UpdateProjectRequest> {
    */
   prodVersion?: string;
 
+  /**
+   * @generated from field: optional bool preview_deployments = 12;
+   */
+  previewDeployments?: boolean;
+
   constructor(data?: PartialMessage<UpdateProjectRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1953,6 +2107,7 @@ export class UpdateProjectRequest extends Message<UpdateProjectRequest> {
     { no: 9, name: "new_name", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
     { no: 10, name: "prod_ttl_seconds", kind: "scalar", T: 3 /* ScalarType.INT64 */, opt: true },
     { no: 11, name: "prod_version", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 12, name: "preview_deployments", kind: "scalar", T: 8 /* ScalarType.BOOL */, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateProjectRequest {
@@ -2028,6 +2183,15 @@ export class UpdateProjectVariablesRequest extends Message<UpdateProjectVariable
    */
   variables: { [key: string]: string } = {};
 
+  /**
+   * Environment to update variables for, e.g. "dev", "prod" or "staging".
+   * If empty, updates the variables that apply to all environments.
+   * Variables set for an environment take precedence over variables that apply to all environments.
+   *
+   * @generated from field: string environment = 4;
+   */
+  environment = "";
+
   constructor(data?: PartialMessage<UpdateProjectVariablesRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -2039,6 +2203,7 @@ export class UpdateProjectVariablesRequest extends Message<UpdateProjectVariable
     { no: 1, name: "organization_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 3, name: "variables", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+    { no: 4, name: "environment", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateProjectVariablesRequest {
@@ -2177,6 +2342,30 @@ export class TriggerRefreshSourcesRequest extends Message<TriggerRefreshSourcesR
    */
   sources: string[] = [];
 
+  /**
+   * Reports to run. If backfill_from and backfill_to are set, they will be backfilled for the time range instead.
+   *
+   * @generated from field: repeated string reports = 3;
+   */
+  reports: string[] = [];
+
+  /**
+   * Alerts to check. If backfill_from and backfill_to are set, they will be backfilled for the time range instead.
+   *
+   * @generated from field: repeated string alerts = 4;
+   */
+  alerts: string[] = [];
+
+  /**
+   * @generated from field: google.protobuf.Timestamp backfill_from = 5;
+   */
+  backfillFrom?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp backfill_to = 6;
+   */
+  backfillTo?: Timestamp;
+
   constructor(data?: PartialMessage<TriggerRefreshSourcesRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -2187,6 +2376,10 @@ export class TriggerRefreshSourcesRequest extends Message<TriggerRefreshSourcesR
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "deployment_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "sources", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 3, name: "reports", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 4, name: "alerts", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 5, name: "backfill_from", kind: "message", T: Timestamp },
+    { no: 6, name: "backfill_to", kind: "message", T: Timestamp },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TriggerRefreshSourcesRequest {
@@ -3372,6 +3565,74 @@ export class SudoUpdateAnnotationsResponse extends Message<SudoUpdateAnnotations
   }
 }
 
+/**
+ * @generated from message rill.admin.v1.SudoRotateEncryptionKeysRequest
+ */
+export class SudoRotateEncryptionKeysRequest extends Message<SudoRotateEncryptionKeysRequest> {
+  constructor(data?: PartialMessage<SudoRotateEncryptionKeysRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.SudoRotateEncryptionKeysRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SudoRotateEncryptionKeysRequest {
+    return new SudoRotateEncryptionKeysRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SudoRotateEncryptionKeysRequest {
+    return new SudoRotateEncryptionKeysRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SudoRotateEncryptionKeysRequest {
+    return new SudoRotateEncryptionKeysRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SudoRotateEncryptionKeysRequest | PlainMessage<SudoRotateEncryptionKeysRequest> | undefined, b: SudoRotateEncryptionKeysRequest | PlainMessage<SudoRotateEncryptionKeysRequest> | undefined): boolean {
+    return proto3.util.equals(SudoRotateEncryptionKeysRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.SudoRotateEncryptionKeysResponse
+ */
+export class SudoRotateEncryptionKeysResponse extends Message<SudoRotateEncryptionKeysResponse> {
+  /**
+   * @generated from field: int64 rotated_projects = 1;
+   */
+  rotatedProjects = protoInt64.zero;
+
+  constructor(data?: PartialMessage<SudoRotateEncryptionKeysResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.SudoRotateEncryptionKeysResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "rotated_projects", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SudoRotateEncryptionKeysResponse {
+    return new SudoRotateEncryptionKeysResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SudoRotateEncryptionKeysResponse {
+    return new SudoRotateEncryptionKeysResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SudoRotateEncryptionKeysResponse {
+    return new SudoRotateEncryptionKeysResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SudoRotateEncryptionKeysResponse | PlainMessage<SudoRotateEncryptionKeysResponse> | undefined, b: SudoRotateEncryptionKeysResponse | PlainMessage<SudoRotateEncryptionKeysResponse> | undefined): boolean {
+    return proto3.util.equals(SudoRotateEncryptionKeysResponse, a, b);
+  }
+}
+
 /**
  * @generated from message rill.admin.v1.ListProjectMembersRequest
  */
@@ -3827,166 +4088,1106 @@ export class SetProjectMemberRoleResponse extends Message<SetProjectMemberRoleRe
 }
 
 /**
- * @generated from message rill.admin.v1.GetCurrentUserRequest
+ * @generated from message rill.admin.v1.ListUsergroupsRequest
  */
-export class GetCurrentUserRequest extends Message<GetCurrentUserRequest> {
-  constructor(data?: PartialMessage<GetCurrentUserRequest>) {
+export class ListUsergroupsRequest extends Message<ListUsergroupsRequest> {
+  /**
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
+  /**
+   * @generated from field: uint32 page_size = 2;
+   */
+  pageSize = 0;
+
+  /**
+   * @generated from field: string page_token = 3;
+   */
+  pageToken = "";
+
+  constructor(data?: PartialMessage<ListUsergroupsRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.GetCurrentUserRequest";
+  static readonly typeName = "rill.admin.v1.ListUsergroupsRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "page_size", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 3, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetCurrentUserRequest {
-    return new GetCurrentUserRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListUsergroupsRequest {
+    return new ListUsergroupsRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetCurrentUserRequest {
-    return new GetCurrentUserRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListUsergroupsRequest {
+    return new ListUsergroupsRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetCurrentUserRequest {
-    return new GetCurrentUserRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListUsergroupsRequest {
+    return new ListUsergroupsRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetCurrentUserRequest | PlainMessage<GetCurrentUserRequest> | undefined, b: GetCurrentUserRequest | PlainMessage<GetCurrentUserRequest> | undefined): boolean {
-    return proto3.util.equals(GetCurrentUserRequest, a, b);
+  static equals(a: ListUsergroupsRequest | PlainMessage<ListUsergroupsRequest> | undefined, b: ListUsergroupsRequest | PlainMessage<ListUsergroupsRequest> | undefined): boolean {
+    return proto3.util.equals(ListUsergroupsRequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.GetCurrentUserResponse
+ * @generated from message rill.admin.v1.ListUsergroupsResponse
  */
-export class GetCurrentUserResponse extends Message<GetCurrentUserResponse> {
+export class ListUsergroupsResponse extends Message<ListUsergroupsResponse> {
   /**
-   * @generated from field: rill.admin.v1.User user = 1;
+   * @generated from field: repeated rill.admin.v1.Usergroup usergroups = 1;
    */
-  user?: User;
+  usergroups: Usergroup[] = [];
 
   /**
-   * @generated from field: rill.admin.v1.UserPreferences preferences = 2;
+   * @generated from field: string next_page_token = 2;
    */
-  preferences?: UserPreferences;
+  nextPageToken = "";
 
-  constructor(data?: PartialMessage<GetCurrentUserResponse>) {
+  constructor(data?: PartialMessage<ListUsergroupsResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.GetCurrentUserResponse";
+  static readonly typeName = "rill.admin.v1.ListUsergroupsResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "user", kind: "message", T: User },
-    { no: 2, name: "preferences", kind: "message", T: UserPreferences },
+    { no: 1, name: "usergroups", kind: "message", T: Usergroup, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetCurrentUserResponse {
-    return new GetCurrentUserResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListUsergroupsResponse {
+    return new ListUsergroupsResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetCurrentUserResponse {
-    return new GetCurrentUserResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListUsergroupsResponse {
+    return new ListUsergroupsResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetCurrentUserResponse {
-    return new GetCurrentUserResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListUsergroupsResponse {
+    return new ListUsergroupsResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetCurrentUserResponse | PlainMessage<GetCurrentUserResponse> | undefined, b: GetCurrentUserResponse | PlainMessage<GetCurrentUserResponse> | undefined): boolean {
-    return proto3.util.equals(GetCurrentUserResponse, a, b);
+  static equals(a: ListUsergroupsResponse | PlainMessage<ListUsergroupsResponse> | undefined, b: ListUsergroupsResponse | PlainMessage<ListUsergroupsResponse> | undefined): boolean {
+    return proto3.util.equals(ListUsergroupsResponse, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.GetUserRequest
+ * @generated from message rill.admin.v1.CreateUsergroupRequest
  */
-export class GetUserRequest extends Message<GetUserRequest> {
+export class CreateUsergroupRequest extends Message<CreateUsergroupRequest> {
   /**
-   * @generated from field: string email = 1;
+   * @generated from field: string organization = 1;
    */
-  email = "";
+  organization = "";
 
-  constructor(data?: PartialMessage<GetUserRequest>) {
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  constructor(data?: PartialMessage<CreateUsergroupRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.GetUserRequest";
+  static readonly typeName = "rill.admin.v1.CreateUsergroupRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetUserRequest {
-    return new GetUserRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateUsergroupRequest {
+    return new CreateUsergroupRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetUserRequest {
-    return new GetUserRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateUsergroupRequest {
+    return new CreateUsergroupRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetUserRequest {
-    return new GetUserRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateUsergroupRequest {
+    return new CreateUsergroupRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetUserRequest | PlainMessage<GetUserRequest> | undefined, b: GetUserRequest | PlainMessage<GetUserRequest> | undefined): boolean {
-    return proto3.util.equals(GetUserRequest, a, b);
+  static equals(a: CreateUsergroupRequest | PlainMessage<CreateUsergroupRequest> | undefined, b: CreateUsergroupRequest | PlainMessage<CreateUsergroupRequest> | undefined): boolean {
+    return proto3.util.equals(CreateUsergroupRequest, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.GetUserResponse
+ * @generated from message rill.admin.v1.CreateUsergroupResponse
  */
-export class GetUserResponse extends Message<GetUserResponse> {
+export class CreateUsergroupResponse extends Message<CreateUsergroupResponse> {
   /**
-   * @generated from field: rill.admin.v1.User user = 1;
+   * @generated from field: rill.admin.v1.Usergroup usergroup = 1;
    */
-  user?: User;
+  usergroup?: Usergroup;
 
-  constructor(data?: PartialMessage<GetUserResponse>) {
+  constructor(data?: PartialMessage<CreateUsergroupResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.GetUserResponse";
+  static readonly typeName = "rill.admin.v1.CreateUsergroupResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "user", kind: "message", T: User },
+    { no: 1, name: "usergroup", kind: "message", T: Usergroup },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetUserResponse {
-    return new GetUserResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateUsergroupResponse {
+    return new CreateUsergroupResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetUserResponse {
-    return new GetUserResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateUsergroupResponse {
+    return new CreateUsergroupResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetUserResponse {
-    return new GetUserResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateUsergroupResponse {
+    return new CreateUsergroupResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetUserResponse | PlainMessage<GetUserResponse> | undefined, b: GetUserResponse | PlainMessage<GetUserResponse> | undefined): boolean {
-    return proto3.util.equals(GetUserResponse, a, b);
+  static equals(a: CreateUsergroupResponse | PlainMessage<CreateUsergroupResponse> | undefined, b: CreateUsergroupResponse | PlainMessage<CreateUsergroupResponse> | undefined): boolean {
+    return proto3.util.equals(CreateUsergroupResponse, a, b);
   }
 }
 
 /**
- * @generated from message rill.admin.v1.UserPreferences
+ * @generated from message rill.admin.v1.RenameUsergroupRequest
  */
-export class UserPreferences extends Message<UserPreferences> {
+export class RenameUsergroupRequest extends Message<RenameUsergroupRequest> {
   /**
-   * @generated from field: optional string time_zone = 1;
+   * @generated from field: string organization = 1;
    */
-  timeZone?: string;
+  organization = "";
 
-  constructor(data?: PartialMessage<UserPreferences>) {
-    super();
-    proto3.util.initPartial(data, this);
-  }
+  /**
+   * @generated from field: string usergroup = 2;
+   */
+  usergroup = "";
+
+  /**
+   * @generated from field: string name = 3;
+   */
+  name = "";
+
+  constructor(data?: PartialMessage<RenameUsergroupRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.RenameUsergroupRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "usergroup", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RenameUsergroupRequest {
+    return new RenameUsergroupRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RenameUsergroupRequest {
+    return new RenameUsergroupRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RenameUsergroupRequest {
+    return new RenameUsergroupRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RenameUsergroupRequest | PlainMessage<RenameUsergroupRequest> | undefined, b: RenameUsergroupRequest | PlainMessage<RenameUsergroupRequest> | undefined): boolean {
+    return proto3.util.equals(RenameUsergroupRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.RenameUsergroupResponse
+ */
+export class RenameUsergroupResponse extends Message<RenameUsergroupResponse> {
+  /**
+   * @generated from field: rill.admin.v1.Usergroup usergroup = 1;
+   */
+  usergroup?: Usergroup;
+
+  constructor(data?: PartialMessage<RenameUsergroupResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.RenameUsergroupResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "usergroup", kind: "message", T: Usergroup },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RenameUsergroupResponse {
+    return new RenameUsergroupResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RenameUsergroupResponse {
+    return new RenameUsergroupResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RenameUsergroupResponse {
+    return new RenameUsergroupResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RenameUsergroupResponse | PlainMessage<RenameUsergroupResponse> | undefined, b: RenameUsergroupResponse | PlainMessage<RenameUsergroupResponse> | undefined): boolean {
+    return proto3.util.equals(RenameUsergroupResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.DeleteUsergroupRequest
+ */
+export class DeleteUsergroupRequest extends Message<DeleteUsergroupRequest> {
+  /**
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
+  /**
+   * @generated from field: string usergroup = 2;
+   */
+  usergroup = "";
+
+  constructor(data?: PartialMessage<DeleteUsergroupRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.DeleteUsergroupRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "usergroup", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteUsergroupRequest {
+    return new DeleteUsergroupRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteUsergroupRequest {
+    return new DeleteUsergroupRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteUsergroupRequest {
+    return new DeleteUsergroupRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteUsergroupRequest | PlainMessage<DeleteUsergroupRequest> | undefined, b: DeleteUsergroupRequest | PlainMessage<DeleteUsergroupRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteUsergroupRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.DeleteUsergroupResponse
+ */
+export class DeleteUsergroupResponse extends Message<DeleteUsergroupResponse> {
+  constructor(data?: PartialMessage<DeleteUsergroupResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.DeleteUsergroupResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteUsergroupResponse {
+    return new DeleteUsergroupResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteUsergroupResponse {
+    return new DeleteUsergroupResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteUsergroupResponse {
+    return new DeleteUsergroupResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteUsergroupResponse | PlainMessage<DeleteUsergroupResponse> | undefined, b: DeleteUsergroupResponse | PlainMessage<DeleteUsergroupResponse> | undefined): boolean {
+    return proto3.util.equals(DeleteUsergroupResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.ListUsergroupMembersRequest
+ */
+export class ListUsergroupMembersRequest extends Message<ListUsergroupMembersRequest> {
+  /**
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
+  /**
+   * @generated from field: string usergroup = 2;
+   */
+  usergroup = "";
+
+  /**
+   * @generated from field: uint32 page_size = 3;
+   */
+  pageSize = 0;
+
+  /**
+   * @generated from field: string page_token = 4;
+   */
+  pageToken = "";
+
+  constructor(data?: PartialMessage<ListUsergroupMembersRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.ListUsergroupMembersRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "usergroup", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "page_size", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 4, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListUsergroupMembersRequest {
+    return new ListUsergroupMembersRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListUsergroupMembersRequest {
+    return new ListUsergroupMembersRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListUsergroupMembersRequest {
+    return new ListUsergroupMembersRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListUsergroupMembersRequest | PlainMessage<ListUsergroupMembersRequest> | undefined, b: ListUsergroupMembersRequest | PlainMessage<ListUsergroupMembersRequest> | undefined): boolean {
+    return proto3.util.equals(ListUsergroupMembersRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.ListUsergroupMembersResponse
+ */
+export class ListUsergroupMembersResponse extends Message<ListUsergroupMembersResponse> {
+  /**
+   * @generated from field: repeated rill.admin.v1.User members = 1;
+   */
+  members: User[] = [];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken = "";
+
+  constructor(data?: PartialMessage<ListUsergroupMembersResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.ListUsergroupMembersResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "members", kind: "message", T: User, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListUsergroupMembersResponse {
+    return new ListUsergroupMembersResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListUsergroupMembersResponse {
+    return new ListUsergroupMembersResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListUsergroupMembersResponse {
+    return new ListUsergroupMembersResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListUsergroupMembersResponse | PlainMessage<ListUsergroupMembersResponse> | undefined, b: ListUsergroupMembersResponse | PlainMessage<ListUsergroupMembersResponse> | undefined): boolean {
+    return proto3.util.equals(ListUsergroupMembersResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.AddUsergroupMemberRequest
+ */
+export class AddUsergroupMemberRequest extends Message<AddUsergroupMemberRequest> {
+  /**
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
+  /**
+   * @generated from field: string usergroup = 2;
+   */
+  usergroup = "";
+
+  /**
+   * @generated from field: string email = 3;
+   */
+  email = "";
+
+  constructor(data?: PartialMessage<AddUsergroupMemberRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.AddUsergroupMemberRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "usergroup", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AddUsergroupMemberRequest {
+    return new AddUsergroupMemberRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AddUsergroupMemberRequest {
+    return new AddUsergroupMemberRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AddUsergroupMemberRequest {
+    return new AddUsergroupMemberRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AddUsergroupMemberRequest | PlainMessage<AddUsergroupMemberRequest> | undefined, b: AddUsergroupMemberRequest | PlainMessage<AddUsergroupMemberRequest> | undefined): boolean {
+    return proto3.util.equals(AddUsergroupMemberRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.AddUsergroupMemberResponse
+ */
+export class AddUsergroupMemberResponse extends Message<AddUsergroupMemberResponse> {
+  constructor(data?: PartialMessage<AddUsergroupMemberResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.AddUsergroupMemberResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AddUsergroupMemberResponse {
+    return new AddUsergroupMemberResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AddUsergroupMemberResponse {
+    return new AddUsergroupMemberResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AddUsergroupMemberResponse {
+    return new AddUsergroupMemberResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AddUsergroupMemberResponse | PlainMessage<AddUsergroupMemberResponse> | undefined, b: AddUsergroupMemberResponse | PlainMessage<AddUsergroupMemberResponse> | undefined): boolean {
+    return proto3.util.equals(AddUsergroupMemberResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.RemoveUsergroupMemberRequest
+ */
+export class RemoveUsergroupMemberRequest extends Message<RemoveUsergroupMemberRequest> {
+  /**
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
+  /**
+   * @generated from field: string usergroup = 2;
+   */
+  usergroup = "";
+
+  /**
+   * @generated from field: string email = 3;
+   */
+  email = "";
+
+  constructor(data?: PartialMessage<RemoveUsergroupMemberRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.RemoveUsergroupMemberRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "usergroup", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveUsergroupMemberRequest {
+    return new RemoveUsergroupMemberRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveUsergroupMemberRequest {
+    return new RemoveUsergroupMemberRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveUsergroupMemberRequest {
+    return new RemoveUsergroupMemberRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RemoveUsergroupMemberRequest | PlainMessage<RemoveUsergroupMemberRequest> | undefined, b: RemoveUsergroupMemberRequest | PlainMessage<RemoveUsergroupMemberRequest> | undefined): boolean {
+    return proto3.util.equals(RemoveUsergroupMemberRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.RemoveUsergroupMemberResponse
+ */
+export class RemoveUsergroupMemberResponse extends Message<RemoveUsergroupMemberResponse> {
+  constructor(data?: PartialMessage<RemoveUsergroupMemberResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.RemoveUsergroupMemberResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveUsergroupMemberResponse {
+    return new RemoveUsergroupMemberResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveUsergroupMemberResponse {
+    return new RemoveUsergroupMemberResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveUsergroupMemberResponse {
+    return new RemoveUsergroupMemberResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RemoveUsergroupMemberResponse | PlainMessage<RemoveUsergroupMemberResponse> | undefined, b: RemoveUsergroupMemberResponse | PlainMessage<RemoveUsergroupMemberResponse> | undefined): boolean {
+    return proto3.util.equals(RemoveUsergroupMemberResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.ListProjectMemberUsergroupsRequest
+ */
+export class ListProjectMemberUsergroupsRequest extends Message<ListProjectMemberUsergroupsRequest> {
+  /**
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
+  /**
+   * @generated from field: string project = 2;
+   */
+  project = "";
+
+  /**
+   * @generated from field: uint32 page_size = 3;
+   */
+  pageSize = 0;
+
+  /**
+   * @generated from field: string page_token = 4;
+   */
+  pageToken = "";
+
+  constructor(data?: PartialMessage<ListProjectMemberUsergroupsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.ListProjectMemberUsergroupsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "page_size", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 4, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListProjectMemberUsergroupsRequest {
+    return new ListProjectMemberUsergroupsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListProjectMemberUsergroupsRequest {
+    return new ListProjectMemberUsergroupsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListProjectMemberUsergroupsRequest {
+    return new ListProjectMemberUsergroupsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListProjectMemberUsergroupsRequest | PlainMessage<ListProjectMemberUsergroupsRequest> | undefined, b: ListProjectMemberUsergroupsRequest | PlainMessage<ListProjectMemberUsergroupsRequest> | undefined): boolean {
+    return proto3.util.equals(ListProjectMemberUsergroupsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.ListProjectMemberUsergroupsResponse
+ */
+export class ListProjectMemberUsergroupsResponse extends Message<ListProjectMemberUsergroupsResponse> {
+  /**
+   * @generated from field: repeated rill.admin.v1.MemberUsergroup usergroups = 1;
+   */
+  usergroups: MemberUsergroup[] = [];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken = "";
+
+  constructor(data?: PartialMessage<ListProjectMemberUsergroupsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.ListProjectMemberUsergroupsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "usergroups", kind: "message", T: MemberUsergroup, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListProjectMemberUsergroupsResponse {
+    return new ListProjectMemberUsergroupsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListProjectMemberUsergroupsResponse {
+    return new ListProjectMemberUsergroupsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListProjectMemberUsergroupsResponse {
+    return new ListProjectMemberUsergroupsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListProjectMemberUsergroupsResponse | PlainMessage<ListProjectMemberUsergroupsResponse> | undefined, b: ListProjectMemberUsergroupsResponse | PlainMessage<ListProjectMemberUsergroupsResponse> | undefined): boolean {
+    return proto3.util.equals(ListProjectMemberUsergroupsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.AddProjectMemberUsergroupRequest
+ */
+export class AddProjectMemberUsergroupRequest extends Message<AddProjectMemberUsergroupRequest> {
+  /**
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
+  /**
+   * @generated from field: string project = 2;
+   */
+  project = "";
+
+  /**
+   * @generated from field: string usergroup = 3;
+   */
+  usergroup = "";
+
+  /**
+   * @generated from field: string role = 4;
+   */
+  role = "";
+
+  constructor(data?: PartialMessage<AddProjectMemberUsergroupRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.AddProjectMemberUsergroupRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "usergroup", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "role", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AddProjectMemberUsergroupRequest {
+    return new AddProjectMemberUsergroupRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AddProjectMemberUsergroupRequest {
+    return new AddProjectMemberUsergroupRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AddProjectMemberUsergroupRequest {
+    return new AddProjectMemberUsergroupRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AddProjectMemberUsergroupRequest | PlainMessage<AddProjectMemberUsergroupRequest> | undefined, b: AddProjectMemberUsergroupRequest | PlainMessage<AddProjectMemberUsergroupRequest> | undefined): boolean {
+    return proto3.util.equals(AddProjectMemberUsergroupRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.AddProjectMemberUsergroupResponse
+ */
+export class AddProjectMemberUsergroupResponse extends Message<AddProjectMemberUsergroupResponse> {
+  constructor(data?: PartialMessage<AddProjectMemberUsergroupResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.AddProjectMemberUsergroupResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AddProjectMemberUsergroupResponse {
+    return new AddProjectMemberUsergroupResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AddProjectMemberUsergroupResponse {
+    return new AddProjectMemberUsergroupResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AddProjectMemberUsergroupResponse {
+    return new AddProjectMemberUsergroupResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AddProjectMemberUsergroupResponse | PlainMessage<AddProjectMemberUsergroupResponse> | undefined, b: AddProjectMemberUsergroupResponse | PlainMessage<AddProjectMemberUsergroupResponse> | undefined): boolean {
+    return proto3.util.equals(AddProjectMemberUsergroupResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.RemoveProjectMemberUsergroupRequest
+ */
+export class RemoveProjectMemberUsergroupRequest extends Message<RemoveProjectMemberUsergroupRequest> {
+  /**
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
+  /**
+   * @generated from field: string project = 2;
+   */
+  project = "";
+
+  /**
+   * @generated from field: string usergroup = 3;
+   */
+  usergroup = "";
+
+  constructor(data?: PartialMessage<RemoveProjectMemberUsergroupRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.RemoveProjectMemberUsergroupRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "usergroup", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveProjectMemberUsergroupRequest {
+    return new RemoveProjectMemberUsergroupRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveProjectMemberUsergroupRequest {
+    return new RemoveProjectMemberUsergroupRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveProjectMemberUsergroupRequest {
+    return new RemoveProjectMemberUsergroupRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RemoveProjectMemberUsergroupRequest | PlainMessage<RemoveProjectMemberUsergroupRequest> | undefined, b: RemoveProjectMemberUsergroupRequest | PlainMessage<RemoveProjectMemberUsergroupRequest> | undefined): boolean {
+    return proto3.util.equals(RemoveProjectMemberUsergroupRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.RemoveProjectMemberUsergroupResponse
+ */
+export class RemoveProjectMemberUsergroupResponse extends Message<RemoveProjectMemberUsergroupResponse> {
+  constructor(data?: PartialMessage<RemoveProjectMemberUsergroupResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.RemoveProjectMemberUsergroupResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveProjectMemberUsergroupResponse {
+    return new RemoveProjectMemberUsergroupResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveProjectMemberUsergroupResponse {
+    return new RemoveProjectMemberUsergroupResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveProjectMemberUsergroupResponse {
+    return new RemoveProjectMemberUsergroupResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RemoveProjectMemberUsergroupResponse | PlainMessage<RemoveProjectMemberUsergroupResponse> | undefined, b: RemoveProjectMemberUsergroupResponse | PlainMessage<RemoveProjectMemberUsergroupResponse> | undefined): boolean {
+    return proto3.util.equals(RemoveProjectMemberUsergroupResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.SetProjectMemberUsergroupRoleRequest
+ */
+export class SetProjectMemberUsergroupRoleRequest extends Message<SetProjectMemberUsergroupRoleRequest> {
+  /**
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
+  /**
+   * @generated from field: string project = 2;
+   */
+  project = "";
+
+  /**
+   * @generated from field: string usergroup = 3;
+   */
+  usergroup = "";
+
+  /**
+   * @generated from field: string role = 4;
+   */
+  role = "";
+
+  constructor(data?: PartialMessage<SetProjectMemberUsergroupRoleRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.SetProjectMemberUsergroupRoleRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "usergroup", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "role", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetProjectMemberUsergroupRoleRequest {
+    return new SetProjectMemberUsergroupRoleRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetProjectMemberUsergroupRoleRequest {
+    return new SetProjectMemberUsergroupRoleRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetProjectMemberUsergroupRoleRequest {
+    return new SetProjectMemberUsergroupRoleRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetProjectMemberUsergroupRoleRequest | PlainMessage<SetProjectMemberUsergroupRoleRequest> | undefined, b: SetProjectMemberUsergroupRoleRequest | PlainMessage<SetProjectMemberUsergroupRoleRequest> | undefined): boolean {
+    return proto3.util.equals(SetProjectMemberUsergroupRoleRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.SetProjectMemberUsergroupRoleResponse
+ */
+export class SetProjectMemberUsergroupRoleResponse extends Message<SetProjectMemberUsergroupRoleResponse> {
+  constructor(data?: PartialMessage<SetProjectMemberUsergroupRoleResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.SetProjectMemberUsergroupRoleResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetProjectMemberUsergroupRoleResponse {
+    return new SetProjectMemberUsergroupRoleResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetProjectMemberUsergroupRoleResponse {
+    return new SetProjectMemberUsergroupRoleResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetProjectMemberUsergroupRoleResponse {
+    return new SetProjectMemberUsergroupRoleResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetProjectMemberUsergroupRoleResponse | PlainMessage<SetProjectMemberUsergroupRoleResponse> | undefined, b: SetProjectMemberUsergroupRoleResponse | PlainMessage<SetProjectMemberUsergroupRoleResponse> | undefined): boolean {
+    return proto3.util.equals(SetProjectMemberUsergroupRoleResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.GetCurrentUserRequest
+ */
+export class GetCurrentUserRequest extends Message<GetCurrentUserRequest> {
+  constructor(data?: PartialMessage<GetCurrentUserRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.GetCurrentUserRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetCurrentUserRequest {
+    return new GetCurrentUserRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetCurrentUserRequest {
+    return new GetCurrentUserRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetCurrentUserRequest {
+    return new GetCurrentUserRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetCurrentUserRequest | PlainMessage<GetCurrentUserRequest> | undefined, b: GetCurrentUserRequest | PlainMessage<GetCurrentUserRequest> | undefined): boolean {
+    return proto3.util.equals(GetCurrentUserRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.GetCurrentUserResponse
+ */
+export class GetCurrentUserResponse extends Message<GetCurrentUserResponse> {
+  /**
+   * @generated from field: rill.admin.v1.User user = 1;
+   */
+  user?: User;
+
+  /**
+   * @generated from field: rill.admin.v1.UserPreferences preferences = 2;
+   */
+  preferences?: UserPreferences;
+
+  constructor(data?: PartialMessage<GetCurrentUserResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.GetCurrentUserResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "user", kind: "message", T: User },
+    { no: 2, name: "preferences", kind: "message", T: UserPreferences },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetCurrentUserResponse {
+    return new GetCurrentUserResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetCurrentUserResponse {
+    return new GetCurrentUserResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetCurrentUserResponse {
+    return new GetCurrentUserResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetCurrentUserResponse | PlainMessage<GetCurrentUserResponse> | undefined, b: GetCurrentUserResponse | PlainMessage<GetCurrentUserResponse> | undefined): boolean {
+    return proto3.util.equals(GetCurrentUserResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.GetUserRequest
+ */
+export class GetUserRequest extends Message<GetUserRequest> {
+  /**
+   * @generated from field: string email = 1;
+   */
+  email = "";
+
+  constructor(data?: PartialMessage<GetUserRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.GetUserRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetUserRequest {
+    return new GetUserRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetUserRequest {
+    return new GetUserRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetUserRequest {
+    return new GetUserRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetUserRequest | PlainMessage<GetUserRequest> | undefined, b: GetUserRequest | PlainMessage<GetUserRequest> | undefined): boolean {
+    return proto3.util.equals(GetUserRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.GetUserResponse
+ */
+export class GetUserResponse extends Message<GetUserResponse> {
+  /**
+   * @generated from field: rill.admin.v1.User user = 1;
+   */
+  user?: User;
+
+  constructor(data?: PartialMessage<GetUserResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.GetUserResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "user", kind: "message", T: User },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetUserResponse {
+    return new GetUserResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetUserResponse {
+    return new GetUserResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetUserResponse {
+    return new GetUserResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetUserResponse | PlainMessage<GetUserResponse> | undefined, b: GetUserResponse | PlainMessage<GetUserResponse> | undefined): boolean {
+    return proto3.util.equals(GetUserResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.UserPreferences
+ */
+export class UserPreferences extends Message<UserPreferences> {
+  /**
+   * @generated from field: optional string time_zone = 1;
+   */
+  timeZone?: string;
+
+  constructor(data?: PartialMessage<UserPreferences>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
 
   static readonly runtime: typeof proto3 = proto3;
   static readonly typeName = "rill.admin.v1.UserPreferences";
@@ -5483,25 +6684,327 @@ export class ListWhitelistedDomainsResponse extends Message<ListWhitelistedDomai
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "rill.admin.v1.ListWhitelistedDomainsResponse";
+  static readonly typeName = "rill.admin.v1.ListWhitelistedDomainsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "domains", kind: "message", T: WhitelistedDomain, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListWhitelistedDomainsResponse {
+    return new ListWhitelistedDomainsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListWhitelistedDomainsResponse {
+    return new ListWhitelistedDomainsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListWhitelistedDomainsResponse {
+    return new ListWhitelistedDomainsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListWhitelistedDomainsResponse | PlainMessage<ListWhitelistedDomainsResponse> | undefined, b: ListWhitelistedDomainsResponse | PlainMessage<ListWhitelistedDomainsResponse> | undefined): boolean {
+    return proto3.util.equals(ListWhitelistedDomainsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.GetSSOProviderRequest
+ */
+export class GetSSOProviderRequest extends Message<GetSSOProviderRequest> {
+  /**
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
+  constructor(data?: PartialMessage<GetSSOProviderRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.GetSSOProviderRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSSOProviderRequest {
+    return new GetSSOProviderRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSSOProviderRequest {
+    return new GetSSOProviderRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSSOProviderRequest {
+    return new GetSSOProviderRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetSSOProviderRequest | PlainMessage<GetSSOProviderRequest> | undefined, b: GetSSOProviderRequest | PlainMessage<GetSSOProviderRequest> | undefined): boolean {
+    return proto3.util.equals(GetSSOProviderRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.GetSSOProviderResponse
+ */
+export class GetSSOProviderResponse extends Message<GetSSOProviderResponse> {
+  /**
+   * @generated from field: rill.admin.v1.SSOProvider provider = 1;
+   */
+  provider?: SSOProvider;
+
+  constructor(data?: PartialMessage<GetSSOProviderResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.GetSSOProviderResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "provider", kind: "message", T: SSOProvider },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSSOProviderResponse {
+    return new GetSSOProviderResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSSOProviderResponse {
+    return new GetSSOProviderResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSSOProviderResponse {
+    return new GetSSOProviderResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetSSOProviderResponse | PlainMessage<GetSSOProviderResponse> | undefined, b: GetSSOProviderResponse | PlainMessage<GetSSOProviderResponse> | undefined): boolean {
+    return proto3.util.equals(GetSSOProviderResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.SetSSOProviderRequest
+ */
+export class SetSSOProviderRequest extends Message<SetSSOProviderRequest> {
+  /**
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
+  /**
+   * Either "oidc" or "saml"
+   *
+   * @generated from field: string type = 2;
+   */
+  type = "";
+
+  /**
+   * Email domains that log in through the provider.
+   * A domain is only used after it has been verified through a DNS TXT record (see SSOProvider.domain_verification_txt_value) or set by a superuser.
+   *
+   * @generated from field: repeated string domains = 3;
+   */
+  domains: string[] = [];
+
+  /**
+   * Optional org role for users who log in through the provider but are not yet members of the organization.
+   * If not set, users must be invited to the organization before they can log in.
+   *
+   * @generated from field: string jit_role = 4;
+   */
+  jitRole = "";
+
+  /**
+   * @generated from field: string oidc_issuer_url = 5;
+   */
+  oidcIssuerUrl = "";
+
+  /**
+   * @generated from field: string oidc_client_id = 6;
+   */
+  oidcClientId = "";
+
+  /**
+   * If empty when updating an OIDC provider, the existing client secret is kept
+   *
+   * @generated from field: string oidc_client_secret = 7;
+   */
+  oidcClientSecret = "";
+
+  /**
+   * @generated from field: repeated string oidc_scopes = 8;
+   */
+  oidcScopes: string[] = [];
+
+  /**
+   * Either the URL or the XML document of the SAML identity provider's metadata is required for SAML
+   *
+   * @generated from field: string saml_idp_metadata_url = 9;
+   */
+  samlIdpMetadataUrl = "";
+
+  /**
+   * @generated from field: string saml_idp_metadata_xml = 10;
+   */
+  samlIdpMetadataXml = "";
+
+  /**
+   * Optional names of the claims (OIDC) or attributes (SAML) that contain the user's email, name and groups
+   *
+   * @generated from field: string email_claim = 11;
+   */
+  emailClaim = "";
+
+  /**
+   * @generated from field: string name_claim = 12;
+   */
+  nameClaim = "";
+
+  /**
+   * @generated from field: string groups_claim = 13;
+   */
+  groupsClaim = "";
+
+  constructor(data?: PartialMessage<SetSSOProviderRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.SetSSOProviderRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "domains", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 4, name: "jit_role", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "oidc_issuer_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "oidc_client_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "oidc_client_secret", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "oidc_scopes", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 9, name: "saml_idp_metadata_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 10, name: "saml_idp_metadata_xml", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 11, name: "email_claim", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 12, name: "name_claim", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 13, name: "groups_claim", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetSSOProviderRequest {
+    return new SetSSOProviderRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetSSOProviderRequest {
+    return new SetSSOProviderRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetSSOProviderRequest {
+    return new SetSSOProviderRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetSSOProviderRequest | PlainMessage<SetSSOProviderRequest> | undefined, b: SetSSOProviderRequest | PlainMessage<SetSSOProviderRequest> | undefined): boolean {
+    return proto3.util.equals(SetSSOProviderRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.SetSSOProviderResponse
+ */
+export class SetSSOProviderResponse extends Message<SetSSOProviderResponse> {
+  /**
+   * @generated from field: rill.admin.v1.SSOProvider provider = 1;
+   */
+  provider?: SSOProvider;
+
+  constructor(data?: PartialMessage<SetSSOProviderResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.SetSSOProviderResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "provider", kind: "message", T: SSOProvider },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetSSOProviderResponse {
+    return new SetSSOProviderResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetSSOProviderResponse {
+    return new SetSSOProviderResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetSSOProviderResponse {
+    return new SetSSOProviderResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetSSOProviderResponse | PlainMessage<SetSSOProviderResponse> | undefined, b: SetSSOProviderResponse | PlainMessage<SetSSOProviderResponse> | undefined): boolean {
+    return proto3.util.equals(SetSSOProviderResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.DeleteSSOProviderRequest
+ */
+export class DeleteSSOProviderRequest extends Message<DeleteSSOProviderRequest> {
+  /**
+   * @generated from field: string organization = 1;
+   */
+  organization = "";
+
+  constructor(data?: PartialMessage<DeleteSSOProviderRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.DeleteSSOProviderRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteSSOProviderRequest {
+    return new DeleteSSOProviderRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteSSOProviderRequest {
+    return new DeleteSSOProviderRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteSSOProviderRequest {
+    return new DeleteSSOProviderRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteSSOProviderRequest | PlainMessage<DeleteSSOProviderRequest> | undefined, b: DeleteSSOProviderRequest | PlainMessage<DeleteSSOProviderRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteSSOProviderRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.DeleteSSOProviderResponse
+ */
+export class DeleteSSOProviderResponse extends Message<DeleteSSOProviderResponse> {
+  constructor(data?: PartialMessage<DeleteSSOProviderResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.DeleteSSOProviderResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "domains", kind: "message", T: WhitelistedDomain, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListWhitelistedDomainsResponse {
-    return new ListWhitelistedDomainsResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteSSOProviderResponse {
+    return new DeleteSSOProviderResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListWhitelistedDomainsResponse {
-    return new ListWhitelistedDomainsResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteSSOProviderResponse {
+    return new DeleteSSOProviderResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListWhitelistedDomainsResponse {
-    return new ListWhitelistedDomainsResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteSSOProviderResponse {
+    return new DeleteSSOProviderResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListWhitelistedDomainsResponse | PlainMessage<ListWhitelistedDomainsResponse> | undefined, b: ListWhitelistedDomainsResponse | PlainMessage<ListWhitelistedDomainsResponse> | undefined): boolean {
-    return proto3.util.equals(ListWhitelistedDomainsResponse, a, b);
+  static equals(a: DeleteSSOProviderResponse | PlainMessage<DeleteSSOProviderResponse> | undefined, b: DeleteSSOProviderResponse | PlainMessage<DeleteSSOProviderResponse> | undefined): boolean {
+    return proto3.util.equals(DeleteSSOProviderResponse, a, b);
   }
 }
 
@@ -7670,6 +9173,13 @@ export class Project extends Message<Project> {
    */
   prodVersion = "";
 
+  /**
+   * If true, pull requests and pushes to branches other than the prod branch are deployed as preview deployments.
+   *
+   * @generated from field: bool preview_deployments = 23;
+   */
+  previewDeployments = false;
+
   /**
    * @generated from field: google.protobuf.Timestamp created_on = 14;
    */
@@ -7707,6 +9217,7 @@ export class Project extends Message<Project> {
     { no: 18, name: "prod_ttl_seconds", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
     { no: 20, name: "annotations", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
     { no: 21, name: "prod_version", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 23, name: "preview_deployments", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
     { no: 14, name: "created_on", kind: "message", T: Timestamp },
     { no: 15, name: "updated_on", kind: "message", T: Timestamp },
   ]);
@@ -7772,6 +9283,39 @@ export class Deployment extends Message<Deployment> {
    */
   statusMessage = "";
 
+  /**
+   * Environment that the deployment's variables and rill.yaml overrides are resolved for.
+   *
+   * @generated from field: string environment = 15;
+   */
+  environment = "";
+
+  /**
+   * Preview deployments deploy a branch other than the project's prod branch.
+   *
+   * @generated from field: bool preview = 11;
+   */
+  preview = false;
+
+  /**
+   * Pull request that a preview deployment was created for (if any).
+   *
+   * @generated from field: int64 pull_request_number = 12;
+   */
+  pullRequestNumber = protoInt64.zero;
+
+  /**
+   * @generated from field: string pull_request_url = 13;
+   */
+  pullRequestUrl = "";
+
+  /**
+   * Set when a preview deployment's pull request has been closed. It will be torn down shortly after.
+   *
+   * @generated from field: google.protobuf.Timestamp closed_on = 14;
+   */
+  closedOn?: Timestamp;
+
   /**
    * @generated from field: google.protobuf.Timestamp created_on = 9;
    */
@@ -7798,6 +9342,11 @@ export class Deployment extends Message<Deployment> {
     { no: 6, name: "runtime_instance_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 7, name: "status", kind: "enum", T: proto3.getEnumType(DeploymentStatus) },
     { no: 8, name: "status_message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 15, name: "environment", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 11, name: "preview", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 12, name: "pull_request_number", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 13, name: "pull_request_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 14, name: "closed_on", kind: "message", T: Timestamp },
     { no: 9, name: "created_on", kind: "message", T: Timestamp },
     { no: 10, name: "updated_on", kind: "message", T: Timestamp },
   ]);
@@ -8074,6 +9623,231 @@ export class Member extends Message<Member> {
   }
 }
 
+/**
+ * @generated from message rill.admin.v1.Usergroup
+ */
+export class Usergroup extends Message<Usergroup> {
+  /**
+   * @generated from field: string group_id = 1;
+   */
+  groupId = "";
+
+  /**
+   * @generated from field: string group_name = 2;
+   */
+  groupName = "";
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_on = 3;
+   */
+  createdOn?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_on = 4;
+   */
+  updatedOn?: Timestamp;
+
+  constructor(data?: PartialMessage<Usergroup>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.Usergroup";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "group_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "group_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "created_on", kind: "message", T: Timestamp },
+    { no: 4, name: "updated_on", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Usergroup {
+    return new Usergroup().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Usergroup {
+    return new Usergroup().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Usergroup {
+    return new Usergroup().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Usergroup | PlainMessage<Usergroup> | undefined, b: Usergroup | PlainMessage<Usergroup> | undefined): boolean {
+    return proto3.util.equals(Usergroup, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.MemberUsergroup
+ */
+export class MemberUsergroup extends Message<MemberUsergroup> {
+  /**
+   * @generated from field: string group_id = 1;
+   */
+  groupId = "";
+
+  /**
+   * @generated from field: string group_name = 2;
+   */
+  groupName = "";
+
+  /**
+   * @generated from field: string role_name = 3;
+   */
+  roleName = "";
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_on = 4;
+   */
+  createdOn?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_on = 5;
+   */
+  updatedOn?: Timestamp;
+
+  constructor(data?: PartialMessage<MemberUsergroup>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.MemberUsergroup";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "group_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "group_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "role_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "created_on", kind: "message", T: Timestamp },
+    { no: 5, name: "updated_on", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MemberUsergroup {
+    return new MemberUsergroup().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MemberUsergroup {
+    return new MemberUsergroup().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MemberUsergroup {
+    return new MemberUsergroup().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MemberUsergroup | PlainMessage<MemberUsergroup> | undefined, b: MemberUsergroup | PlainMessage<MemberUsergroup> | undefined): boolean {
+    return proto3.util.equals(MemberUsergroup, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.admin.v1.AuditEvent
+ */
+export class AuditEvent extends Message<AuditEvent> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string org_id = 2;
+   */
+  orgId = "";
+
+  /**
+   * @generated from field: string project_id = 3;
+   */
+  projectId = "";
+
+  /**
+   * @generated from field: string project_name = 4;
+   */
+  projectName = "";
+
+  /**
+   * @generated from field: string actor_type = 5;
+   */
+  actorType = "";
+
+  /**
+   * @generated from field: string actor_id = 6;
+   */
+  actorId = "";
+
+  /**
+   * @generated from field: string actor_name = 7;
+   */
+  actorName = "";
+
+  /**
+   * Name of the AdminService method that made the change, e.g. "UpdateProjectVariables"
+   *
+   * @generated from field: string action = 8;
+   */
+  action = "";
+
+  /**
+   * The request with secrets redacted
+   *
+   * @generated from field: google.protobuf.Struct payload = 9;
+   */
+  payload?: Struct;
+
+  /**
+   * State of the changed resource before and after the change with secrets redacted (only set for some actions)
+   *
+   * @generated from field: google.protobuf.Struct before = 10;
+   */
+  before?: Struct;
+
+  /**
+   * @generated from field: google.protobuf.Struct after = 11;
+   */
+  after?: Struct;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_on = 12;
+   */
+  createdOn?: Timestamp;
+
+  constructor(data?: PartialMessage<AuditEvent>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.AuditEvent";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "org_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "project_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "project_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "actor_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "actor_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "actor_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "action", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 9, name: "payload", kind: "message", T: Struct },
+    { no: 10, name: "before", kind: "message", T: Struct },
+    { no: 11, name: "after", kind: "message", T: Struct },
+    { no: 12, name: "created_on", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AuditEvent {
+    return new AuditEvent().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AuditEvent {
+    return new AuditEvent().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AuditEvent {
+    return new AuditEvent().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AuditEvent | PlainMessage<AuditEvent> | undefined, b: AuditEvent | PlainMessage<AuditEvent> | undefined): boolean {
+    return proto3.util.equals(AuditEvent, a, b);
+  }
+}
+
 /**
  * @generated from message rill.admin.v1.UserInvite
  */
@@ -8166,6 +9940,159 @@ export class WhitelistedDomain extends Message<WhitelistedDomain> {
   }
 }
 
+/**
+ * @generated from message rill.admin.v1.SSOProvider
+ */
+export class SSOProvider extends Message<SSOProvider> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string org_id = 2;
+   */
+  orgId = "";
+
+  /**
+   * @generated from field: string type = 3;
+   */
+  type = "";
+
+  /**
+   * @generated from field: repeated string domains = 4;
+   */
+  domains: string[] = [];
+
+  /**
+   * @generated from field: string jit_role = 5;
+   */
+  jitRole = "";
+
+  /**
+   * @generated from field: string oidc_issuer_url = 6;
+   */
+  oidcIssuerUrl = "";
+
+  /**
+   * @generated from field: string oidc_client_id = 7;
+   */
+  oidcClientId = "";
+
+  /**
+   * @generated from field: repeated string oidc_scopes = 8;
+   */
+  oidcScopes: string[] = [];
+
+  /**
+   * @generated from field: string saml_idp_metadata_url = 9;
+   */
+  samlIdpMetadataUrl = "";
+
+  /**
+   * @generated from field: string email_claim = 10;
+   */
+  emailClaim = "";
+
+  /**
+   * @generated from field: string name_claim = 11;
+   */
+  nameClaim = "";
+
+  /**
+   * @generated from field: string groups_claim = 12;
+   */
+  groupsClaim = "";
+
+  /**
+   * URL that the identity provider must redirect to after an OIDC login
+   *
+   * @generated from field: string oidc_redirect_url = 13;
+   */
+  oidcRedirectUrl = "";
+
+  /**
+   * Entity ID and ACS URL to register with a SAML identity provider (the entity ID also serves the SP metadata)
+   *
+   * @generated from field: string saml_entity_id = 14;
+   */
+  samlEntityId = "";
+
+  /**
+   * @generated from field: string saml_acs_url = 15;
+   */
+  samlAcsUrl = "";
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_on = 16;
+   */
+  createdOn?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_on = 17;
+   */
+  updatedOn?: Timestamp;
+
+  /**
+   * Subset of domains that have been verified. Users with an email in other domains don't log in through the provider.
+   *
+   * @generated from field: repeated string verified_domains = 18;
+   */
+  verifiedDomains: string[] = [];
+
+  /**
+   * To verify a domain, add a DNS TXT record named "_rill-challenge.<domain>" with this value and set the provider again.
+   *
+   * @generated from field: string domain_verification_txt_value = 19;
+   */
+  domainVerificationTxtValue = "";
+
+  constructor(data?: PartialMessage<SSOProvider>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.admin.v1.SSOProvider";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "org_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "domains", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 5, name: "jit_role", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "oidc_issuer_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "oidc_client_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "oidc_scopes", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 9, name: "saml_idp_metadata_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 10, name: "email_claim", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 11, name: "name_claim", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 12, name: "groups_claim", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 13, name: "oidc_redirect_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 14, name: "saml_entity_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 15, name: "saml_acs_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 16, name: "created_on", kind: "message", T: Timestamp },
+    { no: 17, name: "updated_on", kind: "message", T: Timestamp },
+    { no: 18, name: "verified_domains", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 19, name: "domain_verification_txt_value", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SSOProvider {
+    return new SSOProvider().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SSOProvider {
+    return new SSOProvider().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SSOProvider {
+    return new SSOProvider().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SSOProvider | PlainMessage<SSOProvider> | undefined, b: SSOProvider | PlainMessage<SSOProvider> | undefined): boolean {
+    return proto3.util.equals(SSOProvider, a, b);
+  }
+}
+
 /**
  * @generated from message rill.admin.v1.Bookmark
  */
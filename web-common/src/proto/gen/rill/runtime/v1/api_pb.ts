@@ -2694,6 +2694,183 @@ export class GetResourceResponse extends Message<GetResourceResponse> {
   }
 }
 
+/**
+ * @generated from message rill.runtime.v1.GetModelPartitionsRequest
+ */
+export class GetModelPartitionsRequest extends Message<GetModelPartitionsRequest> {
+  /**
+   * @generated from field: string instance_id = 1;
+   */
+  instanceId = "";
+
+  /**
+   * @generated from field: string model = 2;
+   */
+  model = "";
+
+  /**
+   * @generated from field: bool pending = 3;
+   */
+  pending = false;
+
+  /**
+   * @generated from field: bool errored = 4;
+   */
+  errored = false;
+
+  /**
+   * @generated from field: uint32 page_size = 5;
+   */
+  pageSize = 0;
+
+  /**
+   * @generated from field: string page_token = 6;
+   */
+  pageToken = "";
+
+  constructor(data?: PartialMessage<GetModelPartitionsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.GetModelPartitionsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "instance_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "model", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "pending", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "errored", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 5, name: "page_size", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 6, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetModelPartitionsRequest {
+    return new GetModelPartitionsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetModelPartitionsRequest {
+    return new GetModelPartitionsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetModelPartitionsRequest {
+    return new GetModelPartitionsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetModelPartitionsRequest | PlainMessage<GetModelPartitionsRequest> | undefined, b: GetModelPartitionsRequest | PlainMessage<GetModelPartitionsRequest> | undefined): boolean {
+    return proto3.util.equals(GetModelPartitionsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.runtime.v1.GetModelPartitionsResponse
+ */
+export class GetModelPartitionsResponse extends Message<GetModelPartitionsResponse> {
+  /**
+   * @generated from field: repeated rill.runtime.v1.ModelPartition partitions = 1;
+   */
+  partitions: ModelPartition[] = [];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken = "";
+
+  constructor(data?: PartialMessage<GetModelPartitionsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.GetModelPartitionsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "partitions", kind: "message", T: ModelPartition, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetModelPartitionsResponse {
+    return new GetModelPartitionsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetModelPartitionsResponse {
+    return new GetModelPartitionsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetModelPartitionsResponse {
+    return new GetModelPartitionsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetModelPartitionsResponse | PlainMessage<GetModelPartitionsResponse> | undefined, b: GetModelPartitionsResponse | PlainMessage<GetModelPartitionsResponse> | undefined): boolean {
+    return proto3.util.equals(GetModelPartitionsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.runtime.v1.ModelPartition
+ */
+export class ModelPartition extends Message<ModelPartition> {
+  /**
+   * @generated from field: string key = 1;
+   */
+  key = "";
+
+  /**
+   * @generated from field: google.protobuf.Struct data = 2;
+   */
+  data?: Struct;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp watermark = 3;
+   */
+  watermark?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp executed_on = 4;
+   */
+  executedOn?: Timestamp;
+
+  /**
+   * @generated from field: string error = 5;
+   */
+  error = "";
+
+  /**
+   * @generated from field: uint32 elapsed_ms = 6;
+   */
+  elapsedMs = 0;
+
+  constructor(data?: PartialMessage<ModelPartition>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.ModelPartition";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "data", kind: "message", T: Struct },
+    { no: 3, name: "watermark", kind: "message", T: Timestamp },
+    { no: 4, name: "executed_on", kind: "message", T: Timestamp },
+    { no: 5, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "elapsed_ms", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ModelPartition {
+    return new ModelPartition().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ModelPartition {
+    return new ModelPartition().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ModelPartition {
+    return new ModelPartition().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ModelPartition | PlainMessage<ModelPartition> | undefined, b: ModelPartition | PlainMessage<ModelPartition> | undefined): boolean {
+    return proto3.util.equals(ModelPartition, a, b);
+  }
+}
+
 /**
  * @generated from message rill.runtime.v1.CreateTriggerRequest
  */
@@ -2874,6 +3051,11 @@ export class ConnectorDriver extends Message<ConnectorDriver> {
    */
   implementsNotifier = false;
 
+  /**
+   * @generated from field: bool implements_stream_store = 20;
+   */
+  implementsStreamStore = false;
+
   constructor(data?: PartialMessage<ConnectorDriver>) {
     super();
     proto3.util.initPartial(data, this);
@@ -2897,6 +3079,7 @@ export class ConnectorDriver extends Message<ConnectorDriver> {
     { no: 17, name: "implements_object_store", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
     { no: 18, name: "implements_file_store", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
     { no: 19, name: "implements_notifier", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 20, name: "implements_stream_store", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ConnectorDriver {
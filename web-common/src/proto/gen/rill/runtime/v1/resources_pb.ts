@@ -4,7 +4,7 @@
 // @ts-nocheck
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
-import { Message, proto3, protoInt64, Struct, Timestamp } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64, Struct, Timestamp, Value } from "@bufbuild/protobuf";
 import { StructType } from "./schema_pb.js";
 import { TimeGrain } from "./time_grain_pb.js";
 import { ExportFormat } from "./export_format_pb.js";
@@ -657,6 +657,13 @@ export class SourceState extends Message<SourceState> {
    */
   refreshedOn?: Timestamp;
 
+  /**
+   * Offsets to resume consuming from for sources with stream_ingestion, keyed by partition.
+   *
+   * @generated from field: map<string, int64> stream_offsets = 5;
+   */
+  streamOffsets: { [key: string]: bigint } = {};
+
   constructor(data?: PartialMessage<SourceState>) {
     super();
     proto3.util.initPartial(data, this);
@@ -669,6 +676,7 @@ export class SourceState extends Message<SourceState> {
     { no: 2, name: "table", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 3, name: "spec_hash", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 4, name: "refreshed_on", kind: "message", T: Timestamp },
+    { no: 5, name: "stream_offsets", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 3 /* ScalarType.INT64 */} },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SourceState {
@@ -760,6 +768,26 @@ export class ModelSpec extends Message<ModelSpec> {
    */
   incrementalStateResolverProperties?: Struct;
 
+  /**
+   * Resolver that produces the model's partitions. Each row returned by the resolver becomes a partition.
+   *
+   * @generated from field: string partitions_resolver = 17;
+   */
+  partitionsResolver = "";
+
+  /**
+   * @generated from field: google.protobuf.Struct partitions_resolver_properties = 18;
+   */
+  partitionsResolverProperties?: Struct;
+
+  /**
+   * Optional field in the partition rows that holds a timestamp watermark.
+   * If the watermark of an existing partition increases, the partition is re-executed.
+   *
+   * @generated from field: string partitions_watermark_field = 19;
+   */
+  partitionsWatermarkField = "";
+
   /**
    * @generated from field: string input_connector = 10;
    */
@@ -780,11 +808,23 @@ export class ModelSpec extends Message<ModelSpec> {
    */
   outputProperties?: Struct;
 
+  /**
+   * @generated from field: repeated rill.runtime.v1.ModelTest tests = 16;
+   */
+  tests: ModelTest[] = [];
+
   /**
    * @generated from field: bool trigger = 9;
    */
   trigger = false;
 
+  /**
+   * If true, the next trigger will reset the model and fully re-execute it (including all partitions).
+   *
+   * @generated from field: bool trigger_full = 20;
+   */
+  triggerFull = false;
+
   constructor(data?: PartialMessage<ModelSpec>) {
     super();
     proto3.util.initPartial(data, this);
@@ -798,11 +838,16 @@ export class ModelSpec extends Message<ModelSpec> {
     { no: 13, name: "incremental", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
     { no: 14, name: "incremental_state_resolver", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 15, name: "incremental_state_resolver_properties", kind: "message", T: Struct },
+    { no: 17, name: "partitions_resolver", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 18, name: "partitions_resolver_properties", kind: "message", T: Struct },
+    { no: 19, name: "partitions_watermark_field", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 10, name: "input_connector", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 11, name: "input_properties", kind: "message", T: Struct },
     { no: 1, name: "output_connector", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 12, name: "output_properties", kind: "message", T: Struct },
+    { no: 16, name: "tests", kind: "message", T: ModelTest, repeated: true },
     { no: 9, name: "trigger", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 20, name: "trigger_full", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ModelSpec {
@@ -871,6 +916,30 @@ export class ModelState extends Message<ModelState> {
    */
   incrementalStateSchema?: StructType;
 
+  /**
+   * @generated from field: repeated rill.runtime.v1.ModelTestResult test_results = 10;
+   */
+  testResults: ModelTestResult[] = [];
+
+  /**
+   * @generated from field: string test_hash = 11;
+   */
+  testHash = "";
+
+  /**
+   * Identifier of the model's partitions in the catalog. A new ID is generated every time a partitioned model is reset.
+   *
+   * @generated from field: string partitions_model_id = 12;
+   */
+  partitionsModelId = "";
+
+  /**
+   * True if one or more of the model's partitions failed to execute.
+   *
+   * @generated from field: bool partitions_have_errors = 13;
+   */
+  partitionsHaveErrors = false;
+
   constructor(data?: PartialMessage<ModelState>) {
     super();
     proto3.util.initPartial(data, this);
@@ -888,6 +957,10 @@ export class ModelState extends Message<ModelState> {
     { no: 4, name: "refreshed_on", kind: "message", T: Timestamp },
     { no: 7, name: "incremental_state", kind: "message", T: Struct },
     { no: 8, name: "incremental_state_schema", kind: "message", T: StructType },
+    { no: 10, name: "test_results", kind: "message", T: ModelTestResult, repeated: true },
+    { no: 11, name: "test_hash", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 12, name: "partitions_model_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 13, name: "partitions_have_errors", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ModelState {
@@ -907,6 +980,228 @@ export class ModelState extends Message<ModelState> {
   }
 }
 
+/**
+ * ModelTest is a data assertion that is checked against a model's output after it has been built.
+ *
+ * @generated from message rill.runtime.v1.ModelTest
+ */
+export class ModelTest extends Message<ModelTest> {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * @generated from field: rill.runtime.v1.ModelTest.Type type = 2;
+   */
+  type = ModelTest_Type.UNSPECIFIED;
+
+  /**
+   * @generated from field: rill.runtime.v1.ModelTest.Severity severity = 3;
+   */
+  severity = ModelTest_Severity.UNSPECIFIED;
+
+  /**
+   * Column to test. Used by the not_null, unique and accepted_values tests.
+   *
+   * @generated from field: string column = 4;
+   */
+  column = "";
+
+  /**
+   * Values allowed in the column. Used by the accepted_values test.
+   *
+   * @generated from field: repeated google.protobuf.Value accepted_values = 5;
+   */
+  acceptedValues: Value[] = [];
+
+  /**
+   * Inclusive bounds on the number of rows. Used by the row_count test.
+   *
+   * @generated from field: optional uint64 min_rows = 6;
+   */
+  minRows?: bigint;
+
+  /**
+   * @generated from field: optional uint64 max_rows = 7;
+   */
+  maxRows?: bigint;
+
+  /**
+   * SQL query that returns the rows that violate the assertion. Used by the sql test.
+   *
+   * @generated from field: string sql = 8;
+   */
+  sql = "";
+
+  constructor(data?: PartialMessage<ModelTest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.ModelTest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "type", kind: "enum", T: proto3.getEnumType(ModelTest_Type) },
+    { no: 3, name: "severity", kind: "enum", T: proto3.getEnumType(ModelTest_Severity) },
+    { no: 4, name: "column", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "accepted_values", kind: "message", T: Value, repeated: true },
+    { no: 6, name: "min_rows", kind: "scalar", T: 4 /* ScalarType.UINT64 */, opt: true },
+    { no: 7, name: "max_rows", kind: "scalar", T: 4 /* ScalarType.UINT64 */, opt: true },
+    { no: 8, name: "sql", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ModelTest {
+    return new ModelTest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ModelTest {
+    return new ModelTest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ModelTest {
+    return new ModelTest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ModelTest | PlainMessage<ModelTest> | undefined, b: ModelTest | PlainMessage<ModelTest> | undefined): boolean {
+    return proto3.util.equals(ModelTest, a, b);
+  }
+}
+
+/**
+ * @generated from enum rill.runtime.v1.ModelTest.Type
+ */
+export enum ModelTest_Type {
+  /**
+   * @generated from enum value: TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: TYPE_NOT_NULL = 1;
+   */
+  NOT_NULL = 1,
+
+  /**
+   * @generated from enum value: TYPE_UNIQUE = 2;
+   */
+  UNIQUE = 2,
+
+  /**
+   * @generated from enum value: TYPE_ACCEPTED_VALUES = 3;
+   */
+  ACCEPTED_VALUES = 3,
+
+  /**
+   * @generated from enum value: TYPE_ROW_COUNT = 4;
+   */
+  ROW_COUNT = 4,
+
+  /**
+   * @generated from enum value: TYPE_SQL = 5;
+   */
+  SQL = 5,
+}
+// Retrieve enum metadata with: proto3.getEnumType(ModelTest_Type)
+proto3.util.setEnumType(ModelTest_Type, "rill.runtime.v1.ModelTest.Type", [
+  { no: 0, name: "TYPE_UNSPECIFIED" },
+  { no: 1, name: "TYPE_NOT_NULL" },
+  { no: 2, name: "TYPE_UNIQUE" },
+  { no: 3, name: "TYPE_ACCEPTED_VALUES" },
+  { no: 4, name: "TYPE_ROW_COUNT" },
+  { no: 5, name: "TYPE_SQL" },
+]);
+
+/**
+ * @generated from enum rill.runtime.v1.ModelTest.Severity
+ */
+export enum ModelTest_Severity {
+  /**
+   * @generated from enum value: SEVERITY_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: SEVERITY_ERROR = 1;
+   */
+  ERROR = 1,
+
+  /**
+   * @generated from enum value: SEVERITY_WARN = 2;
+   */
+  WARN = 2,
+}
+// Retrieve enum metadata with: proto3.getEnumType(ModelTest_Severity)
+proto3.util.setEnumType(ModelTest_Severity, "rill.runtime.v1.ModelTest.Severity", [
+  { no: 0, name: "SEVERITY_UNSPECIFIED" },
+  { no: 1, name: "SEVERITY_ERROR" },
+  { no: 2, name: "SEVERITY_WARN" },
+]);
+
+/**
+ * @generated from message rill.runtime.v1.ModelTestResult
+ */
+export class ModelTestResult extends Message<ModelTestResult> {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * @generated from field: rill.runtime.v1.AssertionStatus status = 2;
+   */
+  status = AssertionStatus.UNSPECIFIED;
+
+  /**
+   * @generated from field: rill.runtime.v1.ModelTest.Severity severity = 3;
+   */
+  severity = ModelTest_Severity.UNSPECIFIED;
+
+  /**
+   * Number of rows that failed the assertion. For the row_count test, it is the actual row count if it is out of bounds.
+   *
+   * @generated from field: uint64 failed_rows = 4;
+   */
+  failedRows = protoInt64.zero;
+
+  /**
+   * @generated from field: string error_message = 5;
+   */
+  errorMessage = "";
+
+  constructor(data?: PartialMessage<ModelTestResult>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.ModelTestResult";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "status", kind: "enum", T: proto3.getEnumType(AssertionStatus) },
+    { no: 3, name: "severity", kind: "enum", T: proto3.getEnumType(ModelTest_Severity) },
+    { no: 4, name: "failed_rows", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 5, name: "error_message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ModelTestResult {
+    return new ModelTestResult().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ModelTestResult {
+    return new ModelTestResult().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ModelTestResult {
+    return new ModelTestResult().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ModelTestResult | PlainMessage<ModelTestResult> | undefined, b: ModelTestResult | PlainMessage<ModelTestResult> | undefined): boolean {
+    return proto3.util.equals(ModelTestResult, a, b);
+  }
+}
+
 /**
  * @generated from message rill.runtime.v1.MetricsViewV2
  */
@@ -1157,6 +1452,62 @@ export class MetricsViewSpec extends Message<MetricsViewSpec> {
   }
 }
 
+/**
+ * Type of a measure
+ *
+ * @generated from enum rill.runtime.v1.MetricsViewSpec.MeasureType
+ */
+export enum MetricsViewSpec_MeasureType {
+  /**
+   * @generated from enum value: MEASURE_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Aggregate SQL expression over the underlying table
+   *
+   * @generated from enum value: MEASURE_TYPE_SIMPLE = 1;
+   */
+  SIMPLE = 1,
+
+  /**
+   * Expression that references other measures by name
+   *
+   * @generated from enum value: MEASURE_TYPE_DERIVED = 2;
+   */
+  DERIVED = 2,
+
+  /**
+   * Cumulative sum of another measure over the time dimension
+   *
+   * @generated from enum value: MEASURE_TYPE_RUNNING_TOTAL = 3;
+   */
+  RUNNING_TOTAL = 3,
+
+  /**
+   * Average of another measure over a trailing window of time grain periods
+   *
+   * @generated from enum value: MEASURE_TYPE_MOVING_AVERAGE = 4;
+   */
+  MOVING_AVERAGE = 4,
+
+  /**
+   * Ratio of another measure to its total across all rows in the same time period
+   *
+   * @generated from enum value: MEASURE_TYPE_SHARE_OF_PARENT = 5;
+   */
+  SHARE_OF_PARENT = 5,
+}
+// Retrieve enum metadata with: proto3.getEnumType(MetricsViewSpec_MeasureType)
+proto3.util.setEnumType(MetricsViewSpec_MeasureType, "rill.runtime.v1.MetricsViewSpec.MeasureType", [
+  { no: 0, name: "MEASURE_TYPE_UNSPECIFIED" },
+  { no: 1, name: "MEASURE_TYPE_SIMPLE" },
+  { no: 2, name: "MEASURE_TYPE_DERIVED" },
+  { no: 3, name: "MEASURE_TYPE_RUNNING_TOTAL" },
+  { no: 4, name: "MEASURE_TYPE_MOVING_AVERAGE" },
+  { no: 5, name: "MEASURE_TYPE_SHARE_OF_PARENT" },
+]);
+
 /**
  * @generated from enum rill.runtime.v1.MetricsViewSpec.ComparisonMode
  */
@@ -1270,6 +1621,9 @@ export class MetricsViewSpec_MeasureV2 extends Message<MetricsViewSpec_MeasureV2
   name = "";
 
   /**
+   * Aggregate SQL expression. For derived measures, references to other measures have been expanded.
+   * It is empty for window measures (running total, moving average and share of parent).
+   *
    * @generated from field: string expression = 2;
    */
   expression = "";
@@ -1299,6 +1653,25 @@ export class MetricsViewSpec_MeasureV2 extends Message<MetricsViewSpec_MeasureV2
    */
   validPercentOfTotal = false;
 
+  /**
+   * @generated from field: rill.runtime.v1.MetricsViewSpec.MeasureType type = 8;
+   */
+  type = MetricsViewSpec_MeasureType.UNSPECIFIED;
+
+  /**
+   * Names of the measures referenced by a derived measure, or the single measure a window measure is computed over.
+   *
+   * @generated from field: repeated string referenced_measures = 9;
+   */
+  referencedMeasures: string[] = [];
+
+  /**
+   * Number of time grain periods (including the current one) to average over for moving average measures.
+   *
+   * @generated from field: uint32 window = 10;
+   */
+  window = 0;
+
   constructor(data?: PartialMessage<MetricsViewSpec_MeasureV2>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1314,6 +1687,9 @@ export class MetricsViewSpec_MeasureV2 extends Message<MetricsViewSpec_MeasureV2
     { no: 5, name: "format_preset", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 7, name: "format_d3", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 6, name: "valid_percent_of_total", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 8, name: "type", kind: "enum", T: proto3.getEnumType(MetricsViewSpec_MeasureType) },
+    { no: 9, name: "referenced_measures", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 10, name: "window", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MetricsViewSpec_MeasureV2 {
@@ -1827,6 +2203,19 @@ export class ReportSpec extends Message<ReportSpec> {
    */
   intervalsCheckUnclosed = false;
 
+  /**
+   * If set, the report will be executed for each interval that ends in the range (backfill_from, backfill_to].
+   * It is set by a refresh trigger and cleared when the backfill has completed.
+   *
+   * @generated from field: google.protobuf.Timestamp backfill_from = 16;
+   */
+  backfillFrom?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp backfill_to = 17;
+   */
+  backfillTo?: Timestamp;
+
   constructor(data?: PartialMessage<ReportSpec>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1849,6 +2238,8 @@ export class ReportSpec extends Message<ReportSpec> {
     { no: 13, name: "intervals_iso_duration", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 14, name: "intervals_limit", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
     { no: 15, name: "intervals_check_unclosed", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 16, name: "backfill_from", kind: "message", T: Timestamp },
+    { no: 17, name: "backfill_to", kind: "message", T: Timestamp },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReportSpec {
@@ -1952,6 +2343,13 @@ export class ReportExecution extends Message<ReportExecution> {
    */
   finishedOn?: Timestamp;
 
+  /**
+   * True if the execution was part of a backfill of a historical time range.
+   *
+   * @generated from field: bool backfill = 6;
+   */
+  backfill = false;
+
   constructor(data?: PartialMessage<ReportExecution>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1965,6 +2363,7 @@ export class ReportExecution extends Message<ReportExecution> {
     { no: 3, name: "report_time", kind: "message", T: Timestamp },
     { no: 4, name: "started_on", kind: "message", T: Timestamp },
     { no: 5, name: "finished_on", kind: "message", T: Timestamp },
+    { no: 6, name: "backfill", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReportExecution {
@@ -2141,6 +2540,19 @@ export class AlertSpec extends Message<AlertSpec> {
    */
   annotations: { [key: string]: string } = {};
 
+  /**
+   * If set, the alert will be checked for each interval that ends in the range (backfill_from, backfill_to].
+   * It is set by a refresh trigger and cleared when the backfill has completed.
+   *
+   * @generated from field: google.protobuf.Timestamp backfill_from = 22;
+   */
+  backfillFrom?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp backfill_to = 23;
+   */
+  backfillTo?: Timestamp;
+
   constructor(data?: PartialMessage<AlertSpec>) {
     super();
     proto3.util.initPartial(data, this);
@@ -2169,6 +2581,8 @@ export class AlertSpec extends Message<AlertSpec> {
     { no: 19, name: "renotify_after_seconds", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
     { no: 21, name: "notifiers", kind: "message", T: Notifier, repeated: true },
     { no: 20, name: "annotations", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+    { no: 22, name: "backfill_from", kind: "message", T: Timestamp },
+    { no: 23, name: "backfill_to", kind: "message", T: Timestamp },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AlertSpec {
@@ -2332,6 +2746,13 @@ export class AlertExecution extends Message<AlertExecution> {
    */
   finishedOn?: Timestamp;
 
+  /**
+   * True if the execution was part of a backfill of a historical time range.
+   *
+   * @generated from field: bool backfill = 7;
+   */
+  backfill = false;
+
   constructor(data?: PartialMessage<AlertExecution>) {
     super();
     proto3.util.initPartial(data, this);
@@ -2346,6 +2767,7 @@ export class AlertExecution extends Message<AlertExecution> {
     { no: 4, name: "execution_time", kind: "message", T: Timestamp },
     { no: 5, name: "started_on", kind: "message", T: Timestamp },
     { no: 6, name: "finished_on", kind: "message", T: Timestamp },
+    { no: 7, name: "backfill", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AlertExecution {
@@ -2571,6 +2993,16 @@ export class RefreshTriggerSpec extends Message<RefreshTriggerSpec> {
    */
   onlyNames: ResourceName[] = [];
 
+  /**
+   * @generated from field: repeated rill.runtime.v1.RefreshModelTrigger models = 2;
+   */
+  models: RefreshModelTrigger[] = [];
+
+  /**
+   * @generated from field: repeated rill.runtime.v1.RefreshBackfillTrigger backfills = 3;
+   */
+  backfills: RefreshBackfillTrigger[] = [];
+
   constructor(data?: PartialMessage<RefreshTriggerSpec>) {
     super();
     proto3.util.initPartial(data, this);
@@ -2580,6 +3012,8 @@ export class RefreshTriggerSpec extends Message<RefreshTriggerSpec> {
   static readonly typeName = "rill.runtime.v1.RefreshTriggerSpec";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "only_names", kind: "message", T: ResourceName, repeated: true },
+    { no: 2, name: "models", kind: "message", T: RefreshModelTrigger, repeated: true },
+    { no: 3, name: "backfills", kind: "message", T: RefreshBackfillTrigger, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RefreshTriggerSpec {
@@ -2599,6 +3033,124 @@ export class RefreshTriggerSpec extends Message<RefreshTriggerSpec> {
   }
 }
 
+/**
+ * @generated from message rill.runtime.v1.RefreshModelTrigger
+ */
+export class RefreshModelTrigger extends Message<RefreshModelTrigger> {
+  /**
+   * The model to refresh.
+   *
+   * @generated from field: string model = 1;
+   */
+  model = "";
+
+  /**
+   * If true, the model will be reset and fully re-executed (including all partitions).
+   *
+   * @generated from field: bool full = 2;
+   */
+  full = false;
+
+  /**
+   * Keys of specific partitions to re-execute.
+   *
+   * @generated from field: repeated string partitions = 3;
+   */
+  partitions: string[] = [];
+
+  /**
+   * If true, it will re-execute all partitions that errored during the last execution.
+   *
+   * @generated from field: bool all_errored_partitions = 4;
+   */
+  allErroredPartitions = false;
+
+  constructor(data?: PartialMessage<RefreshModelTrigger>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.RefreshModelTrigger";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "model", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "full", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "partitions", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 4, name: "all_errored_partitions", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RefreshModelTrigger {
+    return new RefreshModelTrigger().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RefreshModelTrigger {
+    return new RefreshModelTrigger().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RefreshModelTrigger {
+    return new RefreshModelTrigger().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RefreshModelTrigger | PlainMessage<RefreshModelTrigger> | undefined, b: RefreshModelTrigger | PlainMessage<RefreshModelTrigger> | undefined): boolean {
+    return proto3.util.equals(RefreshModelTrigger, a, b);
+  }
+}
+
+/**
+ * @generated from message rill.runtime.v1.RefreshBackfillTrigger
+ */
+export class RefreshBackfillTrigger extends Message<RefreshBackfillTrigger> {
+  /**
+   * The report or alert to backfill. The kind must be set.
+   *
+   * @generated from field: rill.runtime.v1.ResourceName name = 1;
+   */
+  name?: ResourceName;
+
+  /**
+   * Start of the time range to backfill (exclusive).
+   *
+   * @generated from field: google.protobuf.Timestamp from = 2;
+   */
+  from?: Timestamp;
+
+  /**
+   * End of the time range to backfill (inclusive).
+   *
+   * @generated from field: google.protobuf.Timestamp to = 3;
+   */
+  to?: Timestamp;
+
+  constructor(data?: PartialMessage<RefreshBackfillTrigger>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.RefreshBackfillTrigger";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "message", T: ResourceName },
+    { no: 2, name: "from", kind: "message", T: Timestamp },
+    { no: 3, name: "to", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RefreshBackfillTrigger {
+    return new RefreshBackfillTrigger().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RefreshBackfillTrigger {
+    return new RefreshBackfillTrigger().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RefreshBackfillTrigger {
+    return new RefreshBackfillTrigger().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RefreshBackfillTrigger | PlainMessage<RefreshBackfillTrigger> | undefined, b: RefreshBackfillTrigger | PlainMessage<RefreshBackfillTrigger> | undefined): boolean {
+    return proto3.util.equals(RefreshBackfillTrigger, a, b);
+  }
+}
+
 /**
  * @generated from message rill.runtime.v1.RefreshTriggerState
  */
@@ -3347,6 +3899,32 @@ export class APISpec extends Message<APISpec> {
    */
   resolverProperties?: Struct;
 
+  /**
+   * Streaming is true if the API should write rows to the response as they are produced instead of buffering the full result.
+   *
+   * @generated from field: bool streaming = 3;
+   */
+  streaming = false;
+
+  /**
+   * @generated from field: string description = 4;
+   */
+  description = "";
+
+  /**
+   * Arguments declares the args accepted by the API. Args that are not declared are passed through without validation.
+   *
+   * @generated from field: repeated rill.runtime.v1.APIArgument arguments = 5;
+   */
+  arguments: APIArgument[] = [];
+
+  /**
+   * Pagination is set if the runtime should paginate the API's output.
+   *
+   * @generated from field: rill.runtime.v1.APIPagination pagination = 6;
+   */
+  pagination?: APIPagination;
+
   constructor(data?: PartialMessage<APISpec>) {
     super();
     proto3.util.initPartial(data, this);
@@ -3357,6 +3935,10 @@ export class APISpec extends Message<APISpec> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "resolver", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "resolver_properties", kind: "message", T: Struct },
+    { no: 3, name: "streaming", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "description", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "arguments", kind: "message", T: APIArgument, repeated: true },
+    { no: 6, name: "pagination", kind: "message", T: APIPagination },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): APISpec {
@@ -3376,6 +3958,275 @@ export class APISpec extends Message<APISpec> {
   }
 }
 
+/**
+ * APIArgument is a typed argument accepted by a custom API.
+ *
+ * @generated from message rill.runtime.v1.APIArgument
+ */
+export class APIArgument extends Message<APIArgument> {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * @generated from field: rill.runtime.v1.APIArgument.Type type = 2;
+   */
+  type = APIArgument_Type.UNSPECIFIED;
+
+  /**
+   * @generated from field: string description = 3;
+   */
+  description = "";
+
+  /**
+   * @generated from field: bool required = 4;
+   */
+  required = false;
+
+  /**
+   * Value to use if the arg is not provided.
+   *
+   * @generated from field: google.protobuf.Value default_value = 5;
+   */
+  defaultValue?: Value;
+
+  /**
+   * If not empty, the arg must be one of these values.
+   *
+   * @generated from field: repeated google.protobuf.Value allowed_values = 6;
+   */
+  allowedValues: Value[] = [];
+
+  /**
+   * Inclusive bounds for integer and number args.
+   *
+   * @generated from field: optional double minimum = 7;
+   */
+  minimum?: number;
+
+  /**
+   * @generated from field: optional double maximum = 8;
+   */
+  maximum?: number;
+
+  /**
+   * Regular expression that string args must match.
+   *
+   * @generated from field: string pattern = 9;
+   */
+  pattern = "";
+
+  constructor(data?: PartialMessage<APIArgument>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.APIArgument";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "type", kind: "enum", T: proto3.getEnumType(APIArgument_Type) },
+    { no: 3, name: "description", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "required", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 5, name: "default_value", kind: "message", T: Value },
+    { no: 6, name: "allowed_values", kind: "message", T: Value, repeated: true },
+    { no: 7, name: "minimum", kind: "scalar", T: 1 /* ScalarType.DOUBLE */, opt: true },
+    { no: 8, name: "maximum", kind: "scalar", T: 1 /* ScalarType.DOUBLE */, opt: true },
+    { no: 9, name: "pattern", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): APIArgument {
+    return new APIArgument().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): APIArgument {
+    return new APIArgument().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): APIArgument {
+    return new APIArgument().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: APIArgument | PlainMessage<APIArgument> | undefined, b: APIArgument | PlainMessage<APIArgument> | undefined): boolean {
+    return proto3.util.equals(APIArgument, a, b);
+  }
+}
+
+/**
+ * @generated from enum rill.runtime.v1.APIArgument.Type
+ */
+export enum APIArgument_Type {
+  /**
+   * @generated from enum value: TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: TYPE_STRING = 1;
+   */
+  STRING = 1,
+
+  /**
+   * @generated from enum value: TYPE_INTEGER = 2;
+   */
+  INTEGER = 2,
+
+  /**
+   * @generated from enum value: TYPE_NUMBER = 3;
+   */
+  NUMBER = 3,
+
+  /**
+   * @generated from enum value: TYPE_BOOLEAN = 4;
+   */
+  BOOLEAN = 4,
+}
+// Retrieve enum metadata with: proto3.getEnumType(APIArgument_Type)
+proto3.util.setEnumType(APIArgument_Type, "rill.runtime.v1.APIArgument.Type", [
+  { no: 0, name: "TYPE_UNSPECIFIED" },
+  { no: 1, name: "TYPE_STRING" },
+  { no: 2, name: "TYPE_INTEGER" },
+  { no: 3, name: "TYPE_NUMBER" },
+  { no: 4, name: "TYPE_BOOLEAN" },
+]);
+
+/**
+ * APIPagination configures pagination of a custom API's output.
+ *
+ * @generated from message rill.runtime.v1.APIPagination
+ */
+export class APIPagination extends Message<APIPagination> {
+  /**
+   * @generated from field: rill.runtime.v1.APIPagination.Mode mode = 1;
+   */
+  mode = APIPagination_Mode.UNSPECIFIED;
+
+  /**
+   * @generated from field: uint32 default_page_size = 2;
+   */
+  defaultPageSize = 0;
+
+  /**
+   * @generated from field: uint32 max_page_size = 3;
+   */
+  maxPageSize = 0;
+
+  /**
+   * Column to paginate by in cursor mode. It should be unique and sortable.
+   *
+   * @generated from field: string cursor_column = 4;
+   */
+  cursorColumn = "";
+
+  /**
+   * Columns to sort by in offset mode. Together, they should uniquely identify a row.
+   *
+   * @generated from field: repeated rill.runtime.v1.APIPagination.Sort order_by = 5;
+   */
+  orderBy: APIPagination_Sort[] = [];
+
+  constructor(data?: PartialMessage<APIPagination>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.APIPagination";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "mode", kind: "enum", T: proto3.getEnumType(APIPagination_Mode) },
+    { no: 2, name: "default_page_size", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 3, name: "max_page_size", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 4, name: "cursor_column", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "order_by", kind: "message", T: APIPagination_Sort, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): APIPagination {
+    return new APIPagination().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): APIPagination {
+    return new APIPagination().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): APIPagination {
+    return new APIPagination().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: APIPagination | PlainMessage<APIPagination> | undefined, b: APIPagination | PlainMessage<APIPagination> | undefined): boolean {
+    return proto3.util.equals(APIPagination, a, b);
+  }
+}
+
+/**
+ * @generated from enum rill.runtime.v1.APIPagination.Mode
+ */
+export enum APIPagination_Mode {
+  /**
+   * @generated from enum value: MODE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: MODE_OFFSET = 1;
+   */
+  OFFSET = 1,
+
+  /**
+   * @generated from enum value: MODE_CURSOR = 2;
+   */
+  CURSOR = 2,
+}
+// Retrieve enum metadata with: proto3.getEnumType(APIPagination_Mode)
+proto3.util.setEnumType(APIPagination_Mode, "rill.runtime.v1.APIPagination.Mode", [
+  { no: 0, name: "MODE_UNSPECIFIED" },
+  { no: 1, name: "MODE_OFFSET" },
+  { no: 2, name: "MODE_CURSOR" },
+]);
+
+/**
+ * @generated from message rill.runtime.v1.APIPagination.Sort
+ */
+export class APIPagination_Sort extends Message<APIPagination_Sort> {
+  /**
+   * @generated from field: string column = 1;
+   */
+  column = "";
+
+  /**
+   * @generated from field: bool desc = 2;
+   */
+  desc = false;
+
+  constructor(data?: PartialMessage<APIPagination_Sort>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "rill.runtime.v1.APIPagination.Sort";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "column", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "desc", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): APIPagination_Sort {
+    return new APIPagination_Sort().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): APIPagination_Sort {
+    return new APIPagination_Sort().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): APIPagination_Sort {
+    return new APIPagination_Sort().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: APIPagination_Sort | PlainMessage<APIPagination_Sort> | undefined, b: APIPagination_Sort | PlainMessage<APIPagination_Sort> | undefined): boolean {
+    return proto3.util.equals(APIPagination_Sort, a, b);
+  }
+}
+
 /**
  * @generated from message rill.runtime.v1.APIState
  */
@@ -37,6 +37,13 @@ export type ConnectorServiceOLAPListTablesParams = {
   connector?: string;
 };
 
+export type RuntimeServiceGetModelPartitionsParams = {
+  pending?: boolean;
+  errored?: boolean;
+  pageSize?: number;
+  pageToken?: string;
+};
+
 export type RuntimeServiceCreateTriggerBody = {
   pullTriggerSpec?: V1PullTriggerSpec;
   refreshTriggerSpec?: V1RefreshTriggerSpec;
@@ -648,11 +655,18 @@ export interface V1StructType {
   fields?: StructTypeField[];
 }
 
+/**
+ * Offsets to resume consuming from for sources with stream_ingestion, keyed by partition.
+ */
+export type V1SourceStateStreamOffsets = { [key: string]: string };
+
 export interface V1SourceState {
   connector?: string;
   table?: string;
   specHash?: string;
   refreshedOn?: string;
+  /** Offsets to resume consuming from for sources with stream_ingestion, keyed by partition. */
+  streamOffsets?: V1SourceStateStreamOffsets;
 }
 
 export interface V1SourceV2 {
@@ -766,6 +780,10 @@ export interface V1ReportSpec {
   intervalsIsoDuration?: string;
   intervalsLimit?: number;
   intervalsCheckUnclosed?: boolean;
+  /** If set, the report will be executed for each interval that ends in the range (backfill_from, backfill_to].
+It is set by a refresh trigger and cleared when the backfill has completed. */
+  backfillFrom?: string;
+  backfillTo?: string;
 }
 
 export interface V1ReportExecution {
@@ -774,6 +792,8 @@ export interface V1ReportExecution {
   reportTime?: string;
   startedOn?: string;
   finishedOn?: string;
+  /** True if the execution was part of a backfill of a historical time range. */
+  backfill?: boolean;
 }
 
 export interface V1Report {
@@ -814,6 +834,27 @@ export interface V1RefreshTriggerState {
 
 export interface V1RefreshTriggerSpec {
   onlyNames?: V1ResourceName[];
+  models?: V1RefreshModelTrigger[];
+  backfills?: V1RefreshBackfillTrigger[];
+}
+
+export interface V1RefreshModelTrigger {
+  /** The model to refresh. */
+  model?: string;
+  /** If true, the model will be reset and fully re-executed (including all partitions). */
+  full?: boolean;
+  /** Keys of specific partitions to re-execute. */
+  partitions?: string[];
+  /** If true, it will re-execute all partitions that errored during the last execution. */
+  allErroredPartitions?: boolean;
+}
+
+export interface V1RefreshBackfillTrigger {
+  name?: V1ResourceName;
+  /** Start of the time range to backfill (exclusive). */
+  from?: string;
+  /** End of the time range to backfill (inclusive). */
+  to?: string;
 }
 
 export type V1ReconcileStatus =
@@ -1002,6 +1043,46 @@ export type V1ModelStateIncrementalState = { [key: string]: any };
 
 export type V1ModelStateResultProperties = { [key: string]: any };
 
+export type V1ModelTestType =
+  (typeof V1ModelTestType)[keyof typeof V1ModelTestType];
+
+// eslint-disable-next-line @typescript-eslint/no-redeclare
+export const V1ModelTestType = {
+  TYPE_UNSPECIFIED: "TYPE_UNSPECIFIED",
+  TYPE_NOT_NULL: "TYPE_NOT_NULL",
+  TYPE_UNIQUE: "TYPE_UNIQUE",
+  TYPE_ACCEPTED_VALUES: "TYPE_ACCEPTED_VALUES",
+  TYPE_ROW_COUNT: "TYPE_ROW_COUNT",
+  TYPE_SQL: "TYPE_SQL",
+} as const;
+
+export interface V1ModelTestResult {
+  name?: string;
+  status?: V1AssertionStatus;
+  severity?: ModelTestSeverity;
+  /** Number of rows that failed the assertion. For the row_count test, it is the actual row count if it is out of bounds. */
+  failedRows?: string;
+  errorMessage?: string;
+}
+
+/**
+ * ModelTest is a data assertion that is checked against a model's output after it has been built.
+ */
+export interface V1ModelTest {
+  name?: string;
+  type?: V1ModelTestType;
+  severity?: ModelTestSeverity;
+  /** Column to test. Used by the not_null, unique and accepted_values tests. */
+  column?: string;
+  /** Values allowed in the column. Used by the accepted_values test. */
+  acceptedValues?: unknown[];
+  /** Inclusive bounds on the number of rows. Used by the row_count test. */
+  minRows?: string;
+  maxRows?: string;
+  /** SQL query that returns the rows that violate the assertion. Used by the sql test. */
+  sql?: string;
+}
+
 export interface V1ModelState {
   executorConnector?: string;
   resultConnector?: string;
@@ -1012,8 +1093,16 @@ export interface V1ModelState {
   refreshedOn?: string;
   incrementalState?: V1ModelStateIncrementalState;
   incrementalStateSchema?: V1StructType;
+  testResults?: V1ModelTestResult[];
+  testHash?: string;
+  /** Identifier of the model's partitions in the catalog. A new ID is generated every time a partitioned model is reset. */
+  partitionsModelId?: string;
+  /** True if one or more of the model's partitions failed to execute. */
+  partitionsHaveErrors?: boolean;
 }
 
+export type V1ModelSpecPartitionsResolverProperties = { [key: string]: any };
+
 export type V1ModelSpecOutputProperties = { [key: string]: any };
 
 export type V1ModelSpecInputProperties = { [key: string]: any };
@@ -1028,11 +1117,20 @@ export interface V1ModelSpec {
   incremental?: boolean;
   incrementalStateResolver?: string;
   incrementalStateResolverProperties?: V1ModelSpecIncrementalStateResolverProperties;
+  /** Resolver that produces the model's partitions. Each row returned by the resolver becomes a partition. */
+  partitionsResolver?: string;
+  partitionsResolverProperties?: V1ModelSpecPartitionsResolverProperties;
+  /** Optional field in the partition rows that holds a timestamp watermark.
+If the watermark of an existing partition increases, the partition is re-executed. */
+  partitionsWatermarkField?: string;
   inputConnector?: string;
   inputProperties?: V1ModelSpecInputProperties;
   outputConnector?: string;
   outputProperties?: V1ModelSpecOutputProperties;
+  tests?: V1ModelTest[];
   trigger?: boolean;
+  /** If true, the next trigger will reset the model and fully re-execute it (including all partitions). */
+  triggerFull?: boolean;
 }
 
 export interface V1ModelV2 {
@@ -1040,6 +1138,17 @@ export interface V1ModelV2 {
   state?: V1ModelState;
 }
 
+export type V1ModelPartitionData = { [key: string]: any };
+
+export interface V1ModelPartition {
+  key?: string;
+  data?: V1ModelPartitionData;
+  watermark?: string;
+  executedOn?: string;
+  error?: string;
+  elapsedMs?: number;
+}
+
 export interface V1MigrationState {
   version?: number;
 }
@@ -1441,6 +1550,11 @@ export interface V1GetResourceResponse {
   resource?: V1Resource;
 }
 
+export interface V1GetModelPartitionsResponse {
+  partitions?: V1ModelPartition[];
+  nextPageToken?: string;
+}
+
 export interface V1GetLogsResponse {
   logs?: V1Log[];
 }
@@ -1529,6 +1643,8 @@ export const V1ExportFormat = {
   EXPORT_FORMAT_CSV: "EXPORT_FORMAT_CSV",
   EXPORT_FORMAT_XLSX: "EXPORT_FORMAT_XLSX",
   EXPORT_FORMAT_PARQUET: "EXPORT_FORMAT_PARQUET",
+  EXPORT_FORMAT_JSONL: "EXPORT_FORMAT_JSONL",
+  EXPORT_FORMAT_ARROW: "EXPORT_FORMAT_ARROW",
 } as const;
 
 /**
@@ -1635,6 +1751,7 @@ export interface V1ConnectorDriver {
   implementsObjectStore?: boolean;
   implementsFileStore?: boolean;
   implementsNotifier?: boolean;
+  implementsStreamStore?: boolean;
 }
 
 export type V1ConnectorConfig = { [key: string]: string };
@@ -1951,6 +2068,10 @@ export interface V1AlertSpec {
   renotifyAfterSeconds?: number;
   notifiers?: V1Notifier[];
   annotations?: V1AlertSpecAnnotations;
+  /** If set, the alert will be checked for each interval that ends in the range (backfill_from, backfill_to].
+It is set by a refresh trigger and cleared when the backfill has completed. */
+  backfillFrom?: string;
+  backfillTo?: string;
 }
 
 export interface V1AlertExecution {
@@ -1960,6 +2081,8 @@ export interface V1AlertExecution {
   executionTime?: string;
   startedOn?: string;
   finishedOn?: string;
+  /** True if the execution was part of a backfill of a historical time range. */
+  backfill?: boolean;
 }
 
 export interface V1AlertState {
@@ -1980,11 +2103,61 @@ export interface V1APIState {
   [key: string]: any;
 }
 
+export type V1APIArgumentType =
+  (typeof V1APIArgumentType)[keyof typeof V1APIArgumentType];
+
+// eslint-disable-next-line @typescript-eslint/no-redeclare
+export const V1APIArgumentType = {
+  TYPE_UNSPECIFIED: "TYPE_UNSPECIFIED",
+  TYPE_STRING: "TYPE_STRING",
+  TYPE_INTEGER: "TYPE_INTEGER",
+  TYPE_NUMBER: "TYPE_NUMBER",
+  TYPE_BOOLEAN: "TYPE_BOOLEAN",
+} as const;
+
+/**
+ * APIArgument is a typed argument accepted by a custom API.
+ */
+export interface V1APIArgument {
+  name?: string;
+  type?: V1APIArgumentType;
+  description?: string;
+  required?: boolean;
+  /** Value to use if the arg is not provided. */
+  defaultValue?: unknown;
+  /** If not empty, the arg must be one of these values. */
+  allowedValues?: unknown[];
+  /** Inclusive bounds for integer and number args. */
+  minimum?: number;
+  maximum?: number;
+  /** Regular expression that string args must match. */
+  pattern?: string;
+}
+
+/**
+ * APIPagination configures pagination of a custom API's output.
+ */
+export interface V1APIPagination {
+  mode?: APIPaginationMode;
+  defaultPageSize?: number;
+  maxPageSize?: number;
+  /** Column to paginate by in cursor mode. It should be unique and sortable. */
+  cursorColumn?: string;
+  /** Columns to sort by in offset mode. Together, they should uniquely identify a row. */
+  orderBy?: APIPaginationSort[];
+}
+
 export type V1APISpecResolverProperties = { [key: string]: any };
 
 export interface V1APISpec {
   resolver?: string;
   resolverProperties?: V1APISpecResolverProperties;
+  /** Streaming is true if the API should write rows to the response as they are produced instead of buffering the full result. */
+  streaming?: boolean;
+  description?: string;
+  /** Arguments declares the args accepted by the API. Args that are not declared are passed through without validation. */
+  arguments?: V1APIArgument[];
+  pagination?: V1APIPagination;
 }
 
 /**
@@ -2100,6 +2273,16 @@ export interface NumericHistogramBinsBin {
   count?: number;
 }
 
+export type ModelTestSeverity =
+  (typeof ModelTestSeverity)[keyof typeof ModelTestSeverity];
+
+// eslint-disable-next-line @typescript-eslint/no-redeclare
+export const ModelTestSeverity = {
+  SEVERITY_UNSPECIFIED: "SEVERITY_UNSPECIFIED",
+  SEVERITY_ERROR: "SEVERITY_ERROR",
+  SEVERITY_WARN: "SEVERITY_WARN",
+} as const;
+
 export interface MetricsViewSpecSecurityV2 {
   access?: string;
   rowFilter?: string;
@@ -2109,14 +2292,41 @@ export interface MetricsViewSpecSecurityV2 {
 
 export interface MetricsViewSpecMeasureV2 {
   name?: string;
+  /** Aggregate SQL expression. For derived measures, references to other measures have been expanded.
+It is empty for window measures (running total, moving average and share of parent). */
   expression?: string;
   label?: string;
   description?: string;
   formatPreset?: string;
   formatD3?: string;
   validPercentOfTotal?: boolean;
+  type?: MetricsViewSpecMeasureType;
+  /** Names of the measures referenced by a derived measure, or the single measure a window measure is computed over. */
+  referencedMeasures?: string[];
+  /** Number of time grain periods (including the current one) to average over for moving average measures. */
+  window?: number;
 }
 
+/**
+ * - MEASURE_TYPE_SIMPLE: Aggregate SQL expression over the underlying table
+ - MEASURE_TYPE_DERIVED: Expression that references other measures by name
+ - MEASURE_TYPE_RUNNING_TOTAL: Cumulative sum of another measure over the time dimension
+ - MEASURE_TYPE_MOVING_AVERAGE: Average of another measure over a trailing window of time grain periods
+ - MEASURE_TYPE_SHARE_OF_PARENT: Ratio of another measure to its total across all rows in the same time period
+ */
+export type MetricsViewSpecMeasureType =
+  (typeof MetricsViewSpecMeasureType)[keyof typeof MetricsViewSpecMeasureType];
+
+// eslint-disable-next-line @typescript-eslint/no-redeclare
+export const MetricsViewSpecMeasureType = {
+  MEASURE_TYPE_UNSPECIFIED: "MEASURE_TYPE_UNSPECIFIED",
+  MEASURE_TYPE_SIMPLE: "MEASURE_TYPE_SIMPLE",
+  MEASURE_TYPE_DERIVED: "MEASURE_TYPE_DERIVED",
+  MEASURE_TYPE_RUNNING_TOTAL: "MEASURE_TYPE_RUNNING_TOTAL",
+  MEASURE_TYPE_MOVING_AVERAGE: "MEASURE_TYPE_MOVING_AVERAGE",
+  MEASURE_TYPE_SHARE_OF_PARENT: "MEASURE_TYPE_SHARE_OF_PARENT",
+} as const;
+
 export interface MetricsViewSpecDimensionV2 {
   name?: string;
   column?: string;
@@ -2196,3 +2406,18 @@ export const BucketExtractPolicyStrategy = {
   STRATEGY_HEAD: "STRATEGY_HEAD",
   STRATEGY_TAIL: "STRATEGY_TAIL",
 } as const;
+
+export interface APIPaginationSort {
+  column?: string;
+  desc?: boolean;
+}
+
+export type APIPaginationMode =
+  (typeof APIPaginationMode)[keyof typeof APIPaginationMode];
+
+// eslint-disable-next-line @typescript-eslint/no-redeclare
+export const APIPaginationMode = {
+  MODE_UNSPECIFIED: "MODE_UNSPECIFIED",
+  MODE_OFFSET: "MODE_OFFSET",
+  MODE_CURSOR: "MODE_CURSOR",
+} as const;
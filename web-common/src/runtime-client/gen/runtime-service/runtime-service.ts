@@ -59,6 +59,8 @@ import type {
   RuntimeServiceGetLogsParams,
   RuntimeServiceWatchLogs200,
   RuntimeServiceWatchLogsParams,
+  V1GetModelPartitionsResponse,
+  RuntimeServiceGetModelPartitionsParams,
   V1GetResourceResponse,
   RuntimeServiceGetResourceParams,
   V1ListResourcesResponse,
@@ -1428,6 +1430,79 @@ export const createRuntimeServiceWatchLogs = <
   return query;
 };
 
+/**
+ * @summary GetModelPartitions returns the partitions of a model
+ */
+export const runtimeServiceGetModelPartitions = (
+  instanceId: string,
+  model: string,
+  params?: RuntimeServiceGetModelPartitionsParams,
+  signal?: AbortSignal,
+) => {
+  return httpClient<V1GetModelPartitionsResponse>({
+    url: `/v1/instances/${instanceId}/models/${model}/partitions`,
+    method: "get",
+    params,
+    signal,
+  });
+};
+
+export const getRuntimeServiceGetModelPartitionsQueryKey = (
+  instanceId: string,
+  model: string,
+  params?: RuntimeServiceGetModelPartitionsParams,
+) => [
+  `/v1/instances/${instanceId}/models/${model}/partitions`,
+  ...(params ? [params] : []),
+];
+
+export type RuntimeServiceGetModelPartitionsQueryResult = NonNullable<
+  Awaited<ReturnType<typeof runtimeServiceGetModelPartitions>>
+>;
+export type RuntimeServiceGetModelPartitionsQueryError = ErrorType<RpcStatus>;
+
+export const createRuntimeServiceGetModelPartitions = <
+  TData = Awaited<ReturnType<typeof runtimeServiceGetModelPartitions>>,
+  TError = ErrorType<RpcStatus>,
+>(
+  instanceId: string,
+  model: string,
+  params?: RuntimeServiceGetModelPartitionsParams,
+  options?: {
+    query?: CreateQueryOptions<
+      Awaited<ReturnType<typeof runtimeServiceGetModelPartitions>>,
+      TError,
+      TData
+    >;
+  },
+): CreateQueryResult<TData, TError> & { queryKey: QueryKey } => {
+  const { query: queryOptions } = options ?? {};
+
+  const queryKey =
+    queryOptions?.queryKey ??
+    getRuntimeServiceGetModelPartitionsQueryKey(instanceId, model, params);
+
+  const queryFn: QueryFunction<
+    Awaited<ReturnType<typeof runtimeServiceGetModelPartitions>>
+  > = ({ signal }) =>
+    runtimeServiceGetModelPartitions(instanceId, model, params, signal);
+
+  const query = createQuery<
+    Awaited<ReturnType<typeof runtimeServiceGetModelPartitions>>,
+    TError,
+    TData
+  >({
+    queryKey,
+    queryFn,
+    enabled: !!(instanceId && model),
+    ...queryOptions,
+  }) as CreateQueryResult<TData, TError> & { queryKey: QueryKey };
+
+  query.queryKey = queryKey;
+
+  return query;
+};
+
 /**
  * @summary GetResource looks up a specific catalog resource
  */